@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zestagio/chat-service/internal/config"
+	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	moderationrepo "github.com/zestagio/chat-service/internal/repositories/moderation"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/services/attachments"
+)
+
+const (
+	attachmentsStorageLocal = "local"
+	attachmentsStorageS3    = "s3"
+
+	defaultS3Timeout = 30 * time.Second
+
+	// uploadBodyOverhead is the room for the multipart headers and other form fields.
+	uploadBodyOverhead = 64 << 10
+	uploadPath         = "/v1/uploadAttachment"
+)
+
+func newAttachmentsService(
+	cfg config.AttachmentsConfig,
+	chatsRepo *chatsrepo.Repo,
+	msgRepo *messagesrepo.Repo,
+	moderationRepo *moderationrepo.Repo,
+	problemsRepo *problemsrepo.Repo,
+) (*attachments.Service, error) {
+	storage, err := newAttachmentsStorage(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create storage: %v", err)
+	}
+
+	opts := []attachments.OptOptionsSetter{attachments.WithPublicURL(cfg.PublicURL)}
+	if cfg.ThumbnailSize > 0 {
+		opts = append(opts, attachments.WithThumbnailSize(cfg.ThumbnailSize))
+	}
+	if cfg.URLTTL > 0 {
+		opts = append(opts, attachments.WithUrlTTL(cfg.URLTTL))
+	}
+
+	return attachments.New(attachments.NewOptions(
+		storage,
+		msgRepo,
+		chatsRepo,
+		moderationRepo,
+		problemsRepo,
+		cfg.MaxFileSize,
+		cfg.AllowedContentTypes,
+		cfg.URLSecret,
+		opts...,
+	))
+}
+
+func newAttachmentsStorage(cfg config.AttachmentsConfig) (attachments.Storage, error) {
+	switch cfg.Storage {
+	case attachmentsStorageLocal:
+		s, err := attachments.NewLocalStorage(cfg.LocalDir)
+		if err != nil {
+			return nil, fmt.Errorf("create local storage: %v", err)
+		}
+		return s, nil
+
+	case attachmentsStorageS3:
+		timeout := cfg.S3Timeout
+		if timeout == 0 {
+			timeout = defaultS3Timeout
+		}
+		s, err := attachments.NewS3Storage(
+			cfg.S3Endpoint,
+			cfg.S3Region,
+			cfg.S3Bucket,
+			cfg.S3AccessKey,
+			cfg.S3SecretKey,
+			timeout,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create s3 storage: %v", err)
+		}
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("unknown attachments storage: %q", cfg.Storage)
+}
+
+// uploadBodyLimits allows the upload requests to exceed the default body limit of the server.
+func uploadBodyLimits(maxFileSize int64) map[string]string {
+	return map[string]string{
+		uploadPath: fmt.Sprintf("%dB", maxFileSize+uploadBodyOverhead),
+	}
+}
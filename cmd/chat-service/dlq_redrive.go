@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/zestagio/chat-service/internal/config"
+	afcverdictsprocessor "github.com/zestagio/chat-service/internal/services/afc-verdicts-processor"
+)
+
+const cmdDLQRedrive = "dlq-redrive"
+
+// runDLQRedrive re-processes the AFC verdicts from the DLQ partition offset range:
+//
+//	chat-service -config configs/config.toml dlq-redrive -partition 0 -from 0 -to -1
+func runDLQRedrive(
+	ctx context.Context,
+	args []string,
+	cfg config.AFCVerdictsProcessorConfig,
+	processor *afcverdictsprocessor.Service,
+) error {
+	fs := flag.NewFlagSet(cmdDLQRedrive, flag.ContinueOnError)
+	partition := fs.Int("partition", 0, "DLQ partition to re-drive")
+	fromOffset := fs.Int64("from", 0, "First offset to re-drive")
+	toOffset := fs.Int64("to", -1, "Last offset to re-drive (inclusive), negative means the current end of partition")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("parse %s args: %v", cmdDLQRedrive, err)
+	}
+
+	dlqReader, err := afcverdictsprocessor.NewKafkaDLQReader(
+		ctx,
+		cfg.Brokers,
+		cfg.VerdictsDLQTopic,
+		*partition,
+		*fromOffset,
+		*toOffset,
+	)
+	if err != nil {
+		return fmt.Errorf("create dlq reader: %v", err)
+	}
+
+	lg := zap.L().Named(cmdDLQRedrive)
+
+	var total, failed int
+	if err := processor.Redrive(ctx, dlqReader, func(o afcverdictsprocessor.RedriveOutcome) {
+		total++
+
+		fields := []zap.Field{
+			zap.Int("partition", o.Partition),
+			zap.Int64("offset", o.Offset),
+		}
+		if !o.MessageID.IsZero() {
+			fields = append(fields, zap.Stringer("message_id", o.MessageID))
+		}
+		if o.Err != nil {
+			failed++
+			lg.Warn("message failed again, kept in dlq", append(fields, zap.Error(o.Err))...)
+			return
+		}
+		lg.Info("message processed", fields...)
+	}); err != nil {
+		return fmt.Errorf("redrive: %v", err)
+	}
+
+	lg.Info("redrive finished", zap.Int("total", total), zap.Int("failed", failed))
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d messages failed again", failed, total)
+	}
+	return nil
+}
@@ -15,18 +15,25 @@ import (
 
 	keycloakclient "github.com/zestagio/chat-service/internal/clients/keycloak"
 	"github.com/zestagio/chat-service/internal/config"
+	"github.com/zestagio/chat-service/internal/cursor"
 	"github.com/zestagio/chat-service/internal/logger"
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
 	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
 	jobsrepo "github.com/zestagio/chat-service/internal/repositories/jobs"
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	moderationrepo "github.com/zestagio/chat-service/internal/repositories/moderation"
 	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
 	clientevents "github.com/zestagio/chat-service/internal/server-client/events"
 	clientv1 "github.com/zestagio/chat-service/internal/server-client/v1"
 	serverdebug "github.com/zestagio/chat-service/internal/server-debug"
 	managerevents "github.com/zestagio/chat-service/internal/server-manager/events"
 	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
+	moderatorv1 "github.com/zestagio/chat-service/internal/server-moderator/v1"
 	afcverdictsprocessor "github.com/zestagio/chat-service/internal/services/afc-verdicts-processor"
+	contentfilter "github.com/zestagio/chat-service/internal/services/content-filter"
 	inmemeventstream "github.com/zestagio/chat-service/internal/services/event-stream/in-mem"
+	"github.com/zestagio/chat-service/internal/services/jwks"
+	lifecycleproducer "github.com/zestagio/chat-service/internal/services/lifecycle-producer"
 	managerload "github.com/zestagio/chat-service/internal/services/manager-load"
 	inmemmanagerpool "github.com/zestagio/chat-service/internal/services/manager-pool/in-mem"
 	managerscheduler "github.com/zestagio/chat-service/internal/services/manager-scheduler"
@@ -34,10 +41,16 @@ import (
 	"github.com/zestagio/chat-service/internal/services/outbox"
 	clientmessageblockedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-blocked"
 	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
+	internalnoteaddedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/internal-note-added"
 	managerassignedtoproblemjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/manager-assigned-to-problem"
+	messagedeletedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-deleted"
+	messageeditedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-edited"
 	problemresolvedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/problem-resolved"
+	reactionschangedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/reactions-changed"
 	sendclientmessagejob "github.com/zestagio/chat-service/internal/services/outbox/jobs/send-client-message"
 	sendmanagermessagejob "github.com/zestagio/chat-service/internal/services/outbox/jobs/send-manager-message"
+	verdicttimeoutjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/verdict-timeout"
+	problemtaxonomy "github.com/zestagio/chat-service/internal/services/problem-taxonomy"
 	"github.com/zestagio/chat-service/internal/store"
 )
 
@@ -89,13 +102,18 @@ func run() (errReturned error) {
 	defer multierr.AppendInvoke(&errReturned, multierr.Close(storage))
 
 	// Migrations.
-	if err := storage.Schema.Create(ctx); err != nil {
+	if err := storage.Schema.Create(ctx, store.WithMessagesSearch()); err != nil {
 		return fmt.Errorf("migrate: %v", err)
 	}
 
 	// Repositories.
 	db := store.NewDatabase(storage)
 
+	cannedResponsesRepo, err := cannedresponsesrepo.New(cannedresponsesrepo.NewOptions(db))
+	if err != nil {
+		return fmt.Errorf("create canned responses repo: %v", err)
+	}
+
 	chatsRepo, err := chatsrepo.New(chatsrepo.NewOptions(db))
 	if err != nil {
 		return fmt.Errorf("create chats repo: %v", err)
@@ -111,6 +129,11 @@ func run() (errReturned error) {
 		return fmt.Errorf("create messages repo: %v", err)
 	}
 
+	moderationRepo, err := moderationrepo.New(moderationrepo.NewOptions(db))
+	if err != nil {
+		return fmt.Errorf("create moderation repo: %v", err)
+	}
+
 	problemsRepo, err := problemsrepo.New(problemsrepo.NewOptions(db))
 	if err != nil {
 		return fmt.Errorf("create problems repo: %v", err)
@@ -135,25 +158,45 @@ func run() (errReturned error) {
 	eventsStream := inmemeventstream.New()
 	defer multierr.AppendInvoke(&errReturned, multierr.Close(eventsStream))
 
+	msgProducerWriter, err := newProducerWriter(cfg.Services.MsgProducer.ProducerSinkConfig)
+	if err != nil {
+		return fmt.Errorf("create message producer writer: %v", err)
+	}
+
 	msgProducer, err := msgproducer.New(msgproducer.NewOptions(
-		msgproducer.NewKafkaWriter(
-			cfg.Services.MsgProducer.Brokers,
-			cfg.Services.MsgProducer.Topic,
-			cfg.Services.MsgProducer.BatchSize,
-		),
+		msgProducerWriter,
 		msgproducer.WithEncryptKey(cfg.Services.MsgProducer.EncryptKey),
+		msgproducer.WithEncryptKeys(cfg.Services.MsgProducer.EncryptKeys),
+		msgproducer.WithActiveEncryptKeyID(cfg.Services.MsgProducer.ActiveEncryptKeyID),
 	))
 	if err != nil {
 		return fmt.Errorf("create message producer: %v", err)
 	}
 	defer multierr.AppendInvoke(&errReturned, multierr.Close(msgProducer))
 
+	lifecycleProducerWriter, err := newProducerWriter(cfg.Services.LifecycleProducer.ProducerSinkConfig)
+	if err != nil {
+		return fmt.Errorf("create lifecycle producer writer: %v", err)
+	}
+
+	lifecycleProducer, err := lifecycleproducer.New(lifecycleproducer.NewOptions(lifecycleProducerWriter))
+	if err != nil {
+		return fmt.Errorf("create lifecycle producer: %v", err)
+	}
+	defer multierr.AppendInvoke(&errReturned, multierr.Close(lifecycleProducer))
+
+	var outBoxOpts []outbox.OptOptionsSetter
+	if gp := cfg.Services.Outbox.ShutdownGracePeriod; gp > 0 {
+		outBoxOpts = append(outBoxOpts, outbox.WithShutdownGracePeriod(gp))
+	}
+
 	outBox, err := outbox.New(outbox.NewOptions(
 		cfg.Services.Outbox.Workers,
 		cfg.Services.Outbox.IdleTime,
 		cfg.Services.Outbox.ReserveFor,
 		jobsRepo,
 		db,
+		outBoxOpts...,
 	))
 	if err != nil {
 		return fmt.Errorf("create outbox service: %v", err)
@@ -163,6 +206,24 @@ func run() (errReturned error) {
 	defer multierr.AppendInvoke(&errReturned, multierr.Close(managerPool))
 
 	// Domain Services.
+	attachmentsSvc, err := newAttachmentsService(cfg.Services.Attachments, chatsRepo, msgRepo, moderationRepo, problemsRepo)
+	if err != nil {
+		return fmt.Errorf("create attachments service: %v", err)
+	}
+
+	cursorCodec, err := cursor.NewCodec(cursor.NewOptions(cfg.Services.Cursors.Secret))
+	if err != nil {
+		return fmt.Errorf("create cursor codec: %v", err)
+	}
+
+	problemTaxonomy, err := problemtaxonomy.New(problemtaxonomy.NewOptions(
+		cfg.Services.ProblemTaxonomy.Categories,
+		cfg.Services.ProblemTaxonomy.ResolutionCodes,
+	))
+	if err != nil {
+		return fmt.Errorf("create problem taxonomy: %v", err)
+	}
+
 	managerLoad, err := managerload.New(managerload.NewOptions(
 		cfg.Services.ManagerLoad.MaxProblemsAtSameTime,
 		problemsRepo,
@@ -171,7 +232,53 @@ func run() (errReturned error) {
 		return fmt.Errorf("create manager load service: %v", err)
 	}
 
+	var contentFilter *contentfilter.Service
+	if cfCfg := cfg.Services.ContentFilter; cfCfg.Mode != "" {
+		var cfOpts []contentfilter.OptOptionsSetter
+		if cfCfg.ReloadPeriod > 0 {
+			cfOpts = append(cfOpts, contentfilter.WithReloadPeriod(cfCfg.ReloadPeriod))
+		}
+
+		contentFilter, err = contentfilter.New(contentfilter.NewOptions(cfCfg.RulesFile, cfOpts...))
+		if err != nil {
+			return fmt.Errorf("create content filter: %v", err)
+		}
+	}
+
+	verdictTimeout, err := newVerdictTimeoutSettings(
+		cfg.Services.AFCVerdictsProcessor.VerdictTimeout,
+		cfg.Services.ContentFilter.Mode,
+	)
+	if err != nil {
+		return fmt.Errorf("verdict timeout settings: %v", err)
+	}
+
 	// Application Services.
+	afcVerdictsProcessorOpts := []afcverdictsprocessor.OptOptionsSetter{
+		afcverdictsprocessor.WithProcessBatchSize(cfg.Services.AFCVerdictsProcessor.BatchSize),
+		afcverdictsprocessor.WithVerdictsSignKey(cfg.Services.AFCVerdictsProcessor.VerdictsSigningPublicKey),
+	}
+
+	var verdictsSignKeys *jwks.Service
+	if jwksCfg := cfg.Services.AFCVerdictsProcessor.VerdictsSigningJWKS; jwksCfg.Source != "" {
+		var jwksOpts []jwks.OptOptionsSetter
+		if jwksCfg.RefreshPeriod > 0 {
+			jwksOpts = append(jwksOpts, jwks.WithRefreshPeriod(jwksCfg.RefreshPeriod))
+		}
+
+		verdictsSignKeys, err = jwks.New(jwks.NewOptions(jwksCfg.Source, jwksOpts...))
+		if err != nil {
+			return fmt.Errorf("create verdicts signing key set: %v", err)
+		}
+		afcVerdictsProcessorOpts = append(afcVerdictsProcessorOpts,
+			afcverdictsprocessor.WithVerdictsSignKeys(verdictsSignKeys))
+	}
+
+	if cfg.Services.ContentFilter.Mode == contentFilterModeShadow {
+		afcVerdictsProcessorOpts = append(afcVerdictsProcessorOpts,
+			afcverdictsprocessor.WithShadowFilter(contentFilter))
+	}
+
 	afcVerdictsProcessor, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
 		cfg.Services.AFCVerdictsProcessor.Brokers,
 		cfg.Services.AFCVerdictsProcessor.Consumers,
@@ -184,14 +291,18 @@ func run() (errReturned error) {
 		),
 		db,
 		msgRepo,
+		moderationRepo,
 		outBox,
-		afcverdictsprocessor.WithProcessBatchSize(cfg.Services.AFCVerdictsProcessor.BatchSize),
-		afcverdictsprocessor.WithVerdictsSignKey(cfg.Services.AFCVerdictsProcessor.VerdictsSigningPublicKey),
+		afcVerdictsProcessorOpts...,
 	))
 	if err != nil {
 		return fmt.Errorf("create afc verdicts processor: %v", err)
 	}
 
+	if flag.Arg(0) == cmdDLQRedrive {
+		return runDLQRedrive(ctx, flag.Args()[1:], cfg.Services.AFCVerdictsProcessor, afcVerdictsProcessor)
+	}
+
 	mngrScheduler, err := managerscheduler.New(managerscheduler.NewOptions(
 		cfg.Services.ManagerScheduler.Period,
 		managerPool,
@@ -205,13 +316,63 @@ func run() (errReturned error) {
 	}
 
 	// Application Services. Jobs.
+	verdictTimeoutJobOpts := []verdicttimeoutjob.OptOptionsSetter{}
+	if contentFilter != nil {
+		verdictTimeoutJobOpts = append(verdictTimeoutJobOpts, verdicttimeoutjob.WithPreFilter(contentFilter))
+	}
+
 	for _, j := range []outbox.Job{
-		clientmessageblockedjob.Must(clientmessageblockedjob.NewOptions(eventsStream, msgRepo)),
-		clientmessagesentjob.Must(clientmessagesentjob.NewOptions(chatsRepo, eventsStream, msgRepo)),
-		managerassignedtoproblemjob.Must(managerassignedtoproblemjob.NewOptions(chatsRepo, eventsStream, msgRepo, managerLoad)),
-		problemresolvedjob.Must(problemresolvedjob.NewOptions(chatsRepo, eventsStream, managerLoad, msgRepo, problemsRepo)),
-		sendclientmessagejob.Must(sendclientmessagejob.NewOptions(eventsStream, msgProducer, msgRepo)),
-		sendmanagermessagejob.Must(sendmanagermessagejob.NewOptions(chatsRepo, eventsStream, msgProducer, msgRepo)),
+		clientmessageblockedjob.Must(clientmessageblockedjob.NewOptions(eventsStream, lifecycleProducer, msgRepo)),
+		clientmessagesentjob.Must(clientmessagesentjob.NewOptions(
+			attachmentsSvc,
+			chatsRepo,
+			eventsStream,
+			lifecycleProducer,
+			msgRepo,
+		)),
+		internalnoteaddedjob.Must(internalnoteaddedjob.NewOptions(eventsStream, msgRepo)),
+		managerassignedtoproblemjob.Must(managerassignedtoproblemjob.NewOptions(
+			chatsRepo,
+			eventsStream,
+			lifecycleProducer,
+			msgRepo,
+			managerLoad,
+		)),
+		messagedeletedjob.Must(messagedeletedjob.NewOptions(chatsRepo, eventsStream, msgRepo)),
+		messageeditedjob.Must(messageeditedjob.NewOptions(attachmentsSvc, chatsRepo, eventsStream, msgProducer, msgRepo)),
+		problemresolvedjob.Must(problemresolvedjob.NewOptions(
+			chatsRepo,
+			eventsStream,
+			lifecycleProducer,
+			managerLoad,
+			msgRepo,
+			problemsRepo,
+			cfg.Services.SatisfactionSurvey.RatingWindow,
+		)),
+		reactionschangedjob.Must(reactionschangedjob.NewOptions(chatsRepo, eventsStream, msgRepo)),
+		sendclientmessagejob.Must(sendclientmessagejob.NewOptions(
+			attachmentsSvc,
+			eventsStream,
+			lifecycleProducer,
+			msgProducer,
+			msgRepo,
+			problemsRepo,
+		)),
+		sendmanagermessagejob.Must(sendmanagermessagejob.NewOptions(
+			attachmentsSvc,
+			chatsRepo,
+			eventsStream,
+			msgProducer,
+			msgRepo,
+		)),
+		verdicttimeoutjob.Must(verdicttimeoutjob.NewOptions(
+			verdictTimeout.policy,
+			msgRepo,
+			moderationRepo,
+			outBox,
+			db,
+			verdictTimeoutJobOpts...,
+		)),
 	} {
 		outBox.MustRegisterJob(j)
 	}
@@ -231,7 +392,17 @@ func run() (errReturned error) {
 		cfg.Servers.Client.RequiredAccess.Resource,
 		cfg.Servers.Client.RequiredAccess.Role,
 		cfg.Servers.Client.SecWsProtocol,
+		verdictTimeout.enabled,
+		verdictTimeout.timeout,
+		cfg.Services.MessageEditing.Window,
+		cfg.Services.MessageDeletion.Window,
+		cfg.Services.SatisfactionSurvey.RatingWindow,
+		cfg.Services.ProblemReopening.Window,
+		cfg.Services.Attachments.MaxFileSize,
+		attachmentsSvc,
+		cursorCodec,
 		eventsStream,
+		managerLoad,
 		outBox,
 		db,
 		chatsRepo,
@@ -256,11 +427,19 @@ func run() (errReturned error) {
 		cfg.Servers.Manager.RequiredAccess.Resource,
 		cfg.Servers.Manager.RequiredAccess.Role,
 		cfg.Servers.Manager.SecWsProtocol,
+		cfg.Services.CannedResponses.EditorAccess.Resource,
+		cfg.Services.CannedResponses.EditorAccess.Role,
+		cfg.Services.MessageEditing.Window,
+		cfg.Services.Attachments.MaxFileSize,
+		attachmentsSvc,
+		cursorCodec,
 		eventsStream,
 		managerLoad,
 		managerPool,
 		outBox,
+		problemTaxonomy,
 		db,
+		cannedResponsesRepo,
 		chatsRepo,
 		msgRepo,
 		problemsRepo,
@@ -269,6 +448,30 @@ func run() (errReturned error) {
 		return fmt.Errorf("init manager server: %v", err)
 	}
 
+	moderatorV1Swagger, err := moderatorv1.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("get moderator v1 swagger: %v", err)
+	}
+
+	srvModerator, err := initServerModerator(
+		cfg.Global.IsProduction(),
+		cfg.Servers.Moderator.Addr,
+		cfg.Servers.Moderator.AllowOrigins,
+		moderatorV1Swagger,
+		kc,
+		cfg.Servers.Moderator.RequiredAccess.Resource,
+		cfg.Servers.Moderator.RequiredAccess.Role,
+		cfg.Servers.Moderator.SecWsProtocol,
+		attachmentsSvc,
+		outBox,
+		db,
+		moderationRepo,
+		msgRepo,
+	)
+	if err != nil {
+		return fmt.Errorf("init moderator server: %v", err)
+	}
+
 	clientEventsSwagger, err := clientevents.GetSwagger()
 	if err != nil {
 		return fmt.Errorf("get client events swagger: %v", err)
@@ -285,6 +488,7 @@ func run() (errReturned error) {
 		clientEventsSwagger,
 		managerV1Swagger,
 		managerEventsSwagger,
+		moderatorV1Swagger,
 	))
 	if err != nil {
 		return fmt.Errorf("init debug server: %v", err)
@@ -295,12 +499,19 @@ func run() (errReturned error) {
 	// Run servers.
 	eg.Go(func() error { return srvClient.Run(ctx) })
 	eg.Go(func() error { return srvManager.Run(ctx) })
+	eg.Go(func() error { return srvModerator.Run(ctx) })
 	eg.Go(func() error { return srvDebug.Run(ctx) })
 
 	// Run services.
 	eg.Go(func() error { return outBox.Run(ctx) })
 	eg.Go(func() error { return mngrScheduler.Run(ctx) })
 	eg.Go(func() error { return afcVerdictsProcessor.Run(ctx) })
+	if verdictsSignKeys != nil {
+		eg.Go(func() error { return verdictsSignKeys.Run(ctx) })
+	}
+	if contentFilter != nil {
+		eg.Go(func() error { return contentFilter.Run(ctx) })
+	}
 
 	if err = eg.Wait(); err != nil && !errors.Is(err, context.Canceled) {
 		return fmt.Errorf("wait app stop: %v", err)
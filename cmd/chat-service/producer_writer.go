@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zestagio/chat-service/internal/config"
+	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
+)
+
+const (
+	msgProducerSinkKafka = "kafka"
+	msgProducerSinkFile  = "file"
+	msgProducerSinkHTTP  = "http"
+
+	defaultWebhookTimeout = 5 * time.Second
+)
+
+func newProducerWriter(cfg config.ProducerSinkConfig) (msgproducer.Writer, error) {
+	switch cfg.Sink {
+	case msgProducerSinkKafka:
+		return msgproducer.NewKafkaWriter(cfg.Brokers, cfg.Topic, cfg.BatchSize), nil
+
+	case msgProducerSinkFile:
+		wr, err := msgproducer.NewFileWriter(cfg.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("create file writer: %v", err)
+		}
+		return wr, nil
+
+	case msgProducerSinkHTTP:
+		timeout := cfg.WebhookTimeout
+		if timeout == 0 {
+			timeout = defaultWebhookTimeout
+		}
+		return msgproducer.NewHTTPWriter(cfg.WebhookURL, timeout), nil
+	}
+
+	return nil, fmt.Errorf("unknown producer sink: %q", cfg.Sink)
+}
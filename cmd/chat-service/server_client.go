@@ -2,11 +2,13 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"go.uber.org/zap"
 
 	keycloakclient "github.com/zestagio/chat-service/internal/clients/keycloak"
+	"github.com/zestagio/chat-service/internal/cursor"
 	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
 	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
@@ -16,11 +18,18 @@ import (
 	clientevents "github.com/zestagio/chat-service/internal/server-client/events"
 	clientv1 "github.com/zestagio/chat-service/internal/server-client/v1"
 	"github.com/zestagio/chat-service/internal/server/errhandler"
+	"github.com/zestagio/chat-service/internal/services/attachments"
 	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
+	managerload "github.com/zestagio/chat-service/internal/services/manager-load"
 	"github.com/zestagio/chat-service/internal/services/outbox"
 	"github.com/zestagio/chat-service/internal/store"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/client/delete-message"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/client/edit-message"
 	gethistory "github.com/zestagio/chat-service/internal/usecases/client/get-history"
+	rateproblem "github.com/zestagio/chat-service/internal/usecases/client/rate-problem"
+	reacttomessage "github.com/zestagio/chat-service/internal/usecases/client/react-to-message"
 	sendmessage "github.com/zestagio/chat-service/internal/usecases/client/send-message"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/client/upload-attachment"
 	websocketstream "github.com/zestagio/chat-service/internal/websocket-stream"
 )
 
@@ -37,8 +46,18 @@ func initServerClient(
 	requiredResource string,
 	requiredRole string,
 	secWsProtocol string,
-
+	verdictTimeoutEnabled bool,
+	verdictTimeout time.Duration,
+	editWindow time.Duration,
+	deleteWindow time.Duration,
+	ratingWindow time.Duration,
+	reopenWindow time.Duration,
+	maxUploadSize int64,
+
+	attachmentsSvc *attachments.Service,
+	cursorCodec *cursor.Codec,
 	eventStream eventstream.EventStream,
+	managerLoad *managerload.Service,
 	outBox *outbox.Service,
 
 	db *store.Database,
@@ -46,25 +65,66 @@ func initServerClient(
 	msgRepo *messagesrepo.Repo,
 	problemsRepo *problemsrepo.Repo,
 ) (*server.Server, error) {
-	getHistoryUseCase, err := gethistory.New(gethistory.NewOptions(msgRepo))
+	deleteMessageUseCase, err := deletemessage.New(deletemessage.NewOptions(msgRepo, outBox, db, deleteWindow))
+	if err != nil {
+		return nil, fmt.Errorf("create deletemessage usecase: %v", err)
+	}
+
+	editMessageUseCase, err := editmessage.New(editmessage.NewOptions(
+		msgRepo,
+		outBox,
+		db,
+		editWindow,
+		editmessage.WithVerdictTimeoutEnabled(verdictTimeoutEnabled),
+		editmessage.WithVerdictTimeout(verdictTimeout),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create editmessage usecase: %v", err)
+	}
+
+	getHistoryUseCase, err := gethistory.New(gethistory.NewOptions(attachmentsSvc, cursorCodec, msgRepo))
 	if err != nil {
 		return nil, fmt.Errorf("create gethistory usecase: %v", err)
 	}
 
+	rateProblemUseCase, err := rateproblem.New(rateproblem.NewOptions(problemsRepo, ratingWindow))
+	if err != nil {
+		return nil, fmt.Errorf("create rateproblem usecase: %v", err)
+	}
+
+	reactToMessageUseCase, err := reacttomessage.New(reacttomessage.NewOptions(chatsRepo, msgRepo, outBox, db))
+	if err != nil {
+		return nil, fmt.Errorf("create reacttomessage usecase: %v", err)
+	}
+
 	sendMessageUseCase, err := sendmessage.New(sendmessage.NewOptions(
 		chatsRepo,
+		managerLoad,
 		msgRepo,
 		outBox,
 		problemsRepo,
 		db,
+		sendmessage.WithVerdictTimeoutEnabled(verdictTimeoutEnabled),
+		sendmessage.WithVerdictTimeout(verdictTimeout),
+		sendmessage.WithReopenWindow(reopenWindow),
 	))
 	if err != nil {
 		return nil, fmt.Errorf("create sendmessage usecase: %v", err)
 	}
 
+	uploadAttachmentUseCase, err := uploadattachment.New(uploadattachment.NewOptions(attachmentsSvc, chatsRepo))
+	if err != nil {
+		return nil, fmt.Errorf("create uploadattachment usecase: %v", err)
+	}
+
 	v1Handlers, err := clientv1.NewHandlers(clientv1.NewOptions(
+		deleteMessageUseCase,
+		editMessageUseCase,
 		getHistoryUseCase,
+		rateProblemUseCase,
+		reactToMessageUseCase,
 		sendMessageUseCase,
+		uploadAttachmentUseCase,
 	))
 	if err != nil {
 		return nil, fmt.Errorf("create v1 handlers: %v", err)
@@ -102,8 +162,16 @@ func initServerClient(
 		requiredResource,
 		requiredRole,
 		secWsProtocol,
-		serverclient.NewHandlersRegistrar(v1Swagger, v1Handlers, wsHandler.Serve, httpErrorHandler.Handle),
+		serverclient.NewHandlersRegistrar(
+			v1Swagger,
+			v1Handlers,
+			wsHandler.Serve,
+			attachmentsSvc.Download,
+			httpErrorHandler.Handle,
+		),
 		shutdownFn,
+		server.WithPublicPaths([]string{attachments.DownloadPath}),
+		server.WithBodyLimits(uploadBodyLimits(maxUploadSize)),
 	))
 	if err != nil {
 		return nil, fmt.Errorf("build server: %v", err)
@@ -2,11 +2,14 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"go.uber.org/zap"
 
 	keycloakclient "github.com/zestagio/chat-service/internal/clients/keycloak"
+	"github.com/zestagio/chat-service/internal/cursor"
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
 	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
 	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
@@ -16,17 +19,33 @@ import (
 	managerevents "github.com/zestagio/chat-service/internal/server-manager/events"
 	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
 	"github.com/zestagio/chat-service/internal/server/errhandler"
+	"github.com/zestagio/chat-service/internal/services/attachments"
 	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
 	managerload "github.com/zestagio/chat-service/internal/services/manager-load"
 	managerpool "github.com/zestagio/chat-service/internal/services/manager-pool"
 	"github.com/zestagio/chat-service/internal/services/outbox"
+	problemtaxonomy "github.com/zestagio/chat-service/internal/services/problem-taxonomy"
 	"github.com/zestagio/chat-service/internal/store"
+	addinternalnote "github.com/zestagio/chat-service/internal/usecases/manager/add-internal-note"
 	canreceiveproblems "github.com/zestagio/chat-service/internal/usecases/manager/can-receive-problems"
+	createcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/create-canned-response"
+	deletecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/delete-canned-response"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/manager/delete-message"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/manager/edit-message"
 	freehandssignal "github.com/zestagio/chat-service/internal/usecases/manager/free-hands-signal"
+	getcannedresponses "github.com/zestagio/chat-service/internal/usecases/manager/get-canned-responses"
 	getchathistory "github.com/zestagio/chat-service/internal/usecases/manager/get-chat-history"
 	getchats "github.com/zestagio/chat-service/internal/usecases/manager/get-chats"
+	getsatisfactionscores "github.com/zestagio/chat-service/internal/usecases/manager/get-satisfaction-scores"
+	markchatasread "github.com/zestagio/chat-service/internal/usecases/manager/mark-chat-as-read"
+	reacttomessage "github.com/zestagio/chat-service/internal/usecases/manager/react-to-message"
 	resolveproblem "github.com/zestagio/chat-service/internal/usecases/manager/resolve-problem"
+	searchmessages "github.com/zestagio/chat-service/internal/usecases/manager/search-messages"
+	sendcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/send-canned-response"
 	sendmessage "github.com/zestagio/chat-service/internal/usecases/manager/send-message"
+	setproblemcategory "github.com/zestagio/chat-service/internal/usecases/manager/set-problem-category"
+	updatecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/update-canned-response"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/manager/upload-attachment"
 	websocketstream "github.com/zestagio/chat-service/internal/websocket-stream"
 )
 
@@ -43,54 +62,153 @@ func initServerManager(
 	requiredResource string,
 	requiredRole string,
 	secWsProtocol string,
+	teamLeadResource string,
+	teamLeadRole string,
+	editWindow time.Duration,
+	maxUploadSize int64,
 
+	attachmentsSvc *attachments.Service,
+	cursorCodec *cursor.Codec,
 	eventStream eventstream.EventStream,
 	mLoadSvc *managerload.Service,
 	mPool managerpool.Pool,
 	outBox *outbox.Service,
+	problemTaxonomy *problemtaxonomy.Service,
 
 	db *store.Database,
+	cannedResponsesRepo *cannedresponsesrepo.Repo,
 	chatsRepo *chatsrepo.Repo,
 	msgRepo *messagesrepo.Repo,
 	problemsRepo *problemsrepo.Repo,
 ) (*server.Server, error) {
+	addInternalNoteUseCase, err := addinternalnote.New(addinternalnote.NewOptions(msgRepo, outBox, problemsRepo, db))
+	if err != nil {
+		return nil, fmt.Errorf("create addinternalnote usecase: %v", err)
+	}
+
 	canReceiveProblemsUseCase, err := canreceiveproblems.New(canreceiveproblems.NewOptions(mLoadSvc, mPool))
 	if err != nil {
 		return nil, fmt.Errorf("create canreceiveproblems usecase: %v", err)
 	}
 
+	createCannedResponseUseCase, err := createcannedresponse.New(createcannedresponse.NewOptions(cannedResponsesRepo))
+	if err != nil {
+		return nil, fmt.Errorf("create createcannedresponse usecase: %v", err)
+	}
+
+	deleteCannedResponseUseCase, err := deletecannedresponse.New(deletecannedresponse.NewOptions(cannedResponsesRepo))
+	if err != nil {
+		return nil, fmt.Errorf("create deletecannedresponse usecase: %v", err)
+	}
+
+	deleteMessageUseCase, err := deletemessage.New(deletemessage.NewOptions(msgRepo, outBox, problemsRepo, db))
+	if err != nil {
+		return nil, fmt.Errorf("create deletemessage usecase: %v", err)
+	}
+
+	editMessageUseCase, err := editmessage.New(editmessage.NewOptions(msgRepo, outBox, problemsRepo, db, editWindow))
+	if err != nil {
+		return nil, fmt.Errorf("create editmessage usecase: %v", err)
+	}
+
 	freeHandsSignalUseCase, err := freehandssignal.New(freehandssignal.NewOptions(mLoadSvc, mPool))
 	if err != nil {
 		return nil, fmt.Errorf("create freehandssignal usecase: %v", err)
 	}
 
+	getCannedResponsesUseCase, err := getcannedresponses.New(getcannedresponses.NewOptions(cannedResponsesRepo))
+	if err != nil {
+		return nil, fmt.Errorf("create getcannedresponses usecase: %v", err)
+	}
+
 	getChatsUseCase, err := getchats.New(getchats.NewOptions(chatsRepo))
 	if err != nil {
 		return nil, fmt.Errorf("create getchats usecase: %v", err)
 	}
 
-	getChatHistoryUseCase, err := getchathistory.New(getchathistory.NewOptions(msgRepo, problemsRepo))
+	getChatHistoryUseCase, err := getchathistory.New(getchathistory.NewOptions(attachmentsSvc, cursorCodec, msgRepo, problemsRepo))
 	if err != nil {
 		return nil, fmt.Errorf("create getchathistory usecase: %v", err)
 	}
 
-	resolveProblemUseCase, err := resolveproblem.New(resolveproblem.NewOptions(msgRepo, outBox, problemsRepo, db))
+	getSatisfactionScoresUseCase, err := getsatisfactionscores.New(getsatisfactionscores.NewOptions(problemsRepo))
+	if err != nil {
+		return nil, fmt.Errorf("create getsatisfactionscores usecase: %v", err)
+	}
+
+	markChatAsReadUseCase, err := markchatasread.New(markchatasread.NewOptions(problemsRepo))
+	if err != nil {
+		return nil, fmt.Errorf("create markchatasread usecase: %v", err)
+	}
+
+	reactToMessageUseCase, err := reacttomessage.New(reacttomessage.NewOptions(msgRepo, outBox, problemsRepo, db))
+	if err != nil {
+		return nil, fmt.Errorf("create reacttomessage usecase: %v", err)
+	}
+
+	resolveProblemUseCase, err := resolveproblem.New(resolveproblem.NewOptions(msgRepo, outBox, problemsRepo, problemTaxonomy, db))
 	if err != nil {
 		return nil, fmt.Errorf("create resolveproblem usecase: %v", err)
 	}
 
+	searchMessagesUseCase, err := searchmessages.New(searchmessages.NewOptions(cursorCodec, msgRepo))
+	if err != nil {
+		return nil, fmt.Errorf("create searchmessages usecase: %v", err)
+	}
+
 	sendMessageUseCase, err := sendmessage.New(sendmessage.NewOptions(msgRepo, outBox, problemsRepo, db))
 	if err != nil {
 		return nil, fmt.Errorf("create sendmessage usecase: %v", err)
 	}
 
+	sendCannedResponseUseCase, err := sendcannedresponse.New(sendcannedresponse.NewOptions(
+		cannedResponsesRepo,
+		chatsRepo,
+		sendMessageUseCase,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create sendcannedresponse usecase: %v", err)
+	}
+
+	setProblemCategoryUseCase, err := setproblemcategory.New(setproblemcategory.NewOptions(problemsRepo, problemTaxonomy))
+	if err != nil {
+		return nil, fmt.Errorf("create setproblemcategory usecase: %v", err)
+	}
+
+	updateCannedResponseUseCase, err := updatecannedresponse.New(updatecannedresponse.NewOptions(cannedResponsesRepo))
+	if err != nil {
+		return nil, fmt.Errorf("create updatecannedresponse usecase: %v", err)
+	}
+
+	uploadAttachmentUseCase, err := uploadattachment.New(uploadattachment.NewOptions(attachmentsSvc, problemsRepo))
+	if err != nil {
+		return nil, fmt.Errorf("create uploadattachment usecase: %v", err)
+	}
+
 	v1Handlers, err := managerv1.NewHandlers(managerv1.NewOptions(
+		addInternalNoteUseCase,
 		canReceiveProblemsUseCase,
+		createCannedResponseUseCase,
+		deleteCannedResponseUseCase,
+		deleteMessageUseCase,
+		editMessageUseCase,
 		freeHandsSignalUseCase,
+		getCannedResponsesUseCase,
 		getChatsUseCase,
 		getChatHistoryUseCase,
+		getSatisfactionScoresUseCase,
+		markChatAsReadUseCase,
+		reactToMessageUseCase,
 		resolveProblemUseCase,
+		searchMessagesUseCase,
+		sendCannedResponseUseCase,
 		sendMessageUseCase,
+		setProblemCategoryUseCase,
+		updateCannedResponseUseCase,
+		uploadAttachmentUseCase,
+		problemTaxonomy,
+		teamLeadResource,
+		teamLeadRole,
 	))
 	if err != nil {
 		return nil, fmt.Errorf("create v1 handlers: %v", err)
@@ -128,8 +246,16 @@ func initServerManager(
 		requiredResource,
 		requiredRole,
 		secWsProtocol,
-		servermanager.NewHandlersRegistrar(v1Swagger, v1Handlers, wsHandler.Serve, httpErrorHandler.Handle),
+		servermanager.NewHandlersRegistrar(
+			v1Swagger,
+			v1Handlers,
+			wsHandler.Serve,
+			attachmentsSvc.Download,
+			httpErrorHandler.Handle,
+		),
 		shutdownFn,
+		server.WithPublicPaths([]string{attachments.DownloadPath}),
+		server.WithBodyLimits(uploadBodyLimits(maxUploadSize)),
 	))
 	if err != nil {
 		return nil, fmt.Errorf("build server: %v", err)
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"go.uber.org/zap"
+
+	keycloakclient "github.com/zestagio/chat-service/internal/clients/keycloak"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	moderationrepo "github.com/zestagio/chat-service/internal/repositories/moderation"
+	"github.com/zestagio/chat-service/internal/server"
+	servermoderator "github.com/zestagio/chat-service/internal/server-moderator"
+	moderatorerrhandler "github.com/zestagio/chat-service/internal/server-moderator/errhandler"
+	moderatorv1 "github.com/zestagio/chat-service/internal/server-moderator/v1"
+	"github.com/zestagio/chat-service/internal/server/errhandler"
+	"github.com/zestagio/chat-service/internal/services/attachments"
+	"github.com/zestagio/chat-service/internal/services/outbox"
+	"github.com/zestagio/chat-service/internal/store"
+	approvemessage "github.com/zestagio/chat-service/internal/usecases/moderator/approve-message"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/moderator/delete-message"
+	getqueue "github.com/zestagio/chat-service/internal/usecases/moderator/get-queue"
+	rejectmessage "github.com/zestagio/chat-service/internal/usecases/moderator/reject-message"
+)
+
+const nameServerModerator = "server-moderator"
+
+//nolint:revive // ignore argument-limit rule to keep server moderator init in single place
+func initServerModerator(
+	productionMode bool,
+	addr string,
+	allowOrigins []string,
+	v1Swagger *openapi3.T,
+
+	keycloak *keycloakclient.Client,
+	requiredResource string,
+	requiredRole string,
+	secWsProtocol string,
+
+	attachmentsSvc *attachments.Service,
+	outBox *outbox.Service,
+
+	db *store.Database,
+	moderationRepo *moderationrepo.Repo,
+	msgRepo *messagesrepo.Repo,
+) (*server.Server, error) {
+	approveMessageUseCase, err := approvemessage.New(approvemessage.NewOptions(msgRepo, moderationRepo, outBox, db))
+	if err != nil {
+		return nil, fmt.Errorf("create approvemessage usecase: %v", err)
+	}
+
+	deleteMessageUseCase, err := deletemessage.New(deletemessage.NewOptions(msgRepo, outBox, db))
+	if err != nil {
+		return nil, fmt.Errorf("create deletemessage usecase: %v", err)
+	}
+
+	getQueueUseCase, err := getqueue.New(getqueue.NewOptions(attachmentsSvc, msgRepo, moderationRepo))
+	if err != nil {
+		return nil, fmt.Errorf("create getqueue usecase: %v", err)
+	}
+
+	rejectMessageUseCase, err := rejectmessage.New(rejectmessage.NewOptions(msgRepo, moderationRepo, outBox, db))
+	if err != nil {
+		return nil, fmt.Errorf("create rejectmessage usecase: %v", err)
+	}
+
+	v1Handlers, err := moderatorv1.NewHandlers(moderatorv1.NewOptions(
+		approveMessageUseCase,
+		deleteMessageUseCase,
+		getQueueUseCase,
+		rejectMessageUseCase,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create v1 handlers: %v", err)
+	}
+
+	lg := zap.L().Named(nameServerModerator)
+
+	httpErrorHandler, err := errhandler.New(errhandler.NewOptions(lg, productionMode, moderatorerrhandler.ResponseBuilder))
+	if err != nil {
+		return nil, fmt.Errorf("create http error handler: %v", err)
+	}
+
+	srv, err := server.New(server.NewOptions(
+		lg,
+		addr,
+		allowOrigins,
+		keycloak,
+		requiredResource,
+		requiredRole,
+		secWsProtocol,
+		servermoderator.NewHandlersRegistrar(
+			v1Swagger,
+			v1Handlers,
+			attachmentsSvc.Download,
+			httpErrorHandler.Handle,
+		),
+		func() {}, // No long-living connections.
+		server.WithPublicPaths([]string{attachments.DownloadPath}),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("build server: %v", err)
+	}
+
+	return srv, nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/zestagio/chat-service/internal/config"
+	verdicttimeoutjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/verdict-timeout"
+)
+
+const (
+	contentFilterModePrimary  = "primary"
+	contentFilterModeShadow   = "shadow"
+	contentFilterModeFallback = "fallback"
+)
+
+var errFallbackWithoutTimeout = errors.New("content filter fallback mode requires verdict timeout")
+
+type verdictTimeoutSettings struct {
+	enabled bool
+	timeout time.Duration
+	policy  verdicttimeoutjob.Policy
+}
+
+// newVerdictTimeoutSettings defines when and how the messages without the AFC verdict are handled:
+//   - primary content filter checks the message right after sending, AFC is not waited for;
+//   - fallback content filter checks the message after the verdict timeout;
+//   - otherwise the configured timeout policy is applied.
+func newVerdictTimeoutSettings(
+	timeoutCfg config.VerdictTimeoutConfig,
+	contentFilterMode string,
+) (verdictTimeoutSettings, error) {
+	s := verdictTimeoutSettings{
+		enabled: timeoutCfg.Timeout > 0,
+		timeout: timeoutCfg.Timeout,
+		policy:  verdicttimeoutjob.Policy(timeoutCfg.Policy),
+	}
+
+	switch contentFilterMode {
+	case contentFilterModePrimary:
+		s.enabled = true
+		s.timeout = 0
+		s.policy = verdicttimeoutjob.PolicyPreFilter
+
+	case contentFilterModeFallback:
+		if !s.enabled {
+			return verdictTimeoutSettings{}, errFallbackWithoutTimeout
+		}
+		s.policy = verdicttimeoutjob.PolicyPreFilter
+	}
+
+	return s, nil
+}
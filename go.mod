@@ -3,6 +3,7 @@ module github.com/zestagio/chat-service
 go 1.22.0
 
 require (
+	ariga.io/atlas v0.19.1-0.20240203083654-5948b60a8e43
 	entgo.io/ent v0.13.1
 	github.com/BurntSushi/toml v1.4.0
 	github.com/TheZeroSlave/zapsentry v1.22.1
@@ -36,7 +37,6 @@ require (
 )
 
 require (
-	ariga.io/atlas v0.19.1-0.20240203083654-5948b60a8e43 // indirect
 	github.com/agext/levenshtein v1.2.1 // indirect
 	github.com/apapsch/go-jsonmerge/v2 v2.0.0 // indirect
 	github.com/apparentlymart/go-textseg/v13 v13.0.0 // indirect
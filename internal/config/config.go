@@ -29,9 +29,10 @@ type SentryConfig struct {
 }
 
 type ServersConfig struct {
-	Debug   DebugServerConfig `toml:"debug"`
-	Client  APIServerConfig   `toml:"client"`
-	Manager APIServerConfig   `toml:"manager"`
+	Debug     DebugServerConfig `toml:"debug"`
+	Client    APIServerConfig   `toml:"client"`
+	Manager   APIServerConfig   `toml:"manager"`
+	Moderator APIServerConfig   `toml:"moderator"`
 }
 
 type DebugServerConfig struct {
@@ -76,20 +77,76 @@ type KeycloakConfig struct {
 
 type ServicesConfig struct {
 	AFCVerdictsProcessor AFCVerdictsProcessorConfig `toml:"afc_verdicts_processor"`
+	Attachments          AttachmentsConfig          `toml:"attachments"`
+	CannedResponses      CannedResponsesConfig      `toml:"canned_responses"`
+	ContentFilter        ContentFilterConfig        `toml:"content_filter"`
+	Cursors              CursorsConfig              `toml:"cursors"`
+	LifecycleProducer    LifecycleProducerConfig    `toml:"lifecycle_producer"`
 	ManagerLoad          ManagerLoadConfig          `toml:"manager_load"`
 	ManagerScheduler     ManagerSchedulerConfig     `toml:"manager_scheduler"`
+	MessageDeletion      MessageDeletionConfig      `toml:"message_deletion"`
+	MessageEditing       MessageEditingConfig       `toml:"message_editing"`
 	MsgProducer          MsgProducerConfig          `toml:"msg_producer"`
 	Outbox               OutboxConfig               `toml:"outbox"`
+	ProblemReopening     ProblemReopeningConfig     `toml:"problem_reopening"`
+	ProblemTaxonomy      ProblemTaxonomyConfig      `toml:"problem_taxonomy"`
+	SatisfactionSurvey   SatisfactionSurveyConfig   `toml:"satisfaction_survey"`
 }
 
 type AFCVerdictsProcessorConfig struct {
-	Brokers                  []string `toml:"brokers" validate:"min=1"`
-	Consumers                int      `toml:"consumers" validate:"min=1,max=32"`
-	ConsumerGroup            string   `toml:"consumer_group" validate:"required"`
-	BatchSize                int      `toml:"batch_size" validate:"min=1,max=1000"`
-	VerdictsTopic            string   `toml:"verdicts_topic" validate:"required"`
-	VerdictsDLQTopic         string   `toml:"verdicts_dlq_topic" validate:"required"`
-	VerdictsSigningPublicKey string   `toml:"verdicts_signing_public_key"`
+	Brokers                  []string             `toml:"brokers" validate:"min=1"`
+	Consumers                int                  `toml:"consumers" validate:"min=1,max=32"`
+	ConsumerGroup            string               `toml:"consumer_group" validate:"required"`
+	BatchSize                int                  `toml:"batch_size" validate:"min=1,max=1000"`
+	VerdictsTopic            string               `toml:"verdicts_topic" validate:"required"`
+	VerdictsDLQTopic         string               `toml:"verdicts_dlq_topic" validate:"required"`
+	VerdictsSigningPublicKey string               `toml:"verdicts_signing_public_key"`
+	VerdictsSigningJWKS      JWKSConfig           `toml:"verdicts_signing_jwks"`
+	VerdictTimeout           VerdictTimeoutConfig `toml:"verdict_timeout"`
+}
+
+type VerdictTimeoutConfig struct {
+	Timeout time.Duration `toml:"timeout" validate:"min=0,max=24h"`                         // Zero disables the policy.
+	Policy  string        `toml:"policy" validate:"omitempty,oneof=release block moderate"` // "moderate" by default.
+}
+
+type AttachmentsConfig struct {
+	MaxFileSize         int64         `toml:"max_file_size" validate:"min=1,max=104857600"` // In bytes, up to 100 MiB.
+	AllowedContentTypes []string      `toml:"allowed_content_types" validate:"min=1,dive,required"`
+	ThumbnailSize       int           `toml:"thumbnail_size" validate:"omitempty,min=16,max=2048"`
+	URLSecret           string        `toml:"url_secret" validate:"min=16"`
+	URLTTL              time.Duration `toml:"url_ttl" validate:"omitempty,min=1m,max=168h"`
+	PublicURL           string        `toml:"public_url" validate:"required,url"` // AFC downloads the files by the absolute URLs.
+
+	Storage     string        `toml:"storage" validate:"required,oneof=local s3"`
+	LocalDir    string        `toml:"local_dir" validate:"required_if=Storage local"`
+	S3Endpoint  string        `toml:"s3_endpoint" validate:"required_if=Storage s3,omitempty,url"`
+	S3Region    string        `toml:"s3_region" validate:"required_if=Storage s3"`
+	S3Bucket    string        `toml:"s3_bucket" validate:"required_if=Storage s3"`
+	S3AccessKey string        `toml:"s3_access_key" validate:"required_if=Storage s3"`
+	S3SecretKey string        `toml:"s3_secret_key" validate:"required_if=Storage s3"`
+	S3Timeout   time.Duration `toml:"s3_timeout" validate:"omitempty,min=100ms,max=5m"`
+}
+
+type CannedResponsesConfig struct {
+	// EditorAccess is required to manage the responses shared with the whole team.
+	EditorAccess RequiredAccessConfig `toml:"editor_access"`
+}
+
+type CursorsConfig struct {
+	// Secret signs the pagination cursors, so the clients cannot tamper with them.
+	Secret string `toml:"secret" validate:"min=16"`
+}
+
+type JWKSConfig struct {
+	Source        string        `toml:"source"`
+	RefreshPeriod time.Duration `toml:"refresh_period" validate:"omitempty,min=1s,max=24h"`
+}
+
+type ContentFilterConfig struct {
+	Mode         string        `toml:"mode" validate:"omitempty,oneof=primary shadow fallback"` // Empty disables the filter.
+	RulesFile    string        `toml:"rules_file" validate:"required_with=Mode"`
+	ReloadPeriod time.Duration `toml:"reload_period" validate:"omitempty,min=1s,max=1h"`
 }
 
 type ManagerLoadConfig struct {
@@ -100,15 +157,52 @@ type ManagerSchedulerConfig struct {
 	Period time.Duration `toml:"period" validate:"min=1s,max=1m"`
 }
 
+type MessageDeletionConfig struct {
+	Window time.Duration `toml:"window" validate:"min=1s,max=24h"` // Time since the message sending to delete it by client.
+}
+
+type MessageEditingConfig struct {
+	Window time.Duration `toml:"window" validate:"min=1s,max=24h"` // Time since the message sending to edit it.
+}
+
 type MsgProducerConfig struct {
-	Brokers    []string `toml:"brokers" validate:"min=1"`
-	Topic      string   `toml:"topic" validate:"required"`
-	BatchSize  int      `toml:"batch_size" validate:"min=1,max=1000"`
-	EncryptKey string   `toml:"encrypt_key" validate:"omitempty,hexadecimal"`
+	ProducerSinkConfig
+	EncryptKey         string            `toml:"encrypt_key" validate:"omitempty,hexadecimal,excluded_with=EncryptKeys"`
+	EncryptKeys        map[string]string `toml:"encrypt_keys" validate:"omitempty,dive,keys,required,endkeys,hexadecimal"`
+	ActiveEncryptKeyID string            `toml:"active_encrypt_key_id" validate:"required_with=EncryptKeys"`
+}
+
+type LifecycleProducerConfig struct {
+	ProducerSinkConfig
+}
+
+type ProducerSinkConfig struct {
+	Sink           string        `toml:"sink" validate:"required,oneof=kafka file http"` // "kafka" by default for msg-producer.
+	Brokers        []string      `toml:"brokers" validate:"required_if=Sink kafka"`
+	Topic          string        `toml:"topic" validate:"required_if=Sink kafka"`
+	BatchSize      int           `toml:"batch_size" validate:"required_if=Sink kafka,omitempty,min=1,max=1000"`
+	FilePath       string        `toml:"file_path" validate:"required_if=Sink file"`
+	WebhookURL     string        `toml:"webhook_url" validate:"required_if=Sink http,omitempty,url"`
+	WebhookTimeout time.Duration `toml:"webhook_timeout" validate:"omitempty,min=100ms,max=1m"`
+}
+
+type ProblemReopeningConfig struct {
+	Window time.Duration `toml:"window" validate:"min=0,max=24h"` // Time since the resolution to reopen it, 0 to disable.
+}
+
+type ProblemTaxonomyConfig struct {
+	Categories      []string `toml:"categories" validate:"min=1,unique,dive,required"`       // The contact reasons.
+	ResolutionCodes []string `toml:"resolution_codes" validate:"min=1,unique,dive,required"` // Required to resolve the problem.
+}
+
+type SatisfactionSurveyConfig struct {
+	RatingWindow time.Duration `toml:"rating_window" validate:"min=1m"` // Time since the problem resolution to rate it.
 }
 
 type OutboxConfig struct {
 	Workers    int           `toml:"workers" validate:"min=1,max=32"`
 	IdleTime   time.Duration `toml:"idle_time" validate:"min=1s,max=10s"`
 	ReserveFor time.Duration `toml:"reserve_for" validate:"min=3s,max=10m"`
+
+	ShutdownGracePeriod time.Duration `toml:"shutdown_grace_period" validate:"min=0,max=1m"` // The service default if zero.
 }
@@ -14,8 +14,27 @@ func ParseAndValidate(filename string) (Config, error) {
 		return Config{}, fmt.Errorf("decode file: %v", err)
 	}
 
+	setDefaults(&cfg)
+
 	if err := validator.Validator.Struct(cfg); err != nil {
 		return Config{}, fmt.Errorf("validate: %v", err)
 	}
 	return cfg, nil
 }
+
+const (
+	defaultVerdictTimeoutPolicy = "moderate"
+	defaultProducerSink         = "kafka"
+)
+
+// setDefaults fills the settings the config may omit.
+// The other new sections are required, see the config upgrade notes in README.
+func setDefaults(cfg *Config) {
+	if vt := &cfg.Services.AFCVerdictsProcessor.VerdictTimeout; vt.Policy == "" {
+		vt.Policy = defaultVerdictTimeoutPolicy
+	}
+
+	if mp := &cfg.Services.MsgProducer; mp.Sink == "" {
+		mp.Sink = defaultProducerSink
+	}
+}
@@ -1,8 +1,10 @@
 package config_test
 
 import (
+	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,3 +25,22 @@ func TestParseAndValidate(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, cfg.Log.Level)
 }
+
+func TestParseAndValidate_Defaults(t *testing.T) {
+	example, err := os.ReadFile(configExamplePath)
+	require.NoError(t, err)
+
+	// The config written before the verdict timeout policy and the msg-producer sinks appeared.
+	old := strings.Replace(string(example), "\npolicy = \"moderate\"", "\n", 1)
+	old = strings.Replace(old, "[services.msg_producer]\nsink = \"kafka\"", "[services.msg_producer]\n", 1)
+	require.NotContains(t, old, "policy = ")
+	require.NotContains(t, old, "[services.msg_producer]\nsink")
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte(old), 0o600))
+
+	cfg, err := config.ParseAndValidate(path)
+	require.NoError(t, err)
+	assert.Equal(t, "moderate", cfg.Services.AFCVerdictsProcessor.VerdictTimeout.Policy)
+	assert.Equal(t, "kafka", cfg.Services.MsgProducer.Sink)
+}
@@ -0,0 +1,61 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidSignature = errors.New("invalid cursor signature")
+
+//go:generate options-gen -out-filename=codec_options.gen.go -from-struct=Options
+type Options struct {
+	secret string `option:"mandatory" validate:"min=16"`
+}
+
+// Codec encodes the cursors into the opaque tokens signed with HMAC-SHA256,
+// so the clients can neither forge the cursors nor tamper with their page size.
+type Codec struct {
+	Options
+}
+
+func NewCodec(opts Options) (*Codec, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+	return &Codec{Options: opts}, nil
+}
+
+// Encode returns base64url(signature || json(data)).
+func (c *Codec) Encode(data any) (string, error) {
+	marshalled, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(append(c.sign(marshalled), marshalled...)), nil
+}
+
+// Decode verifies the token signature and unmarshals the cursor into `to`.
+func (c *Codec) Decode(in string, to any) error {
+	decoded, err := encoding.DecodeString(in)
+	if err != nil {
+		return err
+	}
+	if len(decoded) < sha256.Size {
+		return ErrInvalidSignature
+	}
+
+	signature, marshalled := decoded[:sha256.Size], decoded[sha256.Size:]
+	if !hmac.Equal(signature, c.sign(marshalled)) {
+		return ErrInvalidSignature
+	}
+	return json.Unmarshal(marshalled, to)
+}
+
+func (c *Codec) sign(data []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(c.secret))
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,40 @@
+// Code generated by options-gen. DO NOT EDIT.
+package cursor
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	secret string,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.secret = secret
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("secret", _validate_Options_secret(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_secret(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.secret, "min=16"); err != nil {
+		return fmt461e464ebed9.Errorf("field `secret` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package cursor_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zestagio/chat-service/internal/cursor"
+)
+
+const codecSecret = "0123456789abcdef"
+
+func TestNewCodec(t *testing.T) {
+	_, err := cursor.NewCodec(cursor.NewOptions("short"))
+	require.Error(t, err)
+
+	_, err = cursor.NewCodec(cursor.NewOptions(codecSecret))
+	require.NoError(t, err)
+}
+
+func TestCodec_EncodeDecode(t *testing.T) {
+	codec, err := cursor.NewCodec(cursor.NewOptions(codecSecret))
+	require.NoError(t, err)
+
+	c1 := Cursor{
+		LastCreatedAt: time.Unix(42, 42).UTC(),
+		PageSize:      10,
+	}
+	c, err := codec.Encode(c1)
+	require.NoError(t, err)
+
+	var c2 Cursor
+	require.NoError(t, codec.Decode(c, &c2))
+	assert.Equal(t, c1, c2)
+}
+
+func TestCodec_Decode_Errors(t *testing.T) {
+	codec, err := cursor.NewCodec(cursor.NewOptions(codecSecret))
+	require.NoError(t, err)
+
+	signed, err := codec.Encode(Cursor{LastCreatedAt: time.Unix(42, 42).UTC(), PageSize: 10})
+	require.NoError(t, err)
+
+	t.Run("invalid base64", func(t *testing.T) {
+		err := codec.Decode(`{"page_size":50,"last":1670502502}`, new(Cursor))
+		assert.Error(t, err)
+	})
+
+	t.Run("unsigned cursor", func(t *testing.T) {
+		unsigned, err := cursor.Encode(Cursor{LastCreatedAt: time.Unix(42, 42).UTC(), PageSize: 100})
+		require.NoError(t, err)
+
+		err = codec.Decode(unsigned, new(Cursor))
+		assert.ErrorIs(t, err, cursor.ErrInvalidSignature)
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		err := codec.Decode(base64.URLEncoding.EncodeToString([]byte("{}")), new(Cursor))
+		assert.ErrorIs(t, err, cursor.ErrInvalidSignature)
+	})
+
+	t.Run("tampered page size", func(t *testing.T) {
+		decoded, err := base64.URLEncoding.DecodeString(signed)
+		require.NoError(t, err)
+		tampered := []byte(string(decoded[:len(decoded)-3]) + "99}")
+
+		err = codec.Decode(base64.URLEncoding.EncodeToString(tampered), new(Cursor))
+		assert.ErrorIs(t, err, cursor.ErrInvalidSignature)
+	})
+
+	t.Run("another secret", func(t *testing.T) {
+		another, err := cursor.NewCodec(cursor.NewOptions("fedcba9876543210"))
+		require.NoError(t, err)
+
+		err = another.Decode(signed, new(Cursor))
+		assert.ErrorIs(t, err, cursor.ErrInvalidSignature)
+	})
+}
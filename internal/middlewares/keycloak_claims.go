@@ -19,6 +19,8 @@ type claims struct {
 	Audience        keycloakclient.StringOrSlice `json:"aud,omitempty"`
 	Subject         types.UserID                 `json:"sub,omitempty"`
 	ResourcesAccess resourceAccess               `json:"resource_access"`
+	Name            string                       `json:"name,omitempty"`
+	GivenName       string                       `json:"given_name,omitempty"`
 }
 
 // Valid returns errors:
@@ -45,6 +47,18 @@ func (c claims) UserID() types.UserID {
 	return c.Subject
 }
 
+// UserName returns the given name or the full name if the first one is empty.
+func (c claims) UserName() string {
+	if c.GivenName != "" {
+		return c.GivenName
+	}
+	return c.Name
+}
+
+func (c claims) HasResourceRole(resource, role string) bool {
+	return c.ResourcesAccess.HasResourceRole(resource, role)
+}
+
 type resourceAccess map[string]struct {
 	Roles []string `json:"roles"`
 }
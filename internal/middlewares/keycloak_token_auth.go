@@ -81,19 +81,42 @@ func MustUserID(eCtx echo.Context) types.UserID {
 }
 
 func userID(eCtx echo.Context) (types.UserID, bool) {
-	t := eCtx.Get(tokenCtxKey)
-	if t == nil {
+	userIDProvider, ok := tokenClaims(eCtx).(interface{ UserID() types.UserID })
+	if !ok {
 		return types.UserIDNil, false
 	}
+	return userIDProvider.UserID(), true
+}
 
-	tt, ok := t.(*jwt.Token)
+// UserName returns the name from the user token. It is empty if the token has no names.
+func UserName(eCtx echo.Context) string {
+	userNameProvider, ok := tokenClaims(eCtx).(interface{ UserName() string })
 	if !ok {
-		return types.UserIDNil, false
+		return ""
 	}
+	return userNameProvider.UserName()
+}
 
-	userIDProvider, ok := tt.Claims.(interface{ UserID() types.UserID })
+// HasResourceRole reports whether the user token grants the role of the resource.
+func HasResourceRole(eCtx echo.Context, resource, role string) bool {
+	rolesProvider, ok := tokenClaims(eCtx).(interface {
+		HasResourceRole(resource, role string) bool
+	})
 	if !ok {
-		return types.UserIDNil, false
+		return false
 	}
-	return userIDProvider.UserID(), true
+	return rolesProvider.HasResourceRole(resource, role)
+}
+
+func tokenClaims(eCtx echo.Context) jwt.Claims {
+	t := eCtx.Get(tokenCtxKey)
+	if t == nil {
+		return nil
+	}
+
+	tt, ok := t.(*jwt.Token)
+	if !ok {
+		return nil
+	}
+	return tt.Claims
 }
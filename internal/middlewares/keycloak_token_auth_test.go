@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt"
 	"github.com/golang/mock/gomock"
@@ -92,6 +93,31 @@ func (s *KeycloakTokenAuthSuite) TestValidToken_AudList() {
 	s.Equal("5cb40dc0-a249-4783-a301-9e1f3cf3ea41", uid.String())
 }
 
+func (s *KeycloakTokenAuthSuite) TestValidToken_UserNameAndRoles() {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp":        time.Now().Add(time.Hour).Unix(),
+		"iat":        time.Now().Unix(),
+		"sub":        "5cb40dc0-a249-4783-a301-9e1f3cf3ea41",
+		"name":       "James Bond",
+		"given_name": "James",
+		"resource_access": map[string]any{
+			requiredResource: map[string]any{"roles": []string{requiredRole, "support-chat-team-lead"}},
+		},
+	}).SignedString([]byte("secret"))
+	s.Require().NoError(err)
+	s.req.Header.Add(echo.HeaderAuthorization, bearerPrefix+token)
+
+	s.introspector.EXPECT().IntrospectToken(s.req.Context(), token).Return(&keycloakclient.IntrospectTokenResult{Active: true}, nil)
+
+	err = s.authMdlwr(func(c echo.Context) error {
+		s.Equal("James", middlewares.UserName(c))
+		s.True(middlewares.HasResourceRole(c, requiredResource, "support-chat-team-lead"))
+		s.False(middlewares.HasResourceRole(c, "chat-ui-manager", "support-chat-team-lead"))
+		return nil
+	})(s.ctx)
+	s.Require().NoError(err)
+}
+
 // Negative.
 
 func (s *KeycloakTokenAuthSuite) TestNoAuthorizationHeader() {
@@ -293,6 +319,15 @@ func (s *KeycloakTokenAuthSuite) assertHTTPCode(err error, code int) {
 	s.Equal(httpErr.Code, code)
 }
 
+func TestUserName_NoToken(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, err)
+
+	eCtx := echo.New().NewContext(req, httptest.NewRecorder())
+	assert.Empty(t, middlewares.UserName(eCtx))
+	assert.False(t, middlewares.HasResourceRole(eCtx, requiredResource, requiredRole))
+}
+
 func TestMustUserID_NoUID(t *testing.T) {
 	req, err := http.NewRequest(http.MethodGet, "/", nil)
 	require.NoError(t, err)
@@ -20,8 +20,16 @@ func SetToken(c echo.Context, uid types.UserID) {
 	c.Set(tokenCtxKey, &jwt.Token{Claims: claimsMock{uid: uid}, Valid: true})
 }
 
+// SetTokenWithAccess sets the token of the named user granting the role of the resource.
+func SetTokenWithAccess(c echo.Context, uid types.UserID, name, resource, role string) {
+	c.Set(tokenCtxKey, &jwt.Token{Claims: claimsMock{uid: uid, name: name, resource: resource, role: role}, Valid: true})
+}
+
 type claimsMock struct {
-	uid types.UserID
+	uid      types.UserID
+	name     string
+	resource string
+	role     string
 }
 
 func (m claimsMock) Valid() error {
@@ -31,3 +39,11 @@ func (m claimsMock) Valid() error {
 func (m claimsMock) UserID() types.UserID {
 	return m.uid
 }
+
+func (m claimsMock) UserName() string {
+	return m.name
+}
+
+func (m claimsMock) HasResourceRole(resource, role string) bool {
+	return m.resource == resource && m.role == role
+}
@@ -0,0 +1,126 @@
+package cannedresponsesrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/store/cannedresponse"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+var (
+	ErrCannedResponseNotFound = errors.New("canned response not found")
+	ErrShortcutTaken          = errors.New("shortcut is already taken")
+)
+
+// Create adds the personal or the shared canned response of the manager.
+func (r *Repo) Create(
+	ctx context.Context,
+	authorID types.UserID,
+	isShared bool,
+	shortcut string,
+	body string,
+) (*CannedResponse, error) {
+	cr, err := r.db.CannedResponse(ctx).Create().
+		SetAuthorID(authorID).
+		SetIsShared(isShared).
+		SetShortcut(shortcut).
+		SetBody(body).
+		Save(ctx)
+	if err != nil {
+		if store.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrShortcutTaken, err)
+		}
+		return nil, fmt.Errorf("create canned response: %v", err)
+	}
+
+	result := adaptStoreCannedResponse(cr)
+	return &result, nil
+}
+
+// Update replaces the shortcut and the body of the canned response.
+func (r *Repo) Update(
+	ctx context.Context,
+	id types.CannedResponseID,
+	shortcut string,
+	body string,
+) (*CannedResponse, error) {
+	cr, err := r.db.CannedResponse(ctx).UpdateOneID(id).
+		SetShortcut(shortcut).
+		SetBody(body).
+		Save(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, ErrCannedResponseNotFound
+		}
+		if store.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrShortcutTaken, err)
+		}
+		return nil, fmt.Errorf("update canned response: %v", err)
+	}
+
+	result := adaptStoreCannedResponse(cr)
+	return &result, nil
+}
+
+func (r *Repo) Delete(ctx context.Context, id types.CannedResponseID) error {
+	err := r.db.CannedResponse(ctx).DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return ErrCannedResponseNotFound
+		}
+		return fmt.Errorf("delete canned response: %v", err)
+	}
+	return nil
+}
+
+func (r *Repo) GetByID(ctx context.Context, id types.CannedResponseID) (*CannedResponse, error) {
+	cr, err := r.db.CannedResponse(ctx).Get(ctx, id)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, ErrCannedResponseNotFound
+		}
+		return nil, fmt.Errorf("get canned response: %v", err)
+	}
+
+	result := adaptStoreCannedResponse(cr)
+	return &result, nil
+}
+
+// Search returns the personal canned responses of the manager and the shared ones
+// which shortcuts start with the prefix. The empty prefix matches everything.
+// The responses are ordered by shortcut, the personal response goes before the shared one with the same shortcut.
+func (r *Repo) Search(
+	ctx context.Context,
+	managerID types.UserID,
+	shortcutPrefix string,
+	limit int,
+) ([]CannedResponse, error) {
+	q := r.db.CannedResponse(ctx).Query().
+		Where(cannedresponse.Or(
+			cannedresponse.AuthorID(managerID),
+			cannedresponse.IsShared(true),
+		))
+	if shortcutPrefix != "" {
+		q.Where(cannedresponse.ShortcutHasPrefix(shortcutPrefix))
+	}
+
+	responses, err := q.
+		Order(
+			store.Asc(cannedresponse.FieldShortcut),
+			store.Asc(cannedresponse.FieldIsShared),
+		).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("select canned responses: %v", err)
+	}
+
+	result := make([]CannedResponse, 0, len(responses))
+	for _, cr := range responses {
+		result = append(result, adaptStoreCannedResponse(cr))
+	}
+	return result, nil
+}
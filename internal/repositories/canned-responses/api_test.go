@@ -0,0 +1,166 @@
+//go:build integration
+
+package cannedresponsesrepo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type CannedResponsesRepoSuite struct {
+	testingh.DBSuite
+	repo *cannedresponsesrepo.Repo
+}
+
+func TestCannedResponsesRepoSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &CannedResponsesRepoSuite{DBSuite: testingh.NewDBSuite("TestCannedResponsesRepoSuite")})
+}
+
+func (s *CannedResponsesRepoSuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = cannedresponsesrepo.New(cannedresponsesrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *CannedResponsesRepoSuite) SetupTest() {
+	s.DBSuite.SetupTest()
+	s.Database.CannedResponse(s.Ctx).Delete().ExecX(s.Ctx)
+}
+
+func (s *CannedResponsesRepoSuite) TestCreate() {
+	managerID := types.NewUserID()
+
+	s.Run("personal response created", func() {
+		cr, err := s.repo.Create(s.Ctx, managerID, false, "hello", "Hello, {{client_name}}!")
+		s.Require().NoError(err)
+		s.NotEmpty(cr.ID)
+		s.Equal(managerID, cr.AuthorID)
+		s.False(cr.IsShared)
+		s.Equal("hello", cr.Shortcut)
+		s.Equal("Hello, {{client_name}}!", cr.Body)
+		s.NotEmpty(cr.CreatedAt)
+	})
+
+	s.Run("personal shortcut is taken", func() {
+		_, err := s.repo.Create(s.Ctx, managerID, false, "hello", "Hi!")
+		s.Require().ErrorIs(err, cannedresponsesrepo.ErrShortcutTaken)
+	})
+
+	s.Run("the same shortcut of another manager", func() {
+		_, err := s.repo.Create(s.Ctx, types.NewUserID(), false, "hello", "Hi!")
+		s.Require().NoError(err)
+	})
+
+	s.Run("the same shortcut of shared response", func() {
+		_, err := s.repo.Create(s.Ctx, managerID, true, "hello", "Good day!")
+		s.Require().NoError(err)
+	})
+
+	s.Run("shared shortcut is taken", func() {
+		_, err := s.repo.Create(s.Ctx, types.NewUserID(), true, "hello", "Good day!")
+		s.Require().ErrorIs(err, cannedresponsesrepo.ErrShortcutTaken)
+	})
+}
+
+func (s *CannedResponsesRepoSuite) TestUpdate() {
+	managerID := types.NewUserID()
+
+	cr, err := s.repo.Create(s.Ctx, managerID, false, "hello", "Hello!")
+	s.Require().NoError(err)
+	_, err = s.repo.Create(s.Ctx, managerID, false, "bye", "Bye!")
+	s.Require().NoError(err)
+
+	s.Run("updated", func() {
+		updated, err := s.repo.Update(s.Ctx, cr.ID, "hi", "Hi!")
+		s.Require().NoError(err)
+		s.Equal("hi", updated.Shortcut)
+		s.Equal("Hi!", updated.Body)
+		s.False(updated.UpdatedAt.Before(cr.UpdatedAt))
+
+		got, err := s.repo.GetByID(s.Ctx, cr.ID)
+		s.Require().NoError(err)
+		s.Equal("Hi!", got.Body)
+	})
+
+	s.Run("shortcut is taken", func() {
+		_, err := s.repo.Update(s.Ctx, cr.ID, "bye", "Hi!")
+		s.Require().ErrorIs(err, cannedresponsesrepo.ErrShortcutTaken)
+	})
+
+	s.Run("not found", func() {
+		_, err := s.repo.Update(s.Ctx, types.NewCannedResponseID(), "hi", "Hi!")
+		s.Require().ErrorIs(err, cannedresponsesrepo.ErrCannedResponseNotFound)
+	})
+}
+
+func (s *CannedResponsesRepoSuite) TestDelete() {
+	cr, err := s.repo.Create(s.Ctx, types.NewUserID(), false, "hello", "Hello!")
+	s.Require().NoError(err)
+
+	err = s.repo.Delete(s.Ctx, cr.ID)
+	s.Require().NoError(err)
+
+	_, err = s.repo.GetByID(s.Ctx, cr.ID)
+	s.Require().ErrorIs(err, cannedresponsesrepo.ErrCannedResponseNotFound)
+
+	err = s.repo.Delete(s.Ctx, cr.ID)
+	s.Require().ErrorIs(err, cannedresponsesrepo.ErrCannedResponseNotFound)
+}
+
+func (s *CannedResponsesRepoSuite) TestSearch() {
+	managerID := types.NewUserID()
+	anotherManagerID := types.NewUserID()
+
+	for _, cr := range []struct {
+		authorID types.UserID
+		isShared bool
+		shortcut string
+	}{
+		{authorID: managerID, shortcut: "hello"},
+		{authorID: managerID, shortcut: "bye"},
+		{authorID: anotherManagerID, shortcut: "help"},
+		{authorID: anotherManagerID, shortcut: "hello", isShared: true},
+		{authorID: anotherManagerID, shortcut: "refund", isShared: true},
+	} {
+		_, err := s.repo.Create(s.Ctx, cr.authorID, cr.isShared, cr.shortcut, "Body")
+		s.Require().NoError(err)
+	}
+
+	s.Run("all available responses", func() {
+		responses, err := s.repo.Search(s.Ctx, managerID, "", 10)
+		s.Require().NoError(err)
+		s.Require().Len(responses, 4)
+
+		s.Equal("bye", responses[0].Shortcut)
+		s.Equal("hello", responses[1].Shortcut)
+		s.False(responses[1].IsShared)
+		s.Equal("hello", responses[2].Shortcut)
+		s.True(responses[2].IsShared)
+		s.Equal("refund", responses[3].Shortcut)
+	})
+
+	s.Run("by prefix", func() {
+		responses, err := s.repo.Search(s.Ctx, managerID, "he", 10)
+		s.Require().NoError(err)
+		s.Require().Len(responses, 2)
+		for _, cr := range responses {
+			s.Equal("hello", cr.Shortcut)
+		}
+	})
+
+	s.Run("limited", func() {
+		responses, err := s.repo.Search(s.Ctx, managerID, "", 1)
+		s.Require().NoError(err)
+		s.Require().Len(responses, 1)
+		s.Equal("bye", responses[0].Shortcut)
+	})
+}
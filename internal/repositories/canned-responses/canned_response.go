@@ -0,0 +1,30 @@
+package cannedresponsesrepo
+
+import (
+	"time"
+
+	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type CannedResponse struct {
+	ID        types.CannedResponseID
+	AuthorID  types.UserID
+	IsShared  bool
+	Shortcut  string
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func adaptStoreCannedResponse(cr *store.CannedResponse) CannedResponse {
+	return CannedResponse{
+		ID:        cr.ID,
+		AuthorID:  cr.AuthorID,
+		IsShared:  cr.IsShared,
+		Shortcut:  cr.Shortcut,
+		Body:      cr.Body,
+		CreatedAt: cr.CreatedAt,
+		UpdatedAt: cr.UpdatedAt,
+	}
+}
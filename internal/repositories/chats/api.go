@@ -5,9 +5,6 @@ import (
 	"errors"
 	"fmt"
 
-	"entgo.io/ent/dialect/sql"
-
-	"github.com/zestagio/chat-service/internal/store"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/problem"
 	"github.com/zestagio/chat-service/internal/types"
@@ -46,6 +43,34 @@ func (r *Repo) GetChatClient(ctx context.Context, chatID types.ChatID) (types.Us
 	return c.ClientID, nil
 }
 
+// SetClientName remembers the client name of the chat. The empty name is ignored.
+func (r *Repo) SetClientName(ctx context.Context, chatID types.ChatID, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	if err := r.db.Chat(ctx).Update().
+		Where(chat.ID(chatID), chat.Or(chat.ClientNameIsNil(), chat.ClientNameNEQ(name))).
+		SetClientName(name).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("update chat client name: %v", err)
+	}
+	return nil
+}
+
+// GetChatClientName returns the last known client name of the chat or empty string if it is unknown.
+func (r *Repo) GetChatClientName(ctx context.Context, chatID types.ChatID) (string, error) {
+	c, err := r.db.Chat(ctx).Query().
+		Unique(false).
+		Select(chat.FieldClientName).
+		Where(chat.ID(chatID)).
+		Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("query chat: %v", err)
+	}
+	return c.ClientName, nil
+}
+
 func (r *Repo) GetChatManager(ctx context.Context, chatID types.ChatID) (types.UserID, error) {
 	var managersIDs []types.UserID
 
@@ -67,36 +92,3 @@ func (r *Repo) GetChatManager(ctx context.Context, chatID types.ChatID) (types.U
 
 	return managersIDs[0], nil
 }
-
-type Chat struct {
-	ID       types.ChatID
-	ClientID types.UserID
-}
-
-func (r *Repo) GetChatsWithOpenProblems(ctx context.Context, managerID types.UserID) ([]Chat, error) {
-	chatsWithOpenProblems, err := r.db.Chat(ctx).Query().
-		Unique(false).
-		Select(chat.FieldID, chat.FieldClientID).
-		Where(func(s *sql.Selector) {
-			problemT := sql.Table(problem.Table)
-			s.Join(problemT).On(s.C(chat.FieldID), problemT.C(problem.FieldChatID))
-			s.Where(sql.And(
-				sql.EQ(problemT.C(problem.FieldManagerID), managerID),
-				sql.IsNull(problemT.C(problem.FieldResolvedAt))),
-			)
-		}).
-		Order(store.Asc(problem.FieldCreatedAt)).
-		All(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("query problems with chats: %v", err)
-	}
-
-	result := make([]Chat, 0, len(chatsWithOpenProblems))
-	for _, c := range chatsWithOpenProblems {
-		result = append(result, Chat{
-			ID:       c.ID,
-			ClientID: c.ClientID,
-		})
-	}
-	return result, nil
-}
@@ -0,0 +1,159 @@
+package chatsrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+var ErrChatNotFound = errors.New("chat not found")
+
+const lastMessagePreviewMaxLength = 100
+
+type Chat struct {
+	ID       types.ChatID
+	ClientID types.UserID
+
+	ProblemCreatedAt time.Time
+	UnreadCount      int             // The client messages shown to the manager after they have read the chat.
+	LastMessage      *LastMessage    // Nil if there are no messages yet.
+	WaitingSince     time.Time       // The oldest client message the manager has not answered yet. Zero if there is none.
+	Category         string          // Empty until the manager sets it.
+	ReopenedFromID   types.ProblemID // The resolved problem the open one continues. Zero if it is a new problem.
+}
+
+type LastMessage struct {
+	ID        types.MessageID
+	AuthorID  types.UserID // Zero if the message is a service one.
+	Preview   string       // The beginning of the body.
+	CreatedAt time.Time
+}
+
+// GetChatsWithOpenProblems returns the chats with the problems open and assigned to the manager,
+// from the oldest problem to the newest one.
+func (r *Repo) GetChatsWithOpenProblems(ctx context.Context, managerID types.UserID) ([]Chat, error) {
+	return r.queryChatsWithOpenProblems(ctx, managerID, nil)
+}
+
+// GetChatWithOpenProblem is the same as GetChatsWithOpenProblems, but for the specific chat.
+func (r *Repo) GetChatWithOpenProblem(ctx context.Context, managerID types.UserID, chatID types.ChatID) (*Chat, error) {
+	chats, err := r.queryChatsWithOpenProblems(ctx, managerID, &chatID)
+	if err != nil {
+		return nil, err
+	}
+	if len(chats) == 0 {
+		return nil, ErrChatNotFound
+	}
+	return &chats[0], nil
+}
+
+// queryChatsWithOpenProblems gets the chat list with the counters and the previews in one query.
+// The chat messages are taken through the (problem_id, created_at, id) index.
+// The client message is unread if it became visible (passed the AFC check) after the manager read the chat.
+func (r *Repo) queryChatsWithOpenProblems(
+	ctx context.Context,
+	managerID types.UserID,
+	chatID *types.ChatID,
+) ([]Chat, error) {
+	const sqlQuery = `
+	select
+		"c"."id",
+		"c"."client_id",
+		"p"."created_at",
+		coalesce("p"."category", ''),
+		"p"."reopened_from_id",
+		(
+			select count(*) from "messages" as "m"
+			where "m"."problem_id" = "p"."id"
+				and "m"."author_id" = "c"."client_id"
+				and "m"."is_visible_for_manager"
+				and "m"."deleted_at" is null
+				and (
+					"p"."manager_read_at" is null
+					or coalesce("m"."checked_at", "m"."created_at") > "p"."manager_read_at"
+				)
+		),
+		(
+			select min("m"."created_at") from "messages" as "m"
+			where "m"."problem_id" = "p"."id"
+				and "m"."author_id" = "c"."client_id"
+				and "m"."is_visible_for_manager"
+				and "m"."deleted_at" is null
+				and "m"."created_at" > coalesce((
+					select max("r"."created_at") from "messages" as "r"
+					where "r"."problem_id" = "p"."id"
+						and "r"."author_id" = "p"."manager_id"
+						and not "r"."is_internal_note"
+				), '-infinity')
+		),
+		"l"."id",
+		"l"."author_id",
+		left("l"."body", $3),
+		"l"."created_at"
+	from "problems" as "p"
+	join "chats" as "c" on "c"."id" = "p"."chat_id"
+	left join lateral (
+		select "m"."id", "m"."author_id", "m"."body", "m"."created_at"
+		from "messages" as "m"
+		where "m"."problem_id" = "p"."id"
+			and "m"."is_visible_for_manager"
+			and not "m"."is_internal_note"
+			and "m"."deleted_at" is null
+		order by "m"."created_at" desc, "m"."id" desc
+		limit 1
+	) as "l" on true
+	where "p"."manager_id" = $1
+		and "p"."resolved_at" is null
+		and ($2::uuid is null or "p"."chat_id" = $2)
+	order by "p"."created_at" asc;`
+
+	rows, err := r.db.Chat(ctx).QueryContext(ctx, sqlQuery, managerID, chatID, lastMessagePreviewMaxLength)
+	if err != nil {
+		return nil, fmt.Errorf("query chats with open problems: %v", err)
+	}
+	defer rows.Close()
+
+	var result []Chat
+	for rows.Next() {
+		var (
+			c            Chat
+			reopenedFrom *types.ProblemID
+			waitingSince *time.Time
+			lastID       *types.MessageID
+			lastAuthorID *types.UserID
+			lastPreview  *string
+			lastAt       *time.Time
+		)
+		if err := rows.Scan(
+			&c.ID, &c.ClientID, &c.ProblemCreatedAt, &c.Category, &reopenedFrom, &c.UnreadCount, &waitingSince,
+			&lastID, &lastAuthorID, &lastPreview, &lastAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan chat: %v", err)
+		}
+
+		if reopenedFrom != nil {
+			c.ReopenedFromID = *reopenedFrom
+		}
+		if waitingSince != nil {
+			c.WaitingSince = *waitingSince
+		}
+		if lastID != nil {
+			c.LastMessage = &LastMessage{ID: *lastID, CreatedAt: *lastAt}
+			if lastAuthorID != nil {
+				c.LastMessage.AuthorID = *lastAuthorID
+			}
+			if lastPreview != nil {
+				c.LastMessage.Preview = *lastPreview
+			}
+		}
+		result = append(result, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %v", err)
+	}
+
+	return result, nil
+}
@@ -3,6 +3,7 @@
 package chatsrepo_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -68,6 +69,35 @@ func (s *ChatsRepoSuite) Test_GetChatClient() {
 	s.Equal(expectedClientID, clientID)
 }
 
+func (s *ChatsRepoSuite) Test_SetClientName() {
+	chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	s.Run("name is unknown", func() {
+		name, err := s.repo.GetChatClientName(s.Ctx, chat.ID)
+		s.Require().NoError(err)
+		s.Empty(name)
+	})
+
+	s.Run("name is set", func() {
+		err := s.repo.SetClientName(s.Ctx, chat.ID, "Eric")
+		s.Require().NoError(err)
+
+		name, err := s.repo.GetChatClientName(s.Ctx, chat.ID)
+		s.Require().NoError(err)
+		s.Equal("Eric", name)
+	})
+
+	s.Run("empty name does not reset the known one", func() {
+		err := s.repo.SetClientName(s.Ctx, chat.ID, "")
+		s.Require().NoError(err)
+
+		name, err := s.repo.GetChatClientName(s.Ctx, chat.ID)
+		s.Require().NoError(err)
+		s.Equal("Eric", name)
+	})
+}
+
 func (s *ChatsRepoSuite) TestRepo_GetChatManager() {
 	s.Run("chat has manager", func() {
 		clientID := types.NewUserID()
@@ -194,6 +224,133 @@ func (s *ChatsRepoSuite) TestRepo_GetChatsWithOpenProblems() {
 	})
 }
 
+func (s *ChatsRepoSuite) TestRepo_GetChatsWithOpenProblems_Counters() {
+	managerID := types.NewUserID()
+	clientID := types.NewUserID()
+
+	chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(clientID).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	base := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+
+	problem, err := s.Database.Problem(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetManagerID(managerID).
+		SetManagerReadAt(at(5)).
+		SetCategory("cards").
+		SetCreatedAt(at(0)).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	type msg struct {
+		authorID       types.UserID
+		createdAt      time.Time
+		body           string
+		hidden         bool
+		deleted        bool
+		isInternalNote bool
+		checkedAt      time.Time
+	}
+	longBody := strings.Repeat("я", 150)
+	var lastMsgID types.MessageID
+	for _, m := range []msg{
+		{authorID: clientID, createdAt: at(1), body: "hello"},
+		{authorID: clientID, createdAt: at(2), body: "blocked", hidden: true},
+		{authorID: managerID, createdAt: at(3), body: "hi"},
+		{authorID: clientID, createdAt: at(4), body: "read", checkedAt: at(4)},
+		{authorID: clientID, createdAt: at(4), body: "checked after reading", checkedAt: at(6)},
+		{authorID: clientID, createdAt: at(6), body: "deleted", deleted: true},
+		{authorID: managerID, createdAt: at(7), body: "note", isInternalNote: true},
+		{authorID: clientID, createdAt: at(8), body: longBody},
+	} {
+		op := s.Database.Message(s.Ctx).Create().
+			SetChatID(chat.ID).
+			SetProblemID(problem.ID).
+			SetAuthorID(m.authorID).
+			SetBody(m.body).
+			SetIsVisibleForClient(!m.isInternalNote).
+			SetIsVisibleForManager(!m.hidden).
+			SetIsInternalNote(m.isInternalNote).
+			SetInitialRequestID(types.NewRequestID()).
+			SetCreatedAt(m.createdAt)
+		if m.deleted {
+			op.SetDeletedAt(m.createdAt)
+		}
+		if !m.checkedAt.IsZero() {
+			op.SetCheckedAt(m.checkedAt)
+		}
+		created, err := op.Save(s.Ctx)
+		s.Require().NoError(err)
+		lastMsgID = created.ID
+	}
+
+	// The chat without messages.
+	emptyChatID := s.createChatAndAssignedProblem(types.NewUserID(), managerID)
+
+	chats, err := s.repo.GetChatsWithOpenProblems(s.Ctx, managerID)
+	s.Require().NoError(err)
+	s.Require().Len(chats, 2)
+
+	c := chats[0]
+	s.Equal(chat.ID, c.ID)
+	s.Equal(clientID, c.ClientID)
+	s.True(at(0).Equal(c.ProblemCreatedAt))
+	s.Equal("cards", c.Category)
+	s.Equal(2, c.UnreadCount)
+	s.True(at(4).Equal(c.WaitingSince), c.WaitingSince)
+	s.Require().NotNil(c.LastMessage)
+	s.Equal(lastMsgID, c.LastMessage.ID)
+	s.Equal(clientID, c.LastMessage.AuthorID)
+	s.Equal(strings.Repeat("я", 100), c.LastMessage.Preview)
+	s.True(at(8).Equal(c.LastMessage.CreatedAt))
+
+	empty := chats[1]
+	s.Equal(emptyChatID, empty.ID)
+	s.Empty(empty.Category)
+	s.True(empty.ReopenedFromID.IsZero())
+	s.Zero(empty.UnreadCount)
+	s.True(empty.WaitingSince.IsZero())
+	s.Nil(empty.LastMessage)
+
+	s.Run("specific chat", func() {
+		c, err := s.repo.GetChatWithOpenProblem(s.Ctx, managerID, chat.ID)
+		s.Require().NoError(err)
+		s.Equal(chats[0], *c)
+	})
+
+	s.Run("chat of other manager", func() {
+		c, err := s.repo.GetChatWithOpenProblem(s.Ctx, types.NewUserID(), chat.ID)
+		s.Require().ErrorIs(err, chatsrepo.ErrChatNotFound)
+		s.Nil(c)
+	})
+}
+
+func (s *ChatsRepoSuite) TestRepo_GetChatsWithOpenProblems_ReopenedFrom() {
+	managerID := types.NewUserID()
+
+	chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	resolved, err := s.Database.Problem(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetManagerID(types.NewUserID()).
+		SetResolvedAt(time.Now()).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	_, err = s.Database.Problem(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetManagerID(managerID).
+		SetReopenedFromID(resolved.ID).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	c, err := s.repo.GetChatWithOpenProblem(s.Ctx, managerID, chat.ID)
+	s.Require().NoError(err)
+	s.Equal(resolved.ID, c.ReopenedFromID)
+}
+
 func (s *ChatsRepoSuite) createChatAndAssignedProblem(clientID, managerID types.UserID) types.ChatID {
 	s.T().Helper()
 
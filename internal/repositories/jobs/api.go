@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/zestagio/chat-service/internal/store/job"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
@@ -79,3 +80,13 @@ func (r *Repo) CreateFailedJob(ctx context.Context, name, payload, reason string
 func (r *Repo) DeleteJob(ctx context.Context, jobID types.JobID) error {
 	return r.db.Job(ctx).DeleteOneID(jobID).Exec(ctx)
 }
+
+// ReleaseJob makes the reserved job available for the other workers immediately
+// and gives back the attempt that was counted on reservation.
+func (r *Repo) ReleaseJob(ctx context.Context, jobID types.JobID) error {
+	return r.db.Job(ctx).UpdateOneID(jobID).
+		Where(job.AttemptsGT(0)).
+		AddAttempts(-1).
+		SetReservedUntil(time.Now()).
+		Exec(ctx)
+}
@@ -250,3 +250,42 @@ func (s *JobsRepoSuite) Test_DeleteJob_NoJobs() {
 	// Assert.
 	s.Require().Error(err)
 }
+
+func (s *JobsRepoSuite) Test_ReleaseJob() {
+	// Arrange.
+	_, err := s.Database.Job(s.Ctx).Create().
+		SetName(name).
+		SetPayload(payload).
+		SetAvailableAt(availableAt).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	reserved, err := s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+	s.Require().Equal(1, reserved.Attempts)
+
+	// Action.
+	err = s.repo.ReleaseJob(s.Ctx, reserved.ID)
+
+	// Assert.
+	s.Require().NoError(err)
+
+	job, err := s.Database.Job(s.Ctx).Get(s.Ctx, reserved.ID)
+	s.Require().NoError(err)
+	s.Equal(0, job.Attempts)
+	s.False(job.ReservedUntil.After(time.Now()))
+
+	// Released job is available for reservation again.
+	reservedAgain, err := s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+	s.Equal(reserved.ID, reservedAgain.ID)
+	s.Equal(1, reservedAgain.Attempts)
+}
+
+func (s *JobsRepoSuite) Test_ReleaseJob_NoJobs() {
+	// Action.
+	err := s.repo.ReleaseJob(s.Ctx, types.NewJobID())
+
+	// Assert.
+	s.Require().Error(err)
+}
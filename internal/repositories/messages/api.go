@@ -13,8 +13,15 @@ import (
 var ErrMsgNotFound = errors.New("message not found")
 
 func (r *Repo) GetMessageByID(ctx context.Context, msgID types.MessageID) (*Message, error) {
-	m, err := r.db.Message(ctx).Get(ctx, msgID)
+	m, err := r.db.Message(ctx).Query().
+		Where(message.ID(msgID)).
+		WithAttachments(withAttachmentsOrdered).
+		WithReplyTo().
+		Only(ctx)
 	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, fmt.Errorf("message %v: %w", msgID, ErrMsgNotFound)
+		}
 		return nil, fmt.Errorf("query message by id: %v", err)
 	}
 
@@ -60,7 +67,29 @@ func (r *Repo) GetServiceMessageByRequestID(ctx context.Context, reqID types.Req
 	return &mm, nil
 }
 
+// GetProblemFirstMessageID returns the ID of the message the problem was started with.
+// The service messages and the internal notes are not taken into account.
+func (r *Repo) GetProblemFirstMessageID(ctx context.Context, problemID types.ProblemID) (types.MessageID, error) {
+	id, err := r.db.Message(ctx).Query().
+		Unique(false).
+		Where(
+			message.ProblemID(problemID),
+			message.IsService(false),
+			message.IsInternalNote(false),
+		).
+		Order(store.Asc(message.FieldCreatedAt), store.Asc(message.FieldID)).
+		FirstID(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return types.MessageIDNil, fmt.Errorf("problem %v: %w", problemID, ErrMsgNotFound)
+		}
+		return types.MessageIDNil, fmt.Errorf("query problem first message: %v", err)
+	}
+	return id, nil
+}
+
 // CreateClientVisible creates a message that is visible only to the client.
+// The replyToID is MessageIDNil if the message is not a reply.
 func (r *Repo) CreateClientVisible(
 	ctx context.Context,
 	reqID types.RequestID,
@@ -68,6 +97,7 @@ func (r *Repo) CreateClientVisible(
 	chatID types.ChatID,
 	authorID types.UserID,
 	msgBody string,
+	replyToID types.MessageID,
 ) (*Message, error) {
 	m, err := r.db.Message(ctx).Create().
 		SetChatID(chatID).
@@ -77,6 +107,8 @@ func (r *Repo) CreateClientVisible(
 		SetIsVisibleForManager(false).
 		SetBody(msgBody).
 		SetInitialRequestID(reqID).
+		SetContentRequestID(reqID).
+		SetNillableReplyToMessageID(replyToID.AsPointer()).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("create msg: %v", err)
@@ -86,6 +118,35 @@ func (r *Repo) CreateClientVisible(
 	return &mm, nil
 }
 
+// CreateInternalNote creates the note that is visible only to the managers.
+func (r *Repo) CreateInternalNote(
+	ctx context.Context,
+	reqID types.RequestID,
+	problemID types.ProblemID,
+	chatID types.ChatID,
+	authorID types.UserID,
+	msgBody string,
+) (*Message, error) {
+	m, err := r.db.Message(ctx).Create().
+		SetChatID(chatID).
+		SetProblemID(problemID).
+		SetAuthorID(authorID).
+		SetIsVisibleForClient(false).
+		SetIsVisibleForManager(true).
+		SetIsInternalNote(true).
+		SetBody(msgBody).
+		SetInitialRequestID(reqID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create internal note: %v", err)
+	}
+
+	mm := adaptStoreMessage(m)
+	return &mm, nil
+}
+
+// CreateFullVisible creates a message that is visible to both the client and the manager.
+// The replyToID is MessageIDNil if the message is not a reply.
 func (r *Repo) CreateFullVisible(
 	ctx context.Context,
 	reqID types.RequestID,
@@ -93,6 +154,7 @@ func (r *Repo) CreateFullVisible(
 	chatID types.ChatID,
 	authorID types.UserID,
 	msgBody string,
+	replyToID types.MessageID,
 ) (*Message, error) {
 	msg, err := r.db.Message(ctx).Create().
 		SetChatID(chatID).
@@ -102,6 +164,8 @@ func (r *Repo) CreateFullVisible(
 		SetIsVisibleForManager(true).
 		SetBody(msgBody).
 		SetInitialRequestID(reqID).
+		SetContentRequestID(reqID).
+		SetNillableReplyToMessageID(replyToID.AsPointer()).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("create msg: %v", err)
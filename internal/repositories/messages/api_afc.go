@@ -2,21 +2,151 @@ package messagesrepo
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
+var (
+	ErrMsgAlreadyChecked = errors.New("message already checked")
+	ErrVerdictOutdated   = errors.New("verdict is for the outdated message content")
+)
+
+// Verdict is the AFC verdict status.
+type Verdict string
+
+const (
+	VerdictOK         Verdict = "ok"
+	VerdictSuspicious Verdict = "suspicious"
+)
+
+// VerdictSource is who made the decision applied to the message.
+type VerdictSource string
+
+const (
+	VerdictSourceAFC       VerdictSource = "afc"
+	VerdictSourceTimeout   VerdictSource = "timeout"
+	VerdictSourcePreFilter VerdictSource = "prefilter"
+	VerdictSourceModerator VerdictSource = "moderator"
+)
+
+// MarkAsVisibleForManager applies the moderator's approval.
 func (r *Repo) MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID) error {
 	return r.db.Message(ctx).UpdateOneID(msgID).
 		SetCheckedAt(time.Now()).
 		SetIsVisibleForManager(true).
+		SetVerdict(message.VerdictOk).
+		SetVerdictSource(message.VerdictSourceModerator).
 		Exec(ctx)
 }
 
-func (r *Repo) BlockMessage(ctx context.Context, msgID types.MessageID) error {
+// BlockMessage blocks the message by the src decision.
+func (r *Repo) BlockMessage(ctx context.Context, msgID types.MessageID, src VerdictSource) error {
 	return r.db.Message(ctx).UpdateOneID(msgID).
 		SetCheckedAt(time.Now()).
 		SetIsBlocked(true).
+		SetVerdict(message.VerdictSuspicious).
+		SetVerdictSource(message.VerdictSource(src)).
+		Exec(ctx)
+}
+
+// ApplyVerdict marks the message as checked only if it has not been checked yet,
+// the "ok" verdict makes the message visible for manager.
+// The verdict and its source are kept on the message as the applied decision.
+// It returns ErrMsgAlreadyChecked if the message already has the applied decision.
+func (r *Repo) ApplyVerdict(ctx context.Context, msgID types.MessageID, v Verdict, src VerdictSource) error {
+	return r.applyVerdict(ctx, msgID, types.RequestIDNil, v, src)
+}
+
+// ApplyAFCVerdict is ApplyVerdict for the AFC verdict on the content of the reqID request
+// (the message sending or the edit one). The verdict without reqID is applied to the current content.
+// It returns ErrVerdictOutdated if the message has been edited since.
+func (r *Repo) ApplyAFCVerdict(ctx context.Context, msgID types.MessageID, reqID types.RequestID, v Verdict) error {
+	return r.applyVerdict(ctx, msgID, reqID, v, VerdictSourceAFC)
+}
+
+func (r *Repo) applyVerdict(
+	ctx context.Context,
+	msgID types.MessageID,
+	reqID types.RequestID,
+	v Verdict,
+	src VerdictSource,
+) error {
+	upd := r.db.Message(ctx).Update().
+		Where(
+			message.ID(msgID),
+			message.CheckedAtIsNil(),
+		).
+		SetCheckedAt(time.Now()).
+		SetVerdict(message.Verdict(v)).
+		SetVerdictSource(message.VerdictSource(src))
+	if !reqID.IsZero() {
+		upd.Where(isContentOf(reqID))
+	}
+	if v == VerdictOK {
+		upd.SetIsVisibleForManager(true)
+	}
+
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update message: %v", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	q := r.db.Message(ctx).Query().Where(message.ID(msgID))
+	exists, err := q.Clone().Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check message existence: %v", err)
+	}
+	if !exists {
+		return fmt.Errorf("message %v: %w", msgID, ErrMsgNotFound)
+	}
+
+	if !reqID.IsZero() {
+		current, err := q.Where(isContentOf(reqID)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check message content: %v", err)
+		}
+		if !current {
+			return fmt.Errorf("message %v, request %v: %w", msgID, reqID, ErrVerdictOutdated)
+		}
+	}
+	return fmt.Errorf("message %v: %w", msgID, ErrMsgAlreadyChecked)
+}
+
+// isContentOf checks the message body comes from the reqID request.
+// The messages created before the content request was stored are considered current.
+func isContentOf(reqID types.RequestID) predicate.Message {
+	return message.Or(
+		message.ContentRequestID(reqID),
+		message.ContentRequestIDIsNil(),
+	)
+}
+
+// RecordVerdictConflict saves the received verdict that differs from the applied one.
+// The redelivered conflicting verdict is recorded once.
+func (r *Repo) RecordVerdictConflict(ctx context.Context, msgID types.MessageID, applied, received Verdict) error {
+	err := r.db.VerdictConflict(ctx).Create().
+		SetMessageID(msgID).
+		SetAppliedVerdict(verdictconflict.AppliedVerdict(applied)).
+		SetReceivedVerdict(verdictconflict.ReceivedVerdict(received)).
+		OnConflictColumns(verdictconflict.FieldMessageID, verdictconflict.FieldReceivedVerdict).
+		DoNothing().
 		Exec(ctx)
+	if err != nil {
+		// Nothing is returned by the insert if the conflict is already recorded.
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("create conflict: %v", err)
+	}
+	return nil
 }
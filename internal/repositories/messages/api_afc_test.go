@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
 	"github.com/zestagio/chat-service/internal/testingh"
 	"github.com/zestagio/chat-service/internal/types"
 )
@@ -46,6 +48,8 @@ func (s *MsgRepoAntiFraudAPISuite) TestMarkAsVisibleForManager() {
 	s.False(msg.CheckedAt.IsZero())
 	s.True(msg.IsVisibleForClient)
 	s.True(msg.IsVisibleForManager)
+	s.Equal(message.VerdictOk, msg.Verdict)
+	s.Equal(message.VerdictSourceModerator, msg.VerdictSource)
 }
 
 func (s *MsgRepoAntiFraudAPISuite) TestBlockMessage() {
@@ -53,7 +57,7 @@ func (s *MsgRepoAntiFraudAPISuite) TestBlockMessage() {
 	msgID := s.createMessage()
 
 	// Action.
-	err := s.repo.BlockMessage(s.Ctx, msgID)
+	err := s.repo.BlockMessage(s.Ctx, msgID, messagesrepo.VerdictSourceModerator)
 	s.Require().NoError(err)
 
 	// Assert.
@@ -63,6 +67,89 @@ func (s *MsgRepoAntiFraudAPISuite) TestBlockMessage() {
 	s.False(msg.CheckedAt.IsZero())
 	s.True(msg.IsVisibleForClient)
 	s.False(msg.IsVisibleForManager)
+	s.Equal(message.VerdictSuspicious, msg.Verdict)
+	s.Equal(message.VerdictSourceModerator, msg.VerdictSource)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestApplyVerdict() {
+	s.Run("ok", func() {
+		// Arrange.
+		msgID := s.createMessage()
+
+		// Action.
+		err := s.repo.ApplyVerdict(s.Ctx, msgID, messagesrepo.VerdictOK, messagesrepo.VerdictSourceAFC)
+		s.Require().NoError(err)
+
+		// Assert.
+		msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+		s.False(msg.CheckedAt.IsZero())
+		s.True(msg.IsVisibleForManager)
+		s.False(msg.IsBlocked)
+		s.Equal(message.VerdictOk, msg.Verdict)
+		s.Equal(message.VerdictSourceAfc, msg.VerdictSource)
+	})
+
+	s.Run("suspicious", func() {
+		// Arrange.
+		msgID := s.createMessage()
+
+		// Action.
+		err := s.repo.ApplyVerdict(s.Ctx, msgID, messagesrepo.VerdictSuspicious, messagesrepo.VerdictSourceTimeout)
+		s.Require().NoError(err)
+
+		// Assert.
+		msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+		s.False(msg.CheckedAt.IsZero())
+		s.False(msg.IsVisibleForManager)
+		s.False(msg.IsBlocked)
+		s.Equal(message.VerdictSuspicious, msg.Verdict)
+		s.Equal(message.VerdictSourceTimeout, msg.VerdictSource)
+	})
+
+	s.Run("already checked", func() {
+		// Arrange.
+		msgID := s.createMessage()
+		s.Require().NoError(s.repo.ApplyVerdict(s.Ctx, msgID, messagesrepo.VerdictSuspicious, messagesrepo.VerdictSourcePreFilter))
+		checkedAt := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID).CheckedAt
+
+		// Action.
+		err := s.repo.ApplyVerdict(s.Ctx, msgID, messagesrepo.VerdictOK, messagesrepo.VerdictSourceAFC)
+
+		// Assert.
+		s.Require().ErrorIs(err, messagesrepo.ErrMsgAlreadyChecked)
+
+		msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+		s.True(checkedAt.Equal(msg.CheckedAt))
+		s.False(msg.IsVisibleForManager)
+		s.Equal(message.VerdictSuspicious, msg.Verdict)
+		s.Equal(message.VerdictSourcePrefilter, msg.VerdictSource)
+	})
+
+	s.Run("not found", func() {
+		// Action.
+		err := s.repo.ApplyVerdict(s.Ctx, types.NewMessageID(), messagesrepo.VerdictOK, messagesrepo.VerdictSourceAFC)
+
+		// Assert.
+		s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+	})
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestRecordVerdictConflict() {
+	// Arrange.
+	msgID := s.createMessage()
+
+	// Action.
+	for i := 0; i < 2; i++ {
+		err := s.repo.RecordVerdictConflict(s.Ctx, msgID, messagesrepo.VerdictSuspicious, messagesrepo.VerdictOK)
+		s.Require().NoError(err)
+	}
+
+	// Assert.
+	conflicts := s.Database.VerdictConflict(s.Ctx).Query().Where(verdictconflict.MessageID(msgID)).AllX(s.Ctx)
+	s.Require().Len(conflicts, 1)
+	s.Equal(msgID, conflicts[0].MessageID)
+	s.EqualValues(messagesrepo.VerdictSuspicious, conflicts[0].AppliedVerdict)
+	s.EqualValues(messagesrepo.VerdictOK, conflicts[0].ReceivedVerdict)
 }
 
 func (s *MsgRepoAntiFraudAPISuite) createMessage() types.MessageID {
@@ -0,0 +1,96 @@
+package messagesrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/store/attachment"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+// CreateAttachment keeps the metadata of the file put into the attachments storage.
+// The attachment is not linked to any message yet, see AttachToMessage.
+func (r *Repo) CreateAttachment(ctx context.Context, a Attachment) (*Attachment, error) {
+	q := r.db.Attachment(ctx).Create().
+		SetChatID(a.ChatID).
+		SetUploadedBy(a.UploadedBy).
+		SetFileName(a.FileName).
+		SetContentType(a.ContentType).
+		SetSize(a.Size).
+		SetStorageKey(a.StorageKey).
+		SetThumbnailKey(a.ThumbnailKey)
+	if !a.ID.IsZero() {
+		q.SetID(a.ID)
+	}
+
+	aa, err := q.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create attachment: %v", err)
+	}
+
+	result := adaptStoreAttachment(aa)
+	return &result, nil
+}
+
+// GetAttachmentByID returns the attachment unless its message has been deleted.
+func (r *Repo) GetAttachmentByID(ctx context.Context, id types.AttachmentID) (*Attachment, error) {
+	a, err := r.db.Attachment(ctx).Query().
+		Where(
+			attachment.ID(id),
+			attachment.Or(
+				attachment.MessageIDIsNil(),
+				attachment.HasMessageWith(message.DeletedAtIsNil()),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, fmt.Errorf("attachment %v: %w", id, ErrAttachmentNotFound)
+		}
+		return nil, fmt.Errorf("query attachment by id: %v", err)
+	}
+
+	result := adaptStoreAttachment(a)
+	return &result, nil
+}
+
+// AttachToMessage links the attachments uploaded by the message author to the chat to the message.
+// ErrAttachmentNotFound is returned if any of attachments is unknown, foreign or already attached.
+// Must be called in transaction.
+func (r *Repo) AttachToMessage(
+	ctx context.Context,
+	msgID types.MessageID,
+	chatID types.ChatID,
+	authorID types.UserID,
+	ids []types.AttachmentID,
+) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	n, err := r.db.Attachment(ctx).Update().
+		Where(
+			attachment.IDIn(ids...),
+			attachment.ChatID(chatID),
+			attachment.UploadedBy(authorID),
+			attachment.MessageIDIsNil(),
+		).
+		SetMessageID(msgID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update attachments: %v", err)
+	}
+	if n != len(ids) {
+		return fmt.Errorf("%d of %d attachments: %w", len(ids)-n, len(ids), ErrAttachmentNotFound)
+	}
+	return nil
+}
+
+func withAttachmentsOrdered(q *store.AttachmentQuery) {
+	q.Order(store.Asc(attachment.FieldCreatedAt))
+}
@@ -0,0 +1,179 @@
+//go:build integration
+
+package messagesrepo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type MsgRepoAttachmentsAPISuite struct {
+	testingh.DBSuite
+	repo *messagesrepo.Repo
+}
+
+func TestMsgRepoAttachmentsAPISuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &MsgRepoAttachmentsAPISuite{DBSuite: testingh.NewDBSuite("TestMsgRepoAttachmentsAPISuite")})
+}
+
+func (s *MsgRepoAttachmentsAPISuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = messagesrepo.New(messagesrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *MsgRepoAttachmentsAPISuite) TestAttachToMessage() {
+	// Arrange.
+	msgID, chatID, authorID := s.createMessage()
+	a1 := s.createAttachment(chatID, authorID)
+	a2 := s.createAttachment(chatID, authorID)
+
+	// Action.
+	err := s.repo.AttachToMessage(s.Ctx, msgID, chatID, authorID, []types.AttachmentID{a1.ID, a2.ID})
+	s.Require().NoError(err)
+
+	// Assert.
+	msg, err := s.repo.GetMessageByID(s.Ctx, msgID)
+	s.Require().NoError(err)
+	s.Require().Len(msg.Attachments, 2)
+	s.Equal(a1.ID, msg.Attachments[0].ID)
+	s.Equal(a2.ID, msg.Attachments[1].ID)
+	s.Equal(msgID, msg.Attachments[0].MessageID)
+	s.Equal("screenshot.png", msg.Attachments[0].FileName)
+	s.Equal("image/png", msg.Attachments[0].ContentType)
+	s.EqualValues(1024, msg.Attachments[0].Size)
+
+	msgs, _, err := s.repo.GetClientChatMessages(s.Ctx, authorID, 10, nil)
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 1)
+	s.Len(msgs[0].Attachments, 2)
+}
+
+func (s *MsgRepoAttachmentsAPISuite) TestAttachToMessage_NotFound() {
+	msgID, chatID, authorID := s.createMessage()
+
+	for _, tt := range []struct {
+		name string
+		a    func() types.AttachmentID
+	}{
+		{
+			name: "unknown attachment",
+			a:    types.NewAttachmentID,
+		},
+		{
+			name: "attachment of another chat",
+			a: func() types.AttachmentID {
+				_, anotherChatID, _ := s.createMessage()
+				return s.createAttachment(anotherChatID, authorID).ID
+			},
+		},
+		{
+			name: "attachment of another user",
+			a: func() types.AttachmentID {
+				return s.createAttachment(chatID, types.NewUserID()).ID
+			},
+		},
+		{
+			name: "already attached",
+			a: func() types.AttachmentID {
+				anotherMsgID, _, _ := s.createMessage()
+				a := s.createAttachment(chatID, authorID)
+				err := s.repo.AttachToMessage(s.Ctx, anotherMsgID, chatID, authorID, []types.AttachmentID{a.ID})
+				s.Require().NoError(err)
+				return a.ID
+			},
+		},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			ids := []types.AttachmentID{s.createAttachment(chatID, authorID).ID, tt.a()}
+
+			// Action.
+			err := s.repo.AttachToMessage(s.Ctx, msgID, chatID, authorID, ids)
+
+			// Assert.
+			s.Require().ErrorIs(err, messagesrepo.ErrAttachmentNotFound)
+		})
+	}
+}
+
+func (s *MsgRepoAttachmentsAPISuite) TestGetAttachmentByID() {
+	// Arrange.
+	msgID, chatID, authorID := s.createMessage()
+	a := s.createAttachment(chatID, authorID)
+
+	// Action & assert.
+	got, err := s.repo.GetAttachmentByID(s.Ctx, a.ID)
+	s.Require().NoError(err)
+	s.Equal(a.ID, got.ID)
+	s.Equal(chatID, got.ChatID)
+	s.Equal(authorID, got.UploadedBy)
+	s.Equal(a.StorageKey, got.StorageKey)
+	s.Equal(a.ThumbnailKey, got.ThumbnailKey)
+	s.True(got.MessageID.IsZero())
+
+	err = s.repo.AttachToMessage(s.Ctx, msgID, chatID, authorID, []types.AttachmentID{a.ID})
+	s.Require().NoError(err)
+
+	_, err = s.repo.GetAttachmentByID(s.Ctx, a.ID)
+	s.Require().NoError(err)
+
+	_, err = s.repo.DeleteMessage(s.Ctx, types.NewRequestID(), msgID, authorID, messagesrepo.DeleterRoleClient)
+	s.Require().NoError(err)
+
+	_, err = s.repo.GetAttachmentByID(s.Ctx, a.ID)
+	s.Require().ErrorIs(err, messagesrepo.ErrAttachmentNotFound)
+
+	msg, err := s.repo.GetMessageByID(s.Ctx, msgID)
+	s.Require().NoError(err)
+	s.Empty(msg.Attachments)
+
+	_, err = s.repo.GetAttachmentByID(s.Ctx, types.NewAttachmentID())
+	s.Require().ErrorIs(err, messagesrepo.ErrAttachmentNotFound)
+}
+
+func (s *MsgRepoAttachmentsAPISuite) createMessage() (types.MessageID, types.ChatID, types.UserID) {
+	s.T().Helper()
+
+	authorID := types.NewUserID()
+	chat := s.Database.Chat(s.Ctx).Create().SetClientID(authorID).SaveX(s.Ctx)
+	problem := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).SaveX(s.Ctx)
+
+	msg := s.Database.Message(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetAuthorID(authorID).
+		SetProblemID(problem.ID).
+		SetIsVisibleForClient(true).
+		SetInitialRequestID(types.NewRequestID()).
+		SaveX(s.Ctx)
+
+	return msg.ID, chat.ID, authorID
+}
+
+func (s *MsgRepoAttachmentsAPISuite) createAttachment(chatID types.ChatID, uploadedBy types.UserID) *messagesrepo.Attachment {
+	s.T().Helper()
+
+	id := types.NewAttachmentID()
+	a, err := s.repo.CreateAttachment(s.Ctx, messagesrepo.Attachment{
+		ID:           id,
+		ChatID:       chatID,
+		UploadedBy:   uploadedBy,
+		FileName:     "screenshot.png",
+		ContentType:  "image/png",
+		Size:         1024,
+		StorageKey:   id.String(),
+		ThumbnailKey: id.String() + ".thumb",
+	})
+	s.Require().NoError(err)
+	s.Equal(id, a.ID)
+	return a
+}
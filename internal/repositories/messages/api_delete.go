@@ -0,0 +1,110 @@
+package messagesrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+var (
+	ErrMsgAlreadyDeleted = errors.New("message already deleted")
+	ErrDeletionNotFound  = errors.New("message deletion not found")
+)
+
+// DeleteMessage marks the message as deleted and keeps the audit record of the deletion.
+// The message body stays in the store, but it is not exposed anymore (see Message).
+// The previous bodies of the edited message are removed, so the retracted data does not remain in revisions.
+// Must be called in transaction.
+func (r *Repo) DeleteMessage(
+	ctx context.Context,
+	reqID types.RequestID,
+	msgID types.MessageID,
+	deletedBy types.UserID,
+	role DeleterRole,
+) (*Deletion, error) {
+	// Wait for the concurrent edit of the message, so it does not bring the revisions back after the purge.
+	if _, err := r.db.Message(ctx).Query().
+		Where(message.ID(msgID)).
+		ForUpdate().
+		Only(ctx); err != nil && !store.IsNotFound(err) {
+		return nil, fmt.Errorf("lock message: %v", err)
+	}
+
+	now := time.Now()
+
+	n, err := r.db.Message(ctx).Update().
+		Where(
+			message.ID(msgID),
+			message.DeletedAtIsNil(),
+		).
+		SetDeletedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update message: %v", err)
+	}
+	if n == 0 {
+		exists, err := r.db.Message(ctx).Query().Where(message.ID(msgID)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("check message existence: %v", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("message %v: %w", msgID, ErrMsgNotFound)
+		}
+		return nil, fmt.Errorf("message %v: %w", msgID, ErrMsgAlreadyDeleted)
+	}
+
+	if _, err := r.db.MessageRevision(ctx).Delete().
+		Where(messagerevision.MessageID(msgID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("delete message revisions: %v", err)
+	}
+
+	d, err := r.db.MessageDeletion(ctx).Create().
+		SetMessageID(msgID).
+		SetRequestID(reqID).
+		SetDeletedBy(deletedBy).
+		SetDeletedByRole(messagedeletion.DeletedByRole(role)).
+		SetCreatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create deletion: %v", err)
+	}
+
+	dd := adaptStoreDeletion(d)
+	return &dd, nil
+}
+
+func (r *Repo) GetDeletionByID(ctx context.Context, deletionID types.MessageDeletionID) (*Deletion, error) {
+	d, err := r.db.MessageDeletion(ctx).Get(ctx, deletionID)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, fmt.Errorf("deletion %v: %w", deletionID, ErrDeletionNotFound)
+		}
+		return nil, fmt.Errorf("query deletion by id: %v", err)
+	}
+
+	dd := adaptStoreDeletion(d)
+	return &dd, nil
+}
+
+func (r *Repo) GetDeletionByRequestID(ctx context.Context, reqID types.RequestID) (*Deletion, error) {
+	d, err := r.db.MessageDeletion(ctx).Query().
+		Where(messagedeletion.RequestID(reqID)).
+		Only(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, fmt.Errorf("request id %v: %w", reqID, ErrDeletionNotFound)
+		}
+		return nil, fmt.Errorf("query deletion by request id %v: %v", reqID, err)
+	}
+
+	dd := adaptStoreDeletion(d)
+	return &dd, nil
+}
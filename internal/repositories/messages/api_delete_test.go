@@ -0,0 +1,144 @@
+//go:build integration
+
+package messagesrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type MsgRepoDeleteAPISuite struct {
+	testingh.DBSuite
+	repo *messagesrepo.Repo
+}
+
+func TestMsgRepoDeleteAPISuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &MsgRepoDeleteAPISuite{DBSuite: testingh.NewDBSuite("TestMsgRepoDeleteAPISuite")})
+}
+
+func (s *MsgRepoDeleteAPISuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = messagesrepo.New(messagesrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *MsgRepoDeleteAPISuite) TestDeleteMessage() {
+	// Arrange.
+	msgID, authorID := s.createMessage()
+	reqID := types.NewRequestID()
+
+	// Action.
+	d, err := s.repo.DeleteMessage(s.Ctx, reqID, msgID, authorID, messagesrepo.DeleterRoleClient)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Equal(msgID, d.MessageID)
+	s.Equal(reqID, d.RequestID)
+	s.Equal(authorID, d.DeletedBy)
+	s.Equal(messagesrepo.DeleterRoleClient, d.DeletedByRole)
+
+	// The body is kept in the store but not exposed.
+	stored := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.Equal(msgBody, stored.Body)
+	s.WithinDuration(d.CreatedAt, stored.DeletedAt, time.Millisecond)
+
+	msg, err := s.repo.GetMessageByID(s.Ctx, msgID)
+	s.Require().NoError(err)
+	s.Empty(msg.Body)
+	s.False(msg.DeletedAt.IsZero())
+
+	byReq, err := s.repo.GetDeletionByRequestID(s.Ctx, reqID)
+	s.Require().NoError(err)
+	s.Equal(d.ID, byReq.ID)
+
+	byID, err := s.repo.GetDeletionByID(s.Ctx, d.ID)
+	s.Require().NoError(err)
+	s.Equal(d.ID, byID.ID)
+}
+
+func (s *MsgRepoDeleteAPISuite) TestDeleteMessage_RemovesRevisions() {
+	// Arrange.
+	msgID, authorID := s.createMessage()
+	editReqID := types.NewRequestID()
+	_, err := s.repo.EditMessage(s.Ctx, editReqID, msgID, "My card is 4276 ****", false)
+	s.Require().NoError(err)
+
+	otherMsgID, _ := s.createMessage()
+	otherEditReqID := types.NewRequestID()
+	_, err = s.repo.EditMessage(s.Ctx, otherEditReqID, otherMsgID, "Hello again!", false)
+	s.Require().NoError(err)
+
+	// Action.
+	_, err = s.repo.DeleteMessage(s.Ctx, types.NewRequestID(), msgID, authorID, messagesrepo.DeleterRoleClient)
+	s.Require().NoError(err)
+
+	// Assert.
+	n, err := s.Database.MessageRevision(s.Ctx).Query().Where(messagerevision.MessageID(msgID)).Count(s.Ctx)
+	s.Require().NoError(err)
+	s.Zero(n)
+
+	_, err = s.repo.GetRevisionByEditRequestID(s.Ctx, editReqID)
+	s.Require().ErrorIs(err, messagesrepo.ErrRevisionNotFound)
+
+	// The revisions of other messages are kept.
+	rev, err := s.repo.GetRevisionByEditRequestID(s.Ctx, otherEditReqID)
+	s.Require().NoError(err)
+	s.Equal(otherMsgID, rev.MessageID)
+}
+
+func (s *MsgRepoDeleteAPISuite) TestDeleteMessage_AlreadyDeleted() {
+	// Arrange.
+	msgID, authorID := s.createMessage()
+	_, err := s.repo.DeleteMessage(s.Ctx, types.NewRequestID(), msgID, authorID, messagesrepo.DeleterRoleClient)
+	s.Require().NoError(err)
+
+	// Action.
+	_, err = s.repo.DeleteMessage(s.Ctx, types.NewRequestID(), msgID, types.NewUserID(), messagesrepo.DeleterRoleModerator)
+
+	// Assert.
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgAlreadyDeleted)
+}
+
+func (s *MsgRepoDeleteAPISuite) TestDeleteMessage_NotFound() {
+	_, err := s.repo.DeleteMessage(s.Ctx, types.NewRequestID(), types.NewMessageID(), types.NewUserID(),
+		messagesrepo.DeleterRoleManager)
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+
+	_, err = s.repo.GetDeletionByRequestID(s.Ctx, types.NewRequestID())
+	s.Require().ErrorIs(err, messagesrepo.ErrDeletionNotFound)
+
+	_, err = s.repo.GetDeletionByID(s.Ctx, types.NewMessageDeletionID())
+	s.Require().ErrorIs(err, messagesrepo.ErrDeletionNotFound)
+}
+
+func (s *MsgRepoDeleteAPISuite) createMessage() (types.MessageID, types.UserID) {
+	s.T().Helper()
+
+	authorID := types.NewUserID()
+	chat := s.Database.Chat(s.Ctx).Create().SetClientID(authorID).SaveX(s.Ctx)
+	problem := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).SaveX(s.Ctx)
+
+	msg := s.Database.Message(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetAuthorID(authorID).
+		SetProblemID(problem.ID).
+		SetBody(msgBody).
+		SetIsVisibleForClient(true).
+		SetIsVisibleForManager(true).
+		SetCheckedAt(time.Now()).
+		SetInitialRequestID(types.NewRequestID()).
+		SaveX(s.Ctx)
+
+	return msg.ID, authorID
+}
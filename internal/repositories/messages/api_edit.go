@@ -0,0 +1,118 @@
+package messagesrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+var (
+	ErrMsgNotEditable   = errors.New("message is not editable")
+	ErrRevisionNotFound = errors.New("message revision not found")
+)
+
+// EditMessage saves the current message body as the revision and replaces it with the new one.
+// The recheck resets the AFC decision, so the message is hidden from manager until the new verdict
+// on the content of the reqID request.
+// The message that has been moderated cannot be rechecked, the deleted message cannot be edited,
+// ErrMsgNotEditable is returned in both cases.
+// Must be called in transaction.
+func (r *Repo) EditMessage(
+	ctx context.Context,
+	reqID types.RequestID,
+	msgID types.MessageID,
+	msgBody string,
+	recheck bool,
+) (*Revision, error) {
+	// The row lock serializes the concurrent edits and the deletion of the message,
+	// so no body is lost from the revisions or left there after the deletion.
+	m, err := r.db.Message(ctx).Query().
+		Where(message.ID(msgID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, fmt.Errorf("message %v: %w", msgID, ErrMsgNotFound)
+		}
+		return nil, fmt.Errorf("query message by id: %v", err)
+	}
+	if !m.DeletedAt.IsZero() {
+		return nil, fmt.Errorf("message %v is deleted: %w", msgID, ErrMsgNotEditable)
+	}
+
+	if recheck {
+		moderated, err := r.db.Message(ctx).Query().
+			Where(message.ID(msgID), message.HasModerationCase()).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("check moderation case existence: %v", err)
+		}
+		if moderated {
+			return nil, fmt.Errorf("message %v is moderated: %w", msgID, ErrMsgNotEditable)
+		}
+	}
+
+	rev, err := r.db.MessageRevision(ctx).Create().
+		SetMessageID(msgID).
+		SetBody(m.Body).
+		SetEditRequestID(reqID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create revision: %v", err)
+	}
+
+	upd := r.db.Message(ctx).Update().
+		Where(
+			message.ID(msgID),
+			message.DeletedAtIsNil(),
+		).
+		SetBody(msgBody).
+		SetEditedAt(rev.CreatedAt).
+		SetContentRequestID(reqID)
+	if recheck {
+		upd.ClearCheckedAt().ClearVerdict().ClearVerdictSource().SetIsVisibleForManager(false)
+	}
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update message: %v", err)
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("message %v is deleted: %w", msgID, ErrMsgNotEditable)
+	}
+
+	rr := adaptStoreRevision(rev)
+	return &rr, nil
+}
+
+func (r *Repo) GetRevisionByID(ctx context.Context, revID types.MessageRevisionID) (*Revision, error) {
+	rev, err := r.db.MessageRevision(ctx).Get(ctx, revID)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, fmt.Errorf("revision %v: %w", revID, ErrRevisionNotFound)
+		}
+		return nil, fmt.Errorf("query revision by id: %v", err)
+	}
+
+	rr := adaptStoreRevision(rev)
+	return &rr, nil
+}
+
+func (r *Repo) GetRevisionByEditRequestID(ctx context.Context, reqID types.RequestID) (*Revision, error) {
+	rev, err := r.db.MessageRevision(ctx).Query().
+		Where(messagerevision.EditRequestID(reqID)).
+		Only(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, fmt.Errorf("edit request id %v: %w", reqID, ErrRevisionNotFound)
+		}
+		return nil, fmt.Errorf("query revision by edit request id %v: %v", reqID, err)
+	}
+
+	rr := adaptStoreRevision(rev)
+	return &rr, nil
+}
@@ -0,0 +1,165 @@
+//go:build integration
+
+package messagesrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type MsgRepoEditAPISuite struct {
+	testingh.DBSuite
+	repo *messagesrepo.Repo
+}
+
+func TestMsgRepoEditAPISuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &MsgRepoEditAPISuite{DBSuite: testingh.NewDBSuite("TestMsgRepoEditAPISuite")})
+}
+
+func (s *MsgRepoEditAPISuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = messagesrepo.New(messagesrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *MsgRepoEditAPISuite) TestEditMessage() {
+	// Arrange.
+	msgID := s.createCheckedMessage()
+	reqID := types.NewRequestID()
+
+	// Action.
+	rev, err := s.repo.EditMessage(s.Ctx, reqID, msgID, "edited", false)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Equal(msgID, rev.MessageID)
+	s.Equal(msgBody, rev.Body)
+	s.Equal(reqID, rev.EditRequestID)
+
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.Equal("edited", msg.Body)
+	s.WithinDuration(rev.CreatedAt, msg.EditedAt, time.Millisecond)
+	s.True(msg.IsVisibleForManager)
+	s.False(msg.CheckedAt.IsZero())
+	s.Equal(reqID, msg.ContentRequestID)
+
+	revs := s.Database.MessageRevision(s.Ctx).Query().Where(messagerevision.MessageID(msgID)).AllX(s.Ctx)
+	s.Require().Len(revs, 1)
+	s.Equal(msgBody, revs[0].Body)
+
+	byReq, err := s.repo.GetRevisionByEditRequestID(s.Ctx, reqID)
+	s.Require().NoError(err)
+	s.Equal(rev.ID, byReq.ID)
+
+	byID, err := s.repo.GetRevisionByID(s.Ctx, rev.ID)
+	s.Require().NoError(err)
+	s.Equal(rev.ID, byID.ID)
+}
+
+func (s *MsgRepoEditAPISuite) TestEditMessage_Recheck() {
+	// Arrange.
+	msgID := s.createCheckedMessage()
+	initialReqID := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID).InitialRequestID
+	editReqID := types.NewRequestID()
+
+	// Action.
+	_, err := s.repo.EditMessage(s.Ctx, editReqID, msgID, "edited", true)
+	s.Require().NoError(err)
+
+	// Assert.
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.Equal("edited", msg.Body)
+	s.False(msg.IsVisibleForManager)
+	s.True(msg.CheckedAt.IsZero())
+	s.Empty(msg.Verdict)
+	s.Empty(msg.VerdictSource)
+
+	// The verdict on the previous content is outdated.
+	err = s.repo.ApplyAFCVerdict(s.Ctx, msgID, initialReqID, messagesrepo.VerdictOK)
+	s.Require().ErrorIs(err, messagesrepo.ErrVerdictOutdated)
+	s.True(s.Database.Message(s.Ctx).GetX(s.Ctx, msgID).CheckedAt.IsZero())
+
+	// The verdict on the new content is applied.
+	s.Require().NoError(s.repo.ApplyAFCVerdict(s.Ctx, msgID, editReqID, messagesrepo.VerdictOK))
+	s.True(s.Database.Message(s.Ctx).GetX(s.Ctx, msgID).IsVisibleForManager)
+
+	// The late verdict on the previous content is still outdated, not conflicting.
+	err = s.repo.ApplyAFCVerdict(s.Ctx, msgID, initialReqID, messagesrepo.VerdictSuspicious)
+	s.Require().ErrorIs(err, messagesrepo.ErrVerdictOutdated)
+}
+
+func (s *MsgRepoEditAPISuite) TestEditMessage_ModeratedCannotBeRechecked() {
+	// Arrange.
+	msgID := s.createCheckedMessage()
+	s.Database.ModerationCase(s.Ctx).Create().SetMessageID(msgID).SaveX(s.Ctx)
+
+	// Action.
+	_, err := s.repo.EditMessage(s.Ctx, types.NewRequestID(), msgID, "edited", true)
+
+	// Assert.
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgNotEditable)
+	s.Equal(msgBody, s.Database.Message(s.Ctx).GetX(s.Ctx, msgID).Body)
+}
+
+func (s *MsgRepoEditAPISuite) TestEditMessage_DeletedCannotBeEdited() {
+	// Arrange.
+	msgID := s.createCheckedMessage()
+	_, err := s.repo.DeleteMessage(s.Ctx, types.NewRequestID(), msgID, types.NewUserID(), messagesrepo.DeleterRoleClient)
+	s.Require().NoError(err)
+
+	// Action.
+	_, err = s.repo.EditMessage(s.Ctx, types.NewRequestID(), msgID, "edited", false)
+
+	// Assert.
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgNotEditable)
+	s.Equal(msgBody, s.Database.Message(s.Ctx).GetX(s.Ctx, msgID).Body)
+	s.Zero(s.Database.MessageRevision(s.Ctx).Query().Where(messagerevision.MessageID(msgID)).CountX(s.Ctx))
+}
+
+func (s *MsgRepoEditAPISuite) TestEditMessage_NotFound() {
+	_, err := s.repo.EditMessage(s.Ctx, types.NewRequestID(), types.NewMessageID(), "edited", false)
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+
+	_, err = s.repo.GetRevisionByEditRequestID(s.Ctx, types.NewRequestID())
+	s.Require().ErrorIs(err, messagesrepo.ErrRevisionNotFound)
+
+	_, err = s.repo.GetRevisionByID(s.Ctx, types.NewMessageRevisionID())
+	s.Require().ErrorIs(err, messagesrepo.ErrRevisionNotFound)
+}
+
+func (s *MsgRepoEditAPISuite) createCheckedMessage() types.MessageID {
+	s.T().Helper()
+
+	authorID := types.NewUserID()
+	chat := s.Database.Chat(s.Ctx).Create().SetClientID(authorID).SaveX(s.Ctx)
+	problem := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).SaveX(s.Ctx)
+
+	reqID := types.NewRequestID()
+	msg := s.Database.Message(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetAuthorID(authorID).
+		SetProblemID(problem.ID).
+		SetBody(msgBody).
+		SetIsVisibleForClient(true).
+		SetIsVisibleForManager(true).
+		SetCheckedAt(time.Now()).
+		SetVerdict(message.VerdictOk).
+		SetVerdictSource(message.VerdictSourceAfc).
+		SetInitialRequestID(reqID).
+		SetContentRequestID(reqID).
+		SaveX(s.Ctx)
+
+	return msg.ID
+}
@@ -6,9 +6,13 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+
 	"github.com/zestagio/chat-service/internal/store"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/store/problem"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
@@ -22,9 +26,17 @@ const (
 	maxPageSize = 100
 )
 
+// Cursor is the keyset of the page boundary. The messages are ordered by the (created_at, id) pair,
+// so the messages with the same timestamp are neither skipped nor duplicated at the page boundaries.
 type Cursor struct {
 	LastCreatedAt time.Time
-	PageSize      int
+	// LastID breaks the ties between the messages with the same LastCreatedAt.
+	// The zero LastID is lower than any message ID.
+	LastID   types.MessageID `json:",omitempty"`
+	PageSize int
+
+	// Forward cursor points to the messages newer than the boundary, otherwise to the older ones.
+	Forward bool `json:",omitempty"`
 }
 
 func (c Cursor) Validate() error {
@@ -58,6 +70,7 @@ func (r *Repo) GetClientChatMessages(
 }
 
 // GetProblemMessages returns Nth page of messages in the chat for manager side (specific problem).
+// The internal notes left in the chat before the problem was created are included.
 func (r *Repo) GetProblemMessages(
 	ctx context.Context,
 	problemID types.ProblemID,
@@ -68,37 +81,207 @@ func (r *Repo) GetProblemMessages(
 		Unique(false).
 		Where(
 			message.IsVisibleForManager(true),
-			message.ProblemID(problemID),
+			problemMessages(problemID),
 		)
 	return r.getChatMessages(ctx, query, pageSize, cursor)
 }
 
+// GetClientChatMessagesAround returns the page of messages in the chat for client side
+// with the message in the middle and the cursors to the older and the newer pages.
+func (r *Repo) GetClientChatMessagesAround(
+	ctx context.Context,
+	clientID types.UserID,
+	msgID types.MessageID,
+	pageSize int,
+) (msgs []Message, older, newer *Cursor, err error) {
+	query := r.db.Message(ctx).Query().
+		Unique(false).
+		Where(
+			message.IsVisibleForClient(true),
+			message.HasChatWith(chat.ClientID(clientID)),
+		)
+	return r.getChatMessagesAround(ctx, query, msgID, pageSize)
+}
+
+// GetProblemMessagesAround is the same as GetClientChatMessagesAround, but for manager side (specific problem).
+func (r *Repo) GetProblemMessagesAround(
+	ctx context.Context,
+	problemID types.ProblemID,
+	msgID types.MessageID,
+	pageSize int,
+) (msgs []Message, older, newer *Cursor, err error) {
+	query := r.db.Message(ctx).Query().
+		Unique(false).
+		Where(
+			message.IsVisibleForManager(true),
+			problemMessages(problemID),
+		)
+	return r.getChatMessagesAround(ctx, query, msgID, pageSize)
+}
+
+// BackCursor returns the cursor to the opposite direction of the page got by the cursor c.
+// Returns nil for the first (the newest) page and for the empty page.
+func BackCursor(c *Cursor, msgs []Message) *Cursor {
+	if c == nil || len(msgs) == 0 {
+		return nil
+	}
+
+	if c.Forward {
+		return newOlderCursor(msgs[len(msgs)-1], c.PageSize)
+	}
+	return newNewerCursor(msgs[0], c.PageSize)
+}
+
 // getChatMessages returns messages either by clientID or by problemID.
+// The messages are always ordered from the newest to the oldest, the cursor points to the same direction
+// the given cursor does (to the older messages by default).
 func (r *Repo) getChatMessages(
 	ctx context.Context,
 	query *store.MessageQuery,
 	pageSize int,
 	cursor *Cursor,
 ) ([]Message, *Cursor, error) {
-	lastCreatedAt := time.Now().AddDate(100, 0, 0)
+	c := Cursor{LastCreatedAt: time.Now().AddDate(100, 0, 0), PageSize: pageSize}
 	if cursor != nil {
 		if err := cursor.Validate(); err != nil {
 			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
 		}
-		pageSize, lastCreatedAt = cursor.PageSize, cursor.LastCreatedAt
+		c = *cursor
 	} else {
 		if err := validatePageSize(pageSize); err != nil {
 			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidPageSize, err)
 		}
 	}
 
+	if c.Forward {
+		msgs, hasMore, err := selectNewerMessages(ctx, query, c.LastCreatedAt, c.LastID, c.PageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !hasMore {
+			return msgs, nil, nil
+		}
+		return msgs, newNewerCursor(msgs[0], c.PageSize), nil
+	}
+
+	msgs, hasMore, err := selectOlderMessages(ctx, query, keysetBefore(c.LastCreatedAt, c.LastID), c.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hasMore {
+		return msgs, nil, nil
+	}
+	return msgs, newOlderCursor(msgs[len(msgs)-1], c.PageSize), nil
+}
+
+func (r *Repo) getChatMessagesAround(
+	ctx context.Context,
+	query *store.MessageQuery,
+	msgID types.MessageID,
+	pageSize int,
+) (msgs []Message, older, newer *Cursor, err error) {
+	if err := validatePageSize(pageSize); err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %v", ErrInvalidPageSize, err)
+	}
+
+	target, err := query.Clone().Where(message.ID(msgID)).Only(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, nil, nil, ErrMsgNotFound
+		}
+		return nil, nil, nil, fmt.Errorf("select message: %v", err)
+	}
+
+	// The older half includes the message itself.
+	newerCount := pageSize / 2
+
+	newerMsgs, hasNewer, err := selectNewerMessages(ctx, query.Clone(), target.CreatedAt, target.ID, newerCount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	olderMsgs, hasOlder, err := selectOlderMessages(ctx, query,
+		message.Or(message.ID(target.ID), keysetBefore(target.CreatedAt, target.ID)), pageSize-newerCount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if hasOlder {
+		older = newOlderCursor(olderMsgs[len(olderMsgs)-1], pageSize)
+	}
+	if hasNewer {
+		newer = newNewerCursor(newerMsgs[0], pageSize)
+	}
+	return append(newerMsgs, olderMsgs...), older, newer, nil
+}
+
+func newOlderCursor(last Message, pageSize int) *Cursor {
+	return &Cursor{
+		LastCreatedAt: last.CreatedAt,
+		LastID:        last.ID,
+		PageSize:      pageSize,
+	}
+}
+
+func newNewerCursor(first Message, pageSize int) *Cursor {
+	return &Cursor{
+		LastCreatedAt: first.CreatedAt,
+		LastID:        first.ID,
+		PageSize:      pageSize,
+		Forward:       true,
+	}
+}
+
+// problemMessages matches the messages of the problem and the internal notes of the earlier problems of the chat,
+// so the notes are carried over to the next manager when the chat is handed over or reopened as the new problem.
+func problemMessages(problemID types.ProblemID) predicate.Message {
+	return message.Or(
+		message.ProblemID(problemID),
+		func(s *sql.Selector) {
+			t := sql.Table(problem.Table)
+			s.Where(sql.And(
+				sql.IsTrue(s.C(message.FieldIsInternalNote)),
+				sql.Exists(sql.Select(t.C(problem.FieldID)).From(t).Where(sql.And(
+					sql.EQ(t.C(problem.FieldID), problemID),
+					sql.ColumnsEQ(t.C(problem.FieldChatID), s.C(message.FieldChatID)),
+					sql.ColumnsGT(t.C(problem.FieldCreatedAt), s.C(message.FieldCreatedAt)),
+				))),
+			))
+		},
+	)
+}
+
+// keysetBefore matches the messages preceding the (createdAt, id) key.
+func keysetBefore(createdAt time.Time, id types.MessageID) predicate.Message {
+	return func(s *sql.Selector) {
+		s.Where(sql.CompositeLT([]string{s.C(message.FieldCreatedAt), s.C(message.FieldID)}, createdAt, id))
+	}
+}
+
+// keysetAfter matches the messages following the (createdAt, id) key.
+func keysetAfter(createdAt time.Time, id types.MessageID) predicate.Message {
+	return func(s *sql.Selector) {
+		s.Where(sql.CompositeGT([]string{s.C(message.FieldCreatedAt), s.C(message.FieldID)}, createdAt, id))
+	}
+}
+
+// selectOlderMessages returns up to limit the newest messages matching the predicate, from the newest to the oldest.
+func selectOlderMessages(
+	ctx context.Context,
+	query *store.MessageQuery,
+	keyset predicate.Message,
+	limit int,
+) ([]Message, bool, error) {
 	msgs, err := query.
-		Where(message.CreatedAtLT(lastCreatedAt)).
-		Order(store.Desc(message.FieldCreatedAt)).
-		Limit(pageSize + 1).
+		Where(keyset).
+		Order(store.Desc(message.FieldCreatedAt, message.FieldID)).
+		Limit(limit + 1).
+		WithAttachments(withAttachmentsOrdered).
+		WithReactions(withReactionsOrdered).
+		WithReplyTo().
 		All(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("select messages: %v", err)
+		return nil, false, fmt.Errorf("select messages: %v", err)
 	}
 
 	result := make([]Message, 0, len(msgs))
@@ -106,13 +289,41 @@ func (r *Repo) getChatMessages(
 		result = append(result, adaptStoreMessage(m))
 	}
 
-	if len(result) <= pageSize {
-		return result, nil, nil
+	if len(result) <= limit {
+		return result, false, nil
 	}
+	return result[:limit], true, nil
+}
 
-	result = result[:len(result)-1]
-	return result, &Cursor{
-		LastCreatedAt: result[len(result)-1].CreatedAt,
-		PageSize:      pageSize,
-	}, nil
+// selectNewerMessages returns up to limit the oldest messages following the (createdAt, id) key,
+// from the newest to the oldest.
+func selectNewerMessages(
+	ctx context.Context,
+	query *store.MessageQuery,
+	createdAt time.Time,
+	id types.MessageID,
+	limit int,
+) ([]Message, bool, error) {
+	msgs, err := query.
+		Where(keysetAfter(createdAt, id)).
+		Order(store.Asc(message.FieldCreatedAt, message.FieldID)).
+		Limit(limit + 1).
+		WithAttachments(withAttachmentsOrdered).
+		WithReactions(withReactionsOrdered).
+		WithReplyTo().
+		All(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("select messages: %v", err)
+	}
+
+	hasMore := len(msgs) > limit
+	if hasMore {
+		msgs = msgs[:limit]
+	}
+
+	result := make([]Message, 0, len(msgs))
+	for i := len(msgs) - 1; i >= 0; i-- {
+		result = append(result, adaptStoreMessage(msgs[i]))
+	}
+	return result, hasMore, nil
 }
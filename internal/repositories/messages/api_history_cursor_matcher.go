@@ -24,9 +24,12 @@ func (cm CursorMatcher) Matches(x any) bool {
 	}
 
 	return cm.c.PageSize == v.PageSize &&
-		cm.c.LastCreatedAt.Equal(v.LastCreatedAt)
+		cm.c.LastCreatedAt.Equal(v.LastCreatedAt) &&
+		cm.c.LastID == v.LastID &&
+		cm.c.Forward == v.Forward
 }
 
 func (cm CursorMatcher) String() string {
-	return fmt.Sprintf("{ps=%d, last_created_at=%d}", cm.c.PageSize, cm.c.LastCreatedAt.UnixNano())
+	return fmt.Sprintf("{ps=%d, last_created_at=%d, last_id=%s, forward=%t}",
+		cm.c.PageSize, cm.c.LastCreatedAt.UnixNano(), cm.c.LastID, cm.c.Forward)
 }
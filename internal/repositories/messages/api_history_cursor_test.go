@@ -0,0 +1,25 @@
+package messagesrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+func TestBackCursor(t *testing.T) {
+	newest, oldest := time.Unix(2, 0), time.Unix(1, 0)
+	newestID, oldestID := types.NewMessageID(), types.NewMessageID()
+	msgs := []messagesrepo.Message{{ID: newestID, CreatedAt: newest}, {ID: oldestID, CreatedAt: oldest}}
+
+	assert.Nil(t, messagesrepo.BackCursor(nil, msgs))
+	assert.Nil(t, messagesrepo.BackCursor(&messagesrepo.Cursor{LastCreatedAt: newest, PageSize: 10}, nil))
+
+	assert.Equal(t, &messagesrepo.Cursor{LastCreatedAt: newest, LastID: newestID, PageSize: 10, Forward: true},
+		messagesrepo.BackCursor(&messagesrepo.Cursor{LastCreatedAt: time.Unix(3, 0), PageSize: 10}, msgs))
+	assert.Equal(t, &messagesrepo.Cursor{LastCreatedAt: oldest, LastID: oldestID, PageSize: 10},
+		messagesrepo.BackCursor(&messagesrepo.Cursor{LastCreatedAt: time.Unix(0, 0), PageSize: 10, Forward: true}, msgs))
+}
@@ -3,7 +3,11 @@
 package messagesrepo_test
 
 import (
+	"cmp"
 	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -116,6 +120,7 @@ func (s *MsgRepoHistoryAPISuite) Test_GetClientChatMessages() {
 						expectedCursors = append(expectedCursors, cursor{
 							PageSize:                 pageSize,
 							LastCreatedAtAsUnixMilli: last.CreatedAtAsUnixMilli,
+							LastID:                   last.ID,
 						})
 					}
 				}
@@ -243,6 +248,7 @@ func (s *MsgRepoHistoryAPISuite) Test_GetProblemMessages() {
 						expectedCursors = append(expectedCursors, cursor{
 							PageSize:                 pageSize,
 							LastCreatedAtAsUnixMilli: last.CreatedAtAsUnixMilli,
+							LastID:                   last.ID,
 						})
 					}
 				}
@@ -276,6 +282,231 @@ func (s *MsgRepoHistoryAPISuite) Test_GetProblemMessages() {
 	})
 }
 
+func (s *MsgRepoHistoryAPISuite) Test_GetProblemMessages_EarlierChatNotes() {
+	// Arrange.
+	client := types.NewUserID()
+	manager := types.NewUserID()
+	problem1, chat := s.createProblemAndChat(client)
+	msg1 := s.createMessages(1, chat, problem1, client, true, true, false)[0]
+	note1, err := s.repo.CreateInternalNote(s.Ctx, types.NewRequestID(), problem1, chat, manager, "first note")
+	s.Require().NoError(err)
+	time.Sleep(time.Millisecond)
+
+	problem2, err := s.Database.Problem(s.Ctx).Create().SetChatID(chat).Save(s.Ctx)
+	s.Require().NoError(err)
+	msg2 := s.createMessages(1, chat, problem2.ID, client, true, true, false)[0]
+	note2, err := s.repo.CreateInternalNote(s.Ctx, types.NewRequestID(), problem2.ID, chat, manager, "second note")
+	s.Require().NoError(err)
+
+	// Notes of other chats must be ignored.
+	otherProblem, otherChat := s.createProblemAndChat(types.NewUserID())
+	_, err = s.repo.CreateInternalNote(s.Ctx, types.NewRequestID(), otherProblem, otherChat, manager, "other note")
+	s.Require().NoError(err)
+
+	// Action.
+	msgs2, _, err := s.repo.GetProblemMessages(s.Ctx, problem2.ID, 10, nil)
+	s.Require().NoError(err)
+	msgs1, _, err := s.repo.GetProblemMessages(s.Ctx, problem1, 10, nil)
+	s.Require().NoError(err)
+
+	// Assert.
+	ids := func(msgs []messagesrepo.Message) []types.MessageID {
+		return apply[messagesrepo.Message, types.MessageID](msgs, func(m messagesrepo.Message) types.MessageID { return m.ID })
+	}
+	s.Equal([]types.MessageID{note2.ID, msg2.ID, note1.ID}, ids(msgs2))
+	s.Equal([]types.MessageID{note1.ID, msg1.ID}, ids(msgs1))
+}
+
+func (s *MsgRepoHistoryAPISuite) Test_ForwardCursor() {
+	client := types.NewUserID()
+	problem, chat := s.createProblemAndChat(client)
+	preparedMsgs := apply[*store.Message, msg](
+		s.createMessages(25, chat, problem, client, true, true, false), newMsgFromStoreMsg)
+
+	// Go to the oldest page and back to the newest one.
+	_, older, err := s.repo.GetClientChatMessages(s.Ctx, client, 10, nil)
+	s.Require().NoError(err)
+	msgs, older, err := s.repo.GetClientChatMessages(s.Ctx, client, 0, older)
+	s.Require().NoError(err)
+	s.Equal(preparedMsgs[10:20], apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg))
+
+	msgs, _, err = s.repo.GetClientChatMessages(s.Ctx, client, 0, older)
+	s.Require().NoError(err)
+	s.Equal(preparedMsgs[20:], apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg))
+
+	newer := messagesrepo.BackCursor(older, msgs)
+	s.Require().NotNil(newer)
+	s.True(newer.Forward)
+
+	msgs, newer, err = s.repo.GetClientChatMessages(s.Ctx, client, 0, newer)
+	s.Require().NoError(err)
+	s.Equal(preparedMsgs[10:20], apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg))
+	s.Require().NotNil(newer)
+
+	msgs, newer, err = s.repo.GetProblemMessages(s.Ctx, problem, 0, newer)
+	s.Require().NoError(err)
+	s.Equal(preparedMsgs[:10], apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg))
+	s.Nil(newer)
+}
+
+func (s *MsgRepoHistoryAPISuite) Test_GetMessagesAround() {
+	s.Run("invalid page size", func() {
+		_, _, _, err := s.repo.GetClientChatMessagesAround(s.Ctx, types.NewUserID(), types.NewMessageID(), 9)
+		s.Require().ErrorIs(err, messagesrepo.ErrInvalidPageSize)
+	})
+
+	s.Run("message not found", func() {
+		_, _, _, err := s.repo.GetProblemMessagesAround(s.Ctx, types.NewProblemID(), types.NewMessageID(), 10)
+		s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+	})
+
+	client := types.NewUserID()
+	problem, chat := s.createProblemAndChat(client)
+	preparedMsgs := apply[*store.Message, msg](
+		s.createMessages(30, chat, problem, client, true, true, false), newMsgFromStoreMsg)
+
+	s.Run("invisible message", func() {
+		hidden := s.createMessages(1, chat, problem, client, false, true, false)[0]
+		_, _, _, err := s.repo.GetClientChatMessagesAround(s.Ctx, client, hidden.ID, 10)
+		s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+	})
+
+	s.Run("middle of history", func() {
+		msgs, older, newer, err := s.repo.GetClientChatMessagesAround(s.Ctx, client, preparedMsgs[15].ID, 10)
+		s.Require().NoError(err)
+		s.Equal(preparedMsgs[10:20], apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg))
+		s.Require().NotNil(older)
+		s.False(older.Forward)
+		s.Require().NotNil(newer)
+		s.True(newer.Forward)
+
+		msgs, _, err = s.repo.GetClientChatMessages(s.Ctx, client, 0, newer)
+		s.Require().NoError(err)
+		s.Equal(preparedMsgs[:10], apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg))
+
+		msgs, _, err = s.repo.GetClientChatMessages(s.Ctx, client, 0, older)
+		s.Require().NoError(err)
+		s.Equal(preparedMsgs[20:30], apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg))
+	})
+
+	s.Run("newest message", func() {
+		msgs, older, newer, err := s.repo.GetProblemMessagesAround(s.Ctx, problem, preparedMsgs[0].ID, 10)
+		s.Require().NoError(err)
+		// The message hidden from the client is the newest one for the manager.
+		s.Require().Len(msgs, 6)
+		s.Equal(preparedMsgs[:5], apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg)[1:])
+		s.NotNil(older)
+		s.Nil(newer)
+	})
+}
+
+func (s *MsgRepoHistoryAPISuite) Test_CollidingTimestamps() {
+	seed := time.Now().UnixNano()
+	s.T().Logf("seed: %d", seed)
+	rnd := rand.New(rand.NewSource(seed)) //nolint:gosec // Test data.
+
+	client := types.NewUserID()
+	problem, chat := s.createProblemAndChat(client)
+
+	// Many messages share a few timestamps, like the service messages created in one transaction.
+	timestamps := make([]time.Time, 5)
+	for i := range timestamps {
+		timestamps[i] = time.Now().Add(-time.Duration(i) * time.Minute).Truncate(time.Microsecond)
+	}
+
+	preparedMsgs := make([]msg, 0, 73)
+	for i := 0; i < cap(preparedMsgs); i++ {
+		m, err := s.Database.Message(s.Ctx).Create().
+			SetChatID(chat).
+			SetProblemID(problem).
+			SetAuthorID(client).
+			SetIsVisibleForClient(true).
+			SetIsVisibleForManager(true).
+			SetBody(fmt.Sprintf("message #%d", i)).
+			SetInitialRequestID(types.NewRequestID()).
+			SetCreatedAt(timestamps[rnd.Intn(len(timestamps))]).
+			Save(s.Ctx)
+		s.Require().NoError(err)
+		preparedMsgs = append(preparedMsgs, newMsgFromStoreMsg(m))
+	}
+
+	// From the newest to the oldest by the (created_at, id) key.
+	slices.SortFunc(preparedMsgs, func(a, b msg) int {
+		if c := cmp.Compare(b.CreatedAtAsUnixMilli, a.CreatedAtAsUnixMilli); c != 0 {
+			return c
+		}
+		return strings.Compare(b.ID.String(), a.ID.String())
+	})
+
+	for _, pageSize := range []int{10, 11, 13, 25, 100} {
+		s.Run(fmt.Sprintf("page size %d", pageSize), func() {
+			// Go to the oldest page.
+			var (
+				older   []msg
+				oldest  []messagesrepo.Message
+				c       *messagesrepo.Cursor
+				visited int
+			)
+			for {
+				msgs, next, err := s.repo.GetClientChatMessages(s.Ctx, client, pageSize, c)
+				s.Require().NoError(err)
+				s.Require().NotEmpty(msgs)
+				older = append(older, apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg)...)
+
+				visited++
+				s.Require().LessOrEqual(visited, len(preparedMsgs))
+
+				if next == nil {
+					oldest = msgs
+					break
+				}
+				c = next
+			}
+			s.Equal(preparedMsgs, older)
+
+			// And back to the newest one.
+			newer := apply[messagesrepo.Message, msg](oldest, newMsgFromRepoMsg)
+			if c = messagesrepo.BackCursor(c, oldest); c != nil {
+				for {
+					msgs, next, err := s.repo.GetProblemMessages(s.Ctx, problem, 0, c)
+					s.Require().NoError(err)
+					s.Require().NotEmpty(msgs)
+					newer = append(apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg), newer...)
+
+					if next == nil {
+						break
+					}
+					c = next
+				}
+			}
+			s.Equal(preparedMsgs, newer)
+		})
+	}
+
+	s.Run("around", func() {
+		for _, target := range []int{0, 17, 36, 55, 72} {
+			msgs, older, newer, err := s.repo.GetClientChatMessagesAround(s.Ctx, client, preparedMsgs[target].ID, 10)
+			s.Require().NoError(err)
+
+			got := apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg)
+			from := max(0, target-5)
+			s.Equal(preparedMsgs[from:from+len(got)], got)
+
+			if newer != nil {
+				msgs, _, err := s.repo.GetClientChatMessages(s.Ctx, client, 0, newer)
+				s.Require().NoError(err)
+				s.Equal(preparedMsgs[max(0, from-10):from], apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg))
+			}
+			if older != nil {
+				msgs, _, err := s.repo.GetClientChatMessages(s.Ctx, client, 0, older)
+				s.Require().NoError(err)
+				to := from + len(got)
+				s.Equal(preparedMsgs[to:min(len(preparedMsgs), to+10)], apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg))
+			}
+		}
+	})
+}
+
 func (s *MsgRepoHistoryAPISuite) createProblemAndChat(clientID types.UserID) (types.ProblemID, types.ChatID) {
 	s.T().Helper()
 
@@ -350,6 +581,7 @@ func newMsgFromStoreMsg(m *store.Message) msg {
 type cursor struct {
 	PageSize                 int
 	LastCreatedAtAsUnixMilli int64
+	LastID                   types.MessageID
 }
 
 func (s *MsgRepoHistoryAPISuite) getClientChatMessagesWhileCursor(clientID types.UserID, pageSize int) ([][]msg, []cursor) {
@@ -374,6 +606,7 @@ func (s *MsgRepoHistoryAPISuite) getClientChatMessagesWhileCursor(clientID types
 		cursors = append(cursors, cursor{
 			PageSize:                 next.PageSize,
 			LastCreatedAtAsUnixMilli: next.LastCreatedAt.UnixMilli(),
+			LastID:                   next.LastID,
 		})
 	}
 
@@ -402,6 +635,7 @@ func (s *MsgRepoHistoryAPISuite) getProblemMessagesWhileCursor(problemID types.P
 		cursors = append(cursors, cursor{
 			PageSize:                 next.PageSize,
 			LastCreatedAtAsUnixMilli: next.LastCreatedAt.UnixMilli(),
+			LastID:                   next.LastID,
 		})
 	}
 
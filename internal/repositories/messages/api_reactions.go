@@ -0,0 +1,83 @@
+package messagesrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/store/messagereaction"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// AddReaction puts the user's reaction on the message.
+// It returns false if the user has already put the reaction of this kind.
+func (r *Repo) AddReaction(
+	ctx context.Context,
+	msgID types.MessageID,
+	userID types.UserID,
+	kind ReactionKind,
+) (bool, error) {
+	err := r.db.MessageReaction(ctx).Create().
+		SetMessageID(msgID).
+		SetUserID(userID).
+		SetKind(messagereaction.Kind(kind)).
+		OnConflictColumns(
+			messagereaction.FieldMessageID,
+			messagereaction.FieldUserID,
+			messagereaction.FieldKind,
+		).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		// Nothing is returned by the insert if the reaction exists.
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("create reaction: %v", err)
+	}
+	return true, nil
+}
+
+// RemoveReaction takes the user's reaction off the message.
+// It returns false if the user has not put the reaction of this kind.
+func (r *Repo) RemoveReaction(
+	ctx context.Context,
+	msgID types.MessageID,
+	userID types.UserID,
+	kind ReactionKind,
+) (bool, error) {
+	n, err := r.db.MessageReaction(ctx).Delete().
+		Where(
+			messagereaction.MessageID(msgID),
+			messagereaction.UserID(userID),
+			messagereaction.KindEQ(messagereaction.Kind(kind)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("delete reaction: %v", err)
+	}
+	return n > 0, nil
+}
+
+// GetMessageReactions returns the reactions on the message in order of their appearance.
+func (r *Repo) GetMessageReactions(ctx context.Context, msgID types.MessageID) ([]Reaction, error) {
+	reactions, err := r.db.MessageReaction(ctx).Query().
+		Where(messagereaction.MessageID(msgID)).
+		Order(store.Asc(messagereaction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("select reactions: %v", err)
+	}
+
+	result := make([]Reaction, 0, len(reactions))
+	for _, rr := range reactions {
+		result = append(result, adaptStoreReaction(rr))
+	}
+	return result, nil
+}
+
+func withReactionsOrdered(q *store.MessageReactionQuery) {
+	q.Order(store.Asc(messagereaction.FieldCreatedAt))
+}
@@ -0,0 +1,131 @@
+//go:build integration
+
+package messagesrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type MsgRepoReactionsAPISuite struct {
+	testingh.DBSuite
+	repo *messagesrepo.Repo
+}
+
+func TestMsgRepoReactionsAPISuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &MsgRepoReactionsAPISuite{DBSuite: testingh.NewDBSuite("TestMsgRepoReactionsAPISuite")})
+}
+
+func (s *MsgRepoReactionsAPISuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = messagesrepo.New(messagesrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *MsgRepoReactionsAPISuite) TestAddReaction() {
+	// Arrange.
+	msgID, clientID := s.createMessage()
+	managerID := types.NewUserID()
+
+	// Action.
+	added, err := s.repo.AddReaction(s.Ctx, msgID, clientID, messagesrepo.ReactionKindThumbsUp)
+	s.Require().NoError(err)
+	s.True(added)
+
+	added, err = s.repo.AddReaction(s.Ctx, msgID, managerID, messagesrepo.ReactionKindHeart)
+	s.Require().NoError(err)
+	s.True(added)
+
+	added, err = s.repo.AddReaction(s.Ctx, msgID, clientID, messagesrepo.ReactionKindThumbsUp)
+	s.Require().NoError(err)
+	s.False(added)
+
+	// Assert.
+	reactions, err := s.repo.GetMessageReactions(s.Ctx, msgID)
+	s.Require().NoError(err)
+	s.Require().Len(reactions, 2)
+	s.Equal(clientID, reactions[0].UserID)
+	s.Equal(messagesrepo.ReactionKindThumbsUp, reactions[0].Kind)
+	s.Equal(managerID, reactions[1].UserID)
+	s.Equal(messagesrepo.ReactionKindHeart, reactions[1].Kind)
+}
+
+func (s *MsgRepoReactionsAPISuite) TestRemoveReaction() {
+	// Arrange.
+	msgID, clientID := s.createMessage()
+	_, err := s.repo.AddReaction(s.Ctx, msgID, clientID, messagesrepo.ReactionKindThumbsUp)
+	s.Require().NoError(err)
+	_, err = s.repo.AddReaction(s.Ctx, msgID, clientID, messagesrepo.ReactionKindLaugh)
+	s.Require().NoError(err)
+
+	// Action.
+	removed, err := s.repo.RemoveReaction(s.Ctx, msgID, clientID, messagesrepo.ReactionKindThumbsUp)
+	s.Require().NoError(err)
+	s.True(removed)
+
+	removed, err = s.repo.RemoveReaction(s.Ctx, msgID, clientID, messagesrepo.ReactionKindThumbsUp)
+	s.Require().NoError(err)
+	s.False(removed)
+
+	// Assert.
+	reactions, err := s.repo.GetMessageReactions(s.Ctx, msgID)
+	s.Require().NoError(err)
+	s.Require().Len(reactions, 1)
+	s.Equal(messagesrepo.ReactionKindLaugh, reactions[0].Kind)
+}
+
+func (s *MsgRepoReactionsAPISuite) TestHistoryReactions() {
+	// Arrange.
+	msgID, clientID := s.createMessage()
+	_, err := s.repo.AddReaction(s.Ctx, msgID, clientID, messagesrepo.ReactionKindSad)
+	s.Require().NoError(err)
+
+	// Action.
+	msgs, _, err := s.repo.GetClientChatMessages(s.Ctx, clientID, 10, nil)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Require().Len(msgs, 1)
+	s.Require().Len(msgs[0].Reactions, 1)
+	s.Equal(messagesrepo.ReactionKindSad, msgs[0].Reactions[0].Kind)
+
+	// The reactions of the deleted message are not exposed.
+	_, err = s.repo.DeleteMessage(s.Ctx, types.NewRequestID(), msgID, clientID, messagesrepo.DeleterRoleClient)
+	s.Require().NoError(err)
+
+	msgs, _, err = s.repo.GetClientChatMessages(s.Ctx, clientID, 10, nil)
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 1)
+	s.Empty(msgs[0].Reactions)
+}
+
+func (s *MsgRepoReactionsAPISuite) createMessage() (types.MessageID, types.UserID) {
+	s.T().Helper()
+
+	clientID := types.NewUserID()
+	chat := s.Database.Chat(s.Ctx).Create().SetClientID(clientID).SaveX(s.Ctx)
+	problem := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).SaveX(s.Ctx)
+
+	msg := s.Database.Message(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetAuthorID(types.NewUserID()).
+		SetProblemID(problem.ID).
+		SetBody(msgBody).
+		SetIsVisibleForClient(true).
+		SetIsVisibleForManager(true).
+		SetCheckedAt(time.Now()).
+		SetInitialRequestID(types.NewRequestID()).
+		SaveX(s.Ctx)
+
+	return msg.ID, clientID
+}
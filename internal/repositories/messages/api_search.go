@@ -0,0 +1,116 @@
+package messagesrepo
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const (
+	// The markers are replaced with the HTML tags after the body is escaped.
+	// They are removed from the body before the highlighting.
+	snippetMarkStart = "\x02"
+	snippetMarkStop  = "\x03"
+
+	snippetOptions = "StartSel=" + snippetMarkStart + ", StopSel=" + snippetMarkStop +
+		", MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=\" … \""
+)
+
+type FoundMessage struct {
+	ID        types.MessageID
+	ChatID    types.ChatID
+	ProblemID types.ProblemID
+	AuthorID  types.UserID
+	CreatedAt time.Time
+	Snippet   string // HTML-escaped fragments of the body, the matched words are wrapped into <mark></mark>.
+}
+
+// SearchManagerMessages performs the full-text search over the messages of the chats the manager has handled.
+// The messages are returned from the newest to the oldest and paginated in the same way as the history.
+func (r *Repo) SearchManagerMessages(
+	ctx context.Context,
+	managerID types.UserID,
+	query string,
+	pageSize int,
+	cursor *Cursor,
+) ([]FoundMessage, *Cursor, error) {
+	c := Cursor{LastCreatedAt: time.Now().AddDate(100, 0, 0), PageSize: pageSize}
+	if cursor != nil {
+		if err := cursor.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+		}
+		c = *cursor
+	} else {
+		if err := validatePageSize(pageSize); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidPageSize, err)
+		}
+	}
+
+	const sqlQuery = `
+	with "q" as (
+		select websearch_to_tsquery('russian', $2) || websearch_to_tsquery('english', $2) as "query"
+	)
+	select
+		"m"."id",
+		"m"."chat_id",
+		"m"."problem_id",
+		"m"."author_id",
+		"m"."created_at",
+		ts_headline('russian', translate("m"."body", chr(2) || chr(3), ''), "q"."query", $5)
+	from "messages" as "m", "q"
+	where "m"."body_tsv" @@ "q"."query"
+		and "m"."is_visible_for_manager"
+		and "m"."deleted_at" is null
+		and ("m"."created_at", "m"."id") < ($3, $6)
+		and exists (
+			select 1 from "problems" as "p"
+			where "p"."chat_id" = "m"."chat_id" and "p"."manager_id" = $1
+		)
+	order by "m"."created_at" desc, "m"."id" desc
+	limit $4;`
+
+	rows, err := r.db.Message(ctx).QueryContext(ctx, sqlQuery,
+		managerID, query, c.LastCreatedAt, c.PageSize+1, snippetOptions, c.LastID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query context: %v", err)
+	}
+	defer rows.Close()
+
+	result := make([]FoundMessage, 0, c.PageSize+1)
+	for rows.Next() {
+		var (
+			m       FoundMessage
+			snippet string
+		)
+		if err := rows.Scan(&m.ID, &m.ChatID, &m.ProblemID, &m.AuthorID, &m.CreatedAt, &snippet); err != nil {
+			return nil, nil, fmt.Errorf("scan message: %v", err)
+		}
+		m.Snippet = highlightSnippet(snippet)
+		result = append(result, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("rows err: %v", err)
+	}
+
+	if len(result) <= c.PageSize {
+		return result, nil, nil
+	}
+
+	result = result[:len(result)-1]
+	return result, &Cursor{
+		LastCreatedAt: result[len(result)-1].CreatedAt,
+		LastID:        result[len(result)-1].ID,
+		PageSize:      c.PageSize,
+	}, nil
+}
+
+func highlightSnippet(s string) string {
+	return strings.NewReplacer(
+		snippetMarkStart, "<mark>",
+		snippetMarkStop, "</mark>",
+	).Replace(html.EscapeString(s))
+}
@@ -0,0 +1,167 @@
+//go:build integration
+
+package messagesrepo_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type MsgRepoSearchAPISuite struct {
+	testingh.DBSuite
+	repo *messagesrepo.Repo
+}
+
+func TestMsgRepoSearchAPISuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &MsgRepoSearchAPISuite{DBSuite: testingh.NewDBSuite("TestMsgRepoSearchAPISuite")})
+}
+
+func (s *MsgRepoSearchAPISuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+	s.repo, err = messagesrepo.New(messagesrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *MsgRepoSearchAPISuite) Test_SearchManagerMessages() {
+	s.Run("invalid page size", func() {
+		msgs, next, err := s.repo.SearchManagerMessages(s.Ctx, types.NewUserID(), "card", 9, nil)
+		s.Require().ErrorIs(err, messagesrepo.ErrInvalidPageSize)
+		s.Nil(next)
+		s.Empty(msgs)
+	})
+
+	s.Run("invalid cursor", func() {
+		msgs, next, err := s.repo.SearchManagerMessages(s.Ctx, types.NewUserID(), "card", 0, &messagesrepo.Cursor{
+			PageSize: 10,
+		})
+		s.Require().ErrorIs(err, messagesrepo.ErrInvalidCursor)
+		s.Nil(next)
+		s.Empty(msgs)
+	})
+
+	s.Run("russian and english with highlighting", func() {
+		managerID := types.NewUserID()
+		chatID, problemID := s.createHandledChat(managerID)
+
+		ruMsg := s.createMessage(chatID, problemID, "Не могу оплатить картой <script>", true, false)
+		enMsg := s.createMessage(chatID, problemID, "My cards are blocked", true, false)
+		s.createMessage(chatID, problemID, "Hello!", true, false)
+
+		found, next, err := s.repo.SearchManagerMessages(s.Ctx, managerID, "карта", 10, nil)
+		s.Require().NoError(err)
+		s.Nil(next)
+		s.Require().Len(found, 1)
+		s.Equal(ruMsg, found[0].ID)
+		s.Equal(chatID, found[0].ChatID)
+		s.Equal(problemID, found[0].ProblemID)
+		s.Contains(found[0].Snippet, "оплатить <mark>картой</mark>")
+		s.NotContains(found[0].Snippet, "<script>")
+
+		found, _, err = s.repo.SearchManagerMessages(s.Ctx, managerID, "card", 10, nil)
+		s.Require().NoError(err)
+		s.Require().Len(found, 1)
+		s.Equal(enMsg, found[0].ID)
+		s.Contains(found[0].Snippet, "My <mark>cards</mark> are blocked")
+	})
+
+	s.Run("only chats handled by manager", func() {
+		managerID := types.NewUserID()
+		chatID, problemID := s.createHandledChat(managerID)
+		handled := s.createMessage(chatID, problemID, "transfer is stuck", true, false)
+
+		// The other problem of the same chat is handled by another manager.
+		otherProblem := s.Database.Problem(s.Ctx).Create().
+			SetChatID(chatID).
+			SetManagerID(types.NewUserID()).
+			SetResolvedAt(time.Now()).
+			SaveX(s.Ctx)
+		otherProblemMsg := s.createMessage(chatID, otherProblem.ID, "another transfer", true, false)
+
+		// Other chats, invisible and deleted messages are ignored.
+		otherChatID, otherChatProblemID := s.createHandledChat(types.NewUserID())
+		s.createMessage(otherChatID, otherChatProblemID, "transfer", true, false)
+		s.createMessage(chatID, problemID, "blocked transfer", false, false)
+		s.createMessage(chatID, problemID, "deleted transfer", true, true)
+
+		found, _, err := s.repo.SearchManagerMessages(s.Ctx, managerID, "transfer", 10, nil)
+		s.Require().NoError(err)
+		s.Require().Len(found, 2)
+		s.Equal(otherProblemMsg, found[0].ID)
+		s.Equal(handled, found[1].ID)
+	})
+
+	s.Run("pagination", func() {
+		const messagesCount = 25
+		managerID := types.NewUserID()
+		chatID, problemID := s.createHandledChat(managerID)
+
+		for i := 0; i < messagesCount; i++ {
+			s.createMessage(chatID, problemID, fmt.Sprintf("payment #%d", i), true, false)
+		}
+
+		var (
+			total  int
+			cursor *messagesrepo.Cursor
+			pages  int
+		)
+		for {
+			pageSize := 10
+			if cursor != nil {
+				pageSize = 0
+			}
+
+			found, next, err := s.repo.SearchManagerMessages(s.Ctx, managerID, "payments", pageSize, cursor)
+			s.Require().NoError(err)
+			total += len(found)
+			pages++
+
+			if next == nil {
+				break
+			}
+			cursor = next
+		}
+		s.Equal(messagesCount, total)
+		s.Equal(3, pages)
+	})
+}
+
+func (s *MsgRepoSearchAPISuite) createHandledChat(managerID types.UserID) (types.ChatID, types.ProblemID) {
+	s.T().Helper()
+
+	chat := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).SaveX(s.Ctx)
+	problem := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).SetManagerID(managerID).SaveX(s.Ctx)
+	return chat.ID, problem.ID
+}
+
+func (s *MsgRepoSearchAPISuite) createMessage(
+	chatID types.ChatID,
+	problemID types.ProblemID,
+	body string,
+	visibleForManager bool,
+	deleted bool,
+) types.MessageID {
+	s.T().Helper()
+
+	q := s.Database.Message(s.Ctx).Create().
+		SetChatID(chatID).
+		SetAuthorID(types.NewUserID()).
+		SetProblemID(problemID).
+		SetBody(body).
+		SetIsVisibleForClient(true).
+		SetIsVisibleForManager(visibleForManager).
+		SetInitialRequestID(types.NewRequestID())
+	if deleted {
+		q.SetDeletedAt(time.Now())
+	}
+	return q.SaveX(s.Ctx).ID
+}
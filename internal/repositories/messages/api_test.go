@@ -4,6 +4,7 @@ package messagesrepo_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -185,7 +186,7 @@ func (s *MsgRepoAPISuite) Test_CreateClientVisible() {
 	initialRequestID := types.NewRequestID()
 
 	// Check message was created.
-	msg, err := s.repo.CreateClientVisible(s.Ctx, initialRequestID, problemID, chatID, authorID, msgBody)
+	msg, err := s.repo.CreateClientVisible(s.Ctx, initialRequestID, problemID, chatID, authorID, msgBody, types.MessageIDNil)
 	s.Require().NoError(err)
 	s.Require().NotNil(msg)
 	s.NotEmpty(msg.ID)
@@ -210,6 +211,7 @@ func (s *MsgRepoAPISuite) Test_CreateClientVisible() {
 
 		s.Run("initial_request_id is set correctly", func() {
 			s.Equal(initialRequestID, dbMsg.InitialRequestID)
+			s.Equal(initialRequestID, dbMsg.ContentRequestID)
 		})
 	}
 }
@@ -222,11 +224,11 @@ func (s *MsgRepoAPISuite) Test_CreateClientVisible_DuplicationError() {
 	initialRequestID := types.NewRequestID()
 
 	// Check message was created.
-	_, err := s.repo.CreateClientVisible(s.Ctx, initialRequestID, problemID, chatID, authorID, msgBody)
+	_, err := s.repo.CreateClientVisible(s.Ctx, initialRequestID, problemID, chatID, authorID, msgBody, types.MessageIDNil)
 	s.Require().NoError(err)
 
 	// Retry message creation.
-	_, err = s.repo.CreateClientVisible(s.Ctx, initialRequestID, problemID, chatID, authorID, msgBody)
+	_, err = s.repo.CreateClientVisible(s.Ctx, initialRequestID, problemID, chatID, authorID, msgBody, types.MessageIDNil)
 	s.Require().Error(err)
 }
 
@@ -238,7 +240,7 @@ func (s *MsgRepoAPISuite) Test_CreateFullVisible() {
 	initialRequestID := types.NewRequestID()
 
 	// Check message was created.
-	msg, err := s.repo.CreateFullVisible(s.Ctx, initialRequestID, problemID, chatID, authorID, msgBody)
+	msg, err := s.repo.CreateFullVisible(s.Ctx, initialRequestID, problemID, chatID, authorID, msgBody, types.MessageIDNil)
 	s.Require().NoError(err)
 	s.Require().NotNil(msg)
 	s.NotEmpty(msg.ID)
@@ -263,10 +265,72 @@ func (s *MsgRepoAPISuite) Test_CreateFullVisible() {
 
 		s.Run("initial_request_id is set correctly", func() {
 			s.Equal(initialRequestID, dbMsg.InitialRequestID)
+			s.Equal(initialRequestID, dbMsg.ContentRequestID)
 		})
 	}
 }
 
+func (s *MsgRepoAPISuite) Test_CreateInternalNote() {
+	managerID := types.NewUserID()
+
+	// Create chat and problem.
+	problemID, chatID := s.createProblemAndChat(types.NewUserID())
+	initialRequestID := types.NewRequestID()
+
+	// Check note was created.
+	msg, err := s.repo.CreateInternalNote(s.Ctx, initialRequestID, problemID, chatID, managerID, msgBody)
+	s.Require().NoError(err)
+	s.Require().NotNil(msg)
+	s.NotEmpty(msg.ID)
+	s.Equal(chatID, msg.ChatID)
+	s.Equal(problemID, msg.ProblemID)
+	s.Equal(managerID, msg.AuthorID)
+	s.Equal(msgBody, msg.Body)
+	s.False(msg.IsVisibleForClient)
+	s.True(msg.IsVisibleForManager)
+	s.True(msg.IsInternalNote)
+	s.False(msg.IsService)
+	s.Equal(initialRequestID, msg.InitialRequestID)
+
+	// Check the note is found by request.
+	found, err := s.repo.GetMessageByRequestID(s.Ctx, initialRequestID)
+	s.Require().NoError(err)
+	s.Equal(msg.ID, found.ID)
+	s.True(found.IsInternalNote)
+}
+
+func (s *MsgRepoAPISuite) Test_CreateFullVisible_Reply() {
+	clientID := types.NewUserID()
+	problemID, chatID := s.createProblemAndChat(clientID)
+
+	quoted, err := s.repo.CreateClientVisible(s.Ctx, types.NewRequestID(), problemID, chatID, clientID, msgBody, types.MessageIDNil)
+	s.Require().NoError(err)
+
+	managerID := types.NewUserID()
+	msg, err := s.repo.CreateFullVisible(s.Ctx, types.NewRequestID(), problemID, chatID, managerID, "Yes", quoted.ID)
+	s.Require().NoError(err)
+
+	m, err := s.repo.GetMessageByID(s.Ctx, msg.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(m.ReplyTo)
+	s.Equal(quoted.ID, m.ReplyTo.MessageID)
+	s.Equal(clientID, m.ReplyTo.AuthorID)
+	s.Equal(msgBody, m.ReplyTo.Preview)
+	s.True(m.ReplyTo.IsVisibleForClient)
+	s.False(m.ReplyTo.IsVisibleForManager)
+
+	s.Run("quoted message is deleted", func() {
+		err := s.Database.Message(s.Ctx).UpdateOneID(quoted.ID).SetDeletedAt(time.Now()).Exec(s.Ctx)
+		s.Require().NoError(err)
+
+		m, err := s.repo.GetMessageByID(s.Ctx, msg.ID)
+		s.Require().NoError(err)
+		s.Require().NotNil(m.ReplyTo)
+		s.Equal(quoted.ID, m.ReplyTo.MessageID)
+		s.Empty(m.ReplyTo.Preview)
+	})
+}
+
 func (s *MsgRepoAPISuite) Test_CreateServiceMessageForClient() {
 	requestID := types.NewRequestID()
 	problemID, chatID := s.createProblemAndChat(types.NewUserID())
@@ -290,6 +354,35 @@ func (s *MsgRepoAPISuite) Test_CreateServiceMessageForClient() {
 	}
 }
 
+func (s *MsgRepoAPISuite) Test_GetProblemFirstMessageID() {
+	s.Run("first client message", func() {
+		clientID := types.NewUserID()
+		problemID, chatID := s.createProblemAndChat(clientID)
+
+		createClientMsg := func() *messagesrepo.Message {
+			m, err := s.repo.CreateClientVisible(s.Ctx, types.NewRequestID(), problemID, chatID, clientID, msgBody, types.MessageIDNil)
+			s.Require().NoError(err)
+			return m
+		}
+
+		firstMsg := createClientMsg()
+		_, err := s.repo.CreateServiceMessageForClient(s.Ctx, types.NewRequestID(), problemID, chatID, "Manager will answer you.")
+		s.Require().NoError(err)
+		createClientMsg()
+
+		msgID, err := s.repo.GetProblemFirstMessageID(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Equal(firstMsg.ID, msgID)
+	})
+
+	s.Run("problem without messages", func() {
+		problemID, _ := s.createProblemAndChat(types.NewUserID())
+
+		_, err := s.repo.GetProblemFirstMessageID(s.Ctx, problemID)
+		s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+	})
+}
+
 func (s *MsgRepoAPISuite) createProblemAndChat(clientID types.UserID) (types.ProblemID, types.ChatID) {
 	s.T().Helper()
 
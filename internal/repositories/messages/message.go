@@ -5,32 +5,250 @@ import (
 
 	"github.com/zestagio/chat-service/internal/store"
 	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/pkg/strutil"
 )
 
 type Message struct {
 	ID                  types.MessageID
 	ChatID              types.ChatID
+	ProblemID           types.ProblemID
 	AuthorID            types.UserID
 	Body                string
 	CreatedAt           time.Time
+	EditedAt            time.Time // Zero if the message has not been edited.
+	DeletedAt           time.Time // Zero if the message has not been deleted.
 	IsVisibleForClient  bool
 	IsVisibleForManager bool
 	IsBlocked           bool
+	IsChecked           bool
+	Verdict             Verdict       // The applied decision. Empty if the message has not been checked.
+	VerdictSource       VerdictSource // Who made the applied decision.
 	IsService           bool
+	IsInternalNote      bool // The note of the managers, it is never shown to the client.
 	InitialRequestID    types.RequestID
+	Attachments         []Attachment // Loaded by the methods returning the messages to read.
+	Reactions           []Reaction   // Loaded by the methods returning the history.
+	ReplyTo             *Quote       // Nil if the message is not a reply. Loaded by the methods returning the messages to read.
 }
 
+// adaptStoreMessage never exposes the body, the attachments and the reactions of the deleted message.
 func adaptStoreMessage(m *store.Message) Message {
+	body, attachments, reactions, replyTo := m.Body, m.Edges.Attachments, m.Edges.Reactions, m.Edges.ReplyTo
+	if !m.DeletedAt.IsZero() {
+		body, attachments, reactions, replyTo = "", nil, nil, nil
+	}
+
+	var aa []Attachment
+	for _, a := range attachments {
+		aa = append(aa, adaptStoreAttachment(a))
+	}
+
+	var rr []Reaction
+	for _, r := range reactions {
+		rr = append(rr, adaptStoreReaction(r))
+	}
+
 	return Message{
 		ID:                  m.ID,
 		ChatID:              m.ChatID,
+		ProblemID:           m.ProblemID,
 		AuthorID:            m.AuthorID,
-		Body:                m.Body,
+		Body:                body,
 		CreatedAt:           m.CreatedAt,
+		EditedAt:            m.EditedAt,
+		DeletedAt:           m.DeletedAt,
 		IsVisibleForClient:  m.IsVisibleForClient,
 		IsVisibleForManager: m.IsVisibleForManager,
 		IsBlocked:           m.IsBlocked,
+		IsChecked:           !m.CheckedAt.IsZero(),
+		Verdict:             Verdict(m.Verdict),
+		VerdictSource:       VerdictSource(m.VerdictSource),
 		IsService:           m.IsService,
+		IsInternalNote:      m.IsInternalNote,
 		InitialRequestID:    m.InitialRequestID,
+		Attachments:         aa,
+		Reactions:           rr,
+		ReplyTo:             adaptStoreQuote(m.ReplyToMessageID, replyTo),
+	}
+}
+
+const quotePreviewMaxLength = 100
+
+// Quote is a short preview of the message replied to.
+type Quote struct {
+	MessageID           types.MessageID
+	AuthorID            types.UserID // Zero if the quoted message is a service one.
+	Preview             string       // The beginning of the body. Empty if the quoted message has been deleted.
+	IsVisibleForClient  bool
+	IsVisibleForManager bool
+}
+
+// ForClient returns the quote as the client sees it:
+// the author and the preview of the message invisible to the client are hidden.
+func (q *Quote) ForClient() *Quote {
+	if q == nil || q.IsVisibleForClient {
+		return q
+	}
+	return &Quote{MessageID: q.MessageID}
+}
+
+// ForManager is the same as ForClient, but for the manager.
+func (q *Quote) ForManager() *Quote {
+	if q == nil || q.IsVisibleForManager {
+		return q
+	}
+	return &Quote{MessageID: q.MessageID}
+}
+
+func adaptStoreQuote(replyToID types.MessageID, m *store.Message) *Quote {
+	if replyToID.IsZero() || m == nil {
+		return nil
+	}
+
+	var preview string
+	if m.DeletedAt.IsZero() {
+		preview = strutil.Truncate(m.Body, quotePreviewMaxLength)
+	}
+
+	return &Quote{
+		MessageID:           m.ID,
+		AuthorID:            m.AuthorID,
+		Preview:             preview,
+		IsVisibleForClient:  m.IsVisibleForClient,
+		IsVisibleForManager: m.IsVisibleForManager,
+	}
+}
+
+type Attachment struct {
+	ID           types.AttachmentID
+	ChatID       types.ChatID
+	MessageID    types.MessageID // Nil until the message is sent.
+	UploadedBy   types.UserID
+	FileName     string
+	ContentType  string
+	Size         int64
+	StorageKey   string
+	ThumbnailKey string // Empty if the file has no thumbnail.
+	CreatedAt    time.Time
+}
+
+func adaptStoreAttachment(a *store.Attachment) Attachment {
+	return Attachment{
+		ID:           a.ID,
+		ChatID:       a.ChatID,
+		MessageID:    a.MessageID,
+		UploadedBy:   a.UploadedBy,
+		FileName:     a.FileName,
+		ContentType:  a.ContentType,
+		Size:         a.Size,
+		StorageKey:   a.StorageKey,
+		ThumbnailKey: a.ThumbnailKey,
+		CreatedAt:    a.CreatedAt,
+	}
+}
+
+type Revision struct {
+	ID            types.MessageRevisionID
+	MessageID     types.MessageID
+	Body          string // The body before the edit.
+	EditRequestID types.RequestID
+	CreatedAt     time.Time
+}
+
+func adaptStoreRevision(r *store.MessageRevision) Revision {
+	return Revision{
+		ID:            r.ID,
+		MessageID:     r.MessageID,
+		Body:          r.Body,
+		EditRequestID: r.EditRequestID,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+type DeleterRole string
+
+const (
+	DeleterRoleClient    DeleterRole = "client"
+	DeleterRoleManager   DeleterRole = "manager"
+	DeleterRoleModerator DeleterRole = "moderator"
+)
+
+type Deletion struct {
+	ID            types.MessageDeletionID
+	MessageID     types.MessageID
+	RequestID     types.RequestID
+	DeletedBy     types.UserID
+	DeletedByRole DeleterRole
+	CreatedAt     time.Time
+}
+
+func adaptStoreDeletion(d *store.MessageDeletion) Deletion {
+	return Deletion{
+		ID:            d.ID,
+		MessageID:     d.MessageID,
+		RequestID:     d.RequestID,
+		DeletedBy:     d.DeletedBy,
+		DeletedByRole: DeleterRole(d.DeletedByRole),
+		CreatedAt:     d.CreatedAt,
+	}
+}
+
+type ReactionKind string
+
+const (
+	ReactionKindThumbsUp   ReactionKind = "thumbs_up"
+	ReactionKindThumbsDown ReactionKind = "thumbs_down"
+	ReactionKindHeart      ReactionKind = "heart"
+	ReactionKindLaugh      ReactionKind = "laugh"
+	ReactionKindSurprised  ReactionKind = "surprised"
+	ReactionKindSad        ReactionKind = "sad"
+)
+
+type Reaction struct {
+	ID        types.MessageReactionID
+	MessageID types.MessageID
+	UserID    types.UserID
+	Kind      ReactionKind
+	CreatedAt time.Time
+}
+
+func adaptStoreReaction(r *store.MessageReaction) Reaction {
+	return Reaction{
+		ID:        r.ID,
+		MessageID: r.MessageID,
+		UserID:    r.UserID,
+		Kind:      ReactionKind(r.Kind),
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+type ReactionsCount struct {
+	Kind        ReactionKind
+	Count       int
+	ReactedByMe bool
+}
+
+// CountReactions groups the reactions by kind in order of the first appearance.
+// ReactedByMe is set for the kinds put by the viewer.
+func CountReactions(rr []Reaction, viewerID types.UserID) []ReactionsCount {
+	if len(rr) == 0 {
+		return nil
+	}
+
+	result := make([]ReactionsCount, 0, len(rr))
+	index := make(map[ReactionKind]int, len(rr))
+	for _, r := range rr {
+		i, ok := index[r.Kind]
+		if !ok {
+			i = len(result)
+			index[r.Kind] = i
+			result = append(result, ReactionsCount{Kind: r.Kind})
+		}
+
+		result[i].Count++
+		if r.UserID == viewerID {
+			result[i].ReactedByMe = true
+		}
 	}
+	return result
 }
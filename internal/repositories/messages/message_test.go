@@ -0,0 +1,43 @@
+package messagesrepo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+func TestCountReactions(t *testing.T) {
+	clientID, managerID := types.NewUserID(), types.NewUserID()
+
+	counts := messagesrepo.CountReactions([]messagesrepo.Reaction{
+		{UserID: managerID, Kind: messagesrepo.ReactionKindHeart},
+		{UserID: clientID, Kind: messagesrepo.ReactionKindThumbsUp},
+		{UserID: managerID, Kind: messagesrepo.ReactionKindThumbsUp},
+	}, clientID)
+
+	assert.Equal(t, []messagesrepo.ReactionsCount{
+		{Kind: messagesrepo.ReactionKindHeart, Count: 1, ReactedByMe: false},
+		{Kind: messagesrepo.ReactionKindThumbsUp, Count: 2, ReactedByMe: true},
+	}, counts)
+
+	assert.Nil(t, messagesrepo.CountReactions(nil, clientID))
+}
+
+func TestQuote_ForSide(t *testing.T) {
+	q := &messagesrepo.Quote{
+		MessageID:          types.NewMessageID(),
+		AuthorID:           types.NewUserID(),
+		Preview:            "Do you accept cards?",
+		IsVisibleForClient: true,
+	}
+
+	assert.Equal(t, q, q.ForClient())
+	assert.Equal(t, &messagesrepo.Quote{MessageID: q.MessageID}, q.ForManager())
+
+	var noQuote *messagesrepo.Quote
+	assert.Nil(t, noQuote.ForClient())
+	assert.Nil(t, noQuote.ForManager())
+}
@@ -0,0 +1,107 @@
+package moderationrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+var (
+	ErrPendingCaseNotFound = errors.New("pending moderation case not found")
+	ErrInvalidPageSize     = errors.New("invalid page size")
+)
+
+const (
+	minPageSize = 1
+	maxPageSize = 100
+)
+
+func (r *Repo) CreateIfNotExists(ctx context.Context, msgID types.MessageID) (types.ModerationCaseID, error) {
+	caseID, err := r.db.ModerationCase(ctx).Query().
+		Unique(false).
+		Where(moderationcase.MessageID(msgID)).
+		FirstID(ctx)
+	if nil == err {
+		return caseID, nil
+	}
+	if !store.IsNotFound(err) {
+		return types.ModerationCaseIDNil, fmt.Errorf("select existent case: %v", err)
+	}
+
+	c, err := r.db.ModerationCase(ctx).Create().
+		SetMessageID(msgID).
+		Save(ctx)
+	if err != nil {
+		return types.ModerationCaseIDNil, fmt.Errorf("create new case: %v", err)
+	}
+
+	return c.ID, nil
+}
+
+// GetPendingCases returns the oldest undecided cases together with their messages.
+func (r *Repo) GetPendingCases(ctx context.Context, pageSize int) ([]Case, error) {
+	if pageSize < minPageSize || pageSize > maxPageSize {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidPageSize, pageSize)
+	}
+
+	cases, err := r.db.ModerationCase(ctx).Query().
+		Unique(false).
+		Where(moderationcase.DecidedAtIsNil()).
+		WithMessage().
+		Order(store.Asc(moderationcase.FieldCreatedAt)).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("select pending cases: %v", err)
+	}
+
+	result := make([]Case, 0, len(cases))
+	for _, c := range cases {
+		result = append(result, adaptStoreCase(c))
+	}
+	return result, nil
+}
+
+// HasPendingCase reports whether the message is waiting for the moderator's decision.
+func (r *Repo) HasPendingCase(ctx context.Context, msgID types.MessageID) (bool, error) {
+	ok, err := r.db.ModerationCase(ctx).Query().
+		Where(
+			moderationcase.MessageID(msgID),
+			moderationcase.DecidedAtIsNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check pending case existence: %v", err)
+	}
+	return ok, nil
+}
+
+// Decide records the moderator's decision on the pending case.
+// ErrPendingCaseNotFound is returned if there is no such case or the decision has already been made.
+func (r *Repo) Decide(
+	ctx context.Context,
+	caseID types.ModerationCaseID,
+	moderatorID types.UserID,
+	decision Decision,
+) (*Case, error) {
+	c, err := r.db.ModerationCase(ctx).UpdateOneID(caseID).
+		Where(moderationcase.DecidedAtIsNil()).
+		SetModeratorID(moderatorID).
+		SetDecision(moderationcase.Decision(decision)).
+		SetDecidedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, ErrPendingCaseNotFound
+		}
+		return nil, fmt.Errorf("update case %v: %v", caseID, err)
+	}
+
+	cc := adaptStoreCase(c)
+	return &cc, nil
+}
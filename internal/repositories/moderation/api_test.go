@@ -0,0 +1,210 @@
+//go:build integration
+
+package moderationrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	moderationrepo "github.com/zestagio/chat-service/internal/repositories/moderation"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const msgBody = "Hello, how to pay for my stolen card?"
+
+type ModerationRepoSuite struct {
+	testingh.DBSuite
+	repo *moderationrepo.Repo
+}
+
+func TestModerationRepoSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &ModerationRepoSuite{DBSuite: testingh.NewDBSuite("TestModerationRepoSuite")})
+}
+
+func (s *ModerationRepoSuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = moderationrepo.New(moderationrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *ModerationRepoSuite) SetupTest() {
+	s.DBSuite.SetupTest()
+	s.Database.ModerationCase(s.Ctx).Delete().ExecX(s.Ctx)
+}
+
+func (s *ModerationRepoSuite) Test_CreateIfNotExists() {
+	// Arrange.
+	msgID, _ := s.createMessage()
+
+	// Action.
+	caseID, err := s.repo.CreateIfNotExists(s.Ctx, msgID)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.NotEmpty(caseID)
+
+	c, err := s.Database.ModerationCase(s.Ctx).Get(s.Ctx, caseID)
+	s.Require().NoError(err)
+	s.Equal(msgID, c.MessageID)
+	s.Empty(c.Decision)
+	s.True(c.DecidedAt.IsZero())
+}
+
+func (s *ModerationRepoSuite) Test_CreateIfNotExists_Idempotency() {
+	// Arrange.
+	msgID, _ := s.createMessage()
+
+	caseID, err := s.repo.CreateIfNotExists(s.Ctx, msgID)
+	s.Require().NoError(err)
+
+	// Action.
+	caseID2, err := s.repo.CreateIfNotExists(s.Ctx, msgID)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(caseID, caseID2)
+	s.Equal(1, s.Database.ModerationCase(s.Ctx).Query().CountX(s.Ctx))
+}
+
+func (s *ModerationRepoSuite) Test_GetPendingCases() {
+	// Arrange.
+	const casesCount = 3
+
+	msgIDs := make([]types.MessageID, 0, casesCount)
+	caseIDs := make([]types.ModerationCaseID, 0, casesCount)
+	for i := 0; i < casesCount; i++ {
+		msgID, _ := s.createMessage()
+		caseID, err := s.repo.CreateIfNotExists(s.Ctx, msgID)
+		s.Require().NoError(err)
+
+		msgIDs = append(msgIDs, msgID)
+		caseIDs = append(caseIDs, caseID)
+	}
+
+	// Decided case is not in the queue anymore.
+	_, err := s.repo.Decide(s.Ctx, caseIDs[1], types.NewUserID(), moderationrepo.DecisionApproved)
+	s.Require().NoError(err)
+
+	// Action.
+	cases, err := s.repo.GetPendingCases(s.Ctx, 10)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(cases, 2)
+
+	for i, idx := range []int{0, 2} {
+		c := cases[i]
+		s.Equal(caseIDs[idx], c.ID)
+		s.Equal(msgIDs[idx], c.MessageID)
+		s.Require().NotNil(c.Message)
+		s.Equal(msgBody, c.Message.Body)
+		s.NotEmpty(c.Message.ChatID)
+		s.NotEmpty(c.Message.AuthorID)
+	}
+}
+
+func (s *ModerationRepoSuite) Test_GetPendingCases_InvalidPageSize() {
+	for _, pageSize := range []int{-1, 0, 101} {
+		_, err := s.repo.GetPendingCases(s.Ctx, pageSize)
+		s.Require().ErrorIs(err, moderationrepo.ErrInvalidPageSize)
+	}
+}
+
+func (s *ModerationRepoSuite) Test_Decide() {
+	// Arrange.
+	msgID, _ := s.createMessage()
+	caseID, err := s.repo.CreateIfNotExists(s.Ctx, msgID)
+	s.Require().NoError(err)
+	moderatorID := types.NewUserID()
+
+	// Action.
+	c, err := s.repo.Decide(s.Ctx, caseID, moderatorID, moderationrepo.DecisionRejected)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(caseID, c.ID)
+	s.Equal(msgID, c.MessageID)
+	s.Equal(moderatorID, c.ModeratorID)
+	s.Equal(moderationrepo.DecisionRejected, c.Decision)
+	s.WithinDuration(time.Now(), c.DecidedAt, time.Minute)
+}
+
+func (s *ModerationRepoSuite) Test_Decide_AlreadyDecided() {
+	// Arrange.
+	msgID, _ := s.createMessage()
+	caseID, err := s.repo.CreateIfNotExists(s.Ctx, msgID)
+	s.Require().NoError(err)
+
+	_, err = s.repo.Decide(s.Ctx, caseID, types.NewUserID(), moderationrepo.DecisionRejected)
+	s.Require().NoError(err)
+
+	// Action.
+	_, err = s.repo.Decide(s.Ctx, caseID, types.NewUserID(), moderationrepo.DecisionApproved)
+
+	// Assert.
+	s.Require().ErrorIs(err, moderationrepo.ErrPendingCaseNotFound)
+
+	c := s.Database.ModerationCase(s.Ctx).GetX(s.Ctx, caseID)
+	s.EqualValues(moderationrepo.DecisionRejected, c.Decision)
+}
+
+func (s *ModerationRepoSuite) Test_Decide_UnknownCase() {
+	// Action.
+	_, err := s.repo.Decide(s.Ctx, types.NewModerationCaseID(), types.NewUserID(), moderationrepo.DecisionApproved)
+
+	// Assert.
+	s.Require().ErrorIs(err, moderationrepo.ErrPendingCaseNotFound)
+}
+
+func (s *ModerationRepoSuite) Test_HasPendingCase() {
+	// Arrange.
+	msgID, _ := s.createMessage()
+
+	// Action & assert: the case is pending until the decision.
+	pending, err := s.repo.HasPendingCase(s.Ctx, msgID)
+	s.Require().NoError(err)
+	s.False(pending)
+
+	caseID, err := s.repo.CreateIfNotExists(s.Ctx, msgID)
+	s.Require().NoError(err)
+	pending, err = s.repo.HasPendingCase(s.Ctx, msgID)
+	s.Require().NoError(err)
+	s.True(pending)
+
+	_, err = s.repo.Decide(s.Ctx, caseID, types.NewUserID(), moderationrepo.DecisionApproved)
+	s.Require().NoError(err)
+	pending, err = s.repo.HasPendingCase(s.Ctx, msgID)
+	s.Require().NoError(err)
+	s.False(pending)
+}
+
+func (s *ModerationRepoSuite) createMessage() (types.MessageID, types.ChatID) {
+	s.T().Helper()
+
+	clientID := types.NewUserID()
+
+	chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(clientID).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	problem, err := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	msg, err := s.Database.Message(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetAuthorID(clientID).
+		SetProblemID(problem.ID).
+		SetBody(msgBody).
+		SetIsVisibleForClient(true).
+		SetInitialRequestID(types.NewRequestID()).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	return msg.ID, chat.ID
+}
@@ -0,0 +1,59 @@
+package moderationrepo
+
+import (
+	"time"
+
+	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type Decision string
+
+const (
+	DecisionApproved Decision = "approved"
+	DecisionRejected Decision = "rejected"
+)
+
+type Case struct {
+	ID          types.ModerationCaseID
+	MessageID   types.MessageID
+	ModeratorID types.UserID
+	Decision    Decision
+	DecidedAt   time.Time
+	CreatedAt   time.Time
+
+	// Message is loaded for the pending cases only.
+	Message *Message
+}
+
+type Message struct {
+	ChatID    types.ChatID
+	AuthorID  types.UserID
+	Body      string
+	CreatedAt time.Time
+}
+
+func adaptStoreCase(c *store.ModerationCase) Case {
+	cc := Case{
+		ID:          c.ID,
+		MessageID:   c.MessageID,
+		ModeratorID: c.ModeratorID,
+		Decision:    Decision(c.Decision),
+		DecidedAt:   c.DecidedAt,
+		CreatedAt:   c.CreatedAt,
+	}
+
+	if m := c.Edges.Message; m != nil {
+		cc.Message = &Message{
+			ChatID:    m.ChatID,
+			AuthorID:  m.AuthorID,
+			Body:      m.Body,
+			CreatedAt: m.CreatedAt,
+		}
+		if !m.DeletedAt.IsZero() {
+			cc.Message.Body = ""
+		}
+	}
+
+	return cc
+}
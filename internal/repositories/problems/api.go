@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/problem"
 	"github.com/zestagio/chat-service/internal/types"
 )
@@ -26,6 +27,19 @@ func (r *Repo) GetProblemByResolveRequestID(ctx context.Context, reqID types.Req
 	return &pp, nil
 }
 
+func (r *Repo) GetProblemByID(ctx context.Context, problemID types.ProblemID) (*Problem, error) {
+	p, err := r.db.Problem(ctx).Get(ctx, problemID)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, fmt.Errorf("problem %v: %w", problemID, ErrProblemNotFound)
+		}
+		return nil, fmt.Errorf("query problem by id %v: %v", problemID, err)
+	}
+
+	pp := adaptStoreProblem(p)
+	return &pp, nil
+}
+
 func (r *Repo) CreateIfNotExists(ctx context.Context, chatID types.ChatID) (types.ProblemID, error) {
 	pID, err := r.db.Problem(ctx).Query().
 		Unique(false).
@@ -84,9 +98,52 @@ func (r *Repo) GetAssignedProblemID(
 	return pID, nil
 }
 
-func (r *Repo) ResolveProblem(ctx context.Context, requestID types.RequestID, problemID types.ProblemID) error {
+// IsProblemOfHandledChat reports whether the problem belongs to the chat the manager has handled.
+// The problem itself could be handled by another manager, e.g. before the chat was handed over.
+func (r *Repo) IsProblemOfHandledChat(
+	ctx context.Context,
+	managerID types.UserID,
+	chatID types.ChatID,
+	problemID types.ProblemID,
+) (bool, error) {
+	ok, err := r.db.Problem(ctx).Query().
+		Where(
+			problem.ID(problemID),
+			problem.ChatID(chatID),
+			problem.HasChatWith(chat.HasProblemsWith(problem.ManagerID(managerID))),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("query problem of handled chat: %v", err)
+	}
+	return ok, nil
+}
+
+func (r *Repo) ResolveProblem(
+	ctx context.Context,
+	requestID types.RequestID,
+	problemID types.ProblemID,
+	resolutionCode string,
+	resolutionSummary string,
+) error {
 	return r.db.Problem(ctx).UpdateOneID(problemID).
 		SetResolveRequestID(requestID).
 		SetResolvedAt(time.Now()).
+		SetResolutionCode(resolutionCode).
+		SetResolutionSummary(resolutionSummary).
+		Exec(ctx)
+}
+
+// SetCategory sets the contact reason of the problem, the previous one is overwritten.
+func (r *Repo) SetCategory(ctx context.Context, problemID types.ProblemID, category string) error {
+	return r.db.Problem(ctx).UpdateOneID(problemID).
+		SetCategory(category).
+		Exec(ctx)
+}
+
+// MarkReadByManager marks the problem messages created so far as read by the manager.
+func (r *Repo) MarkReadByManager(ctx context.Context, problemID types.ProblemID) error {
+	return r.db.Problem(ctx).UpdateOneID(problemID).
+		SetManagerReadAt(time.Now()).
 		Exec(ctx)
 }
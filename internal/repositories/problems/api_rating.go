@@ -0,0 +1,117 @@
+package problemsrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/store/chat"
+	"github.com/zestagio/chat-service/internal/store/problem"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+var (
+	ErrProblemNotFound     = errors.New("problem not found")
+	ErrProblemAlreadyRated = errors.New("problem already rated")
+)
+
+// satisfiedMinScore is the lowest score counted as the satisfied client (CSAT).
+const satisfiedMinScore = 4
+
+// ManagerSatisfaction is the aggregated client ratings of the manager's problems.
+type ManagerSatisfaction struct {
+	ManagerID      types.UserID
+	RatingsCount   int
+	AverageScore   float64
+	SatisfiedCount int // The ratings of satisfiedMinScore and higher.
+}
+
+// GetClientProblem returns the problem of the client's chat.
+func (r *Repo) GetClientProblem(ctx context.Context, clientID types.UserID, problemID types.ProblemID) (*Problem, error) {
+	p, err := r.db.Problem(ctx).Query().
+		Unique(false).
+		Where(
+			problem.ID(problemID),
+			problem.HasChatWith(chat.ClientID(clientID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, fmt.Errorf("problem %v: %w", problemID, ErrProblemNotFound)
+		}
+		return nil, fmt.Errorf("query problem: %v", err)
+	}
+
+	pp := adaptStoreProblem(p)
+	return &pp, nil
+}
+
+// RateProblem saves the client's rating. The problem can be rated only once.
+func (r *Repo) RateProblem(ctx context.Context, problemID types.ProblemID, score int, comment string) error {
+	n, err := r.db.Problem(ctx).Update().
+		Where(
+			problem.ID(problemID),
+			problem.RatedAtIsNil(),
+		).
+		SetRatingScore(score).
+		SetRatingComment(comment).
+		SetRatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update problem: %v", err)
+	}
+	if n == 0 {
+		exists, err := r.db.Problem(ctx).Query().Where(problem.ID(problemID)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check problem existence: %v", err)
+		}
+		if !exists {
+			return fmt.Errorf("problem %v: %w", problemID, ErrProblemNotFound)
+		}
+		return fmt.Errorf("problem %v: %w", problemID, ErrProblemAlreadyRated)
+	}
+	return nil
+}
+
+// GetSatisfactionScores aggregates the ratings given in [from, to) by the problem managers.
+// The zero managerID means all managers.
+func (r *Repo) GetSatisfactionScores(
+	ctx context.Context,
+	from, to time.Time,
+	managerID types.UserID,
+) ([]ManagerSatisfaction, error) {
+	const sqlQuery = `
+	select
+		"p"."manager_id",
+		count(*),
+		avg("p"."rating_score")::float8,
+		count(*) filter (where "p"."rating_score" >= $4)
+	from "problems" as "p"
+	where "p"."rated_at" >= $1
+		and "p"."rated_at" < $2
+		and ($3::uuid is null or "p"."manager_id" = $3)
+	group by "p"."manager_id"
+	order by "p"."manager_id";`
+
+	rows, err := r.db.Problem(ctx).QueryContext(ctx, sqlQuery, from, to, managerID.AsPointer(), satisfiedMinScore)
+	if err != nil {
+		return nil, fmt.Errorf("query satisfaction scores: %v", err)
+	}
+	defer rows.Close()
+
+	var result []ManagerSatisfaction
+	for rows.Next() {
+		var s ManagerSatisfaction
+		if err := rows.Scan(&s.ManagerID, &s.RatingsCount, &s.AverageScore, &s.SatisfiedCount); err != nil {
+			return nil, fmt.Errorf("scan satisfaction: %v", err)
+		}
+		result = append(result, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %v", err)
+	}
+
+	return result, nil
+}
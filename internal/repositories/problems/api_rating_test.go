@@ -0,0 +1,159 @@
+//go:build integration
+
+package problemsrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type ProblemsRepoRatingAPISuite struct {
+	testingh.DBSuite
+	repo *problemsrepo.Repo
+}
+
+func TestProblemsRepoRatingAPISuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &ProblemsRepoRatingAPISuite{DBSuite: testingh.NewDBSuite("ProblemsRepoRatingAPISuite")})
+}
+
+func (s *ProblemsRepoRatingAPISuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = problemsrepo.New(problemsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *ProblemsRepoRatingAPISuite) Test_GetClientProblem() {
+	clientID := types.NewUserID()
+	problemID := s.createResolvedProblem(clientID, types.NewUserID())
+
+	s.Run("client problem", func() {
+		p, err := s.repo.GetClientProblem(s.Ctx, clientID, problemID)
+		s.Require().NoError(err)
+		s.Equal(problemID, p.ID)
+		s.False(p.ResolvedAt.IsZero())
+		s.True(p.RatedAt.IsZero())
+	})
+
+	s.Run("problem of other client", func() {
+		p, err := s.repo.GetClientProblem(s.Ctx, types.NewUserID(), problemID)
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+		s.Nil(p)
+	})
+}
+
+func (s *ProblemsRepoRatingAPISuite) Test_RateProblem() {
+	clientID := types.NewUserID()
+	problemID := s.createResolvedProblem(clientID, types.NewUserID())
+
+	s.Run("rate", func() {
+		err := s.repo.RateProblem(s.Ctx, problemID, 4, "Quick answer")
+		s.Require().NoError(err)
+
+		p, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Equal(4, p.RatingScore)
+		s.Equal("Quick answer", p.RatingComment)
+		s.WithinDuration(time.Now(), p.RatedAt, time.Second)
+	})
+
+	s.Run("rate twice", func() {
+		err := s.repo.RateProblem(s.Ctx, problemID, 1, "")
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemAlreadyRated)
+
+		p, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Equal(4, p.RatingScore)
+	})
+
+	s.Run("rate non-existent problem", func() {
+		err := s.repo.RateProblem(s.Ctx, types.NewProblemID(), 5, "")
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+
+	s.Run("score out of range", func() {
+		p := s.createResolvedProblem(types.NewUserID(), types.NewUserID())
+		err := s.repo.RateProblem(s.Ctx, p, 6, "")
+		s.Require().Error(err)
+	})
+}
+
+func (s *ProblemsRepoRatingAPISuite) Test_GetSatisfactionScores() {
+	from := time.Now()
+
+	manager1, manager2 := types.NewUserID(), types.NewUserID()
+	for _, r := range []struct {
+		managerID types.UserID
+		score     int
+	}{
+		{managerID: manager1, score: 5},
+		{managerID: manager1, score: 4},
+		{managerID: manager1, score: 1},
+		{managerID: manager2, score: 3},
+	} {
+		problemID := s.createResolvedProblem(types.NewUserID(), r.managerID)
+		s.Require().NoError(s.repo.RateProblem(s.Ctx, problemID, r.score, ""))
+	}
+
+	// Not rated problem.
+	s.createResolvedProblem(types.NewUserID(), manager1)
+
+	to := time.Now()
+
+	s.Run("all managers", func() {
+		scores, err := s.repo.GetSatisfactionScores(s.Ctx, from, to, types.UserIDNil)
+		s.Require().NoError(err)
+
+		byManager := make(map[types.UserID]problemsrepo.ManagerSatisfaction, len(scores))
+		for _, sc := range scores {
+			byManager[sc.ManagerID] = sc
+		}
+
+		s.Equal(3, byManager[manager1].RatingsCount)
+		s.InDelta(10./3, byManager[manager1].AverageScore, 0.001)
+		s.Equal(2, byManager[manager1].SatisfiedCount)
+
+		s.Equal(1, byManager[manager2].RatingsCount)
+		s.InDelta(3., byManager[manager2].AverageScore, 0.001)
+		s.Equal(0, byManager[manager2].SatisfiedCount)
+	})
+
+	s.Run("specific manager", func() {
+		scores, err := s.repo.GetSatisfactionScores(s.Ctx, from, to, manager2)
+		s.Require().NoError(err)
+		s.Require().Len(scores, 1)
+		s.Equal(manager2, scores[0].ManagerID)
+	})
+
+	s.Run("other period", func() {
+		scores, err := s.repo.GetSatisfactionScores(s.Ctx, to, to.Add(time.Hour), manager1)
+		s.Require().NoError(err)
+		s.Empty(scores)
+	})
+}
+
+func (s *ProblemsRepoRatingAPISuite) createResolvedProblem(clientID, managerID types.UserID) types.ProblemID {
+	s.T().Helper()
+
+	chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(clientID).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	p, err := s.Database.Problem(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetManagerID(managerID).
+		SetResolvedAt(time.Now()).
+		SetResolutionCode("answered").
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	return p.ID
+}
@@ -0,0 +1,72 @@
+package problemsrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zestagio/chat-service/internal/store"
+	"github.com/zestagio/chat-service/internal/store/problem"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// GetRecentlyResolvedProblem returns the last problem of the chat if it was resolved since the given time.
+// ErrProblemNotFound is returned if the chat has no problems, the last one is open or was resolved earlier.
+func (r *Repo) GetRecentlyResolvedProblem(ctx context.Context, chatID types.ChatID, since time.Time) (*Problem, error) {
+	p, err := r.db.Problem(ctx).Query().
+		Unique(false).
+		Where(problem.ChatID(chatID)).
+		Order(store.Desc(problem.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, ErrProblemNotFound
+		}
+		return nil, fmt.Errorf("query last chat problem: %v", err)
+	}
+
+	if p.ResolvedAt.IsZero() || p.ResolvedAt.Before(since) {
+		return nil, ErrProblemNotFound
+	}
+
+	pp := adaptStoreProblem(p)
+	return &pp, nil
+}
+
+// ReopenProblem makes the resolved problem open again, the manager stays the same.
+// The resolution of the problem is cleared, the problem will be resolved again.
+// ErrProblemNotFound is returned if there is no such resolved problem.
+func (r *Repo) ReopenProblem(ctx context.Context, problemID types.ProblemID) error {
+	n, err := r.db.Problem(ctx).Update().
+		Where(
+			problem.ID(problemID),
+			problem.ResolvedAtNotNil(),
+		).
+		ClearResolvedAt().
+		ClearResolutionCode().
+		ClearResolutionSummary().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("reopen problem: %v", err)
+	}
+	if n == 0 {
+		return ErrProblemNotFound
+	}
+	return nil
+}
+
+// CreateReopenedProblem creates the new problem without manager, it continues the resolved one.
+func (r *Repo) CreateReopenedProblem(
+	ctx context.Context,
+	chatID types.ChatID,
+	reopenedFromID types.ProblemID,
+) (types.ProblemID, error) {
+	p, err := r.db.Problem(ctx).Create().
+		SetChatID(chatID).
+		SetReopenedFromID(reopenedFromID).
+		Save(ctx)
+	if err != nil {
+		return types.ProblemIDNil, fmt.Errorf("create reopened problem: %v", err)
+	}
+	return p.ID, nil
+}
@@ -0,0 +1,156 @@
+//go:build integration
+
+package problemsrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type ProblemsRepoReopenAPISuite struct {
+	testingh.DBSuite
+	repo *problemsrepo.Repo
+}
+
+func TestProblemsRepoReopenAPISuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &ProblemsRepoReopenAPISuite{DBSuite: testingh.NewDBSuite("ProblemsRepoReopenAPISuite")})
+}
+
+func (s *ProblemsRepoReopenAPISuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = problemsrepo.New(problemsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *ProblemsRepoReopenAPISuite) Test_GetRecentlyResolvedProblem() {
+	s.Run("recently resolved", func() {
+		managerID := types.NewUserID()
+		chatID := s.createChat()
+		problemID := s.createProblem(chatID, managerID, time.Now().Add(-time.Minute))
+
+		p, err := s.repo.GetRecentlyResolvedProblem(s.Ctx, chatID, time.Now().Add(-5*time.Minute))
+		s.Require().NoError(err)
+		s.Equal(problemID, p.ID)
+		s.Equal(managerID, p.ManagerID)
+	})
+
+	s.Run("resolved long ago", func() {
+		chatID := s.createChat()
+		s.createProblem(chatID, types.NewUserID(), time.Now().Add(-time.Hour))
+
+		p, err := s.repo.GetRecentlyResolvedProblem(s.Ctx, chatID, time.Now().Add(-5*time.Minute))
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+		s.Nil(p)
+	})
+
+	s.Run("last problem is open", func() {
+		chatID := s.createChat()
+		s.createProblem(chatID, types.NewUserID(), time.Now().Add(-time.Minute))
+		s.createProblem(chatID, types.UserIDNil, time.Time{})
+
+		p, err := s.repo.GetRecentlyResolvedProblem(s.Ctx, chatID, time.Now().Add(-5*time.Minute))
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+		s.Nil(p)
+	})
+
+	s.Run("no problems", func() {
+		p, err := s.repo.GetRecentlyResolvedProblem(s.Ctx, s.createChat(), time.Now().Add(-5*time.Minute))
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+		s.Nil(p)
+	})
+}
+
+func (s *ProblemsRepoReopenAPISuite) Test_ReopenProblem() {
+	managerID := types.NewUserID()
+	chatID := s.createChat()
+	problemID := s.createProblem(chatID, managerID, time.Now().Add(-time.Minute))
+	_, err := s.Database.Problem(s.Ctx).UpdateOneID(problemID).
+		SetResolutionCode("answered").
+		SetResolutionSummary("Card limit explained").
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	s.Run("reopen resolved problem", func() {
+		err := s.repo.ReopenProblem(s.Ctx, problemID)
+		s.Require().NoError(err)
+
+		assignedID, err := s.repo.GetAssignedProblemID(s.Ctx, managerID, chatID)
+		s.Require().NoError(err)
+		s.Equal(problemID, assignedID)
+
+		p, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Empty(p.ResolutionCode)
+		s.Empty(p.ResolutionSummary)
+	})
+
+	s.Run("reopen open problem", func() {
+		err := s.repo.ReopenProblem(s.Ctx, problemID)
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+
+	s.Run("reopen non-existent problem", func() {
+		err := s.repo.ReopenProblem(s.Ctx, types.NewProblemID())
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+}
+
+func (s *ProblemsRepoReopenAPISuite) Test_CreateReopenedProblem() {
+	chatID := s.createChat()
+	resolvedID := s.createProblem(chatID, types.NewUserID(), time.Now().Add(-time.Minute))
+
+	problemID, err := s.repo.CreateReopenedProblem(s.Ctx, chatID, resolvedID)
+	s.Require().NoError(err)
+	s.NotEqual(resolvedID, problemID)
+
+	p, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+	s.Require().NoError(err)
+	s.Equal(chatID, p.ChatID)
+	s.Equal(resolvedID, p.ReopenedFromID)
+	s.True(p.ManagerID.IsZero())
+	s.True(p.ResolvedAt.IsZero())
+
+	// The new problem is open, so the chat waits for the manager from the pool.
+	existentID, err := s.repo.CreateIfNotExists(s.Ctx, chatID)
+	s.Require().NoError(err)
+	s.Equal(problemID, existentID)
+}
+
+func (s *ProblemsRepoReopenAPISuite) createChat() types.ChatID {
+	s.T().Helper()
+
+	chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).Save(s.Ctx)
+	s.Require().NoError(err)
+	return chat.ID
+}
+
+// createProblem creates the problem of the chat, the zero resolvedAt means the open problem.
+func (s *ProblemsRepoReopenAPISuite) createProblem(
+	chatID types.ChatID,
+	managerID types.UserID,
+	resolvedAt time.Time,
+) types.ProblemID {
+	s.T().Helper()
+
+	create := s.Database.Problem(s.Ctx).Create().SetChatID(chatID)
+	if !managerID.IsZero() {
+		create.SetManagerID(managerID)
+	}
+	if !resolvedAt.IsZero() {
+		create.SetResolvedAt(resolvedAt)
+	}
+
+	p, err := create.Save(s.Ctx)
+	s.Require().NoError(err)
+	return p.ID
+}
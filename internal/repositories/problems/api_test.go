@@ -39,8 +39,11 @@ func (s *ProblemsRepoSuite) Test_GetProblemByResolveRequestID() {
 		managerID := types.NewUserID()
 		chatID, problemID := s.createChatWithProblemAssignedTo(managerID)
 
+		err := s.repo.SetCategory(s.Ctx, problemID, "cards")
+		s.Require().NoError(err)
+
 		resolveRequestID := types.NewRequestID()
-		err := s.repo.ResolveProblem(s.Ctx, resolveRequestID, problemID)
+		err = s.repo.ResolveProblem(s.Ctx, resolveRequestID, problemID, "answered", "Unblocked the card")
 		s.Require().NoError(err)
 
 		problem, err := s.repo.GetProblemByResolveRequestID(s.Ctx, resolveRequestID)
@@ -49,6 +52,9 @@ func (s *ProblemsRepoSuite) Test_GetProblemByResolveRequestID() {
 		s.Equal(problemID, problem.ID)
 		s.Equal(chatID, problem.ChatID)
 		s.Equal(managerID, problem.ManagerID)
+		s.Equal("cards", problem.Category)
+		s.Equal("answered", problem.ResolutionCode)
+		s.Equal("Unblocked the card", problem.ResolutionSummary)
 	})
 
 	s.Run("problem does not exist", func() {
@@ -58,6 +64,27 @@ func (s *ProblemsRepoSuite) Test_GetProblemByResolveRequestID() {
 	})
 }
 
+func (s *ProblemsRepoSuite) Test_GetProblemByID() {
+	s.Run("problem exists", func() {
+		managerID := types.NewUserID()
+		chatID, problemID := s.createChatWithProblemAssignedTo(managerID)
+
+		problem, err := s.repo.GetProblemByID(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Require().NotNil(problem)
+		s.Equal(problemID, problem.ID)
+		s.Equal(chatID, problem.ChatID)
+		s.Equal(managerID, problem.ManagerID)
+		s.True(problem.ReopenedFromID.IsZero())
+	})
+
+	s.Run("problem does not exist", func() {
+		problem, err := s.repo.GetProblemByID(s.Ctx, types.NewProblemID())
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+		s.Require().Nil(problem)
+	})
+}
+
 func (s *ProblemsRepoSuite) Test_CreateIfNotExists() {
 	s.Run("problem does not exist, should be created", func() {
 		clientID := types.NewUserID()
@@ -197,6 +224,40 @@ func (s *ProblemsRepoSuite) Test_GetAssignedProblemID() {
 	})
 }
 
+func (s *ProblemsRepoSuite) Test_IsProblemOfHandledChat() {
+	managerID := types.NewUserID()
+	chatID, resolvedID := s.createChatWithProblemAssignedTo(managerID)
+	_, err := s.Database.Problem(s.Ctx).UpdateOneID(resolvedID).SetResolvedAt(time.Now()).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	// The chat was handed over to another manager.
+	otherManagerID := types.NewUserID()
+	p, err := s.Database.Problem(s.Ctx).Create().SetChatID(chatID).SetManagerID(otherManagerID).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	otherChatID, otherProblemID := s.createChatWithProblemAssignedTo(otherManagerID)
+
+	for _, tt := range []struct {
+		name      string
+		managerID types.UserID
+		chatID    types.ChatID
+		problemID types.ProblemID
+		expected  bool
+	}{
+		{name: "resolved problem of manager", managerID: managerID, chatID: chatID, problemID: resolvedID, expected: true},
+		{name: "problem of other manager in the chat", managerID: managerID, chatID: chatID, problemID: p.ID, expected: true},
+		{name: "chat not handled", managerID: managerID, chatID: otherChatID, problemID: otherProblemID},
+		{name: "problem of other chat", managerID: otherManagerID, chatID: chatID, problemID: otherProblemID},
+		{name: "unknown problem", managerID: managerID, chatID: chatID, problemID: types.NewProblemID()},
+	} {
+		s.Run(tt.name, func() {
+			ok, err := s.repo.IsProblemOfHandledChat(s.Ctx, tt.managerID, tt.chatID, tt.problemID)
+			s.Require().NoError(err)
+			s.Equal(tt.expected, ok)
+		})
+	}
+}
+
 func (s *ProblemsRepoSuite) Test_ResolveProblem() {
 	s.Run("resolve problem", func() {
 		managerID := types.NewUserID()
@@ -204,7 +265,7 @@ func (s *ProblemsRepoSuite) Test_ResolveProblem() {
 
 		// Resolve problem.
 		requestID := types.NewRequestID()
-		err := s.repo.ResolveProblem(s.Ctx, requestID, problemID)
+		err := s.repo.ResolveProblem(s.Ctx, requestID, problemID, "answered", "Told about the card limits")
 		s.Require().NoError(err)
 
 		// Checking if problem was resolved.
@@ -212,10 +273,52 @@ func (s *ProblemsRepoSuite) Test_ResolveProblem() {
 		s.Require().NoError(err)
 		s.NotEmpty(problem.ResolvedAt)
 		s.Equal(requestID, problem.ResolveRequestID)
+		s.Equal("answered", problem.ResolutionCode)
+		s.Equal("Told about the card limits", problem.ResolutionSummary)
 	})
 
 	s.Run("resolve non-existent problem", func() {
-		err := s.repo.ResolveProblem(s.Ctx, types.NewRequestID(), types.NewProblemID())
+		err := s.repo.ResolveProblem(s.Ctx, types.NewRequestID(), types.NewProblemID(), "answered", "")
+		s.Require().Error(err)
+		s.True(store.IsNotFound(err))
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_SetCategory() {
+	s.Run("set and change category", func() {
+		_, problemID := s.createChatWithProblemAssignedTo(types.NewUserID())
+
+		err := s.repo.SetCategory(s.Ctx, problemID, "cards")
+		s.Require().NoError(err)
+		err = s.repo.SetCategory(s.Ctx, problemID, "loans")
+		s.Require().NoError(err)
+
+		problem, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Equal("loans", problem.Category)
+	})
+
+	s.Run("set category of non-existent problem", func() {
+		err := s.repo.SetCategory(s.Ctx, types.NewProblemID(), "cards")
+		s.Require().Error(err)
+		s.True(store.IsNotFound(err))
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_MarkReadByManager() {
+	s.Run("mark read", func() {
+		_, problemID := s.createChatWithProblemAssignedTo(types.NewUserID())
+
+		err := s.repo.MarkReadByManager(s.Ctx, problemID)
+		s.Require().NoError(err)
+
+		problem, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.WithinDuration(time.Now(), problem.ManagerReadAt, time.Second)
+	})
+
+	s.Run("mark non-existent problem", func() {
+		err := s.repo.MarkReadByManager(s.Ctx, types.NewProblemID())
 		s.Require().Error(err)
 		s.True(store.IsNotFound(err))
 	})
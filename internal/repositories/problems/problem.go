@@ -1,6 +1,8 @@
 package problemsrepo
 
 import (
+	"time"
+
 	"github.com/zestagio/chat-service/internal/store"
 	"github.com/zestagio/chat-service/internal/types"
 )
@@ -9,6 +11,16 @@ type Problem struct {
 	ID        types.ProblemID
 	ChatID    types.ChatID
 	ManagerID types.UserID
+
+	ReopenedFromID types.ProblemID // Zero if the problem is not the continuation of the resolved one.
+
+	Category          string
+	ResolutionCode    string
+	ResolutionSummary string
+	ResolvedAt        time.Time // Zero if the problem is open.
+
+	RatingScore int       // Zero if the client has not rated the problem.
+	RatedAt     time.Time // Zero if the client has not rated the problem.
 }
 
 func adaptStoreProblem(p *store.Problem) Problem {
@@ -16,5 +28,15 @@ func adaptStoreProblem(p *store.Problem) Problem {
 		ID:        p.ID,
 		ChatID:    p.ChatID,
 		ManagerID: p.ManagerID,
+
+		ReopenedFromID: p.ReopenedFromID,
+
+		Category:          p.Category,
+		ResolutionCode:    p.ResolutionCode,
+		ResolutionSummary: p.ResolutionSummary,
+		ResolvedAt:        p.ResolvedAt,
+
+		RatingScore: p.RatingScore,
+		RatedAt:     p.RatedAt,
 	}
 }
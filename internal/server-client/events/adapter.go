@@ -5,6 +5,7 @@ import (
 
 	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
 	websocketstream "github.com/zestagio/chat-service/internal/websocket-stream"
+	"github.com/zestagio/chat-service/pkg/pointer"
 )
 
 var _ websocketstream.EventAdapter = Adapter{}
@@ -21,11 +22,13 @@ func (Adapter) Adapt(sEvent eventstream.Event) (any, error) {
 		event.RequestId = v.RequestID
 
 		err = event.FromNewMessageEvent(NewMessageEvent{
-			AuthorId:  v.AuthorID.AsPointer(),
-			Body:      v.MessageBody,
-			CreatedAt: v.CreatedAt,
-			IsService: v.IsService,
-			MessageId: v.MessageID,
+			Attachments: adaptAttachments(v.Attachments),
+			AuthorId:    v.AuthorID.AsPointer(),
+			Body:        v.MessageBody,
+			CreatedAt:   v.CreatedAt,
+			IsService:   v.IsService,
+			MessageId:   v.MessageID,
+			ReplyTo:     adaptQuote(v.ReplyTo),
 		})
 
 	case *eventstream.MessageSentEvent:
@@ -44,6 +47,43 @@ func (Adapter) Adapt(sEvent eventstream.Event) (any, error) {
 			MessageId: v.MessageID,
 		})
 
+	case *eventstream.MessageEditedEvent:
+		event.EventId = v.EventID
+		event.RequestId = v.RequestID
+
+		err = event.FromMessageEditedEvent(MessageEditedEvent{
+			Body:      v.MessageBody,
+			EditedAt:  v.EditedAt,
+			MessageId: v.MessageID,
+		})
+
+	case *eventstream.MessageDeletedEvent:
+		event.EventId = v.EventID
+		event.RequestId = v.RequestID
+
+		err = event.FromMessageDeletedEvent(MessageDeletedEvent{
+			DeletedAt: v.DeletedAt,
+			MessageId: v.MessageID,
+		})
+
+	case *eventstream.ReactionsChangedEvent:
+		event.EventId = v.EventID
+		event.RequestId = v.RequestID
+
+		err = event.FromReactionsChangedEvent(ReactionsChangedEvent{
+			MessageId: v.MessageID,
+			Reactions: adaptReactions(v.Reactions),
+		})
+
+	case *eventstream.RateProblemRequestEvent:
+		event.EventId = v.EventID
+		event.RequestId = v.RequestID
+
+		err = event.FromRateProblemRequestEvent(RateProblemRequestEvent{
+			ExpiresAt: v.ExpiresAt,
+			ProblemId: v.ProblemID,
+		})
+
 	default:
 		return nil, fmt.Errorf("unknown client event: %v (%T)", v, v)
 	}
@@ -56,3 +96,45 @@ func (Adapter) Adapt(sEvent eventstream.Event) (any, error) {
 
 	return event, nil
 }
+
+func adaptAttachments(attachments []eventstream.Attachment) *[]Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	result := make([]Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		result = append(result, Attachment{
+			ContentType:  a.ContentType,
+			FileName:     a.FileName,
+			Id:           a.ID,
+			Size:         a.Size,
+			ThumbnailUrl: pointer.PtrWithZeroAsNil(a.ThumbnailURL),
+			Url:          a.URL,
+		})
+	}
+	return &result
+}
+
+func adaptReactions(reactions []eventstream.Reaction) []Reaction {
+	result := make([]Reaction, 0, len(reactions))
+	for _, r := range reactions {
+		result = append(result, Reaction{
+			Count:       r.Count,
+			Kind:        ReactionKind(r.Kind),
+			ReactedByMe: r.ReactedByMe,
+		})
+	}
+	return result
+}
+
+func adaptQuote(q *eventstream.Quote) *Quote {
+	if q == nil {
+		return nil
+	}
+	return &Quote{
+		AuthorId:  q.AuthorID.AsPointer(),
+		MessageId: q.MessageID,
+		Preview:   q.Preview,
+	}
+}
@@ -45,6 +45,9 @@ func TestAdapter_Adapt(t *testing.T) {
 				time.Unix(1, 1).UTC(),
 				"Manager will coming soon",
 				true,
+				nil,
+				nil,
+				false,
 			),
 			expJSON: `{
 				"body": "Manager will coming soon",
@@ -56,6 +59,178 @@ func TestAdapter_Adapt(t *testing.T) {
 				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
 			}`,
 		},
+
+		{
+			name: "message with attachments",
+			ev: eventstream.NewNewMessageEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				types.MustParse[types.UserID]("a322bb38-bc31-11ed-83a2-461e464ebed8"),
+				time.Unix(2, 2).UTC(),
+				"",
+				false,
+				[]eventstream.Attachment{
+					{
+						ID:           types.MustParse[types.AttachmentID]("5c1c0a9a-bc31-11ed-9b2c-461e464ebed8"),
+						FileName:     "screenshot.png",
+						ContentType:  "image/png",
+						Size:         2048,
+						URL:          "/attachments/5c1c0a9a-bc31-11ed-9b2c-461e464ebed8?signature=a",
+						ThumbnailURL: "/attachments/5c1c0a9a-bc31-11ed-9b2c-461e464ebed8?signature=b&variant=thumbnail",
+					},
+				},
+				nil,
+				false,
+			),
+			expJSON: `{
+				"attachments": [
+					{
+						"contentType": "image/png",
+						"fileName": "screenshot.png",
+						"id": "5c1c0a9a-bc31-11ed-9b2c-461e464ebed8",
+						"size": 2048,
+						"thumbnailUrl": "/attachments/5c1c0a9a-bc31-11ed-9b2c-461e464ebed8?signature=b&variant=thumbnail",
+						"url": "/attachments/5c1c0a9a-bc31-11ed-9b2c-461e464ebed8?signature=a"
+					}
+				],
+				"authorId": "a322bb38-bc31-11ed-83a2-461e464ebed8",
+				"body": "",
+				"createdAt": "1970-01-01T00:00:02.000000002Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "NewMessageEvent",
+				"isService": false,
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+
+		{
+			name: "reply",
+			ev: eventstream.NewNewMessageEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				types.MustParse[types.UserID]("a322bb38-bc31-11ed-83a2-461e464ebed8"),
+				time.Unix(2, 2).UTC(),
+				"Yes, I do.",
+				false,
+				nil,
+				&eventstream.Quote{
+					MessageID: types.MustParse[types.MessageID]("8e5e6f2c-bc31-11ed-a6d1-461e464ebed8"),
+					AuthorID:  types.MustParse[types.UserID]("95f4a3de-bc31-11ed-8f0b-461e464ebed8"),
+					Preview:   "Do you have the order number?",
+				},
+				false,
+			),
+			expJSON: `{
+				"authorId": "a322bb38-bc31-11ed-83a2-461e464ebed8",
+				"body": "Yes, I do.",
+				"createdAt": "1970-01-01T00:00:02.000000002Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "NewMessageEvent",
+				"isService": false,
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"replyTo": {
+					"authorId": "95f4a3de-bc31-11ed-8f0b-461e464ebed8",
+					"messageId": "8e5e6f2c-bc31-11ed-a6d1-461e464ebed8",
+					"preview": "Do you have the order number?"
+				},
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+
+		{
+			name: "edited message",
+			ev: eventstream.NewMessageEditedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				time.Unix(3, 3).UTC(),
+				"Hello, manager! (edited)",
+			),
+			expJSON: `{
+				"body": "Hello, manager! (edited)",
+				"editedAt": "1970-01-01T00:00:03.000000003Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "MessageEditedEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "deleted message",
+			ev: eventstream.NewMessageDeletedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				time.Unix(4, 4).UTC(),
+			),
+			expJSON: `{
+				"deletedAt": "1970-01-01T00:00:04.000000004Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "MessageDeletedEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "reactions changed",
+			ev: eventstream.NewReactionsChangedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				[]eventstream.Reaction{
+					{Kind: "thumbs_up", Count: 2, ReactedByMe: true},
+				},
+			),
+			expJSON: `{
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "ReactionsChangedEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"reactions": [{"kind": "thumbs_up", "count": 2, "reactedByMe": true}],
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "last reaction taken off",
+			ev: eventstream.NewReactionsChangedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				nil,
+			),
+			expJSON: `{
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "ReactionsChangedEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"reactions": [],
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "rate problem request",
+			ev: eventstream.NewRateProblemRequestEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.ProblemID]("6ad9a4d4-bc31-11ed-8f0e-461e464ebed8"),
+				time.Date(2023, 3, 10, 12, 0, 0, 0, time.UTC),
+			),
+			expJSON: `{
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "RateProblemRequestEvent",
+				"expiresAt": "2023-03-10T12:00:00Z",
+				"problemId": "6ad9a4d4-bc31-11ed-8f0e-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
 	}
 
 	for _, tt := range cases {
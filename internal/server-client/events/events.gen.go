@@ -20,6 +20,26 @@ import (
 	"github.com/zestagio/chat-service/internal/types"
 )
 
+// Defines values for ReactionKind.
+const (
+	ReactionKindHeart      ReactionKind = "heart"
+	ReactionKindLaugh      ReactionKind = "laugh"
+	ReactionKindSad        ReactionKind = "sad"
+	ReactionKindSurprised  ReactionKind = "surprised"
+	ReactionKindThumbsDown ReactionKind = "thumbs_down"
+	ReactionKindThumbsUp   ReactionKind = "thumbs_up"
+)
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType  string             `json:"contentType"`
+	FileName     string             `json:"fileName"`
+	Id           types.AttachmentID `json:"id"`
+	Size         int64              `json:"size"`
+	ThumbnailUrl *string            `json:"thumbnailUrl,omitempty"`
+	Url          string             `json:"url"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	EventId   types.EventID   `json:"eventId"`
@@ -30,16 +50,31 @@ type Event struct {
 
 // Message defines model for Message.
 type Message struct {
-	AuthorId  *types.UserID   `json:"authorId,omitempty"`
-	Body      string          `json:"body"`
-	CreatedAt time.Time       `json:"createdAt"`
-	IsService bool            `json:"isService"`
-	MessageId types.MessageID `json:"messageId"`
+	Attachments *[]Attachment   `json:"attachments,omitempty"`
+	AuthorId    *types.UserID   `json:"authorId,omitempty"`
+	Body        string          `json:"body"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	IsService   bool            `json:"isService"`
+	MessageId   types.MessageID `json:"messageId"`
+	ReplyTo     *Quote          `json:"replyTo,omitempty"`
 }
 
 // MessageBlockedEvent defines model for MessageBlockedEvent.
 type MessageBlockedEvent = MessageId
 
+// MessageDeletedEvent defines model for MessageDeletedEvent.
+type MessageDeletedEvent struct {
+	DeletedAt time.Time       `json:"deletedAt"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// MessageEditedEvent defines model for MessageEditedEvent.
+type MessageEditedEvent struct {
+	Body      string          `json:"body"`
+	EditedAt  time.Time       `json:"editedAt"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
 // MessageId defines model for MessageId.
 type MessageId struct {
 	MessageId types.MessageID `json:"messageId"`
@@ -51,6 +86,41 @@ type MessageSentEvent = MessageId
 // NewMessageEvent defines model for NewMessageEvent.
 type NewMessageEvent = Message
 
+// Quote defines model for Quote.
+type Quote struct {
+	// AuthorId Absent if the quoted message is a service one or is hidden from you.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the quoted message body. Empty if the message is deleted or is hidden from you.
+	Preview string `json:"preview"`
+}
+
+// RateProblemRequestEvent The problem was resolved, the client is asked to rate it with /rateProblem.
+type RateProblemRequestEvent struct {
+	// ExpiresAt The rating is not accepted after this time.
+	ExpiresAt time.Time       `json:"expiresAt"`
+	ProblemId types.ProblemID `json:"problemId"`
+}
+
+// Reaction defines model for Reaction.
+type Reaction struct {
+	Count       int          `json:"count"`
+	Kind        ReactionKind `json:"kind"`
+	ReactedByMe bool         `json:"reactedByMe"`
+}
+
+// ReactionKind defines model for Reaction.Kind.
+type ReactionKind string
+
+// ReactionsChangedEvent defines model for ReactionsChangedEvent.
+type ReactionsChangedEvent struct {
+	MessageId types.MessageID `json:"messageId"`
+
+	// Reactions The actual reactions on the message, empty if the last one was taken off.
+	Reactions []Reaction `json:"reactions"`
+}
+
 // AsNewMessageEvent returns the union data inside the Event as a NewMessageEvent
 func (t Event) AsNewMessageEvent() (NewMessageEvent, error) {
 	var body NewMessageEvent
@@ -141,6 +211,126 @@ func (t *Event) MergeMessageBlockedEvent(v MessageBlockedEvent) error {
 	return err
 }
 
+// AsMessageEditedEvent returns the union data inside the Event as a MessageEditedEvent
+func (t Event) AsMessageEditedEvent() (MessageEditedEvent, error) {
+	var body MessageEditedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMessageEditedEvent overwrites any union data inside the Event as the provided MessageEditedEvent
+func (t *Event) FromMessageEditedEvent(v MessageEditedEvent) error {
+	t.EventType = "MessageEditedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMessageEditedEvent performs a merge with any union data inside the Event, using the provided MessageEditedEvent
+func (t *Event) MergeMessageEditedEvent(v MessageEditedEvent) error {
+	t.EventType = "MessageEditedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsMessageDeletedEvent returns the union data inside the Event as a MessageDeletedEvent
+func (t Event) AsMessageDeletedEvent() (MessageDeletedEvent, error) {
+	var body MessageDeletedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMessageDeletedEvent overwrites any union data inside the Event as the provided MessageDeletedEvent
+func (t *Event) FromMessageDeletedEvent(v MessageDeletedEvent) error {
+	t.EventType = "MessageDeletedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMessageDeletedEvent performs a merge with any union data inside the Event, using the provided MessageDeletedEvent
+func (t *Event) MergeMessageDeletedEvent(v MessageDeletedEvent) error {
+	t.EventType = "MessageDeletedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsReactionsChangedEvent returns the union data inside the Event as a ReactionsChangedEvent
+func (t Event) AsReactionsChangedEvent() (ReactionsChangedEvent, error) {
+	var body ReactionsChangedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromReactionsChangedEvent overwrites any union data inside the Event as the provided ReactionsChangedEvent
+func (t *Event) FromReactionsChangedEvent(v ReactionsChangedEvent) error {
+	t.EventType = "ReactionsChangedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeReactionsChangedEvent performs a merge with any union data inside the Event, using the provided ReactionsChangedEvent
+func (t *Event) MergeReactionsChangedEvent(v ReactionsChangedEvent) error {
+	t.EventType = "ReactionsChangedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRateProblemRequestEvent returns the union data inside the Event as a RateProblemRequestEvent
+func (t Event) AsRateProblemRequestEvent() (RateProblemRequestEvent, error) {
+	var body RateProblemRequestEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRateProblemRequestEvent overwrites any union data inside the Event as the provided RateProblemRequestEvent
+func (t *Event) FromRateProblemRequestEvent(v RateProblemRequestEvent) error {
+	t.EventType = "RateProblemRequestEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRateProblemRequestEvent performs a merge with any union data inside the Event, using the provided RateProblemRequestEvent
+func (t *Event) MergeRateProblemRequestEvent(v RateProblemRequestEvent) error {
+	t.EventType = "RateProblemRequestEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t Event) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"eventType"`
@@ -157,10 +347,18 @@ func (t Event) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "MessageBlockedEvent":
 		return t.AsMessageBlockedEvent()
+	case "MessageDeletedEvent":
+		return t.AsMessageDeletedEvent()
+	case "MessageEditedEvent":
+		return t.AsMessageEditedEvent()
 	case "MessageSentEvent":
 		return t.AsMessageSentEvent()
 	case "NewMessageEvent":
 		return t.AsNewMessageEvent()
+	case "RateProblemRequestEvent":
+		return t.AsRateProblemRequestEvent()
+	case "ReactionsChangedEvent":
+		return t.AsReactionsChangedEvent()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -236,17 +434,26 @@ func (t *Event) UnmarshalJSON(b []byte) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xWT2/TThD9Ktb8fhIXJw5wqfZGW4QiRCsROFU9bOyJva29u+yME0rk745m7TROQBVU",
-	"VEgVJ4/W8+fNe+P1bCF3jXcWLROoLVBeYaOj+XaNlsUoDOXBNMZqdkEOfHAeA99d6AZBAYrjpzuP0KXg",
-	"LF6uQF1t4f+AK1DwX7avkA3pswvcfEAiXWJfpUsf9h+cF2j5twJOa5ffYjHEXKc76AZjixH5vBBz5UKj",
-	"GRS0rSkgBZZ+FBAHY0tI4eukdJPhUB40jUnn5+N3E9N4FyJpXnMFCkrDVbuc5q7JviGxLo3L8krzhDCs",
-	"TY6ZsYzB6jqLSaHr0hGfanuEo0sh4JcW6dGoPw7hfxz3AM0ELEBdjZpI72keg7/uUhhEkrK6rn9hbIaA",
-	"eRH1P9RSt1y58FhaPhOGp9By6Yq7n8qYB9SMxRs+wFtoxgmbBn8A3aVgaNEXGiVcOlejtnBMf6w7rjIO",
-	"v75P7pY3mMuXsVfj4JNRxyw39wo8iuadgE89fXuYo8560M+nn/1t+DzaOv4p/LsW/v61IAmMXbmY23At",
-	"b0+1vU0WrRcekrNKc3JWG7ScRNkIUlhjIOMsKFi/jEuBR6u9AQWvp7PpDNLIXdQnI26XYpTYLxsoy4bn",
-	"PnzOSUtIycqFpESLQbOxZRJ/KDRNLrnCsDGEieGkcEj2BU8h1hNPZ0V3eIe8kCJCBXlnqZ+MV7OZPHJn",
-	"eTdt3tcmj4HZDQmA3UIk1kMTOCwYwtZhA5fv5VTOZRowUBzmQ59zXGPtfCMU9l6QQhtqULAhlWW1y3Vd",
-	"OWJ1MjuZZRsSYb4HAAD//xviyve5CQAA",
+	"H4sIAAAAAAAC/+xY32/bNhD+VwhuwF5ky/uBofBb2gRb0LXZmuQpCIazeJbYSKRCnuy6gf/34SjZkmzF",
+	"TbIEG4o+WaJ4x+++++7O0p1MbFFag4a8nN5Jn2RYQLg8IoIkK9AQ35XOluhIY3iWWENo6GJVIt9S+JWe",
+	"nDapXEdyrnN8D8XwQ614eW5dASSnsqq0ktHOtkh+GqV21Czyjx+3gE6PuxtGuiitq1ECZXIqU01ZNRsn",
+	"tog/oydItY2TDGjk0S10grE2hM5AHgfPcr2OpNefsYdLG/r1lxYYm6ToOADKqmJmQOeXLh+MsBpcX0fS",
+	"4W2lHSo5vZIh6i1RUY/TBk7t6XodyZNFkwelfeJ0oQ2QdZ3ErGq6JS42PtaRtAbP5nJ6dSe/dziXU/ld",
+	"3KY7bnIdv8flO/QeUqxPWUeH9zebz9HQowxe5za5QfUomxOl6ZEmx5jjg20+ICSkrfFvMjDpg62A8E9n",
+	"ZzkWH/C2Qr8h4jraKZSQjtMnCv5k8UJab0UypF5Xh/RU1A0jz497p37aIKItzV3wXDaNJPhYyPMH1EJj",
+	"cKqCBvq5hG3/CbeasAgXh/x1muh6Sx44Byu+h4oy655K9KVH9xLqmFm1GhRG4hAI1RH18CogHJEOPWzP",
+	"RPvz+qCOw5m1OYKppVbmqwv7JRb/qizhXgMNOLuousddb8HY2UdMuDZbPfQ60d5wK7YaeFJaNhJ6af23",
+	"MDuR9Zrfs6he1R4fnvQdmK39wZR0G/2z4L5Xxaj0v4mnUd3Wy8Goag19PfJqZ/7XEdbuX59vc+LbnGBd",
+	"1I72NN7Ng0L+I17yn0c5lUczj4aEngvKUNyyuRKN1oT2AkRDpLAGhXW8lmml0Ii5s4VY2Woso5as/zy5",
+	"/9dyjmTpcKFxuZ+DiwzFDFNtjDapsIOpYC2MxUlR0mqTrE6Wmll1f34Oj4aWsxYlq+m+14XBCMp6o1iC",
+	"Fw69zReoogA0yXXQmBfgb1AJssIBodAklpoyEbv2nLHcexP5VGqH/uieYx0Qs6a9MJYEJAmWzATMCZ2g",
+	"THvBpdvT6MGCbsJ4qn6aMF58HLQwow5DIWnNm+HQx4+qTt7+l4EbbULAaKqC3YcvBf7vqpTR5lrZpZGR",
+	"zBAcyUjmUKWZjKSvXOm0RwbiQcnrIZbY7WgBzkDBUK62IN9qoy6C/0s+an/5uD61++D3BkF37Y8GTXft",
+	"vIOstw71DHW8hur16t1g894hPDAUNRz2jbus99/Hn2Usu43r4QKAhCrIxXaXsKbbHiKB3aaRg6fQyrlM",
+	"CW7QCDufc3k8aNxvxbU37HfoakEPDSrerc08zEXSlPPT12BuxHlVcqWINxmQeFP3jUCll5FcoPN13Isf",
+	"GYAt0UCp5VT+PJ6MJzIK1RViiD1VM75IcaBvnJKoPHoxt06kaLDpIeFl3I/FGWXoltqHDqUsevMDMUOc",
+	"FGAX3Bzkb0jnfAjH7UtrfJ2tnyaTzodGvoSyzHUSDOOPvi7Mms8vsd18nGG2+gGcveVVXud+gc4HgfX3",
+	"HOMCc1sWTGG9q/ksN5VLP43j3CaQZ9bT9NXk1SReek7MPwMArD1hTlcVAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -7,12 +7,14 @@ import (
 	oapimdlwr "github.com/oapi-codegen/echo-middleware"
 
 	clientv1 "github.com/zestagio/chat-service/internal/server-client/v1"
+	"github.com/zestagio/chat-service/internal/services/attachments"
 )
 
 func NewHandlersRegistrar(
 	v1Swagger *openapi3.T,
 	v1Handlers clientv1.ServerInterface,
 	wsHandler echo.HandlerFunc,
+	downloadHandler echo.HandlerFunc,
 	httpErrorHandler echo.HTTPErrorHandler,
 ) func(e *echo.Echo) {
 	return func(e *echo.Echo) {
@@ -26,6 +28,7 @@ func NewHandlersRegistrar(
 		clientv1.RegisterHandlers(v1, v1Handlers)
 
 		e.GET("/ws", wsHandler)
+		e.GET(attachments.DownloadPath, downloadHandler)
 
 		e.HTTPErrorHandler = httpErrorHandler
 	}
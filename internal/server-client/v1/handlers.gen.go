@@ -11,18 +11,33 @@ import (
 type OptOptionsSetter func(o *Options)
 
 func NewOptions(
+	deleteMessage deleteMessageUseCase,
+	editMessage editMessageUseCase,
 	getHistory getHistoryUseCase,
+	rateProblem rateProblemUseCase,
+	reactToMessage reactToMessageUseCase,
 	sendMessage sendMessageUseCase,
+	uploadAttachment uploadAttachmentUseCase,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
 
 	// Setting defaults from field tag (if present)
 
+	o.deleteMessage = deleteMessage
+
+	o.editMessage = editMessage
+
 	o.getHistory = getHistory
 
+	o.rateProblem = rateProblem
+
+	o.reactToMessage = reactToMessage
+
 	o.sendMessage = sendMessage
 
+	o.uploadAttachment = uploadAttachment
+
 	for _, opt := range options {
 		opt(&o)
 	}
@@ -31,11 +46,30 @@ func NewOptions(
 
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("deleteMessage", _validate_Options_deleteMessage(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("editMessage", _validate_Options_editMessage(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("getHistory", _validate_Options_getHistory(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("rateProblem", _validate_Options_rateProblem(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("reactToMessage", _validate_Options_reactToMessage(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("sendMessage", _validate_Options_sendMessage(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("uploadAttachment", _validate_Options_uploadAttachment(o)))
 	return errs.AsError()
 }
 
+func _validate_Options_deleteMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.deleteMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `deleteMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_editMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.editMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `editMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_getHistory(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getHistory, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `getHistory` did not pass the test: %w", err)
@@ -43,9 +77,30 @@ func _validate_Options_getHistory(o *Options) error {
 	return nil
 }
 
+func _validate_Options_rateProblem(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.rateProblem, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `rateProblem` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_reactToMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.reactToMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `reactToMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_sendMessage(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.sendMessage, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `sendMessage` did not pass the test: %w", err)
 	}
 	return nil
 }
+
+func _validate_Options_uploadAttachment(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.uploadAttachment, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `uploadAttachment` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -4,24 +4,54 @@ import (
 	"context"
 	"fmt"
 
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/client/delete-message"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/client/edit-message"
 	gethistory "github.com/zestagio/chat-service/internal/usecases/client/get-history"
+	rateproblem "github.com/zestagio/chat-service/internal/usecases/client/rate-problem"
+	reacttomessage "github.com/zestagio/chat-service/internal/usecases/client/react-to-message"
 	sendmessage "github.com/zestagio/chat-service/internal/usecases/client/send-message"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/client/upload-attachment"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/handlers_mocks.gen.go -package=clientv1mocks
 
+type deleteMessageUseCase interface {
+	Handle(ctx context.Context, req deletemessage.Request) (deletemessage.Response, error)
+}
+
+type editMessageUseCase interface {
+	Handle(ctx context.Context, req editmessage.Request) (editmessage.Response, error)
+}
+
 type getHistoryUseCase interface {
 	Handle(ctx context.Context, req gethistory.Request) (gethistory.Response, error)
 }
 
+type rateProblemUseCase interface {
+	Handle(ctx context.Context, req rateproblem.Request) (rateproblem.Response, error)
+}
+
+type reactToMessageUseCase interface {
+	Handle(ctx context.Context, req reacttomessage.Request) (reacttomessage.Response, error)
+}
+
 type sendMessageUseCase interface {
 	Handle(ctx context.Context, req sendmessage.Request) (sendmessage.Response, error)
 }
 
+type uploadAttachmentUseCase interface {
+	Handle(ctx context.Context, req uploadattachment.Request) (uploadattachment.Response, error)
+}
+
 //go:generate options-gen -out-filename=handlers.gen.go -from-struct=Options
 type Options struct {
-	getHistory  getHistoryUseCase  `option:"mandatory" validate:"required"`
-	sendMessage sendMessageUseCase `option:"mandatory" validate:"required"`
+	deleteMessage    deleteMessageUseCase    `option:"mandatory" validate:"required"`
+	editMessage      editMessageUseCase      `option:"mandatory" validate:"required"`
+	getHistory       getHistoryUseCase       `option:"mandatory" validate:"required"`
+	rateProblem      rateProblemUseCase      `option:"mandatory" validate:"required"`
+	reactToMessage   reactToMessageUseCase   `option:"mandatory" validate:"required"`
+	sendMessage      sendMessageUseCase      `option:"mandatory" validate:"required"`
+	uploadAttachment uploadAttachmentUseCase `option:"mandatory" validate:"required"`
 }
 
 type Handlers struct {
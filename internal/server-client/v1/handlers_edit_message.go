@@ -0,0 +1,54 @@
+package clientv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/client/edit-message"
+)
+
+func (h Handlers) PostEditMessage(eCtx echo.Context, params PostEditMessageParams) error {
+	ctx := eCtx.Request().Context()
+	clientID := middlewares.MustUserID(eCtx)
+
+	var req EditMessageRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.editMessage.Handle(ctx, editmessage.Request{
+		ID:          params.XRequestID,
+		ClientID:    clientID,
+		MessageID:   req.MessageId,
+		MessageBody: req.MessageBody,
+	})
+	if err != nil {
+		if errors.Is(err, editmessage.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, editmessage.ErrMessageNotFound) {
+			return internalerrors.NewServerError(http.StatusNotFound, "message not found", err)
+		}
+
+		if errors.Is(err, editmessage.ErrMessageNotEditable) {
+			return internalerrors.NewServerError(int(ErrorCodeMessageNotEditable), "message is not editable", err)
+		}
+
+		if errors.Is(err, editmessage.ErrEditWindowExpired) {
+			return internalerrors.NewServerError(int(ErrorCodeEditWindowExpired), "edit window expired", err)
+		}
+
+		return fmt.Errorf("handle `edit message` use case: %v", err)
+	}
+
+	return eCtx.JSON(http.StatusOK, EditMessageResponse{Data: &EditedMessageHeader{
+		EditedAt: resp.EditedAt,
+		Id:       resp.MessageID,
+	}})
+}
@@ -0,0 +1,97 @@
+package clientv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	clientv1 "github.com/zestagio/chat-service/internal/server-client/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/client/edit-message"
+)
+
+func (s *HandlersSuite) TestEditMessage_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/editMessage", `{"messageBody": "Hel`)
+
+	// Action.
+	err := s.handlers.PostEditMessage(eCtx, clientv1.PostEditMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestEditMessage_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: editmessage.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{name: "not found", err: editmessage.ErrMessageNotFound, expCode: http.StatusNotFound},
+		{name: "not editable", err: editmessage.ErrMessageNotEditable, expCode: int(clientv1.ErrorCodeMessageNotEditable)},
+		{name: "window expired", err: editmessage.ErrEditWindowExpired, expCode: int(clientv1.ErrorCodeEditWindowExpired)},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			msgID := types.NewMessageID()
+
+			resp, eCtx := s.newEchoCtx(reqID, "/v1/editMessage",
+				fmt.Sprintf(`{"messageBody": "Hello!", "messageId": %q}`, msgID))
+			s.editMsgUseCase.EXPECT().Handle(eCtx.Request().Context(), editmessage.Request{
+				ID:          reqID,
+				ClientID:    s.clientID,
+				MessageID:   msgID,
+				MessageBody: "Hello!",
+			}).Return(editmessage.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostEditMessage(eCtx, clientv1.PostEditMessageParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestEditMessage_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	msgID := types.NewMessageID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/editMessage",
+		fmt.Sprintf(`{"messageBody": "Hello!", "messageId": %q}`, msgID))
+	s.editMsgUseCase.EXPECT().Handle(eCtx.Request().Context(), editmessage.Request{
+		ID:          reqID,
+		ClientID:    s.clientID,
+		MessageID:   msgID,
+		MessageBody: "Hello!",
+	}).Return(editmessage.Response{
+		MessageID: msgID,
+		EditedAt:  time.Unix(1, 1).UTC(),
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostEditMessage(eCtx, clientv1.PostEditMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "editedAt": "1970-01-01T00:00:01.000000001Z",
+        "id": "%s"
+    }
+}`, msgID), resp.Body.String())
+}
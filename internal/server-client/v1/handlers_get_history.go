@@ -27,6 +27,8 @@ func (h Handlers) PostGetHistory(eCtx echo.Context, params PostGetHistoryParams)
 		ClientID: clientID,
 		Cursor:   pointer.Indirect(req.Cursor),
 		PageSize: pointer.Indirect(req.PageSize),
+
+		AroundMessageID: pointer.Indirect(req.AroundMessageId),
 	})
 	if err != nil {
 		if errors.Is(err, gethistory.ErrInvalidRequest) {
@@ -37,6 +39,10 @@ func (h Handlers) PostGetHistory(eCtx echo.Context, params PostGetHistoryParams)
 			return internalerrors.NewServerError(http.StatusBadRequest, "invalid cursor", err)
 		}
 
+		if errors.Is(err, gethistory.ErrMessageNotFound) {
+			return internalerrors.NewServerError(http.StatusNotFound, "message not found", err)
+		}
+
 		return fmt.Errorf("handle `get history`: %v", err)
 	}
 
@@ -51,11 +57,50 @@ func (h Handlers) PostGetHistory(eCtx echo.Context, params PostGetHistoryParams)
 			IsReceived: m.IsReceived,
 			IsService:  m.IsService,
 		}
+		if !m.EditedAt.IsZero() {
+			mm.EditedAt = &m.EditedAt
+		}
+		if !m.DeletedAt.IsZero() {
+			mm.DeletedAt = &m.DeletedAt
+		}
+		if len(m.Attachments) > 0 {
+			aa := make([]Attachment, 0, len(m.Attachments))
+			for _, a := range m.Attachments {
+				aa = append(aa, Attachment{
+					ContentType:  a.ContentType,
+					FileName:     a.FileName,
+					Id:           a.ID,
+					Size:         a.Size,
+					ThumbnailUrl: pointer.PtrWithZeroAsNil(a.ThumbnailURL),
+					Url:          a.URL,
+				})
+			}
+			mm.Attachments = &aa
+		}
+		if len(m.Reactions) > 0 {
+			rr := make([]Reaction, 0, len(m.Reactions))
+			for _, r := range m.Reactions {
+				rr = append(rr, Reaction{
+					Count:       r.Count,
+					Kind:        ReactionKind(r.Kind),
+					ReactedByMe: r.ReactedByMe,
+				})
+			}
+			mm.Reactions = &rr
+		}
+		if q := m.ReplyTo; q != nil {
+			mm.ReplyTo = &Quote{
+				AuthorId:  pointer.PtrWithZeroAsNil(q.AuthorID),
+				MessageId: q.MessageID,
+				Preview:   q.Preview,
+			}
+		}
 		page = append(page, mm)
 	}
 
 	return eCtx.JSON(http.StatusOK, GetHistoryResponse{Data: &MessagesPage{
 		Messages: page,
 		Next:     resp.NextCursor,
+		Prev:     resp.PrevCursor,
 	}})
 }
@@ -66,6 +66,27 @@ func (s *HandlersSuite) TestGetHistory_Usecase_InvalidCursor() {
 	s.Empty(resp.Body)
 }
 
+func (s *HandlersSuite) TestGetHistory_Usecase_MessageNotFound() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	msgID := types.NewMessageID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getHistory", fmt.Sprintf(`{"pageSize":10,"aroundMessageId":%q}`, msgID))
+	s.getHistoryUseCase.EXPECT().Handle(eCtx.Request().Context(), gethistory.Request{
+		ID:              reqID,
+		ClientID:        s.clientID,
+		PageSize:        10,
+		AroundMessageID: msgID,
+	}).Return(gethistory.Response{}, gethistory.ErrMessageNotFound)
+
+	// Action.
+	err := s.handlers.PostGetHistory(eCtx, clientv1.PostGetHistoryParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusNotFound, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
 func (s *HandlersSuite) TestGetHistory_Usecase_UnknownError() {
 	// Arrange.
 	reqID := types.NewRequestID()
@@ -89,15 +110,35 @@ func (s *HandlersSuite) TestGetHistory_Usecase_Success() {
 	reqID := types.NewRequestID()
 	resp, eCtx := s.newEchoCtx(reqID, "/v1/getHistory", `{"pageSize":10}`)
 
+	quotedID := types.NewMessageID()
 	msgs := []gethistory.Message{
 		{
 			ID:         types.NewMessageID(),
 			AuthorID:   types.NewUserID(),
 			Body:       "hello!",
 			CreatedAt:  time.Unix(1, 1).UTC(),
+			EditedAt:   time.Unix(3, 3).UTC(),
 			IsReceived: true,
 			IsBlocked:  false,
 			IsService:  false,
+			Attachments: []gethistory.Attachment{
+				{
+					ID:           types.NewAttachmentID(),
+					FileName:     "cat.png",
+					ContentType:  "image/png",
+					Size:         1024,
+					URL:          "/attachments/1",
+					ThumbnailURL: "/attachments/1?variant=thumbnail",
+				},
+				{
+					ID:          types.NewAttachmentID(),
+					FileName:    "statement.pdf",
+					ContentType: "application/pdf",
+					Size:        2048,
+					URL:         "/attachments/2",
+				},
+			},
+			ReplyTo: &gethistory.Quote{MessageID: quotedID, Preview: "Manager Igor will answer you."},
 		},
 		{
 			ID:         types.NewMessageID(),
@@ -107,6 +148,19 @@ func (s *HandlersSuite) TestGetHistory_Usecase_Success() {
 			IsReceived: true,
 			IsBlocked:  false,
 			IsService:  true,
+			Reactions: []gethistory.Reaction{
+				{Kind: "thumbs_up", Count: 2, ReactedByMe: true},
+			},
+		},
+		{
+			ID:         types.NewMessageID(),
+			AuthorID:   types.NewUserID(),
+			Body:       "",
+			CreatedAt:  time.Unix(4, 4).UTC(),
+			DeletedAt:  time.Unix(5, 5).UTC(),
+			IsReceived: true,
+			IsBlocked:  false,
+			IsService:  false,
 		},
 	}
 	s.getHistoryUseCase.EXPECT().Handle(eCtx.Request().Context(), gethistory.Request{
@@ -134,10 +188,34 @@ func (s *HandlersSuite) TestGetHistory_Usecase_Success() {
                 "authorId": %q,
                 "body": "hello!",
                 "createdAt": "1970-01-01T00:00:01.000000001Z",
+                "editedAt": "1970-01-01T00:00:03.000000003Z",
                 "id": %q,
                 "isBlocked": false,
                 "isReceived": true,
-                "isService": false
+                "isService": false,
+                "attachments":
+                [
+                    {
+                        "id": %q,
+                        "fileName": "cat.png",
+                        "contentType": "image/png",
+                        "size": 1024,
+                        "url": "/attachments/1",
+                        "thumbnailUrl": "/attachments/1?variant=thumbnail"
+                    },
+                    {
+                        "id": %q,
+                        "fileName": "statement.pdf",
+                        "contentType": "application/pdf",
+                        "size": 2048,
+                        "url": "/attachments/2"
+                    }
+                ],
+                "replyTo":
+                {
+                    "messageId": %q,
+                    "preview": "Manager Igor will answer you."
+                }
             },
             {
                 "body": "service message",
@@ -145,10 +223,30 @@ func (s *HandlersSuite) TestGetHistory_Usecase_Success() {
                 "id": %q,
                 "isBlocked": false,
                 "isReceived": true,
-                "isService": true
+                "isService": true,
+                "reactions":
+                [
+                    {
+                        "kind": "thumbs_up",
+                        "count": 2,
+                        "reactedByMe": true
+                    }
+                ]
+            },
+            {
+                "authorId": %q,
+                "body": "",
+                "createdAt": "1970-01-01T00:00:04.000000004Z",
+                "deletedAt": "1970-01-01T00:00:05.000000005Z",
+                "id": %q,
+                "isBlocked": false,
+                "isReceived": true,
+                "isService": false
             }
         ],
-        "next": ""
+        "next": "",
+        "prev": ""
     }
-}`, msgs[0].AuthorID, msgs[0].ID, msgs[1].ID), resp.Body.String())
+}`, msgs[0].AuthorID, msgs[0].ID, msgs[0].Attachments[0].ID, msgs[0].Attachments[1].ID, quotedID,
+		msgs[1].ID, msgs[2].AuthorID, msgs[2].ID), resp.Body.String())
 }
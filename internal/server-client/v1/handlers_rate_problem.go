@@ -0,0 +1,57 @@
+package clientv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	rateproblem "github.com/zestagio/chat-service/internal/usecases/client/rate-problem"
+	"github.com/zestagio/chat-service/pkg/pointer"
+)
+
+func (h Handlers) PostRateProblem(eCtx echo.Context, params PostRateProblemParams) error {
+	ctx := eCtx.Request().Context()
+	clientID := middlewares.MustUserID(eCtx)
+
+	var req RateProblemRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	if _, err := h.rateProblem.Handle(ctx, rateproblem.Request{
+		ID:        params.XRequestID,
+		ClientID:  clientID,
+		ProblemID: req.ProblemId,
+		Score:     req.Score,
+		Comment:   pointer.Indirect(req.Comment),
+	}); err != nil {
+		if errors.Is(err, rateproblem.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, rateproblem.ErrProblemNotFound) {
+			return internalerrors.NewServerError(http.StatusNotFound, "problem not found", err)
+		}
+
+		if errors.Is(err, rateproblem.ErrProblemNotResolved) {
+			return internalerrors.NewServerError(int(ErrorCodeProblemNotResolved), "problem is not resolved yet", err)
+		}
+
+		if errors.Is(err, rateproblem.ErrAlreadyRated) {
+			return internalerrors.NewServerError(int(ErrorCodeProblemAlreadyRated), "problem is already rated", err)
+		}
+
+		if errors.Is(err, rateproblem.ErrRatingWindowExpired) {
+			return internalerrors.NewServerError(int(ErrorCodeRatingWindowExpired), "rating window expired", err)
+		}
+
+		return fmt.Errorf("handle `rate problem` use case: %v", err)
+	}
+
+	var empty map[string]any
+	return eCtx.JSON(http.StatusOK, RateProblemResponse{Data: &empty})
+}
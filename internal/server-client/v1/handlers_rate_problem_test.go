@@ -0,0 +1,88 @@
+package clientv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	clientv1 "github.com/zestagio/chat-service/internal/server-client/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	rateproblem "github.com/zestagio/chat-service/internal/usecases/client/rate-problem"
+)
+
+func (s *HandlersSuite) TestRateProblem_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/rateProblem", `{"score": "`)
+
+	// Action.
+	err := s.handlers.PostRateProblem(eCtx, clientv1.PostRateProblemParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestRateProblem_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: rateproblem.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{name: "not found", err: rateproblem.ErrProblemNotFound, expCode: http.StatusNotFound},
+		{name: "not resolved", err: rateproblem.ErrProblemNotResolved, expCode: int(clientv1.ErrorCodeProblemNotResolved)},
+		{name: "already rated", err: rateproblem.ErrAlreadyRated, expCode: int(clientv1.ErrorCodeProblemAlreadyRated)},
+		{name: "window expired", err: rateproblem.ErrRatingWindowExpired, expCode: int(clientv1.ErrorCodeRatingWindowExpired)},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			problemID := types.NewProblemID()
+
+			resp, eCtx := s.newEchoCtx(reqID, "/v1/rateProblem",
+				fmt.Sprintf(`{"problemId": %q, "score": 5}`, problemID))
+			s.rateProblemUseCase.EXPECT().Handle(eCtx.Request().Context(), rateproblem.Request{
+				ID:        reqID,
+				ClientID:  s.clientID,
+				ProblemID: problemID,
+				Score:     5,
+			}).Return(rateproblem.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostRateProblem(eCtx, clientv1.PostRateProblemParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestRateProblem_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	problemID := types.NewProblemID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/rateProblem",
+		fmt.Sprintf(`{"problemId": %q, "score": 4, "comment": "Quick and polite."}`, problemID))
+	s.rateProblemUseCase.EXPECT().Handle(eCtx.Request().Context(), rateproblem.Request{
+		ID:        reqID,
+		ClientID:  s.clientID,
+		ProblemID: problemID,
+		Score:     4,
+		Comment:   "Quick and polite.",
+	}).Return(rateproblem.Response{}, nil)
+
+	// Action.
+	err := s.handlers.PostRateProblem(eCtx, clientv1.PostRateProblemParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data": null}`, resp.Body.String())
+}
@@ -0,0 +1,65 @@
+package clientv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	"github.com/zestagio/chat-service/internal/types"
+	reacttomessage "github.com/zestagio/chat-service/internal/usecases/client/react-to-message"
+)
+
+func (h Handlers) PostAddReaction(eCtx echo.Context, params PostAddReactionParams) error {
+	return h.reactToMessageHandler(eCtx, params.XRequestID, reacttomessage.OperationAdd)
+}
+
+func (h Handlers) PostRemoveReaction(eCtx echo.Context, params PostRemoveReactionParams) error {
+	return h.reactToMessageHandler(eCtx, params.XRequestID, reacttomessage.OperationRemove)
+}
+
+func (h Handlers) reactToMessageHandler(eCtx echo.Context, reqID types.RequestID, op reacttomessage.Operation) error {
+	ctx := eCtx.Request().Context()
+	clientID := middlewares.MustUserID(eCtx)
+
+	var req ReactionRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.reactToMessage.Handle(ctx, reacttomessage.Request{
+		ID:        reqID,
+		ClientID:  clientID,
+		MessageID: req.MessageId,
+		Kind:      string(req.Kind),
+		Operation: op,
+	})
+	if err != nil {
+		if errors.Is(err, reacttomessage.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, reacttomessage.ErrMessageNotFound) {
+			return internalerrors.NewServerError(http.StatusNotFound, "message not found", err)
+		}
+
+		return fmt.Errorf("handle `%s reaction` use case: %v", op, err)
+	}
+
+	reactions := make([]Reaction, 0, len(resp.Reactions))
+	for _, r := range resp.Reactions {
+		reactions = append(reactions, Reaction{
+			Count:       r.Count,
+			Kind:        ReactionKind(r.Kind),
+			ReactedByMe: r.ReactedByMe,
+		})
+	}
+
+	return eCtx.JSON(http.StatusOK, ReactionsResponse{Data: &MessageReactions{
+		Id:        resp.MessageID,
+		Reactions: reactions,
+	}})
+}
@@ -26,6 +26,10 @@ func (h Handlers) PostSendMessage(eCtx echo.Context, params PostSendMessageParam
 		ID:          params.XRequestID,
 		ClientID:    clientID,
 		MessageBody: req.MessageBody,
+		ClientName:  middlewares.UserName(eCtx),
+
+		AttachmentIDs:    pointer.Indirect(req.AttachmentIds),
+		ReplyToMessageID: pointer.Indirect(req.ReplyToMessageId),
 	})
 	if err != nil {
 		if errors.Is(err, sendmessage.ErrInvalidRequest) {
@@ -36,6 +40,14 @@ func (h Handlers) PostSendMessage(eCtx echo.Context, params PostSendMessageParam
 			return internalerrors.NewServerError(int(ErrorCodeCreateChatError), "create chat error", err)
 		}
 
+		if errors.Is(err, sendmessage.ErrAttachmentInvalid) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid attachment", err)
+		}
+
+		if errors.Is(err, sendmessage.ErrReplyToInvalid) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid replied message", err)
+		}
+
 		if errors.Is(err, sendmessage.ErrProblemNotCreated) {
 			return internalerrors.NewServerError(int(ErrorCodeCreateProblemError), "create problem error", err)
 		}
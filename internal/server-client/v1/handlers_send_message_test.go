@@ -85,6 +85,52 @@ func (s *HandlersSuite) TestSendMessage_Usecase_ProblemNotCreatedError() {
 	s.Empty(resp.Body)
 }
 
+func (s *HandlersSuite) TestSendMessage_Usecase_AttachmentInvalidError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	attachmentID := types.NewAttachmentID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage",
+		fmt.Sprintf(`{"messageBody": "", "attachmentIds": [%q]}`, attachmentID))
+	s.sendMsgUseCase.EXPECT().Handle(eCtx.Request().Context(), sendmessage.Request{
+		ID:            reqID,
+		ClientID:      s.clientID,
+		MessageBody:   "",
+		AttachmentIDs: []types.AttachmentID{attachmentID},
+	}).Return(sendmessage.Response{}, sendmessage.ErrAttachmentInvalid)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, clientv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendMessage_Usecase_ReplyToInvalidError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	replyToID := types.NewMessageID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage",
+		fmt.Sprintf(`{"messageBody": "Yes", "replyToMessageId": %q}`, replyToID))
+	s.sendMsgUseCase.EXPECT().Handle(eCtx.Request().Context(), sendmessage.Request{
+		ID:               reqID,
+		ClientID:         s.clientID,
+		MessageBody:      "Yes",
+		ReplyToMessageID: replyToID,
+	}).Return(sendmessage.Response{}, sendmessage.ErrReplyToInvalid)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, clientv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
 func (s *HandlersSuite) TestSendMessage_Usecase_Success() {
 	// Arrange.
 	reqID := types.NewRequestID()
@@ -20,10 +20,15 @@ import (
 type HandlersSuite struct {
 	testingh.ContextSuite
 
-	ctrl              *gomock.Controller
-	getHistoryUseCase *clientv1mocks.MockgetHistoryUseCase
-	sendMsgUseCase    *clientv1mocks.MocksendMessageUseCase
-	handlers          clientv1.Handlers
+	ctrl                  *gomock.Controller
+	deleteMsgUseCase      *clientv1mocks.MockdeleteMessageUseCase
+	editMsgUseCase        *clientv1mocks.MockeditMessageUseCase
+	getHistoryUseCase     *clientv1mocks.MockgetHistoryUseCase
+	rateProblemUseCase    *clientv1mocks.MockrateProblemUseCase
+	reactToMessageUseCase *clientv1mocks.MockreactToMessageUseCase
+	sendMsgUseCase        *clientv1mocks.MocksendMessageUseCase
+	uploadUseCase         *clientv1mocks.MockuploadAttachmentUseCase
+	handlers              clientv1.Handlers
 
 	clientID types.UserID
 }
@@ -35,11 +40,24 @@ func TestHandlersSuite(t *testing.T) {
 
 func (s *HandlersSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
+	s.deleteMsgUseCase = clientv1mocks.NewMockdeleteMessageUseCase(s.ctrl)
+	s.editMsgUseCase = clientv1mocks.NewMockeditMessageUseCase(s.ctrl)
 	s.getHistoryUseCase = clientv1mocks.NewMockgetHistoryUseCase(s.ctrl)
+	s.rateProblemUseCase = clientv1mocks.NewMockrateProblemUseCase(s.ctrl)
+	s.reactToMessageUseCase = clientv1mocks.NewMockreactToMessageUseCase(s.ctrl)
 	s.sendMsgUseCase = clientv1mocks.NewMocksendMessageUseCase(s.ctrl)
+	s.uploadUseCase = clientv1mocks.NewMockuploadAttachmentUseCase(s.ctrl)
 	{
 		var err error
-		s.handlers, err = clientv1.NewHandlers(clientv1.NewOptions(s.getHistoryUseCase, s.sendMsgUseCase))
+		s.handlers, err = clientv1.NewHandlers(clientv1.NewOptions(
+			s.deleteMsgUseCase,
+			s.editMsgUseCase,
+			s.getHistoryUseCase,
+			s.rateProblemUseCase,
+			s.reactToMessageUseCase,
+			s.sendMsgUseCase,
+			s.uploadUseCase,
+		))
 		s.Require().NoError(err)
 	}
 	s.clientID = types.NewUserID()
@@ -0,0 +1,138 @@
+package clientv1_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	clientv1 "github.com/zestagio/chat-service/internal/server-client/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/client/upload-attachment"
+)
+
+func (s *HandlersSuite) TestUploadAttachment_NoFile() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/uploadAttachment", `{}`)
+
+	// Action.
+	err := s.handlers.PostUploadAttachment(eCtx, clientv1.PostUploadAttachmentParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestUploadAttachment_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: uploadattachment.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{name: "chat not created", err: uploadattachment.ErrChatNotCreated, expCode: int(clientv1.ErrorCodeCreateChatError)},
+		{name: "too large", err: uploadattachment.ErrFileTooLarge, expCode: http.StatusRequestEntityTooLarge},
+		{
+			name:    "content type not allowed",
+			err:     uploadattachment.ErrContentTypeNotAllowed,
+			expCode: http.StatusUnsupportedMediaType,
+		},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			resp, eCtx := s.newMultipartEchoCtx(reqID, "/v1/uploadAttachment", "statement.pdf", []byte("%PDF-1.7"))
+
+			s.uploadUseCase.EXPECT().Handle(gomock.Any(), uploadattachment.Request{
+				ID:       reqID,
+				ClientID: s.clientID,
+				FileName: "statement.pdf",
+				Data:     []byte("%PDF-1.7"),
+			}).Return(uploadattachment.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostUploadAttachment(eCtx, clientv1.PostUploadAttachmentParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestUploadAttachment_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	attachmentID := types.NewAttachmentID()
+	resp, eCtx := s.newMultipartEchoCtx(reqID, "/v1/uploadAttachment", "cat.png", []byte("\x89PNG\r\n\x1a\n"))
+
+	s.uploadUseCase.EXPECT().Handle(gomock.Any(), uploadattachment.Request{
+		ID:       reqID,
+		ClientID: s.clientID,
+		FileName: "cat.png",
+		Data:     []byte("\x89PNG\r\n\x1a\n"),
+	}).Return(uploadattachment.Response{
+		AttachmentID: attachmentID,
+		FileName:     "cat.png",
+		ContentType:  "image/png",
+		Size:         8,
+		URL:          "/attachments/1",
+		ThumbnailURL: "/attachments/1?variant=thumbnail",
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostUploadAttachment(eCtx, clientv1.PostUploadAttachmentParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "id": %q,
+        "fileName": "cat.png",
+        "contentType": "image/png",
+        "size": 8,
+        "url": "/attachments/1",
+        "thumbnailUrl": "/attachments/1?variant=thumbnail"
+    }
+}`, attachmentID), resp.Body.String())
+}
+
+func (s *HandlersSuite) newMultipartEchoCtx(
+	requestID types.RequestID,
+	path string,
+	fileName string,
+	data []byte,
+) (*httptest.ResponseRecorder, echo.Context) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	fw, err := w.CreateFormFile("file", fileName)
+	s.Require().NoError(err)
+	_, err = fw.Write(data)
+	s.Require().NoError(err)
+	s.Require().NoError(w.Close())
+
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set(echo.HeaderContentType, w.FormDataContentType())
+	req.Header.Set(echo.HeaderXRequestID, requestID.String())
+
+	resp := httptest.NewRecorder()
+
+	ctx := echo.New().NewContext(req, resp)
+	middlewares.SetToken(ctx, s.clientID)
+
+	return resp, ctx
+}
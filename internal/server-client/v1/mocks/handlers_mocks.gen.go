@@ -9,10 +9,91 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/client/delete-message"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/client/edit-message"
 	gethistory "github.com/zestagio/chat-service/internal/usecases/client/get-history"
+	rateproblem "github.com/zestagio/chat-service/internal/usecases/client/rate-problem"
+	reacttomessage "github.com/zestagio/chat-service/internal/usecases/client/react-to-message"
 	sendmessage "github.com/zestagio/chat-service/internal/usecases/client/send-message"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/client/upload-attachment"
 )
 
+// MockdeleteMessageUseCase is a mock of deleteMessageUseCase interface.
+type MockdeleteMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeleteMessageUseCaseMockRecorder
+}
+
+// MockdeleteMessageUseCaseMockRecorder is the mock recorder for MockdeleteMessageUseCase.
+type MockdeleteMessageUseCaseMockRecorder struct {
+	mock *MockdeleteMessageUseCase
+}
+
+// NewMockdeleteMessageUseCase creates a new mock instance.
+func NewMockdeleteMessageUseCase(ctrl *gomock.Controller) *MockdeleteMessageUseCase {
+	mock := &MockdeleteMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockdeleteMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeleteMessageUseCase) EXPECT() *MockdeleteMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockdeleteMessageUseCase) Handle(ctx context.Context, req deletemessage.Request) (deletemessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(deletemessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockdeleteMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdeleteMessageUseCase)(nil).Handle), ctx, req)
+}
+
+// MockeditMessageUseCase is a mock of editMessageUseCase interface.
+type MockeditMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockeditMessageUseCaseMockRecorder
+}
+
+// MockeditMessageUseCaseMockRecorder is the mock recorder for MockeditMessageUseCase.
+type MockeditMessageUseCaseMockRecorder struct {
+	mock *MockeditMessageUseCase
+}
+
+// NewMockeditMessageUseCase creates a new mock instance.
+func NewMockeditMessageUseCase(ctrl *gomock.Controller) *MockeditMessageUseCase {
+	mock := &MockeditMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockeditMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeditMessageUseCase) EXPECT() *MockeditMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockeditMessageUseCase) Handle(ctx context.Context, req editmessage.Request) (editmessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(editmessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockeditMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockeditMessageUseCase)(nil).Handle), ctx, req)
+}
+
 // MockgetHistoryUseCase is a mock of getHistoryUseCase interface.
 type MockgetHistoryUseCase struct {
 	ctrl     *gomock.Controller
@@ -51,6 +132,82 @@ func (mr *MockgetHistoryUseCaseMockRecorder) Handle(ctx, req interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockgetHistoryUseCase)(nil).Handle), ctx, req)
 }
 
+// MockrateProblemUseCase is a mock of rateProblemUseCase interface.
+type MockrateProblemUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockrateProblemUseCaseMockRecorder
+}
+
+// MockrateProblemUseCaseMockRecorder is the mock recorder for MockrateProblemUseCase.
+type MockrateProblemUseCaseMockRecorder struct {
+	mock *MockrateProblemUseCase
+}
+
+// NewMockrateProblemUseCase creates a new mock instance.
+func NewMockrateProblemUseCase(ctrl *gomock.Controller) *MockrateProblemUseCase {
+	mock := &MockrateProblemUseCase{ctrl: ctrl}
+	mock.recorder = &MockrateProblemUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrateProblemUseCase) EXPECT() *MockrateProblemUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockrateProblemUseCase) Handle(ctx context.Context, req rateproblem.Request) (rateproblem.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(rateproblem.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockrateProblemUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockrateProblemUseCase)(nil).Handle), ctx, req)
+}
+
+// MockreactToMessageUseCase is a mock of reactToMessageUseCase interface.
+type MockreactToMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockreactToMessageUseCaseMockRecorder
+}
+
+// MockreactToMessageUseCaseMockRecorder is the mock recorder for MockreactToMessageUseCase.
+type MockreactToMessageUseCaseMockRecorder struct {
+	mock *MockreactToMessageUseCase
+}
+
+// NewMockreactToMessageUseCase creates a new mock instance.
+func NewMockreactToMessageUseCase(ctrl *gomock.Controller) *MockreactToMessageUseCase {
+	mock := &MockreactToMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockreactToMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreactToMessageUseCase) EXPECT() *MockreactToMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockreactToMessageUseCase) Handle(ctx context.Context, req reacttomessage.Request) (reacttomessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(reacttomessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockreactToMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockreactToMessageUseCase)(nil).Handle), ctx, req)
+}
+
 // MocksendMessageUseCase is a mock of sendMessageUseCase interface.
 type MocksendMessageUseCase struct {
 	ctrl     *gomock.Controller
@@ -88,3 +245,41 @@ func (mr *MocksendMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MocksendMessageUseCase)(nil).Handle), ctx, req)
 }
+
+// MockuploadAttachmentUseCase is a mock of uploadAttachmentUseCase interface.
+type MockuploadAttachmentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockuploadAttachmentUseCaseMockRecorder
+}
+
+// MockuploadAttachmentUseCaseMockRecorder is the mock recorder for MockuploadAttachmentUseCase.
+type MockuploadAttachmentUseCaseMockRecorder struct {
+	mock *MockuploadAttachmentUseCase
+}
+
+// NewMockuploadAttachmentUseCase creates a new mock instance.
+func NewMockuploadAttachmentUseCase(ctrl *gomock.Controller) *MockuploadAttachmentUseCase {
+	mock := &MockuploadAttachmentUseCase{ctrl: ctrl}
+	mock.recorder = &MockuploadAttachmentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuploadAttachmentUseCase) EXPECT() *MockuploadAttachmentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockuploadAttachmentUseCase) Handle(ctx context.Context, req uploadattachment.Request) (uploadattachment.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(uploadattachment.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockuploadAttachmentUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockuploadAttachmentUseCase)(nil).Handle), ctx, req)
+}
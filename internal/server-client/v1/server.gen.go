@@ -17,6 +17,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
@@ -26,25 +27,94 @@ const (
 
 // Defines values for ErrorCode.
 const (
-	ErrorCodeCreateChatError    ErrorCode = 1000
-	ErrorCodeCreateProblemError ErrorCode = 1001
+	ErrorCodeCreateChatError     ErrorCode = 1000
+	ErrorCodeCreateProblemError  ErrorCode = 1001
+	ErrorCodeDeleteWindowExpired ErrorCode = 1004
+	ErrorCodeEditWindowExpired   ErrorCode = 1003
+	ErrorCodeMessageNotEditable  ErrorCode = 1002
+	ErrorCodeProblemAlreadyRated ErrorCode = 1006
+	ErrorCodeProblemNotResolved  ErrorCode = 1005
+	ErrorCodeRatingWindowExpired ErrorCode = 1007
 )
 
+// Defines values for ReactionKind.
+const (
+	ReactionKindHeart      ReactionKind = "heart"
+	ReactionKindLaugh      ReactionKind = "laugh"
+	ReactionKindSad        ReactionKind = "sad"
+	ReactionKindSurprised  ReactionKind = "surprised"
+	ReactionKindThumbsDown ReactionKind = "thumbs_down"
+	ReactionKindThumbsUp   ReactionKind = "thumbs_up"
+)
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType string             `json:"contentType"`
+	FileName    string             `json:"fileName"`
+	Id          types.AttachmentID `json:"id"`
+	Size        int64              `json:"size"`
+
+	// ThumbnailUrl The signed URL of the image thumbnail. It is absent for the other files.
+	ThumbnailUrl *string `json:"thumbnailUrl,omitempty"`
+
+	// Url The signed download URL, it expires in some time. Get the history again to renew it.
+	Url string `json:"url"`
+}
+
+// DeleteMessageRequest defines model for DeleteMessageRequest.
+type DeleteMessageRequest struct {
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// DeleteMessageResponse defines model for DeleteMessageResponse.
+type DeleteMessageResponse struct {
+	Data  *DeletedMessageHeader `json:"data,omitempty"`
+	Error *Error                `json:"error,omitempty"`
+}
+
+// DeletedMessageHeader defines model for DeletedMessageHeader.
+type DeletedMessageHeader struct {
+	DeletedAt time.Time       `json:"deletedAt"`
+	Id        types.MessageID `json:"id"`
+}
+
+// EditMessageRequest defines model for EditMessageRequest.
+type EditMessageRequest struct {
+	MessageBody string          `json:"messageBody"`
+	MessageId   types.MessageID `json:"messageId"`
+}
+
+// EditMessageResponse defines model for EditMessageResponse.
+type EditMessageResponse struct {
+	Data  *EditedMessageHeader `json:"data,omitempty"`
+	Error *Error               `json:"error,omitempty"`
+}
+
+// EditedMessageHeader defines model for EditedMessageHeader.
+type EditedMessageHeader struct {
+	EditedAt time.Time       `json:"editedAt"`
+	Id       types.MessageID `json:"id"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
+	// The upload errors are 413 for the too large file and 415 for the not allowed file type.
 	Code    ErrorCode `json:"code"`
 	Details *string   `json:"details,omitempty"`
 	Message string    `json:"message"`
 }
 
 // ErrorCode contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
+// The upload errors are 413 for the too large file and 415 for the not allowed file type.
 type ErrorCode int
 
 // GetHistoryRequest defines model for GetHistoryRequest.
 type GetHistoryRequest struct {
-	Cursor   *string `json:"cursor,omitempty"`
-	PageSize *int    `json:"pageSize,omitempty"`
+	// AroundMessageId Open the history at the message (e.g. the search result or the quote), requires pageSize.
+	AroundMessageId *types.MessageID `json:"aroundMessageId,omitempty"`
+	Cursor          *string          `json:"cursor,omitempty"`
+	PageSize        *int             `json:"pageSize,omitempty"`
 }
 
 // GetHistoryResponse defines model for GetHistoryResponse.
@@ -55,13 +125,20 @@ type GetHistoryResponse struct {
 
 // Message defines model for Message.
 type Message struct {
-	AuthorId   *types.UserID   `json:"authorId,omitempty"`
-	Body       string          `json:"body"`
-	CreatedAt  time.Time       `json:"createdAt"`
+	Attachments *[]Attachment `json:"attachments,omitempty"`
+	AuthorId    *types.UserID `json:"authorId,omitempty"`
+	Body        string        `json:"body"`
+	CreatedAt   time.Time     `json:"createdAt"`
+
+	// DeletedAt The body and the attachments of the deleted message are empty.
+	DeletedAt  *time.Time      `json:"deletedAt,omitempty"`
+	EditedAt   *time.Time      `json:"editedAt,omitempty"`
 	Id         types.MessageID `json:"id"`
 	IsBlocked  bool            `json:"isBlocked"`
 	IsReceived bool            `json:"isReceived"`
 	IsService  bool            `json:"isService"`
+	Reactions  *[]Reaction     `json:"reactions,omitempty"`
+	ReplyTo    *Quote          `json:"replyTo,omitempty"`
 }
 
 // MessageHeader defines model for MessageHeader.
@@ -71,15 +148,79 @@ type MessageHeader struct {
 	Id        types.MessageID `json:"id"`
 }
 
+// MessageReactions defines model for MessageReactions.
+type MessageReactions struct {
+	Id        types.MessageID `json:"id"`
+	Reactions []Reaction      `json:"reactions"`
+}
+
 // MessagesPage defines model for MessagesPage.
 type MessagesPage struct {
 	Messages []Message `json:"messages"`
-	Next     string    `json:"next"`
+
+	// Next The cursor to the older messages, empty if there are no ones.
+	Next string `json:"next"`
+
+	// Prev The cursor to the newer messages, empty if the page is the newest one.
+	Prev string `json:"prev"`
+}
+
+// Quote defines model for Quote.
+type Quote struct {
+	// AuthorId Absent if the quoted message is a service one or is hidden from you.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the quoted message body. Empty if the message is deleted or is hidden from you.
+	Preview string `json:"preview"`
+}
+
+// RateProblemRequest defines model for RateProblemRequest.
+type RateProblemRequest struct {
+	Comment   *string         `json:"comment,omitempty"`
+	ProblemId types.ProblemID `json:"problemId"`
+	Score     int             `json:"score"`
+}
+
+// RateProblemResponse defines model for RateProblemResponse.
+type RateProblemResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// Reaction defines model for Reaction.
+type Reaction struct {
+	Count int          `json:"count"`
+	Kind  ReactionKind `json:"kind"`
+
+	// ReactedByMe The current user has put the reaction of this kind.
+	ReactedByMe bool `json:"reactedByMe"`
+}
+
+// ReactionKind defines model for ReactionKind.
+type ReactionKind string
+
+// ReactionRequest defines model for ReactionRequest.
+type ReactionRequest struct {
+	Kind      ReactionKind    `json:"kind"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// ReactionsResponse defines model for ReactionsResponse.
+type ReactionsResponse struct {
+	Data  *MessageReactions `json:"data,omitempty"`
+	Error *Error            `json:"error,omitempty"`
 }
 
 // SendMessageRequest defines model for SendMessageRequest.
 type SendMessageRequest struct {
+	AttachmentIds *[]types.AttachmentID `json:"attachmentIds,omitempty"`
+
+	// MessageBody Can be empty if the message has attachments.
 	MessageBody string `json:"messageBody"`
+
+	// ReplyToMessageId The message of the chat to answer to.
+	ReplyToMessageId *types.MessageID `json:"replyToMessageId,omitempty"`
 }
 
 // SendMessageResponse defines model for SendMessageResponse.
@@ -88,33 +229,110 @@ type SendMessageResponse struct {
 	Error *Error         `json:"error,omitempty"`
 }
 
+// UploadAttachmentRequest defines model for UploadAttachmentRequest.
+type UploadAttachmentRequest struct {
+	File openapi_types.File `json:"file"`
+}
+
+// UploadAttachmentResponse defines model for UploadAttachmentResponse.
+type UploadAttachmentResponse struct {
+	Data  *Attachment `json:"data,omitempty"`
+	Error *Error      `json:"error,omitempty"`
+}
+
 // XRequestIDHeader defines model for XRequestIDHeader.
 type XRequestIDHeader = types.RequestID
 
+// PostAddReactionParams defines parameters for PostAddReaction.
+type PostAddReactionParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostDeleteMessageParams defines parameters for PostDeleteMessage.
+type PostDeleteMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostEditMessageParams defines parameters for PostEditMessage.
+type PostEditMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostGetHistoryParams defines parameters for PostGetHistory.
 type PostGetHistoryParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostRateProblemParams defines parameters for PostRateProblem.
+type PostRateProblemParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostRemoveReactionParams defines parameters for PostRemoveReaction.
+type PostRemoveReactionParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostSendMessageParams defines parameters for PostSendMessage.
 type PostSendMessageParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostUploadAttachmentParams defines parameters for PostUploadAttachment.
+type PostUploadAttachmentParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostAddReactionJSONRequestBody defines body for PostAddReaction for application/json ContentType.
+type PostAddReactionJSONRequestBody = ReactionRequest
+
+// PostDeleteMessageJSONRequestBody defines body for PostDeleteMessage for application/json ContentType.
+type PostDeleteMessageJSONRequestBody = DeleteMessageRequest
+
+// PostEditMessageJSONRequestBody defines body for PostEditMessage for application/json ContentType.
+type PostEditMessageJSONRequestBody = EditMessageRequest
+
 // PostGetHistoryJSONRequestBody defines body for PostGetHistory for application/json ContentType.
 type PostGetHistoryJSONRequestBody = GetHistoryRequest
 
+// PostRateProblemJSONRequestBody defines body for PostRateProblem for application/json ContentType.
+type PostRateProblemJSONRequestBody = RateProblemRequest
+
+// PostRemoveReactionJSONRequestBody defines body for PostRemoveReaction for application/json ContentType.
+type PostRemoveReactionJSONRequestBody = ReactionRequest
+
 // PostSendMessageJSONRequestBody defines body for PostSendMessage for application/json ContentType.
 type PostSendMessageJSONRequestBody = SendMessageRequest
 
+// PostUploadAttachmentMultipartRequestBody defines body for PostUploadAttachment for multipart/form-data ContentType.
+type PostUploadAttachmentMultipartRequestBody = UploadAttachmentRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (POST /addReaction)
+	PostAddReaction(ctx echo.Context, params PostAddReactionParams) error
+
+	// (POST /deleteMessage)
+	PostDeleteMessage(ctx echo.Context, params PostDeleteMessageParams) error
+
+	// (POST /editMessage)
+	PostEditMessage(ctx echo.Context, params PostEditMessageParams) error
+
 	// (POST /getHistory)
 	PostGetHistory(ctx echo.Context, params PostGetHistoryParams) error
 
+	// (POST /rateProblem)
+	PostRateProblem(ctx echo.Context, params PostRateProblemParams) error
+
+	// (POST /removeReaction)
+	PostRemoveReaction(ctx echo.Context, params PostRemoveReactionParams) error
+
 	// (POST /sendMessage)
 	PostSendMessage(ctx echo.Context, params PostSendMessageParams) error
+
+	// (POST /uploadAttachment)
+	PostUploadAttachment(ctx echo.Context, params PostUploadAttachmentParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -122,6 +340,105 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// PostAddReaction converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAddReaction(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAddReactionParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAddReaction(ctx, params)
+	return err
+}
+
+// PostDeleteMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDeleteMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostDeleteMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostDeleteMessage(ctx, params)
+	return err
+}
+
+// PostEditMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEditMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostEditMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostEditMessage(ctx, params)
+	return err
+}
+
 // PostGetHistory converts echo context to params.
 func (w *ServerInterfaceWrapper) PostGetHistory(ctx echo.Context) error {
 	var err error
@@ -155,6 +472,72 @@ func (w *ServerInterfaceWrapper) PostGetHistory(ctx echo.Context) error {
 	return err
 }
 
+// PostRateProblem converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRateProblem(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostRateProblemParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRateProblem(ctx, params)
+	return err
+}
+
+// PostRemoveReaction converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRemoveReaction(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostRemoveReactionParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRemoveReaction(ctx, params)
+	return err
+}
+
 // PostSendMessage converts echo context to params.
 func (w *ServerInterfaceWrapper) PostSendMessage(ctx echo.Context) error {
 	var err error
@@ -188,6 +571,39 @@ func (w *ServerInterfaceWrapper) PostSendMessage(ctx echo.Context) error {
 	return err
 }
 
+// PostUploadAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUploadAttachment(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUploadAttachmentParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUploadAttachment(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -216,31 +632,60 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/addReaction", wrapper.PostAddReaction)
+	router.POST(baseURL+"/deleteMessage", wrapper.PostDeleteMessage)
+	router.POST(baseURL+"/editMessage", wrapper.PostEditMessage)
 	router.POST(baseURL+"/getHistory", wrapper.PostGetHistory)
+	router.POST(baseURL+"/rateProblem", wrapper.PostRateProblem)
+	router.POST(baseURL+"/removeReaction", wrapper.PostRemoveReaction)
 	router.POST(baseURL+"/sendMessage", wrapper.PostSendMessage)
+	router.POST(baseURL+"/uploadAttachment", wrapper.PostUploadAttachment)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xWTW/jNhD9K8S0hxagLbnpYSGgh3y0mxRdNNik6AKpD7Q0kdiVSC05cpMN9N+LIWVL",
-	"jp0mXewWPdni8GPmvTePfIDcNq01aMhD9gCtcqpBQhe+3r3FDx16ujg7R1Wg4zFtIIMqfkowqkHI4N1s",
-	"mDm7OAMJDj902mEBGbkOJfi8wkbx6lvrGkWQQdfpAiTQfcvrPTltSpBwNyvtbBjkHz/fpjCNznTTWkcx",
-	"Y6ogg1JT1a3muW2Sj+hJldomeaVo5tGtdY6JNoTOqDoJ20Lf9/0msVDrj87ZUGDrbIuONIbh3BbIv187",
-	"vIUMvkpGvJJhdRKWnvLEXkKBpHQd1u4W10to0HtV4oFYPwXtZjtRxvOXvYTxkOwBCvS50y1py2zk1pDS",
-	"xovz6+tLgTxR8DovlCmEbzHXtzoXq85rg96L2pY635n3DVUoauVJNJ0nsULxR5emR/iDWKRp+u0cJKDp",
-	"Gshu+Fsu0nSxlNBooxse/T5Nt1QyzmXQxt2M18zWyrFKPNe1LeLUoSI8rRRF3OXj0KWzqxqbGOX6XyOd",
-	"a0/W3Q+KOMBV53zkcA/5VpV4pT8G8Bp1F9NecNrbIhb7NQSNTA/2rTUe908uFKnnVPImcuovmdheAm4E",
-	"96y0Yh5vRvGouv71FrKbFx04tG4vHye9ssX9QbC0P6lt/p6luI2urK1RmRh+iznq9dPxq9hzh8KPhB5y",
-	"2Nlyevx0r2W/HEEY7Wi3JNVRZd1F8WlW85tH9/l9RkIeFF0c005ahSKckW5wLzfG8BNLGPD5Am65y1tI",
-	"Z6xrQk3U9x4zg6OF/5qw8S9sFwZjqFA5p+752+AdPe+hYZYcD+Ycr9AUw8ZPusiw4GTojkbd/YKmZNiO",
-	"0sEwNgML+TIfD3vtnf8ZzGTb2//STfjqw7xzmu6vODYYAiqH7rjj0jZfP20k+PPv1zBcmKGhQ3TUZEXU",
-	"Rploc2sDO5pqjpwo815cdS1LULDji9NaoyFxfHkBEtbofLzG1gsuxLZoVKshg6N5Oj8CGTQb8kvKrRUH",
-	"1Gykb/cyfI0kWMqiijP57mJ0FcfZGeDSehpNPRwwPnqe8NRxSrL3KOqXkXT0tBEN38hoQnaqbWudh9OT",
-	"Pz2n+DB5D/0TW/s33qM25MdVGIhKChh9l6ZfJIFBrCGDXcA3fS9q7Wk+qCvxo9Kf5orbQRj8Swx9IsgK",
-	"fokwf4d5mzTQ/5e4Ay7zHzN3yGeepk4MRh7Jm3hDQHXqCjdLxowviQ3muxue4Rpr2zbc3nEWSOhcPRhE",
-	"liS1zVVdWU/Zq/RVmnDPL/u/AwAA//9jQfg7hgwAAA==",
+	"H4sIAAAAAAAC/+xaW2/bOBb+KwR3H2YAxXYm6ezAwD6kSXeanbaTzQUzQGssaOlY4lQiVZKK4xb+74tD",
+	"UjdLSrxuEniKeXEi8XZ4vo/nRn2hocxyKUAYTadfaM4Uy8CAsk+/X8KnArQ5P3sNLAKF77igU5q4x4AK",
+	"lgGd0t8PfM+D8zMaUAWfCq4golOjCgioDhPIGI5eSJUxQ6e0KHhEA2pWOY7XRnER04DeHcTywL/EP3pU",
+	"idBsPeBZLpVxEpuETmnMTVLMR6HMxp9BGxZzOQ4TZg40qFsewpgLA0qwdGynpev1el0KZvd6YgwLkwyE",
+	"m1XJHJThYNtCKQwIc23l+rIh9DqgC57CO5b1N/Jot43XAj3+3gOq+WdoycWF+fG4FgyHxKBwAyYpsrlg",
+	"PL1RKQ6JQIeK54ZLpMJ1AkTzWEBEbi7fELkgJgHCMxYDqUaOyLkhXBM21yAMWUhle0mTgCKoPT3q6GQd",
+	"0OKBBSO5FKlkduWAcEPgLucKNOGCaJkBMTyDEfkZjF0u4dpItSIsZlwQI4kCAUvCTc/i6yaL31OLWQVz",
+	"0GKEV6YTd7YO6BmkYOAtaM1i8Pztkipz7ec70sNP/wTnor3zWsyerelcCg3dvUXM2OP+dwULOqV/G9dW",
+	"ZuzP3NhNFfm5vIFZBxSUkuqhwa9sJyts7zxdiVyvE9PSdsQMHCBJaPB4B/e5kLHi1PtCeF5F3GzJu5cy",
+	"WtlHdvcGRIwiHU0mk4BmXJQvDnu08qejbdDacUdLX0NhnOgRGNw3TUceiPi3yN9qWxaYUnGb7jeCrdR5",
+	"ih3XeCgM46nudceeDD1t/Qyyxj6CWr5TL03bK6FDYFxo8vr6+oJYBhAcpwkTEdE5hHzBQzIvNBegNUll",
+	"zMNWv+/QRaVMG5IV2pA5kA/FZHIE/ySHk8nk+9EHgZ6vyK3DswM1YQrI8eFR5VCNlCRlKgbrVO3Sx4cv",
+	"qmYhDWFpKpcQuQ6ogdEHQQMKosjo9D0uFRxOJof48wP+HOHPMf68wJ8f8ecfM2smeIZjjtFobEQNSByc",
+	"8eCWKQwRNSq00t6pAmbgNGHGvqLBZtOFkvMUsk6rZ+Y7afDIsHkKzVZ89xsXkVy+smFA1Gx0XmKw2a/4",
+	"TppL0DK97W09SRWwaHXJTLv5khku4vbcSJefwbx2IcegMWZKFiJ627SpbV79moNoBy8ulvHsJN/BKB7Z",
+	"NxqYChOiQBepIR7xT4U08H1APLE1yVkMV/wzYMizR6YhoGGhtDv8nSNbyuzdlaPdofdV5VM3cl1vQPA1",
+	"lt5vXF+gRdjBxL+trQ5L018XdPp+qwUrp9IhTpUd2EduINMPydNIcdaVuphSbIXPcx8QdNTfCp26sTiO",
+	"s5YG+dYQq0wF/PCKsWizIMvNqkXBez3ZDr5Pv0xl+BGixo7mUqbAhGu+hBD47XD7lWNqf7MCFqIGtlf9",
+	"pR/Rp3gFebq6lg9N8R88yh0vZVFrbai5+eZOZutZTcShKIMVJpFq1+DuRoN6EttgvcK3F/zU+2pAc9kk",
+	"VxudvdvU4x6FPhXV8zdU5MzwUGazvSh+ur5DKeBuwN45R4XlA1vCSCNQpW3TgbNshFvTp5yxE5JIMVDh",
+	"yBXcbrOMgOXgMtanY3Wl7KgNLvhwUcPu0csQ1NpDRTtjc695aIt84io7fFGHHbXJx8oP8SRC0TA64Zok",
+	"PIpAkIWSGVnJYreQ5Klszr7muQ4vDssBbwwxF4KLmMheKNBfjMirJn0aKJW+ehCfrVImm3SXUiKbLuuY",
+	"fjASDmVWFl8bJQmbj/QdGzvZruB4WZ4CHB1K1Q5UXzTD1J4ota3CemflXB0FPhTH+hXk/A8IzS7BamWh",
+	"e0AqPET37CigH7mItnUDv2Df0pFA9HL1FgYNokITU2hQJGGa5IVLhUoX4RjPNcHlG2StArcNVVspA7+n",
+	"tgCzhhZ+8ZvxGbIrh+v/FjkNyv+xDk0DmgBTOFPKijhB/AqVK65tHKZZRGd93Oykyc11r+38N7hU9/WZ",
+	"W7XZ8NpL0Hz3xkvTfHfVkKz1nkWtvQ+e1l0Q/hNWDu02mwrRj5JGVrPtcjqvoCoYDJcV6rujqB0O7ds9",
+	"VMbuzp1sh5ONKCygheCfCvDtRhWwDjbL1207ccoEFs6gz72hyaj1YsOxTu2742h8bnZPgea6sYR3ubhn",
+	"DNyY0Bi1GblnxZZ+wlfl8RbBHoHtuxfGb2yxsybgIN+xmNli95wLplYPBix23Czouszuyl+jiHbh5f/T",
+	"AsYUEBaKm9UVtrlV58AUqJPCJPXTv8rN//u3a+rvtq3/s621LhJjcqdfLha27GC4Qf3Rl0x8JFdFjgwj",
+	"WJ8lpylHn3tycU4DegtKO9LfHuJGZA6C5ZxO6dFoMjqigaWklW/MoqgVRkjdk05ddDy4IKb/PI3IRWGw",
+	"zIrXvGaJiUSYMBGDxqp2wkWMhwyBYTgTnlR6IbU5acgRtD5vGCjA1V3Gnc8f1jNHH9CmtD/+Ihj/ZXme",
+	"8tAuP/5Du33XXz5s4yn9eptH1Fs+5TloFfzDZPLoy9fOzQqwkeCFpmBpBZXewGrkuTqOmjfEw9C7eryd",
+	"QS5FhfiSI5Z13RBJsbSF9RGpio0bqclcmoRoHoHup0Drznp/SdD71cAzM6H/er+HDb5LmTBW6EN9tTqM",
+	"PV7U3Ic8TlKi3oto4wJ3f/HsuYt/ZjT77rnvwdJV2Sso4+rqZBhJ/LLGRjv+dqofrvoSZn/R6t7VPTNY",
+	"PTdVw1hpknJtKqhUXR4YxgprCN7buhtO4msNRIoQguYBVPZC0x9BorkIGyMLnK8f6EaZYo+dbbcY9dz+",
+	"tqea04P1O0kwuERHq4swBK1rwCGTt/BwgHXNPtY+tlEnWQyGWf24tpf7K456hjhK11nYML6YqmHJv4LS",
+	"yAegbCR3+4tjT4njmaHsy4HvcZ3+Iq8Cr9hIH4cRdImmBc19kSN9kcLmOeXlz52pC/grwo0m52f+g6Dq",
+	"Ox5XvzcQYgHf9/K6CHxAHaZMYXVf2M48FlJB5L7/6TJlMwV+arpkRWp4zpQZYxZ/UObV2+E1VCl4ZtoM",
+	"lg36DEHVy3/UVfKnkfFbNTdz/fczVCJWdkoQNtOqW0hlbmd1vfx3yS7tn47HqQxZmkhtpj9NfpqMMZOf",
+	"rf83AIwNJZMHMAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
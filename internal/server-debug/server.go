@@ -3,6 +3,7 @@ package serverdebug
 import (
 	"context"
 	"errors"
+	"expvar"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
@@ -33,6 +34,8 @@ type Options struct {
 
 	managerSwagger       *openapi3.T `option:"mandatory" validate:"required"`
 	managerEventsSwagger *openapi3.T `option:"mandatory" validate:"required"`
+
+	moderatorSwagger *openapi3.T `option:"mandatory" validate:"required"`
 }
 
 type Server struct {
@@ -82,6 +85,9 @@ func New(opts Options) (*Server, error) {
 		index.addPage("/debug/pprof/profile?seconds=30", "Take half-min profile")
 	}
 
+	e.GET("/debug/vars", echo.WrapHandler(expvar.Handler()))
+	index.addPage("/debug/vars", "Application metrics")
+
 	e.GET("/debug/error", s.DebugError)
 	index.addPage("/debug/error", "Debug Sentry error event")
 
@@ -97,6 +103,9 @@ func New(opts Options) (*Server, error) {
 
 		e.GET("/schema/managerevents", s.ExposeSchema(opts.managerEventsSwagger))
 		index.addPage("/schema/managerevents", "Get manager events OpenAPI specification")
+
+		e.GET("/schema/moderator", s.ExposeSchema(opts.moderatorSwagger))
+		index.addPage("/schema/moderator", "Get moderator OpenAPI specification")
 	}
 
 	e.GET("/", index.handler)
@@ -17,6 +17,7 @@ func NewOptions(
 	clientEventsSwagger *openapi3.T,
 	managerSwagger *openapi3.T,
 	managerEventsSwagger *openapi3.T,
+	moderatorSwagger *openapi3.T,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -33,6 +34,8 @@ func NewOptions(
 
 	o.managerEventsSwagger = managerEventsSwagger
 
+	o.moderatorSwagger = moderatorSwagger
+
 	for _, opt := range options {
 		opt(&o)
 	}
@@ -46,6 +49,7 @@ func (o *Options) Validate() error {
 	errs.Add(errors461e464ebed9.NewValidationError("clientEventsSwagger", _validate_Options_clientEventsSwagger(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("managerSwagger", _validate_Options_managerSwagger(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("managerEventsSwagger", _validate_Options_managerEventsSwagger(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("moderatorSwagger", _validate_Options_moderatorSwagger(o)))
 	return errs.AsError()
 }
 
@@ -83,3 +87,10 @@ func _validate_Options_managerEventsSwagger(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_moderatorSwagger(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.moderatorSwagger, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `moderatorSwagger` did not pass the test: %w", err)
+	}
+	return nil
+}
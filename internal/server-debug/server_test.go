@@ -20,6 +20,7 @@ import (
 	serverdebug "github.com/zestagio/chat-service/internal/server-debug"
 	managerevents "github.com/zestagio/chat-service/internal/server-manager/events"
 	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
+	moderatorv1 "github.com/zestagio/chat-service/internal/server-moderator/v1"
 )
 
 func TestServer_LoggerLevel(t *testing.T) {
@@ -39,12 +40,16 @@ func TestServer_LoggerLevel(t *testing.T) {
 	managerEventsSwagger, err := managerevents.GetSwagger()
 	require.NoError(t, err)
 
+	moderatorV1Swagger, err := moderatorv1.GetSwagger()
+	require.NoError(t, err)
+
 	srv, err := serverdebug.New(serverdebug.NewOptions(
 		":80",
 		clientV1Swagger,
 		clientEventsSwagger,
 		managerV1Swagger,
 		managerEventsSwagger,
+		moderatorV1Swagger,
 	))
 	require.NoError(t, err)
 
@@ -5,6 +5,7 @@ import (
 
 	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
 	websocketstream "github.com/zestagio/chat-service/internal/websocket-stream"
+	"github.com/zestagio/chat-service/pkg/pointer"
 )
 
 var _ websocketstream.EventAdapter = Adapter{}
@@ -31,11 +32,15 @@ func (Adapter) Adapt(sEvent eventstream.Event) (any, error) {
 		event.RequestId = v.RequestID
 
 		err = event.FromNewMessageEvent(NewMessageEvent{
-			AuthorId:  v.AuthorID,
-			Body:      v.MessageBody,
-			ChatId:    v.ChatID,
-			CreatedAt: v.CreatedAt,
-			MessageId: v.MessageID,
+			Attachments: adaptAttachments(v.Attachments),
+			AuthorId:    v.AuthorID,
+			Body:        v.MessageBody,
+			ChatId:      v.ChatID,
+			CreatedAt:   v.CreatedAt,
+			MessageId:   v.MessageID,
+			ReplyTo:     adaptQuote(v.ReplyTo),
+
+			IsInternalNote: pointer.PtrWithZeroAsNil(v.IsInternalNote),
 		})
 
 	case *eventstream.ChatClosedEvent:
@@ -47,6 +52,52 @@ func (Adapter) Adapt(sEvent eventstream.Event) (any, error) {
 			ChatId:              v.ChatID,
 		})
 
+	case *eventstream.MessageEditedEvent:
+		event.EventId = v.EventID
+		event.RequestId = v.RequestID
+
+		err = event.FromMessageEditedEvent(MessageEditedEvent{
+			Body:      v.MessageBody,
+			ChatId:    v.ChatID,
+			EditedAt:  v.EditedAt,
+			MessageId: v.MessageID,
+		})
+
+	case *eventstream.MessageDeletedEvent:
+		event.EventId = v.EventID
+		event.RequestId = v.RequestID
+
+		err = event.FromMessageDeletedEvent(MessageDeletedEvent{
+			ChatId:    v.ChatID,
+			DeletedAt: v.DeletedAt,
+			MessageId: v.MessageID,
+		})
+
+	case *eventstream.ReactionsChangedEvent:
+		event.EventId = v.EventID
+		event.RequestId = v.RequestID
+
+		err = event.FromReactionsChangedEvent(ReactionsChangedEvent{
+			ChatId:    v.ChatID,
+			MessageId: v.MessageID,
+			Reactions: adaptReactions(v.Reactions),
+		})
+
+	case *eventstream.ChatUpdatedEvent:
+		event.EventId = v.EventID
+		event.RequestId = v.RequestID
+
+		e := ChatUpdatedEvent{
+			ChatId:           v.ChatID,
+			LastMessage:      adaptLastMessage(v.LastMessage),
+			ProblemCreatedAt: v.ProblemCreatedAt,
+			UnreadCount:      v.UnreadCount,
+		}
+		if !v.WaitingSince.IsZero() {
+			e.WaitingSince = &v.WaitingSince
+		}
+		err = event.FromChatUpdatedEvent(e)
+
 	default:
 		return nil, fmt.Errorf("unknown manager event: %v (%T)", v, v)
 	}
@@ -59,3 +110,57 @@ func (Adapter) Adapt(sEvent eventstream.Event) (any, error) {
 
 	return event, nil
 }
+
+func adaptAttachments(attachments []eventstream.Attachment) *[]Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	result := make([]Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		result = append(result, Attachment{
+			ContentType:  a.ContentType,
+			FileName:     a.FileName,
+			Id:           a.ID,
+			Size:         a.Size,
+			ThumbnailUrl: pointer.PtrWithZeroAsNil(a.ThumbnailURL),
+			Url:          a.URL,
+		})
+	}
+	return &result
+}
+
+func adaptReactions(reactions []eventstream.Reaction) []Reaction {
+	result := make([]Reaction, 0, len(reactions))
+	for _, r := range reactions {
+		result = append(result, Reaction{
+			Count:       r.Count,
+			Kind:        ReactionKind(r.Kind),
+			ReactedByMe: r.ReactedByMe,
+		})
+	}
+	return result
+}
+
+func adaptQuote(q *eventstream.Quote) *Quote {
+	if q == nil {
+		return nil
+	}
+	return &Quote{
+		AuthorId:  q.AuthorID.AsPointer(),
+		MessageId: q.MessageID,
+		Preview:   q.Preview,
+	}
+}
+
+func adaptLastMessage(m *eventstream.LastMessage) *LastMessage {
+	if m == nil {
+		return nil
+	}
+	return &LastMessage{
+		AuthorId:  m.AuthorID.AsPointer(),
+		CreatedAt: m.CreatedAt,
+		MessageId: m.MessageID,
+		Preview:   m.Preview,
+	}
+}
@@ -49,8 +49,28 @@ func TestAdapter_Adapt(t *testing.T) {
 				time.Unix(2, 2).UTC(),
 				"Hello, manager!",
 				false,
+				[]eventstream.Attachment{
+					{
+						ID:          types.MustParse[types.AttachmentID]("5c1c0a9a-bc31-11ed-9b2c-461e464ebed8"),
+						FileName:    "statement.pdf",
+						ContentType: "application/pdf",
+						Size:        4096,
+						URL:         "/attachments/5c1c0a9a-bc31-11ed-9b2c-461e464ebed8?signature=a",
+					},
+				},
+				nil,
+				false,
 			),
 			expJSON: `{
+				"attachments": [
+					{
+						"contentType": "application/pdf",
+						"fileName": "statement.pdf",
+						"id": "5c1c0a9a-bc31-11ed-9b2c-461e464ebed8",
+						"size": 4096,
+						"url": "/attachments/5c1c0a9a-bc31-11ed-9b2c-461e464ebed8?signature=a"
+					}
+				],
 				"authorId": "a322bb38-bc31-11ed-83a2-461e464ebed8",
 				"body": "Hello, manager!",
 				"chatId": "31b4dc06-bc31-11ed-93cc-461e464ebed8",
@@ -61,6 +81,194 @@ func TestAdapter_Adapt(t *testing.T) {
 				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
 			}`,
 		},
+
+		{
+			name: "reply to hidden message",
+			ev: eventstream.NewNewMessageEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				types.MustParse[types.UserID]("a322bb38-bc31-11ed-83a2-461e464ebed8"),
+				time.Unix(2, 2).UTC(),
+				"Yes",
+				false,
+				nil,
+				&eventstream.Quote{MessageID: types.MustParse[types.MessageID]("8e5e6f2c-bc31-11ed-a6d1-461e464ebed8")},
+				false,
+			),
+			expJSON: `{
+				"authorId": "a322bb38-bc31-11ed-83a2-461e464ebed8",
+				"body": "Yes",
+				"chatId": "31b4dc06-bc31-11ed-93cc-461e464ebed8",
+				"createdAt": "1970-01-01T00:00:02.000000002Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "NewMessageEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"replyTo": {
+					"messageId": "8e5e6f2c-bc31-11ed-a6d1-461e464ebed8",
+					"preview": ""
+				},
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+
+		{
+			name: "internal note",
+			ev: eventstream.NewNewMessageEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				types.MustParse[types.UserID]("a322bb38-bc31-11ed-83a2-461e464ebed8"),
+				time.Unix(2, 2).UTC(),
+				"The client asked for the refund twice",
+				false,
+				nil,
+				nil,
+				true,
+			),
+			expJSON: `{
+				"authorId": "a322bb38-bc31-11ed-83a2-461e464ebed8",
+				"body": "The client asked for the refund twice",
+				"chatId": "31b4dc06-bc31-11ed-93cc-461e464ebed8",
+				"createdAt": "1970-01-01T00:00:02.000000002Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "NewMessageEvent",
+				"isInternalNote": true,
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+
+		{
+			name: "edited message",
+			ev: eventstream.NewMessageEditedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				time.Unix(3, 3).UTC(),
+				"Hello, client! (edited)",
+			),
+			expJSON: `{
+				"body": "Hello, client! (edited)",
+				"chatId": "31b4dc06-bc31-11ed-93cc-461e464ebed8",
+				"editedAt": "1970-01-01T00:00:03.000000003Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "MessageEditedEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "deleted message",
+			ev: eventstream.NewMessageDeletedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				time.Unix(4, 4).UTC(),
+			),
+			expJSON: `{
+				"chatId": "31b4dc06-bc31-11ed-93cc-461e464ebed8",
+				"deletedAt": "1970-01-01T00:00:04.000000004Z",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "MessageDeletedEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "reactions changed",
+			ev: eventstream.NewReactionsChangedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				[]eventstream.Reaction{
+					{Kind: "thumbs_up", Count: 2, ReactedByMe: true},
+				},
+			),
+			expJSON: `{
+				"chatId": "31b4dc06-bc31-11ed-93cc-461e464ebed8",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "ReactionsChangedEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"reactions": [{"kind": "thumbs_up", "count": 2, "reactedByMe": true}],
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "last reaction taken off",
+			ev: eventstream.NewReactionsChangedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				nil,
+			),
+			expJSON: `{
+				"chatId": "31b4dc06-bc31-11ed-93cc-461e464ebed8",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "ReactionsChangedEvent",
+				"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"reactions": [],
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8"
+			}`,
+		},
+		{
+			name: "chat updated",
+			ev: eventstream.NewChatUpdatedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				time.Date(2023, time.March, 7, 10, 0, 0, 0, time.UTC),
+				3,
+				&eventstream.LastMessage{
+					MessageID: types.MustParse[types.MessageID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+					AuthorID:  types.MustParse[types.UserID]("a0ac5e3e-bc30-11ed-8d0e-461e464ebed8"),
+					Preview:   "Where is my money?",
+					CreatedAt: time.Date(2023, time.March, 7, 10, 5, 0, 0, time.UTC),
+				},
+				time.Date(2023, time.March, 7, 10, 2, 0, 0, time.UTC),
+			),
+			expJSON: `{
+				"chatId": "31b4dc06-bc31-11ed-93cc-461e464ebed8",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "ChatUpdatedEvent",
+				"lastMessage": {
+					"messageId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+					"authorId": "a0ac5e3e-bc30-11ed-8d0e-461e464ebed8",
+					"preview": "Where is my money?",
+					"createdAt": "2023-03-07T10:05:00Z"
+				},
+				"problemCreatedAt": "2023-03-07T10:00:00Z",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8",
+				"unreadCount": 3,
+				"waitingSince": "2023-03-07T10:02:00Z"
+			}`,
+		},
+		{
+			name: "chat updated without messages",
+			ev: eventstream.NewChatUpdatedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("31b4dc06-bc31-11ed-93cc-461e464ebed8"),
+				time.Date(2023, time.March, 7, 10, 0, 0, 0, time.UTC),
+				0,
+				nil,
+				time.Time{},
+			),
+			expJSON: `{
+				"chatId": "31b4dc06-bc31-11ed-93cc-461e464ebed8",
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "ChatUpdatedEvent",
+				"problemCreatedAt": "2023-03-07T10:00:00Z",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8",
+				"unreadCount": 0
+			}`,
+		},
 	}
 
 	for _, tt := range cases {
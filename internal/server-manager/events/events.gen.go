@@ -20,6 +20,26 @@ import (
 	"github.com/zestagio/chat-service/internal/types"
 )
 
+// Defines values for ReactionKind.
+const (
+	ReactionKindHeart      ReactionKind = "heart"
+	ReactionKindLaugh      ReactionKind = "laugh"
+	ReactionKindSad        ReactionKind = "sad"
+	ReactionKindSurprised  ReactionKind = "surprised"
+	ReactionKindThumbsDown ReactionKind = "thumbs_down"
+	ReactionKindThumbsUp   ReactionKind = "thumbs_up"
+)
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType  string             `json:"contentType"`
+	FileName     string             `json:"fileName"`
+	Id           types.AttachmentID `json:"id"`
+	Size         int64              `json:"size"`
+	ThumbnailUrl *string            `json:"thumbnailUrl,omitempty"`
+	Url          string             `json:"url"`
+}
+
 // ChatClosedEvent defines model for ChatClosedEvent.
 type ChatClosedEvent struct {
 	CanTakeMoreProblems bool         `json:"canTakeMoreProblems"`
@@ -31,6 +51,21 @@ type ChatId struct {
 	ChatId types.ChatID `json:"chatId"`
 }
 
+// ChatUpdatedEvent defines model for ChatUpdatedEvent.
+type ChatUpdatedEvent struct {
+	ChatId      types.ChatID `json:"chatId"`
+	LastMessage *LastMessage `json:"lastMessage,omitempty"`
+
+	// ProblemCreatedAt The problem age is counted from it.
+	ProblemCreatedAt time.Time `json:"problemCreatedAt"`
+
+	// UnreadCount The client messages created after the manager has read the chat.
+	UnreadCount int `json:"unreadCount"`
+
+	// WaitingSince The oldest client message the manager has not answered yet. Absent if there is none.
+	WaitingSince *time.Time `json:"waitingSince,omitempty"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	EventId   types.EventID   `json:"eventId"`
@@ -39,12 +74,43 @@ type Event struct {
 	union     json.RawMessage
 }
 
+// LastMessage defines model for LastMessage.
+type LastMessage struct {
+	// AuthorId Absent if the message is a service one.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the message body.
+	Preview string `json:"preview"`
+}
+
 // Message defines model for Message.
 type Message struct {
-	AuthorId  types.UserID    `json:"authorId"`
+	Attachments *[]Attachment `json:"attachments,omitempty"`
+	AuthorId    types.UserID  `json:"authorId"`
+	Body        string        `json:"body"`
+	ChatId      types.ChatID  `json:"chatId"`
+	CreatedAt   time.Time     `json:"createdAt"`
+
+	// IsInternalNote The note is visible for managers only.
+	IsInternalNote *bool           `json:"isInternalNote,omitempty"`
+	MessageId      types.MessageID `json:"messageId"`
+	ReplyTo        *Quote          `json:"replyTo,omitempty"`
+}
+
+// MessageDeletedEvent defines model for MessageDeletedEvent.
+type MessageDeletedEvent struct {
+	ChatId    types.ChatID    `json:"chatId"`
+	DeletedAt time.Time       `json:"deletedAt"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// MessageEditedEvent defines model for MessageEditedEvent.
+type MessageEditedEvent struct {
 	Body      string          `json:"body"`
 	ChatId    types.ChatID    `json:"chatId"`
-	CreatedAt time.Time       `json:"createdAt"`
+	EditedAt  time.Time       `json:"editedAt"`
 	MessageId types.MessageID `json:"messageId"`
 }
 
@@ -63,6 +129,35 @@ type NewChatEvent struct {
 // NewMessageEvent defines model for NewMessageEvent.
 type NewMessageEvent = Message
 
+// Quote defines model for Quote.
+type Quote struct {
+	// AuthorId Absent if the quoted message is a service one or is hidden from you.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the quoted message body. Empty if the message is deleted or is hidden from you.
+	Preview string `json:"preview"`
+}
+
+// Reaction defines model for Reaction.
+type Reaction struct {
+	Count       int          `json:"count"`
+	Kind        ReactionKind `json:"kind"`
+	ReactedByMe bool         `json:"reactedByMe"`
+}
+
+// ReactionKind defines model for Reaction.Kind.
+type ReactionKind string
+
+// ReactionsChangedEvent defines model for ReactionsChangedEvent.
+type ReactionsChangedEvent struct {
+	ChatId    types.ChatID    `json:"chatId"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Reactions The actual reactions on the message, empty if the last one was taken off.
+	Reactions []Reaction `json:"reactions"`
+}
+
 // AsNewChatEvent returns the union data inside the Event as a NewChatEvent
 func (t Event) AsNewChatEvent() (NewChatEvent, error) {
 	var body NewChatEvent
@@ -153,6 +248,126 @@ func (t *Event) MergeChatClosedEvent(v ChatClosedEvent) error {
 	return err
 }
 
+// AsMessageEditedEvent returns the union data inside the Event as a MessageEditedEvent
+func (t Event) AsMessageEditedEvent() (MessageEditedEvent, error) {
+	var body MessageEditedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMessageEditedEvent overwrites any union data inside the Event as the provided MessageEditedEvent
+func (t *Event) FromMessageEditedEvent(v MessageEditedEvent) error {
+	t.EventType = "MessageEditedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMessageEditedEvent performs a merge with any union data inside the Event, using the provided MessageEditedEvent
+func (t *Event) MergeMessageEditedEvent(v MessageEditedEvent) error {
+	t.EventType = "MessageEditedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsMessageDeletedEvent returns the union data inside the Event as a MessageDeletedEvent
+func (t Event) AsMessageDeletedEvent() (MessageDeletedEvent, error) {
+	var body MessageDeletedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMessageDeletedEvent overwrites any union data inside the Event as the provided MessageDeletedEvent
+func (t *Event) FromMessageDeletedEvent(v MessageDeletedEvent) error {
+	t.EventType = "MessageDeletedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMessageDeletedEvent performs a merge with any union data inside the Event, using the provided MessageDeletedEvent
+func (t *Event) MergeMessageDeletedEvent(v MessageDeletedEvent) error {
+	t.EventType = "MessageDeletedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsReactionsChangedEvent returns the union data inside the Event as a ReactionsChangedEvent
+func (t Event) AsReactionsChangedEvent() (ReactionsChangedEvent, error) {
+	var body ReactionsChangedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromReactionsChangedEvent overwrites any union data inside the Event as the provided ReactionsChangedEvent
+func (t *Event) FromReactionsChangedEvent(v ReactionsChangedEvent) error {
+	t.EventType = "ReactionsChangedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeReactionsChangedEvent performs a merge with any union data inside the Event, using the provided ReactionsChangedEvent
+func (t *Event) MergeReactionsChangedEvent(v ReactionsChangedEvent) error {
+	t.EventType = "ReactionsChangedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChatUpdatedEvent returns the union data inside the Event as a ChatUpdatedEvent
+func (t Event) AsChatUpdatedEvent() (ChatUpdatedEvent, error) {
+	var body ChatUpdatedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChatUpdatedEvent overwrites any union data inside the Event as the provided ChatUpdatedEvent
+func (t *Event) FromChatUpdatedEvent(v ChatUpdatedEvent) error {
+	t.EventType = "ChatUpdatedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChatUpdatedEvent performs a merge with any union data inside the Event, using the provided ChatUpdatedEvent
+func (t *Event) MergeChatUpdatedEvent(v ChatUpdatedEvent) error {
+	t.EventType = "ChatUpdatedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t Event) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"eventType"`
@@ -169,10 +384,18 @@ func (t Event) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "ChatClosedEvent":
 		return t.AsChatClosedEvent()
+	case "ChatUpdatedEvent":
+		return t.AsChatUpdatedEvent()
+	case "MessageDeletedEvent":
+		return t.AsMessageDeletedEvent()
+	case "MessageEditedEvent":
+		return t.AsMessageEditedEvent()
 	case "NewChatEvent":
 		return t.AsNewChatEvent()
 	case "NewMessageEvent":
 		return t.AsNewMessageEvent()
+	case "ReactionsChangedEvent":
+		return t.AsReactionsChangedEvent()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -248,18 +471,29 @@ func (t *Event) UnmarshalJSON(b []byte) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xWzW7bSAx+FYG7wF7GlrN7CXTbJkURFEmKJj0FOYwlWp5EGk5nKLupoXcvOJb/jTRx",
-	"66KHniRw+PORH8mZGeRUO7JoOUA2g5CPsdbx92ys+ayigMXbCVoWka6q6xFkdzP42+MIMvgrXZmnnW0q",
-	"hhcFtGoGzpNDzwajx1zbW/2Il+Txg6dhhXUU85NDyGBIVKG20LYKPH5ujMcCsru9VvdqYUXDB8wZ2vtW",
-	"QRc424m7lI/I15ohg6YxBSydBPbGlqDgS6+kXieUT+hHn+frRz1TO/KxHk7zGDIoDY+bYT+nOv2KgXVp",
-	"KJWYvYB+YnJMjWX0Vldp9AntTopzgJLDstaFCbk3tbGaya/l9HSla4GHongrUFsFZPEFxFzhVNKZhxB6",
-	"vqN8iSHoEl+mv90u7b3aoiEiPpSH6PTnE6HW6rhqxQ5HxxKGg1F/7MyP3UCrJNSyzOvgpbM6Ng+a4+fU",
-	"Or97J143PCZ/aPU+BfTHoHxIxdNetnOPmrH4nzfwFpqxx6bGHdDbNCzT7WKse9y/slbF29la9frR64u3",
-	"8Hzs1lvBlHw2VswvuzEU5JX5geVynEbbXvILiOoVV9r2Fv4zvL/N8Iq9sSOKYAxXcvpG28fkpnGSZyIU",
-	"JJfa6hJ9EukLoGCCPhiykMHkJF7dDq12BjL4rz/oD0DF4kQC0sDNUH5KnD8JUJ4EjufmF5w0AUMyIp+U",
-	"aNFrNrZM4voP/eSax+inJmBiOCkIg/2H+xDjiSZZIRbeId9IEClFcGTDnPp/B4M4fmR50XXOVSaPhulD",
-	"EACLx6L8Pddi3XNAyrWZwPV7kYpc6EYfYlNv6pzjBCtyNVpO5lqgoPEVZDANWZpWlOtqTIGz08HpSToN",
-	"wsy3AAAA//+cpsvq1QoAAA==",
+	"H4sIAAAAAAAC/+xZUW/bNhD+KwQ3YC+KnW7DUPitTYotaNNuTfJUBMNZPEtsJFIlT/bcQP99OEqWJVlJ",
+	"nKwJgqFPsSjy+N13H++oy7WMbV5Yg4a8nF1LH6eYQ/j5igjiNEdD/FQ4W6AjjeFdbA2hofN1gfxI4a/0",
+	"5LRJZBXJhc7wPeTjL7Xi4YV1OZCcybLUSkaDaZH85yCxB80g//GTLaCT4+6EA50X1tUogVI5k4mmtJxP",
+	"YptPv6InSLSdxinQgUe31DFOtSF0BrJpsCyrKpJef8UeLm3ot1+3wHhJgo4doLTM5wZ0duGyUQ/L0fEq",
+	"kg6/lNqhkrNPMnjdEhX1OG3g1JYuq0gepUBHmfWo3iybiECWfVjI2adr+aPDhZzJH6bbWE6bQE554YmS",
+	"VbQTQjDncIWn1uGfzs4zzH0H89zaDMHsgB5bddlyZOefMSZZbRCfqBHptOP3V0Cw+c1jP3SxBrjx4aJQ",
+	"QN+O9gw8naL3kAS13WbjXWdqFcmi5vvIIeN5FcAo9LHTBWlr5EyepyiaWQISFNqL2JaGUImFs7nQNJHR",
+	"lnb264B0EN+uhI1DUEe8fHyjONNoSOQ1QC/iGpeABaETlKLIwUCCTqTgBRsLg8zuZPRUrUCTNsmZNjGO",
+	"b2kzhZ4GO+9sZSwJMH6FDpVYI03Eq7nnBXrBc13gxViD+5Ix0MdOHPpkjZ+GVj5Ks1e5NkDWdU7Huk6X",
+	"EpebHFBF0hrcQ2vvccVyq7eoojsnN5rab/4w8dw1f2NcabrnkmPMcO81HxFiVoY/SsEkqPZ3pneeq8to",
+	"cD4D/w/NT8HoYxSnrSrGyg2rE/2DUX9slj92Yt06EbU0d8HzOXnXT4/92EBJqXUnajc79E54mxm0FyAa",
+	"dGJ44Pem58Kje4yYxt1Evl9Sbhx7aKAbYh/DmcLhUuNqPG/PMdHGaJMI2w/Q3Kr15M6Eu/V6u0+XPtZN",
+	"RzP3LtB75KbRUg7tfTQ8amquULfZ61yqq9ZvcA7W/NwV+PMRKkdpNO88QMHanzQbvLd0Q5k3lsLZXWqv",
+	"5xmKhXWbAu+FNVlXMu09NZIOi2x9bu+KwF8lbzzUWMt8425PX6MVfaxqPaX6VL3x/uQPPN6uv9W/biF/",
+	"SvduFB0q/V/cbqLbWrnV+bHvl+eag29MmuxP74r4ZN+Okayv6s8roQ0/9zYQo3t83A5v0d/Lzvey8zRl",
+	"p7bz4KvxF16ubrwhC+t4LNVKoambBmtbPq978//lEjwIRbgLizd5QeuR75imWt8cn/tfoVlNm8/osQ5v",
+	"0/nZbdRcaRO4R1PmbDy0Q/3fZSGjzW9lV0ZGMkVwJCOZQZmkMpK+dIXTHhmGByUvh7g5JGz2YAnOQM5Q",
+	"PrUg32qjzoP9C95qd/i43rX74o8GQXfsXYOmO3bWQdYbh7qAOh5D9Xp9int0SQNDUcNhf3GX9X7z4ilr",
+	"iNsgGNcqxFRCJtpZwpquICOBXZlySzMkjxV4QXCFRtjFgkW5V21qNbhTmQasbkGPpUaerc0iJGLSlPHb",
+	"12CuxFlZ8NkWTJs4bRqFgXIvI7lE52vHly8YgS3QQKHlTP4yOZwc8nkBSoMTU0/lnH8kONITPSFRevSh",
+	"bCRo0AF3M0VodPiJ+EApupX2KDQJZdGbn0IflKMCbILzmfwd6Yw3Ycd9YY2vw/Xz4WHnvy78E4oi03FY",
+	"OP3s6wNcE3oX3U3ji+nqO/DhLY/yOKc4dD4IsT/nGJeY2SLnilLPav5HMZMrP5tOMxtDllpPs5eHL19M",
+	"V54j8+8Ai6QcV2QaAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -11,30 +11,81 @@ import (
 type OptOptionsSetter func(o *Options)
 
 func NewOptions(
+	addInternalNote addInternalNoteUseCase,
 	canReceiveProblems canReceiveProblemsUseCase,
+	createCannedResponse createCannedResponseUseCase,
+	deleteCannedResponse deleteCannedResponseUseCase,
+	deleteMessage deleteMessageUseCase,
+	editMessage editMessageUseCase,
 	freeHandsSignal freeHandsSignalUseCase,
+	getCannedResponses getCannedResponsesUseCase,
 	getChats getChatsUseCase,
 	getChatHistory getChatHistoryUseCase,
+	getSatisfactionScores getSatisfactionScoresUseCase,
+	markChatAsRead markChatAsReadUseCase,
+	reactToMessage reactToMessageUseCase,
 	resolveProblem resolveProblemUseCase,
+	searchMessages searchMessagesUseCase,
+	sendCannedResponse sendCannedResponseUseCase,
 	sendMessage sendMessageUseCase,
+	setProblemCategory setProblemCategoryUseCase,
+	updateCannedResponse updateCannedResponseUseCase,
+	uploadAttachment uploadAttachmentUseCase,
+	taxonomy problemTaxonomy,
+	teamLeadResource string,
+	teamLeadRole string,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
 
 	// Setting defaults from field tag (if present)
 
+	o.addInternalNote = addInternalNote
+
 	o.canReceiveProblems = canReceiveProblems
 
+	o.createCannedResponse = createCannedResponse
+
+	o.deleteCannedResponse = deleteCannedResponse
+
+	o.deleteMessage = deleteMessage
+
+	o.editMessage = editMessage
+
 	o.freeHandsSignal = freeHandsSignal
 
+	o.getCannedResponses = getCannedResponses
+
 	o.getChats = getChats
 
 	o.getChatHistory = getChatHistory
 
+	o.getSatisfactionScores = getSatisfactionScores
+
+	o.markChatAsRead = markChatAsRead
+
+	o.reactToMessage = reactToMessage
+
 	o.resolveProblem = resolveProblem
 
+	o.searchMessages = searchMessages
+
+	o.sendCannedResponse = sendCannedResponse
+
 	o.sendMessage = sendMessage
 
+	o.setProblemCategory = setProblemCategory
+
+	o.updateCannedResponse = updateCannedResponse
+
+	o.uploadAttachment = uploadAttachment
+
+	o.taxonomy = taxonomy
+
+	o.teamLeadResource = teamLeadResource
+
+	o.teamLeadRole = teamLeadRole
+
 	for _, opt := range options {
 		opt(&o)
 	}
@@ -43,15 +94,39 @@ func NewOptions(
 
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("addInternalNote", _validate_Options_addInternalNote(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("canReceiveProblems", _validate_Options_canReceiveProblems(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("createCannedResponse", _validate_Options_createCannedResponse(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("deleteCannedResponse", _validate_Options_deleteCannedResponse(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("deleteMessage", _validate_Options_deleteMessage(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("editMessage", _validate_Options_editMessage(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("freeHandsSignal", _validate_Options_freeHandsSignal(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("getCannedResponses", _validate_Options_getCannedResponses(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("getChats", _validate_Options_getChats(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("getChatHistory", _validate_Options_getChatHistory(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("getSatisfactionScores", _validate_Options_getSatisfactionScores(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("markChatAsRead", _validate_Options_markChatAsRead(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("reactToMessage", _validate_Options_reactToMessage(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("resolveProblem", _validate_Options_resolveProblem(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("searchMessages", _validate_Options_searchMessages(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("sendCannedResponse", _validate_Options_sendCannedResponse(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("sendMessage", _validate_Options_sendMessage(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("setProblemCategory", _validate_Options_setProblemCategory(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("updateCannedResponse", _validate_Options_updateCannedResponse(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("uploadAttachment", _validate_Options_uploadAttachment(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("taxonomy", _validate_Options_taxonomy(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("teamLeadResource", _validate_Options_teamLeadResource(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("teamLeadRole", _validate_Options_teamLeadRole(o)))
 	return errs.AsError()
 }
 
+func _validate_Options_addInternalNote(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.addInternalNote, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `addInternalNote` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_canReceiveProblems(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.canReceiveProblems, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `canReceiveProblems` did not pass the test: %w", err)
@@ -59,6 +134,34 @@ func _validate_Options_canReceiveProblems(o *Options) error {
 	return nil
 }
 
+func _validate_Options_createCannedResponse(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.createCannedResponse, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `createCannedResponse` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_deleteCannedResponse(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.deleteCannedResponse, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `deleteCannedResponse` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_deleteMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.deleteMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `deleteMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_editMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.editMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `editMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_freeHandsSignal(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.freeHandsSignal, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `freeHandsSignal` did not pass the test: %w", err)
@@ -66,6 +169,13 @@ func _validate_Options_freeHandsSignal(o *Options) error {
 	return nil
 }
 
+func _validate_Options_getCannedResponses(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getCannedResponses, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `getCannedResponses` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_getChats(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getChats, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `getChats` did not pass the test: %w", err)
@@ -80,6 +190,27 @@ func _validate_Options_getChatHistory(o *Options) error {
 	return nil
 }
 
+func _validate_Options_getSatisfactionScores(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getSatisfactionScores, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `getSatisfactionScores` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_markChatAsRead(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.markChatAsRead, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `markChatAsRead` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_reactToMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.reactToMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `reactToMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_resolveProblem(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.resolveProblem, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `resolveProblem` did not pass the test: %w", err)
@@ -87,9 +218,65 @@ func _validate_Options_resolveProblem(o *Options) error {
 	return nil
 }
 
+func _validate_Options_searchMessages(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.searchMessages, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `searchMessages` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_sendCannedResponse(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.sendCannedResponse, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `sendCannedResponse` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_sendMessage(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.sendMessage, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `sendMessage` did not pass the test: %w", err)
 	}
 	return nil
 }
+
+func _validate_Options_setProblemCategory(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.setProblemCategory, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `setProblemCategory` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_updateCannedResponse(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.updateCannedResponse, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `updateCannedResponse` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_uploadAttachment(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.uploadAttachment, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `uploadAttachment` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_taxonomy(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.taxonomy, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `taxonomy` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_teamLeadResource(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.teamLeadResource, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `teamLeadResource` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_teamLeadRole(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.teamLeadRole, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `teamLeadRole` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -4,26 +4,64 @@ import (
 	"context"
 	"fmt"
 
+	addinternalnote "github.com/zestagio/chat-service/internal/usecases/manager/add-internal-note"
 	canreceiveproblems "github.com/zestagio/chat-service/internal/usecases/manager/can-receive-problems"
+	createcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/create-canned-response"
+	deletecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/delete-canned-response"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/manager/delete-message"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/manager/edit-message"
 	freehandssignal "github.com/zestagio/chat-service/internal/usecases/manager/free-hands-signal"
+	getcannedresponses "github.com/zestagio/chat-service/internal/usecases/manager/get-canned-responses"
 	getchathistory "github.com/zestagio/chat-service/internal/usecases/manager/get-chat-history"
 	getchats "github.com/zestagio/chat-service/internal/usecases/manager/get-chats"
+	getsatisfactionscores "github.com/zestagio/chat-service/internal/usecases/manager/get-satisfaction-scores"
+	markchatasread "github.com/zestagio/chat-service/internal/usecases/manager/mark-chat-as-read"
+	reacttomessage "github.com/zestagio/chat-service/internal/usecases/manager/react-to-message"
 	resolveproblem "github.com/zestagio/chat-service/internal/usecases/manager/resolve-problem"
+	searchmessages "github.com/zestagio/chat-service/internal/usecases/manager/search-messages"
+	sendcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/send-canned-response"
 	sendmessage "github.com/zestagio/chat-service/internal/usecases/manager/send-message"
+	setproblemcategory "github.com/zestagio/chat-service/internal/usecases/manager/set-problem-category"
+	updatecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/update-canned-response"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/manager/upload-attachment"
 )
 
 var _ ServerInterface = (*Handlers)(nil)
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/handlers_mocks.gen.go -package=managerv1mocks
 
+type addInternalNoteUseCase interface {
+	Handle(ctx context.Context, req addinternalnote.Request) (addinternalnote.Response, error)
+}
+
 type canReceiveProblemsUseCase interface {
 	Handle(ctx context.Context, req canreceiveproblems.Request) (canreceiveproblems.Response, error)
 }
 
+type createCannedResponseUseCase interface {
+	Handle(ctx context.Context, req createcannedresponse.Request) (createcannedresponse.Response, error)
+}
+
+type deleteCannedResponseUseCase interface {
+	Handle(ctx context.Context, req deletecannedresponse.Request) (deletecannedresponse.Response, error)
+}
+
+type deleteMessageUseCase interface {
+	Handle(ctx context.Context, req deletemessage.Request) (deletemessage.Response, error)
+}
+
+type editMessageUseCase interface {
+	Handle(ctx context.Context, req editmessage.Request) (editmessage.Response, error)
+}
+
 type freeHandsSignalUseCase interface {
 	Handle(ctx context.Context, req freehandssignal.Request) (freehandssignal.Response, error)
 }
 
+type getCannedResponsesUseCase interface {
+	Handle(ctx context.Context, req getcannedresponses.Request) (getcannedresponses.Response, error)
+}
+
 type getChatHistoryUseCase interface {
 	Handle(ctx context.Context, req getchathistory.Request) (getchathistory.Response, error)
 }
@@ -32,22 +70,79 @@ type getChatsUseCase interface {
 	Handle(ctx context.Context, req getchats.Request) (getchats.Response, error)
 }
 
+type getSatisfactionScoresUseCase interface {
+	Handle(ctx context.Context, req getsatisfactionscores.Request) (getsatisfactionscores.Response, error)
+}
+
+type markChatAsReadUseCase interface {
+	Handle(ctx context.Context, req markchatasread.Request) (markchatasread.Response, error)
+}
+
+type reactToMessageUseCase interface {
+	Handle(ctx context.Context, req reacttomessage.Request) (reacttomessage.Response, error)
+}
+
 type resolveProblemUseCase interface {
 	Handle(ctx context.Context, req resolveproblem.Request) (resolveproblem.Response, error)
 }
 
+type searchMessagesUseCase interface {
+	Handle(ctx context.Context, req searchmessages.Request) (searchmessages.Response, error)
+}
+
+type sendCannedResponseUseCase interface {
+	Handle(ctx context.Context, req sendcannedresponse.Request) (sendcannedresponse.Response, error)
+}
+
 type sendMessageUseCase interface {
 	Handle(ctx context.Context, req sendmessage.Request) (sendmessage.Response, error)
 }
 
+type setProblemCategoryUseCase interface {
+	Handle(ctx context.Context, req setproblemcategory.Request) (setproblemcategory.Response, error)
+}
+
+type updateCannedResponseUseCase interface {
+	Handle(ctx context.Context, req updatecannedresponse.Request) (updatecannedresponse.Response, error)
+}
+
+type uploadAttachmentUseCase interface {
+	Handle(ctx context.Context, req uploadattachment.Request) (uploadattachment.Response, error)
+}
+
+type problemTaxonomy interface {
+	Categories() []string
+	ResolutionCodes() []string
+}
+
 //go:generate options-gen -out-filename=handlers.gen.go -from-struct=Options
 type Options struct {
-	canReceiveProblems canReceiveProblemsUseCase `option:"mandatory" validate:"required"`
-	freeHandsSignal    freeHandsSignalUseCase    `option:"mandatory" validate:"required"`
-	getChats           getChatsUseCase           `option:"mandatory" validate:"required"`
-	getChatHistory     getChatHistoryUseCase     `option:"mandatory" validate:"required"`
-	resolveProblem     resolveProblemUseCase     `option:"mandatory" validate:"required"`
-	sendMessage        sendMessageUseCase        `option:"mandatory" validate:"required"`
+	addInternalNote       addInternalNoteUseCase       `option:"mandatory" validate:"required"`
+	canReceiveProblems    canReceiveProblemsUseCase    `option:"mandatory" validate:"required"`
+	createCannedResponse  createCannedResponseUseCase  `option:"mandatory" validate:"required"`
+	deleteCannedResponse  deleteCannedResponseUseCase  `option:"mandatory" validate:"required"`
+	deleteMessage         deleteMessageUseCase         `option:"mandatory" validate:"required"`
+	editMessage           editMessageUseCase           `option:"mandatory" validate:"required"`
+	freeHandsSignal       freeHandsSignalUseCase       `option:"mandatory" validate:"required"`
+	getCannedResponses    getCannedResponsesUseCase    `option:"mandatory" validate:"required"`
+	getChats              getChatsUseCase              `option:"mandatory" validate:"required"`
+	getChatHistory        getChatHistoryUseCase        `option:"mandatory" validate:"required"`
+	getSatisfactionScores getSatisfactionScoresUseCase `option:"mandatory" validate:"required"`
+	markChatAsRead        markChatAsReadUseCase        `option:"mandatory" validate:"required"`
+	reactToMessage        reactToMessageUseCase        `option:"mandatory" validate:"required"`
+	resolveProblem        resolveProblemUseCase        `option:"mandatory" validate:"required"`
+	searchMessages        searchMessagesUseCase        `option:"mandatory" validate:"required"`
+	sendCannedResponse    sendCannedResponseUseCase    `option:"mandatory" validate:"required"`
+	sendMessage           sendMessageUseCase           `option:"mandatory" validate:"required"`
+	setProblemCategory    setProblemCategoryUseCase    `option:"mandatory" validate:"required"`
+	updateCannedResponse  updateCannedResponseUseCase  `option:"mandatory" validate:"required"`
+	uploadAttachment      uploadAttachmentUseCase      `option:"mandatory" validate:"required"`
+	taxonomy              problemTaxonomy              `option:"mandatory" validate:"required"`
+
+	// teamLeadResource and teamLeadRole are required to manage the canned responses shared with the whole team
+	// and to see the satisfaction scores of all managers.
+	teamLeadResource string `option:"mandatory" validate:"required"`
+	teamLeadRole     string `option:"mandatory" validate:"required"`
 }
 
 type Handlers struct {
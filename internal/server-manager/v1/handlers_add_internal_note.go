@@ -0,0 +1,44 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	addinternalnote "github.com/zestagio/chat-service/internal/usecases/manager/add-internal-note"
+)
+
+func (h Handlers) PostAddInternalNote(eCtx echo.Context, params PostAddInternalNoteParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req AddInternalNoteRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.addInternalNote.Handle(ctx, addinternalnote.Request{
+		ID:          params.XRequestID,
+		ManagerID:   managerID,
+		ChatID:      req.ChatId,
+		MessageBody: req.MessageBody,
+	})
+	if err != nil {
+		if errors.Is(err, addinternalnote.ErrAssignedProblemNotFound) {
+			return internalerrors.NewServerError(int(ErrorCodeAssignedProblemNotFound),
+				"assigned to manager problem was not found", err)
+		}
+
+		return fmt.Errorf("handle `add internal note` use case: %v", err)
+	}
+
+	return eCtx.JSON(http.StatusOK, AddInternalNoteResponse{Data: &MessageWithoutBody{
+		AuthorId:  managerID,
+		CreatedAt: resp.CreatedAt,
+		Id:        resp.MessageID,
+	}})
+}
@@ -0,0 +1,110 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	addinternalnote "github.com/zestagio/chat-service/internal/usecases/manager/add-internal-note"
+)
+
+func (s *HandlersSuite) TestAddInternalNote_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/addInternalNote", `{"messageBody": "Wat`)
+
+	// Action.
+	err := s.handlers.PostAddInternalNote(eCtx, managerv1.PostAddInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestAddInternalNote_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/addInternalNote",
+		fmt.Sprintf(`{"messageBody": "Watch the refund", "chatId": %q}`, chatID))
+
+	s.addInternalNoteUseCase.EXPECT().Handle(eCtx.Request().Context(), addinternalnote.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Watch the refund",
+	}).Return(addinternalnote.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostAddInternalNote(eCtx, managerv1.PostAddInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestAddInternalNote_Usecase_AssignedProblemNotFound() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/addInternalNote",
+		fmt.Sprintf(`{"messageBody": "Watch the refund", "chatId": %q}`, chatID))
+
+	s.addInternalNoteUseCase.EXPECT().Handle(eCtx.Request().Context(), addinternalnote.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Watch the refund",
+	}).Return(addinternalnote.Response{}, addinternalnote.ErrAssignedProblemNotFound)
+
+	// Action.
+	err := s.handlers.PostAddInternalNote(eCtx, managerv1.PostAddInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.EqualValues(managerv1.ErrorCodeAssignedProblemNotFound, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestAddInternalNote_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/addInternalNote",
+		fmt.Sprintf(`{"messageBody": "Watch the refund", "chatId": %q}`, chatID))
+
+	msgID := types.NewMessageID()
+	s.addInternalNoteUseCase.EXPECT().Handle(eCtx.Request().Context(), addinternalnote.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Watch the refund",
+	}).Return(addinternalnote.Response{
+		MessageID: msgID,
+		CreatedAt: time.Unix(1, 1).UTC(),
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostAddInternalNote(eCtx, managerv1.PostAddInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "authorId": "%s",
+        "createdAt": "1970-01-01T00:00:01.000000001Z",
+        "id": "%s"
+    }
+}`, s.managerID, msgID), resp.Body.String())
+}
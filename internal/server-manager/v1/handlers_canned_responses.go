@@ -0,0 +1,228 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	createcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/create-canned-response"
+	deletecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/delete-canned-response"
+	getcannedresponses "github.com/zestagio/chat-service/internal/usecases/manager/get-canned-responses"
+	sendcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/send-canned-response"
+	sendmessage "github.com/zestagio/chat-service/internal/usecases/manager/send-message"
+	updatecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/update-canned-response"
+	"github.com/zestagio/chat-service/pkg/pointer"
+)
+
+func (h Handlers) PostGetCannedResponses(eCtx echo.Context, params PostGetCannedResponsesParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req GetCannedResponsesRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.getCannedResponses.Handle(ctx, getcannedresponses.Request{
+		ID:             params.XRequestID,
+		ManagerID:      managerID,
+		ShortcutPrefix: pointer.Indirect(req.ShortcutPrefix),
+	})
+	if err != nil {
+		if errors.Is(err, getcannedresponses.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		return fmt.Errorf("handle `get canned responses` use case: %v", err)
+	}
+
+	responses := make([]CannedResponse, 0, len(resp.CannedResponses))
+	for _, cr := range resp.CannedResponses {
+		responses = append(responses, CannedResponse{
+			AuthorId:  cr.AuthorID,
+			Body:      cr.Body,
+			Id:        cr.ID,
+			IsShared:  cr.IsShared,
+			Shortcut:  cr.Shortcut,
+			UpdatedAt: cr.UpdatedAt,
+		})
+	}
+
+	return eCtx.JSON(http.StatusOK, GetCannedResponsesResponse{Data: &CannedResponseList{
+		CannedResponses: responses,
+	}})
+}
+
+func (h Handlers) PostCreateCannedResponse(eCtx echo.Context, params PostCreateCannedResponseParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req CreateCannedResponseRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.createCannedResponse.Handle(ctx, createcannedresponse.Request{
+		ID:              params.XRequestID,
+		ManagerID:       managerID,
+		IsShared:        pointer.Indirect(req.IsShared),
+		Shortcut:        req.Shortcut,
+		Body:            req.Body,
+		CanManageShared: h.isTeamLead(eCtx),
+	})
+	if err != nil {
+		if errors.Is(err, createcannedresponse.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, createcannedresponse.ErrForbidden) {
+			return internalerrors.NewServerError(http.StatusForbidden, "shared responses are managed by team leads", err)
+		}
+
+		if errors.Is(err, createcannedresponse.ErrShortcutTaken) {
+			return internalerrors.NewServerError(int(ErrorCodeShortcutTaken), "shortcut is already taken", err)
+		}
+
+		return fmt.Errorf("handle `create canned response` use case: %v", err)
+	}
+
+	cr := resp.CannedResponse
+	return eCtx.JSON(http.StatusOK, CannedResponseResponse{Data: &CannedResponse{
+		AuthorId:  cr.AuthorID,
+		Body:      cr.Body,
+		Id:        cr.ID,
+		IsShared:  cr.IsShared,
+		Shortcut:  cr.Shortcut,
+		UpdatedAt: cr.UpdatedAt,
+	}})
+}
+
+func (h Handlers) PostUpdateCannedResponse(eCtx echo.Context, params PostUpdateCannedResponseParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req UpdateCannedResponseRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.updateCannedResponse.Handle(ctx, updatecannedresponse.Request{
+		ID:               params.XRequestID,
+		ManagerID:        managerID,
+		CannedResponseID: req.Id,
+		Shortcut:         req.Shortcut,
+		Body:             req.Body,
+		CanManageShared:  h.isTeamLead(eCtx),
+	})
+	if err != nil {
+		if errors.Is(err, updatecannedresponse.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, updatecannedresponse.ErrCannedResponseNotFound) {
+			return internalerrors.NewServerError(http.StatusNotFound, "canned response not found", err)
+		}
+
+		if errors.Is(err, updatecannedresponse.ErrForbidden) {
+			return internalerrors.NewServerError(http.StatusForbidden, "shared responses are managed by team leads", err)
+		}
+
+		if errors.Is(err, updatecannedresponse.ErrShortcutTaken) {
+			return internalerrors.NewServerError(int(ErrorCodeShortcutTaken), "shortcut is already taken", err)
+		}
+
+		return fmt.Errorf("handle `update canned response` use case: %v", err)
+	}
+
+	cr := resp.CannedResponse
+	return eCtx.JSON(http.StatusOK, CannedResponseResponse{Data: &CannedResponse{
+		AuthorId:  cr.AuthorID,
+		Body:      cr.Body,
+		Id:        cr.ID,
+		IsShared:  cr.IsShared,
+		Shortcut:  cr.Shortcut,
+		UpdatedAt: cr.UpdatedAt,
+	}})
+}
+
+func (h Handlers) PostDeleteCannedResponse(eCtx echo.Context, params PostDeleteCannedResponseParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req DeleteCannedResponseRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	if _, err := h.deleteCannedResponse.Handle(ctx, deletecannedresponse.Request{
+		ID:               params.XRequestID,
+		ManagerID:        managerID,
+		CannedResponseID: req.Id,
+		CanManageShared:  h.isTeamLead(eCtx),
+	}); err != nil {
+		if errors.Is(err, deletecannedresponse.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, deletecannedresponse.ErrCannedResponseNotFound) {
+			return internalerrors.NewServerError(http.StatusNotFound, "canned response not found", err)
+		}
+
+		if errors.Is(err, deletecannedresponse.ErrForbidden) {
+			return internalerrors.NewServerError(http.StatusForbidden, "shared responses are managed by team leads", err)
+		}
+
+		return fmt.Errorf("handle `delete canned response` use case: %v", err)
+	}
+
+	var empty map[string]any
+	return eCtx.JSON(http.StatusOK, DeleteCannedResponseResponse{Data: &empty})
+}
+
+func (h Handlers) PostSendCannedResponse(eCtx echo.Context, params PostSendCannedResponseParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req SendCannedResponseRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.sendCannedResponse.Handle(ctx, sendcannedresponse.Request{
+		ID:               params.XRequestID,
+		ManagerID:        managerID,
+		ChatID:           req.ChatId,
+		CannedResponseID: req.CannedResponseId,
+		ManagerName:      middlewares.UserName(eCtx),
+		ReplyToMessageID: pointer.Indirect(req.ReplyToMessageId),
+	})
+	if err != nil {
+		if errors.Is(err, sendcannedresponse.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, sendcannedresponse.ErrCannedResponseNotFound) {
+			return internalerrors.NewServerError(http.StatusNotFound, "canned response not found", err)
+		}
+
+		if errors.Is(err, sendmessage.ErrReplyToInvalid) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid replied message", err)
+		}
+
+		return fmt.Errorf("handle `send canned response` use case: %v", err)
+	}
+
+	return eCtx.JSON(http.StatusOK, SendMessageResponse{Data: &MessageWithoutBody{
+		AuthorId:  managerID,
+		CreatedAt: resp.CreatedAt,
+		Id:        resp.MessageID,
+	}})
+}
+
+func (h Handlers) isTeamLead(eCtx echo.Context) bool {
+	return middlewares.HasResourceRole(eCtx, h.teamLeadResource, h.teamLeadRole)
+}
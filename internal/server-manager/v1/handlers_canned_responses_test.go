@@ -0,0 +1,373 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	createcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/create-canned-response"
+	deletecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/delete-canned-response"
+	getcannedresponses "github.com/zestagio/chat-service/internal/usecases/manager/get-canned-responses"
+	sendcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/send-canned-response"
+	sendmessage "github.com/zestagio/chat-service/internal/usecases/manager/send-message"
+	updatecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/update-canned-response"
+)
+
+func (s *HandlersSuite) TestGetCannedResponses_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	crID := types.NewCannedResponseID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getCannedResponses", `{"shortcutPrefix": "he"}`)
+	s.getCannedResponsesUseCase.EXPECT().Handle(eCtx.Request().Context(), getcannedresponses.Request{
+		ID:             reqID,
+		ManagerID:      s.managerID,
+		ShortcutPrefix: "he",
+	}).Return(getcannedresponses.Response{CannedResponses: []getcannedresponses.CannedResponse{{
+		ID:        crID,
+		AuthorID:  s.managerID,
+		Shortcut:  "hello",
+		Body:      "Hello, {{client_name}}!",
+		UpdatedAt: time.Unix(1, 1).UTC(),
+	}}}, nil)
+
+	// Action.
+	err := s.handlers.PostGetCannedResponses(eCtx, managerv1.PostGetCannedResponsesParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "cannedResponses":
+        [
+            {
+                "authorId": "%s",
+                "body": "Hello, {{client_name}}!",
+                "id": "%s",
+                "isShared": false,
+                "shortcut": "hello",
+                "updatedAt": "1970-01-01T00:00:01.000000001Z"
+            }
+        ]
+    }
+}`, s.managerID, crID), resp.Body.String())
+}
+
+func (s *HandlersSuite) TestCreateCannedResponse_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/createCannedResponse", `{"shortcut": "`)
+
+	// Action.
+	err := s.handlers.PostCreateCannedResponse(eCtx, managerv1.PostCreateCannedResponseParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestCreateCannedResponse_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: createcannedresponse.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{name: "forbidden", err: createcannedresponse.ErrForbidden, expCode: http.StatusForbidden},
+		{name: "shortcut taken", err: createcannedresponse.ErrShortcutTaken, expCode: int(managerv1.ErrorCodeShortcutTaken)},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+
+			resp, eCtx := s.newEchoCtx(reqID, "/v1/createCannedResponse",
+				`{"shortcut": "hello", "body": "Hello!", "isShared": true}`)
+			s.createCannedResponseUseCase.EXPECT().Handle(eCtx.Request().Context(), createcannedresponse.Request{
+				ID:              reqID,
+				ManagerID:       s.managerID,
+				IsShared:        true,
+				Shortcut:        "hello",
+				Body:            "Hello!",
+				CanManageShared: false,
+			}).Return(createcannedresponse.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostCreateCannedResponse(eCtx, managerv1.PostCreateCannedResponseParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestCreateCannedResponse_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	crID := types.NewCannedResponseID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/createCannedResponse",
+		`{"shortcut": "hello", "body": "Hello!", "isShared": true}`)
+	middlewares.SetTokenWithAccess(eCtx, s.managerID, "Anna", teamLeadResource, teamLeadRole)
+
+	s.createCannedResponseUseCase.EXPECT().Handle(eCtx.Request().Context(), createcannedresponse.Request{
+		ID:              reqID,
+		ManagerID:       s.managerID,
+		IsShared:        true,
+		Shortcut:        "hello",
+		Body:            "Hello!",
+		CanManageShared: true,
+	}).Return(createcannedresponse.Response{CannedResponse: createcannedresponse.CannedResponse{
+		ID:        crID,
+		AuthorID:  s.managerID,
+		IsShared:  true,
+		Shortcut:  "hello",
+		Body:      "Hello!",
+		UpdatedAt: time.Unix(1, 1).UTC(),
+	}}, nil)
+
+	// Action.
+	err := s.handlers.PostCreateCannedResponse(eCtx, managerv1.PostCreateCannedResponseParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "authorId": "%s",
+        "body": "Hello!",
+        "id": "%s",
+        "isShared": true,
+        "shortcut": "hello",
+        "updatedAt": "1970-01-01T00:00:01.000000001Z"
+    }
+}`, s.managerID, crID), resp.Body.String())
+}
+
+func (s *HandlersSuite) TestUpdateCannedResponse_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: updatecannedresponse.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{name: "not found", err: updatecannedresponse.ErrCannedResponseNotFound, expCode: http.StatusNotFound},
+		{name: "forbidden", err: updatecannedresponse.ErrForbidden, expCode: http.StatusForbidden},
+		{name: "shortcut taken", err: updatecannedresponse.ErrShortcutTaken, expCode: int(managerv1.ErrorCodeShortcutTaken)},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			crID := types.NewCannedResponseID()
+
+			resp, eCtx := s.newEchoCtx(reqID, "/v1/updateCannedResponse",
+				fmt.Sprintf(`{"id": %q, "shortcut": "hi", "body": "Hi!"}`, crID))
+			s.updateCannedResponseUseCase.EXPECT().Handle(eCtx.Request().Context(), updatecannedresponse.Request{
+				ID:               reqID,
+				ManagerID:        s.managerID,
+				CannedResponseID: crID,
+				Shortcut:         "hi",
+				Body:             "Hi!",
+			}).Return(updatecannedresponse.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostUpdateCannedResponse(eCtx, managerv1.PostUpdateCannedResponseParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestUpdateCannedResponse_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	crID := types.NewCannedResponseID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/updateCannedResponse",
+		fmt.Sprintf(`{"id": %q, "shortcut": "hi", "body": "Hi!"}`, crID))
+	s.updateCannedResponseUseCase.EXPECT().Handle(eCtx.Request().Context(), updatecannedresponse.Request{
+		ID:               reqID,
+		ManagerID:        s.managerID,
+		CannedResponseID: crID,
+		Shortcut:         "hi",
+		Body:             "Hi!",
+	}).Return(updatecannedresponse.Response{CannedResponse: updatecannedresponse.CannedResponse{
+		ID:        crID,
+		AuthorID:  s.managerID,
+		Shortcut:  "hi",
+		Body:      "Hi!",
+		UpdatedAt: time.Unix(1, 1).UTC(),
+	}}, nil)
+
+	// Action.
+	err := s.handlers.PostUpdateCannedResponse(eCtx, managerv1.PostUpdateCannedResponseParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "authorId": "%s",
+        "body": "Hi!",
+        "id": "%s",
+        "isShared": false,
+        "shortcut": "hi",
+        "updatedAt": "1970-01-01T00:00:01.000000001Z"
+    }
+}`, s.managerID, crID), resp.Body.String())
+}
+
+func (s *HandlersSuite) TestDeleteCannedResponse_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: deletecannedresponse.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{name: "not found", err: deletecannedresponse.ErrCannedResponseNotFound, expCode: http.StatusNotFound},
+		{name: "forbidden", err: deletecannedresponse.ErrForbidden, expCode: http.StatusForbidden},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			crID := types.NewCannedResponseID()
+
+			resp, eCtx := s.newEchoCtx(reqID, "/v1/deleteCannedResponse", fmt.Sprintf(`{"id": %q}`, crID))
+			s.deleteCannedResponseUseCase.EXPECT().Handle(eCtx.Request().Context(), deletecannedresponse.Request{
+				ID:               reqID,
+				ManagerID:        s.managerID,
+				CannedResponseID: crID,
+			}).Return(deletecannedresponse.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostDeleteCannedResponse(eCtx, managerv1.PostDeleteCannedResponseParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestDeleteCannedResponse_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	crID := types.NewCannedResponseID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/deleteCannedResponse", fmt.Sprintf(`{"id": %q}`, crID))
+	middlewares.SetTokenWithAccess(eCtx, s.managerID, "Anna", teamLeadResource, teamLeadRole)
+
+	s.deleteCannedResponseUseCase.EXPECT().Handle(eCtx.Request().Context(), deletecannedresponse.Request{
+		ID:               reqID,
+		ManagerID:        s.managerID,
+		CannedResponseID: crID,
+		CanManageShared:  true,
+	}).Return(deletecannedresponse.Response{CannedResponseID: crID}, nil)
+
+	// Action.
+	err := s.handlers.PostDeleteCannedResponse(eCtx, managerv1.PostDeleteCannedResponseParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data": null}`, resp.Body.String())
+}
+
+func (s *HandlersSuite) TestSendCannedResponse_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: sendcannedresponse.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{name: "not found", err: sendcannedresponse.ErrCannedResponseNotFound, expCode: http.StatusNotFound},
+		{name: "invalid replied message", err: sendmessage.ErrReplyToInvalid, expCode: http.StatusBadRequest},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			chatID := types.NewChatID()
+			crID := types.NewCannedResponseID()
+
+			resp, eCtx := s.newEchoCtx(reqID, "/v1/sendCannedResponse",
+				fmt.Sprintf(`{"chatId": %q, "cannedResponseId": %q}`, chatID, crID))
+			s.sendCannedResponseUseCase.EXPECT().Handle(eCtx.Request().Context(), sendcannedresponse.Request{
+				ID:               reqID,
+				ManagerID:        s.managerID,
+				ChatID:           chatID,
+				CannedResponseID: crID,
+			}).Return(sendcannedresponse.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostSendCannedResponse(eCtx, managerv1.PostSendCannedResponseParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestSendCannedResponse_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	crID := types.NewCannedResponseID()
+	replyToID := types.NewMessageID()
+	msgID := types.NewMessageID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendCannedResponse",
+		fmt.Sprintf(`{"chatId": %q, "cannedResponseId": %q, "replyToMessageId": %q}`, chatID, crID, replyToID))
+	middlewares.SetTokenWithAccess(eCtx, s.managerID, "Anna", "", "")
+
+	s.sendCannedResponseUseCase.EXPECT().Handle(eCtx.Request().Context(), sendcannedresponse.Request{
+		ID:               reqID,
+		ManagerID:        s.managerID,
+		ChatID:           chatID,
+		CannedResponseID: crID,
+		ManagerName:      "Anna",
+		ReplyToMessageID: replyToID,
+	}).Return(sendcannedresponse.Response{
+		MessageID: msgID,
+		CreatedAt: time.Unix(1, 1).UTC(),
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostSendCannedResponse(eCtx, managerv1.PostSendCannedResponseParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "authorId": "%s",
+        "createdAt": "1970-01-01T00:00:01.000000001Z",
+        "id": "%s"
+    }
+}`, s.managerID, msgID), resp.Body.String())
+}
@@ -1,11 +1,13 @@
 package managerv1
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
 	"github.com/zestagio/chat-service/internal/middlewares"
 	getchathistory "github.com/zestagio/chat-service/internal/usecases/manager/get-chat-history"
 	getchats "github.com/zestagio/chat-service/internal/usecases/manager/get-chats"
@@ -26,10 +28,26 @@ func (h Handlers) PostGetChats(eCtx echo.Context, params PostGetChatsParams) err
 
 	result := make([]Chat, 0, len(resp.Chats))
 	for _, c := range resp.Chats {
-		result = append(result, Chat{
-			ChatId:   c.ID,
-			ClientId: c.ClientID,
-		})
+		cc := Chat{
+			ChatId:                c.ID,
+			ClientId:              c.ClientID,
+			ProblemCreatedAt:      c.ProblemCreatedAt,
+			UnreadCount:           c.UnreadCount,
+			Category:              pointer.PtrWithZeroAsNil(c.Category),
+			ReopenedFromProblemId: pointer.PtrWithZeroAsNil(c.ReopenedFromID),
+		}
+		if m := c.LastMessage; m != nil {
+			cc.LastMessage = &LastMessage{
+				AuthorId:  pointer.PtrWithZeroAsNil(m.AuthorID),
+				CreatedAt: m.CreatedAt,
+				MessageId: m.ID,
+				Preview:   m.Preview,
+			}
+		}
+		if !c.WaitingSince.IsZero() {
+			cc.WaitingSince = &c.WaitingSince
+		}
+		result = append(result, cc)
 	}
 	return eCtx.JSON(http.StatusOK, GetChatsResponse{Data: &ChatList{
 		Chats: result,
@@ -51,22 +69,74 @@ func (h Handlers) PostGetChatHistory(eCtx echo.Context, params PostGetChatHistor
 		ChatID:    req.ChatId,
 		Cursor:    pointer.Indirect(req.Cursor),
 		PageSize:  pointer.Indirect(req.PageSize),
+
+		AroundMessageID: pointer.Indirect(req.AroundMessageId),
+		ProblemID:       pointer.Indirect(req.ProblemId),
 	})
 	if err != nil {
+		if errors.Is(err, getchathistory.ErrMessageNotFound) {
+			return internalerrors.NewServerError(http.StatusNotFound, "message not found", err)
+		}
+		if errors.Is(err, getchathistory.ErrProblemNotFound) {
+			return internalerrors.NewServerError(http.StatusNotFound, "problem not found", err)
+		}
+
 		return fmt.Errorf("handle `get chat history` use case: %v", err)
 	}
 
 	page := make([]Message, 0, len(resp.Messages))
 	for _, m := range resp.Messages {
-		page = append(page, Message{
+		mm := Message{
 			Id:        m.ID,
 			AuthorId:  m.AuthorID,
 			Body:      m.Body,
 			CreatedAt: m.CreatedAt,
-		})
+
+			IsInternalNote: pointer.PtrWithZeroAsNil(m.IsInternalNote),
+		}
+		if !m.EditedAt.IsZero() {
+			mm.EditedAt = &m.EditedAt
+		}
+		if !m.DeletedAt.IsZero() {
+			mm.DeletedAt = &m.DeletedAt
+		}
+		if len(m.Attachments) > 0 {
+			aa := make([]Attachment, 0, len(m.Attachments))
+			for _, a := range m.Attachments {
+				aa = append(aa, Attachment{
+					ContentType:  a.ContentType,
+					FileName:     a.FileName,
+					Id:           a.ID,
+					Size:         a.Size,
+					ThumbnailUrl: pointer.PtrWithZeroAsNil(a.ThumbnailURL),
+					Url:          a.URL,
+				})
+			}
+			mm.Attachments = &aa
+		}
+		if len(m.Reactions) > 0 {
+			rr := make([]Reaction, 0, len(m.Reactions))
+			for _, r := range m.Reactions {
+				rr = append(rr, Reaction{
+					Count:       r.Count,
+					Kind:        ReactionKind(r.Kind),
+					ReactedByMe: r.ReactedByMe,
+				})
+			}
+			mm.Reactions = &rr
+		}
+		if q := m.ReplyTo; q != nil {
+			mm.ReplyTo = &Quote{
+				AuthorId:  pointer.PtrWithZeroAsNil(q.AuthorID),
+				MessageId: q.MessageID,
+				Preview:   q.Preview,
+			}
+		}
+		page = append(page, mm)
 	}
 	return eCtx.JSON(http.StatusOK, GetChatHistoryResponse{Data: &MessagesPage{
 		Messages: page,
 		Next:     resp.NextCursor,
+		Prev:     resp.PrevCursor,
 	}})
 }
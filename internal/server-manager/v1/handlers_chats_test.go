@@ -41,16 +41,34 @@ func (s *HandlersSuite) TestGetChats_Usecase_Success() {
 	}).Return(getchats.Response{
 		Chats: []getchats.Chat{
 			{
-				ID:       types.MustParse[types.ChatID]("20b99498-a9d3-11ed-92b0-461e464ebed8"),
-				ClientID: types.MustParse[types.UserID]("4faa9042-a9d3-11ed-8bfe-461e464ebed8"),
+				ID:               types.MustParse[types.ChatID]("20b99498-a9d3-11ed-92b0-461e464ebed8"),
+				ClientID:         types.MustParse[types.UserID]("4faa9042-a9d3-11ed-8bfe-461e464ebed8"),
+				ProblemCreatedAt: time.Date(2023, 2, 11, 10, 0, 0, 0, time.UTC),
+				UnreadCount:      2,
+				LastMessage: &getchats.LastMessage{
+					ID:        types.MustParse[types.MessageID]("b1d3bf76-a9d3-11ed-8d0b-461e464ebed8"),
+					AuthorID:  types.MustParse[types.UserID]("4faa9042-a9d3-11ed-8bfe-461e464ebed8"),
+					Preview:   "Where is my money?",
+					CreatedAt: time.Date(2023, 2, 11, 10, 5, 0, 0, time.UTC),
+				},
+				WaitingSince: time.Date(2023, 2, 11, 10, 3, 0, 0, time.UTC),
+				Category:     "cards",
 			},
 			{
-				ID:       types.MustParse[types.ChatID]("214db664-a9d3-11ed-9a8f-461e464ebed8"),
-				ClientID: types.MustParse[types.UserID]("42fb6208-a9d3-11ed-b40b-461e464ebed8"),
+				ID:               types.MustParse[types.ChatID]("214db664-a9d3-11ed-9a8f-461e464ebed8"),
+				ClientID:         types.MustParse[types.UserID]("42fb6208-a9d3-11ed-b40b-461e464ebed8"),
+				ProblemCreatedAt: time.Date(2023, 2, 11, 11, 0, 0, 0, time.UTC),
+				LastMessage: &getchats.LastMessage{
+					ID:        types.MustParse[types.MessageID]("c06a2d38-a9d3-11ed-8c5f-461e464ebed8"),
+					Preview:   "Manager is assigned",
+					CreatedAt: time.Date(2023, 2, 11, 11, 1, 0, 0, time.UTC),
+				},
 			},
 			{
-				ID:       types.MustParse[types.ChatID]("2b50973a-a9d3-11ed-818b-461e464ebed8"),
-				ClientID: types.MustParse[types.UserID]("463fde4e-a9d3-11ed-886f-461e464ebed8"),
+				ID:               types.MustParse[types.ChatID]("2b50973a-a9d3-11ed-818b-461e464ebed8"),
+				ClientID:         types.MustParse[types.UserID]("463fde4e-a9d3-11ed-886f-461e464ebed8"),
+				ProblemCreatedAt: time.Date(2023, 2, 11, 12, 0, 0, 0, time.UTC),
+				ReopenedFromID:   types.MustParse[types.ProblemID]("5a2d1c6e-a9d3-11ed-a1b2-461e464ebed8"),
 			},
 		},
 	}, nil)
@@ -69,15 +87,37 @@ func (s *HandlersSuite) TestGetChats_Usecase_Success() {
         [
             {
                 "chatId": "20b99498-a9d3-11ed-92b0-461e464ebed8",
-                "clientId": "4faa9042-a9d3-11ed-8bfe-461e464ebed8"
+                "clientId": "4faa9042-a9d3-11ed-8bfe-461e464ebed8",
+                "problemCreatedAt": "2023-02-11T10:00:00Z",
+                "unreadCount": 2,
+                "lastMessage":
+                {
+                    "messageId": "b1d3bf76-a9d3-11ed-8d0b-461e464ebed8",
+                    "authorId": "4faa9042-a9d3-11ed-8bfe-461e464ebed8",
+                    "preview": "Where is my money?",
+                    "createdAt": "2023-02-11T10:05:00Z"
+                },
+                "waitingSince": "2023-02-11T10:03:00Z",
+                "category": "cards"
             },
             {
                 "chatId": "214db664-a9d3-11ed-9a8f-461e464ebed8",
-                "clientId": "42fb6208-a9d3-11ed-b40b-461e464ebed8"
+                "clientId": "42fb6208-a9d3-11ed-b40b-461e464ebed8",
+                "problemCreatedAt": "2023-02-11T11:00:00Z",
+                "unreadCount": 0,
+                "lastMessage":
+                {
+                    "messageId": "c06a2d38-a9d3-11ed-8c5f-461e464ebed8",
+                    "preview": "Manager is assigned",
+                    "createdAt": "2023-02-11T11:01:00Z"
+                }
             },
             {
                 "chatId": "2b50973a-a9d3-11ed-818b-461e464ebed8",
-                "clientId": "463fde4e-a9d3-11ed-886f-461e464ebed8"
+                "clientId": "463fde4e-a9d3-11ed-886f-461e464ebed8",
+                "problemCreatedAt": "2023-02-11T12:00:00Z",
+                "unreadCount": 0,
+                "reopenedFromProblemId": "5a2d1c6e-a9d3-11ed-a1b2-461e464ebed8"
             }
         ]
     }
@@ -119,6 +159,54 @@ func (s *HandlersSuite) TestGetChatHistory_Usecase_UnknownError() {
 	s.Empty(resp.Body)
 }
 
+func (s *HandlersSuite) TestGetChatHistory_Usecase_MessageNotFound() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	msgID := types.NewMessageID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getChatHistory",
+		fmt.Sprintf(`{"pageSize":10,"chatId":%q,"aroundMessageId":%q}`, chatID, msgID))
+	s.getChatHistoryUseCase.EXPECT().Handle(eCtx.Request().Context(), getchathistory.Request{
+		ID:              reqID,
+		ManagerID:       s.managerID,
+		ChatID:          chatID,
+		PageSize:        10,
+		AroundMessageID: msgID,
+	}).Return(getchathistory.Response{}, getchathistory.ErrMessageNotFound)
+
+	// Action.
+	err := s.handlers.PostGetChatHistory(eCtx, managerv1.PostGetChatHistoryParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusNotFound, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestGetChatHistory_Usecase_ProblemNotFound() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getChatHistory",
+		fmt.Sprintf(`{"pageSize":10,"chatId":%q,"problemId":%q}`, chatID, problemID))
+	s.getChatHistoryUseCase.EXPECT().Handle(eCtx.Request().Context(), getchathistory.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+		PageSize:  10,
+		ProblemID: problemID,
+	}).Return(getchathistory.Response{}, getchathistory.ErrProblemNotFound)
+
+	// Action.
+	err := s.handlers.PostGetChatHistory(eCtx, managerv1.PostGetChatHistoryParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusNotFound, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
 func (s *HandlersSuite) TestGetChatHistory_Usecase_Success() {
 	// Arrange.
 	reqID := types.NewRequestID()
@@ -137,12 +225,36 @@ func (s *HandlersSuite) TestGetChatHistory_Usecase_Success() {
 				AuthorID:  types.MustParse[types.UserID]("086eafc8-ac2f-11ed-a746-461e464ebed8"),
 				Body:      "Hello!",
 				CreatedAt: time.Unix(1, 1).UTC(),
+				EditedAt:  time.Unix(3, 3).UTC(),
+				Attachments: []getchathistory.Attachment{{
+					ID:           types.MustParse[types.AttachmentID]("1c1b8f2e-ac2f-11ed-8ac8-461e464ebed8"),
+					FileName:     "cat.png",
+					ContentType:  "image/png",
+					Size:         1024,
+					URL:          "/attachments/1",
+					ThumbnailURL: "/attachments/1?variant=thumbnail",
+				}},
 			},
 			{
 				ID:        types.MustParse[types.MessageID]("05061024-ac2f-11ed-b21c-461e464ebed8"),
 				AuthorID:  s.managerID,
 				Body:      "How can I help you?",
 				CreatedAt: time.Unix(2, 2).UTC(),
+				Reactions: []getchathistory.Reaction{
+					{Kind: "heart", Count: 1, ReactedByMe: false},
+				},
+				ReplyTo: &getchathistory.Quote{
+					MessageID: types.MustParse[types.MessageID]("027c483c-ac2f-11ed-8ac8-461e464ebed8"),
+					AuthorID:  types.MustParse[types.UserID]("086eafc8-ac2f-11ed-a746-461e464ebed8"),
+					Preview:   "Hello!",
+				},
+			},
+			{
+				ID:        types.MustParse[types.MessageID]("0a3f3ec2-ac2f-11ed-9e4e-461e464ebed8"),
+				AuthorID:  types.MustParse[types.UserID]("086eafc8-ac2f-11ed-a746-461e464ebed8"),
+				Body:      "",
+				CreatedAt: time.Unix(4, 4).UTC(),
+				DeletedAt: time.Unix(5, 5).UTC(),
 			},
 		},
 		NextCursor: "",
@@ -164,16 +276,50 @@ func (s *HandlersSuite) TestGetChatHistory_Usecase_Success() {
                 "authorId": "086eafc8-ac2f-11ed-a746-461e464ebed8",
                 "body": "Hello!",
                 "createdAt": "1970-01-01T00:00:01.000000001Z",
-                "id": "027c483c-ac2f-11ed-8ac8-461e464ebed8"
+                "editedAt": "1970-01-01T00:00:03.000000003Z",
+                "id": "027c483c-ac2f-11ed-8ac8-461e464ebed8",
+                "attachments":
+                [
+                    {
+                        "id": "1c1b8f2e-ac2f-11ed-8ac8-461e464ebed8",
+                        "fileName": "cat.png",
+                        "contentType": "image/png",
+                        "size": 1024,
+                        "url": "/attachments/1",
+                        "thumbnailUrl": "/attachments/1?variant=thumbnail"
+                    }
+                ]
             },
             {
                 "authorId": %q,
                 "body": "How can I help you?",
                 "createdAt": "1970-01-01T00:00:02.000000002Z",
-                "id": "05061024-ac2f-11ed-b21c-461e464ebed8"
+                "id": "05061024-ac2f-11ed-b21c-461e464ebed8",
+                "reactions":
+                [
+                    {
+                        "kind": "heart",
+                        "count": 1,
+                        "reactedByMe": false
+                    }
+                ],
+                "replyTo":
+                {
+                    "messageId": "027c483c-ac2f-11ed-8ac8-461e464ebed8",
+                    "authorId": "086eafc8-ac2f-11ed-a746-461e464ebed8",
+                    "preview": "Hello!"
+                }
+            },
+            {
+                "authorId": "086eafc8-ac2f-11ed-a746-461e464ebed8",
+                "body": "",
+                "createdAt": "1970-01-01T00:00:04.000000004Z",
+                "deletedAt": "1970-01-01T00:00:05.000000005Z",
+                "id": "0a3f3ec2-ac2f-11ed-9e4e-461e464ebed8"
             }
         ],
-        "next": ""
+        "next": "",
+        "prev": ""
     }
 }`, s.managerID), resp.Body.String())
 }
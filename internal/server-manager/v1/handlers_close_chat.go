@@ -10,6 +10,7 @@ import (
 	internalerrors "github.com/zestagio/chat-service/internal/errors"
 	"github.com/zestagio/chat-service/internal/middlewares"
 	resolveproblem "github.com/zestagio/chat-service/internal/usecases/manager/resolve-problem"
+	"github.com/zestagio/chat-service/pkg/pointer"
 )
 
 func (h Handlers) PostCloseChat(eCtx echo.Context, params PostCloseChatParams) error {
@@ -25,11 +26,17 @@ func (h Handlers) PostCloseChat(eCtx echo.Context, params PostCloseChatParams) e
 		ID:        params.XRequestID,
 		ManagerID: managerID,
 		ChatID:    req.ChatId,
+
+		ResolutionCode:    req.ResolutionCode,
+		ResolutionSummary: pointer.Indirect(req.ResolutionSummary),
 	}); err != nil {
 		if errors.Is(err, resolveproblem.ErrAssignedProblemNotFound) {
 			return internalerrors.NewServerError(int(ErrorCodeAssignedProblemNotFound),
 				"assigned to manager problem was not found", err)
 		}
+		if errors.Is(err, resolveproblem.ErrUnknownResolutionCode) {
+			return internalerrors.NewServerError(int(ErrorCodeUnknownResolutionCode), "unknown resolution code", err)
+		}
 
 		return fmt.Errorf("handle resolve problem: %v", err)
 	}
@@ -31,12 +31,14 @@ func (s *HandlersSuite) TestCloseChat_Usecase_ProblemNotFoundError() {
 	// Arrange.
 	reqID := types.NewRequestID()
 	chatID := types.NewChatID()
-	resp, eCtx := s.newEchoCtx(reqID, "/v1/closeChat", fmt.Sprintf(`{"chatId": %q}`, chatID))
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/closeChat", fmt.Sprintf(`{"chatId": %q, "resolutionCode": "answered"}`, chatID))
 
 	s.resolveProblemUseCase.EXPECT().Handle(gomock.Any(), resolveproblem.Request{
 		ID:        reqID,
 		ManagerID: s.managerID,
 		ChatID:    chatID,
+
+		ResolutionCode: "answered",
 	}).Return(resolveproblem.Response{}, resolveproblem.ErrAssignedProblemNotFound)
 
 	// Action.
@@ -52,12 +54,14 @@ func (s *HandlersSuite) TestCloseChat_Usecase_UnknownError() {
 	// Arrange.
 	reqID := types.NewRequestID()
 	chatID := types.NewChatID()
-	resp, eCtx := s.newEchoCtx(reqID, "/v1/closeChat", fmt.Sprintf(`{"chatId": %q}`, chatID))
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/closeChat", fmt.Sprintf(`{"chatId": %q, "resolutionCode": "answered"}`, chatID))
 
 	s.resolveProblemUseCase.EXPECT().Handle(gomock.Any(), resolveproblem.Request{
 		ID:        reqID,
 		ManagerID: s.managerID,
 		ChatID:    chatID,
+
+		ResolutionCode: "answered",
 	}).Return(resolveproblem.Response{}, errors.New("something went wrong"))
 
 	// Action.
@@ -68,16 +72,43 @@ func (s *HandlersSuite) TestCloseChat_Usecase_UnknownError() {
 	s.Empty(resp.Body)
 }
 
+func (s *HandlersSuite) TestCloseChat_Usecase_UnknownResolutionCodeError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/closeChat", fmt.Sprintf(`{"chatId": %q, "resolutionCode": "answered"}`, chatID))
+
+	s.resolveProblemUseCase.EXPECT().Handle(gomock.Any(), resolveproblem.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+
+		ResolutionCode: "answered",
+	}).Return(resolveproblem.Response{}, resolveproblem.ErrUnknownResolutionCode)
+
+	// Action.
+	err := s.handlers.PostCloseChat(eCtx, managerv1.PostCloseChatParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.EqualValues(managerv1.ErrorCodeUnknownResolutionCode, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
 func (s *HandlersSuite) TestCloseChat_Usecase_Success() {
 	// Arrange.
 	reqID := types.NewRequestID()
 	chatID := types.NewChatID()
-	resp, eCtx := s.newEchoCtx(reqID, "/v1/closeChat", fmt.Sprintf(`{"chatId": %q}`, chatID))
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/closeChat", fmt.Sprintf(
+		`{"chatId": %q, "resolutionCode": "escalated", "resolutionSummary": "Passed to the cards team"}`, chatID))
 
 	s.resolveProblemUseCase.EXPECT().Handle(gomock.Any(), resolveproblem.Request{
 		ID:        reqID,
 		ManagerID: s.managerID,
 		ChatID:    chatID,
+
+		ResolutionCode:    "escalated",
+		ResolutionSummary: "Passed to the cards team",
 	}).Return(resolveproblem.Response{}, nil)
 
 	// Action.
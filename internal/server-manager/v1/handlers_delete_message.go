@@ -0,0 +1,45 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/manager/delete-message"
+)
+
+func (h Handlers) PostDeleteMessage(eCtx echo.Context, params PostDeleteMessageParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req DeleteMessageRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.deleteMessage.Handle(ctx, deletemessage.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		MessageID: req.MessageId,
+	})
+	if err != nil {
+		if errors.Is(err, deletemessage.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, deletemessage.ErrMessageNotFound) {
+			return internalerrors.NewServerError(http.StatusNotFound, "message not found", err)
+		}
+
+		return fmt.Errorf("handle `delete message` use case: %v", err)
+	}
+
+	return eCtx.JSON(http.StatusOK, DeleteMessageResponse{Data: &DeletedMessageHeader{
+		DeletedAt: resp.DeletedAt,
+		Id:        resp.MessageID,
+	}})
+}
@@ -0,0 +1,39 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	markchatasread "github.com/zestagio/chat-service/internal/usecases/manager/mark-chat-as-read"
+)
+
+func (h Handlers) PostMarkChatAsRead(eCtx echo.Context, params PostMarkChatAsReadParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req MarkChatAsReadRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	if _, err := h.markChatAsRead.Handle(ctx, markchatasread.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		ChatID:    req.ChatId,
+	}); err != nil {
+		if errors.Is(err, markchatasread.ErrAssignedProblemNotFound) {
+			return internalerrors.NewServerError(int(ErrorCodeAssignedProblemNotFound),
+				"assigned to manager problem was not found", err)
+		}
+
+		return fmt.Errorf("handle `mark chat as read` use case: %v", err)
+	}
+
+	var empty map[string]any
+	return eCtx.JSON(http.StatusOK, MarkChatAsReadResponse{Data: &empty})
+}
@@ -0,0 +1,90 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/mock/gomock"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	markchatasread "github.com/zestagio/chat-service/internal/usecases/manager/mark-chat-as-read"
+)
+
+func (s *HandlersSuite) TestMarkChatAsRead_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/markChatAsRead", `{"chatId": "64bce534-`)
+
+	// Action.
+	err := s.handlers.PostMarkChatAsRead(eCtx, managerv1.PostMarkChatAsReadParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestMarkChatAsRead_Usecase_ProblemNotFoundError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/markChatAsRead", fmt.Sprintf(`{"chatId": %q}`, chatID))
+
+	s.markChatAsReadUseCase.EXPECT().Handle(gomock.Any(), markchatasread.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(markchatasread.Response{}, markchatasread.ErrAssignedProblemNotFound)
+
+	// Action.
+	err := s.handlers.PostMarkChatAsRead(eCtx, managerv1.PostMarkChatAsReadParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.EqualValues(managerv1.ErrorCodeAssignedProblemNotFound, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestMarkChatAsRead_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/markChatAsRead", fmt.Sprintf(`{"chatId": %q}`, chatID))
+
+	s.markChatAsReadUseCase.EXPECT().Handle(gomock.Any(), markchatasread.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(markchatasread.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostMarkChatAsRead(eCtx, managerv1.PostMarkChatAsReadParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestMarkChatAsRead_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/markChatAsRead", fmt.Sprintf(`{"chatId": %q}`, chatID))
+
+	s.markChatAsReadUseCase.EXPECT().Handle(gomock.Any(), markchatasread.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(markchatasread.Response{}, nil)
+
+	// Action.
+	err := s.handlers.PostMarkChatAsRead(eCtx, managerv1.PostMarkChatAsReadParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data": null}`, resp.Body.String())
+}
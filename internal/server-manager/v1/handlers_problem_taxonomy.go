@@ -0,0 +1,50 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	setproblemcategory "github.com/zestagio/chat-service/internal/usecases/manager/set-problem-category"
+)
+
+func (h Handlers) PostGetProblemTaxonomy(eCtx echo.Context, _ PostGetProblemTaxonomyParams) error {
+	return eCtx.JSON(http.StatusOK, GetProblemTaxonomyResponse{Data: &ProblemTaxonomy{
+		Categories:      h.taxonomy.Categories(),
+		ResolutionCodes: h.taxonomy.ResolutionCodes(),
+	}})
+}
+
+func (h Handlers) PostSetProblemCategory(eCtx echo.Context, params PostSetProblemCategoryParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req SetProblemCategoryRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	if _, err := h.setProblemCategory.Handle(ctx, setproblemcategory.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		ChatID:    req.ChatId,
+		Category:  req.Category,
+	}); err != nil {
+		if errors.Is(err, setproblemcategory.ErrAssignedProblemNotFound) {
+			return internalerrors.NewServerError(int(ErrorCodeAssignedProblemNotFound),
+				"assigned to manager problem was not found", err)
+		}
+		if errors.Is(err, setproblemcategory.ErrUnknownCategory) {
+			return internalerrors.NewServerError(int(ErrorCodeUnknownProblemCategory), "unknown problem category", err)
+		}
+
+		return fmt.Errorf("handle `set problem category` use case: %v", err)
+	}
+
+	var empty map[string]any
+	return eCtx.JSON(http.StatusOK, SetProblemCategoryResponse{Data: &empty})
+}
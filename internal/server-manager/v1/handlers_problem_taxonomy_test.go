@@ -0,0 +1,121 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/mock/gomock"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	setproblemcategory "github.com/zestagio/chat-service/internal/usecases/manager/set-problem-category"
+)
+
+func (s *HandlersSuite) TestGetProblemTaxonomy() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getProblemTaxonomy", "")
+
+	s.problemTaxonomy.EXPECT().Categories().Return([]string{"cards", "loans"})
+	s.problemTaxonomy.EXPECT().ResolutionCodes().Return([]string{"answered", "escalated"})
+
+	// Action.
+	err := s.handlers.PostGetProblemTaxonomy(eCtx, managerv1.PostGetProblemTaxonomyParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`
+{
+    "data":
+    {
+        "categories": ["cards", "loans"],
+        "resolutionCodes": ["answered", "escalated"]
+    }
+}`, resp.Body.String())
+}
+
+func (s *HandlersSuite) TestSetProblemCategory_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/setProblemCategory", `{"chatId": "64bce534-`)
+
+	// Action.
+	err := s.handlers.PostSetProblemCategory(eCtx, managerv1.PostSetProblemCategoryParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSetProblemCategory_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{
+			name:    "problem not found",
+			err:     setproblemcategory.ErrAssignedProblemNotFound,
+			expCode: int(managerv1.ErrorCodeAssignedProblemNotFound),
+		},
+		{
+			name:    "unknown category",
+			err:     fmt.Errorf("%w: %q", setproblemcategory.ErrUnknownCategory, "cards"),
+			expCode: int(managerv1.ErrorCodeUnknownProblemCategory),
+		},
+		{
+			name:    "unknown error",
+			err:     errors.New("something went wrong"),
+			expCode: http.StatusInternalServerError,
+		},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			chatID := types.NewChatID()
+			resp, eCtx := s.newEchoCtx(reqID, "/v1/setProblemCategory",
+				fmt.Sprintf(`{"chatId": %q, "category": "cards"}`, chatID))
+
+			s.setProblemCategoryUseCase.EXPECT().Handle(gomock.Any(), setproblemcategory.Request{
+				ID:        reqID,
+				ManagerID: s.managerID,
+				ChatID:    chatID,
+				Category:  "cards",
+			}).Return(setproblemcategory.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostSetProblemCategory(eCtx, managerv1.PostSetProblemCategoryParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestSetProblemCategory_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/setProblemCategory", fmt.Sprintf(`{"chatId": %q, "category": "cards"}`, chatID))
+
+	s.setProblemCategoryUseCase.EXPECT().Handle(gomock.Any(), setproblemcategory.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+		Category:  "cards",
+	}).Return(setproblemcategory.Response{}, nil)
+
+	// Action.
+	err := s.handlers.PostSetProblemCategory(eCtx, managerv1.PostSetProblemCategoryParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data": null}`, resp.Body.String())
+}
@@ -0,0 +1,142 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	reacttomessage "github.com/zestagio/chat-service/internal/usecases/manager/react-to-message"
+)
+
+func (s *HandlersSuite) TestAddReaction_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/addReaction", `{"messageId": "`)
+
+	// Action.
+	err := s.handlers.PostAddReaction(eCtx, managerv1.PostAddReactionParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestAddReaction_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: reacttomessage.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{name: "not found", err: reacttomessage.ErrMessageNotFound, expCode: http.StatusNotFound},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			msgID := types.NewMessageID()
+
+			resp, eCtx := s.newEchoCtx(reqID, "/v1/addReaction", fmt.Sprintf(`{"messageId": %q, "kind": "heart"}`, msgID))
+			s.reactToMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), reacttomessage.Request{
+				ID:        reqID,
+				ManagerID: s.managerID,
+				MessageID: msgID,
+				Kind:      "heart",
+				Operation: reacttomessage.OperationAdd,
+			}).Return(reacttomessage.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostAddReaction(eCtx, managerv1.PostAddReactionParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestAddReaction_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	msgID := types.NewMessageID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/addReaction", fmt.Sprintf(`{"messageId": %q, "kind": "heart"}`, msgID))
+	s.reactToMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), reacttomessage.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		MessageID: msgID,
+		Kind:      "heart",
+		Operation: reacttomessage.OperationAdd,
+	}).Return(reacttomessage.Response{
+		MessageID: msgID,
+		Reactions: []reacttomessage.Reaction{
+			{Kind: "thumbs_up", Count: 1, ReactedByMe: false},
+			{Kind: "heart", Count: 2, ReactedByMe: true},
+		},
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostAddReaction(eCtx, managerv1.PostAddReactionParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "id": "%s",
+        "reactions":
+        [
+            {
+                "kind": "thumbs_up",
+                "count": 1,
+                "reactedByMe": false
+            },
+            {
+                "kind": "heart",
+                "count": 2,
+                "reactedByMe": true
+            }
+        ]
+    }
+}`, msgID), resp.Body.String())
+}
+
+func (s *HandlersSuite) TestRemoveReaction_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	msgID := types.NewMessageID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/removeReaction", fmt.Sprintf(`{"messageId": %q, "kind": "sad"}`, msgID))
+	s.reactToMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), reacttomessage.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		MessageID: msgID,
+		Kind:      "sad",
+		Operation: reacttomessage.OperationRemove,
+	}).Return(reacttomessage.Response{
+		MessageID: msgID,
+		Reactions: []reacttomessage.Reaction{},
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostRemoveReaction(eCtx, managerv1.PostRemoveReactionParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "id": "%s",
+        "reactions": []
+    }
+}`, msgID), resp.Body.String())
+}
@@ -0,0 +1,56 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	getsatisfactionscores "github.com/zestagio/chat-service/internal/usecases/manager/get-satisfaction-scores"
+	"github.com/zestagio/chat-service/pkg/pointer"
+)
+
+func (h Handlers) PostGetSatisfactionScores(eCtx echo.Context, params PostGetSatisfactionScoresParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req GetSatisfactionScoresRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.getSatisfactionScores.Handle(ctx, getsatisfactionscores.Request{
+		ID:                params.XRequestID,
+		ManagerID:         managerID,
+		From:              req.From,
+		To:                req.To,
+		AllManagers:       pointer.Indirect(req.AllManagers),
+		CanSeeAllManagers: h.isTeamLead(eCtx),
+	})
+	if err != nil {
+		if errors.Is(err, getsatisfactionscores.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, getsatisfactionscores.ErrForbidden) {
+			return internalerrors.NewServerError(http.StatusForbidden, "scores of all managers are available to team leads", err)
+		}
+
+		return fmt.Errorf("handle `get satisfaction scores` use case: %v", err)
+	}
+
+	scores := make([]SatisfactionScore, 0, len(resp.Scores))
+	for _, s := range resp.Scores {
+		scores = append(scores, SatisfactionScore{
+			AverageScore:   s.AverageScore,
+			ManagerId:      s.ManagerID,
+			RatingsCount:   s.RatingsCount,
+			SatisfiedCount: s.SatisfiedCount,
+		})
+	}
+
+	return eCtx.JSON(http.StatusOK, GetSatisfactionScoresResponse{Data: &SatisfactionScoreList{Scores: scores}})
+}
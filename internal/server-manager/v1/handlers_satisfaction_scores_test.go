@@ -0,0 +1,114 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	getsatisfactionscores "github.com/zestagio/chat-service/internal/usecases/manager/get-satisfaction-scores"
+)
+
+func (s *HandlersSuite) TestGetSatisfactionScores_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getSatisfactionScores", `{"from": "`)
+
+	// Action.
+	err := s.handlers.PostGetSatisfactionScores(eCtx, managerv1.PostGetSatisfactionScoresParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestGetSatisfactionScores_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: getsatisfactionscores.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{name: "forbidden", err: getsatisfactionscores.ErrForbidden, expCode: http.StatusForbidden},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+
+			resp, eCtx := s.newEchoCtx(reqID, "/v1/getSatisfactionScores",
+				`{"from": "2024-01-01T00:00:00Z", "to": "2024-02-01T00:00:00Z", "allManagers": true}`)
+			s.getSatisfactionScoresUseCase.EXPECT().Handle(eCtx.Request().Context(), getsatisfactionscores.Request{
+				ID:                reqID,
+				ManagerID:         s.managerID,
+				From:              time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:                time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				AllManagers:       true,
+				CanSeeAllManagers: false,
+			}).Return(getsatisfactionscores.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostGetSatisfactionScores(eCtx, managerv1.PostGetSatisfactionScoresParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestGetSatisfactionScores_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	otherManagerID := types.NewUserID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getSatisfactionScores",
+		`{"from": "2024-01-01T00:00:00Z", "to": "2024-02-01T00:00:00Z", "allManagers": true}`)
+	middlewares.SetTokenWithAccess(eCtx, s.managerID, "Anna", teamLeadResource, teamLeadRole)
+
+	s.getSatisfactionScoresUseCase.EXPECT().Handle(eCtx.Request().Context(), getsatisfactionscores.Request{
+		ID:                reqID,
+		ManagerID:         s.managerID,
+		From:              time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:                time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		AllManagers:       true,
+		CanSeeAllManagers: true,
+	}).Return(getsatisfactionscores.Response{Scores: []getsatisfactionscores.ManagerScore{
+		{ManagerID: s.managerID, RatingsCount: 4, AverageScore: 4.5, SatisfiedCount: 4},
+		{ManagerID: otherManagerID, RatingsCount: 2, AverageScore: 2, SatisfiedCount: 0},
+	}}, nil)
+
+	// Action.
+	err := s.handlers.PostGetSatisfactionScores(eCtx, managerv1.PostGetSatisfactionScoresParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "scores":
+        [
+            {
+                "averageScore": 4.5,
+                "managerId": "%s",
+                "ratingsCount": 4,
+                "satisfiedCount": 4
+            },
+            {
+                "averageScore": 2,
+                "managerId": "%s",
+                "ratingsCount": 2,
+                "satisfiedCount": 0
+            }
+        ]
+    }
+}`, s.managerID, otherManagerID), resp.Body.String())
+}
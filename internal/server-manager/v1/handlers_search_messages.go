@@ -0,0 +1,60 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	searchmessages "github.com/zestagio/chat-service/internal/usecases/manager/search-messages"
+	"github.com/zestagio/chat-service/pkg/pointer"
+)
+
+func (h Handlers) PostSearchMessages(eCtx echo.Context, params PostSearchMessagesParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req SearchMessagesRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.searchMessages.Handle(ctx, searchmessages.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		Query:     req.Query,
+		Cursor:    pointer.Indirect(req.Cursor),
+		PageSize:  pointer.Indirect(req.PageSize),
+	})
+	if err != nil {
+		if errors.Is(err, searchmessages.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, searchmessages.ErrInvalidCursor) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid cursor", err)
+		}
+
+		return fmt.Errorf("handle `search messages` use case: %v", err)
+	}
+
+	results := make([]SearchResult, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		results = append(results, SearchResult{
+			AuthorId:      pointer.PtrWithZeroAsNil(r.AuthorID),
+			ChatId:        r.ChatID,
+			CreatedAt:     r.CreatedAt,
+			HistoryCursor: r.HistoryCursor,
+			MessageId:     r.MessageID,
+			ProblemId:     r.ProblemID,
+			Snippet:       r.Snippet,
+		})
+	}
+	return eCtx.JSON(http.StatusOK, SearchMessagesResponse{Data: &SearchResultsPage{
+		Next:    resp.NextCursor,
+		Results: results,
+	}})
+}
@@ -0,0 +1,104 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	searchmessages "github.com/zestagio/chat-service/internal/usecases/manager/search-messages"
+)
+
+func (s *HandlersSuite) TestSearchMessages_Usecase_InvalidRequest() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/searchMessages", `{"pageSize":10,"query":"c"}`)
+	s.searchMessagesUseCase.EXPECT().Handle(eCtx.Request().Context(), searchmessages.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		Query:     "c",
+		PageSize:  10,
+	}).Return(searchmessages.Response{}, fmt.Errorf("validate: %w", searchmessages.ErrInvalidRequest))
+
+	// Action.
+	err := s.handlers.PostSearchMessages(eCtx, managerv1.PostSearchMessagesParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSearchMessages_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/searchMessages", `{"cursor":"abc","query":"card"}`)
+	s.searchMessagesUseCase.EXPECT().Handle(eCtx.Request().Context(), searchmessages.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		Query:     "card",
+		Cursor:    "abc",
+	}).Return(searchmessages.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostSearchMessages(eCtx, managerv1.PostSearchMessagesParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusInternalServerError, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSearchMessages_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/searchMessages", `{"pageSize":10,"query":"card"}`)
+	s.searchMessagesUseCase.EXPECT().Handle(eCtx.Request().Context(), searchmessages.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		Query:     "card",
+		PageSize:  10,
+	}).Return(searchmessages.Response{
+		Results: []searchmessages.Result{
+			{
+				ChatID:        types.MustParse[types.ChatID]("20b99498-a9d3-11ed-92b0-461e464ebed8"),
+				ProblemID:     types.MustParse[types.ProblemID]("8e3f2a1c-a9d3-11ed-b2c4-461e464ebed8"),
+				MessageID:     types.MustParse[types.MessageID]("ac5b5b0e-a9d3-11ed-9d3c-461e464ebed8"),
+				AuthorID:      types.MustParse[types.UserID]("4faa9042-a9d3-11ed-8bfe-461e464ebed8"),
+				CreatedAt:     time.Unix(1, 1).UTC(),
+				Snippet:       "my <mark>card</mark> is blocked",
+				HistoryCursor: "eyJQYWdlU2l6ZSI6MjB9",
+			},
+		},
+		NextCursor: "eyJQYWdlU2l6ZSI6MTB9",
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostSearchMessages(eCtx, managerv1.PostSearchMessagesParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`
+{
+    "data":
+    {
+        "next": "eyJQYWdlU2l6ZSI6MTB9",
+        "results":
+        [
+            {
+                "chatId": "20b99498-a9d3-11ed-92b0-461e464ebed8",
+                "problemId": "8e3f2a1c-a9d3-11ed-b2c4-461e464ebed8",
+                "messageId": "ac5b5b0e-a9d3-11ed-9d3c-461e464ebed8",
+                "authorId": "4faa9042-a9d3-11ed-8bfe-461e464ebed8",
+                "createdAt": "1970-01-01T00:00:01.000000001Z",
+                "snippet": "my <mark>card</mark> is blocked",
+                "historyCursor": "eyJQYWdlU2l6ZSI6MjB9"
+            }
+        ]
+    }
+}`, resp.Body.String())
+}
@@ -1,13 +1,16 @@
 package managerv1
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
 	"github.com/zestagio/chat-service/internal/middlewares"
 	sendmessage "github.com/zestagio/chat-service/internal/usecases/manager/send-message"
+	"github.com/zestagio/chat-service/pkg/pointer"
 )
 
 func (h Handlers) PostSendMessage(eCtx echo.Context, params PostSendMessageParams) error {
@@ -24,8 +27,19 @@ func (h Handlers) PostSendMessage(eCtx echo.Context, params PostSendMessageParam
 		ManagerID:   managerID,
 		ChatID:      req.ChatId,
 		MessageBody: req.MessageBody,
+
+		AttachmentIDs:    pointer.Indirect(req.AttachmentIds),
+		ReplyToMessageID: pointer.Indirect(req.ReplyToMessageId),
 	})
 	if err != nil {
+		if errors.Is(err, sendmessage.ErrAttachmentInvalid) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid attachment", err)
+		}
+
+		if errors.Is(err, sendmessage.ErrReplyToInvalid) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid replied message", err)
+		}
+
 		return fmt.Errorf("handle `send message` use case: %v", err)
 	}
 
@@ -49,6 +49,58 @@ func (s *HandlersSuite) TestSendMessage_Usecase_UnknownError() {
 	s.Empty(resp.Body)
 }
 
+func (s *HandlersSuite) TestSendMessage_Usecase_AttachmentInvalidError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	attachmentID := types.NewAttachmentID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage",
+		fmt.Sprintf(`{"messageBody": "", "chatId": %q, "attachmentIds": [%q]}`, chatID, attachmentID))
+
+	s.sendMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), sendmessage.Request{
+		ID:            reqID,
+		ManagerID:     s.managerID,
+		ChatID:        chatID,
+		MessageBody:   "",
+		AttachmentIDs: []types.AttachmentID{attachmentID},
+	}).Return(sendmessage.Response{}, sendmessage.ErrAttachmentInvalid)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendMessage_Usecase_ReplyToInvalidError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	replyToID := types.NewMessageID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage",
+		fmt.Sprintf(`{"messageBody": "Yes", "chatId": %q, "replyToMessageId": %q}`, chatID, replyToID))
+
+	s.sendMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), sendmessage.Request{
+		ID:               reqID,
+		ManagerID:        s.managerID,
+		ChatID:           chatID,
+		MessageBody:      "Yes",
+		ReplyToMessageID: replyToID,
+	}).Return(sendmessage.Response{}, sendmessage.ErrReplyToInvalid)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
 func (s *HandlersSuite) TestSendMessage_Usecase_Success() {
 	// Arrange.
 	reqID := types.NewRequestID()
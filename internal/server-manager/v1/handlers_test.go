@@ -17,17 +17,37 @@ import (
 	"github.com/zestagio/chat-service/internal/types"
 )
 
+const (
+	teamLeadResource = "chat-ui-manager"
+	teamLeadRole     = "support-chat-team-lead"
+)
+
 type HandlersSuite struct {
 	testingh.ContextSuite
 
-	ctrl                      *gomock.Controller
-	canReceiveProblemsUseCase *managerv1mocks.MockcanReceiveProblemsUseCase
-	freeHandsSignalUseCase    *managerv1mocks.MockfreeHandsSignalUseCase
-	getChatsUseCase           *managerv1mocks.MockgetChatsUseCase
-	getChatHistoryUseCase     *managerv1mocks.MockgetChatHistoryUseCase
-	resolveProblemUseCase     *managerv1mocks.MockresolveProblemUseCase
-	sendMessageUseCase        *managerv1mocks.MocksendMessageUseCase
-	handlers                  managerv1.Handlers
+	ctrl                         *gomock.Controller
+	addInternalNoteUseCase       *managerv1mocks.MockaddInternalNoteUseCase
+	canReceiveProblemsUseCase    *managerv1mocks.MockcanReceiveProblemsUseCase
+	createCannedResponseUseCase  *managerv1mocks.MockcreateCannedResponseUseCase
+	deleteCannedResponseUseCase  *managerv1mocks.MockdeleteCannedResponseUseCase
+	deleteMessageUseCase         *managerv1mocks.MockdeleteMessageUseCase
+	editMessageUseCase           *managerv1mocks.MockeditMessageUseCase
+	freeHandsSignalUseCase       *managerv1mocks.MockfreeHandsSignalUseCase
+	getCannedResponsesUseCase    *managerv1mocks.MockgetCannedResponsesUseCase
+	getChatsUseCase              *managerv1mocks.MockgetChatsUseCase
+	getChatHistoryUseCase        *managerv1mocks.MockgetChatHistoryUseCase
+	getSatisfactionScoresUseCase *managerv1mocks.MockgetSatisfactionScoresUseCase
+	markChatAsReadUseCase        *managerv1mocks.MockmarkChatAsReadUseCase
+	reactToMessageUseCase        *managerv1mocks.MockreactToMessageUseCase
+	resolveProblemUseCase        *managerv1mocks.MockresolveProblemUseCase
+	searchMessagesUseCase        *managerv1mocks.MocksearchMessagesUseCase
+	sendCannedResponseUseCase    *managerv1mocks.MocksendCannedResponseUseCase
+	sendMessageUseCase           *managerv1mocks.MocksendMessageUseCase
+	setProblemCategoryUseCase    *managerv1mocks.MocksetProblemCategoryUseCase
+	updateCannedResponseUseCase  *managerv1mocks.MockupdateCannedResponseUseCase
+	uploadAttachmentUseCase      *managerv1mocks.MockuploadAttachmentUseCase
+	problemTaxonomy              *managerv1mocks.MockproblemTaxonomy
+	handlers                     managerv1.Handlers
 
 	managerID types.UserID
 }
@@ -39,21 +59,53 @@ func TestHandlersSuite(t *testing.T) {
 
 func (s *HandlersSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
+	s.addInternalNoteUseCase = managerv1mocks.NewMockaddInternalNoteUseCase(s.ctrl)
 	s.canReceiveProblemsUseCase = managerv1mocks.NewMockcanReceiveProblemsUseCase(s.ctrl)
+	s.createCannedResponseUseCase = managerv1mocks.NewMockcreateCannedResponseUseCase(s.ctrl)
+	s.deleteCannedResponseUseCase = managerv1mocks.NewMockdeleteCannedResponseUseCase(s.ctrl)
+	s.deleteMessageUseCase = managerv1mocks.NewMockdeleteMessageUseCase(s.ctrl)
+	s.editMessageUseCase = managerv1mocks.NewMockeditMessageUseCase(s.ctrl)
 	s.freeHandsSignalUseCase = managerv1mocks.NewMockfreeHandsSignalUseCase(s.ctrl)
+	s.getCannedResponsesUseCase = managerv1mocks.NewMockgetCannedResponsesUseCase(s.ctrl)
 	s.getChatsUseCase = managerv1mocks.NewMockgetChatsUseCase(s.ctrl)
 	s.getChatHistoryUseCase = managerv1mocks.NewMockgetChatHistoryUseCase(s.ctrl)
+	s.getSatisfactionScoresUseCase = managerv1mocks.NewMockgetSatisfactionScoresUseCase(s.ctrl)
+	s.markChatAsReadUseCase = managerv1mocks.NewMockmarkChatAsReadUseCase(s.ctrl)
+	s.reactToMessageUseCase = managerv1mocks.NewMockreactToMessageUseCase(s.ctrl)
 	s.resolveProblemUseCase = managerv1mocks.NewMockresolveProblemUseCase(s.ctrl)
+	s.searchMessagesUseCase = managerv1mocks.NewMocksearchMessagesUseCase(s.ctrl)
+	s.sendCannedResponseUseCase = managerv1mocks.NewMocksendCannedResponseUseCase(s.ctrl)
 	s.sendMessageUseCase = managerv1mocks.NewMocksendMessageUseCase(s.ctrl)
+	s.setProblemCategoryUseCase = managerv1mocks.NewMocksetProblemCategoryUseCase(s.ctrl)
+	s.updateCannedResponseUseCase = managerv1mocks.NewMockupdateCannedResponseUseCase(s.ctrl)
+	s.uploadAttachmentUseCase = managerv1mocks.NewMockuploadAttachmentUseCase(s.ctrl)
+	s.problemTaxonomy = managerv1mocks.NewMockproblemTaxonomy(s.ctrl)
 	{
 		var err error
 		s.handlers, err = managerv1.NewHandlers(managerv1.NewOptions(
+			s.addInternalNoteUseCase,
 			s.canReceiveProblemsUseCase,
+			s.createCannedResponseUseCase,
+			s.deleteCannedResponseUseCase,
+			s.deleteMessageUseCase,
+			s.editMessageUseCase,
 			s.freeHandsSignalUseCase,
+			s.getCannedResponsesUseCase,
 			s.getChatsUseCase,
 			s.getChatHistoryUseCase,
+			s.getSatisfactionScoresUseCase,
+			s.markChatAsReadUseCase,
+			s.reactToMessageUseCase,
 			s.resolveProblemUseCase,
+			s.searchMessagesUseCase,
+			s.sendCannedResponseUseCase,
 			s.sendMessageUseCase,
+			s.setProblemCategoryUseCase,
+			s.updateCannedResponseUseCase,
+			s.uploadAttachmentUseCase,
+			s.problemTaxonomy,
+			teamLeadResource,
+			teamLeadRole,
 		))
 		s.Require().NoError(err)
 	}
@@ -0,0 +1,94 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	"github.com/zestagio/chat-service/internal/types"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/manager/upload-attachment"
+	"github.com/zestagio/chat-service/pkg/pointer"
+)
+
+const (
+	formFieldChatID = "chatId"
+	formFieldFile   = "file"
+)
+
+func (h Handlers) PostUploadAttachment(eCtx echo.Context, params PostUploadAttachmentParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	chatID, err := types.Parse[types.ChatID](eCtx.FormValue(formFieldChatID))
+	if err != nil {
+		return internalerrors.NewServerError(http.StatusBadRequest, "invalid chat id", err)
+	}
+
+	fileName, data, err := readFormFile(eCtx, formFieldFile)
+	if err != nil {
+		return internalerrors.NewServerError(http.StatusBadRequest, "invalid file", err)
+	}
+
+	resp, err := h.uploadAttachment.Handle(ctx, uploadattachment.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		ChatID:    chatID,
+		FileName:  fileName,
+		Data:      data,
+	})
+	if err != nil {
+		if errors.Is(err, uploadattachment.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+
+		if errors.Is(err, uploadattachment.ErrAssignedProblemNotFound) {
+			return internalerrors.NewServerError(int(ErrorCodeAssignedProblemNotFound),
+				"assigned problem not found", err)
+		}
+
+		if errors.Is(err, uploadattachment.ErrFileTooLarge) {
+			return internalerrors.NewServerError(http.StatusRequestEntityTooLarge, "file is too large", err)
+		}
+
+		if errors.Is(err, uploadattachment.ErrContentTypeNotAllowed) {
+			return internalerrors.NewServerError(http.StatusUnsupportedMediaType, "file type is not allowed", err)
+		}
+
+		return fmt.Errorf("handle `upload attachment` use case: %v", err)
+	}
+
+	return eCtx.JSON(http.StatusOK, UploadAttachmentResponse{Data: &Attachment{
+		ContentType:  resp.ContentType,
+		FileName:     resp.FileName,
+		Id:           resp.AttachmentID,
+		Size:         resp.Size,
+		ThumbnailUrl: pointer.PtrWithZeroAsNil(resp.ThumbnailURL),
+		Url:          resp.URL,
+	}})
+}
+
+// readFormFile reads the whole file of the multipart form.
+// The size of the file is limited by the server body limit.
+func readFormFile(eCtx echo.Context, field string) (string, []byte, error) {
+	fh, err := eCtx.FormFile(field)
+	if err != nil {
+		return "", nil, fmt.Errorf("get form file: %v", err)
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return "", nil, fmt.Errorf("open form file: %v", err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return "", nil, fmt.Errorf("read form file: %v", err)
+	}
+	return fh.Filename, data, nil
+}
@@ -0,0 +1,148 @@
+package managerv1_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	managerv1 "github.com/zestagio/chat-service/internal/server-manager/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/manager/upload-attachment"
+)
+
+func (s *HandlersSuite) TestUploadAttachment_InvalidChatID() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newMultipartEchoCtx(reqID, "/v1/uploadAttachment", "not-uuid", "cat.png", []byte("x"))
+
+	// Action.
+	err := s.handlers.PostUploadAttachment(eCtx, managerv1.PostUploadAttachmentParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestUploadAttachment_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: uploadattachment.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{
+			name:    "assigned problem not found",
+			err:     uploadattachment.ErrAssignedProblemNotFound,
+			expCode: int(managerv1.ErrorCodeAssignedProblemNotFound),
+		},
+		{name: "too large", err: uploadattachment.ErrFileTooLarge, expCode: http.StatusRequestEntityTooLarge},
+		{
+			name:    "content type not allowed",
+			err:     uploadattachment.ErrContentTypeNotAllowed,
+			expCode: http.StatusUnsupportedMediaType,
+		},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			chatID := types.NewChatID()
+			resp, eCtx := s.newMultipartEchoCtx(reqID, "/v1/uploadAttachment",
+				chatID.String(), "statement.pdf", []byte("%PDF-1.7"))
+
+			s.uploadAttachmentUseCase.EXPECT().Handle(gomock.Any(), uploadattachment.Request{
+				ID:        reqID,
+				ManagerID: s.managerID,
+				ChatID:    chatID,
+				FileName:  "statement.pdf",
+				Data:      []byte("%PDF-1.7"),
+			}).Return(uploadattachment.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostUploadAttachment(eCtx, managerv1.PostUploadAttachmentParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestUploadAttachment_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	attachmentID := types.NewAttachmentID()
+	resp, eCtx := s.newMultipartEchoCtx(reqID, "/v1/uploadAttachment",
+		chatID.String(), "statement.pdf", []byte("%PDF-1.7"))
+
+	s.uploadAttachmentUseCase.EXPECT().Handle(gomock.Any(), uploadattachment.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+		FileName:  "statement.pdf",
+		Data:      []byte("%PDF-1.7"),
+	}).Return(uploadattachment.Response{
+		AttachmentID: attachmentID,
+		FileName:     "statement.pdf",
+		ContentType:  "application/pdf",
+		Size:         8,
+		URL:          "/attachments/1",
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostUploadAttachment(eCtx, managerv1.PostUploadAttachmentParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "id": %q,
+        "fileName": "statement.pdf",
+        "contentType": "application/pdf",
+        "size": 8,
+        "url": "/attachments/1"
+    }
+}`, attachmentID), resp.Body.String())
+}
+
+func (s *HandlersSuite) newMultipartEchoCtx(
+	requestID types.RequestID,
+	path string,
+	chatID string,
+	fileName string,
+	data []byte,
+) (*httptest.ResponseRecorder, echo.Context) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	s.Require().NoError(w.WriteField("chatId", chatID))
+	fw, err := w.CreateFormFile("file", fileName)
+	s.Require().NoError(err)
+	_, err = fw.Write(data)
+	s.Require().NoError(err)
+	s.Require().NoError(w.Close())
+
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set(echo.HeaderContentType, w.FormDataContentType())
+	req.Header.Set(echo.HeaderXRequestID, requestID.String())
+
+	resp := httptest.NewRecorder()
+
+	ctx := echo.New().NewContext(req, resp)
+	middlewares.SetToken(ctx, s.managerID)
+
+	return resp, ctx
+}
@@ -9,14 +9,66 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	addinternalnote "github.com/zestagio/chat-service/internal/usecases/manager/add-internal-note"
 	canreceiveproblems "github.com/zestagio/chat-service/internal/usecases/manager/can-receive-problems"
+	createcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/create-canned-response"
+	deletecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/delete-canned-response"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/manager/delete-message"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/manager/edit-message"
 	freehandssignal "github.com/zestagio/chat-service/internal/usecases/manager/free-hands-signal"
+	getcannedresponses "github.com/zestagio/chat-service/internal/usecases/manager/get-canned-responses"
 	getchathistory "github.com/zestagio/chat-service/internal/usecases/manager/get-chat-history"
 	getchats "github.com/zestagio/chat-service/internal/usecases/manager/get-chats"
+	getsatisfactionscores "github.com/zestagio/chat-service/internal/usecases/manager/get-satisfaction-scores"
+	markchatasread "github.com/zestagio/chat-service/internal/usecases/manager/mark-chat-as-read"
+	reacttomessage "github.com/zestagio/chat-service/internal/usecases/manager/react-to-message"
 	resolveproblem "github.com/zestagio/chat-service/internal/usecases/manager/resolve-problem"
+	searchmessages "github.com/zestagio/chat-service/internal/usecases/manager/search-messages"
+	sendcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/send-canned-response"
 	sendmessage "github.com/zestagio/chat-service/internal/usecases/manager/send-message"
+	setproblemcategory "github.com/zestagio/chat-service/internal/usecases/manager/set-problem-category"
+	updatecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/update-canned-response"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/manager/upload-attachment"
 )
 
+// MockaddInternalNoteUseCase is a mock of addInternalNoteUseCase interface.
+type MockaddInternalNoteUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddInternalNoteUseCaseMockRecorder
+}
+
+// MockaddInternalNoteUseCaseMockRecorder is the mock recorder for MockaddInternalNoteUseCase.
+type MockaddInternalNoteUseCaseMockRecorder struct {
+	mock *MockaddInternalNoteUseCase
+}
+
+// NewMockaddInternalNoteUseCase creates a new mock instance.
+func NewMockaddInternalNoteUseCase(ctrl *gomock.Controller) *MockaddInternalNoteUseCase {
+	mock := &MockaddInternalNoteUseCase{ctrl: ctrl}
+	mock.recorder = &MockaddInternalNoteUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaddInternalNoteUseCase) EXPECT() *MockaddInternalNoteUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockaddInternalNoteUseCase) Handle(ctx context.Context, req addinternalnote.Request) (addinternalnote.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(addinternalnote.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockaddInternalNoteUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockaddInternalNoteUseCase)(nil).Handle), ctx, req)
+}
+
 // MockcanReceiveProblemsUseCase is a mock of canReceiveProblemsUseCase interface.
 type MockcanReceiveProblemsUseCase struct {
 	ctrl     *gomock.Controller
@@ -55,6 +107,158 @@ func (mr *MockcanReceiveProblemsUseCaseMockRecorder) Handle(ctx, req interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockcanReceiveProblemsUseCase)(nil).Handle), ctx, req)
 }
 
+// MockcreateCannedResponseUseCase is a mock of createCannedResponseUseCase interface.
+type MockcreateCannedResponseUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockcreateCannedResponseUseCaseMockRecorder
+}
+
+// MockcreateCannedResponseUseCaseMockRecorder is the mock recorder for MockcreateCannedResponseUseCase.
+type MockcreateCannedResponseUseCaseMockRecorder struct {
+	mock *MockcreateCannedResponseUseCase
+}
+
+// NewMockcreateCannedResponseUseCase creates a new mock instance.
+func NewMockcreateCannedResponseUseCase(ctrl *gomock.Controller) *MockcreateCannedResponseUseCase {
+	mock := &MockcreateCannedResponseUseCase{ctrl: ctrl}
+	mock.recorder = &MockcreateCannedResponseUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcreateCannedResponseUseCase) EXPECT() *MockcreateCannedResponseUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockcreateCannedResponseUseCase) Handle(ctx context.Context, req createcannedresponse.Request) (createcannedresponse.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(createcannedresponse.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockcreateCannedResponseUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockcreateCannedResponseUseCase)(nil).Handle), ctx, req)
+}
+
+// MockdeleteCannedResponseUseCase is a mock of deleteCannedResponseUseCase interface.
+type MockdeleteCannedResponseUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeleteCannedResponseUseCaseMockRecorder
+}
+
+// MockdeleteCannedResponseUseCaseMockRecorder is the mock recorder for MockdeleteCannedResponseUseCase.
+type MockdeleteCannedResponseUseCaseMockRecorder struct {
+	mock *MockdeleteCannedResponseUseCase
+}
+
+// NewMockdeleteCannedResponseUseCase creates a new mock instance.
+func NewMockdeleteCannedResponseUseCase(ctrl *gomock.Controller) *MockdeleteCannedResponseUseCase {
+	mock := &MockdeleteCannedResponseUseCase{ctrl: ctrl}
+	mock.recorder = &MockdeleteCannedResponseUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeleteCannedResponseUseCase) EXPECT() *MockdeleteCannedResponseUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockdeleteCannedResponseUseCase) Handle(ctx context.Context, req deletecannedresponse.Request) (deletecannedresponse.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(deletecannedresponse.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockdeleteCannedResponseUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdeleteCannedResponseUseCase)(nil).Handle), ctx, req)
+}
+
+// MockdeleteMessageUseCase is a mock of deleteMessageUseCase interface.
+type MockdeleteMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeleteMessageUseCaseMockRecorder
+}
+
+// MockdeleteMessageUseCaseMockRecorder is the mock recorder for MockdeleteMessageUseCase.
+type MockdeleteMessageUseCaseMockRecorder struct {
+	mock *MockdeleteMessageUseCase
+}
+
+// NewMockdeleteMessageUseCase creates a new mock instance.
+func NewMockdeleteMessageUseCase(ctrl *gomock.Controller) *MockdeleteMessageUseCase {
+	mock := &MockdeleteMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockdeleteMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeleteMessageUseCase) EXPECT() *MockdeleteMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockdeleteMessageUseCase) Handle(ctx context.Context, req deletemessage.Request) (deletemessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(deletemessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockdeleteMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdeleteMessageUseCase)(nil).Handle), ctx, req)
+}
+
+// MockeditMessageUseCase is a mock of editMessageUseCase interface.
+type MockeditMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockeditMessageUseCaseMockRecorder
+}
+
+// MockeditMessageUseCaseMockRecorder is the mock recorder for MockeditMessageUseCase.
+type MockeditMessageUseCaseMockRecorder struct {
+	mock *MockeditMessageUseCase
+}
+
+// NewMockeditMessageUseCase creates a new mock instance.
+func NewMockeditMessageUseCase(ctrl *gomock.Controller) *MockeditMessageUseCase {
+	mock := &MockeditMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockeditMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeditMessageUseCase) EXPECT() *MockeditMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockeditMessageUseCase) Handle(ctx context.Context, req editmessage.Request) (editmessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(editmessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockeditMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockeditMessageUseCase)(nil).Handle), ctx, req)
+}
+
 // MockfreeHandsSignalUseCase is a mock of freeHandsSignalUseCase interface.
 type MockfreeHandsSignalUseCase struct {
 	ctrl     *gomock.Controller
@@ -93,6 +297,44 @@ func (mr *MockfreeHandsSignalUseCaseMockRecorder) Handle(ctx, req interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockfreeHandsSignalUseCase)(nil).Handle), ctx, req)
 }
 
+// MockgetCannedResponsesUseCase is a mock of getCannedResponsesUseCase interface.
+type MockgetCannedResponsesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgetCannedResponsesUseCaseMockRecorder
+}
+
+// MockgetCannedResponsesUseCaseMockRecorder is the mock recorder for MockgetCannedResponsesUseCase.
+type MockgetCannedResponsesUseCaseMockRecorder struct {
+	mock *MockgetCannedResponsesUseCase
+}
+
+// NewMockgetCannedResponsesUseCase creates a new mock instance.
+func NewMockgetCannedResponsesUseCase(ctrl *gomock.Controller) *MockgetCannedResponsesUseCase {
+	mock := &MockgetCannedResponsesUseCase{ctrl: ctrl}
+	mock.recorder = &MockgetCannedResponsesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgetCannedResponsesUseCase) EXPECT() *MockgetCannedResponsesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockgetCannedResponsesUseCase) Handle(ctx context.Context, req getcannedresponses.Request) (getcannedresponses.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(getcannedresponses.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockgetCannedResponsesUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockgetCannedResponsesUseCase)(nil).Handle), ctx, req)
+}
+
 // MockgetChatHistoryUseCase is a mock of getChatHistoryUseCase interface.
 type MockgetChatHistoryUseCase struct {
 	ctrl     *gomock.Controller
@@ -169,6 +411,120 @@ func (mr *MockgetChatsUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockgetChatsUseCase)(nil).Handle), ctx, req)
 }
 
+// MockgetSatisfactionScoresUseCase is a mock of getSatisfactionScoresUseCase interface.
+type MockgetSatisfactionScoresUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgetSatisfactionScoresUseCaseMockRecorder
+}
+
+// MockgetSatisfactionScoresUseCaseMockRecorder is the mock recorder for MockgetSatisfactionScoresUseCase.
+type MockgetSatisfactionScoresUseCaseMockRecorder struct {
+	mock *MockgetSatisfactionScoresUseCase
+}
+
+// NewMockgetSatisfactionScoresUseCase creates a new mock instance.
+func NewMockgetSatisfactionScoresUseCase(ctrl *gomock.Controller) *MockgetSatisfactionScoresUseCase {
+	mock := &MockgetSatisfactionScoresUseCase{ctrl: ctrl}
+	mock.recorder = &MockgetSatisfactionScoresUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgetSatisfactionScoresUseCase) EXPECT() *MockgetSatisfactionScoresUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockgetSatisfactionScoresUseCase) Handle(ctx context.Context, req getsatisfactionscores.Request) (getsatisfactionscores.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(getsatisfactionscores.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockgetSatisfactionScoresUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockgetSatisfactionScoresUseCase)(nil).Handle), ctx, req)
+}
+
+// MockmarkChatAsReadUseCase is a mock of markChatAsReadUseCase interface.
+type MockmarkChatAsReadUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockmarkChatAsReadUseCaseMockRecorder
+}
+
+// MockmarkChatAsReadUseCaseMockRecorder is the mock recorder for MockmarkChatAsReadUseCase.
+type MockmarkChatAsReadUseCaseMockRecorder struct {
+	mock *MockmarkChatAsReadUseCase
+}
+
+// NewMockmarkChatAsReadUseCase creates a new mock instance.
+func NewMockmarkChatAsReadUseCase(ctrl *gomock.Controller) *MockmarkChatAsReadUseCase {
+	mock := &MockmarkChatAsReadUseCase{ctrl: ctrl}
+	mock.recorder = &MockmarkChatAsReadUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmarkChatAsReadUseCase) EXPECT() *MockmarkChatAsReadUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockmarkChatAsReadUseCase) Handle(ctx context.Context, req markchatasread.Request) (markchatasread.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(markchatasread.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockmarkChatAsReadUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockmarkChatAsReadUseCase)(nil).Handle), ctx, req)
+}
+
+// MockreactToMessageUseCase is a mock of reactToMessageUseCase interface.
+type MockreactToMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockreactToMessageUseCaseMockRecorder
+}
+
+// MockreactToMessageUseCaseMockRecorder is the mock recorder for MockreactToMessageUseCase.
+type MockreactToMessageUseCaseMockRecorder struct {
+	mock *MockreactToMessageUseCase
+}
+
+// NewMockreactToMessageUseCase creates a new mock instance.
+func NewMockreactToMessageUseCase(ctrl *gomock.Controller) *MockreactToMessageUseCase {
+	mock := &MockreactToMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockreactToMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreactToMessageUseCase) EXPECT() *MockreactToMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockreactToMessageUseCase) Handle(ctx context.Context, req reacttomessage.Request) (reacttomessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(reacttomessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockreactToMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockreactToMessageUseCase)(nil).Handle), ctx, req)
+}
+
 // MockresolveProblemUseCase is a mock of resolveProblemUseCase interface.
 type MockresolveProblemUseCase struct {
 	ctrl     *gomock.Controller
@@ -207,6 +563,82 @@ func (mr *MockresolveProblemUseCaseMockRecorder) Handle(ctx, req interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockresolveProblemUseCase)(nil).Handle), ctx, req)
 }
 
+// MocksearchMessagesUseCase is a mock of searchMessagesUseCase interface.
+type MocksearchMessagesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MocksearchMessagesUseCaseMockRecorder
+}
+
+// MocksearchMessagesUseCaseMockRecorder is the mock recorder for MocksearchMessagesUseCase.
+type MocksearchMessagesUseCaseMockRecorder struct {
+	mock *MocksearchMessagesUseCase
+}
+
+// NewMocksearchMessagesUseCase creates a new mock instance.
+func NewMocksearchMessagesUseCase(ctrl *gomock.Controller) *MocksearchMessagesUseCase {
+	mock := &MocksearchMessagesUseCase{ctrl: ctrl}
+	mock.recorder = &MocksearchMessagesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksearchMessagesUseCase) EXPECT() *MocksearchMessagesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MocksearchMessagesUseCase) Handle(ctx context.Context, req searchmessages.Request) (searchmessages.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(searchmessages.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MocksearchMessagesUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MocksearchMessagesUseCase)(nil).Handle), ctx, req)
+}
+
+// MocksendCannedResponseUseCase is a mock of sendCannedResponseUseCase interface.
+type MocksendCannedResponseUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MocksendCannedResponseUseCaseMockRecorder
+}
+
+// MocksendCannedResponseUseCaseMockRecorder is the mock recorder for MocksendCannedResponseUseCase.
+type MocksendCannedResponseUseCaseMockRecorder struct {
+	mock *MocksendCannedResponseUseCase
+}
+
+// NewMocksendCannedResponseUseCase creates a new mock instance.
+func NewMocksendCannedResponseUseCase(ctrl *gomock.Controller) *MocksendCannedResponseUseCase {
+	mock := &MocksendCannedResponseUseCase{ctrl: ctrl}
+	mock.recorder = &MocksendCannedResponseUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksendCannedResponseUseCase) EXPECT() *MocksendCannedResponseUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MocksendCannedResponseUseCase) Handle(ctx context.Context, req sendcannedresponse.Request) (sendcannedresponse.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(sendcannedresponse.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MocksendCannedResponseUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MocksendCannedResponseUseCase)(nil).Handle), ctx, req)
+}
+
 // MocksendMessageUseCase is a mock of sendMessageUseCase interface.
 type MocksendMessageUseCase struct {
 	ctrl     *gomock.Controller
@@ -244,3 +676,168 @@ func (mr *MocksendMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MocksendMessageUseCase)(nil).Handle), ctx, req)
 }
+
+// MocksetProblemCategoryUseCase is a mock of setProblemCategoryUseCase interface.
+type MocksetProblemCategoryUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MocksetProblemCategoryUseCaseMockRecorder
+}
+
+// MocksetProblemCategoryUseCaseMockRecorder is the mock recorder for MocksetProblemCategoryUseCase.
+type MocksetProblemCategoryUseCaseMockRecorder struct {
+	mock *MocksetProblemCategoryUseCase
+}
+
+// NewMocksetProblemCategoryUseCase creates a new mock instance.
+func NewMocksetProblemCategoryUseCase(ctrl *gomock.Controller) *MocksetProblemCategoryUseCase {
+	mock := &MocksetProblemCategoryUseCase{ctrl: ctrl}
+	mock.recorder = &MocksetProblemCategoryUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksetProblemCategoryUseCase) EXPECT() *MocksetProblemCategoryUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MocksetProblemCategoryUseCase) Handle(ctx context.Context, req setproblemcategory.Request) (setproblemcategory.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(setproblemcategory.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MocksetProblemCategoryUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MocksetProblemCategoryUseCase)(nil).Handle), ctx, req)
+}
+
+// MockupdateCannedResponseUseCase is a mock of updateCannedResponseUseCase interface.
+type MockupdateCannedResponseUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockupdateCannedResponseUseCaseMockRecorder
+}
+
+// MockupdateCannedResponseUseCaseMockRecorder is the mock recorder for MockupdateCannedResponseUseCase.
+type MockupdateCannedResponseUseCaseMockRecorder struct {
+	mock *MockupdateCannedResponseUseCase
+}
+
+// NewMockupdateCannedResponseUseCase creates a new mock instance.
+func NewMockupdateCannedResponseUseCase(ctrl *gomock.Controller) *MockupdateCannedResponseUseCase {
+	mock := &MockupdateCannedResponseUseCase{ctrl: ctrl}
+	mock.recorder = &MockupdateCannedResponseUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockupdateCannedResponseUseCase) EXPECT() *MockupdateCannedResponseUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockupdateCannedResponseUseCase) Handle(ctx context.Context, req updatecannedresponse.Request) (updatecannedresponse.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(updatecannedresponse.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockupdateCannedResponseUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockupdateCannedResponseUseCase)(nil).Handle), ctx, req)
+}
+
+// MockuploadAttachmentUseCase is a mock of uploadAttachmentUseCase interface.
+type MockuploadAttachmentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockuploadAttachmentUseCaseMockRecorder
+}
+
+// MockuploadAttachmentUseCaseMockRecorder is the mock recorder for MockuploadAttachmentUseCase.
+type MockuploadAttachmentUseCaseMockRecorder struct {
+	mock *MockuploadAttachmentUseCase
+}
+
+// NewMockuploadAttachmentUseCase creates a new mock instance.
+func NewMockuploadAttachmentUseCase(ctrl *gomock.Controller) *MockuploadAttachmentUseCase {
+	mock := &MockuploadAttachmentUseCase{ctrl: ctrl}
+	mock.recorder = &MockuploadAttachmentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuploadAttachmentUseCase) EXPECT() *MockuploadAttachmentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockuploadAttachmentUseCase) Handle(ctx context.Context, req uploadattachment.Request) (uploadattachment.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(uploadattachment.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockuploadAttachmentUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockuploadAttachmentUseCase)(nil).Handle), ctx, req)
+}
+
+// MockproblemTaxonomy is a mock of problemTaxonomy interface.
+type MockproblemTaxonomy struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemTaxonomyMockRecorder
+}
+
+// MockproblemTaxonomyMockRecorder is the mock recorder for MockproblemTaxonomy.
+type MockproblemTaxonomyMockRecorder struct {
+	mock *MockproblemTaxonomy
+}
+
+// NewMockproblemTaxonomy creates a new mock instance.
+func NewMockproblemTaxonomy(ctrl *gomock.Controller) *MockproblemTaxonomy {
+	mock := &MockproblemTaxonomy{ctrl: ctrl}
+	mock.recorder = &MockproblemTaxonomyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemTaxonomy) EXPECT() *MockproblemTaxonomyMockRecorder {
+	return m.recorder
+}
+
+// Categories mocks base method.
+func (m *MockproblemTaxonomy) Categories() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Categories")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Categories indicates an expected call of Categories.
+func (mr *MockproblemTaxonomyMockRecorder) Categories() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Categories", reflect.TypeOf((*MockproblemTaxonomy)(nil).Categories))
+}
+
+// ResolutionCodes mocks base method.
+func (m *MockproblemTaxonomy) ResolutionCodes() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolutionCodes")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ResolutionCodes indicates an expected call of ResolutionCodes.
+func (mr *MockproblemTaxonomyMockRecorder) ResolutionCodes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolutionCodes", reflect.TypeOf((*MockproblemTaxonomy)(nil).ResolutionCodes))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
@@ -27,13 +28,94 @@ const (
 // Defines values for ErrorCode.
 const (
 	ErrorCodeAssignedProblemNotFound ErrorCode = 5001
+	ErrorCodeEditWindowExpired       ErrorCode = 5003
 	ErrorCodeManagerOverloaded       ErrorCode = 5000
+	ErrorCodeMessageNotEditable      ErrorCode = 5002
+	ErrorCodeShortcutTaken           ErrorCode = 5004
+	ErrorCodeUnknownProblemCategory  ErrorCode = 5005
+	ErrorCodeUnknownResolutionCode   ErrorCode = 5006
 )
 
+// Defines values for ReactionKind.
+const (
+	ReactionKindHeart      ReactionKind = "heart"
+	ReactionKindLaugh      ReactionKind = "laugh"
+	ReactionKindSad        ReactionKind = "sad"
+	ReactionKindSurprised  ReactionKind = "surprised"
+	ReactionKindThumbsDown ReactionKind = "thumbs_down"
+	ReactionKindThumbsUp   ReactionKind = "thumbs_up"
+)
+
+// AddInternalNoteRequest defines model for AddInternalNoteRequest.
+type AddInternalNoteRequest struct {
+	ChatId      types.ChatID `json:"chatId"`
+	MessageBody string       `json:"messageBody"`
+}
+
+// AddInternalNoteResponse defines model for AddInternalNoteResponse.
+type AddInternalNoteResponse struct {
+	Data  *MessageWithoutBody `json:"data,omitempty"`
+	Error *Error              `json:"error,omitempty"`
+}
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType string             `json:"contentType"`
+	FileName    string             `json:"fileName"`
+	Id          types.AttachmentID `json:"id"`
+	Size        int64              `json:"size"`
+
+	// ThumbnailUrl The signed URL of the image thumbnail. It is absent for the other files.
+	ThumbnailUrl *string `json:"thumbnailUrl,omitempty"`
+
+	// Url The signed download URL, it expires in some time. Get the chat history again to renew it.
+	Url string `json:"url"`
+}
+
+// CannedResponse defines model for CannedResponse.
+type CannedResponse struct {
+	AuthorId types.UserID `json:"authorId"`
+
+	// Body The template of the message, it may contain the variables like {{client_name}}.
+	Body string                 `json:"body"`
+	Id   types.CannedResponseID `json:"id"`
+
+	// IsShared The response is available to the whole team.
+	IsShared  bool      `json:"isShared"`
+	Shortcut  string    `json:"shortcut"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CannedResponseList defines model for CannedResponseList.
+type CannedResponseList struct {
+	CannedResponses []CannedResponse `json:"cannedResponses"`
+}
+
+// CannedResponseResponse defines model for CannedResponseResponse.
+type CannedResponseResponse struct {
+	Data  *CannedResponse `json:"data,omitempty"`
+	Error *Error          `json:"error,omitempty"`
+}
+
 // Chat defines model for Chat.
 type Chat struct {
-	ChatId   types.ChatID `json:"chatId"`
-	ClientId types.UserID `json:"clientId"`
+	// Category The problem category. Absent until the manager sets it.
+	Category    *string      `json:"category,omitempty"`
+	ChatId      types.ChatID `json:"chatId"`
+	ClientId    types.UserID `json:"clientId"`
+	LastMessage *LastMessage `json:"lastMessage,omitempty"`
+
+	// ProblemCreatedAt The problem age is counted from it.
+	ProblemCreatedAt time.Time `json:"problemCreatedAt"`
+
+	// ReopenedFromProblemId The resolved problem of the chat continued by the current one. Absent if the problem is a new one.
+	ReopenedFromProblemId *types.ProblemID `json:"reopenedFromProblemId,omitempty"`
+
+	// UnreadCount The client messages shown to the manager (after the AFC check) since they have read the chat.
+	UnreadCount int `json:"unreadCount"`
+
+	// WaitingSince The oldest client message the manager has not answered yet. Absent if there is none.
+	WaitingSince *time.Time `json:"waitingSince,omitempty"`
 }
 
 // ChatId defines model for ChatId.
@@ -47,7 +129,13 @@ type ChatList struct {
 }
 
 // CloseChatRequest defines model for CloseChatRequest.
-type CloseChatRequest = ChatId
+type CloseChatRequest struct {
+	ChatId types.ChatID `json:"chatId"`
+
+	// ResolutionCode One of the resolution codes from /getProblemTaxonomy.
+	ResolutionCode    string  `json:"resolutionCode"`
+	ResolutionSummary *string `json:"resolutionSummary,omitempty"`
+}
 
 // CloseChatResponse defines model for CloseChatResponse.
 type CloseChatResponse struct {
@@ -55,15 +143,72 @@ type CloseChatResponse struct {
 	Error *Error                  `json:"error,omitempty"`
 }
 
+// CreateCannedResponseRequest defines model for CreateCannedResponseRequest.
+type CreateCannedResponseRequest struct {
+	Body string `json:"body"`
+
+	// IsShared Share the response with the whole team.
+	IsShared *bool  `json:"isShared,omitempty"`
+	Shortcut string `json:"shortcut"`
+}
+
+// DeleteCannedResponseRequest defines model for DeleteCannedResponseRequest.
+type DeleteCannedResponseRequest struct {
+	Id types.CannedResponseID `json:"id"`
+}
+
+// DeleteCannedResponseResponse defines model for DeleteCannedResponseResponse.
+type DeleteCannedResponseResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// DeleteMessageRequest defines model for DeleteMessageRequest.
+type DeleteMessageRequest struct {
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// DeleteMessageResponse defines model for DeleteMessageResponse.
+type DeleteMessageResponse struct {
+	Data  *DeletedMessageHeader `json:"data,omitempty"`
+	Error *Error                `json:"error,omitempty"`
+}
+
+// DeletedMessageHeader defines model for DeletedMessageHeader.
+type DeletedMessageHeader struct {
+	DeletedAt time.Time       `json:"deletedAt"`
+	Id        types.MessageID `json:"id"`
+}
+
+// EditMessageRequest defines model for EditMessageRequest.
+type EditMessageRequest struct {
+	MessageBody string          `json:"messageBody"`
+	MessageId   types.MessageID `json:"messageId"`
+}
+
+// EditMessageResponse defines model for EditMessageResponse.
+type EditMessageResponse struct {
+	Data  *EditedMessageHeader `json:"data,omitempty"`
+	Error *Error               `json:"error,omitempty"`
+}
+
+// EditedMessageHeader defines model for EditedMessageHeader.
+type EditedMessageHeader struct {
+	EditedAt time.Time       `json:"editedAt"`
+	Id       types.MessageID `json:"id"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
+	// The upload errors are 413 for the too large file and 415 for the not allowed file type.
 	Code    ErrorCode `json:"code"`
 	Details *string   `json:"details,omitempty"`
 	Message string    `json:"message"`
 }
 
 // ErrorCode contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
+// The upload errors are 413 for the too large file and 415 for the not allowed file type.
 type ErrorCode int
 
 // FreeHandsBtnAvailability defines model for FreeHandsBtnAvailability.
@@ -77,11 +222,29 @@ type FreeHandsResponse struct {
 	Error *Error                  `json:"error,omitempty"`
 }
 
+// GetCannedResponsesRequest defines model for GetCannedResponsesRequest.
+type GetCannedResponsesRequest struct {
+	// ShortcutPrefix Return only the responses which shortcuts start with it.
+	ShortcutPrefix *string `json:"shortcutPrefix,omitempty"`
+}
+
+// GetCannedResponsesResponse defines model for GetCannedResponsesResponse.
+type GetCannedResponsesResponse struct {
+	Data  *CannedResponseList `json:"data,omitempty"`
+	Error *Error              `json:"error,omitempty"`
+}
+
 // GetChatHistoryRequest defines model for GetChatHistoryRequest.
 type GetChatHistoryRequest struct {
-	ChatId   types.ChatID `json:"chatId"`
-	Cursor   *string      `json:"cursor,omitempty"`
-	PageSize *int         `json:"pageSize,omitempty"`
+	// AroundMessageId Open the history at the message (e.g. the search result or the quote), requires pageSize.
+	AroundMessageId *types.MessageID `json:"aroundMessageId,omitempty"`
+	ChatId          types.ChatID     `json:"chatId"`
+	Cursor          *string          `json:"cursor,omitempty"`
+	PageSize        *int             `json:"pageSize,omitempty"`
+
+	// ProblemId The problem of the chat to read (e.g. from the search result), the chat must have been handled by the manager.
+	// The problem currently assigned to the manager if absent.
+	ProblemId *types.ProblemID `json:"problemId,omitempty"`
 }
 
 // GetChatHistoryResponse defines model for GetChatHistoryResponse.
@@ -102,12 +265,72 @@ type GetFreeHandsBtnAvailabilityResponse struct {
 	Error *Error                    `json:"error,omitempty"`
 }
 
+// GetProblemTaxonomyResponse defines model for GetProblemTaxonomyResponse.
+type GetProblemTaxonomyResponse struct {
+	Data  *ProblemTaxonomy `json:"data,omitempty"`
+	Error *Error           `json:"error,omitempty"`
+}
+
+// GetSatisfactionScoresRequest defines model for GetSatisfactionScoresRequest.
+type GetSatisfactionScoresRequest struct {
+	// AllManagers Get the scores of the whole team, only for the team leads.
+	AllManagers *bool `json:"allManagers,omitempty"`
+
+	// From The ratings given since this time, inclusive.
+	From time.Time `json:"from"`
+
+	// To The ratings given before this time, exclusive.
+	To time.Time `json:"to"`
+}
+
+// GetSatisfactionScoresResponse defines model for GetSatisfactionScoresResponse.
+type GetSatisfactionScoresResponse struct {
+	Data  *SatisfactionScoreList `json:"data,omitempty"`
+	Error *Error                 `json:"error,omitempty"`
+}
+
+// LastMessage defines model for LastMessage.
+type LastMessage struct {
+	// AuthorId Absent if the message is a service one.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the message body.
+	Preview string `json:"preview"`
+}
+
+// MarkChatAsReadRequest defines model for MarkChatAsReadRequest.
+type MarkChatAsReadRequest = ChatId
+
+// MarkChatAsReadResponse defines model for MarkChatAsReadResponse.
+type MarkChatAsReadResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
 // Message defines model for Message.
 type Message struct {
-	AuthorId  types.UserID    `json:"authorId"`
-	Body      string          `json:"body"`
-	CreatedAt time.Time       `json:"createdAt"`
+	Attachments *[]Attachment `json:"attachments,omitempty"`
+	AuthorId    types.UserID  `json:"authorId"`
+	Body        string        `json:"body"`
+	CreatedAt   time.Time     `json:"createdAt"`
+
+	// DeletedAt The body and the attachments of the deleted message are empty.
+	DeletedAt *time.Time      `json:"deletedAt,omitempty"`
+	EditedAt  *time.Time      `json:"editedAt,omitempty"`
+	Id        types.MessageID `json:"id"`
+
+	// IsInternalNote The note is visible for managers only.
+	IsInternalNote *bool       `json:"isInternalNote,omitempty"`
+	Reactions      *[]Reaction `json:"reactions,omitempty"`
+	ReplyTo        *Quote      `json:"replyTo,omitempty"`
+}
+
+// MessageReactions defines model for MessageReactions.
+type MessageReactions struct {
 	Id        types.MessageID `json:"id"`
+	Reactions []Reaction      `json:"reactions"`
 }
 
 // MessageWithoutBody defines model for MessageWithoutBody.
@@ -120,13 +343,125 @@ type MessageWithoutBody struct {
 // MessagesPage defines model for MessagesPage.
 type MessagesPage struct {
 	Messages []Message `json:"messages"`
-	Next     string    `json:"next"`
+
+	// Next The cursor to the older messages, empty if there are no ones.
+	Next string `json:"next"`
+
+	// Prev The cursor to the newer messages, empty if the page is the newest one.
+	Prev string `json:"prev"`
+}
+
+// ProblemTaxonomy defines model for ProblemTaxonomy.
+type ProblemTaxonomy struct {
+	Categories      []string `json:"categories"`
+	ResolutionCodes []string `json:"resolutionCodes"`
+}
+
+// Quote defines model for Quote.
+type Quote struct {
+	// AuthorId Absent if the quoted message is a service one or is hidden from you.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the quoted message body. Empty if the message is deleted or is hidden from you.
+	Preview string `json:"preview"`
+}
+
+// Reaction defines model for Reaction.
+type Reaction struct {
+	Count int          `json:"count"`
+	Kind  ReactionKind `json:"kind"`
+
+	// ReactedByMe The current user has put the reaction of this kind.
+	ReactedByMe bool `json:"reactedByMe"`
+}
+
+// ReactionKind defines model for ReactionKind.
+type ReactionKind string
+
+// ReactionRequest defines model for ReactionRequest.
+type ReactionRequest struct {
+	Kind      ReactionKind    `json:"kind"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// ReactionsResponse defines model for ReactionsResponse.
+type ReactionsResponse struct {
+	Data  *MessageReactions `json:"data,omitempty"`
+	Error *Error            `json:"error,omitempty"`
+}
+
+// SatisfactionScore defines model for SatisfactionScore.
+type SatisfactionScore struct {
+	AverageScore float64      `json:"averageScore"`
+	ManagerId    types.UserID `json:"managerId"`
+	RatingsCount int          `json:"ratingsCount"`
+
+	// SatisfiedCount The ratings of 4 and 5, CSAT is satisfiedCount / ratingsCount.
+	SatisfiedCount int `json:"satisfiedCount"`
+}
+
+// SatisfactionScoreList defines model for SatisfactionScoreList.
+type SatisfactionScoreList struct {
+	Scores []SatisfactionScore `json:"scores"`
+}
+
+// SearchMessagesRequest defines model for SearchMessagesRequest.
+type SearchMessagesRequest struct {
+	Cursor   *string `json:"cursor,omitempty"`
+	PageSize *int    `json:"pageSize,omitempty"`
+
+	// Query Words to search in Russian or English, supports "quoted phrases", OR and -exclusions.
+	Query string `json:"query"`
+}
+
+// SearchMessagesResponse defines model for SearchMessagesResponse.
+type SearchMessagesResponse struct {
+	Data  *SearchResultsPage `json:"data,omitempty"`
+	Error *Error             `json:"error,omitempty"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	AuthorId  *types.UserID `json:"authorId,omitempty"`
+	ChatId    types.ChatID  `json:"chatId"`
+	CreatedAt time.Time     `json:"createdAt"`
+
+	// HistoryCursor The /getChatHistory cursor of the page starting with the message.
+	// It is used together with the problemId, so the resolved problems can be opened too.
+	HistoryCursor string          `json:"historyCursor"`
+	MessageId     types.MessageID `json:"messageId"`
+	ProblemId     types.ProblemID `json:"problemId"`
+
+	// Snippet HTML-escaped fragments of the message body, the matched words are wrapped into <mark></mark>.
+	Snippet string `json:"snippet"`
+}
+
+// SearchResultsPage defines model for SearchResultsPage.
+type SearchResultsPage struct {
+	Next    string         `json:"next"`
+	Results []SearchResult `json:"results"`
+}
+
+// SendCannedResponseRequest defines model for SendCannedResponseRequest.
+type SendCannedResponseRequest struct {
+	CannedResponseId types.CannedResponseID `json:"cannedResponseId"`
+	ChatId           types.ChatID           `json:"chatId"`
+
+	// ReplyToMessageId The message of the chat to answer to.
+	ReplyToMessageId *types.MessageID `json:"replyToMessageId,omitempty"`
 }
 
 // SendMessageRequest defines model for SendMessageRequest.
 type SendMessageRequest struct {
-	ChatId      types.ChatID `json:"chatId"`
-	MessageBody string       `json:"messageBody"`
+	AttachmentIds *[]types.AttachmentID `json:"attachmentIds,omitempty"`
+	ChatId        types.ChatID          `json:"chatId"`
+
+	// MessageBody Can be empty if the message has attachments.
+	MessageBody string `json:"messageBody"`
+
+	// ReplyToMessageId The message of the chat to answer to.
+	ReplyToMessageId *types.MessageID `json:"replyToMessageId,omitempty"`
 }
 
 // SendMessageResponse defines model for SendMessageResponse.
@@ -135,19 +470,86 @@ type SendMessageResponse struct {
 	Error *Error              `json:"error,omitempty"`
 }
 
+// SetProblemCategoryRequest defines model for SetProblemCategoryRequest.
+type SetProblemCategoryRequest struct {
+	// Category One of the categories from /getProblemTaxonomy.
+	Category string       `json:"category"`
+	ChatId   types.ChatID `json:"chatId"`
+}
+
+// SetProblemCategoryResponse defines model for SetProblemCategoryResponse.
+type SetProblemCategoryResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// UpdateCannedResponseRequest defines model for UpdateCannedResponseRequest.
+type UpdateCannedResponseRequest struct {
+	Body     string                 `json:"body"`
+	Id       types.CannedResponseID `json:"id"`
+	Shortcut string                 `json:"shortcut"`
+}
+
+// UploadAttachmentRequest defines model for UploadAttachmentRequest.
+type UploadAttachmentRequest struct {
+	ChatId types.ChatID       `json:"chatId"`
+	File   openapi_types.File `json:"file"`
+}
+
+// UploadAttachmentResponse defines model for UploadAttachmentResponse.
+type UploadAttachmentResponse struct {
+	Data  *Attachment `json:"data,omitempty"`
+	Error *Error      `json:"error,omitempty"`
+}
+
 // XRequestIDHeader defines model for XRequestIDHeader.
 type XRequestIDHeader = types.RequestID
 
+// PostAddInternalNoteParams defines parameters for PostAddInternalNote.
+type PostAddInternalNoteParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostAddReactionParams defines parameters for PostAddReaction.
+type PostAddReactionParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostCloseChatParams defines parameters for PostCloseChat.
 type PostCloseChatParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostCreateCannedResponseParams defines parameters for PostCreateCannedResponse.
+type PostCreateCannedResponseParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostDeleteCannedResponseParams defines parameters for PostDeleteCannedResponse.
+type PostDeleteCannedResponseParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostDeleteMessageParams defines parameters for PostDeleteMessage.
+type PostDeleteMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostEditMessageParams defines parameters for PostEditMessage.
+type PostEditMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostFreeHandsParams defines parameters for PostFreeHands.
 type PostFreeHandsParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostGetCannedResponsesParams defines parameters for PostGetCannedResponses.
+type PostGetCannedResponsesParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostGetChatHistoryParams defines parameters for PostGetChatHistory.
 type PostGetChatHistoryParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
@@ -163,29 +565,140 @@ type PostGetFreeHandsBtnAvailabilityParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostGetProblemTaxonomyParams defines parameters for PostGetProblemTaxonomy.
+type PostGetProblemTaxonomyParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostGetSatisfactionScoresParams defines parameters for PostGetSatisfactionScores.
+type PostGetSatisfactionScoresParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostMarkChatAsReadParams defines parameters for PostMarkChatAsRead.
+type PostMarkChatAsReadParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostRemoveReactionParams defines parameters for PostRemoveReaction.
+type PostRemoveReactionParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSearchMessagesParams defines parameters for PostSearchMessages.
+type PostSearchMessagesParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSendCannedResponseParams defines parameters for PostSendCannedResponse.
+type PostSendCannedResponseParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostSendMessageParams defines parameters for PostSendMessage.
 type PostSendMessageParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostSetProblemCategoryParams defines parameters for PostSetProblemCategory.
+type PostSetProblemCategoryParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostUpdateCannedResponseParams defines parameters for PostUpdateCannedResponse.
+type PostUpdateCannedResponseParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostUploadAttachmentParams defines parameters for PostUploadAttachment.
+type PostUploadAttachmentParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostAddInternalNoteJSONRequestBody defines body for PostAddInternalNote for application/json ContentType.
+type PostAddInternalNoteJSONRequestBody = AddInternalNoteRequest
+
+// PostAddReactionJSONRequestBody defines body for PostAddReaction for application/json ContentType.
+type PostAddReactionJSONRequestBody = ReactionRequest
+
 // PostCloseChatJSONRequestBody defines body for PostCloseChat for application/json ContentType.
 type PostCloseChatJSONRequestBody = CloseChatRequest
 
+// PostCreateCannedResponseJSONRequestBody defines body for PostCreateCannedResponse for application/json ContentType.
+type PostCreateCannedResponseJSONRequestBody = CreateCannedResponseRequest
+
+// PostDeleteCannedResponseJSONRequestBody defines body for PostDeleteCannedResponse for application/json ContentType.
+type PostDeleteCannedResponseJSONRequestBody = DeleteCannedResponseRequest
+
+// PostDeleteMessageJSONRequestBody defines body for PostDeleteMessage for application/json ContentType.
+type PostDeleteMessageJSONRequestBody = DeleteMessageRequest
+
+// PostEditMessageJSONRequestBody defines body for PostEditMessage for application/json ContentType.
+type PostEditMessageJSONRequestBody = EditMessageRequest
+
+// PostGetCannedResponsesJSONRequestBody defines body for PostGetCannedResponses for application/json ContentType.
+type PostGetCannedResponsesJSONRequestBody = GetCannedResponsesRequest
+
 // PostGetChatHistoryJSONRequestBody defines body for PostGetChatHistory for application/json ContentType.
 type PostGetChatHistoryJSONRequestBody = GetChatHistoryRequest
 
+// PostGetSatisfactionScoresJSONRequestBody defines body for PostGetSatisfactionScores for application/json ContentType.
+type PostGetSatisfactionScoresJSONRequestBody = GetSatisfactionScoresRequest
+
+// PostMarkChatAsReadJSONRequestBody defines body for PostMarkChatAsRead for application/json ContentType.
+type PostMarkChatAsReadJSONRequestBody = MarkChatAsReadRequest
+
+// PostRemoveReactionJSONRequestBody defines body for PostRemoveReaction for application/json ContentType.
+type PostRemoveReactionJSONRequestBody = ReactionRequest
+
+// PostSearchMessagesJSONRequestBody defines body for PostSearchMessages for application/json ContentType.
+type PostSearchMessagesJSONRequestBody = SearchMessagesRequest
+
+// PostSendCannedResponseJSONRequestBody defines body for PostSendCannedResponse for application/json ContentType.
+type PostSendCannedResponseJSONRequestBody = SendCannedResponseRequest
+
 // PostSendMessageJSONRequestBody defines body for PostSendMessage for application/json ContentType.
 type PostSendMessageJSONRequestBody = SendMessageRequest
 
+// PostSetProblemCategoryJSONRequestBody defines body for PostSetProblemCategory for application/json ContentType.
+type PostSetProblemCategoryJSONRequestBody = SetProblemCategoryRequest
+
+// PostUpdateCannedResponseJSONRequestBody defines body for PostUpdateCannedResponse for application/json ContentType.
+type PostUpdateCannedResponseJSONRequestBody = UpdateCannedResponseRequest
+
+// PostUploadAttachmentMultipartRequestBody defines body for PostUploadAttachment for multipart/form-data ContentType.
+type PostUploadAttachmentMultipartRequestBody = UploadAttachmentRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (POST /addInternalNote)
+	PostAddInternalNote(ctx echo.Context, params PostAddInternalNoteParams) error
+
+	// (POST /addReaction)
+	PostAddReaction(ctx echo.Context, params PostAddReactionParams) error
+
 	// (POST /closeChat)
 	PostCloseChat(ctx echo.Context, params PostCloseChatParams) error
 
+	// (POST /createCannedResponse)
+	PostCreateCannedResponse(ctx echo.Context, params PostCreateCannedResponseParams) error
+
+	// (POST /deleteCannedResponse)
+	PostDeleteCannedResponse(ctx echo.Context, params PostDeleteCannedResponseParams) error
+
+	// (POST /deleteMessage)
+	PostDeleteMessage(ctx echo.Context, params PostDeleteMessageParams) error
+
+	// (POST /editMessage)
+	PostEditMessage(ctx echo.Context, params PostEditMessageParams) error
+
 	// (POST /freeHands)
 	PostFreeHands(ctx echo.Context, params PostFreeHandsParams) error
 
+	// (POST /getCannedResponses)
+	PostGetCannedResponses(ctx echo.Context, params PostGetCannedResponsesParams) error
+
 	// (POST /getChatHistory)
 	PostGetChatHistory(ctx echo.Context, params PostGetChatHistoryParams) error
 
@@ -195,8 +708,35 @@ type ServerInterface interface {
 	// (POST /getFreeHandsBtnAvailability)
 	PostGetFreeHandsBtnAvailability(ctx echo.Context, params PostGetFreeHandsBtnAvailabilityParams) error
 
+	// (POST /getProblemTaxonomy)
+	PostGetProblemTaxonomy(ctx echo.Context, params PostGetProblemTaxonomyParams) error
+
+	// (POST /getSatisfactionScores)
+	PostGetSatisfactionScores(ctx echo.Context, params PostGetSatisfactionScoresParams) error
+
+	// (POST /markChatAsRead)
+	PostMarkChatAsRead(ctx echo.Context, params PostMarkChatAsReadParams) error
+
+	// (POST /removeReaction)
+	PostRemoveReaction(ctx echo.Context, params PostRemoveReactionParams) error
+
+	// (POST /searchMessages)
+	PostSearchMessages(ctx echo.Context, params PostSearchMessagesParams) error
+
+	// (POST /sendCannedResponse)
+	PostSendCannedResponse(ctx echo.Context, params PostSendCannedResponseParams) error
+
 	// (POST /sendMessage)
 	PostSendMessage(ctx echo.Context, params PostSendMessageParams) error
+
+	// (POST /setProblemCategory)
+	PostSetProblemCategory(ctx echo.Context, params PostSetProblemCategoryParams) error
+
+	// (POST /updateCannedResponse)
+	PostUpdateCannedResponse(ctx echo.Context, params PostUpdateCannedResponseParams) error
+
+	// (POST /uploadAttachment)
+	PostUploadAttachment(ctx echo.Context, params PostUploadAttachmentParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -204,14 +744,14 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// PostCloseChat converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCloseChat(ctx echo.Context) error {
+// PostAddInternalNote converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAddInternalNote(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostCloseChatParams
+	var params PostAddInternalNoteParams
 
 	headers := ctx.Request().Header
 	// ------------- Required header parameter "X-Request-ID" -------------
@@ -233,18 +773,18 @@ func (w *ServerInterfaceWrapper) PostCloseChat(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCloseChat(ctx, params)
+	err = w.Handler.PostAddInternalNote(ctx, params)
 	return err
 }
 
-// PostFreeHands converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFreeHands(ctx echo.Context) error {
+// PostAddReaction converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAddReaction(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostFreeHandsParams
+	var params PostAddReactionParams
 
 	headers := ctx.Request().Header
 	// ------------- Required header parameter "X-Request-ID" -------------
@@ -266,18 +806,18 @@ func (w *ServerInterfaceWrapper) PostFreeHands(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostFreeHands(ctx, params)
+	err = w.Handler.PostAddReaction(ctx, params)
 	return err
 }
 
-// PostGetChatHistory converts echo context to params.
-func (w *ServerInterfaceWrapper) PostGetChatHistory(ctx echo.Context) error {
+// PostCloseChat converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCloseChat(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostGetChatHistoryParams
+	var params PostCloseChatParams
 
 	headers := ctx.Request().Header
 	// ------------- Required header parameter "X-Request-ID" -------------
@@ -299,18 +839,18 @@ func (w *ServerInterfaceWrapper) PostGetChatHistory(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostGetChatHistory(ctx, params)
+	err = w.Handler.PostCloseChat(ctx, params)
 	return err
 }
 
-// PostGetChats converts echo context to params.
-func (w *ServerInterfaceWrapper) PostGetChats(ctx echo.Context) error {
+// PostCreateCannedResponse converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCreateCannedResponse(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostGetChatsParams
+	var params PostCreateCannedResponseParams
 
 	headers := ctx.Request().Header
 	// ------------- Required header parameter "X-Request-ID" -------------
@@ -332,18 +872,18 @@ func (w *ServerInterfaceWrapper) PostGetChats(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostGetChats(ctx, params)
+	err = w.Handler.PostCreateCannedResponse(ctx, params)
 	return err
 }
 
-// PostGetFreeHandsBtnAvailability converts echo context to params.
-func (w *ServerInterfaceWrapper) PostGetFreeHandsBtnAvailability(ctx echo.Context) error {
+// PostDeleteCannedResponse converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDeleteCannedResponse(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostGetFreeHandsBtnAvailabilityParams
+	var params PostDeleteCannedResponseParams
 
 	headers := ctx.Request().Header
 	// ------------- Required header parameter "X-Request-ID" -------------
@@ -365,18 +905,18 @@ func (w *ServerInterfaceWrapper) PostGetFreeHandsBtnAvailability(ctx echo.Contex
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostGetFreeHandsBtnAvailability(ctx, params)
+	err = w.Handler.PostDeleteCannedResponse(ctx, params)
 	return err
 }
 
-// PostSendMessage converts echo context to params.
-func (w *ServerInterfaceWrapper) PostSendMessage(ctx echo.Context) error {
+// PostDeleteMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDeleteMessage(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostSendMessageParams
+	var params PostDeleteMessageParams
 
 	headers := ctx.Request().Header
 	// ------------- Required header parameter "X-Request-ID" -------------
@@ -398,7 +938,535 @@ func (w *ServerInterfaceWrapper) PostSendMessage(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostSendMessage(ctx, params)
+	err = w.Handler.PostDeleteMessage(ctx, params)
+	return err
+}
+
+// PostEditMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEditMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostEditMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostEditMessage(ctx, params)
+	return err
+}
+
+// PostFreeHands converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFreeHands(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFreeHandsParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFreeHands(ctx, params)
+	return err
+}
+
+// PostGetCannedResponses converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetCannedResponses(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetCannedResponsesParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetCannedResponses(ctx, params)
+	return err
+}
+
+// PostGetChatHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetChatHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetChatHistoryParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetChatHistory(ctx, params)
+	return err
+}
+
+// PostGetChats converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetChats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetChatsParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetChats(ctx, params)
+	return err
+}
+
+// PostGetFreeHandsBtnAvailability converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetFreeHandsBtnAvailability(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetFreeHandsBtnAvailabilityParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetFreeHandsBtnAvailability(ctx, params)
+	return err
+}
+
+// PostGetProblemTaxonomy converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetProblemTaxonomy(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetProblemTaxonomyParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetProblemTaxonomy(ctx, params)
+	return err
+}
+
+// PostGetSatisfactionScores converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetSatisfactionScores(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetSatisfactionScoresParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetSatisfactionScores(ctx, params)
+	return err
+}
+
+// PostMarkChatAsRead converts echo context to params.
+func (w *ServerInterfaceWrapper) PostMarkChatAsRead(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostMarkChatAsReadParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostMarkChatAsRead(ctx, params)
+	return err
+}
+
+// PostRemoveReaction converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRemoveReaction(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostRemoveReactionParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRemoveReaction(ctx, params)
+	return err
+}
+
+// PostSearchMessages converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSearchMessages(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSearchMessagesParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSearchMessages(ctx, params)
+	return err
+}
+
+// PostSendCannedResponse converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSendCannedResponse(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSendCannedResponseParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSendCannedResponse(ctx, params)
+	return err
+}
+
+// PostSendMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSendMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSendMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSendMessage(ctx, params)
+	return err
+}
+
+// PostSetProblemCategory converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSetProblemCategory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSetProblemCategoryParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSetProblemCategory(ctx, params)
+	return err
+}
+
+// PostUpdateCannedResponse converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUpdateCannedResponse(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUpdateCannedResponseParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUpdateCannedResponse(ctx, params)
+	return err
+}
+
+// PostUploadAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUploadAttachment(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUploadAttachmentParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUploadAttachment(ctx, params)
 	return err
 }
 
@@ -430,41 +1498,111 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/addInternalNote", wrapper.PostAddInternalNote)
+	router.POST(baseURL+"/addReaction", wrapper.PostAddReaction)
 	router.POST(baseURL+"/closeChat", wrapper.PostCloseChat)
+	router.POST(baseURL+"/createCannedResponse", wrapper.PostCreateCannedResponse)
+	router.POST(baseURL+"/deleteCannedResponse", wrapper.PostDeleteCannedResponse)
+	router.POST(baseURL+"/deleteMessage", wrapper.PostDeleteMessage)
+	router.POST(baseURL+"/editMessage", wrapper.PostEditMessage)
 	router.POST(baseURL+"/freeHands", wrapper.PostFreeHands)
+	router.POST(baseURL+"/getCannedResponses", wrapper.PostGetCannedResponses)
 	router.POST(baseURL+"/getChatHistory", wrapper.PostGetChatHistory)
 	router.POST(baseURL+"/getChats", wrapper.PostGetChats)
 	router.POST(baseURL+"/getFreeHandsBtnAvailability", wrapper.PostGetFreeHandsBtnAvailability)
+	router.POST(baseURL+"/getProblemTaxonomy", wrapper.PostGetProblemTaxonomy)
+	router.POST(baseURL+"/getSatisfactionScores", wrapper.PostGetSatisfactionScores)
+	router.POST(baseURL+"/markChatAsRead", wrapper.PostMarkChatAsRead)
+	router.POST(baseURL+"/removeReaction", wrapper.PostRemoveReaction)
+	router.POST(baseURL+"/searchMessages", wrapper.PostSearchMessages)
+	router.POST(baseURL+"/sendCannedResponse", wrapper.PostSendCannedResponse)
 	router.POST(baseURL+"/sendMessage", wrapper.PostSendMessage)
+	router.POST(baseURL+"/setProblemCategory", wrapper.PostSetProblemCategory)
+	router.POST(baseURL+"/updateCannedResponse", wrapper.PostUpdateCannedResponse)
+	router.POST(baseURL+"/uploadAttachment", wrapper.PostUploadAttachment)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RX227bOBD9FWJ2gXYB2ZI3W6AQsA9JekkWvQSbLlog9QMtjSW2EqmSIzduoH9fkLr4",
-	"IjlO0yZInxKLQ3LmzJnhmSuIVF4oiZIMhFdQcM1zJNTu14d/8UuJhk6fnSCPUdtvQkIIaf3TA8lzhBA+",
-	"jBrL0ekz8EDjl1JojCEkXaIHJkox53b3XOmcE4RQliIGD2hZ2P2GtJAJeHA5StSo+Wj/mHHnwvrqSOSF",
-	"0lR7TCmEkAhKy9k4Urn/DQ3xRCg/SjmNDOqFiNAXklBLnvnuWKiqqmodc7Eep9ydx7Ps7RzCiyv4XeMc",
-	"QvjNX0HkNxt8a30aQ+VdQaFVgZoEumOiTKC0S7cK9j+D+g4iXc/IxcrFaeeSmn3CiKCaVh40oYW9yLrv",
-	"3x+XO/PO46odbGN4JQwNR+H+EYS5+2dfmqHqIuRa8yUM3WvqazNl0O5pSPuLg7iKxhRKGuyHE3NyZb1F",
-	"Iw9Qa6X3ofvcGTkXnrf2W3CpGG90yrE1rDyIkbjIzJpPDaCVBzkawxMcWNvCoDX06vunrX/HjTcxmkiL",
-	"goSyvTBSkriQhp28e3fGXODM7jOMy5iZAiMxFxGblUZINIZlKhHRht1jSpFl3BDLS0NshuxjGQQH+Deb",
-	"BEHwxxg8QFnmEF48CYLAexIEk6kHuZAit1//CoKOPja9ievMlyO7Z7Tg2vZoY+PqgnjNJU9Qv12gzhSP",
-	"0fKvWzw0RiQS4zOtZhnmbxS9UKWsKfFCI55wGZsjkocLLjI+E5mgZT9zvF7N1uGeKZUhlz28V7Ybd9wD",
-	"7V4iWYKfCENKL3+hqvUgKrWpY+0RveAJnotvDricX9YsmViWdJyZ9ClzTSfYhmlfXq5D/3VdXObMVtjt",
-	"U2Z+zIvugbidB7vq4Mec2lldt3Dy9arZ3UzRNBveC0pVSUcqXg6om5n9vLd/OqupExMDp/ZbRUmp0g9L",
-	"MnkQaeSE8SFtuBVzwhGJHHu+VR6IW4bQgHTnb7tzp0N7PcS1VNWF2UtS8ybeXDq1BOypJw8kXtJ+Fjkr",
-	"b3Wx9fEcZdwcvNasf1CyNze05Mz55SuUiYX8IGiaZvth4t1MOrizhuX1Rgg/oZFuFux39gk7AmFUakHL",
-	"c7vWVDlyjfqwtBG3v160rP7n/TtoBif3prvVFc1ToqJmnpBz5bIsyKoAOOLyMzsvC8tqZpPBGhnCDs9O",
-	"wYMFalMrqsXERqIKlLwQEMLBOBgfgOfqwDnoR600ddCpmgabsszizKyS4Rkje1s99jwyLKp/KYMxe1zU",
-	"Kod95YZpNCpbYOwUl00Ht2fZxgRnylCnh50rqzl5B+9WJn5vjq6mNW3QdD3RykiUNZ+LIhORu9z/ZGw0",
-	"V2sj9LUc3x5AtnqAHcfdh5p4Dsw/g+Au7m+o7RzYzMwbxSy/mZLMlFGExowbLvrz9gXck1e+kdnTRznT",
-	"yOMlI8Vi5Bn7KihlEr+yJr1mOKPdg/uzMnpHsPYl8ffBmmzot93YvkSqqyOtLYdR21SDD7cYhsX9PVfE",
-	"Duk8kL/2/WWZMLSdOnN90twAKQwxNXcJNHUF2Ba6pwRaOf3AK6Cn+gcAdAY99K4dWQcBPU4x+sz4mq2F",
-	"9aMbTJk76iOwWUmk5E5Md9764GHeO9oMIH/kwNiEbJ7xpMuDWYmePZ3dNu1GRNluboltCT0M9JqWerht",
-	"aECz3nMPGpKcuxsQa8aCOnlrCtGhuq4NL6YWMzt9tJhvHvgMF5ipIkdJrLYCD0qdNTIx9P1MRTxLlaHw",
-	"afB04lvhN63+DwAA///RglVQlBgAAA==",
+	"H4sIAAAAAAAC/+xde2/buJb/KoR2gTsFFCd9XVwE2D/STDvN3nbaTVLMBZpgQEvHFm8lUiWpJJ7A331x",
+	"SEqiJMp2nMe4s/tPWssUH+f8eM7hedC3USKKUnDgWkWHt1FJJS1AgzSf/nUK3ytQ+uTn90BTkPiM8egw",
+	"yuzHOOK0gOgw+teea7l38nMURxK+V0xCGh1qWUEcqSSDguLbMyELqqPDqKpYGsWRXpT4vtKS8XkURzd7",
+	"c7HnHuI/atJMwf92jxWlkNrOWGfRYTRnOqumk0QU+3+A0nTOxH6SUb2nQF6xBPYZ1yA5zfdNt9FyuVzW",
+	"EzNrPUrTE9fkV6HBDYvf0Dz/NIsOv95G/ylhFh1G/7HfEm3fdbF/nFF9kkbL+DYqpShBagam4wKUonN4",
+	"I9KF+UhvPgCf46RfHhwcxFHBeP3geZ8gy6VPzK+dvi6bxmL6b0h0tLxcxsNlqFJwBdFhf1op1YYjq9b0",
+	"0Q73G9OZqLQZdRlHIKWQ6159axoZIh9pTZOsAK6Hs0gE18D1uVnIbX/1cTRjOfxKi/CXLN0OUu2EHh5V",
+	"caTYH9CZF+P676/aieErc5C4AJ1VxZRTln+RueEJqESyUjOBm+w8A6LYnENKvpx+IGJGdAaEFXQOpHlz",
+	"Qk40YYrQqQKuyUxI00roDCRB6qnJgCbLOKrWDJiKa54LakaOCdMEbkomQRHGiRIFEM0KmJBfQJvhkCYk",
+	"Y0oLuSB0ThknWhAJHK4J05NoHa4N4xpexx1YOIraOSPEjynnkI4jm1Y6E/JkS3B8USAfAxZTt/+HJNdQ",
+	"lDnVUHPYbXJD9oIuCBLDUDQDckUlo9McFMnZNyC3t0nOgOvfUQ4vl0FWb7tLumR+DJIwdZZRg4AQWaQb",
+	"2aD7irIc142wQjpcZwI/AC28NU+FyIFyXLTKhNRJpYNyoypTqiE90h3C4LM9hPVmaG1Q5q3DG9cx3B9s",
+	"iN0PTIVkYqeNecQ0FGqdyO32HS2bZVAp6WKwiv4ww+ndT3kMp3NXxYEq9QEUcEI1zIUc2X2lFNMcClK3",
+	"mpAjK0orrlluNyTldA6SKNAqLM7iyO7DXRM6OVXaKfF1dP/gNV3GkSPLsYR2q4wTD1USUyQRFdeQkpkU",
+	"hSPUJtsLcSlK4JC+k6L4bPs8GRcLIr+CtBnbSU2jglBUMl5BSqYL+7SSEpkpODSMZfaF+n0ULwQVFbaJ",
+	"4i24V8/4ERhYcQk0PUa6hslhcVfrDEVUJq55LSVr4P5EZxqsYXD07pgkGSTfnhHFeIKWBCxIRq+QsjRt",
+	"SDkJGizXlGnG52f4Zng+Ik9B6d60OpPJqCJcaEK5ugYJKVmA7vFGGjTxPkM2l9DNbgwAuUvUsBXtZMlQ",
+	"NDfPt9Cn+O7Dn2J6C7cTrNcwomAyqu+gVlAKr1UmpkszbC4U4DsPd4IyW75CkB2LNIC7T7yxndqmJBEp",
+	"KCuK9ueg3SY9pzeCi2IxCYuh+u2zqiio7B/YnpsD22ro9SY7gq+WSOtUbO/tLbSoQX5fsze86Y463eqU",
+	"usqUM89r3pjByTXT2Z2tOH9GL+52au4bZciBnyGHjamyqyb00C4dX9qj48wO60yIUVI6jbCtEHXdPzop",
+	"22leDpd2H6vYdpW6vpxbbWtq9/oZzsi22vyks/1x8ak4Y6bTrgvZ8zZlekPcvdlSvv1wsI27vsI+le4D",
+	"YezoARAc6mYwH0jZXxG/zbIMY2rC9V2jKWxETmNnLHFTaMpyFXR5FO0hcCMPs/HBpdDOL2x5OdeUIu/P",
+	"zz8TgwBnd1GeElVCwmYsIdNKMQ5KkVzMWdJp9xPaAXhMJUWlNJkCuagODl7CfxE0tp5NLjieKqrSOCPN",
+	"i4pQCeTV85eNs1MLQXIq52AcnmboV89fN1+bw0aei2tIbQOkwOSCIx94VUSHX1+jCHh9cPAc/7zAPy/x",
+	"zyv88xr//P3SSAhWYPNXnhFYn40QM9jZ3hWVnBbIwa8t4T7ao8+nK5C4DuMpar48Utbn6gzUX4V+Jyre",
+	"aeKw+avQuGnQD+Z/i89+YzwV12+Nk7bz6pmzfc7pN+D+F1/4Ny6uuRv1uPaRDFuc9gzaZRy9kwDvKU/V",
+	"G82PrGuO5UwvAr7Y2nHnIa8x8HrQa9t2xngC0+UX0F1zSY3qkdqW/Cxhxm6GO+IUdCU5ETxfdIxeRa4z",
+	"lmSkfl8RpanU1ha2rpKehdvfpyPzfDgXnTksbkm9jOr31v0/SjkqEdYffUXaO8aVYJ3cTSBB+/5w8hNM",
+	"5hPzRAGVSYa0rXJN3Db/XgkNz2LiIKVISedwxv7Y0qnzaPog3kEvQhwllVSW6wPdUdPR2U1WCD53RlP9",
+	"KeQtKld78kIOPBM1oqnjtTm8Dxj+LG7bG6VhvFdTAE4yytO89f45l5NTIvV4zieYLwh1orfvL2MzF0+z",
+	"WmKHPILj7p7+LnyAuK/67JzBW0qE+0qn2oG13QzGlNT9JjXW65aT7Hml7je3XmdbTumMaqZmNDFusETI",
+	"FcqQ5rkzbdRwi9exYWX6qHd56/KJrZJsjDigBcmBpirsC0JZMBIRoOiTVmTOroA3Xm2mTIw6JowneaXY",
+	"FWwejtBik5GmMBOyMxTc3HGo3nY2azTDX47z4j4IGfS37f760A0sjQfgu0TsRmBqxW4iME7kbR+FeawY",
+	"WuKHwDbDz656C1AlwxWD6zC8pzBnnDM+72UiEPSark/i8J0P9Tg++RDTH6n8hnL9SJ0CTUcFy48UZ+kv",
+	"6dFPK96+2yywEkzjGmzaJilq89CQl9k1CBC1GS+D7dHxSgZgKNKFOcQjBL1p1ah0rzfopBIIFKVebC7g",
+	"t3ArKT+tLjxxLrQRZldMMcxVQdXmTEpllF1Ys0mwAnlzup+6N0JUl1Dmi3Oxrov/qXAZfVi78Mhli7JT",
+	"f3IPExV5RPH2kKQM+e3a/j0S+fvqB0lG20Kn/RB+VS8pq6d3/BPNWHBgc9R4iTL9/cfhZixVwxyx67Mm",
+	"JkrIJm8jthKszX9AocYFEXwkgRMV7CbDcLgeHcZ4SFBg1Q2Vrs2v1ZrerNHNIW6ph4Tun0EOR7KxWI/a",
+	"gwUO5ZrvhrzTy4PEt2YGw25xDVY2bm3WGkdUOmrdEiHxWcbSFLh1cSxEtVs271/FgO2xwtix5K2/BTwu",
+	"1XbFKH/ubv4imhoNM9wKdVpX60cLudG+MZ5uqsb+iW1rRQjpm8VHGJUSJjGuUi4lq6y0c1jbniwJmSI4",
+	"fMhw6S3fzDJ2a+pOwKfCP91iXOTFpsCr36syiuv/Y+55FEcZUIk95bSaZ1EcqUqWkimbZkvT6LLPkGAM",
+	"xh/33PT/BYcaPv7Zjup/8d7NwH/2wc3Gf3bmzazznKadtY8ed7bh8A8YkTbL9AmiHsRN2fS2zWFq4BEJ",
+	"xbBAogu8/ra1l0RlQ3GOsrwqpnbLOqt/18w+579q0kmHskYZcjBYlXLqekEB8cqc017H5Pjs6BylZvd9",
+	"sk/8IUM5pX24NITrzTbusmEw08sQL8Ppj9YhubGtN+h1rYHhBjBTMoGL2voc93c8QvTlewWhnPffhEwV",
+	"WocupsI4Oa2UYpSj4nvL5zlTWUxUVSLgFLmInBItM0kVqIsoJp9ODd/3nL9TcNWLX754/fdOSs2LdcrT",
+	"TjZEsXu5O01fpyZqtHUww+/kAZJZ/zIHQhelPW6gO5QU+/NOUKo+mgjv9GGi4GixNUmhTmNMLritbKuU",
+	"CdDNwZS0Nc2a4GJMlCA6UBmgSELRS09sbQHRQth43g/lr/VCqDtVmaA4K0sIqIj35x8/7IFKaGkqQei8",
+	"47zzTXEbxy2oTjJIybURTFQCuZa0xJcZ18KmAyUFld/M/8B+3m8frLfMWyLGHXsk8WoC6uX0cR3O3x4K",
+	"loF8qv0AoSRzfGtz/eONtVb1uJN5PYadK083zHPuloSd7Gzh4C4mUDi/64r8lnMP/r2cB1sLQ7TYsVyV",
+	"cNQjHgKlBtowDfe+6rKt1U67e2bX6r4LenNi5/b8oLdLseSIfa/Afa9lBcu4n5LcBcuxVV0Q8lXgcb2l",
+	"S9/2ehkqUvm/gc/11yN0MPrnX41wBrqXgPlwO2e87NWrl2p9oXeplAo7VMdpPlzkowdJv5iy68euetpZ",
+	"DflAlVMsVNN+aciLicytQP2BIvn2oonOjKaMU5P7vAbntfozHQzBHiLLfWRMN7x+tx2AGICkkkwvzvA7",
+	"B3SgEuRRpbP207uaCv/923nkrsQxjl7zbUuUTOvS7i3GZya+rJlGQkZvKP9GzqzDgCDTiMsKI0efT6I4",
+	"ugKprOS5eo4rESVwWrLoMHo5OZi8jGLDTDPBfdq9v8YQTqjAMeMoTevs/uZWCGSQuTZjXRAeOUGxI4Rm",
+	"9Fko3bs3J4o7VyGNyOC2yf7gqqTlpQUPqCYy7G41wf/SssxZYqaw/29lgwPtLUkrQRG+p6inC52JIf1L",
+	"JF4cHDzeLOpbHpbLuMco/J64o9bEQRPZ3ImLBFn8eRCS4B07yDdSGr9Ak9PrTn0T8rnSxsPANNHXLDEv",
+	"8DmYKvSM8fkoIJoZ7iwY+pGFJ0bB0I8f4P9RoiuaN0xUPS42kEjqmuhxQKD1Zm4oongxB62vGfibshgw",
+	"PaTkJ8d5ck1V4xR6FuZyU4i9uzweFNQ/MZOHterBTU5QoyFvVZUkoFTL10D9+TiLbbW69fCBVAJZbU+b",
+	"bf24yxhWpuLchtRnTQ7x5IJ/MsU3TT6x8SnVBWBaOFnkd9HQzvoHAzAJrWF3EbOi4v+pwROuQg8g6LjH",
+	"5L7GSANV7eMwsuXRhsd4H0oPQROyBiJp+/oAIkGAhGrudxcgqy4/eGKArLysYAOYuKSNHkz81PRV+KB8",
+	"cVdroslQ7eWITIXOiGLpaoR8bMprdxoaPTfen4KJvpsmAAbXZAACaEvdxyGAZbONgKhBgIx3V+1hJ+Ta",
+	"1NWGOeoV1O8uPwN3IzwxN0P3DqzgpU3Nblg5q+ut1liFtGMXnvytMHWECyvMaW53NN7yVQcHwzxtyrse",
+	"iqOPRNRhdfTdjLL5oJJ4nL6/QLtPeopUNXn6rTGmfGuMCJmaS76mi6b0OUz5YW3z7m6q8XrxJ95bKwrC",
+	"Qyex5hbNPhs7uGgD9qsx4V/6Os5Tr7ed5uewgv1P4GWggHdcVOIdsEr3WbfBRsbXcJMiA5WVjKIEvkY0",
+	"/lL3v9uScVB7HDIizcL71Ft5q0X4sIoXKRLqtUWyXpi7K4jp6iIi00prwUdpOjrqzpN5bYF1gPJvDDG6",
+	"JJvldO7zIVRCsBLPvStcmaeWBvcBJoLP2LxCleRXHo+ypz+ZnefKWEV5gBn+pQi6ifa1jBhWH6/nhbv4",
+	"08sRDadm0flcwpxqaxp4ly+ohi8lSCbSyQU/qr8jc9CmWoVJY43YRMuY0PYob3K+5mtKz8fcPMGK651W",
+	"W+PF+k+vvVYUqwfAZ1t0hXDRKaUdBxuW3Hp3gNTqUEICDFGmBJlRSYz7l6bhvd0t291dJocrpp+YuyM1",
+	"znc7c0goxBWsD/vgVVXNgcMrR5ltEfwJsv60O5H/j+48QXRHddK6x5n/rsrzPQ03us5PF1cg/R6Vz3k1",
+	"uFna3QAUZnw3t3x3GR+uGnhi9o8k4gcwYO6ra/nT3NrkSkq9gldP1qtBWuo4KE6Bpw4F/VhQbSyslgP2",
+	"OkL0EzGNagHbVAqB22abd36Ko/2dDSoHP7MRk9tbhzn3xPR/e+uK6n5PqcZf4xixMoYJubuMxLHk4SdH",
+	"4zBxb4UX0Vze5UNtrTsYBzAOwlrBeDklk1E+7rz/N5CUu9uc68f91CB7cBUPtZ/VuLC3i+IqaKJRRynB",
+	"n3Ushyasc6LrahGbHZJ6v//h/VJEfZAZQ4Qe3qe5s8AYSz19cnyMpofezb6sAimfq3w3yGbnu7aO6cZr",
+	"YOJ7YtYYof3w8foUA7/zzVIMQgmru4ueVem1P0yKgftpJg9A3ezNcfDYPE/DX3ujsHAJ+SbJrL5540aH",
+	"lMmK04o1Qpo7iu2lBBoS5yVhWhFHstjdhJTkTQ4MU4TNuZCQjkOst75HhldR5ZqVVOp9zLTdq1NeN0VY",
+	"OMP4idE1mtEbOgs1rdyF1TW2vGRcQ2Y/DffrJRJRgbyqmdDPVLiCXJSmV9vK/RSezcg93N/PRULzTCh9",
+	"+I+Dfzzfxxzby+X/DgANjY/C2XMAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
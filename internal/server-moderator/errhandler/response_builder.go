@@ -0,0 +1,20 @@
+package errhandler
+
+import (
+	moderatorv1 "github.com/zestagio/chat-service/internal/server-moderator/v1"
+	"github.com/zestagio/chat-service/pkg/pointer"
+)
+
+type Response struct {
+	Error moderatorv1.Error `json:"error"`
+}
+
+func ResponseBuilder(code int, msg string, details string) any {
+	return Response{
+		Error: moderatorv1.Error{
+			Code:    moderatorv1.ErrorCode(code),
+			Message: msg,
+			Details: pointer.PtrWithZeroAsNil(details),
+		},
+	}
+}
@@ -0,0 +1,73 @@
+// Code generated by options-gen. DO NOT EDIT.
+package moderatorv1
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	approveMessage approveMessageUseCase,
+	deleteMessage deleteMessageUseCase,
+	getQueue getQueueUseCase,
+	rejectMessage rejectMessageUseCase,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.approveMessage = approveMessage
+
+	o.deleteMessage = deleteMessage
+
+	o.getQueue = getQueue
+
+	o.rejectMessage = rejectMessage
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("approveMessage", _validate_Options_approveMessage(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("deleteMessage", _validate_Options_deleteMessage(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("getQueue", _validate_Options_getQueue(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("rejectMessage", _validate_Options_rejectMessage(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_approveMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.approveMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `approveMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_deleteMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.deleteMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `deleteMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_getQueue(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getQueue, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `getQueue` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_rejectMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.rejectMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `rejectMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package moderatorv1
+
+import (
+	"context"
+	"fmt"
+
+	approvemessage "github.com/zestagio/chat-service/internal/usecases/moderator/approve-message"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/moderator/delete-message"
+	getqueue "github.com/zestagio/chat-service/internal/usecases/moderator/get-queue"
+	rejectmessage "github.com/zestagio/chat-service/internal/usecases/moderator/reject-message"
+)
+
+var _ ServerInterface = (*Handlers)(nil)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/handlers_mocks.gen.go -package=moderatorv1mocks
+
+type approveMessageUseCase interface {
+	Handle(ctx context.Context, req approvemessage.Request) (approvemessage.Response, error)
+}
+
+type deleteMessageUseCase interface {
+	Handle(ctx context.Context, req deletemessage.Request) (deletemessage.Response, error)
+}
+
+type getQueueUseCase interface {
+	Handle(ctx context.Context, req getqueue.Request) (getqueue.Response, error)
+}
+
+type rejectMessageUseCase interface {
+	Handle(ctx context.Context, req rejectmessage.Request) (rejectmessage.Response, error)
+}
+
+//go:generate options-gen -out-filename=handlers.gen.go -from-struct=Options
+type Options struct {
+	approveMessage approveMessageUseCase `option:"mandatory" validate:"required"`
+	deleteMessage  deleteMessageUseCase  `option:"mandatory" validate:"required"`
+	getQueue       getQueueUseCase       `option:"mandatory" validate:"required"`
+	rejectMessage  rejectMessageUseCase  `option:"mandatory" validate:"required"`
+}
+
+type Handlers struct {
+	Options
+}
+
+func NewHandlers(opts Options) (Handlers, error) {
+	if err := opts.Validate(); err != nil {
+		return Handlers{}, fmt.Errorf("validate options: %v", err)
+	}
+	return Handlers{Options: opts}, nil
+}
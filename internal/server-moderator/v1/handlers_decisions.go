@@ -0,0 +1,70 @@
+package moderatorv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	approvemessage "github.com/zestagio/chat-service/internal/usecases/moderator/approve-message"
+	rejectmessage "github.com/zestagio/chat-service/internal/usecases/moderator/reject-message"
+)
+
+func (h Handlers) PostApproveMessage(eCtx echo.Context, params PostApproveMessageParams) error {
+	ctx := eCtx.Request().Context()
+	moderatorID := middlewares.MustUserID(eCtx)
+
+	var req ApproveMessageRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	if _, err := h.approveMessage.Handle(ctx, approvemessage.Request{
+		ID:          params.XRequestID,
+		ModeratorID: moderatorID,
+		CaseID:      req.CaseId,
+	}); err != nil {
+		if errors.Is(err, approvemessage.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+		if errors.Is(err, approvemessage.ErrCaseNotFound) {
+			return internalerrors.NewServerError(int(ErrorCodeModerationCaseNotFound),
+				"pending moderation case was not found", err)
+		}
+		return fmt.Errorf("handle `approve message` use case: %v", err)
+	}
+
+	var empty map[string]any
+	return eCtx.JSON(http.StatusOK, ApproveMessageResponse{Data: &empty})
+}
+
+func (h Handlers) PostRejectMessage(eCtx echo.Context, params PostRejectMessageParams) error {
+	ctx := eCtx.Request().Context()
+	moderatorID := middlewares.MustUserID(eCtx)
+
+	var req RejectMessageRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	if _, err := h.rejectMessage.Handle(ctx, rejectmessage.Request{
+		ID:          params.XRequestID,
+		ModeratorID: moderatorID,
+		CaseID:      req.CaseId,
+	}); err != nil {
+		if errors.Is(err, rejectmessage.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+		if errors.Is(err, rejectmessage.ErrCaseNotFound) {
+			return internalerrors.NewServerError(int(ErrorCodeModerationCaseNotFound),
+				"pending moderation case was not found", err)
+		}
+		return fmt.Errorf("handle `reject message` use case: %v", err)
+	}
+
+	var empty map[string]any
+	return eCtx.JSON(http.StatusOK, RejectMessageResponse{Data: &empty})
+}
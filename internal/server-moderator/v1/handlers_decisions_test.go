@@ -0,0 +1,147 @@
+package moderatorv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/mock/gomock"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	moderatorv1 "github.com/zestagio/chat-service/internal/server-moderator/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	approvemessage "github.com/zestagio/chat-service/internal/usecases/moderator/approve-message"
+	rejectmessage "github.com/zestagio/chat-service/internal/usecases/moderator/reject-message"
+)
+
+func (s *HandlersSuite) TestApproveMessage_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/approveMessage", `{"caseId": "64bce534-`)
+
+	// Action.
+	err := s.handlers.PostApproveMessage(eCtx, moderatorv1.PostApproveMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestApproveMessage_Usecase_CaseNotFoundError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	caseID := types.NewModerationCaseID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/approveMessage", fmt.Sprintf(`{"caseId": %q}`, caseID))
+
+	s.approveMessageUseCase.EXPECT().Handle(gomock.Any(), approvemessage.Request{
+		ID:          reqID,
+		ModeratorID: s.moderatorID,
+		CaseID:      caseID,
+	}).Return(approvemessage.Response{}, approvemessage.ErrCaseNotFound)
+
+	// Action.
+	err := s.handlers.PostApproveMessage(eCtx, moderatorv1.PostApproveMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.EqualValues(moderatorv1.ErrorCodeModerationCaseNotFound, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestApproveMessage_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	caseID := types.NewModerationCaseID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/approveMessage", fmt.Sprintf(`{"caseId": %q}`, caseID))
+
+	s.approveMessageUseCase.EXPECT().Handle(gomock.Any(), approvemessage.Request{
+		ID:          reqID,
+		ModeratorID: s.moderatorID,
+		CaseID:      caseID,
+	}).Return(approvemessage.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostApproveMessage(eCtx, moderatorv1.PostApproveMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestApproveMessage_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	caseID := types.NewModerationCaseID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/approveMessage", fmt.Sprintf(`{"caseId": %q}`, caseID))
+
+	s.approveMessageUseCase.EXPECT().Handle(gomock.Any(), approvemessage.Request{
+		ID:          reqID,
+		ModeratorID: s.moderatorID,
+		CaseID:      caseID,
+	}).Return(approvemessage.Response{}, nil)
+
+	// Action.
+	err := s.handlers.PostApproveMessage(eCtx, moderatorv1.PostApproveMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data": null}`, resp.Body.String())
+}
+
+func (s *HandlersSuite) TestRejectMessage_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/rejectMessage", `{"caseId": "64bce534-`)
+
+	// Action.
+	err := s.handlers.PostRejectMessage(eCtx, moderatorv1.PostRejectMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestRejectMessage_Usecase_CaseNotFoundError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	caseID := types.NewModerationCaseID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/rejectMessage", fmt.Sprintf(`{"caseId": %q}`, caseID))
+
+	s.rejectMessageUseCase.EXPECT().Handle(gomock.Any(), rejectmessage.Request{
+		ID:          reqID,
+		ModeratorID: s.moderatorID,
+		CaseID:      caseID,
+	}).Return(rejectmessage.Response{}, rejectmessage.ErrCaseNotFound)
+
+	// Action.
+	err := s.handlers.PostRejectMessage(eCtx, moderatorv1.PostRejectMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.EqualValues(moderatorv1.ErrorCodeModerationCaseNotFound, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestRejectMessage_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	caseID := types.NewModerationCaseID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/rejectMessage", fmt.Sprintf(`{"caseId": %q}`, caseID))
+
+	s.rejectMessageUseCase.EXPECT().Handle(gomock.Any(), rejectmessage.Request{
+		ID:          reqID,
+		ModeratorID: s.moderatorID,
+		CaseID:      caseID,
+	}).Return(rejectmessage.Response{}, nil)
+
+	// Action.
+	err := s.handlers.PostRejectMessage(eCtx, moderatorv1.PostRejectMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data": null}`, resp.Body.String())
+}
@@ -0,0 +1,91 @@
+package moderatorv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	moderatorv1 "github.com/zestagio/chat-service/internal/server-moderator/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/moderator/delete-message"
+)
+
+func (s *HandlersSuite) TestDeleteMessage_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/deleteMessage", `{"messageId": "`)
+
+	// Action.
+	err := s.handlers.PostDeleteMessage(eCtx, moderatorv1.PostDeleteMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestDeleteMessage_Usecase_Errors() {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		expCode int
+	}{
+		{name: "invalid request", err: deletemessage.ErrInvalidRequest, expCode: http.StatusBadRequest},
+		{name: "not found", err: deletemessage.ErrMessageNotFound, expCode: http.StatusNotFound},
+		{name: "unknown", err: errors.New("something went wrong"), expCode: http.StatusInternalServerError},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			msgID := types.NewMessageID()
+
+			resp, eCtx := s.newEchoCtx(reqID, "/v1/deleteMessage", fmt.Sprintf(`{"messageId": %q}`, msgID))
+			s.deleteMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), deletemessage.Request{
+				ID:          reqID,
+				ModeratorID: s.moderatorID,
+				MessageID:   msgID,
+			}).Return(deletemessage.Response{}, tt.err)
+
+			// Action.
+			err := s.handlers.PostDeleteMessage(eCtx, moderatorv1.PostDeleteMessageParams{XRequestID: reqID})
+
+			// Assert.
+			s.Require().Error(err)
+			s.Equal(tt.expCode, internalerrors.GetServerErrorCode(err))
+			s.Empty(resp.Body)
+		})
+	}
+}
+
+func (s *HandlersSuite) TestDeleteMessage_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	msgID := types.NewMessageID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/deleteMessage", fmt.Sprintf(`{"messageId": %q}`, msgID))
+	s.deleteMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), deletemessage.Request{
+		ID:          reqID,
+		ModeratorID: s.moderatorID,
+		MessageID:   msgID,
+	}).Return(deletemessage.Response{
+		MessageID: msgID,
+		DeletedAt: time.Unix(1, 1).UTC(),
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostDeleteMessage(eCtx, moderatorv1.PostDeleteMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "deletedAt": "1970-01-01T00:00:01.000000001Z",
+        "id": "%s"
+    }
+}`, msgID), resp.Body.String())
+}
@@ -0,0 +1,79 @@
+package moderatorv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	"github.com/zestagio/chat-service/internal/middlewares"
+	getqueue "github.com/zestagio/chat-service/internal/usecases/moderator/get-queue"
+	"github.com/zestagio/chat-service/pkg/pointer"
+)
+
+func (h Handlers) PostGetQueue(eCtx echo.Context, params PostGetQueueParams) error {
+	ctx := eCtx.Request().Context()
+	moderatorID := middlewares.MustUserID(eCtx)
+
+	var req GetQueueRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request: %w", err)
+	}
+
+	resp, err := h.getQueue.Handle(ctx, getqueue.Request{
+		ID:          params.XRequestID,
+		ModeratorID: moderatorID,
+		PageSize:    pointer.Indirect(req.PageSize),
+	})
+	if err != nil {
+		if errors.Is(err, getqueue.ErrInvalidRequest) {
+			return internalerrors.NewServerError(http.StatusBadRequest, "invalid request", err)
+		}
+		return fmt.Errorf("handle `get queue` use case: %v", err)
+	}
+
+	cases := make([]Case, 0, len(resp.Cases))
+	for _, c := range resp.Cases {
+		m := Message{
+			Id:        c.Message.ID,
+			ChatId:    c.Message.ChatID,
+			AuthorId:  c.Message.AuthorID,
+			Body:      c.Message.Body,
+			CreatedAt: c.Message.CreatedAt,
+		}
+		if len(c.Message.Attachments) > 0 {
+			aa := make([]Attachment, 0, len(c.Message.Attachments))
+			for _, a := range c.Message.Attachments {
+				aa = append(aa, Attachment{
+					ContentType:  a.ContentType,
+					FileName:     a.FileName,
+					Id:           a.ID,
+					Size:         a.Size,
+					ThumbnailUrl: pointer.PtrWithZeroAsNil(a.ThumbnailURL),
+					Url:          a.URL,
+				})
+			}
+			m.Attachments = &aa
+		}
+
+		ctxMsgs := make([]ContextMessage, 0, len(c.Context))
+		for _, cm := range c.Context {
+			ctxMsgs = append(ctxMsgs, ContextMessage{
+				AuthorId:  pointer.PtrWithZeroAsNil(cm.AuthorID),
+				Body:      cm.Body,
+				CreatedAt: cm.CreatedAt,
+				Id:        cm.ID,
+			})
+		}
+
+		cases = append(cases, Case{
+			CaseId:    c.ID,
+			Context:   ctxMsgs,
+			CreatedAt: c.CreatedAt,
+			Message:   m,
+		})
+	}
+	return eCtx.JSON(http.StatusOK, GetQueueResponse{Data: &CaseList{Cases: cases}})
+}
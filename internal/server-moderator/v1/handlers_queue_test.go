@@ -0,0 +1,146 @@
+package moderatorv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	internalerrors "github.com/zestagio/chat-service/internal/errors"
+	moderatorv1 "github.com/zestagio/chat-service/internal/server-moderator/v1"
+	"github.com/zestagio/chat-service/internal/types"
+	getqueue "github.com/zestagio/chat-service/internal/usecases/moderator/get-queue"
+)
+
+func (s *HandlersSuite) TestGetQueue_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getQueue", `{"pageSize": "`)
+
+	// Action.
+	err := s.handlers.PostGetQueue(eCtx, moderatorv1.PostGetQueueParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestGetQueue_Usecase_InvalidRequest() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getQueue", `{"pageSize": 1000}`)
+
+	s.getQueueUseCase.EXPECT().Handle(gomock.Any(), getqueue.Request{
+		ID:          reqID,
+		ModeratorID: s.moderatorID,
+		PageSize:    1000,
+	}).Return(getqueue.Response{}, getqueue.ErrInvalidRequest)
+
+	// Action.
+	err := s.handlers.PostGetQueue(eCtx, moderatorv1.PostGetQueueParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestGetQueue_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getQueue", `{}`)
+
+	s.getQueueUseCase.EXPECT().Handle(gomock.Any(), getqueue.Request{
+		ID:          reqID,
+		ModeratorID: s.moderatorID,
+	}).Return(getqueue.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostGetQueue(eCtx, moderatorv1.PostGetQueueParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestGetQueue_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getQueue", `{"pageSize": 10}`)
+
+	c := getqueue.Case{
+		ID:        types.NewModerationCaseID(),
+		CreatedAt: time.Unix(1, 1).UTC(),
+		Message: getqueue.Message{
+			ID:        types.NewMessageID(),
+			ChatID:    types.NewChatID(),
+			AuthorID:  types.NewUserID(),
+			Body:      "hello!",
+			CreatedAt: time.Unix(0, 1).UTC(),
+			Attachments: []getqueue.Attachment{{
+				ID:          types.NewAttachmentID(),
+				FileName:    "card.png",
+				ContentType: "image/png",
+				Size:        1024,
+				URL:         "/attachments/file",
+			}},
+		},
+		Context: []getqueue.ContextMessage{
+			{ID: types.NewMessageID(), Body: "Manager will answer you soon", CreatedAt: time.Unix(0, 0).UTC()},
+		},
+	}
+	s.getQueueUseCase.EXPECT().Handle(gomock.Any(), getqueue.Request{
+		ID:          reqID,
+		ModeratorID: s.moderatorID,
+		PageSize:    10,
+	}).Return(getqueue.Response{Cases: []getqueue.Case{c}}, nil)
+
+	// Action.
+	err := s.handlers.PostGetQueue(eCtx, moderatorv1.PostGetQueueParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "cases":
+        [
+            {
+                "caseId": %q,
+                "createdAt": "1970-01-01T00:00:01.000000001Z",
+                "message":
+                {
+                    "id": %q,
+                    "chatId": %q,
+                    "authorId": %q,
+                    "body": "hello!",
+                    "createdAt": "1970-01-01T00:00:00.000000001Z",
+                    "attachments":
+                    [
+                        {
+                            "id": %q,
+                            "fileName": "card.png",
+                            "contentType": "image/png",
+                            "size": 1024,
+                            "url": "/attachments/file"
+                        }
+                    ]
+                },
+                "context":
+                [
+                    {
+                        "id": %q,
+                        "body": "Manager will answer you soon",
+                        "createdAt": "1970-01-01T00:00:00Z"
+                    }
+                ]
+            }
+        ]
+    }
+}`, c.ID, c.Message.ID, c.Message.ChatID, c.Message.AuthorID, c.Message.Attachments[0].ID, c.Context[0].ID), resp.Body.String())
+}
@@ -0,0 +1,80 @@
+package moderatorv1_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/zestagio/chat-service/internal/middlewares"
+	moderatorv1 "github.com/zestagio/chat-service/internal/server-moderator/v1"
+	moderatorv1mocks "github.com/zestagio/chat-service/internal/server-moderator/v1/mocks"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type HandlersSuite struct {
+	testingh.ContextSuite
+
+	ctrl                  *gomock.Controller
+	approveMessageUseCase *moderatorv1mocks.MockapproveMessageUseCase
+	deleteMessageUseCase  *moderatorv1mocks.MockdeleteMessageUseCase
+	getQueueUseCase       *moderatorv1mocks.MockgetQueueUseCase
+	rejectMessageUseCase  *moderatorv1mocks.MockrejectMessageUseCase
+	handlers              moderatorv1.Handlers
+
+	moderatorID types.UserID
+}
+
+func TestHandlersSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(HandlersSuite))
+}
+
+func (s *HandlersSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.approveMessageUseCase = moderatorv1mocks.NewMockapproveMessageUseCase(s.ctrl)
+	s.deleteMessageUseCase = moderatorv1mocks.NewMockdeleteMessageUseCase(s.ctrl)
+	s.getQueueUseCase = moderatorv1mocks.NewMockgetQueueUseCase(s.ctrl)
+	s.rejectMessageUseCase = moderatorv1mocks.NewMockrejectMessageUseCase(s.ctrl)
+	{
+		var err error
+		s.handlers, err = moderatorv1.NewHandlers(moderatorv1.NewOptions(
+			s.approveMessageUseCase,
+			s.deleteMessageUseCase,
+			s.getQueueUseCase,
+			s.rejectMessageUseCase,
+		))
+		s.Require().NoError(err)
+	}
+	s.moderatorID = types.NewUserID()
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *HandlersSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *HandlersSuite) newEchoCtx(
+	requestID types.RequestID,
+	path string,
+	body string,
+) (*httptest.ResponseRecorder, echo.Context) {
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderXRequestID, requestID.String())
+
+	resp := httptest.NewRecorder()
+
+	ctx := echo.New().NewContext(req, resp)
+	middlewares.SetToken(ctx, s.moderatorID)
+
+	return resp, ctx
+}
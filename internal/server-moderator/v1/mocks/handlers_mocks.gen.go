@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handlers.go
+
+// Package moderatorv1mocks is a generated GoMock package.
+package moderatorv1mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	approvemessage "github.com/zestagio/chat-service/internal/usecases/moderator/approve-message"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/moderator/delete-message"
+	getqueue "github.com/zestagio/chat-service/internal/usecases/moderator/get-queue"
+	rejectmessage "github.com/zestagio/chat-service/internal/usecases/moderator/reject-message"
+)
+
+// MockapproveMessageUseCase is a mock of approveMessageUseCase interface.
+type MockapproveMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockapproveMessageUseCaseMockRecorder
+}
+
+// MockapproveMessageUseCaseMockRecorder is the mock recorder for MockapproveMessageUseCase.
+type MockapproveMessageUseCaseMockRecorder struct {
+	mock *MockapproveMessageUseCase
+}
+
+// NewMockapproveMessageUseCase creates a new mock instance.
+func NewMockapproveMessageUseCase(ctrl *gomock.Controller) *MockapproveMessageUseCase {
+	mock := &MockapproveMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockapproveMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapproveMessageUseCase) EXPECT() *MockapproveMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockapproveMessageUseCase) Handle(ctx context.Context, req approvemessage.Request) (approvemessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(approvemessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockapproveMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockapproveMessageUseCase)(nil).Handle), ctx, req)
+}
+
+// MockdeleteMessageUseCase is a mock of deleteMessageUseCase interface.
+type MockdeleteMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeleteMessageUseCaseMockRecorder
+}
+
+// MockdeleteMessageUseCaseMockRecorder is the mock recorder for MockdeleteMessageUseCase.
+type MockdeleteMessageUseCaseMockRecorder struct {
+	mock *MockdeleteMessageUseCase
+}
+
+// NewMockdeleteMessageUseCase creates a new mock instance.
+func NewMockdeleteMessageUseCase(ctrl *gomock.Controller) *MockdeleteMessageUseCase {
+	mock := &MockdeleteMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockdeleteMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeleteMessageUseCase) EXPECT() *MockdeleteMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockdeleteMessageUseCase) Handle(ctx context.Context, req deletemessage.Request) (deletemessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(deletemessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockdeleteMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockdeleteMessageUseCase)(nil).Handle), ctx, req)
+}
+
+// MockgetQueueUseCase is a mock of getQueueUseCase interface.
+type MockgetQueueUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgetQueueUseCaseMockRecorder
+}
+
+// MockgetQueueUseCaseMockRecorder is the mock recorder for MockgetQueueUseCase.
+type MockgetQueueUseCaseMockRecorder struct {
+	mock *MockgetQueueUseCase
+}
+
+// NewMockgetQueueUseCase creates a new mock instance.
+func NewMockgetQueueUseCase(ctrl *gomock.Controller) *MockgetQueueUseCase {
+	mock := &MockgetQueueUseCase{ctrl: ctrl}
+	mock.recorder = &MockgetQueueUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgetQueueUseCase) EXPECT() *MockgetQueueUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockgetQueueUseCase) Handle(ctx context.Context, req getqueue.Request) (getqueue.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(getqueue.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockgetQueueUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockgetQueueUseCase)(nil).Handle), ctx, req)
+}
+
+// MockrejectMessageUseCase is a mock of rejectMessageUseCase interface.
+type MockrejectMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockrejectMessageUseCaseMockRecorder
+}
+
+// MockrejectMessageUseCaseMockRecorder is the mock recorder for MockrejectMessageUseCase.
+type MockrejectMessageUseCaseMockRecorder struct {
+	mock *MockrejectMessageUseCase
+}
+
+// NewMockrejectMessageUseCase creates a new mock instance.
+func NewMockrejectMessageUseCase(ctrl *gomock.Controller) *MockrejectMessageUseCase {
+	mock := &MockrejectMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockrejectMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrejectMessageUseCase) EXPECT() *MockrejectMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockrejectMessageUseCase) Handle(ctx context.Context, req rejectmessage.Request) (rejectmessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(rejectmessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockrejectMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockrejectMessageUseCase)(nil).Handle), ctx, req)
+}
@@ -0,0 +1,464 @@
+// Package moderatorv1 provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.2.0 DO NOT EDIT.
+package moderatorv1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ErrorCode.
+const (
+	ErrorCodeModerationCaseNotFound ErrorCode = 7000
+)
+
+// ApproveMessageRequest defines model for ApproveMessageRequest.
+type ApproveMessageRequest = CaseId
+
+// ApproveMessageResponse defines model for ApproveMessageResponse.
+type ApproveMessageResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType string             `json:"contentType"`
+	FileName    string             `json:"fileName"`
+	Id          types.AttachmentID `json:"id"`
+	Size        int64              `json:"size"`
+
+	// ThumbnailUrl The signed URL of the image thumbnail. It is absent for the other files.
+	ThumbnailUrl *string `json:"thumbnailUrl,omitempty"`
+
+	// Url The signed download URL, it expires in some time. Get the queue again to renew it.
+	Url string `json:"url"`
+}
+
+// Case defines model for Case.
+type Case struct {
+	CaseId types.ModerationCaseID `json:"caseId"`
+
+	// Context The messages of the problem preceding the message, from the oldest to the newest.
+	Context   []ContextMessage `json:"context"`
+	CreatedAt time.Time        `json:"createdAt"`
+	Message   Message          `json:"message"`
+}
+
+// CaseId defines model for CaseId.
+type CaseId struct {
+	CaseId types.ModerationCaseID `json:"caseId"`
+}
+
+// CaseList defines model for CaseList.
+type CaseList struct {
+	Cases []Case `json:"cases"`
+}
+
+// ContextMessage defines model for ContextMessage.
+type ContextMessage struct {
+	// AuthorId It is absent for the service messages.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	Body      string          `json:"body"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Id        types.MessageID `json:"id"`
+}
+
+// DeleteMessageRequest defines model for DeleteMessageRequest.
+type DeleteMessageRequest struct {
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// DeleteMessageResponse defines model for DeleteMessageResponse.
+type DeleteMessageResponse struct {
+	Data  *DeletedMessageHeader `json:"data,omitempty"`
+	Error *Error                `json:"error,omitempty"`
+}
+
+// DeletedMessageHeader defines model for DeletedMessageHeader.
+type DeletedMessageHeader struct {
+	DeletedAt time.Time       `json:"deletedAt"`
+	Id        types.MessageID `json:"id"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	// Code contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
+	Code    ErrorCode `json:"code"`
+	Details *string   `json:"details,omitempty"`
+	Message string    `json:"message"`
+}
+
+// ErrorCode contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
+type ErrorCode int
+
+// GetQueueRequest defines model for GetQueueRequest.
+type GetQueueRequest struct {
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// GetQueueResponse defines model for GetQueueResponse.
+type GetQueueResponse struct {
+	Data  *CaseList `json:"data,omitempty"`
+	Error *Error    `json:"error,omitempty"`
+}
+
+// Message defines model for Message.
+type Message struct {
+	Attachments *[]Attachment   `json:"attachments,omitempty"`
+	AuthorId    types.UserID    `json:"authorId"`
+	Body        string          `json:"body"`
+	ChatId      types.ChatID    `json:"chatId"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	Id          types.MessageID `json:"id"`
+}
+
+// RejectMessageRequest defines model for RejectMessageRequest.
+type RejectMessageRequest = CaseId
+
+// RejectMessageResponse defines model for RejectMessageResponse.
+type RejectMessageResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// XRequestIDHeader defines model for XRequestIDHeader.
+type XRequestIDHeader = types.RequestID
+
+// PostApproveMessageParams defines parameters for PostApproveMessage.
+type PostApproveMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostDeleteMessageParams defines parameters for PostDeleteMessage.
+type PostDeleteMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostGetQueueParams defines parameters for PostGetQueue.
+type PostGetQueueParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostRejectMessageParams defines parameters for PostRejectMessage.
+type PostRejectMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostApproveMessageJSONRequestBody defines body for PostApproveMessage for application/json ContentType.
+type PostApproveMessageJSONRequestBody = ApproveMessageRequest
+
+// PostDeleteMessageJSONRequestBody defines body for PostDeleteMessage for application/json ContentType.
+type PostDeleteMessageJSONRequestBody = DeleteMessageRequest
+
+// PostGetQueueJSONRequestBody defines body for PostGetQueue for application/json ContentType.
+type PostGetQueueJSONRequestBody = GetQueueRequest
+
+// PostRejectMessageJSONRequestBody defines body for PostRejectMessage for application/json ContentType.
+type PostRejectMessageJSONRequestBody = RejectMessageRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /approveMessage)
+	PostApproveMessage(ctx echo.Context, params PostApproveMessageParams) error
+
+	// (POST /deleteMessage)
+	PostDeleteMessage(ctx echo.Context, params PostDeleteMessageParams) error
+
+	// (POST /getQueue)
+	PostGetQueue(ctx echo.Context, params PostGetQueueParams) error
+
+	// (POST /rejectMessage)
+	PostRejectMessage(ctx echo.Context, params PostRejectMessageParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// PostApproveMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApproveMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApproveMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApproveMessage(ctx, params)
+	return err
+}
+
+// PostDeleteMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDeleteMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostDeleteMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostDeleteMessage(ctx, params)
+	return err
+}
+
+// PostGetQueue converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetQueue(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetQueueParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetQueue(ctx, params)
+	return err
+}
+
+// PostRejectMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRejectMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostRejectMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRejectMessage(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/approveMessage", wrapper.PostApproveMessage)
+	router.POST(baseURL+"/deleteMessage", wrapper.PostDeleteMessage)
+	router.POST(baseURL+"/getQueue", wrapper.PostGetQueue)
+	router.POST(baseURL+"/rejectMessage", wrapper.PostRejectMessage)
+
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/9xY3W7bxhJ+lcWeA5xTgBLpJGgDAb1wnD8VSZo6DhrA9cWKHJGTkLvM7lC2Y+jdi1lS",
+	"FClRdqLGgesrieTuzs83O/PNXMnYFKXRoMnJyZUslVUFEFj/9OEYPlfgaPr0JagELL9DLScyqx8DqVUB",
+	"ciI/jJqVo+lTGUgLnyu0kMgJ2QoC6eIMCsW758YWiuREVhUmMpB0WfJ+RxZ1KgN5MUrNqHnJP27cqtD9",
+	"OsKiNJZqjSmTE5kiZdVsHJsi/AKOVIomjDNFIwd2gTGEqAmsVnnoj5XL5XK5UszbeliW1izgNTinUmik",
+	"egHWlGAJwS+LlYNpsp8pr00CVhEafcSnfH+Lup4/Xal6tgy2jHOl0Q62rUsUeZgatc3sI8Qkl4EEa42H",
+	"/78W5nIi/xOu4yZsvBg+84u8HodEKs4K0EMuNJpA04mXcbXht2Ug55jDG1UMf8Q9fb9W6Pv7PZAOv0BP",
+	"L9T086O1YrwlBcsGUFYVM60wf29z73JwscWSo0JO5EkGwmGqIRHvj18JMxeUgcBCpSDanWMxJYFOqJkD",
+	"TWJurF9lKAMr2HtuvOWTZSCrGwQm5lznRnnJgUAScFGiBSdQC2cKEIQFjMULIC/ucwUVCJUq1IKMsKDh",
+	"XCANiN6IS49YC3LQi4fGlbWyHLl8U1hrlee/z+Xk9PoIPKpDfhkMxtwFDdtf1NfCrdxdWjPLoRClhRgS",
+	"1Kmg9apAzK0p/BuTJ+CIjecnDefgvPlIULibLstRrVFzJX1k1F5T1qpLfo4tKILkkHqRlSiCESMxBHGj",
+	"402yW6GbGaOVuD4qaH13FmzmhRVAdUK8D4mS5b3CXYnf//k6dJUbwHRArqvF9oNhS7iqKDN2mmzH72Am",
+	"aAxvA5uD8ttReO/A3kaynJnkcjC37xHw+5aDxtO3HlpeHW9w1zyG/CnkQDfSjQbB6R03c63mgGk3kY3r",
+	"7lF9VNKc1bDQPfjI4DnbGtWr7l0Aru1ieJ6tfLdZIhP4Ko8e8cIln0oKczd4lTuF6Hoy0C0zCaz1O2q0",
+	"6Wc7LkUKtRMvT07eCh8Egvc5oXQiXAkxzjEWs8qhBudEblKMe+v+z/kxV45EUTkSMxB/VVH0EH4VB1EU",
+	"/cSZEnRVyMnpL1EUnQWyQI0Fv3gURVt8jrHj5aOFstwKOTap1b9fxt4Yem4qXV+RF0B/MH/aefFLlcK7",
+	"hlUW6qJW4SCKOgodbNPLZe/of3Lx2kK4x2XbXcZaEv71lbTTSQxwpG5h/DdUuEzRvsoe8d5bUPa+Vt3G",
+	"150Y2VGJj4HZ7P1s/Ddsu+W+nzthiCuLdPmOv9WHz0BZsIcVZeun5ysf/vbniWzmMCy5/rp2akZU1gai",
+	"nhuvIlLOX54o/Um8q0p2nuC7IRovGysO305lIBdgXV02FgdsiylBqxLlRD4cR+OHMvAO9yqGqjcg8Q4y",
+	"bqBdPIYclIOaZVeuxBhN5da9IZI4xzznupJAjguwkHCDWCitUrBcXkzZxAJHk3xrHPWnMzLoTeF2dLzr",
+	"JeHWlG55VscDOHrSZKKmx+a/qixzjL0G4UfHVl11BnTXpuLBEdlG+JGtwL+oY82790EU3ZoStZhaiz5W",
+	"b4zguBZGC1fFMTg3boI0TLoMdTfaNWsUSl+uEB4LHhhwGuG2K8MkAV0PBGaGMuEwATcMco8U312MB9uS",
+	"HwzxcP8wgHCzRDT8toU3bSjQbmRXQ6xmhBPnyP1zOwg6V0g89+GGulillf85kUCMnFOGIV4xr7uL7ibt",
+	"/MHAblHTIUzbWin8fETk6KhF1nbr2W54j4yeo62ndLPcxJ9Wo73tnD0MZa9u3l08B6nLDwZ1mGJ8Qz7u",
+	"sAbv3C5fOD1j1zHxWbl+M0MvIDdlwbe3XtXMjmvqMAnD3MQqz4yjyePo8YOQqcDZ8u8BABDbLPn3GgAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -33,6 +34,11 @@ type Options struct {
 	secWsProtocol     string                   `option:"mandatory" validate:"required"`
 	handlersRegistrar func(e *echo.Echo)       `option:"mandatory" validate:"required"`
 	shutdown          func()                   `option:"mandatory" validate:"required"`
+
+	// publicPaths are the routes served without the token, e.g. the signed attachment downloads.
+	publicPaths []string
+	// bodyLimits overrides the default body limit for the routes, e.g. the file uploads.
+	bodyLimits map[string]string
 }
 
 type Server struct {
@@ -51,16 +57,28 @@ func New(opts Options) (*Server, error) {
 		middlewares.NewRecovery(opts.logger),
 		echomdlwr.CORSWithConfig(echomdlwr.CORSConfig{
 			AllowOrigins: opts.allowOrigins,
-			AllowMethods: []string{http.MethodPost},
+			AllowMethods: []string{http.MethodGet, http.MethodPost},
 		}),
-		middlewares.NewKeycloakTokenAuth(
+		skipPaths(opts.publicPaths, middlewares.NewKeycloakTokenAuth(
 			opts.introspector,
 			opts.requiredResource,
 			opts.requiredRole,
 			opts.secWsProtocol,
-		),
-		echomdlwr.BodyLimit(bodyLimit),
+		)),
+		echomdlwr.BodyLimitWithConfig(echomdlwr.BodyLimitConfig{
+			Skipper: func(eCtx echo.Context) bool {
+				_, ok := opts.bodyLimits[eCtx.Path()]
+				return ok
+			},
+			Limit: bodyLimit,
+		}),
 	)
+	for path, limit := range opts.bodyLimits {
+		e.Use(echomdlwr.BodyLimitWithConfig(echomdlwr.BodyLimitConfig{
+			Skipper: func(eCtx echo.Context) bool { return eCtx.Path() != path },
+			Limit:   limit,
+		}))
+	}
 
 	opts.handlersRegistrar(e)
 
@@ -102,3 +120,16 @@ func (s *Server) Run(ctx context.Context) error {
 
 	return eg.Wait()
 }
+
+// skipPaths applies the middleware to all routes except the paths.
+func skipPaths(paths []string, m echo.MiddlewareFunc) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		withM := m(next)
+		return func(eCtx echo.Context) error {
+			if slices.Contains(paths, eCtx.Path()) {
+				return next(eCtx)
+			}
+			return withM(eCtx)
+		}
+	}
+}
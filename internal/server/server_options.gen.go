@@ -53,6 +53,22 @@ func NewOptions(
 	return o
 }
 
+// publicPaths are the routes served without the token, e.g. the signed attachment downloads.
+func WithPublicPaths(opt []string) OptOptionsSetter {
+	return func(o *Options) {
+		o.publicPaths = opt
+
+	}
+}
+
+// bodyLimits overrides the default body limit for the routes, e.g. the file uploads.
+func WithBodyLimits(opt map[string]string) OptOptionsSetter {
+	return func(o *Options) {
+		o.bodyLimits = opt
+
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("logger", _validate_Options_logger(o)))
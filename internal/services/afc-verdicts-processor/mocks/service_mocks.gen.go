@@ -10,6 +10,9 @@ import (
 	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	contentfilter "github.com/zestagio/chat-service/internal/services/content-filter"
+	jwks "github.com/zestagio/chat-service/internal/services/jwks"
 	types "github.com/zestagio/chat-service/internal/types"
 )
 
@@ -36,32 +39,160 @@ func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
 	return m.recorder
 }
 
-// BlockMessage mocks base method.
-func (m *MockmessagesRepository) BlockMessage(ctx context.Context, msgID types.MessageID) error {
+// ApplyAFCVerdict mocks base method.
+func (m *MockmessagesRepository) ApplyAFCVerdict(ctx context.Context, msgID types.MessageID, reqID types.RequestID, v messagesrepo.Verdict) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BlockMessage", ctx, msgID)
+	ret := m.ctrl.Call(m, "ApplyAFCVerdict", ctx, msgID, reqID, v)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// BlockMessage indicates an expected call of BlockMessage.
-func (mr *MockmessagesRepositoryMockRecorder) BlockMessage(ctx, msgID interface{}) *gomock.Call {
+// ApplyAFCVerdict indicates an expected call of ApplyAFCVerdict.
+func (mr *MockmessagesRepositoryMockRecorder) ApplyAFCVerdict(ctx, msgID, reqID, v interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockMessage", reflect.TypeOf((*MockmessagesRepository)(nil).BlockMessage), ctx, msgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyAFCVerdict", reflect.TypeOf((*MockmessagesRepository)(nil).ApplyAFCVerdict), ctx, msgID, reqID, v)
 }
 
-// MarkAsVisibleForManager mocks base method.
-func (m *MockmessagesRepository) MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID) error {
+// GetMessageByID mocks base method.
+func (m *MockmessagesRepository) GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkAsVisibleForManager", ctx, msgID)
+	ret := m.ctrl.Call(m, "GetMessageByID", ctx, msgID)
+	ret0, _ := ret[0].(*messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageByID indicates an expected call of GetMessageByID.
+func (mr *MockmessagesRepositoryMockRecorder) GetMessageByID(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockmessagesRepository)(nil).GetMessageByID), ctx, msgID)
+}
+
+// RecordVerdictConflict mocks base method.
+func (m *MockmessagesRepository) RecordVerdictConflict(ctx context.Context, msgID types.MessageID, applied, received messagesrepo.Verdict) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordVerdictConflict", ctx, msgID, applied, received)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MarkAsVisibleForManager indicates an expected call of MarkAsVisibleForManager.
-func (mr *MockmessagesRepositoryMockRecorder) MarkAsVisibleForManager(ctx, msgID interface{}) *gomock.Call {
+// RecordVerdictConflict indicates an expected call of RecordVerdictConflict.
+func (mr *MockmessagesRepositoryMockRecorder) RecordVerdictConflict(ctx, msgID, applied, received interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordVerdictConflict", reflect.TypeOf((*MockmessagesRepository)(nil).RecordVerdictConflict), ctx, msgID, applied, received)
+}
+
+// MockverdictsKeySet is a mock of verdictsKeySet interface.
+type MockverdictsKeySet struct {
+	ctrl     *gomock.Controller
+	recorder *MockverdictsKeySetMockRecorder
+}
+
+// MockverdictsKeySetMockRecorder is the mock recorder for MockverdictsKeySet.
+type MockverdictsKeySetMockRecorder struct {
+	mock *MockverdictsKeySet
+}
+
+// NewMockverdictsKeySet creates a new mock instance.
+func NewMockverdictsKeySet(ctrl *gomock.Controller) *MockverdictsKeySet {
+	mock := &MockverdictsKeySet{ctrl: ctrl}
+	mock.recorder = &MockverdictsKeySetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockverdictsKeySet) EXPECT() *MockverdictsKeySetMockRecorder {
+	return m.recorder
+}
+
+// Key mocks base method.
+func (m *MockverdictsKeySet) Key(kid string) (jwks.Key, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Key", kid)
+	ret0, _ := ret[0].(jwks.Key)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Key indicates an expected call of Key.
+func (mr *MockverdictsKeySetMockRecorder) Key(kid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Key", reflect.TypeOf((*MockverdictsKeySet)(nil).Key), kid)
+}
+
+// MockcontentFilter is a mock of contentFilter interface.
+type MockcontentFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentFilterMockRecorder
+}
+
+// MockcontentFilterMockRecorder is the mock recorder for MockcontentFilter.
+type MockcontentFilterMockRecorder struct {
+	mock *MockcontentFilter
+}
+
+// NewMockcontentFilter creates a new mock instance.
+func NewMockcontentFilter(ctrl *gomock.Controller) *MockcontentFilter {
+	mock := &MockcontentFilter{ctrl: ctrl}
+	mock.recorder = &MockcontentFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentFilter) EXPECT() *MockcontentFilterMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockcontentFilter) Check(body string) contentfilter.Result {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", body)
+	ret0, _ := ret[0].(contentfilter.Result)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockcontentFilterMockRecorder) Check(body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockcontentFilter)(nil).Check), body)
+}
+
+// MockmoderationRepository is a mock of moderationRepository interface.
+type MockmoderationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmoderationRepositoryMockRecorder
+}
+
+// MockmoderationRepositoryMockRecorder is the mock recorder for MockmoderationRepository.
+type MockmoderationRepositoryMockRecorder struct {
+	mock *MockmoderationRepository
+}
+
+// NewMockmoderationRepository creates a new mock instance.
+func NewMockmoderationRepository(ctrl *gomock.Controller) *MockmoderationRepository {
+	mock := &MockmoderationRepository{ctrl: ctrl}
+	mock.recorder = &MockmoderationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmoderationRepository) EXPECT() *MockmoderationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateIfNotExists mocks base method.
+func (m *MockmoderationRepository) CreateIfNotExists(ctx context.Context, msgID types.MessageID) (types.ModerationCaseID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfNotExists", ctx, msgID)
+	ret0, _ := ret[0].(types.ModerationCaseID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIfNotExists indicates an expected call of CreateIfNotExists.
+func (mr *MockmoderationRepositoryMockRecorder) CreateIfNotExists(ctx, msgID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsVisibleForManager", reflect.TypeOf((*MockmessagesRepository)(nil).MarkAsVisibleForManager), ctx, msgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockmoderationRepository)(nil).CreateIfNotExists), ctx, msgID)
 }
 
 // MockoutboxService is a mock of outboxService interface.
@@ -2,9 +2,12 @@ package afcverdictsprocessor
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 
 	"github.com/segmentio/kafka-go"
+	"go.uber.org/multierr"
 
 	"github.com/zestagio/chat-service/internal/logger"
 )
@@ -39,3 +42,73 @@ func NewKafkaReader(brokers []string, groupID string, topic string) KafkaReader
 		ErrorLogger:           logger.NewKafkaAdapted().WithServiceName(serviceName).ForErrors(),
 	})
 }
+
+// NewKafkaDLQReader reads the DLQ partition from the fromOffset up to the toOffset inclusively.
+// The negative toOffset means the last message at the moment of the reader creation,
+// so the messages written back to the DLQ during the re-drive are not read again.
+func NewKafkaDLQReader(
+	ctx context.Context,
+	brokers []string,
+	topic string,
+	partition int,
+	fromOffset, toOffset int64,
+) (KafkaReader, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("no brokers")
+	}
+
+	if toOffset < 0 {
+		conn, err := kafka.DialLeader(ctx, "tcp", brokers[0], topic, partition)
+		if err != nil {
+			return nil, fmt.Errorf("dial partition leader: %v", err)
+		}
+		defer conn.Close()
+
+		lastOffset, err := conn.ReadLastOffset()
+		if err != nil {
+			return nil, fmt.Errorf("read last offset: %v", err)
+		}
+		toOffset = lastOffset - 1
+	}
+
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     brokers,
+		Topic:       topic,
+		Partition:   partition,
+		MinBytes:    minBytesToRead,
+		MaxBytes:    maxBytesToRead,
+		Logger:      logger.NewKafkaAdapted().WithServiceName(dlqSubServiceName),
+		ErrorLogger: logger.NewKafkaAdapted().WithServiceName(dlqSubServiceName).ForErrors(),
+	})
+	if err := r.SetOffset(fromOffset); err != nil {
+		return nil, multierr.Append(fmt.Errorf("set offset: %v", err), r.Close())
+	}
+
+	return &boundedReader{Reader: r, fromOffset: fromOffset, toOffset: toOffset}, nil
+}
+
+type boundedReader struct {
+	*kafka.Reader
+	fromOffset int64
+	toOffset   int64
+}
+
+func (r *boundedReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	if r.fromOffset > r.toOffset {
+		return kafka.Message{}, io.EOF
+	}
+
+	msg, err := r.Reader.FetchMessage(ctx)
+	if err != nil {
+		return kafka.Message{}, err
+	}
+	if msg.Offset > r.toOffset {
+		return kafka.Message{}, io.EOF
+	}
+	return msg, nil
+}
+
+// CommitMessages does nothing, the reader is not a member of consumer group.
+func (r *boundedReader) CommitMessages(_ context.Context, _ ...kafka.Message) error {
+	return nil
+}
@@ -16,7 +16,9 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
-	clientmessageblockedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	contentfilter "github.com/zestagio/chat-service/internal/services/content-filter"
+	"github.com/zestagio/chat-service/internal/services/jwks"
 	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
 	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
 	"github.com/zestagio/chat-service/internal/types"
@@ -27,11 +29,30 @@ const (
 	dlqSubServiceName = "afc-verdicts-processor.dlq"
 )
 
+var (
+	errNoKeyID              = errors.New("no key id in verdict header")
+	errUnknownKeyID         = errors.New("unknown key id")
+	errUnexpectedSignMethod = errors.New("unexpected signing method")
+)
+
 //go:generate mockgen -source=$GOFILE -destination=mocks/service_mocks.gen.go -package=afcverdictsprocessormocks
 
 type messagesRepository interface {
-	BlockMessage(ctx context.Context, msgID types.MessageID) error
-	MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID) error
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	ApplyAFCVerdict(ctx context.Context, msgID types.MessageID, reqID types.RequestID, v messagesrepo.Verdict) error
+	RecordVerdictConflict(ctx context.Context, msgID types.MessageID, applied, received messagesrepo.Verdict) error
+}
+
+type verdictsKeySet interface {
+	Key(kid string) (jwks.Key, bool)
+}
+
+type contentFilter interface {
+	Check(body string) contentfilter.Result
+}
+
+type moderationRepository interface {
+	CreateIfNotExists(ctx context.Context, msgID types.MessageID) (types.ModerationCaseID, error)
 }
 
 type outboxService interface {
@@ -51,20 +72,29 @@ type Options struct {
 	consumers        int      `option:"mandatory" validate:"min=1,max=16"`
 	consumerGroup    string   `option:"mandatory" validate:"required"`
 	verdictsTopic    string   `option:"mandatory" validate:"required"`
-	verdictsSignKey  string
-	processBatchSize int `default:"1" validate:"min=1"`
+	processBatchSize int      `default:"1" validate:"min=1"`
+
+	// verdictsSignKey is a static RSA public key in PEM, used for the verdicts without "kid" header.
+	verdictsSignKey string
+	// verdictsSignKeys is a rotated key set, the key is selected by the verdict's "kid" header.
+	verdictsSignKeys verdictsKeySet
+
+	// shadowFilter is the local content filter to compare its verdicts with AFC ones.
+	shadowFilter contentFilter
 
 	readerFactory KafkaReaderFactory `option:"mandatory" validate:"required"`
 	dlqWriter     KafkaDLQWriter     `option:"mandatory" validate:"required"`
 
-	txtor   transactor         `option:"mandatory" validate:"required"`
-	msgRepo messagesRepository `option:"mandatory" validate:"required"`
-	outBox  outboxService      `option:"mandatory" validate:"required"`
+	txtor          transactor           `option:"mandatory" validate:"required"`
+	msgRepo        messagesRepository   `option:"mandatory" validate:"required"`
+	moderationRepo moderationRepository `option:"mandatory" validate:"required"`
+	outBox         outboxService        `option:"mandatory" validate:"required"`
 }
 
 type Service struct {
 	Options
 	verdictsSignKey *rsa.PublicKey
+	verdictsParser  *jwt.Parser
 	dlq             chan erroredMessage
 	logger          *zap.Logger
 }
@@ -85,17 +115,23 @@ func New(opts Options) (*Service, error) {
 
 	lg := zap.L().Named(serviceName)
 
-	if verdictsSignPubKey == nil {
+	if verdictsSignPubKey == nil && opts.verdictsSignKeys == nil {
 		lg.Info("verdicts signature validation disabled")
 	} else {
-		lg.Info("verdicts signature validation enabled")
+		lg.Info("verdicts signature validation enabled",
+			zap.Bool("static_key", verdictsSignPubKey != nil),
+			zap.Bool("key_set", opts.verdictsSignKeys != nil),
+		)
 	}
 
 	return &Service{
 		Options:         opts,
 		verdictsSignKey: verdictsSignPubKey,
-		dlq:             make(chan erroredMessage),
-		logger:          lg,
+		verdictsParser: &jwt.Parser{
+			ValidMethods: []string{jwks.AlgRS256, jwks.AlgES256, jwks.AlgEdDSA},
+		},
+		dlq:    make(chan erroredMessage),
+		logger: lg,
 	}, nil
 }
 
@@ -140,28 +176,7 @@ func (s *Service) startConsumer(ctx context.Context, consumerIdx int) (errReturn
 
 		logger := logger.With(zap.Int64("offset", msg.Offset))
 
-		if err := backoff.Retry(func() error {
-			err := s.processMessage(ctx, msg, logger)
-			if nil == err {
-				return nil
-			}
-
-			if !isRetriable(err) {
-				logger.Error("process message unretriable error", zap.Error(err), zap.Any("message", msg))
-				return backoff.Permanent(err)
-			}
-
-			// Retriable error.
-			fields := []zap.Field{zap.Error(err)}
-			if v, ok := extractVerdict(err); ok {
-				fields = append(fields,
-					zap.Stringer("chat_id", v.ChatID),
-					zap.Stringer("message_id", v.MessageID),
-				)
-			}
-			logger.Error("process message error", fields...)
-			return err
-		}, b); err != nil {
+		if err := s.processMessageWithRetries(ctx, b, msg, logger); err != nil {
 			go func() {
 				select {
 				case <-ctx.Done():
@@ -182,6 +197,38 @@ func (s *Service) startConsumer(ctx context.Context, consumerIdx int) (errReturn
 	}
 }
 
+func (s *Service) processMessageWithRetries(
+	ctx context.Context,
+	b backoff.BackOff,
+	msg kafka.Message,
+	logger *zap.Logger,
+) error {
+	ctx, logger = withMessageTrace(ctx, msg, logger)
+
+	return backoff.Retry(func() error {
+		err := s.processMessage(ctx, msg, logger)
+		if nil == err {
+			return nil
+		}
+
+		if !isRetriable(err) {
+			logger.Error("process message unretriable error", zap.Error(err), zap.Any("message", msg))
+			return backoff.Permanent(err)
+		}
+
+		// Retriable error.
+		fields := []zap.Field{zap.Error(err)}
+		if v, ok := extractVerdict(err); ok {
+			fields = append(fields,
+				zap.Stringer("chat_id", v.ChatID),
+				zap.Stringer("message_id", v.MessageID),
+			)
+		}
+		logger.Error("process message error", fields...)
+		return err
+	}, b)
+}
+
 func (s *Service) newProcessMessageBackOff() *backoff.ExponentialBackOff {
 	b := &backoff.ExponentialBackOff{
 		InitialInterval:     s.backoffInitialInterval,
@@ -196,52 +243,105 @@ func (s *Service) newProcessMessageBackOff() *backoff.ExponentialBackOff {
 }
 
 func (s *Service) processMessage(ctx context.Context, msg kafka.Message, logger *zap.Logger) error {
-	var v verdict
-
-	if s.verdictsSignKey != nil {
-		if _, err := jwt.ParseWithClaims(string(msg.Value), &v, func(_ *jwt.Token) (any, error) {
-			return s.verdictsSignKey, nil
-		}); err != nil {
-			return fmt.Errorf("validate msg signature: %v", err)
-		}
-	} else {
-		if err := json.Unmarshal(msg.Value, &v); err != nil {
-			return fmt.Errorf("unmarshal verdict: %v", err)
-		}
-
-		if err := v.Valid(); err != nil {
-			return fmt.Errorf("invalid verdict: %v", err)
-		}
+	v, err := s.decodeVerdict(msg)
+	if err != nil {
+		return err
 	}
 
+	reqID := contentRequestID(v, msg)
+
 	logger.Info("process verdict",
 		zap.Stringer("chat_id", v.ChatID),
 		zap.Stringer("message_id", v.MessageID),
+		zap.Stringer("content_request_id", reqID),
 		zap.String("message_status", string(v.Status)),
 	)
 
-	var err error
+	if s.shadowFilter != nil {
+		s.compareWithShadowFilter(ctx, v, logger)
+	}
+
 	switch status := v.Status; status {
 	case msgStatusOK:
-		err = s.processValidMessage(ctx, v.MessageID)
+		err = s.processValidMessage(ctx, v.MessageID, reqID, logger)
 
 	case msgStatusSuspicious:
-		err = s.processSuspiciousMessage(ctx, v.MessageID)
+		err = s.processSuspiciousMessage(ctx, v.MessageID, reqID, logger)
 
 	default:
 		return fmt.Errorf("unknown verdict: %q", status)
 	}
 
+	if errors.Is(err, messagesrepo.ErrVerdictOutdated) {
+		// The message has been edited since, the verdict on the new content is expected.
+		logger.Info("outdated verdict skipped", zap.Stringer("message_id", v.MessageID), zap.Error(err))
+		return nil
+	}
 	if err != nil {
 		return attachVerdict(newRetriableError(err), v)
 	}
 	return nil
 }
 
-func (s *Service) processValidMessage(ctx context.Context, msgID types.MessageID) error {
+// decodeVerdict validates the signature of the verdict if the signing keys are configured.
+func (s *Service) decodeVerdict(msg kafka.Message) (verdict, error) {
+	var v verdict
+
+	if s.verdictsSignKey != nil || s.verdictsSignKeys != nil {
+		if _, err := s.verdictsParser.ParseWithClaims(string(msg.Value), &v, s.verdictKey); err != nil {
+			return verdict{}, fmt.Errorf("validate msg signature: %v", err)
+		}
+		return v, nil
+	}
+
+	if err := json.Unmarshal(msg.Value, &v); err != nil {
+		return verdict{}, fmt.Errorf("unmarshal verdict: %v", err)
+	}
+
+	if err := v.Valid(); err != nil {
+		return verdict{}, fmt.Errorf("invalid verdict: %v", err)
+	}
+	return v, nil
+}
+
+// verdictKey selects the key for the verdict's signature validation.
+// The key from the key set must be used with the algorithm it is issued for.
+func (s *Service) verdictKey(t *jwt.Token) (any, error) {
+	alg := t.Method.Alg()
+
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" || s.verdictsSignKeys == nil {
+		if s.verdictsSignKey == nil {
+			return nil, errNoKeyID
+		}
+		if alg != jwks.AlgRS256 {
+			return nil, fmt.Errorf("%w: %q for static key", errUnexpectedSignMethod, alg)
+		}
+		return s.verdictsSignKey, nil
+	}
+
+	k, ok := s.verdictsSignKeys.Key(kid)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errUnknownKeyID, kid)
+	}
+	if alg != k.Alg {
+		return nil, fmt.Errorf("%w: %q for key %q", errUnexpectedSignMethod, alg, kid)
+	}
+	return k.PublicKey, nil
+}
+
+func (s *Service) processValidMessage(
+	ctx context.Context,
+	msgID types.MessageID,
+	reqID types.RequestID,
+	logger *zap.Logger,
+) error {
 	return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
-		if err := s.msgRepo.MarkAsVisibleForManager(ctx, msgID); err != nil {
-			return fmt.Errorf("mark message %q as visible for manager: %v", msgID.String(), err)
+		if err := s.msgRepo.ApplyAFCVerdict(ctx, msgID, reqID, messagesrepo.VerdictOK); err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgAlreadyChecked) {
+				return s.processRepeatedVerdict(ctx, msgID, messagesrepo.VerdictOK, logger)
+			}
+			return fmt.Errorf("mark message %q as visible for manager: %w", msgID.String(), err)
 		}
 
 		_, err := s.outBox.Put(ctx, clientmessagesentjob.Name, simpleid.MustMarshal(msgID), time.Now())
@@ -249,13 +349,73 @@ func (s *Service) processValidMessage(ctx context.Context, msgID types.MessageID
 	})
 }
 
-func (s *Service) processSuspiciousMessage(ctx context.Context, msgID types.MessageID) error {
+// processSuspiciousMessage sends the message to manual moderation.
+// The message stays invisible for manager until the moderator's decision.
+func (s *Service) processSuspiciousMessage(
+	ctx context.Context,
+	msgID types.MessageID,
+	reqID types.RequestID,
+	logger *zap.Logger,
+) error {
 	return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
-		if err := s.msgRepo.BlockMessage(ctx, msgID); err != nil {
-			return fmt.Errorf("block message: %v", err)
+		if err := s.msgRepo.ApplyAFCVerdict(ctx, msgID, reqID, messagesrepo.VerdictSuspicious); err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgAlreadyChecked) {
+				return s.processRepeatedVerdict(ctx, msgID, messagesrepo.VerdictSuspicious, logger)
+			}
+			return fmt.Errorf("mark message %q as checked: %w", msgID.String(), err)
 		}
 
-		_, err := s.outBox.Put(ctx, clientmessageblockedjob.Name, simpleid.MustMarshal(msgID), time.Now())
-		return err
+		if _, err := s.moderationRepo.CreateIfNotExists(ctx, msgID); err != nil {
+			return fmt.Errorf("create moderation case: %v", err)
+		}
+		return nil
 	})
 }
+
+// processRepeatedVerdict handles the verdict for the already checked message.
+// The first applied decision wins (AFC verdict, timeout policy, prefilter or moderator's decision),
+// the message is visible for manager already and cannot be taken back.
+// So the redelivered verdict is skipped and the conflicting one is only recorded for audit.
+func (s *Service) processRepeatedVerdict(
+	ctx context.Context,
+	msgID types.MessageID,
+	received messagesrepo.Verdict,
+	logger *zap.Logger,
+) error {
+	msg, err := s.msgRepo.GetMessageByID(ctx, msgID)
+	if err != nil {
+		return fmt.Errorf("get message %q: %v", msgID.String(), err)
+	}
+
+	applied := appliedVerdict(msg)
+
+	logger = logger.With(
+		zap.Stringer("message_id", msgID),
+		zap.String("applied_verdict", string(applied)),
+		zap.String("applied_verdict_source", string(msg.VerdictSource)),
+		zap.String("received_verdict", string(received)),
+	)
+
+	if applied == received {
+		logger.Info("repeated verdict skipped")
+		return nil
+	}
+
+	if err := s.msgRepo.RecordVerdictConflict(ctx, msgID, applied, received); err != nil {
+		return fmt.Errorf("record verdict conflict: %v", err)
+	}
+	logger.Warn("conflicting verdict recorded")
+	return nil
+}
+
+// appliedVerdict returns the decision applied to the message.
+// The messages checked before the decision was stored have it deduced from the visibility.
+func appliedVerdict(msg *messagesrepo.Message) messagesrepo.Verdict {
+	if msg.Verdict != "" {
+		return msg.Verdict
+	}
+	if msg.IsVisibleForManager {
+		return messagesrepo.VerdictOK
+	}
+	return messagesrepo.VerdictSuspicious
+}
@@ -13,6 +13,11 @@ import (
 	"github.com/zestagio/chat-service/internal/logger"
 )
 
+const (
+	dlqHeaderLastError         = "LAST_ERROR"
+	dlqHeaderOriginalPartition = "ORIGINAL_PARTITION"
+)
+
 type erroredMessage struct {
 	msg     kafka.Message
 	lastErr error
@@ -31,22 +36,40 @@ func (s *Service) startDLQProducer(ctx context.Context) (errReturned error) {
 				return errors.New("dlq: channel was closed")
 			}
 
-			msg := kafka.Message{
-				Partition: 0,
-				Key:       m.msg.Key,
-				Value:     m.msg.Value,
-				Headers: append(m.msg.Headers,
-					kafka.Header{Key: "LAST_ERROR", Value: []byte(m.lastErr.Error())},
-					kafka.Header{Key: "ORIGINAL_PARTITION", Value: []byte(strconv.Itoa(m.msg.Partition))},
-				),
-			}
-			if err := s.dlqWriter.WriteMessages(ctx, msg); err != nil {
+			if err := s.dlqWriter.WriteMessages(ctx, newDLQMessage(m)); err != nil {
 				return fmt.Errorf("dql: write msg: %v", err)
 			}
 		}
 	}
 }
 
+// newDLQMessage builds the DLQ message with the last processing error.
+// The message can be the one from the DLQ already (re-drive), so its original partition is kept.
+func newDLQMessage(m erroredMessage) kafka.Message {
+	origPartition := []byte(strconv.Itoa(m.msg.Partition))
+
+	headers := make([]kafka.Header, 0, len(m.msg.Headers)+2)
+	for _, h := range m.msg.Headers {
+		switch h.Key {
+		case dlqHeaderLastError:
+		case dlqHeaderOriginalPartition:
+			origPartition = h.Value
+		default:
+			headers = append(headers, h)
+		}
+	}
+
+	return kafka.Message{
+		Partition: 0,
+		Key:       m.msg.Key,
+		Value:     m.msg.Value,
+		Headers: append(headers,
+			kafka.Header{Key: dlqHeaderLastError, Value: []byte(m.lastErr.Error())},
+			kafka.Header{Key: dlqHeaderOriginalPartition, Value: origPartition},
+		),
+	}
+}
+
 //go:generate mockgen -source=$GOFILE -destination=mocks/dlq_writer_mock.gen.go -package=afcverdictsprocessormocks
 
 type KafkaDLQWriter interface {
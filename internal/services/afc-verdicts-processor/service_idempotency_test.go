@@ -0,0 +1,124 @@
+package afcverdictsprocessor_test
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/segmentio/kafka-go"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+func (s *ServiceSuite) TestRedeliveredVerdict_Skipped() {
+	for _, tc := range []struct {
+		status              string
+		verdict             messagesrepo.Verdict
+		applied             messagesrepo.Verdict
+		isVisibleForManager bool
+	}{
+		{status: "ok", verdict: messagesrepo.VerdictOK, isVisibleForManager: true},
+		{status: "suspicious", verdict: messagesrepo.VerdictSuspicious, isVisibleForManager: false},
+		{
+			// The stored decision is compared, not the visibility.
+			status:              "ok",
+			verdict:             messagesrepo.VerdictOK,
+			applied:             messagesrepo.VerdictOK,
+			isVisibleForManager: false,
+		},
+	} {
+		// Arrange.
+		msgID := types.NewMessageID()
+		msg := s.newKafkaMessage(msgID, tc.status)
+
+		s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+		s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, tc.verdict).
+			Return(fmt.Errorf("message %v: %w", msgID, messagesrepo.ErrMsgAlreadyChecked))
+		s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).
+			Return(&messagesrepo.Message{
+				ID:                  msgID,
+				IsChecked:           true,
+				IsVisibleForManager: tc.isVisibleForManager,
+				Verdict:             tc.applied,
+			}, nil)
+		// No outbox jobs, no moderation cases, no conflicts.
+		s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+	}
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *ServiceSuite) TestOutOfOrderVerdict_ConflictRecorded() {
+	for _, tc := range []struct {
+		status              string
+		received            messagesrepo.Verdict
+		applied             messagesrepo.Verdict
+		isVisibleForManager bool
+	}{
+		{
+			// The message was released, the "suspicious" verdict cannot hide it.
+			status:              "suspicious",
+			received:            messagesrepo.VerdictSuspicious,
+			applied:             messagesrepo.VerdictOK,
+			isVisibleForManager: true,
+		},
+		{
+			// The message is waiting for moderator's decision or blocked.
+			status:              "ok",
+			received:            messagesrepo.VerdictOK,
+			applied:             messagesrepo.VerdictSuspicious,
+			isVisibleForManager: false,
+		},
+	} {
+		// Arrange.
+		msgID := types.NewMessageID()
+		msg := s.newKafkaMessage(msgID, tc.status)
+
+		s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+		s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, tc.received).
+			Return(fmt.Errorf("message %v: %w", msgID, messagesrepo.ErrMsgAlreadyChecked))
+		s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).
+			Return(&messagesrepo.Message{ID: msgID, IsChecked: true, IsVisibleForManager: tc.isVisibleForManager}, nil)
+		s.msgRepo.EXPECT().RecordVerdictConflict(gomock.Any(), msgID, tc.applied, tc.received).Return(nil)
+		s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+	}
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *ServiceSuite) TestOutdatedVerdict_Skipped() {
+	// Arrange.
+	msgID, reqID := types.NewMessageID(), types.NewRequestID()
+	msg := kafka.Message{Value: []byte(s.encode(verdict{
+		ChatID:    types.NewChatID().String(),
+		MessageID: msgID.String(),
+		Status:    "ok",
+		RequestID: reqID.String(),
+	}))}
+
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, reqID, messagesrepo.VerdictOK).
+		Return(fmt.Errorf("message %v: %w", msgID, messagesrepo.ErrVerdictOutdated))
+	// No outbox jobs, no retries, no DLQ.
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *ServiceSuite) newKafkaMessage(msgID types.MessageID, status string) kafka.Message {
+	s.T().Helper()
+
+	return kafka.Message{Value: []byte(s.encode(verdict{
+		ChatID:    types.NewChatID().String(),
+		MessageID: msgID.String(),
+		Status:    status,
+	}))}
+}
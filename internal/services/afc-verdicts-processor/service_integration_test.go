@@ -17,8 +17,10 @@ import (
 	"github.com/zestagio/chat-service/internal/logger"
 	jobsrepo "github.com/zestagio/chat-service/internal/repositories/jobs"
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	moderationrepo "github.com/zestagio/chat-service/internal/repositories/moderation"
 	afcverdictsprocessor "github.com/zestagio/chat-service/internal/services/afc-verdicts-processor"
 	"github.com/zestagio/chat-service/internal/services/outbox"
+	"github.com/zestagio/chat-service/internal/store"
 	"github.com/zestagio/chat-service/internal/store/message"
 	"github.com/zestagio/chat-service/internal/testingh"
 	"github.com/zestagio/chat-service/internal/types"
@@ -112,6 +114,9 @@ func (s *ServiceIntegrationSuite) SetupTest() {
 	msgRepo, err := messagesrepo.New(messagesrepo.NewOptions(s.Database))
 	s.Require().NoError(err)
 
+	moderationRepo, err := moderationrepo.New(moderationrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
 	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
 	s.Require().NoError(err)
 
@@ -127,6 +132,7 @@ func (s *ServiceIntegrationSuite) SetupTest() {
 		afcverdictsprocessor.NewKafkaDLQWriter(s.ks.KafkaBrokers(), s.verdictsDLQTopic),
 		s.Database,
 		msgRepo,
+		moderationRepo,
 		outboxSvc,
 		afcverdictsprocessor.WithVerdictsSignKey(s.SignPubKey),
 		afcverdictsprocessor.WithProcessBatchSize(4),
@@ -148,7 +154,7 @@ func (s *ServiceIntegrationSuite) TearDownTest() {
 func (s *ServiceIntegrationSuite) TestComplex() {
 	// Arrange.
 	const n = 126
-	var expPassedMsgs, expBlockedMsg, expBrokenMsgs int
+	var expPassedMsgs, expSuspiciousMsgs, expBrokenMsgs int
 
 	messages := make([]kafka.Message, n)
 	for i := 0; i < n; i++ {
@@ -160,7 +166,7 @@ func (s *ServiceIntegrationSuite) TestComplex() {
 
 		case i%3 == 0:
 			status = "suspicious"
-			expBlockedMsg++
+			expSuspiciousMsgs++
 
 		default:
 			status = "abracadabra"
@@ -218,20 +224,104 @@ func (s *ServiceIntegrationSuite) TestComplex() {
 
 	passedMsgs := s.Database.Message(s.Ctx).Query().Where(message.IsVisibleForManager(true)).CountX(s.Ctx)
 	blockedMsgs := s.Database.Message(s.Ctx).Query().Where(message.IsBlocked(true)).CountX(s.Ctx)
+	moderationCases := s.Database.ModerationCase(s.Ctx).Query().CountX(s.Ctx)
 	checkedMsgs := s.Database.Message(s.Ctx).Query().Where(message.CheckedAtNotNil()).CountX(s.Ctx)
 	jobs := s.Database.Job(s.Ctx).Query().CountX(s.Ctx)
 	failedJobs := s.Database.FailedJob(s.Ctx).Query().CountX(s.Ctx)
 
 	s.Equal(expPassedMsgs, passedMsgs)
-	s.Equal(expBlockedMsg, blockedMsgs)
-	s.Equal(expPassedMsgs+expBlockedMsg, checkedMsgs)
-	s.Equal(expPassedMsgs+expBlockedMsg, jobs)
+	s.Equal(0, blockedMsgs) // Suspicious messages are waiting for moderator's decision.
+	s.Equal(expSuspiciousMsgs, moderationCases)
+	s.Equal(expPassedMsgs+expSuspiciousMsgs, checkedMsgs)
+	s.Equal(expPassedMsgs, jobs)
 	s.Equal(0, failedJobs)
 
 	cancel()
 	s.Require().NoError(<-errCh)
 }
 
+func (s *ServiceIntegrationSuite) TestRedeliveredAndOutOfOrderVerdicts() {
+	// Arrange.
+	passedMsg := s.createMessage()
+	suspiciousMsg := s.createMessage()
+
+	var messages []kafka.Message
+	for _, v := range []struct {
+		msg    *store.Message
+		status string
+	}{
+		{msg: passedMsg, status: "ok"},
+		{msg: passedMsg, status: "ok"},         // Redelivery.
+		{msg: passedMsg, status: "suspicious"}, // Conflict.
+		{msg: suspiciousMsg, status: "suspicious"},
+		{msg: suspiciousMsg, status: "suspicious"}, // Redelivery.
+		{msg: suspiciousMsg, status: "ok"},         // Conflict.
+		{msg: suspiciousMsg, status: "ok"},         // Redelivered conflict.
+	} {
+		messages = append(messages, kafka.Message{
+			Key: []byte(v.msg.ChatID.String()),
+			Value: []byte(s.encode(verdict{
+				ChatID:    v.msg.ChatID.String(),
+				MessageID: v.msg.ID.String(),
+				Status:    v.status,
+			})),
+		})
+	}
+
+	// Action.
+	cancel, errCh := s.runProcessor()
+	defer cancel()
+
+	for _, m := range messages {
+		// One by one to keep the order between the different chats.
+		err := s.verdictsProducer.WriteMessages(s.Ctx, m)
+		s.Require().NoError(err)
+	}
+
+	time.Sleep(time.Second) // For the last messages processing.
+
+	// Assert.
+	s.True(s.Database.Message(s.Ctx).GetX(s.Ctx, passedMsg.ID).IsVisibleForManager)
+	s.False(s.Database.Message(s.Ctx).GetX(s.Ctx, suspiciousMsg.ID).IsVisibleForManager)
+
+	s.Equal(1, s.Database.Job(s.Ctx).Query().CountX(s.Ctx))
+	s.Equal(1, s.Database.ModerationCase(s.Ctx).Query().CountX(s.Ctx))
+
+	conflicts := s.Database.VerdictConflict(s.Ctx).Query().AllX(s.Ctx)
+	s.Require().Len(conflicts, 2)
+	for _, c := range conflicts {
+		switch c.MessageID {
+		case passedMsg.ID:
+			s.EqualValues("ok", c.AppliedVerdict)
+			s.EqualValues("suspicious", c.ReceivedVerdict)
+		case suspiciousMsg.ID:
+			s.EqualValues("suspicious", c.AppliedVerdict)
+			s.EqualValues("ok", c.ReceivedVerdict)
+		default:
+			s.Failf("unexpected conflict", "message %v", c.MessageID)
+		}
+	}
+
+	cancel()
+	s.Require().NoError(<-errCh)
+}
+
+func (s *ServiceIntegrationSuite) createMessage() *store.Message {
+	s.T().Helper()
+
+	chat := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).SaveX(s.Ctx)
+	problem := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).SaveX(s.Ctx)
+
+	return s.Database.Message(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetProblemID(problem.ID).
+		SetAuthorID(types.NewUserID()).
+		SetIsVisibleForClient(true).
+		SetInitialRequestID(types.NewRequestID()).
+		SetBody("Hello!").
+		SaveX(s.Ctx)
+}
+
 func (s *ServiceIntegrationSuite) runProcessor() (context.CancelFunc, <-chan error) {
 	s.T().Helper()
 
@@ -0,0 +1,265 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	afcverdictsprocessor "github.com/zestagio/chat-service/internal/services/afc-verdicts-processor"
+	afcverdictsprocessormocks "github.com/zestagio/chat-service/internal/services/afc-verdicts-processor/mocks"
+	"github.com/zestagio/chat-service/internal/services/jwks"
+	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type ServiceKeySetSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	outboxSvc   *afcverdictsprocessormocks.MockoutboxService
+	msgRepo     *afcverdictsprocessormocks.MockmessagesRepository
+	consumer    *afcverdictsprocessormocks.MockKafkaReader
+	dlqProducer *afcverdictsprocessormocks.MockKafkaDLQWriter
+
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+	edKey  ed25519.PrivateKey
+	keySet *keySet
+}
+
+func TestServiceKeySetSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceKeySetSuite))
+}
+
+func (s *ServiceKeySetSuite) SetupSuite() {
+	s.ContextSuite.SetupSuite()
+
+	var err error
+
+	s.rsaKey, err = jwt.ParseRSAPrivateKeyFromPEM([]byte(privateKey))
+	s.Require().NoError(err)
+
+	s.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+
+	_, s.edKey, err = ed25519.GenerateKey(rand.Reader)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceKeySetSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+
+	s.ctrl = gomock.NewController(s.T())
+	s.outboxSvc = afcverdictsprocessormocks.NewMockoutboxService(s.ctrl)
+	s.msgRepo = afcverdictsprocessormocks.NewMockmessagesRepository(s.ctrl)
+	s.consumer = afcverdictsprocessormocks.NewMockKafkaReader(s.ctrl)
+	s.dlqProducer = afcverdictsprocessormocks.NewMockKafkaDLQWriter(s.ctrl)
+	s.keySet = &keySet{keys: map[string]jwks.Key{}}
+
+	// Always.
+	s.consumer.EXPECT().Close().Return(nil)
+	s.dlqProducer.EXPECT().Close().Return(nil)
+}
+
+func (s *ServiceKeySetSuite) TearDownTest() {
+	s.ContextSuite.TearDownTest()
+	s.ctrl.Finish()
+}
+
+func (s *ServiceKeySetSuite) TestRotationOverlap_AllPublishedKeysAccepted() {
+	// Arrange.
+	s.keySet.set("rsa-2023", jwks.Key{Alg: jwks.AlgRS256, PublicKey: s.rsaKey.Public()})
+	s.keySet.set("ec-2024", jwks.Key{Alg: jwks.AlgES256, PublicKey: s.ecKey.Public()})
+	s.keySet.set("ed-2024", jwks.Key{Alg: jwks.AlgEdDSA, PublicKey: s.edKey.Public()})
+
+	s.expectValid(s.sign(jwt.SigningMethodRS256, "rsa-2023", s.rsaKey))
+	s.expectValid(s.sign(jwt.SigningMethodES256, "ec-2024", s.ecKey))
+	s.expectValid(s.sign(jwt.SigningMethodEdDSA, "ed-2024", s.edKey))
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(s.newService(), 100*time.Millisecond)
+}
+
+func (s *ServiceKeySetSuite) TestRotation_RevokedKeyRejected() {
+	// Arrange.
+	svc := s.newService()
+
+	s.keySet.set("rsa-2023", jwks.Key{Alg: jwks.AlgRS256, PublicKey: s.rsaKey.Public()})
+	s.keySet.set("ec-2024", jwks.Key{Alg: jwks.AlgES256, PublicKey: s.ecKey.Public()})
+
+	// The old key is revoked after the overlap period.
+	s.keySet.delete("rsa-2023")
+
+	s.expectDLQ(s.sign(jwt.SigningMethodRS256, "rsa-2023", s.rsaKey))
+	s.expectValid(s.sign(jwt.SigningMethodES256, "ec-2024", s.ecKey))
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(svc, 100*time.Millisecond)
+}
+
+func (s *ServiceKeySetSuite) TestKeyAlgorithmMismatch() {
+	// Arrange.
+	s.keySet.set("key", jwks.Key{Alg: jwks.AlgES256, PublicKey: s.rsaKey.Public()})
+
+	s.expectDLQ(s.sign(jwt.SigningMethodRS256, "key", s.rsaKey))
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(s.newService(), 100*time.Millisecond)
+}
+
+func (s *ServiceKeySetSuite) TestNoKeyID_StaticKeyUsed() {
+	// Arrange.
+	s.keySet.set("ec-2024", jwks.Key{Alg: jwks.AlgES256, PublicKey: s.ecKey.Public()})
+
+	s.expectValid(s.sign(jwt.SigningMethodRS256, "", s.rsaKey))
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(s.newService(afcverdictsprocessor.WithVerdictsSignKey(publicKey)), 100*time.Millisecond)
+}
+
+func (s *ServiceKeySetSuite) TestNoKeyID_NoStaticKey() {
+	// Arrange.
+	s.keySet.set("ec-2024", jwks.Key{Alg: jwks.AlgES256, PublicKey: s.ecKey.Public()})
+
+	s.expectDLQ(s.sign(jwt.SigningMethodES256, "", s.ecKey))
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(s.newService(), 100*time.Millisecond)
+}
+
+func (s *ServiceKeySetSuite) TestUnsignedVerdictRejected() {
+	// Arrange.
+	s.keySet.set("ec-2024", jwks.Key{Alg: jwks.AlgES256, PublicKey: s.ecKey.Public()})
+
+	s.expectDLQ(s.sign(jwt.SigningMethodNone, "ec-2024", jwt.UnsafeAllowNoneSignatureType))
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(s.newService(), 100*time.Millisecond)
+}
+
+func (s *ServiceKeySetSuite) newService(opts ...afcverdictsprocessor.OptOptionsSetter) *afcverdictsprocessor.Service {
+	s.T().Helper()
+
+	txtor := afcverdictsprocessormocks.NewMocktransactor(s.ctrl)
+	txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		}).AnyTimes()
+
+	svc, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+		[]string{"test:9092"},
+		1,
+		"afcverdictsprocessor_test.ServiceKeySetSuite",
+		"afc.unit-test.verdicts",
+		func(_ []string, _ string, _ string) afcverdictsprocessor.KafkaReader {
+			return s.consumer
+		},
+		s.dlqProducer,
+		txtor,
+		s.msgRepo,
+		afcverdictsprocessormocks.NewMockmoderationRepository(s.ctrl),
+		s.outboxSvc,
+		append([]afcverdictsprocessor.OptOptionsSetter{
+			afcverdictsprocessor.WithVerdictsSignKeys(s.keySet),
+			afcverdictsprocessor.WithBackoffInitialInterval(backoffInitialInterval),
+			afcverdictsprocessor.WithBackoffMaxElapsedTime(backoffMaxElapsedTime),
+		}, opts...)...,
+	))
+	s.Require().NoError(err)
+
+	return svc
+}
+
+func (s *ServiceKeySetSuite) sign(method jwt.SigningMethod, kid string, key any) (types.MessageID, kafka.Message) {
+	s.T().Helper()
+
+	msgID := types.NewMessageID()
+	t := jwt.NewWithClaims(method, verdict{
+		ChatID:    types.NewChatID().String(),
+		MessageID: msgID.String(),
+		Status:    "ok",
+	})
+	if kid != "" {
+		t.Header["kid"] = kid
+	}
+
+	data, err := t.SignedString(key)
+	s.Require().NoError(err)
+
+	return msgID, kafka.Message{Value: []byte(data)}
+}
+
+func (s *ServiceKeySetSuite) expectValid(msgID types.MessageID, msg kafka.Message) {
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, messagesrepo.VerdictOK).Return(nil)
+	s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+}
+
+func (s *ServiceKeySetSuite) expectDLQ(_ types.MessageID, msg kafka.Message) {
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), kafkaMsgValueMatcher{msg.Value})
+}
+
+func (s *ServiceKeySetSuite) runProcessorFor(svc *afcverdictsprocessor.Service, timeout time.Duration) {
+	s.T().Helper()
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	time.Sleep(timeout)
+	cancel()
+	s.NoError(<-errCh)
+}
+
+type keySet struct {
+	mu   sync.RWMutex
+	keys map[string]jwks.Key
+}
+
+func (ks *keySet) Key(kid string) (jwks.Key, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	k, ok := ks.keys[kid]
+	return k, ok
+}
+
+func (ks *keySet) set(kid string, k jwks.Key) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	ks.keys[kid] = k
+}
+
+func (ks *keySet) delete(kid string) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	delete(ks.keys, kid)
+}
@@ -20,6 +20,7 @@ func NewOptions(
 	dlqWriter KafkaDLQWriter,
 	txtor transactor,
 	msgRepo messagesRepository,
+	moderationRepo moderationRepository,
 	outBox outboxService,
 	options ...OptOptionsSetter,
 ) Options {
@@ -48,6 +49,8 @@ func NewOptions(
 
 	o.msgRepo = msgRepo
 
+	o.moderationRepo = moderationRepo
+
 	o.outBox = outBox
 
 	for _, opt := range options {
@@ -70,6 +73,14 @@ func WithBackoffMaxElapsedTime(opt time.Duration) OptOptionsSetter {
 	}
 }
 
+func WithProcessBatchSize(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.processBatchSize = opt
+
+	}
+}
+
+// verdictsSignKey is a static RSA public key in PEM, used for the verdicts without "kid" header.
 func WithVerdictsSignKey(opt string) OptOptionsSetter {
 	return func(o *Options) {
 		o.verdictsSignKey = opt
@@ -77,9 +88,18 @@ func WithVerdictsSignKey(opt string) OptOptionsSetter {
 	}
 }
 
-func WithProcessBatchSize(opt int) OptOptionsSetter {
+// verdictsSignKeys is a rotated key set, the key is selected by the verdict's "kid" header.
+func WithVerdictsSignKeys(opt verdictsKeySet) OptOptionsSetter {
 	return func(o *Options) {
-		o.processBatchSize = opt
+		o.verdictsSignKeys = opt
+
+	}
+}
+
+// shadowFilter is the local content filter to compare its verdicts with AFC ones.
+func WithShadowFilter(opt contentFilter) OptOptionsSetter {
+	return func(o *Options) {
+		o.shadowFilter = opt
 
 	}
 }
@@ -97,6 +117,7 @@ func (o *Options) Validate() error {
 	errs.Add(errors461e464ebed9.NewValidationError("dlqWriter", _validate_Options_dlqWriter(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("moderationRepo", _validate_Options_moderationRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("outBox", _validate_Options_outBox(o)))
 	return errs.AsError()
 }
@@ -178,6 +199,13 @@ func _validate_Options_msgRepo(o *Options) error {
 	return nil
 }
 
+func _validate_Options_moderationRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.moderationRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `moderationRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_outBox(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.outBox, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `outBox` did not pass the test: %w", err)
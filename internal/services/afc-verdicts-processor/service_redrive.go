@@ -0,0 +1,72 @@
+package afcverdictsprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cenkalti/backoff"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// RedriveOutcome is the result of the DLQ message re-processing.
+type RedriveOutcome struct {
+	Partition int
+	Offset    int64
+	MessageID types.MessageID // Nil if the verdict was not parsed.
+	Err       error           // Nil if the message was processed successfully.
+}
+
+// Redrive re-runs the processing of the DLQ messages with the current code and
+// reports the outcome of each message. The messages failed again are written back to the DLQ.
+// Redrive is an alternative to Run, the service must not be used after it.
+func (s *Service) Redrive(ctx context.Context, dlqReader KafkaReader, report func(RedriveOutcome)) (errReturned error) {
+	defer multierr.AppendInvoke(&errReturned, multierr.Close(dlqReader))
+	defer multierr.AppendInvoke(&errReturned, multierr.Close(s.dlqWriter))
+
+	logger := s.logger.Named("redrive")
+	b := backoff.WithContext(s.newProcessMessageBackOff(), ctx)
+
+	for {
+		msg, err := dlqReader.FetchMessage(ctx)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("fetch message: %w", err)
+		}
+
+		logger := logger.With(zap.Int("partition", msg.Partition), zap.Int64("offset", msg.Offset))
+
+		outcome := RedriveOutcome{
+			Partition: msg.Partition,
+			Offset:    msg.Offset,
+		}
+		// The message is reported for the processed verdicts too, the decoding error is reported by the processing.
+		if v, err := s.decodeVerdict(msg); err == nil {
+			outcome.MessageID = v.MessageID
+		}
+
+		if err := s.processMessageWithRetries(ctx, b, msg, logger); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			outcome.Err = err
+
+			if err := s.dlqWriter.WriteMessages(ctx, newDLQMessage(erroredMessage{msg: msg, lastErr: err})); err != nil {
+				return fmt.Errorf("write msg back to dlq: %v", err)
+			}
+		}
+
+		if err := dlqReader.CommitMessages(ctx, msg); err != nil {
+			return fmt.Errorf("commit message: %v", err)
+		}
+
+		report(outcome)
+	}
+}
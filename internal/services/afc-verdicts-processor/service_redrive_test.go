@@ -0,0 +1,101 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"io"
+
+	"github.com/golang/mock/gomock"
+	"github.com/segmentio/kafka-go"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	afcverdictsprocessor "github.com/zestagio/chat-service/internal/services/afc-verdicts-processor"
+	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+func (s *ServiceSuite) TestRedrive() {
+	// Arrange.
+	fixedMsgID := types.NewMessageID()
+	fixed := kafka.Message{
+		Partition: 0,
+		Offset:    10,
+		Value: []byte(s.encode(verdict{
+			ChatID:    types.NewChatID().String(),
+			MessageID: fixedMsgID.String(),
+			Status:    "ok",
+		})),
+		Headers: []kafka.Header{
+			{Key: "LAST_ERROR", Value: []byte("connection refused")},
+			{Key: "ORIGINAL_PARTITION", Value: []byte("3")},
+		},
+	}
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(fixed, nil)
+	s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), fixedMsgID, types.RequestIDNil, messagesrepo.VerdictOK).Return(nil)
+	s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), fixed)
+
+	brokenMsgID := types.NewMessageID()
+	stillBroken := kafka.Message{
+		Partition: 0,
+		Offset:    11,
+		Value: []byte(s.encode(verdict{
+			ChatID:    types.NewChatID().String(),
+			MessageID: brokenMsgID.String(),
+			Status:    "unknown",
+		})),
+		Headers: []kafka.Header{
+			{Key: "X-Request-ID", Value: []byte("abc")},
+			{Key: "LAST_ERROR", Value: []byte("unknown verdict")},
+			{Key: "ORIGINAL_PARTITION", Value: []byte("5")},
+		},
+	}
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(stillBroken, nil)
+	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msgs ...kafka.Message) error {
+			s.Require().Len(msgs, 1)
+			s.Equal(stillBroken.Value, msgs[0].Value)
+			s.Require().Len(msgs[0].Headers, 3)
+			s.Equal("X-Request-ID", msgs[0].Headers[0].Key)
+			s.Equal("LAST_ERROR", msgs[0].Headers[1].Key)
+			s.Contains(string(msgs[0].Headers[1].Value), "unknown verdict")
+			s.Equal("ORIGINAL_PARTITION", msgs[0].Headers[2].Key)
+			s.Equal("5", string(msgs[0].Headers[2].Value))
+			return nil
+		})
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), stillBroken)
+
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF)
+
+	// Action.
+	var outcomes []afcverdictsprocessor.RedriveOutcome
+	err := s.svc.Redrive(s.Ctx, s.consumer, func(o afcverdictsprocessor.RedriveOutcome) {
+		outcomes = append(outcomes, o)
+	})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(outcomes, 2)
+
+	s.Equal(int64(10), outcomes[0].Offset)
+	s.Equal(fixedMsgID, outcomes[0].MessageID)
+	s.NoError(outcomes[0].Err)
+
+	s.Equal(int64(11), outcomes[1].Offset)
+	s.Equal(brokenMsgID, outcomes[1].MessageID)
+	s.Error(outcomes[1].Err)
+}
+
+func (s *ServiceSuite) TestRedrive_WriteBackError() {
+	// Arrange.
+	msg := kafka.Message{Offset: 1, Value: []byte("{")}
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), kafkaMsgValueMatcher{msg.Value}).Return(io.ErrClosedPipe)
+
+	// Action.
+	err := s.svc.Redrive(s.Ctx, s.consumer, func(afcverdictsprocessor.RedriveOutcome) {
+		s.Fail("unexpected report")
+	})
+
+	// Assert.
+	s.Require().Error(err)
+}
@@ -0,0 +1,35 @@
+package afcverdictsprocessor
+
+import (
+	"context"
+	"expvar"
+
+	"go.uber.org/zap"
+)
+
+// shadowComparisons counts the matches and mismatches of AFC and local content filter verdicts.
+var shadowComparisons = expvar.NewMap("prefilter_shadow_comparisons_total")
+
+// compareWithShadowFilter logs the difference between the AFC verdict and the local content filter one.
+// It never affects the verdict processing.
+func (s *Service) compareWithShadowFilter(ctx context.Context, v verdict, logger *zap.Logger) {
+	msg, err := s.msgRepo.GetMessageByID(ctx, v.MessageID)
+	if err != nil {
+		logger.Warn("shadow filter: get message error", zap.Stringer("message_id", v.MessageID), zap.Error(err))
+		return
+	}
+
+	res := s.shadowFilter.Check(msg.Body)
+	if string(res.Verdict) == string(v.Status) {
+		shadowComparisons.Add("match", 1)
+		return
+	}
+
+	shadowComparisons.Add("mismatch", 1)
+	logger.Warn("shadow filter: verdicts mismatch",
+		zap.Stringer("message_id", v.MessageID),
+		zap.String("afc_verdict", string(v.Status)),
+		zap.String("prefilter_verdict", string(res.Verdict)),
+		zap.String("prefilter_rule", res.Rule),
+	)
+}
@@ -0,0 +1,89 @@
+package afcverdictsprocessor_test
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/segmentio/kafka-go"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	afcverdictsprocessor "github.com/zestagio/chat-service/internal/services/afc-verdicts-processor"
+	afcverdictsprocessormocks "github.com/zestagio/chat-service/internal/services/afc-verdicts-processor/mocks"
+	contentfilter "github.com/zestagio/chat-service/internal/services/content-filter"
+	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+func (s *ServiceSuite) TestShadowFilter_VerdictsComparedWithoutAffectingProcessing() {
+	// Arrange.
+	shadowFilter := afcverdictsprocessormocks.NewMockcontentFilter(s.ctrl)
+	s.svc = s.newServiceWithShadowFilter(shadowFilter)
+
+	for _, tc := range []struct {
+		body  string
+		local contentfilter.Result
+	}{
+		{body: "Hello!", local: contentfilter.Result{Verdict: contentfilter.VerdictOK}},
+		{body: "Wire me", local: contentfilter.Result{Verdict: contentfilter.VerdictSuspicious, Rule: "scam"}},
+	} {
+		msgID := types.NewMessageID()
+
+		msg := kafka.Message{Value: []byte(s.encode(verdict{
+			ChatID:    types.NewChatID().String(),
+			MessageID: msgID.String(),
+			Status:    "ok",
+		}))}
+		s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+		s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{ID: msgID, Body: tc.body}, nil)
+		shadowFilter.EXPECT().Check(tc.body).Return(tc.local)
+		s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, messagesrepo.VerdictOK).Return(nil)
+		s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
+		s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+	}
+
+	// Shadow filter errors are ignored.
+	msgID := types.NewMessageID()
+	msg := kafka.Message{Value: []byte(s.encode(verdict{
+		ChatID:    types.NewChatID().String(),
+		MessageID: msgID.String(),
+		Status:    "ok",
+	}))}
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(nil, errors.New("unexpected"))
+	s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, messagesrepo.VerdictOK).Return(nil)
+	s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *ServiceSuite) newServiceWithShadowFilter(f *afcverdictsprocessormocks.MockcontentFilter) *afcverdictsprocessor.Service {
+	s.T().Helper()
+
+	svc, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+		[]string{"test:9092"},
+		1,
+		"afcverdictsprocessor_test.ServiceSuite",
+		"afc.unit-test.verdicts",
+		func(_ []string, _ string, _ string) afcverdictsprocessor.KafkaReader {
+			return s.consumer
+		},
+		s.dlqProducer,
+		s.transactor,
+		s.msgRepo,
+		s.modRepo,
+		s.outboxSvc,
+		afcverdictsprocessor.WithVerdictsSignKey(s.SignPubKey),
+		afcverdictsprocessor.WithShadowFilter(f),
+		afcverdictsprocessor.WithBackoffInitialInterval(backoffInitialInterval),
+		afcverdictsprocessor.WithBackoffMaxElapsedTime(backoffMaxElapsedTime),
+	))
+	s.Require().NoError(err)
+
+	return svc
+}
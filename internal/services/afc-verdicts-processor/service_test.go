@@ -13,9 +13,9 @@ import (
 	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/suite"
 
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
 	afcverdictsprocessor "github.com/zestagio/chat-service/internal/services/afc-verdicts-processor"
 	afcverdictsprocessormocks "github.com/zestagio/chat-service/internal/services/afc-verdicts-processor/mocks"
-	clientmessageblockedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-blocked"
 	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
 	"github.com/zestagio/chat-service/internal/testingh"
 	"github.com/zestagio/chat-service/internal/types"
@@ -35,6 +35,7 @@ type ServiceSuite struct {
 	ctrl        *gomock.Controller
 	outboxSvc   *afcverdictsprocessormocks.MockoutboxService
 	msgRepo     *afcverdictsprocessormocks.MockmessagesRepository
+	modRepo     *afcverdictsprocessormocks.MockmoderationRepository
 	transactor  *afcverdictsprocessormocks.Mocktransactor
 	consumer    *afcverdictsprocessormocks.MockKafkaReader
 	dlqProducer *afcverdictsprocessormocks.MockKafkaDLQWriter
@@ -62,6 +63,7 @@ func (s *ServiceSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.outboxSvc = afcverdictsprocessormocks.NewMockoutboxService(s.ctrl)
 	s.msgRepo = afcverdictsprocessormocks.NewMockmessagesRepository(s.ctrl)
+	s.modRepo = afcverdictsprocessormocks.NewMockmoderationRepository(s.ctrl)
 
 	s.transactor = afcverdictsprocessormocks.NewMocktransactor(s.ctrl)
 	s.transactor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
@@ -93,6 +95,7 @@ func (s *ServiceSuite) SetupTest() {
 		s.dlqProducer,
 		s.transactor,
 		s.msgRepo,
+		s.modRepo,
 		s.outboxSvc,
 		afcverdictsprocessor.WithVerdictsSignKey(s.SignPubKey),
 		afcverdictsprocessor.WithBackoffInitialInterval(backoffInitialInterval),
@@ -158,9 +161,9 @@ func (s *ServiceSuite) TestOperationRetriedWithSuccess() {
 	msg := kafka.Message{Value: data}
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(context.Canceled)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(context.Canceled)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(nil)
+	s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, messagesrepo.VerdictOK).Return(context.Canceled)
+	s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, messagesrepo.VerdictOK).Return(context.Canceled)
+	s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, messagesrepo.VerdictOK).Return(nil)
 	s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
 	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 
@@ -181,7 +184,8 @@ func (s *ServiceSuite) TestOperationBackoffExceeded() {
 	msg := kafka.Message{Value: data}
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(context.Canceled).AnyTimes()
+	s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, messagesrepo.VerdictOK).
+		Return(context.Canceled).AnyTimes()
 	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), kafkaMsgValueMatcher{data})
 
@@ -211,11 +215,13 @@ func (s *ServiceSuite) TestProcessMessagesWithoutErrors() {
 		msg := kafka.Message{Value: data}
 		s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
 		if v.Status == "ok" {
-			s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), types.MustParse[types.MessageID](v.MessageID)).Return(nil)
+			msgID := types.MustParse[types.MessageID](v.MessageID)
+			s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, messagesrepo.VerdictOK).Return(nil)
 			s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
 		} else {
-			s.msgRepo.EXPECT().BlockMessage(gomock.Any(), types.MustParse[types.MessageID](v.MessageID))
-			s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, gomock.Any(), gomock.Any())
+			msgID := types.MustParse[types.MessageID](v.MessageID)
+			s.msgRepo.EXPECT().ApplyAFCVerdict(gomock.Any(), msgID, types.RequestIDNil, messagesrepo.VerdictSuspicious).Return(nil)
+			s.modRepo.EXPECT().CreateIfNotExists(gomock.Any(), msgID).Return(types.NewModerationCaseID(), nil)
 		}
 		s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 	}
@@ -265,6 +271,7 @@ type verdict struct {
 	ChatID    string `json:"chatId"`
 	MessageID string `json:"messageId"`
 	Status    string `json:"status"`
+	RequestID string `json:"requestId,omitempty"`
 }
 
 func (v verdict) Valid() error { return nil }
@@ -0,0 +1,34 @@
+package afcverdictsprocessor
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+
+	"github.com/zestagio/chat-service/internal/tracing"
+	"github.com/zestagio/chat-service/pkg/msgheaders"
+)
+
+// withMessageTrace continues the trace started by msg-producer, if AFC passed the headers through.
+func withMessageTrace(ctx context.Context, msg kafka.Message, logger *zap.Logger) (context.Context, *zap.Logger) {
+	md := msgheaders.Parse(msg.Headers)
+	if md.RequestID != "" {
+		logger = logger.With(zap.String("request_id", md.RequestID))
+	}
+	if md.TraceParent == "" {
+		return ctx, logger
+	}
+
+	tp, err := tracing.Parse(md.TraceParent)
+	if err != nil {
+		logger.Warn("invalid trace parent", zap.Error(err))
+		return ctx, logger
+	}
+	tp = tp.Child()
+
+	return tracing.WithTraceParent(ctx, tp), logger.With(
+		zap.String("trace_id", tp.TraceIDString()),
+		zap.String("span_id", tp.SpanIDString()),
+	)
+}
@@ -0,0 +1,72 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/segmentio/kafka-go"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/tracing"
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/pkg/msgheaders"
+)
+
+func (s *ServiceSuite) TestTraceContinuedFromHeaders() {
+	// Arrange.
+	const (
+		traceID  = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentID = "00f067aa0ba902b7"
+	)
+
+	msgID := types.NewMessageID()
+	msg := s.newKafkaMessage(msgID, "ok")
+	msg.Headers = msgheaders.Metadata{
+		RequestID:   "4bf92f35-77b3-4da6-a3ce-929d0e0e4736",
+		TraceParent: "00-" + traceID + "-" + parentID + "-01",
+	}.Headers()
+
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.msgRepo.EXPECT().ApplyAFCVerdict(
+		traceCtxMatcher{traceID: traceID, notSpanID: parentID},
+		msgID,
+		types.MustParse[types.RequestID]("4bf92f35-77b3-4da6-a3ce-929d0e0e4736"),
+		messagesrepo.VerdictOK,
+	).Return(nil)
+	s.outboxSvc.EXPECT().Put(
+		traceCtxMatcher{traceID: traceID, notSpanID: parentID},
+		clientmessagesentjob.Name,
+		simpleid.MustMarshal(msgID),
+		gomock.Any(),
+	).Return(types.NewJobID(), nil)
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+var _ gomock.Matcher = traceCtxMatcher{}
+
+type traceCtxMatcher struct {
+	traceID   string
+	notSpanID string
+}
+
+func (m traceCtxMatcher) Matches(x any) bool {
+	ctx, ok := x.(context.Context)
+	if !ok {
+		return false
+	}
+
+	tp, ok := tracing.FromContext(ctx)
+	return ok && tp.TraceIDString() == m.traceID && tp.SpanIDString() != m.notSpanID
+}
+
+func (m traceCtxMatcher) String() string {
+	return "context with trace " + m.traceID
+}
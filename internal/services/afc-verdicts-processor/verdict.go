@@ -2,9 +2,11 @@ package afcverdictsprocessor
 
 import (
 	"github.com/golang-jwt/jwt"
+	"github.com/segmentio/kafka-go"
 
 	"github.com/zestagio/chat-service/internal/types"
 	"github.com/zestagio/chat-service/internal/validator"
+	"github.com/zestagio/chat-service/pkg/msgheaders"
 )
 
 type msgStatus string
@@ -20,8 +22,24 @@ type verdict struct {
 	ChatID    types.ChatID    `json:"chatId" validate:"required"`
 	MessageID types.MessageID `json:"messageId" validate:"required"`
 	Status    msgStatus       `json:"status" validate:"required"`
+	// RequestID is the request the checked content comes from (the message sending or the edit one).
+	RequestID types.RequestID `json:"requestId,omitempty"`
 }
 
 func (v verdict) Valid() error {
 	return validator.Validator.Struct(v)
 }
+
+// contentRequestID returns the request the verdict is made for.
+// The verdict without it falls back to the headers AFC copies from the checked message.
+func contentRequestID(v verdict, msg kafka.Message) types.RequestID {
+	if !v.RequestID.IsZero() {
+		return v.RequestID
+	}
+
+	reqID, err := types.Parse[types.RequestID](msgheaders.Parse(msg.Headers).RequestID)
+	if err != nil {
+		return types.RequestIDNil
+	}
+	return reqID
+}
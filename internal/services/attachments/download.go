@@ -0,0 +1,223 @@
+package attachments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// DownloadPath is the path of the download handler, ":id" is the attachment ID.
+const DownloadPath = "/attachments/:id"
+
+const (
+	queryExpires   = "expires"
+	querySignature = "signature"
+	queryVariant   = "variant"
+	queryViewer    = "viewer"
+
+	variantThumbnail = "thumbnail"
+)
+
+// URLs returns the signed URLs to download the attachment file and its thumbnail for the viewer.
+// The zero viewer is the consumer of the messages queue (AFC), it can download the files of any not deleted message.
+// The thumbnail URL is empty if the attachment has no thumbnail.
+func (s *Service) URLs(a messagesrepo.Attachment, viewerID types.UserID) (fileURL, thumbnailURL string) {
+	expires := s.now().Add(s.urlTTL).Unix()
+
+	fileURL = s.signedURL(a.ID, "", viewerID, expires)
+	if a.ThumbnailKey != "" {
+		thumbnailURL = s.signedURL(a.ID, variantThumbnail, viewerID, expires)
+	}
+	return fileURL, thumbnailURL
+}
+
+func (s *Service) signedURL(id types.AttachmentID, variant string, viewerID types.UserID, expires int64) string {
+	q := url.Values{}
+	q.Set(queryExpires, strconv.FormatInt(expires, 10))
+	q.Set(querySignature, s.signature(id, variant, viewerID, expires))
+	if variant != "" {
+		q.Set(queryVariant, variant)
+	}
+	if !viewerID.IsZero() {
+		q.Set(queryViewer, viewerID.String())
+	}
+	return s.publicURL + strings.Replace(DownloadPath, ":id", id.String(), 1) + "?" + q.Encode()
+}
+
+func (s *Service) signature(id types.AttachmentID, variant string, viewerID types.UserID, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(s.urlSecret))
+	_, _ = fmt.Fprintf(mac, "%s\n%s\n%s\n%d", id, variant, viewerID, expires)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) verify(id types.AttachmentID, variant string, viewerID types.UserID, expiresStr, signature string) bool {
+	expires, err := strconv.ParseInt(expiresStr, 10, 64)
+	if err != nil || s.now().Unix() > expires {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(s.signature(id, variant, viewerID, expires)))
+}
+
+// Download is the HTTP handler serving the files by the signed URLs. It requires no token,
+// so the URLs can be used in <img> tags and passed to AFC.
+// The viewer the URL was issued to must still be able to see the message of the attachment.
+// The errors are responded with the plain HTTP statuses instead of the API error format.
+func (s *Service) Download(eCtx echo.Context) error {
+	id, err := types.Parse[types.AttachmentID](eCtx.Param("id"))
+	if err != nil {
+		return eCtx.NoContent(http.StatusNotFound)
+	}
+
+	variant := eCtx.QueryParam(queryVariant)
+	if variant != "" && variant != variantThumbnail {
+		return eCtx.NoContent(http.StatusBadRequest)
+	}
+
+	var viewerID types.UserID
+	if v := eCtx.QueryParam(queryViewer); v != "" {
+		if viewerID, err = types.Parse[types.UserID](v); err != nil {
+			return eCtx.NoContent(http.StatusForbidden)
+		}
+	}
+
+	if !s.verify(id, variant, viewerID, eCtx.QueryParam(queryExpires), eCtx.QueryParam(querySignature)) {
+		return eCtx.NoContent(http.StatusForbidden)
+	}
+
+	ctx := eCtx.Request().Context()
+
+	a, err := s.attachmentsRepo.GetAttachmentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, messagesrepo.ErrAttachmentNotFound) {
+			return eCtx.NoContent(http.StatusNotFound)
+		}
+		return fmt.Errorf("get attachment: %v", err)
+	}
+
+	status, err := s.checkViewer(ctx, a, viewerID)
+	if err != nil {
+		return fmt.Errorf("check viewer: %v", err)
+	}
+	if status != http.StatusOK {
+		return eCtx.NoContent(status)
+	}
+
+	key, contentType := a.StorageKey, a.ContentType
+	if variant == variantThumbnail {
+		if a.ThumbnailKey == "" {
+			return eCtx.NoContent(http.StatusNotFound)
+		}
+		key, contentType = a.ThumbnailKey, thumbnailContentType(a.ContentType)
+	}
+
+	r, err := s.storage.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrObjectNotFound) {
+			s.logger.Warn("attachment file is missing", zap.Stringer("attachment_id", id), zap.String("key", key))
+			return eCtx.NoContent(http.StatusNotFound)
+		}
+		return fmt.Errorf("get file: %v", err)
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			s.logger.Warn("close file", zap.Error(err))
+		}
+	}()
+
+	disposition := "attachment"
+	if strings.HasPrefix(contentType, "image/") {
+		disposition = "inline"
+	}
+
+	h := eCtx.Response().Header()
+	h.Set(echo.HeaderContentDisposition, mime.FormatMediaType(disposition, map[string]string{"filename": a.FileName}))
+	h.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	h.Set("Cache-Control", "private, max-age="+strconv.Itoa(int(s.urlTTL/time.Second)))
+	if variant == "" {
+		h.Set(echo.HeaderContentLength, strconv.FormatInt(a.Size, 10))
+	}
+
+	return eCtx.Stream(http.StatusOK, contentType, r)
+}
+
+// checkViewer returns http.StatusOK if the viewer can see the attachment now. The URL could be issued
+// before the message was deleted or hidden by AFC, or before the chat was handed over to another manager.
+// The URLs are issued to the moderators for the messages of the pending moderation cases only.
+func (s *Service) checkViewer(ctx context.Context, a *messagesrepo.Attachment, viewerID types.UserID) (int, error) {
+	if a.MessageID.IsZero() {
+		// The attachment is not sent yet.
+		if viewerID != a.UploadedBy {
+			return http.StatusForbidden, nil
+		}
+		return http.StatusOK, nil
+	}
+
+	m, err := s.attachmentsRepo.GetMessageByID(ctx, a.MessageID)
+	if err != nil {
+		return 0, fmt.Errorf("get message: %v", err)
+	}
+	if !m.DeletedAt.IsZero() {
+		return http.StatusNotFound, nil
+	}
+	if viewerID.IsZero() {
+		return http.StatusOK, nil
+	}
+
+	clientID, err := s.chatsRepo.GetChatClient(ctx, m.ChatID)
+	if err != nil {
+		return 0, fmt.Errorf("get chat client: %v", err)
+	}
+	if viewerID == clientID {
+		if !m.IsVisibleForClient {
+			return http.StatusForbidden, nil
+		}
+		return http.StatusOK, nil
+	}
+
+	// The moderator deciding whether the suspicious message is shown to the manager.
+	pending, err := s.moderationRepo.HasPendingCase(ctx, m.ID)
+	if err != nil {
+		return 0, fmt.Errorf("check pending moderation case: %v", err)
+	}
+	if pending {
+		return http.StatusOK, nil
+	}
+
+	if !m.IsVisibleForManager {
+		return http.StatusForbidden, nil
+	}
+
+	// The manager of the message problem or the current manager of the chat.
+	p, err := s.problemsRepo.GetProblemByID(ctx, m.ProblemID)
+	if err != nil {
+		return 0, fmt.Errorf("get problem: %v", err)
+	}
+	if viewerID == p.ManagerID {
+		return http.StatusOK, nil
+	}
+
+	managerID, err := s.chatsRepo.GetChatManager(ctx, m.ChatID)
+	if err != nil && !errors.Is(err, chatsrepo.ErrChatWithoutManager) {
+		return 0, fmt.Errorf("get chat manager: %v", err)
+	}
+	if viewerID != managerID {
+		return http.StatusForbidden, nil
+	}
+	return http.StatusOK, nil
+}
@@ -0,0 +1,212 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package attachmentsmocks is a generated GoMock package.
+package attachmentsmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockattachmentsRepository is a mock of attachmentsRepository interface.
+type MockattachmentsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockattachmentsRepositoryMockRecorder
+}
+
+// MockattachmentsRepositoryMockRecorder is the mock recorder for MockattachmentsRepository.
+type MockattachmentsRepositoryMockRecorder struct {
+	mock *MockattachmentsRepository
+}
+
+// NewMockattachmentsRepository creates a new mock instance.
+func NewMockattachmentsRepository(ctrl *gomock.Controller) *MockattachmentsRepository {
+	mock := &MockattachmentsRepository{ctrl: ctrl}
+	mock.recorder = &MockattachmentsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockattachmentsRepository) EXPECT() *MockattachmentsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAttachment mocks base method.
+func (m *MockattachmentsRepository) CreateAttachment(ctx context.Context, a messagesrepo.Attachment) (*messagesrepo.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", ctx, a)
+	ret0, _ := ret[0].(*messagesrepo.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockattachmentsRepositoryMockRecorder) CreateAttachment(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockattachmentsRepository)(nil).CreateAttachment), ctx, a)
+}
+
+// GetAttachmentByID mocks base method.
+func (m *MockattachmentsRepository) GetAttachmentByID(ctx context.Context, id types.AttachmentID) (*messagesrepo.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentByID", ctx, id)
+	ret0, _ := ret[0].(*messagesrepo.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentByID indicates an expected call of GetAttachmentByID.
+func (mr *MockattachmentsRepositoryMockRecorder) GetAttachmentByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentByID", reflect.TypeOf((*MockattachmentsRepository)(nil).GetAttachmentByID), ctx, id)
+}
+
+// GetMessageByID mocks base method.
+func (m *MockattachmentsRepository) GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageByID", ctx, msgID)
+	ret0, _ := ret[0].(*messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageByID indicates an expected call of GetMessageByID.
+func (mr *MockattachmentsRepositoryMockRecorder) GetMessageByID(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockattachmentsRepository)(nil).GetMessageByID), ctx, msgID)
+}
+
+// MockchatsRepository is a mock of chatsRepository interface.
+type MockchatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockchatsRepositoryMockRecorder
+}
+
+// MockchatsRepositoryMockRecorder is the mock recorder for MockchatsRepository.
+type MockchatsRepositoryMockRecorder struct {
+	mock *MockchatsRepository
+}
+
+// NewMockchatsRepository creates a new mock instance.
+func NewMockchatsRepository(ctrl *gomock.Controller) *MockchatsRepository {
+	mock := &MockchatsRepository{ctrl: ctrl}
+	mock.recorder = &MockchatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockchatsRepository) EXPECT() *MockchatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetChatClient mocks base method.
+func (m *MockchatsRepository) GetChatClient(ctx context.Context, chatID types.ChatID) (types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatClient", ctx, chatID)
+	ret0, _ := ret[0].(types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatClient indicates an expected call of GetChatClient.
+func (mr *MockchatsRepositoryMockRecorder) GetChatClient(ctx, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatClient", reflect.TypeOf((*MockchatsRepository)(nil).GetChatClient), ctx, chatID)
+}
+
+// GetChatManager mocks base method.
+func (m *MockchatsRepository) GetChatManager(ctx context.Context, chatID types.ChatID) (types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatManager", ctx, chatID)
+	ret0, _ := ret[0].(types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatManager indicates an expected call of GetChatManager.
+func (mr *MockchatsRepositoryMockRecorder) GetChatManager(ctx, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatManager", reflect.TypeOf((*MockchatsRepository)(nil).GetChatManager), ctx, chatID)
+}
+
+// MockmoderationRepository is a mock of moderationRepository interface.
+type MockmoderationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmoderationRepositoryMockRecorder
+}
+
+// MockmoderationRepositoryMockRecorder is the mock recorder for MockmoderationRepository.
+type MockmoderationRepositoryMockRecorder struct {
+	mock *MockmoderationRepository
+}
+
+// NewMockmoderationRepository creates a new mock instance.
+func NewMockmoderationRepository(ctrl *gomock.Controller) *MockmoderationRepository {
+	mock := &MockmoderationRepository{ctrl: ctrl}
+	mock.recorder = &MockmoderationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmoderationRepository) EXPECT() *MockmoderationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// HasPendingCase mocks base method.
+func (m *MockmoderationRepository) HasPendingCase(ctx context.Context, msgID types.MessageID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPendingCase", ctx, msgID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPendingCase indicates an expected call of HasPendingCase.
+func (mr *MockmoderationRepositoryMockRecorder) HasPendingCase(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPendingCase", reflect.TypeOf((*MockmoderationRepository)(nil).HasPendingCase), ctx, msgID)
+}
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetProblemByID mocks base method.
+func (m *MockproblemsRepository) GetProblemByID(ctx context.Context, problemID types.ProblemID) (*problemsrepo.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProblemByID", ctx, problemID)
+	ret0, _ := ret[0].(*problemsrepo.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProblemByID indicates an expected call of GetProblemByID.
+func (mr *MockproblemsRepositoryMockRecorder) GetProblemByID(ctx, problemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblemByID", reflect.TypeOf((*MockproblemsRepository)(nil).GetProblemByID), ctx, problemID)
+}
@@ -0,0 +1,183 @@
+package attachments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/service_mock.gen.go -package=attachmentsmocks
+
+const (
+	serviceName = "attachments"
+
+	maxFileNameLen  = 255
+	defaultFileName = "file"
+	thumbnailSuffix = ".thumbnail"
+)
+
+var (
+	ErrFileTooLarge          = errors.New("file is too large")
+	ErrContentTypeNotAllowed = errors.New("content type is not allowed")
+)
+
+type attachmentsRepository interface {
+	CreateAttachment(ctx context.Context, a messagesrepo.Attachment) (*messagesrepo.Attachment, error)
+	GetAttachmentByID(ctx context.Context, id types.AttachmentID) (*messagesrepo.Attachment, error)
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+}
+
+type chatsRepository interface {
+	GetChatClient(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+	GetChatManager(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type moderationRepository interface {
+	HasPendingCase(ctx context.Context, msgID types.MessageID) (bool, error)
+}
+
+type problemsRepository interface {
+	GetProblemByID(ctx context.Context, problemID types.ProblemID) (*problemsrepo.Problem, error)
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	storage             Storage               `option:"mandatory" validate:"required"`
+	attachmentsRepo     attachmentsRepository `option:"mandatory" validate:"required"`
+	chatsRepo           chatsRepository       `option:"mandatory" validate:"required"`
+	moderationRepo      moderationRepository  `option:"mandatory" validate:"required"`
+	problemsRepo        problemsRepository    `option:"mandatory" validate:"required"`
+	maxFileSize         int64                 `option:"mandatory" validate:"min=1"`
+	allowedContentTypes []string              `option:"mandatory" validate:"min=1,dive,required"`
+	urlSecret           string                `option:"mandatory" validate:"min=16"`
+
+	// thumbnailSize is the max side of the image thumbnail in pixels.
+	thumbnailSize int           `default:"256" validate:"min=16,max=2048"`
+	urlTTL        time.Duration `default:"24h" validate:"min=1m,max=168h"`
+
+	// publicURL is the base of the download URLs, e.g. "https://chat.example.com".
+	// The URLs are relative if it is empty, AFC requires the absolute ones.
+	publicURL string `validate:"omitempty,url"`
+}
+
+// Service keeps the files attached to the messages and issues the signed URLs to download them.
+// The signed URL is bound to the viewer it is issued to, the download checks the viewer can still see the message.
+type Service struct {
+	Options
+	now    func() time.Time
+	logger *zap.Logger
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+
+	opts.publicURL = strings.TrimSuffix(opts.publicURL, "/")
+	return &Service{
+		Options: opts,
+		now:     time.Now,
+		logger:  zap.L().Named(serviceName),
+	}, nil
+}
+
+// Upload validates the file, puts it with its thumbnail into the storage and creates the attachment.
+// The attachment is not linked to any message until the message is sent.
+func (s *Service) Upload(
+	ctx context.Context,
+	chatID types.ChatID,
+	uploadedBy types.UserID,
+	fileName string,
+	data []byte,
+) (*messagesrepo.Attachment, error) {
+	if int64(len(data)) > s.maxFileSize {
+		return nil, fmt.Errorf("%w: %d bytes, max %d", ErrFileTooLarge, len(data), s.maxFileSize)
+	}
+
+	// The content type declared by the client is not trusted.
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil {
+		return nil, fmt.Errorf("detect content type: %v", err)
+	}
+	if !slices.Contains(s.allowedContentTypes, contentType) {
+		return nil, fmt.Errorf("%w: %s", ErrContentTypeNotAllowed, contentType)
+	}
+
+	id := types.NewAttachmentID()
+	key := chatID.String() + "/" + id.String()
+	if err := s.storage.Put(ctx, key, contentType, data); err != nil {
+		return nil, fmt.Errorf("put file: %v", err)
+	}
+
+	var thumbnailKey string
+	if hasThumbnail(contentType) {
+		thumbnailKey, err = s.putThumbnail(ctx, key, contentType, data)
+		if err != nil {
+			// The attachment is still downloadable, the thumbnail is optional.
+			s.logger.Warn("cannot make thumbnail", zap.Stringer("attachment_id", id), zap.Error(err))
+		}
+	}
+
+	a, err := s.attachmentsRepo.CreateAttachment(ctx, messagesrepo.Attachment{
+		ID:           id,
+		ChatID:       chatID,
+		UploadedBy:   uploadedBy,
+		FileName:     sanitizeFileName(fileName),
+		ContentType:  contentType,
+		Size:         int64(len(data)),
+		StorageKey:   key,
+		ThumbnailKey: thumbnailKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create attachment: %v", err)
+	}
+	return a, nil
+}
+
+func (s *Service) putThumbnail(ctx context.Context, key, contentType string, data []byte) (string, error) {
+	thumbnail, err := makeThumbnail(data, s.thumbnailSize)
+	if err != nil {
+		return "", err
+	}
+
+	thumbnailKey := key + thumbnailSuffix
+	if err := s.storage.Put(ctx, thumbnailKey, thumbnailContentType(contentType), thumbnail); err != nil {
+		return "", fmt.Errorf("put thumbnail: %v", err)
+	}
+	return thumbnailKey, nil
+}
+
+func sanitizeFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+
+	for len(name) > maxFileNameLen || !utf8.ValidString(name) {
+		name = strings.ToValidUTF8(name, "")
+		if len(name) > maxFileNameLen {
+			name = name[:maxFileNameLen]
+		}
+	}
+
+	if name == "" || name == "." || name == "/" {
+		return defaultFileName
+	}
+	return name
+}
@@ -0,0 +1,169 @@
+// Code generated by options-gen. DO NOT EDIT.
+package attachments
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	storage Storage,
+	attachmentsRepo attachmentsRepository,
+	chatsRepo chatsRepository,
+	moderationRepo moderationRepository,
+	problemsRepo problemsRepository,
+	maxFileSize int64,
+	allowedContentTypes []string,
+	urlSecret string,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.thumbnailSize = 256
+
+	o.urlTTL, _ = time.ParseDuration("24h")
+
+	o.storage = storage
+
+	o.attachmentsRepo = attachmentsRepo
+
+	o.chatsRepo = chatsRepo
+
+	o.moderationRepo = moderationRepo
+
+	o.problemsRepo = problemsRepo
+
+	o.maxFileSize = maxFileSize
+
+	o.allowedContentTypes = allowedContentTypes
+
+	o.urlSecret = urlSecret
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+// thumbnailSize is the max side of the image thumbnail in pixels.
+func WithThumbnailSize(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.thumbnailSize = opt
+
+	}
+}
+
+func WithUrlTTL(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.urlTTL = opt
+
+	}
+}
+
+// publicURL is the base of the download URLs, e.g. "https://chat.example.com".
+// The URLs are relative if it is empty, AFC requires the absolute ones.
+func WithPublicURL(opt string) OptOptionsSetter {
+	return func(o *Options) {
+		o.publicURL = opt
+
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("storage", _validate_Options_storage(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("attachmentsRepo", _validate_Options_attachmentsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("chatsRepo", _validate_Options_chatsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("moderationRepo", _validate_Options_moderationRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("maxFileSize", _validate_Options_maxFileSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("allowedContentTypes", _validate_Options_allowedContentTypes(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("urlSecret", _validate_Options_urlSecret(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("thumbnailSize", _validate_Options_thumbnailSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("urlTTL", _validate_Options_urlTTL(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("publicURL", _validate_Options_publicURL(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_storage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.storage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `storage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_attachmentsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.attachmentsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `attachmentsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_chatsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.chatsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `chatsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_moderationRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.moderationRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `moderationRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_problemsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.problemsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `problemsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_maxFileSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.maxFileSize, "min=1"); err != nil {
+		return fmt461e464ebed9.Errorf("field `maxFileSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_allowedContentTypes(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.allowedContentTypes, "min=1,dive,required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `allowedContentTypes` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_urlSecret(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.urlSecret, "min=16"); err != nil {
+		return fmt461e464ebed9.Errorf("field `urlSecret` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_thumbnailSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.thumbnailSize, "min=16,max=2048"); err != nil {
+		return fmt461e464ebed9.Errorf("field `thumbnailSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_urlTTL(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.urlTTL, "min=1m,max=168h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `urlTTL` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_publicURL(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.publicURL, "omitempty,url"); err != nil {
+		return fmt461e464ebed9.Errorf("field `publicURL` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,433 @@
+package attachments_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/services/attachments"
+	attachmentsmocks "github.com/zestagio/chat-service/internal/services/attachments/mocks"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const (
+	maxFileSize   = 1 << 20
+	thumbnailSize = 32
+	urlSecret     = "0123456789abcdef"
+)
+
+func TestService_Upload_Image(t *testing.T) {
+	// Arrange.
+	svc, m := newService(t)
+	chatID, userID := types.NewChatID(), types.NewUserID()
+	data := newPNG(t, 100, 50)
+
+	m.attachmentsRepo.EXPECT().CreateAttachment(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, a messagesrepo.Attachment) (*messagesrepo.Attachment, error) {
+			return &a, nil
+		})
+
+	// Action.
+	a, err := svc.Upload(context.Background(), chatID, userID, "../../photos/cat.png", data)
+
+	// Assert.
+	require.NoError(t, err)
+	assert.False(t, a.ID.IsZero())
+	assert.Equal(t, chatID, a.ChatID)
+	assert.Equal(t, userID, a.UploadedBy)
+	assert.Equal(t, "cat.png", a.FileName)
+	assert.Equal(t, "image/png", a.ContentType)
+	assert.EqualValues(t, len(data), a.Size)
+	assert.NotEmpty(t, a.StorageKey)
+	assert.NotEmpty(t, a.ThumbnailKey)
+
+	fileURL, thumbnailURL := svc.URLs(*a, userID)
+	require.NotEmpty(t, thumbnailURL)
+
+	m.attachmentsRepo.EXPECT().GetAttachmentByID(gomock.Any(), a.ID).Return(a, nil).Times(2)
+
+	resp := download(t, svc, fileURL)
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, data, resp.Body.Bytes())
+	assert.Equal(t, "image/png", resp.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, `inline; filename=cat.png`, resp.Header().Get(echo.HeaderContentDisposition))
+	assert.Equal(t, "nosniff", resp.Header().Get(echo.HeaderXContentTypeOptions))
+
+	resp = download(t, svc, thumbnailURL)
+	require.Equal(t, http.StatusOK, resp.Code)
+	thumbnail, err := png.Decode(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, thumbnailSize, thumbnailSize/2), thumbnail.Bounds())
+}
+
+func TestService_Upload_Document(t *testing.T) {
+	// Arrange.
+	svc, m := newService(t)
+	userID := types.NewUserID()
+	data := []byte("%PDF-1.7\n...")
+
+	m.attachmentsRepo.EXPECT().CreateAttachment(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, a messagesrepo.Attachment) (*messagesrepo.Attachment, error) {
+			return &a, nil
+		})
+
+	// Action.
+	a, err := svc.Upload(context.Background(), types.NewChatID(), userID, "", data)
+
+	// Assert.
+	require.NoError(t, err)
+	assert.Equal(t, "file", a.FileName)
+	assert.Equal(t, "application/pdf", a.ContentType)
+	assert.Empty(t, a.ThumbnailKey)
+
+	fileURL, thumbnailURL := svc.URLs(*a, userID)
+	assert.Empty(t, thumbnailURL)
+
+	m.attachmentsRepo.EXPECT().GetAttachmentByID(gomock.Any(), a.ID).Return(a, nil)
+
+	resp := download(t, svc, fileURL)
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, data, resp.Body.Bytes())
+	assert.Equal(t, `attachment; filename=file`, resp.Header().Get(echo.HeaderContentDisposition))
+}
+
+func TestService_Upload_Errors(t *testing.T) {
+	svc, _ := newService(t)
+
+	t.Run("too large", func(t *testing.T) {
+		_, err := svc.Upload(context.Background(), types.NewChatID(), types.NewUserID(), "big.png",
+			bytes.Repeat([]byte("x"), maxFileSize+1))
+		require.ErrorIs(t, err, attachments.ErrFileTooLarge)
+	})
+
+	t.Run("content type is not allowed", func(t *testing.T) {
+		// The declared file name does not matter.
+		_, err := svc.Upload(context.Background(), types.NewChatID(), types.NewUserID(), "cat.png",
+			[]byte("<html><script>alert(1)</script></html>"))
+		require.ErrorIs(t, err, attachments.ErrContentTypeNotAllowed)
+	})
+}
+
+func TestService_Download_Errors(t *testing.T) {
+	svc, m := newService(t)
+
+	a := messagesrepo.Attachment{
+		ID:          types.NewAttachmentID(),
+		ChatID:      types.NewChatID(),
+		UploadedBy:  types.NewUserID(),
+		FileName:    "doc.pdf",
+		ContentType: "application/pdf",
+		StorageKey:  "unknown",
+	}
+	fileURL, _ := svc.URLs(a, a.UploadedBy)
+
+	t.Run("tampered signature", func(t *testing.T) {
+		u := mustParseURL(t, fileURL)
+		q := u.Query()
+		q.Set("expires", q.Get("expires")+"0")
+		u.RawQuery = q.Encode()
+
+		resp := download(t, svc, u.String())
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+	})
+
+	t.Run("foreign attachment", func(t *testing.T) {
+		resp := download(t, svc, strings.Replace(fileURL, a.ID.String(), types.NewAttachmentID().String(), 1))
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+	})
+
+	t.Run("thumbnail variant is signed", func(t *testing.T) {
+		resp := download(t, svc, fileURL+"&variant=thumbnail")
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+	})
+
+	t.Run("viewer is signed", func(t *testing.T) {
+		resp := download(t, svc, strings.Replace(fileURL, a.UploadedBy.String(), types.NewUserID().String(), 1))
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+	})
+
+	t.Run("attachment not found", func(t *testing.T) {
+		m.attachmentsRepo.EXPECT().GetAttachmentByID(gomock.Any(), a.ID).Return(nil, messagesrepo.ErrAttachmentNotFound)
+
+		resp := download(t, svc, fileURL)
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		m.attachmentsRepo.EXPECT().GetAttachmentByID(gomock.Any(), a.ID).Return(&a, nil)
+
+		resp := download(t, svc, fileURL)
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+}
+
+func TestService_Download_Viewer(t *testing.T) {
+	svc, mocks := newService(t)
+	ctx := context.Background()
+	clientID, managerID, moderatorID := types.NewUserID(), types.NewUserID(), types.NewUserID()
+
+	mocks.attachmentsRepo.EXPECT().CreateAttachment(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, a messagesrepo.Attachment) (*messagesrepo.Attachment, error) {
+			return &a, nil
+		})
+	a, err := svc.Upload(ctx, types.NewChatID(), clientID, "doc.pdf", []byte("%PDF-1.7\n..."))
+	require.NoError(t, err)
+
+	t.Run("not sent attachment of other user", func(t *testing.T) {
+		mocks.attachmentsRepo.EXPECT().GetAttachmentByID(gomock.Any(), a.ID).Return(a, nil)
+
+		fileURL, _ := svc.URLs(*a, managerID)
+		resp := download(t, svc, fileURL)
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+	})
+
+	sent := *a
+	sent.MessageID = types.NewMessageID()
+	msg := messagesrepo.Message{
+		ID:                  sent.MessageID,
+		ChatID:              sent.ChatID,
+		ProblemID:           types.NewProblemID(),
+		AuthorID:            clientID,
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+	}
+
+	cases := []struct {
+		name      string
+		viewerID  types.UserID
+		msg       func(m messagesrepo.Message) messagesrepo.Message // Changes the message, nil to keep it.
+		arrange   func()
+		expStatus int
+	}{
+		{
+			name:      "AFC gets message not checked yet",
+			viewerID:  types.UserIDNil,
+			msg:       func(m messagesrepo.Message) messagesrepo.Message { m.IsVisibleForManager = false; return m },
+			expStatus: http.StatusOK,
+		},
+		{
+			name:      "deleted message",
+			viewerID:  clientID,
+			msg:       func(m messagesrepo.Message) messagesrepo.Message { m.DeletedAt = time.Now(); return m },
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:     "client of the chat",
+			viewerID: clientID,
+			arrange: func() {
+				mocks.chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name:     "client does not see internal note",
+			viewerID: clientID,
+			msg:      func(m messagesrepo.Message) messagesrepo.Message { m.IsVisibleForClient = false; return m },
+			arrange: func() {
+				mocks.chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+			},
+			expStatus: http.StatusForbidden,
+		},
+		{
+			name:     "manager of the message problem",
+			viewerID: managerID,
+			arrange: func() {
+				mocks.chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+				mocks.moderationRepo.EXPECT().HasPendingCase(gomock.Any(), msg.ID).Return(false, nil)
+				mocks.problemsRepo.EXPECT().GetProblemByID(gomock.Any(), msg.ProblemID).
+					Return(&problemsrepo.Problem{ID: msg.ProblemID, ManagerID: managerID}, nil)
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name:     "current manager of the chat",
+			viewerID: managerID,
+			arrange: func() {
+				mocks.chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+				mocks.moderationRepo.EXPECT().HasPendingCase(gomock.Any(), msg.ID).Return(false, nil)
+				mocks.problemsRepo.EXPECT().GetProblemByID(gomock.Any(), msg.ProblemID).
+					Return(&problemsrepo.Problem{ID: msg.ProblemID, ManagerID: types.NewUserID()}, nil)
+				mocks.chatsRepo.EXPECT().GetChatManager(gomock.Any(), msg.ChatID).Return(managerID, nil)
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name:     "chat was handed over to other manager",
+			viewerID: managerID,
+			arrange: func() {
+				mocks.chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+				mocks.moderationRepo.EXPECT().HasPendingCase(gomock.Any(), msg.ID).Return(false, nil)
+				mocks.problemsRepo.EXPECT().GetProblemByID(gomock.Any(), msg.ProblemID).
+					Return(&problemsrepo.Problem{ID: msg.ProblemID, ManagerID: types.NewUserID()}, nil)
+				mocks.chatsRepo.EXPECT().GetChatManager(gomock.Any(), msg.ChatID).Return(types.UserIDNil, chatsrepo.ErrChatWithoutManager)
+			},
+			expStatus: http.StatusForbidden,
+		},
+		{
+			name:     "manager does not see blocked message",
+			viewerID: managerID,
+			msg:      func(m messagesrepo.Message) messagesrepo.Message { m.IsVisibleForManager = false; return m },
+			arrange: func() {
+				mocks.chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+				mocks.moderationRepo.EXPECT().HasPendingCase(gomock.Any(), msg.ID).Return(false, nil)
+			},
+			expStatus: http.StatusForbidden,
+		},
+		{
+			name:     "moderator of pending case",
+			viewerID: moderatorID,
+			msg:      func(m messagesrepo.Message) messagesrepo.Message { m.IsVisibleForManager = false; return m },
+			arrange: func() {
+				mocks.chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+				mocks.moderationRepo.EXPECT().HasPendingCase(gomock.Any(), msg.ID).Return(true, nil)
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name:     "moderator after decision",
+			viewerID: moderatorID,
+			arrange: func() {
+				mocks.chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+				mocks.moderationRepo.EXPECT().HasPendingCase(gomock.Any(), msg.ID).Return(false, nil)
+				mocks.problemsRepo.EXPECT().GetProblemByID(gomock.Any(), msg.ProblemID).
+					Return(&problemsrepo.Problem{ID: msg.ProblemID, ManagerID: managerID}, nil)
+				mocks.chatsRepo.EXPECT().GetChatManager(gomock.Any(), msg.ChatID).Return(managerID, nil)
+			},
+			expStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange.
+			mm := msg
+			if tt.msg != nil {
+				mm = tt.msg(mm)
+			}
+			mocks.attachmentsRepo.EXPECT().GetAttachmentByID(gomock.Any(), sent.ID).Return(&sent, nil)
+			mocks.attachmentsRepo.EXPECT().GetMessageByID(gomock.Any(), sent.MessageID).Return(&mm, nil)
+			if tt.arrange != nil {
+				tt.arrange()
+			}
+			fileURL, _ := svc.URLs(sent, tt.viewerID)
+
+			// Action.
+			resp := download(t, svc, fileURL)
+
+			// Assert.
+			assert.Equal(t, tt.expStatus, resp.Code)
+		})
+	}
+}
+
+func TestService_URLs_PublicURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	storage, err := attachments.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	svc, err := attachments.New(attachments.NewOptions(
+		storage,
+		attachmentsmocks.NewMockattachmentsRepository(ctrl),
+		attachmentsmocks.NewMockchatsRepository(ctrl),
+		attachmentsmocks.NewMockmoderationRepository(ctrl),
+		attachmentsmocks.NewMockproblemsRepository(ctrl),
+		maxFileSize,
+		[]string{"image/png"},
+		urlSecret,
+		attachments.WithPublicURL("https://chat.example.com/"),
+	))
+	require.NoError(t, err)
+
+	id := types.NewAttachmentID()
+	fileURL, _ := svc.URLs(messagesrepo.Attachment{ID: id}, types.UserIDNil)
+	assert.True(t, strings.HasPrefix(fileURL, "https://chat.example.com/attachments/"+id.String()+"?"), fileURL)
+}
+
+type serviceMocks struct {
+	attachmentsRepo *attachmentsmocks.MockattachmentsRepository
+	chatsRepo       *attachmentsmocks.MockchatsRepository
+	moderationRepo  *attachmentsmocks.MockmoderationRepository
+	problemsRepo    *attachmentsmocks.MockproblemsRepository
+}
+
+func newService(t *testing.T) (*attachments.Service, serviceMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	m := serviceMocks{
+		attachmentsRepo: attachmentsmocks.NewMockattachmentsRepository(ctrl),
+		chatsRepo:       attachmentsmocks.NewMockchatsRepository(ctrl),
+		moderationRepo:  attachmentsmocks.NewMockmoderationRepository(ctrl),
+		problemsRepo:    attachmentsmocks.NewMockproblemsRepository(ctrl),
+	}
+
+	storage, err := attachments.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	svc, err := attachments.New(attachments.NewOptions(
+		storage,
+		m.attachmentsRepo,
+		m.chatsRepo,
+		m.moderationRepo,
+		m.problemsRepo,
+		maxFileSize,
+		[]string{"image/png", "application/pdf"},
+		urlSecret,
+		attachments.WithThumbnailSize(thumbnailSize),
+	))
+	require.NoError(t, err)
+
+	return svc, m
+}
+
+func download(t *testing.T, svc *attachments.Service, rawURL string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, rawURL, nil)
+	resp := httptest.NewRecorder()
+
+	e := echo.New()
+	e.GET(attachments.DownloadPath, svc.Download)
+	e.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func newPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 0xff, A: 0xff})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func mustParseURL(t *testing.T, rawURL string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return u
+}
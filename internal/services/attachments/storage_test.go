@@ -0,0 +1,126 @@
+package attachments_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zestagio/chat-service/internal/services/attachments"
+)
+
+func TestLocalStorage(t *testing.T) {
+	s, err := attachments.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	testStorage(t, s)
+
+	t.Run("invalid key", func(t *testing.T) {
+		for _, key := range []string{"../secret", "/etc/passwd", `a\..\..\b`, ""} {
+			err := s.Put(context.Background(), key, "text/plain", []byte("x"))
+			assert.Error(t, err, key)
+
+			_, err = s.Get(context.Background(), key)
+			assert.Error(t, err, key)
+		}
+	})
+}
+
+func TestS3Storage(t *testing.T) {
+	srv := newFakeS3(t, "attachments")
+
+	s, err := attachments.NewS3Storage(srv.URL, "us-east-1", "attachments", "access-key", "secret-key", time.Second)
+	require.NoError(t, err)
+	testStorage(t, s)
+
+	t.Run("invalid endpoint", func(t *testing.T) {
+		_, err := attachments.NewS3Storage("localhost:9000", "us-east-1", "b", "a", "s", time.Second)
+		assert.Error(t, err)
+	})
+}
+
+func testStorage(t *testing.T, s attachments.Storage) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("put and get", func(t *testing.T) {
+		const key = "chat/file name.txt"
+
+		require.NoError(t, s.Put(ctx, key, "text/plain", []byte("first")))
+		require.NoError(t, s.Put(ctx, key, "text/plain", []byte("second")))
+
+		r, err := s.Get(ctx, key)
+		require.NoError(t, err)
+		defer r.Close()
+
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "second", string(data))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := s.Get(ctx, "chat/unknown")
+		require.ErrorIs(t, err, attachments.ErrObjectNotFound)
+	})
+}
+
+// newFakeS3 starts the server keeping the objects of the single bucket in memory.
+// It checks the presence of the signature parts, not the signature itself.
+func newFakeS3(t *testing.T, bucket string) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=access-key/") ||
+			!strings.Contains(auth, "/us-east-1/s3/aws4_request") ||
+			!strings.Contains(auth, "SignedHeaders=") ||
+			!strings.Contains(auth, "Signature=") ||
+			r.Header.Get("X-Amz-Date") == "" ||
+			r.Header.Get("X-Amz-Content-Sha256") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		key, ok := strings.CutPrefix(r.URL.Path, "/"+bucket+"/")
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			objects[key] = data
+
+		case http.MethodGet:
+			data, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
@@ -0,0 +1,107 @@
+package attachments
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // Register GIF decoder.
+	"image/jpeg"
+	"image/png"
+)
+
+const (
+	thumbnailJPEGQuality = 80
+
+	// maxImagePixels protects from the decompression bombs.
+	maxImagePixels = 50_000_000
+)
+
+var errImageTooLarge = errors.New("image is too large")
+
+// hasThumbnail reports whether the thumbnail is made for the file of the content type.
+func hasThumbnail(contentType string) bool {
+	switch contentType {
+	case "image/gif", "image/jpeg", "image/png":
+		return true
+	}
+	return false
+}
+
+// thumbnailContentType returns the content type of the thumbnail of the file of the content type.
+// JPEG images keep the format, the others are converted to PNG to keep the transparency.
+func thumbnailContentType(contentType string) string {
+	if contentType == "image/jpeg" {
+		return "image/jpeg"
+	}
+	return "image/png"
+}
+
+// makeThumbnail downscales the image to fit the square with the maxSide side.
+// The smaller images are re-encoded only.
+func makeThumbnail(data []byte, maxSide int) ([]byte, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode config: %v", err)
+	}
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return nil, fmt.Errorf("%w: %dx%d", errImageTooLarge, cfg.Width, cfg.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode: %v", err)
+	}
+
+	w, h := fitInto(src.Bounds().Dx(), src.Bounds().Dy(), maxSide)
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	downscale(dst, src)
+
+	var buf bytes.Buffer
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: thumbnailJPEGQuality})
+	} else {
+		err = png.Encode(&buf, dst)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("encode: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func fitInto(w, h, maxSide int) (int, int) {
+	if w <= maxSide && h <= maxSide {
+		return w, h
+	}
+	if w >= h {
+		return maxSide, max(1, h*maxSide/w)
+	}
+	return max(1, w*maxSide/h), maxSide
+}
+
+// downscale fills dst with the box-filtered src: every dst pixel is the average of the src pixels it covers.
+func downscale(dst *image.RGBA, src image.Image) {
+	sb, db := src.Bounds(), dst.Bounds()
+	sw, sh, dw, dh := sb.Dx(), sb.Dy(), db.Dx(), db.Dy()
+
+	for y := 0; y < dh; y++ {
+		y0, y1 := sb.Min.Y+y*sh/dh, sb.Min.Y+max((y+1)*sh/dh, y*sh/dh+1)
+		for x := 0; x < dw; x++ {
+			x0, x1 := sb.Min.X+x*sw/dw, sb.Min.X+max((x+1)*sw/dw, x*sw/dw+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, b, a, n = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca), n+1
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i+0] = uint8(r / n >> 8)
+			dst.Pix[i+1] = uint8(g / n >> 8)
+			dst.Pix[i+2] = uint8(b / n >> 8)
+			dst.Pix[i+3] = uint8(a / n >> 8)
+		}
+	}
+}
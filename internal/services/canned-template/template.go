@@ -0,0 +1,58 @@
+package cannedtemplate
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Variables of the canned response body.
+const (
+	VarClientName  = "client_name"
+	VarManagerName = "manager_name"
+	VarCurrentDate = "current_date"
+)
+
+const currentDateLayout = "02.01.2006"
+
+var ErrUnknownVariable = errors.New("unknown template variable")
+
+var variableRe = regexp.MustCompile(`{{\s*([a-z_]+)\s*}}`)
+
+// Vars are the values of the template variables. The unknown names are rendered as empty strings.
+type Vars struct {
+	ClientName  string
+	ManagerName string
+	Now         time.Time
+}
+
+// Validate makes sure the body uses the known variables only.
+func Validate(body string) error {
+	for _, m := range variableRe.FindAllStringSubmatch(body, -1) {
+		switch m[1] {
+		case VarClientName, VarManagerName, VarCurrentDate:
+		default:
+			return fmt.Errorf("%w: %q", ErrUnknownVariable, m[1])
+		}
+	}
+	return nil
+}
+
+// Render substitutes the variables of the body, e.g. "Hello, {{client_name}}!".
+// The unknown variables are left as is, use Validate to reject them beforehand.
+func Render(body string, vars Vars) string {
+	rendered := variableRe.ReplaceAllStringFunc(body, func(v string) string {
+		switch variableRe.FindStringSubmatch(v)[1] {
+		case VarClientName:
+			return vars.ClientName
+		case VarManagerName:
+			return vars.ManagerName
+		case VarCurrentDate:
+			return vars.Now.Format(currentDateLayout)
+		}
+		return v
+	})
+	return strings.TrimSpace(rendered)
+}
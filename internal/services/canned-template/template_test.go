@@ -0,0 +1,70 @@
+package cannedtemplate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cannedtemplate "github.com/zestagio/chat-service/internal/services/canned-template"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{name: "no variables", body: "Hello!"},
+		{name: "known variables", body: "Hello, {{client_name}}! I am {{ manager_name }}, today is {{current_date}}."},
+		{name: "unknown variable", body: "Your balance is {{balance}}", wantErr: true},
+		{name: "not closed braces", body: "Braces {{ are allowed"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cannedtemplate.Validate(tt.body)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, cannedtemplate.ErrUnknownVariable)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	now := time.Date(2023, time.March, 8, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		body string
+		vars cannedtemplate.Vars
+		exp  string
+	}{
+		{
+			name: "all variables",
+			body: "Hello, {{client_name}}! I am {{ manager_name }}, today is {{current_date}}.",
+			vars: cannedtemplate.Vars{ClientName: "Eric", ManagerName: "Anna", Now: now},
+			exp:  "Hello, Eric! I am Anna, today is 08.03.2023.",
+		},
+		{
+			name: "unknown client name",
+			body: "{{client_name}} Thank you for waiting!",
+			vars: cannedtemplate.Vars{Now: now},
+			exp:  "Thank you for waiting!",
+		},
+		{
+			name: "unknown variable is kept",
+			body: "Your balance is {{balance}}",
+			vars: cannedtemplate.Vars{Now: now},
+			exp:  "Your balance is {{balance}}",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, cannedtemplate.Render(tt.body, tt.vars))
+		})
+	}
+}
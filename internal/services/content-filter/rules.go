@@ -0,0 +1,162 @@
+package contentfilter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/BurntSushi/toml"
+)
+
+var errInvalidRuleKind = errors.New("exactly one of regex, keywords or denylist expected")
+
+// Verdict has the same values as the AFC verdict status.
+type Verdict string
+
+const (
+	VerdictOK         Verdict = "ok"
+	VerdictSuspicious Verdict = "suspicious"
+)
+
+// Result is the outcome of the message body check.
+type Result struct {
+	Verdict Verdict
+	Rule    string // The name of the first matched rule, empty for VerdictOK.
+}
+
+// rulesFile is the rules file format:
+//
+//	[[rules]]
+//	name = "card-number"
+//	regex = '\b\d{16}\b'
+//
+//	[[rules]]
+//	name = "scam"
+//	keywords = ["wire me", "crypto wallet"] # Case-insensitive substrings.
+//
+//	[[rules]]
+//	name = "profanity"
+//	denylist = ["badword"] # Case-insensitive whole words.
+type rulesFile struct {
+	Rules []struct {
+		Name     string   `toml:"name"`
+		Regex    string   `toml:"regex"`
+		Keywords []string `toml:"keywords"`
+		Denylist []string `toml:"denylist"`
+	} `toml:"rules"`
+}
+
+type rule struct {
+	name     string
+	regex    *regexp.Regexp
+	keywords []string
+	denylist map[string]struct{}
+}
+
+// parseRules parses the rules in TOML format. Each rule must have exactly one of regex, keywords or denylist.
+func parseRules(data []byte) ([]rule, error) {
+	var f rulesFile
+	if err := toml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("decode: %v", err)
+	}
+
+	rules := make([]rule, 0, len(f.Rules))
+	names := make(map[string]struct{}, len(f.Rules))
+
+	for i, r := range f.Rules {
+		if r.Name == "" {
+			return nil, fmt.Errorf("rule #%d: no name", i)
+		}
+		if _, ok := names[r.Name]; ok {
+			return nil, fmt.Errorf("rule %q: duplicated name", r.Name)
+		}
+		names[r.Name] = struct{}{}
+
+		var kinds int
+		for _, set := range []bool{r.Regex != "", len(r.Keywords) > 0, len(r.Denylist) > 0} {
+			if set {
+				kinds++
+			}
+		}
+		if kinds != 1 {
+			return nil, fmt.Errorf("rule %q: %w", r.Name, errInvalidRuleKind)
+		}
+
+		rr := rule{name: r.Name}
+		switch {
+		case r.Regex != "":
+			re, err := regexp.Compile(r.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("rule %q: compile regex: %v", r.Name, err)
+			}
+			rr.regex = re
+
+		case len(r.Keywords) > 0:
+			for _, k := range r.Keywords {
+				if k = strings.TrimSpace(k); k != "" {
+					rr.keywords = append(rr.keywords, strings.ToLower(k))
+				}
+			}
+
+		case len(r.Denylist) > 0:
+			rr.denylist = make(map[string]struct{}, len(r.Denylist))
+			for _, w := range r.Denylist {
+				rr.denylist[strings.ToLower(strings.TrimSpace(w))] = struct{}{}
+			}
+		}
+		rules = append(rules, rr)
+	}
+
+	return rules, nil
+}
+
+func check(rules []rule, body string) Result {
+	lowered := strings.ToLower(body)
+
+	var words map[string]struct{}
+	for _, r := range rules {
+		if r.matches(body, lowered, &words) {
+			return Result{Verdict: VerdictSuspicious, Rule: r.name}
+		}
+	}
+	return Result{Verdict: VerdictOK}
+}
+
+func (r rule) matches(body, lowered string, words *map[string]struct{}) bool {
+	switch {
+	case r.regex != nil:
+		return r.regex.MatchString(body)
+
+	case len(r.keywords) > 0:
+		for _, k := range r.keywords {
+			if strings.Contains(lowered, k) {
+				return true
+			}
+		}
+
+	case len(r.denylist) > 0:
+		if *words == nil {
+			*words = splitWords(lowered)
+		}
+		for w := range r.denylist {
+			if _, ok := (*words)[w]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func splitWords(s string) map[string]struct{} {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	words := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		words[f] = struct{}{}
+	}
+	return words
+}
@@ -0,0 +1,98 @@
+package contentfilter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const serviceName = "content-filter"
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	rulesFile    string        `option:"mandatory" validate:"required"`
+	reloadPeriod time.Duration `default:"10s" validate:"min=10ms,max=1h"`
+}
+
+// Service is the in-process rule-based content filter.
+// It is used when AFC is unavailable and reloads the rules on the file change without the service restarting.
+type Service struct {
+	Options
+	rules   atomic.Pointer[[]rule]
+	modTime time.Time
+	size    int64
+	logger  *zap.Logger
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+
+	s := &Service{
+		Options: opts,
+		logger:  zap.L().Named(serviceName).With(zap.String("rules_file", opts.rulesFile)),
+	}
+	if _, err := s.reload(); err != nil {
+		return nil, fmt.Errorf("initial rules loading: %v", err)
+	}
+	return s, nil
+}
+
+// Check checks the message body against the rules.
+func (s *Service) Check(body string) Result {
+	return check(*s.rules.Load(), body)
+}
+
+func (s *Service) Run(ctx context.Context) error {
+	t := time.NewTicker(s.reloadPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+
+		// Keep using the previous rules until the file is fixed.
+		reloaded, err := s.reload()
+		if err != nil {
+			s.logger.Warn("reload rules error", zap.Error(err))
+			continue
+		}
+		if reloaded {
+			s.logger.Info("rules reloaded", zap.Int("count", len(*s.rules.Load())))
+		}
+	}
+}
+
+// reload loads the rules if the file was changed since the last loading.
+func (s *Service) reload() (bool, error) {
+	fi, err := os.Stat(s.rulesFile)
+	if err != nil {
+		return false, fmt.Errorf("stat: %v", err)
+	}
+	if fi.ModTime().Equal(s.modTime) && fi.Size() == s.size {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(s.rulesFile)
+	if err != nil {
+		return false, fmt.Errorf("read: %v", err)
+	}
+	// Do not parse the same broken file again.
+	s.modTime, s.size = fi.ModTime(), fi.Size()
+
+	rules, err := parseRules(data)
+	if err != nil {
+		return false, fmt.Errorf("parse: %v", err)
+	}
+
+	s.rules.Store(&rules)
+	return true, nil
+}
@@ -0,0 +1,57 @@
+// Code generated by options-gen. DO NOT EDIT.
+package contentfilter
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	rulesFile string,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.reloadPeriod, _ = time.ParseDuration("10s")
+
+	o.rulesFile = rulesFile
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithReloadPeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.reloadPeriod = opt
+
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("rulesFile", _validate_Options_rulesFile(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("reloadPeriod", _validate_Options_reloadPeriod(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_rulesFile(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.rulesFile, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `rulesFile` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_reloadPeriod(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.reloadPeriod, "min=10ms,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `reloadPeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
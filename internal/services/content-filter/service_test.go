@@ -0,0 +1,128 @@
+package contentfilter_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contentfilter "github.com/zestagio/chat-service/internal/services/content-filter"
+)
+
+const reloadPeriod = 50 * time.Millisecond
+
+const rules = `
+[[rules]]
+name = "card-number"
+regex = '\b\d{4}[ -]?\d{4}[ -]?\d{4}[ -]?\d{4}\b'
+
+[[rules]]
+name = "scam"
+keywords = ["Wire me", "crypto wallet"]
+
+[[rules]]
+name = "profanity"
+denylist = ["darn"]
+`
+
+func TestService_Check(t *testing.T) {
+	svc, _ := newService(t, rules)
+
+	cases := []struct {
+		body string
+		rule string
+	}{
+		{body: "Hello! I can't log in to the app", rule: ""},
+		{body: "My card is 4242 4242 4242 4242, help", rule: "card-number"},
+		{body: "please WIRE ME the money", rule: "scam"},
+		{body: "send it to my Crypto Wallet", rule: "scam"},
+		{body: "Darn, it's broken again!", rule: "profanity"},
+		{body: "darned app", rule: ""}, // Denylist matches whole words only.
+	}
+	for _, tc := range cases {
+		t.Run(tc.body, func(t *testing.T) {
+			res := svc.Check(tc.body)
+
+			if tc.rule == "" {
+				assert.Equal(t, contentfilter.Result{Verdict: contentfilter.VerdictOK}, res)
+			} else {
+				assert.Equal(t, contentfilter.Result{Verdict: contentfilter.VerdictSuspicious, Rule: tc.rule}, res)
+			}
+		})
+	}
+}
+
+func TestNew_InvalidRules(t *testing.T) {
+	cases := map[string]string{
+		"invalid toml":  `[[rules]`,
+		"no name":       "[[rules]]\nregex = 'a'",
+		"duplicate":     "[[rules]]\nname = 'a'\nregex = 'a'\n[[rules]]\nname = 'a'\nregex = 'b'",
+		"no kind":       "[[rules]]\nname = 'a'",
+		"several kinds": "[[rules]]\nname = 'a'\nregex = 'a'\nkeywords = ['b']",
+		"invalid regex": "[[rules]]\nname = 'a'\nregex = '('",
+	}
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rules.toml")
+			writeFile(t, path, data)
+
+			_, err := contentfilter.New(contentfilter.NewOptions(path))
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("no file", func(t *testing.T) {
+		_, err := contentfilter.New(contentfilter.NewOptions(filepath.Join(t.TempDir(), "unknown.toml")))
+		require.Error(t, err)
+	})
+}
+
+func TestService_HotReload(t *testing.T) {
+	// Arrange.
+	svc, path := newService(t, "[[rules]]\nname = 'a'\nkeywords = ['apple']")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	// Action & assert: the rules are replaced.
+	writeFile(t, path, "[[rules]]\nname = 'b'\nkeywords = ['banana']")
+	require.Eventually(t, func() bool {
+		return svc.Check("banana").Verdict == contentfilter.VerdictSuspicious
+	}, time.Second, reloadPeriod/5)
+	assert.Equal(t, contentfilter.VerdictOK, svc.Check("apple").Verdict)
+
+	// Action & assert: the broken file is ignored, the previous rules are used.
+	writeFile(t, path, "[[rules]]\nname = 'c'")
+	time.Sleep(3 * reloadPeriod)
+	assert.Equal(t, contentfilter.VerdictSuspicious, svc.Check("banana").Verdict)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func newService(t *testing.T, data string) (*contentfilter.Service, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "rules.toml")
+	writeFile(t, path, data)
+
+	svc, err := contentfilter.New(contentfilter.NewOptions(path, contentfilter.WithReloadPeriod(reloadPeriod)))
+	require.NoError(t, err)
+	return svc, path
+}
+
+func writeFile(t *testing.T, path string, data string) {
+	t.Helper()
+
+	// Write via rename to avoid reading of partially written file.
+	tmp := path + ".tmp"
+	require.NoError(t, os.WriteFile(tmp, []byte(data), 0o600))
+	require.NoError(t, os.Rename(tmp, path))
+}
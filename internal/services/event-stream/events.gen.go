@@ -1,4 +1,4 @@
-// Code generated by gonstructor --output=events.gen.go --type=NewMessageEvent --type=MessageSentEvent --type=MessageBlockedEvent --type=NewChatEvent --type=ChatClosedEvent; DO NOT EDIT.
+// Code generated by gonstructor --output=events.gen.go --type=NewMessageEvent --type=MessageSentEvent --type=MessageBlockedEvent --type=NewChatEvent --type=ChatClosedEvent --type=MessageEditedEvent --type=MessageDeletedEvent --type=ReactionsChangedEvent --type=ChatUpdatedEvent --type=RateProblemRequestEvent; DO NOT EDIT.
 
 package eventstream
 
@@ -17,16 +17,22 @@ func NewNewMessageEvent(
 	createdAt time.Time,
 	messageBody string,
 	isService bool,
+	attachments []Attachment,
+	replyTo *Quote,
+	isInternalNote bool,
 ) *NewMessageEvent {
 	return &NewMessageEvent{
-		EventID:     eventID,
-		RequestID:   requestID,
-		ChatID:      chatID,
-		MessageID:   messageID,
-		AuthorID:    authorID,
-		CreatedAt:   createdAt,
-		MessageBody: messageBody,
-		IsService:   isService,
+		EventID:        eventID,
+		RequestID:      requestID,
+		ChatID:         chatID,
+		MessageID:      messageID,
+		AuthorID:       authorID,
+		CreatedAt:      createdAt,
+		MessageBody:    messageBody,
+		IsService:      isService,
+		Attachments:    attachments,
+		ReplyTo:        replyTo,
+		IsInternalNote: isInternalNote,
 	}
 }
 
@@ -83,3 +89,89 @@ func NewChatClosedEvent(
 		CanTakeMoreProblems: canTakeMoreProblems,
 	}
 }
+
+func NewMessageEditedEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	messageID types.MessageID,
+	editedAt time.Time,
+	messageBody string,
+) *MessageEditedEvent {
+	return &MessageEditedEvent{
+		EventID:     eventID,
+		RequestID:   requestID,
+		ChatID:      chatID,
+		MessageID:   messageID,
+		EditedAt:    editedAt,
+		MessageBody: messageBody,
+	}
+}
+
+func NewMessageDeletedEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	messageID types.MessageID,
+	deletedAt time.Time,
+) *MessageDeletedEvent {
+	return &MessageDeletedEvent{
+		EventID:   eventID,
+		RequestID: requestID,
+		ChatID:    chatID,
+		MessageID: messageID,
+		DeletedAt: deletedAt,
+	}
+}
+
+func NewReactionsChangedEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	messageID types.MessageID,
+	reactions []Reaction,
+) *ReactionsChangedEvent {
+	return &ReactionsChangedEvent{
+		EventID:   eventID,
+		RequestID: requestID,
+		ChatID:    chatID,
+		MessageID: messageID,
+		Reactions: reactions,
+	}
+}
+
+func NewChatUpdatedEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	problemCreatedAt time.Time,
+	unreadCount int,
+	lastMessage *LastMessage,
+	waitingSince time.Time,
+) *ChatUpdatedEvent {
+	return &ChatUpdatedEvent{
+		EventID:          eventID,
+		RequestID:        requestID,
+		ChatID:           chatID,
+		ProblemCreatedAt: problemCreatedAt,
+		UnreadCount:      unreadCount,
+		LastMessage:      lastMessage,
+		WaitingSince:     waitingSince,
+	}
+}
+
+func NewRateProblemRequestEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	problemID types.ProblemID,
+	expiresAt time.Time,
+) *RateProblemRequestEvent {
+	return &RateProblemRequestEvent{
+		EventID:   eventID,
+		RequestID: requestID,
+		ChatID:    chatID,
+		ProblemID: problemID,
+		ExpiresAt: expiresAt,
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/zestagio/chat-service/internal/validator"
 )
 
-//go:generate gonstructor --output=events.gen.go --type=NewMessageEvent --type=MessageSentEvent --type=MessageBlockedEvent --type=NewChatEvent --type=ChatClosedEvent
+//go:generate gonstructor --output=events.gen.go --type=NewMessageEvent --type=MessageSentEvent --type=MessageBlockedEvent --type=NewChatEvent --type=ChatClosedEvent --type=MessageEditedEvent --type=MessageDeletedEvent --type=ReactionsChangedEvent --type=ChatUpdatedEvent --type=RateProblemRequestEvent
 
 type Event interface {
 	eventMarker()
@@ -26,12 +26,34 @@ type NewMessageEvent struct {
 	MessageID   types.MessageID `validate:"required"`
 	AuthorID    types.UserID    // Zero if IsService == true.
 	CreatedAt   time.Time       `validate:"required"`
-	MessageBody string          `validate:"required,max=3000"`
+	MessageBody string          `validate:"required_without=Attachments,max=3000"`
 	IsService   bool
+	Attachments []Attachment `validate:"max=10,dive"`
+	ReplyTo     *Quote       // Nil if the message is not a reply.
+
+	// IsInternalNote is true for the note of the managers, it is never sent to the client.
+	IsInternalNote bool
 }
 
 func (e NewMessageEvent) Validate() error { return validator.Validator.Struct(e) }
 
+// Quote is the short preview of the message replied to.
+type Quote struct {
+	MessageID types.MessageID `validate:"required"`
+	AuthorID  types.UserID    // Zero if the quoted message is a service one or is hidden from the receiver.
+	Preview   string          // Empty if the quoted message is deleted or is hidden from the receiver.
+}
+
+// Attachment is the file attached to the message. The URLs are signed and expire in some time.
+type Attachment struct {
+	ID           types.AttachmentID `validate:"required"`
+	FileName     string             `validate:"required"`
+	ContentType  string             `validate:"required"`
+	Size         int64
+	URL          string `validate:"required"`
+	ThumbnailURL string // Empty if the attachment has no thumbnail.
+}
+
 // MessageSentEvent indicates that the message was checked by AFC
 // and was sent to the manager. Two gray ticks.
 type MessageSentEvent struct {
@@ -76,3 +98,83 @@ type ChatClosedEvent struct {
 }
 
 func (e ChatClosedEvent) Validate() error { return validator.Validator.Struct(e) }
+
+// MessageEditedEvent indicates that the message body was changed by its author.
+type MessageEditedEvent struct {
+	event       `gonstructor:"-"`
+	EventID     types.EventID   `validate:"required"`
+	RequestID   types.RequestID `validate:"required"`
+	ChatID      types.ChatID    `validate:"required"`
+	MessageID   types.MessageID `validate:"required"`
+	EditedAt    time.Time       `validate:"required"`
+	MessageBody string          `validate:"required,max=3000"`
+}
+
+func (e MessageEditedEvent) Validate() error { return validator.Validator.Struct(e) }
+
+// MessageDeletedEvent indicates that the message was deleted and its body is not available anymore.
+type MessageDeletedEvent struct {
+	event     `gonstructor:"-"`
+	EventID   types.EventID   `validate:"required"`
+	RequestID types.RequestID `validate:"required"`
+	ChatID    types.ChatID    `validate:"required"`
+	MessageID types.MessageID `validate:"required"`
+	DeletedAt time.Time       `validate:"required"`
+}
+
+func (e MessageDeletedEvent) Validate() error { return validator.Validator.Struct(e) }
+
+// ReactionsChangedEvent carries the actual reactions on the message after somebody has put or taken off one.
+// The reactions are personalized for the event receiver.
+type ReactionsChangedEvent struct {
+	event     `gonstructor:"-"`
+	EventID   types.EventID   `validate:"required"`
+	RequestID types.RequestID `validate:"required"`
+	ChatID    types.ChatID    `validate:"required"`
+	MessageID types.MessageID `validate:"required"`
+	Reactions []Reaction      `validate:"dive"` // Empty if the last reaction was taken off.
+}
+
+func (e ReactionsChangedEvent) Validate() error { return validator.Validator.Struct(e) }
+
+// Reaction is the number of the message reactions of the same kind.
+type Reaction struct {
+	Kind        string `validate:"required"`
+	Count       int    `validate:"min=1"`
+	ReactedByMe bool
+}
+
+// ChatUpdatedEvent carries the actual state of the chat in the manager chat list,
+// e.g. after a new message has appeared in the chat.
+type ChatUpdatedEvent struct {
+	event            `gonstructor:"-"`
+	EventID          types.EventID   `validate:"required"`
+	RequestID        types.RequestID `validate:"required"`
+	ChatID           types.ChatID    `validate:"required"`
+	ProblemCreatedAt time.Time       `validate:"required"`
+	UnreadCount      int             `validate:"min=0"`
+	LastMessage      *LastMessage    // Nil if there are no messages yet.
+	WaitingSince     time.Time       // Zero if the client is not waiting for the answer.
+}
+
+func (e ChatUpdatedEvent) Validate() error { return validator.Validator.Struct(e) }
+
+// RateProblemRequestEvent asks the client to rate the resolved problem until ExpiresAt.
+type RateProblemRequestEvent struct {
+	event     `gonstructor:"-"`
+	EventID   types.EventID   `validate:"required"`
+	RequestID types.RequestID `validate:"required"`
+	ChatID    types.ChatID    `validate:"required"`
+	ProblemID types.ProblemID `validate:"required"`
+	ExpiresAt time.Time       `validate:"required"`
+}
+
+func (e RateProblemRequestEvent) Validate() error { return validator.Validator.Struct(e) }
+
+// LastMessage is a short preview of the last message in the chat.
+type LastMessage struct {
+	MessageID types.MessageID `validate:"required"`
+	AuthorID  types.UserID    // Zero if the message is a service one.
+	Preview   string
+	CreatedAt time.Time `validate:"required"`
+}
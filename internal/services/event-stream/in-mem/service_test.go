@@ -269,5 +269,8 @@ func newMessageEvent(body string) eventstream.Event {
 		time.Now(),
 		body,
 		false,
+		nil,
+		nil,
+		false,
 	)
 }
@@ -0,0 +1,206 @@
+package jwks
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"go.uber.org/zap"
+)
+
+const (
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
+	AlgEdDSA = "EdDSA"
+)
+
+var (
+	errNoKeyID            = errors.New("no key id")
+	errUnsupportedKeyType = errors.New("unsupported key type")
+	errUnsupportedCurve   = errors.New("unsupported curve")
+	errAlgMismatch        = errors.New("algorithm does not match key type")
+)
+
+// Key is the public part of the signing key.
+type Key struct {
+	// Alg is the only signing algorithm allowed for the key.
+	Alg string
+
+	// PublicKey is one of *rsa.PublicKey, *ecdsa.PublicKey or ed25519.PublicKey.
+	PublicKey crypto.PublicKey
+}
+
+type jwkSet struct {
+	Keys []json.RawMessage `json:"keys"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+
+	// RSA.
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC and OKP.
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Parse parses JSON Web Key Set (RFC 7517) and returns signature verification keys by their ids.
+// Keys intended for encryption and keys of unsupported types, curves or algorithms are skipped,
+// so the set may contain the keys for the other consumers. Only the malformed supported key is an error.
+func Parse(data []byte) (map[string]Key, error) {
+	var set jwkSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("unmarshal jwks: %v", err)
+	}
+
+	keys := make(map[string]Key, len(set.Keys))
+	for i, raw := range set.Keys {
+		var k jwk
+		if err := json.Unmarshal(raw, &k); err != nil {
+			return nil, fmt.Errorf("unmarshal key #%d: %v", i, err)
+		}
+
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.parse()
+		if errors.Is(err, errUnsupportedKeyType) || errors.Is(err, errUnsupportedCurve) || errors.Is(err, errAlgMismatch) {
+			zap.L().Named(serviceName).Info("key skipped", zap.Int("index", i), zap.String("kid", k.Kid), zap.Error(err))
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse key #%d (kid %q): %v", i, k.Kid, err)
+		}
+
+		if _, ok := keys[k.Kid]; ok {
+			return nil, fmt.Errorf("duplicated kid %q", k.Kid)
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k jwk) parse() (Key, error) {
+	var (
+		alg   string
+		parse func() (Key, error)
+	)
+
+	switch k.Kty {
+	case "RSA":
+		alg, parse = AlgRS256, k.parseRSA
+	case "EC":
+		alg, parse = AlgES256, k.parseEC
+	case "OKP":
+		alg, parse = AlgEdDSA, k.parseOKP
+	default:
+		return Key{}, fmt.Errorf("%w: %q", errUnsupportedKeyType, k.Kty)
+	}
+
+	// The key of the other algorithm is not ours, its material is not validated.
+	if k.Alg != "" && k.Alg != alg {
+		return Key{}, fmt.Errorf("%w: %q for %q", errAlgMismatch, k.Alg, k.Kty)
+	}
+
+	key, err := parse()
+	if err != nil {
+		return Key{}, err
+	}
+
+	if k.Kid == "" {
+		return Key{}, errNoKeyID
+	}
+	return key, nil
+}
+
+func (k jwk) parseRSA() (Key, error) {
+	n, err := decodeBase64URL(k.N)
+	if err != nil {
+		return Key{}, fmt.Errorf("decode modulus: %v", err)
+	}
+	e, err := decodeBase64URL(k.E)
+	if err != nil {
+		return Key{}, fmt.Errorf("decode exponent: %v", err)
+	}
+
+	eInt := new(big.Int).SetBytes(e)
+	if !eInt.IsInt64() || eInt.Int64() < 3 || eInt.Int64() > 1<<31-1 {
+		return Key{}, errors.New("invalid exponent")
+	}
+
+	pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(eInt.Int64())}
+	if pub.N.BitLen() < 2048 {
+		return Key{}, fmt.Errorf("too short modulus: %d bits", pub.N.BitLen())
+	}
+	return Key{Alg: AlgRS256, PublicKey: pub}, nil
+}
+
+func (k jwk) parseEC() (Key, error) {
+	if k.Crv != "P-256" {
+		return Key{}, fmt.Errorf("%w: %q", errUnsupportedCurve, k.Crv)
+	}
+
+	x, err := decodeBase64URL(k.X)
+	if err != nil {
+		return Key{}, fmt.Errorf("decode x: %v", err)
+	}
+	y, err := decodeBase64URL(k.Y)
+	if err != nil {
+		return Key{}, fmt.Errorf("decode y: %v", err)
+	}
+
+	const coordSize = 32
+	if len(x) != coordSize || len(y) != coordSize {
+		return Key{}, errors.New("invalid coordinates length")
+	}
+
+	// Uncompressed point encoding, crypto/ecdh validates that the point is on the curve.
+	if _, err := ecdh.P256().NewPublicKey(bytes.Join([][]byte{{4}, x, y}, nil)); err != nil {
+		return Key{}, fmt.Errorf("invalid point: %v", err)
+	}
+
+	ecdsaKey := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}
+	return Key{Alg: AlgES256, PublicKey: ecdsaKey}, nil
+}
+
+func (k jwk) parseOKP() (Key, error) {
+	if k.Crv != "Ed25519" {
+		return Key{}, fmt.Errorf("%w: %q", errUnsupportedCurve, k.Crv)
+	}
+
+	x, err := decodeBase64URL(k.X)
+	if err != nil {
+		return Key{}, fmt.Errorf("decode x: %v", err)
+	}
+	if len(x) != ed25519.PublicKeySize {
+		return Key{}, errors.New("invalid key length")
+	}
+	return Key{Alg: AlgEdDSA, PublicKey: ed25519.PublicKey(x)}, nil
+}
+
+func decodeBase64URL(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("empty value")
+	}
+	return base64.RawURLEncoding.DecodeString(s)
+}
@@ -0,0 +1,183 @@
+package jwks_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zestagio/chat-service/internal/services/jwks"
+)
+
+func TestParse(t *testing.T) {
+	rsaKey := newRSAKey(t)
+	ecKey := newECKey(t)
+	edKey := newEdKey(t)
+
+	t.Run("all supported key types", func(t *testing.T) {
+		data := marshalJWKS(t,
+			toJWK(t, "rsa-1", rsaKey.Public()),
+			toJWK(t, "ec-1", ecKey.Public()),
+			toJWK(t, "ed-1", edKey.Public()),
+		)
+
+		keys, err := jwks.Parse(data)
+		require.NoError(t, err)
+		require.Len(t, keys, 3)
+
+		assert.Equal(t, jwks.AlgRS256, keys["rsa-1"].Alg)
+		assert.True(t, rsaKey.PublicKey.Equal(keys["rsa-1"].PublicKey))
+
+		assert.Equal(t, jwks.AlgES256, keys["ec-1"].Alg)
+		assert.True(t, ecKey.PublicKey.Equal(keys["ec-1"].PublicKey))
+
+		assert.Equal(t, jwks.AlgEdDSA, keys["ed-1"].Alg)
+		assert.True(t, edKey.Public().(ed25519.PublicKey).Equal(keys["ed-1"].PublicKey))
+	})
+
+	t.Run("encryption and unknown keys are skipped", func(t *testing.T) {
+		encKey := toJWK(t, "rsa-enc", rsaKey.Public())
+		encKey["use"] = "enc"
+
+		data := marshalJWKS(t,
+			encKey,
+			map[string]string{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"},
+			toJWK(t, "ed-1", edKey.Public()),
+		)
+
+		keys, err := jwks.Parse(data)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Contains(t, keys, "ed-1")
+	})
+
+	t.Run("keys of unsupported curves and algorithms are skipped", func(t *testing.T) {
+		ecKey384 := toJWK(t, "ec-384", ecKey.Public())
+		ecKey384["crv"] = "P-384"
+
+		rsaKey512 := toJWK(t, "rsa-512", rsaKey.Public())
+		rsaKey512["alg"] = "RS512"
+		rsaKey512["n"] = "not-validated"
+
+		data := marshalJWKS(t,
+			ecKey384,
+			rsaKey512,
+			toJWK(t, "ed-1", edKey.Public()),
+		)
+
+		keys, err := jwks.Parse(data)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Contains(t, keys, "ed-1")
+	})
+
+	t.Run("malformed supported key", func(t *testing.T) {
+		k := toJWK(t, "rsa-1", rsaKey.Public())
+		k["alg"] = "RS256"
+		k["n"] = "!"
+
+		_, err := jwks.Parse(marshalJWKS(t, k))
+		require.Error(t, err)
+	})
+
+	t.Run("kid is required", func(t *testing.T) {
+		_, err := jwks.Parse(marshalJWKS(t, toJWK(t, "", edKey.Public())))
+		require.Error(t, err)
+	})
+
+	t.Run("duplicated kid", func(t *testing.T) {
+		_, err := jwks.Parse(marshalJWKS(t,
+			toJWK(t, "key", edKey.Public()),
+			toJWK(t, "key", ecKey.Public()),
+		))
+		require.Error(t, err)
+	})
+
+	t.Run("point is not on curve", func(t *testing.T) {
+		k := toJWK(t, "ec-1", ecKey.Public())
+		k["y"] = k["x"]
+
+		_, err := jwks.Parse(marshalJWKS(t, k))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := jwks.Parse([]byte(`{"keys": [`))
+		require.Error(t, err)
+	})
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return k
+}
+
+func newECKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return k
+}
+
+func newEdKey(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+
+	_, k, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return k
+}
+
+func toJWK(t *testing.T, kid string, pub crypto.PublicKey) map[string]string {
+	t.Helper()
+
+	b64 := base64.RawURLEncoding.EncodeToString
+
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"n":   b64(k.N.Bytes()),
+			"e":   b64(big.NewInt(int64(k.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		return map[string]string{
+			"kty": "EC",
+			"kid": kid,
+			"crv": "P-256",
+			"x":   b64(k.X.FillBytes(make([]byte, 32))),
+			"y":   b64(k.Y.FillBytes(make([]byte, 32))),
+		}
+	case ed25519.PublicKey:
+		return map[string]string{
+			"kty": "OKP",
+			"kid": kid,
+			"crv": "Ed25519",
+			"x":   b64(k),
+		}
+	}
+
+	t.Fatalf("unsupported key type: %T", pub)
+	return nil
+}
+
+func marshalJWKS(t *testing.T, keys ...map[string]string) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,123 @@
+package jwks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	serviceName = "jwks"
+
+	maxJWKSSize = 1 << 20
+)
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	// source is a path to local JWKS file or http(s) URL.
+	source        string        `option:"mandatory" validate:"required"`
+	refreshPeriod time.Duration `default:"5m" validate:"min=10ms,max=24h"`
+	httpClient    *http.Client
+}
+
+// Service keeps the key set loaded from the source and refreshes it periodically.
+// It allows to rotate the signing keys without the service restarting:
+// the new key is published in advance and the old one is removed after the rotation.
+type Service struct {
+	Options
+	keys   atomic.Pointer[map[string]Key]
+	logger *zap.Logger
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+
+	if opts.httpClient == nil {
+		opts.httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	s := &Service{
+		Options: opts,
+		logger:  zap.L().Named(serviceName).With(zap.String("source", opts.source)),
+	}
+	// Fail fast: the service is useless without keys.
+	if err := s.refresh(context.Background()); err != nil {
+		return nil, fmt.Errorf("initial keys loading: %v", err)
+	}
+	return s, nil
+}
+
+// Key returns the key by its id.
+func (s *Service) Key(kid string) (Key, bool) {
+	k, ok := (*s.keys.Load())[kid]
+	return k, ok
+}
+
+func (s *Service) Run(ctx context.Context) error {
+	t := time.NewTicker(s.refreshPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+
+		// Keep serving the previous keys until the source recovery.
+		if err := s.refresh(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Warn("refresh keys error", zap.Error(err))
+		}
+	}
+}
+
+func (s *Service) refresh(ctx context.Context) error {
+	data, err := s.load(ctx)
+	if err != nil {
+		return fmt.Errorf("load: %v", err)
+	}
+
+	keys, err := Parse(data)
+	if err != nil {
+		return fmt.Errorf("parse: %v", err)
+	}
+	if len(keys) == 0 {
+		return errors.New("no signing keys found")
+	}
+
+	s.keys.Store(&keys)
+	s.logger.Debug("keys refreshed", zap.Int("count", len(keys)))
+	return nil
+}
+
+func (s *Service) load(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(s.source, "http://") && !strings.HasPrefix(s.source, "https://") {
+		return os.ReadFile(s.source)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.source, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %v", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+}
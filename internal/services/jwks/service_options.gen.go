@@ -0,0 +1,65 @@
+// Code generated by options-gen. DO NOT EDIT.
+package jwks
+
+import (
+	fmt461e464ebed9 "fmt"
+	"net/http"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	source string,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.refreshPeriod, _ = time.ParseDuration("5m")
+
+	o.source = source
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithRefreshPeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.refreshPeriod = opt
+
+	}
+}
+
+func WithHttpClient(opt *http.Client) OptOptionsSetter {
+	return func(o *Options) {
+		o.httpClient = opt
+
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("source", _validate_Options_source(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("refreshPeriod", _validate_Options_refreshPeriod(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_source(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.source, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `source` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_refreshPeriod(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.refreshPeriod, "min=10ms,max=24h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `refreshPeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
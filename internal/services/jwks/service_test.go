@@ -0,0 +1,135 @@
+package jwks_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zestagio/chat-service/internal/services/jwks"
+)
+
+const refreshPeriod = 50 * time.Millisecond
+
+func TestService_FileSource_Rotation(t *testing.T) {
+	// Arrange.
+	oldKey, newKey := newEdKey(t), newECKey(t)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeFile(t, path, marshalJWKS(t, toJWK(t, "old", oldKey.Public())))
+
+	svc, err := jwks.New(jwks.NewOptions(path, jwks.WithRefreshPeriod(refreshPeriod)))
+	require.NoError(t, err)
+	assertKeys(t, svc, []string{"old"}, []string{"new"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	// Action & assert: overlap period, both keys are valid.
+	writeFile(t, path, marshalJWKS(t,
+		toJWK(t, "old", oldKey.Public()),
+		toJWK(t, "new", newKey.Public()),
+	))
+	require.Eventually(t, func() bool {
+		_, ok := svc.Key("new")
+		return ok
+	}, time.Second, refreshPeriod/5)
+	assertKeys(t, svc, []string{"old", "new"}, nil)
+
+	// Action & assert: the old key is revoked.
+	writeFile(t, path, marshalJWKS(t, toJWK(t, "new", newKey.Public())))
+	require.Eventually(t, func() bool {
+		_, ok := svc.Key("old")
+		return !ok
+	}, time.Second, refreshPeriod/5)
+	assertKeys(t, svc, []string{"new"}, []string{"old"})
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestService_HTTPSource_KeepKeysOnRefreshError(t *testing.T) {
+	// Arrange.
+	key := newRSAKey(t)
+	data := marshalJWKS(t, toJWK(t, "rsa", key.Public()))
+
+	var broken atomic.Bool
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		if broken.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer srv.Close()
+
+	svc, err := jwks.New(jwks.NewOptions(srv.URL, jwks.WithRefreshPeriod(refreshPeriod)))
+	require.NoError(t, err)
+	assertKeys(t, svc, []string{"rsa"}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	// Action.
+	broken.Store(true)
+	n := requests.Load()
+	require.Eventually(t, func() bool { return requests.Load() > n+1 }, time.Second, refreshPeriod/5)
+
+	// Assert.
+	assertKeys(t, svc, []string{"rsa"}, nil)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestNew_InitialLoadingError(t *testing.T) {
+	t.Run("no file", func(t *testing.T) {
+		_, err := jwks.New(jwks.NewOptions(filepath.Join(t.TempDir(), "unknown.json")))
+		require.Error(t, err)
+	})
+
+	t.Run("empty key set", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		writeFile(t, path, []byte(`{"keys": []}`))
+
+		_, err := jwks.New(jwks.NewOptions(path))
+		require.Error(t, err)
+	})
+}
+
+func assertKeys(t *testing.T, svc *jwks.Service, present, absent []string) {
+	t.Helper()
+
+	for _, kid := range present {
+		_, ok := svc.Key(kid)
+		assert.Truef(t, ok, "key %q expected", kid)
+	}
+	for _, kid := range absent {
+		_, ok := svc.Key(kid)
+		assert.Falsef(t, ok, "key %q unexpected", kid)
+	}
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+
+	// Write via rename to avoid reading of partially written file.
+	tmp := path + ".tmp"
+	require.NoError(t, os.WriteFile(tmp, data, 0o600))
+	require.NoError(t, os.Rename(tmp, path))
+}
@@ -0,0 +1,464 @@
+// Package lifecycleproducer provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.2.0 DO NOT EDIT.
+package lifecycleproducer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/runtime"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ClientId defines model for ClientId.
+type ClientId struct {
+	ClientId types.UserID `json:"clientId"`
+}
+
+// ClientMessageBlockedEvent defines model for ClientMessageBlockedEvent.
+type ClientMessageBlockedEvent struct {
+	ClientId  types.UserID    `json:"clientId"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// ClientMessageSentEvent defines model for ClientMessageSentEvent.
+type ClientMessageSentEvent struct {
+	ClientId  types.UserID    `json:"clientId"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	ChatId     types.ChatID  `json:"chatId"`
+	EventId    types.EventID `json:"eventId"`
+	EventType  string        `json:"eventType"`
+	OccurredAt time.Time     `json:"occurredAt"`
+
+	// SchemaVersion Version of the event schema, 1 for now.
+	SchemaVersion int `json:"schemaVersion"`
+	union         json.RawMessage
+}
+
+// ManagerId defines model for ManagerId.
+type ManagerId struct {
+	ManagerId types.UserID `json:"managerId"`
+}
+
+// MessageId defines model for MessageId.
+type MessageId struct {
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// ProblemCreatedEvent defines model for ProblemCreatedEvent.
+type ProblemCreatedEvent struct {
+	ClientId  types.UserID    `json:"clientId"`
+	ProblemId types.ProblemID `json:"problemId"`
+
+	// ReopenedFromProblemId The resolved problem continued by the new one. Absent if the problem is not a continuation.
+	ReopenedFromProblemId *types.ProblemID `json:"reopenedFromProblemId,omitempty"`
+}
+
+// ProblemId defines model for ProblemId.
+type ProblemId struct {
+	ProblemId types.ProblemID `json:"problemId"`
+}
+
+// ProblemManagerAssignedEvent defines model for ProblemManagerAssignedEvent.
+type ProblemManagerAssignedEvent struct {
+	ClientId  types.UserID    `json:"clientId"`
+	ManagerId types.UserID    `json:"managerId"`
+	ProblemId types.ProblemID `json:"problemId"`
+}
+
+// ProblemResolvedEvent defines model for ProblemResolvedEvent.
+type ProblemResolvedEvent struct {
+	// Category The contact reason. Absent if the manager has not set it.
+	Category  *string         `json:"category,omitempty"`
+	ClientId  types.UserID    `json:"clientId"`
+	ManagerId types.UserID    `json:"managerId"`
+	ProblemId types.ProblemID `json:"problemId"`
+
+	// ResolutionCode How the problem was solved. Absent in the events produced before the resolution codes.
+	ResolutionCode *string `json:"resolutionCode,omitempty"`
+
+	// ResolutionSummary The manager's summary of the resolution. Absent if it is empty.
+	ResolutionSummary *string `json:"resolutionSummary,omitempty"`
+}
+
+// AsProblemCreatedEvent returns the union data inside the Event as a ProblemCreatedEvent
+func (t Event) AsProblemCreatedEvent() (ProblemCreatedEvent, error) {
+	var body ProblemCreatedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProblemCreatedEvent overwrites any union data inside the Event as the provided ProblemCreatedEvent
+func (t *Event) FromProblemCreatedEvent(v ProblemCreatedEvent) error {
+	t.EventType = "ProblemCreatedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProblemCreatedEvent performs a merge with any union data inside the Event, using the provided ProblemCreatedEvent
+func (t *Event) MergeProblemCreatedEvent(v ProblemCreatedEvent) error {
+	t.EventType = "ProblemCreatedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProblemManagerAssignedEvent returns the union data inside the Event as a ProblemManagerAssignedEvent
+func (t Event) AsProblemManagerAssignedEvent() (ProblemManagerAssignedEvent, error) {
+	var body ProblemManagerAssignedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProblemManagerAssignedEvent overwrites any union data inside the Event as the provided ProblemManagerAssignedEvent
+func (t *Event) FromProblemManagerAssignedEvent(v ProblemManagerAssignedEvent) error {
+	t.EventType = "ProblemManagerAssignedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProblemManagerAssignedEvent performs a merge with any union data inside the Event, using the provided ProblemManagerAssignedEvent
+func (t *Event) MergeProblemManagerAssignedEvent(v ProblemManagerAssignedEvent) error {
+	t.EventType = "ProblemManagerAssignedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProblemResolvedEvent returns the union data inside the Event as a ProblemResolvedEvent
+func (t Event) AsProblemResolvedEvent() (ProblemResolvedEvent, error) {
+	var body ProblemResolvedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProblemResolvedEvent overwrites any union data inside the Event as the provided ProblemResolvedEvent
+func (t *Event) FromProblemResolvedEvent(v ProblemResolvedEvent) error {
+	t.EventType = "ProblemResolvedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProblemResolvedEvent performs a merge with any union data inside the Event, using the provided ProblemResolvedEvent
+func (t *Event) MergeProblemResolvedEvent(v ProblemResolvedEvent) error {
+	t.EventType = "ProblemResolvedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsClientMessageSentEvent returns the union data inside the Event as a ClientMessageSentEvent
+func (t Event) AsClientMessageSentEvent() (ClientMessageSentEvent, error) {
+	var body ClientMessageSentEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromClientMessageSentEvent overwrites any union data inside the Event as the provided ClientMessageSentEvent
+func (t *Event) FromClientMessageSentEvent(v ClientMessageSentEvent) error {
+	t.EventType = "ClientMessageSentEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeClientMessageSentEvent performs a merge with any union data inside the Event, using the provided ClientMessageSentEvent
+func (t *Event) MergeClientMessageSentEvent(v ClientMessageSentEvent) error {
+	t.EventType = "ClientMessageSentEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsClientMessageBlockedEvent returns the union data inside the Event as a ClientMessageBlockedEvent
+func (t Event) AsClientMessageBlockedEvent() (ClientMessageBlockedEvent, error) {
+	var body ClientMessageBlockedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromClientMessageBlockedEvent overwrites any union data inside the Event as the provided ClientMessageBlockedEvent
+func (t *Event) FromClientMessageBlockedEvent(v ClientMessageBlockedEvent) error {
+	t.EventType = "ClientMessageBlockedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeClientMessageBlockedEvent performs a merge with any union data inside the Event, using the provided ClientMessageBlockedEvent
+func (t *Event) MergeClientMessageBlockedEvent(v ClientMessageBlockedEvent) error {
+	t.EventType = "ClientMessageBlockedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Event) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"eventType"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t Event) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "ClientMessageBlockedEvent":
+		return t.AsClientMessageBlockedEvent()
+	case "ClientMessageSentEvent":
+		return t.AsClientMessageSentEvent()
+	case "ProblemCreatedEvent":
+		return t.AsProblemCreatedEvent()
+	case "ProblemManagerAssignedEvent":
+		return t.AsProblemManagerAssignedEvent()
+	case "ProblemResolvedEvent":
+		return t.AsProblemResolvedEvent()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t Event) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	object["chatId"], err = json.Marshal(t.ChatId)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'chatId': %w", err)
+	}
+
+	object["eventId"], err = json.Marshal(t.EventId)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'eventId': %w", err)
+	}
+
+	object["eventType"], err = json.Marshal(t.EventType)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'eventType': %w", err)
+	}
+
+	object["occurredAt"], err = json.Marshal(t.OccurredAt)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'occurredAt': %w", err)
+	}
+
+	object["schemaVersion"], err = json.Marshal(t.SchemaVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'schemaVersion': %w", err)
+	}
+
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *Event) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["chatId"]; found {
+		err = json.Unmarshal(raw, &t.ChatId)
+		if err != nil {
+			return fmt.Errorf("error reading 'chatId': %w", err)
+		}
+	}
+
+	if raw, found := object["eventId"]; found {
+		err = json.Unmarshal(raw, &t.EventId)
+		if err != nil {
+			return fmt.Errorf("error reading 'eventId': %w", err)
+		}
+	}
+
+	if raw, found := object["eventType"]; found {
+		err = json.Unmarshal(raw, &t.EventType)
+		if err != nil {
+			return fmt.Errorf("error reading 'eventType': %w", err)
+		}
+	}
+
+	if raw, found := object["occurredAt"]; found {
+		err = json.Unmarshal(raw, &t.OccurredAt)
+		if err != nil {
+			return fmt.Errorf("error reading 'occurredAt': %w", err)
+		}
+	}
+
+	if raw, found := object["schemaVersion"]; found {
+		err = json.Unmarshal(raw, &t.SchemaVersion)
+		if err != nil {
+			return fmt.Errorf("error reading 'schemaVersion': %w", err)
+		}
+	}
+
+	return err
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/8xX3W4bNxN9FYLfByQBVpKTXmXvYjttjTZNUae9iQ2YImdXzO5ytpxZqaqhdy/I/ZWs",
+	"uHZQA76SsCSHZ2bOnBneSo1VjQ4ck0xvJekVVCr+PSstOL4w4X/tsQbPFuKKnqxk6CvFMpVNY41MJG9r",
+	"kKkk9tblMpF/zXKcdR/DD81/J/AX59Olma1q9BwvUrySqcwtr5rlXGO1+BuIVW5xoVeKZwR+bTUsrGPw",
+	"TpWLaFPudrtEevizsR6MTD+PEK93SefJByBSOZyWqAsw79fg4o2qLD9mMv18K//vIZOp/N9iDMmii8ei",
+	"O3xh5C65f+cQtt11Ig2Q9rZmi06m8tMKRAvsBYmqtSg2isSyxSSWW8Fhzwp0QXN5CP0SHD8b3LUiAjPB",
+	"K5Qz0RsDpV2DBxM9GAAbG2xW1ilGPyHV9hdVgUwlhI2fAlN2iUQHD/DuV4/LEqozD4r7lO6SB535oJzK",
+	"wb8jsrl75NnfgLBcP/TQV1L4qGN7pA0JOijIlfrmcjwLZ//zckzadH4rqOjok6GKJEtvD3AE1mndeA/m",
+	"He/BNophxraCO9h3SaeYf4CnWCy3B7XTLQjMYqnE+0V7JhGvRYZeONzMR8sBdA5eHiraiHyM7eHtex4k",
+	"PS2CBHZ0P6bm1XTpmcr5iDE6M4jaXWemS493prf85P4MMIM/x2TswQrfHX6UwieHYfOANTgw33usRoPp",
+	"7ZFG4DvxE3W7T2h0bF0zti8HG4EO5uLdkgLbbUv9fr8l4ZCF6g+qYDwUwOPT1UN9inR1F+HyC2i+tykK",
+	"YuUZzOB87+nG8ip8tF5k1hP3vTP2xb0w72ejni49r6hMSTzCnJD4aF99ajLfOwEN0nE8h520CEYs2mmm",
+	"n3Q6/+ZX7tOEvn2pDMNay4EcgcRS6UIwCss02O1IYKnTfpUr6+ZXbsKB/XnimQTrjkZoxZCj3x6XhVDM",
+	"SrPwoAjdYe33wViptvgJWFieH+unUV+aYPkMDdy97Efc7MlJmDjb8I2XurHXxjSaRoeEQYYe4tJ4idBo",
+	"gP4FyWVTVeprnne+vSBB7ba+14/np+GwHBQQqpq3R259oPD08RzE+Chx25K1LsO7yN8fBIexG+UVi9Jm",
+	"oLe6BMFYWy1e3oTP8+HzTeC+gUw1Jb/qyqN/FhSwDf4Nti7OE0E4zQdmAl23qjwI9CY+Fq7ceftwiAYU",
+	"z0pQxDN0GhJhwDR1aQMHp7aWW3HTDUM36ZVrY2vA2xCTzGM1bhYhrvGNEqpTq4ZgQOZheLN0mwuAmgJF",
+	"r9yp0sVGeSNCvSi2yzKCdzmQeBl7XYypKkVmoTSUxB4w3kmvojVxszer3SRXTqOjpgJPomqIhc1d4Gfj",
+	"Cocb11mLiCfG5uLUgyqsywcQ1ulQdXB4QxCZRLLlEmQqT5UrxGVThy4gwtAvfh7S3JJBJnLdj7Fy/ToO",
+	"xDU4VVuZyu/mJ/MTmcTmEeVgQdwsw58c+C69Llg0BBTn2xwceMUBcpu2ufjIK/AbSxDKwSCQexHVIOhN",
+	"nAdC75M/AF+GS2Ip1uioFaI3JyfhJyhOr5d1yw2LbvGF2jG8jYVM7xe87kUV6mTfgY8/ha/he2iH4CnK",
+	"8f6ec1hDiXUVkrP0WICXiWx8KVNZqKxQ6WJRolblConTtydv3yz260jurnf/DACKZZXkgREAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,159 @@
+// Package lifecycleproducer produces the chat lifecycle events for downstream analytics.
+// The events schema is described in api/lifecycle.events.swagger.yml.
+package lifecycleproducer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+
+	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/pkg/pointer"
+)
+
+// SchemaVersion is increased on breaking changes of the events schema.
+const SchemaVersion = 1
+
+// eventIDNamespace is the namespace of the event IDs derived from the event type and its cause.
+var eventIDNamespace = uuid.MustParse("6f1c2a8e-5d3b-4e7a-9c0f-2b8d4e6a1f35")
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	wr msgproducer.Writer `option:"mandatory" validate:"required"`
+}
+
+type Service struct {
+	wr msgproducer.Writer
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+	return &Service{wr: opts.wr}, nil
+}
+
+// ProblemCreated omits the zero reopenedFromID.
+func (s *Service) ProblemCreated(
+	ctx context.Context,
+	chatID types.ChatID,
+	problemID types.ProblemID,
+	clientID types.UserID,
+	reopenedFromID types.ProblemID,
+) error {
+	return s.produce(ctx, chatID, problemID.String(), func(e *Event) error {
+		return e.FromProblemCreatedEvent(ProblemCreatedEvent{
+			ProblemId:             problemID,
+			ClientId:              clientID,
+			ReopenedFromProblemId: pointer.PtrWithZeroAsNil(reopenedFromID),
+		})
+	})
+}
+
+// ProblemManagerAssigned is caused by the service message announcing the manager,
+// the reopened problem gets the new one.
+func (s *Service) ProblemManagerAssigned(
+	ctx context.Context,
+	chatID types.ChatID,
+	problemID types.ProblemID,
+	clientID types.UserID,
+	managerID types.UserID,
+	serviceMsgID types.MessageID,
+) error {
+	return s.produce(ctx, chatID, serviceMsgID.String(), func(e *Event) error {
+		return e.FromProblemManagerAssignedEvent(ProblemManagerAssignedEvent{
+			ProblemId: problemID,
+			ClientId:  clientID,
+			ManagerId: managerID,
+		})
+	})
+}
+
+// ProblemResolved omits the empty category and resolution summary.
+// It is caused by the resolve request, the reopened problem is resolved with the new one.
+func (s *Service) ProblemResolved(
+	ctx context.Context,
+	chatID types.ChatID,
+	problemID types.ProblemID,
+	clientID types.UserID,
+	managerID types.UserID,
+	category string,
+	resolutionCode string,
+	resolutionSummary string,
+	resolveRequestID types.RequestID,
+) error {
+	return s.produce(ctx, chatID, resolveRequestID.String(), func(e *Event) error {
+		return e.FromProblemResolvedEvent(ProblemResolvedEvent{
+			ProblemId:         problemID,
+			ClientId:          clientID,
+			ManagerId:         managerID,
+			Category:          pointer.PtrWithZeroAsNil(category),
+			ResolutionCode:    pointer.PtrWithZeroAsNil(resolutionCode),
+			ResolutionSummary: pointer.PtrWithZeroAsNil(resolutionSummary),
+		})
+	})
+}
+
+func (s *Service) ClientMessageSent(
+	ctx context.Context,
+	chatID types.ChatID,
+	msgID types.MessageID,
+	clientID types.UserID,
+) error {
+	return s.produce(ctx, chatID, msgID.String(), func(e *Event) error {
+		return e.FromClientMessageSentEvent(ClientMessageSentEvent{
+			MessageId: msgID,
+			ClientId:  clientID,
+		})
+	})
+}
+
+func (s *Service) ClientMessageBlocked(
+	ctx context.Context,
+	chatID types.ChatID,
+	msgID types.MessageID,
+	clientID types.UserID,
+) error {
+	return s.produce(ctx, chatID, msgID.String(), func(e *Event) error {
+		return e.FromClientMessageBlockedEvent(ClientMessageBlockedEvent{
+			MessageId: msgID,
+			ClientId:  clientID,
+		})
+	})
+}
+
+func (s *Service) Close() error {
+	return s.wr.Close()
+}
+
+// produce writes the event with ID derived from its type and the cause,
+// so the event produced again by the retried job keeps the ID.
+func (s *Service) produce(ctx context.Context, chatID types.ChatID, cause string, fill func(e *Event) error) error {
+	event := Event{
+		ChatId:        chatID,
+		OccurredAt:    time.Now(),
+		SchemaVersion: SchemaVersion,
+	}
+	if err := fill(&event); err != nil {
+		return fmt.Errorf("build event: %v", err)
+	}
+	event.EventId = types.EventID(uuid.NewSHA1(eventIDNamespace, []byte(event.EventType+"/"+cause)))
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %v", err)
+	}
+
+	if err := s.wr.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(chatID.String()),
+		Value: data,
+	}); err != nil {
+		return fmt.Errorf("write %s: %v", event.EventType, err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Code generated by options-gen. DO NOT EDIT.
+package lifecycleproducer
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	wr msgproducer.Writer,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.wr = wr
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("wr", _validate_Options_wr(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_wr(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.wr, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `wr` did not pass the test: %w", err)
+	}
+	return nil
+}
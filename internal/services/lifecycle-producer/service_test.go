@@ -0,0 +1,173 @@
+package lifecycleproducer_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	lifecycleproducer "github.com/zestagio/chat-service/internal/services/lifecycle-producer"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+func TestService(t *testing.T) {
+	var (
+		chatID         = types.NewChatID()
+		problemID      = types.NewProblemID()
+		reopenedFromID = types.NewProblemID()
+		msgID          = types.NewMessageID()
+		clientID       = types.NewUserID()
+		managerID      = types.NewUserID()
+		requestID      = types.NewRequestID()
+	)
+
+	cases := []struct {
+		name       string
+		produce    func(ctx context.Context, s *lifecycleproducer.Service) error
+		expType    string
+		expPayload string
+	}{
+		{
+			name: "problem created",
+			produce: func(ctx context.Context, s *lifecycleproducer.Service) error {
+				return s.ProblemCreated(ctx, chatID, problemID, clientID, types.ProblemIDNil)
+			},
+			expType:    "ProblemCreatedEvent",
+			expPayload: fmt.Sprintf(`{"problemId": %q, "clientId": %q}`, problemID, clientID),
+		},
+		{
+			name: "reopened problem created",
+			produce: func(ctx context.Context, s *lifecycleproducer.Service) error {
+				return s.ProblemCreated(ctx, chatID, problemID, clientID, reopenedFromID)
+			},
+			expType: "ProblemCreatedEvent",
+			expPayload: fmt.Sprintf(`{"problemId": %q, "clientId": %q, "reopenedFromProblemId": %q}`,
+				problemID, clientID, reopenedFromID),
+		},
+		{
+			name: "problem manager assigned",
+			produce: func(ctx context.Context, s *lifecycleproducer.Service) error {
+				return s.ProblemManagerAssigned(ctx, chatID, problemID, clientID, managerID, msgID)
+			},
+			expType: "ProblemManagerAssignedEvent",
+			expPayload: fmt.Sprintf(`{"problemId": %q, "clientId": %q, "managerId": %q}`,
+				problemID, clientID, managerID),
+		},
+		{
+			name: "problem resolved",
+			produce: func(ctx context.Context, s *lifecycleproducer.Service) error {
+				return s.ProblemResolved(ctx, chatID, problemID, clientID, managerID, "cards", "answered", "Unblocked", requestID)
+			},
+			expType: "ProblemResolvedEvent",
+			expPayload: fmt.Sprintf(`{"problemId": %q, "clientId": %q, "managerId": %q,
+				"category": "cards", "resolutionCode": "answered", "resolutionSummary": "Unblocked"}`,
+				problemID, clientID, managerID),
+		},
+		{
+			name: "problem resolved without category and summary",
+			produce: func(ctx context.Context, s *lifecycleproducer.Service) error {
+				return s.ProblemResolved(ctx, chatID, problemID, clientID, managerID, "", "answered", "", requestID)
+			},
+			expType: "ProblemResolvedEvent",
+			expPayload: fmt.Sprintf(`{"problemId": %q, "clientId": %q, "managerId": %q, "resolutionCode": "answered"}`,
+				problemID, clientID, managerID),
+		},
+		{
+			name: "client message sent",
+			produce: func(ctx context.Context, s *lifecycleproducer.Service) error {
+				return s.ClientMessageSent(ctx, chatID, msgID, clientID)
+			},
+			expType:    "ClientMessageSentEvent",
+			expPayload: fmt.Sprintf(`{"messageId": %q, "clientId": %q}`, msgID, clientID),
+		},
+		{
+			name: "client message blocked",
+			produce: func(ctx context.Context, s *lifecycleproducer.Service) error {
+				return s.ClientMessageBlocked(ctx, chatID, msgID, clientID)
+			},
+			expType:    "ClientMessageBlockedEvent",
+			expPayload: fmt.Sprintf(`{"messageId": %q, "clientId": %q}`, msgID, clientID),
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange.
+			wr := new(writerMock)
+			s, err := lifecycleproducer.New(lifecycleproducer.NewOptions(wr))
+			require.NoError(t, err)
+
+			// Action.
+			err = tt.produce(context.Background(), s)
+
+			// Assert.
+			require.NoError(t, err)
+			require.Len(t, wr.msgs, 1)
+			assert.Equal(t, chatID.String(), string(wr.msgs[0].Key))
+
+			var event lifecycleproducer.Event
+			require.NoError(t, json.Unmarshal(wr.msgs[0].Value, &event))
+			assert.Equal(t, tt.expType, event.EventType)
+			assert.Equal(t, chatID, event.ChatId)
+			assert.Equal(t, lifecycleproducer.SchemaVersion, event.SchemaVersion)
+			assert.False(t, event.EventId.IsZero())
+			assert.False(t, event.OccurredAt.IsZero())
+
+			payload, err := event.ValueByDiscriminator()
+			require.NoError(t, err)
+			payloadJSON, err := json.Marshal(payload)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expPayload, string(payloadJSON))
+
+			require.NoError(t, s.Close())
+			assert.True(t, wr.closed)
+		})
+	}
+}
+
+func TestService_EventID(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+	chatID, msgID, clientID := types.NewChatID(), types.NewMessageID(), types.NewUserID()
+
+	wr := new(writerMock)
+	s, err := lifecycleproducer.New(lifecycleproducer.NewOptions(wr))
+	require.NoError(t, err)
+
+	// Action.
+	require.NoError(t, s.ClientMessageSent(ctx, chatID, msgID, clientID))
+	require.NoError(t, s.ClientMessageSent(ctx, chatID, msgID, clientID))
+	require.NoError(t, s.ClientMessageBlocked(ctx, chatID, msgID, clientID))
+	require.NoError(t, s.ClientMessageSent(ctx, chatID, types.NewMessageID(), clientID))
+
+	// Assert.
+	require.Len(t, wr.msgs, 4)
+	ids := make([]types.EventID, 0, len(wr.msgs))
+	for _, m := range wr.msgs {
+		var event lifecycleproducer.Event
+		require.NoError(t, json.Unmarshal(m.Value, &event))
+		ids = append(ids, event.EventId)
+	}
+	assert.Equal(t, ids[0], ids[1], "the same event is produced again")
+	assert.NotEqual(t, ids[0], ids[2], "another event type of the same message")
+	assert.NotEqual(t, ids[0], ids[3], "the same event type of another message")
+}
+
+type writerMock struct {
+	msgs   []kafka.Message
+	closed bool
+}
+
+func (m *writerMock) Close() error {
+	m.closed = true
+	return nil
+}
+
+func (m *writerMock) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	m.msgs = append(m.msgs, msgs...)
+	return nil
+}
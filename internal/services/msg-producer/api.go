@@ -4,17 +4,45 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 
+	"github.com/zestagio/chat-service/internal/tracing"
 	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/pkg/msgcrypto"
+	"github.com/zestagio/chat-service/pkg/msgheaders"
 )
 
+// SchemaVersion is increased on breaking changes of the message value schema.
+const SchemaVersion = 1
+
 type Message struct {
-	ID         types.MessageID
-	ChatID     types.ChatID
-	Body       string
-	FromClient bool
+	ID               types.MessageID
+	ChatID           types.ChatID
+	Body             string
+	FromClient       bool
+	InitialRequestID types.RequestID
+	CreatedAt        time.Time
+	Attachments      []Attachment
+}
+
+// Attachment is the file attached to the message.
+// URL is signed and lets the consumer (e.g. AFC) download the file for inspection.
+type Attachment struct {
+	ID          types.AttachmentID
+	FileName    string
+	ContentType string
+	Size        int64
+	URL         string
+}
+
+type attachmentValue struct {
+	ID          string `json:"id"`
+	FileName    string `json:"fileName"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	URL         string `json:"url"`
 }
 
 func (s *Service) ProduceMessage(ctx context.Context, msg Message) error {
@@ -23,27 +51,73 @@ func (s *Service) ProduceMessage(ctx context.Context, msg Message) error {
 		return fmt.Errorf("compute msg value: %v", err)
 	}
 
-	return s.wr.WriteMessages(ctx, kafka.Message{
-		Key:   []byte(msg.ChatID.String()),
-		Value: val,
-	})
+	kafkaMsg := kafka.Message{
+		Key:     []byte(msg.ChatID.String()),
+		Value:   val,
+		Headers: getMessageMetadata(ctx, msg).Headers(),
+	}
+	if s.cipher != nil {
+		kafkaMsg.Headers = append(kafkaMsg.Headers, kafka.Header{Key: msgcrypto.HeaderKeyID, Value: []byte(s.keyID)})
+	}
+
+	return s.wr.WriteMessages(ctx, kafkaMsg)
 }
 
 func (s *Service) Close() error {
 	return s.wr.Close()
 }
 
+// getMessageMetadata continues the trace from the context if any,
+// otherwise the trace identified by the initial request ID is started.
+func getMessageMetadata(ctx context.Context, msg Message) msgheaders.Metadata {
+	var tp tracing.TraceParent
+	if v, ok := tracing.FromContext(ctx); ok {
+		tp = v.Child()
+	} else if !msg.InitialRequestID.IsZero() {
+		tp = tracing.FromRequestID(msg.InitialRequestID)
+	}
+
+	md := msgheaders.Metadata{
+		CreatedAt:     msg.CreatedAt,
+		AuthorRole:    msgheaders.AuthorRoleManager,
+		SchemaVersion: SchemaVersion,
+	}
+	if msg.FromClient {
+		md.AuthorRole = msgheaders.AuthorRoleClient
+	}
+	if !msg.InitialRequestID.IsZero() {
+		md.RequestID = msg.InitialRequestID.String()
+	}
+	if !tp.IsZero() {
+		md.TraceParent = tp.String()
+	}
+	return md
+}
+
 func (s *Service) getMessageValue(msg Message) ([]byte, error) {
+	var attachments []attachmentValue
+	for _, a := range msg.Attachments {
+		attachments = append(attachments, attachmentValue{
+			ID:          a.ID.String(),
+			FileName:    a.FileName,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			URL:         a.URL,
+		})
+	}
+
 	data, err := json.Marshal(struct {
-		ID         string `json:"id"`
-		ChatID     string `json:"chatId"`
-		Body       string `json:"body"`
-		FromClient bool   `json:"fromClient"`
+		ID          string            `json:"id"`
+		ChatID      string            `json:"chatId"`
+		Body        string            `json:"body"`
+		FromClient  bool              `json:"fromClient"`
+		Attachments []attachmentValue `json:"attachments,omitempty"`
 	}{
-		ID:         msg.ID.String(),
-		ChatID:     msg.ChatID.String(),
-		Body:       msg.Body,
-		FromClient: msg.FromClient,
+		ID:          msg.ID.String(),
+		ChatID:      msg.ChatID.String(),
+		Body:        msg.Body,
+		FromClient:  msg.FromClient,
+		Attachments: attachments,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("marshal msg: %v", err)
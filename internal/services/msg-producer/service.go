@@ -2,32 +2,42 @@ package msgproducer
 
 import (
 	"context"
-	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
-	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
+
+	"github.com/zestagio/chat-service/pkg/msgcrypto"
 )
 
-type KafkaWriter interface {
+// Writer is a sink of the produced messages.
+// kafka.Message is used as an envelope for all sinks: the key is a chat ID,
+// the value is a (possibly encrypted) message and the headers are message metadata.
+type Writer interface {
 	io.Closer
 	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
 }
 
 //go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
 type Options struct {
-	wr           KafkaWriter `option:"mandatory" validate:"required"`
-	encryptKey   string      `validate:"omitempty,hexadecimal"`
+	wr           Writer `option:"mandatory" validate:"required"`
+	encryptKey   string `validate:"omitempty,hexadecimal"`
 	nonceFactory func(size int) ([]byte, error)
+
+	// encryptKeys is a keyring of HEX-encoded keys indexed by key ID.
+	// Only the activeEncryptKeyID key is used for encryption, the others are kept for consumers.
+	encryptKeys        map[string]string `validate:"omitempty,dive,keys,required,endkeys,hexadecimal"`
+	activeEncryptKeyID string
 }
 
 type Service struct {
-	wr           KafkaWriter
+	wr           Writer
 	cipher       cipher.AEAD
+	keyID        string
 	nonceFactory func(size int) ([]byte, error)
 }
 
@@ -39,21 +49,16 @@ func New(opts Options) (*Service, error) {
 		opts.nonceFactory = defaultNonceFactory
 	}
 
-	var aeadCipher cipher.AEAD
-	if key := opts.encryptKey; key != "" {
-		key, err := hex.DecodeString(key)
-		if err != nil {
-			return nil, fmt.Errorf("decode encryption key from HEX: %v", err)
-		}
-
-		aesBlockCipher, err := aes.NewCipher(key)
-		if err != nil {
-			return nil, fmt.Errorf("build AES cipher: %v", err)
-		}
+	keyID, key, err := activeKey(opts)
+	if err != nil {
+		return nil, err
+	}
 
-		aeadCipher, err = cipher.NewGCM(aesBlockCipher)
+	var aeadCipher cipher.AEAD
+	if key != "" {
+		aeadCipher, err = msgcrypto.NewAEAD(key)
 		if err != nil {
-			return nil, fmt.Errorf("build AEAD cipher: %v", err)
+			return nil, fmt.Errorf("build cipher for key %q: %v", keyID, err)
 		}
 	}
 
@@ -61,16 +66,46 @@ func New(opts Options) (*Service, error) {
 	if aeadCipher == nil {
 		log.Info("encryption disabled")
 	} else {
-		log.Info("encryption enabled")
+		log.Info("encryption enabled", zap.String("key_id", keyID))
 	}
 
 	return &Service{
 		wr:           opts.wr,
 		cipher:       aeadCipher,
+		keyID:        keyID,
 		nonceFactory: opts.nonceFactory,
 	}, nil
 }
 
+// activeKey returns the ID and the value of the key to encrypt messages with.
+// The empty key means that encryption is disabled.
+func activeKey(opts Options) (keyID string, key string, err error) {
+	if len(opts.encryptKeys) == 0 {
+		if opts.activeEncryptKeyID != "" {
+			return "", "", errors.New("active encryption key id is set, but keyring is empty")
+		}
+		if opts.encryptKey == "" {
+			return "", "", nil
+		}
+		return msgcrypto.DefaultKeyID, opts.encryptKey, nil
+	}
+
+	if opts.encryptKey != "" {
+		return "", "", errors.New("single encryption key and keyring are mutually exclusive")
+	}
+
+	// Validate retired keys as well, to not find out about the broken key in the middle of rotation.
+	if _, err := msgcrypto.NewKeyring(opts.encryptKeys); err != nil {
+		return "", "", fmt.Errorf("build keyring: %v", err)
+	}
+
+	key, ok := opts.encryptKeys[opts.activeEncryptKeyID]
+	if !ok {
+		return "", "", fmt.Errorf("active encryption key %q is not in keyring", opts.activeEncryptKeyID)
+	}
+	return opts.activeEncryptKeyID, key, nil
+}
+
 func defaultNonceFactory(size int) (nonce []byte, err error) {
 	nonce = make([]byte, size)
 	_, err = rand.Read(nonce)
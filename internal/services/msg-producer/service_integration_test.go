@@ -17,6 +17,7 @@ import (
 	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
 	"github.com/zestagio/chat-service/internal/testingh"
 	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/pkg/msgcrypto"
 )
 
 type ServiceIntegrationSuite struct {
@@ -187,6 +188,14 @@ func (s *ServiceIntegrationSuite) TestEncryptedMessages() {
 			}
 		}
 	})
+
+	s.Run("message carries encryption key id", func() {
+		for _, m := range producedMsgs {
+			keyID, ok := msgcrypto.KeyID(m)
+			s.Require().True(ok, "msg = %s", m)
+			s.Equal(msgcrypto.DefaultKeyID, keyID)
+		}
+	})
 }
 
 func (s *ServiceIntegrationSuite) consumeMessages(n int) []kafka.Message {
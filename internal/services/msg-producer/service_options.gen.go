@@ -11,7 +11,7 @@ import (
 type OptOptionsSetter func(o *Options)
 
 func NewOptions(
-	wr KafkaWriter,
+	wr Writer,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -40,10 +40,27 @@ func WithNonceFactory(opt func(size int) ([]byte, error)) OptOptionsSetter {
 	}
 }
 
+// encryptKeys is a keyring of HEX-encoded keys indexed by key ID.
+// Only the activeEncryptKeyID key is used for encryption, the others are kept for consumers.
+func WithEncryptKeys(opt map[string]string) OptOptionsSetter {
+	return func(o *Options) {
+		o.encryptKeys = opt
+
+	}
+}
+
+func WithActiveEncryptKeyID(opt string) OptOptionsSetter {
+	return func(o *Options) {
+		o.activeEncryptKeyID = opt
+
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("wr", _validate_Options_wr(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("encryptKey", _validate_Options_encryptKey(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("encryptKeys", _validate_Options_encryptKeys(o)))
 	return errs.AsError()
 }
 
@@ -60,3 +77,10 @@ func _validate_Options_encryptKey(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_encryptKeys(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.encryptKeys, "omitempty,dive,keys,required,endkeys,hexadecimal"); err != nil {
+		return fmt461e464ebed9.Errorf("field `encryptKeys` did not pass the test: %w", err)
+	}
+	return nil
+}
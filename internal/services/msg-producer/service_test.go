@@ -2,35 +2,53 @@ package msgproducer_test
 
 import (
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
+	"github.com/zestagio/chat-service/internal/tracing"
 	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/pkg/msgcrypto"
+	"github.com/zestagio/chat-service/pkg/msgheaders"
 )
 
 func TestService_ProduceMessage(t *testing.T) {
 	const messagesCount = 10
 
+	keyring := map[string]string{
+		"2024-01": "24432646294A404E635266546A576E5A",
+		"2024-02": "68566D597133743677397A2443264629",
+	}
+
 	cases := []struct {
-		name string
-		key  string
+		name      string
+		opts      []msgproducer.OptOptionsSetter
+		decryptor map[string]string
+		keyID     string
 	}{
 		{
 			name: "plain",
-			key:  "",
 		},
 		{
-			name: "encrypted",
-			key:  "24432646294A404E635266546A576E5A",
+			name:      "encrypted with single key",
+			opts:      []msgproducer.OptOptionsSetter{msgproducer.WithEncryptKey("24432646294A404E635266546A576E5A")},
+			decryptor: map[string]string{msgcrypto.DefaultKeyID: "24432646294A404E635266546A576E5A"},
+			keyID:     msgcrypto.DefaultKeyID,
+		},
+		{
+			name: "encrypted with keyring",
+			opts: []msgproducer.OptOptionsSetter{
+				msgproducer.WithEncryptKeys(keyring),
+				msgproducer.WithActiveEncryptKeyID("2024-02"),
+			},
+			decryptor: keyring,
+			keyID:     "2024-02",
 		},
 	}
 
@@ -38,7 +56,7 @@ func TestService_ProduceMessage(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange.
 			writer := new(kafkaWriterMock)
-			s, err := msgproducer.New(msgproducer.NewOptions(writer, msgproducer.WithEncryptKey(tt.key)))
+			s, err := msgproducer.New(msgproducer.NewOptions(writer, tt.opts...))
 			require.NoError(t, err)
 			defer func() {
 				require.NoError(t, s.Close())
@@ -47,12 +65,22 @@ func TestService_ProduceMessage(t *testing.T) {
 
 			msgs := make([]msgproducer.Message, 0, messagesCount)
 			for i := 0; i < messagesCount; i++ {
-				msgs = append(msgs, msgproducer.Message{
+				msg := msgproducer.Message{
 					ID:         types.NewMessageID(),
 					ChatID:     types.NewChatID(),
 					Body:       fmt.Sprintf("Message %d", i),
 					FromClient: i%3 != 0,
-				})
+				}
+				if i%2 == 0 {
+					msg.Attachments = []msgproducer.Attachment{{
+						ID:          types.NewAttachmentID(),
+						FileName:    fmt.Sprintf("statement-%d.pdf", i),
+						ContentType: "application/pdf",
+						Size:        int64(1024 * (i + 1)),
+						URL:         fmt.Sprintf("http://localhost:8080/attachments/%d?signature=xxx", i),
+					}}
+				}
+				msgs = append(msgs, msg)
 			}
 
 			// Action.
@@ -66,8 +94,15 @@ func TestService_ProduceMessage(t *testing.T) {
 			produced := make([]msgproducer.Message, 0, messagesCount)
 			for _, m := range writer.msgs {
 				data := m.Value
-				if tt.key != "" {
-					data = requireMsgDecrypt(t, tt.key, data)
+				if tt.decryptor != nil {
+					keyID, ok := msgcrypto.KeyID(m)
+					require.True(t, ok)
+					assert.Equal(t, tt.keyID, keyID)
+
+					data = requireMsgDecrypt(t, tt.decryptor, m)
+				} else {
+					_, ok := msgcrypto.KeyID(m)
+					assert.False(t, ok)
 				}
 
 				msg := requireMsgUnmarshal(t, data)
@@ -80,22 +115,123 @@ func TestService_ProduceMessage(t *testing.T) {
 	}
 }
 
-func requireMsgDecrypt(t *testing.T, keyStr string, data []byte) []byte {
-	t.Helper()
+func TestService_ProduceMessage_Metadata(t *testing.T) {
+	reqID := types.MustParse[types.RequestID]("4bf92f35-77b3-4da6-a3ce-929d0e0e4736")
+	createdAt := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
 
-	key, err := hex.DecodeString(keyStr)
+	incomingTrace, err := tracing.Parse("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
 	require.NoError(t, err)
 
-	blockCipher, err := aes.NewCipher(key)
-	require.NoError(t, err)
+	cases := []struct {
+		name         string
+		ctx          context.Context
+		fromClient   bool
+		expRole      string
+		expTraceID   string
+		expNotSpanID string
+	}{
+		{
+			name:       "trace from request id",
+			ctx:        context.Background(),
+			fromClient: true,
+			expRole:    msgheaders.AuthorRoleClient,
+			expTraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		{
+			name:         "trace from context",
+			ctx:          tracing.WithTraceParent(context.Background(), incomingTrace),
+			fromClient:   false,
+			expRole:      msgheaders.AuthorRoleManager,
+			expTraceID:   "0af7651916cd43dd8448eb211c80319c",
+			expNotSpanID: "b7ad6b7169203331",
+		},
+	}
 
-	aead, err := cipher.NewGCM(blockCipher)
-	require.NoError(t, err)
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange.
+			writer := new(kafkaWriterMock)
+			s, err := msgproducer.New(msgproducer.NewOptions(writer))
+			require.NoError(t, err)
+
+			// Action.
+			err = s.ProduceMessage(tt.ctx, msgproducer.Message{
+				ID:               types.NewMessageID(),
+				ChatID:           types.NewChatID(),
+				Body:             "Hello",
+				FromClient:       tt.fromClient,
+				InitialRequestID: reqID,
+				CreatedAt:        createdAt,
+			})
+
+			// Assert.
+			require.NoError(t, err)
+			require.Len(t, writer.msgs, 1)
+
+			md := msgheaders.Parse(writer.msgs[0].Headers)
+			assert.Equal(t, reqID.String(), md.RequestID)
+			assert.Equal(t, createdAt, md.CreatedAt)
+			assert.Equal(t, tt.expRole, md.AuthorRole)
+			assert.Equal(t, msgproducer.SchemaVersion, md.SchemaVersion)
+
+			tp, err := tracing.Parse(md.TraceParent)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expTraceID, tp.TraceIDString())
+			assert.NotEqual(t, tt.expNotSpanID, tp.SpanIDString())
+		})
+	}
+}
+
+func TestNew_InvalidKeyring(t *testing.T) {
+	const key = "24432646294A404E635266546A576E5A"
+
+	cases := []struct {
+		name string
+		opts []msgproducer.OptOptionsSetter
+	}{
+		{
+			name: "active key id without keyring",
+			opts: []msgproducer.OptOptionsSetter{msgproducer.WithActiveEncryptKeyID("k1")},
+		},
+		{
+			name: "single key and keyring",
+			opts: []msgproducer.OptOptionsSetter{
+				msgproducer.WithEncryptKey(key),
+				msgproducer.WithEncryptKeys(map[string]string{"k1": key}),
+				msgproducer.WithActiveEncryptKeyID("k1"),
+			},
+		},
+		{
+			name: "active key is not in keyring",
+			opts: []msgproducer.OptOptionsSetter{
+				msgproducer.WithEncryptKeys(map[string]string{"k1": key}),
+				msgproducer.WithActiveEncryptKeyID("k2"),
+			},
+		},
+		{
+			name: "invalid retired key",
+			opts: []msgproducer.OptOptionsSetter{
+				msgproducer.WithEncryptKeys(map[string]string{"k1": key, "k0": "abcd"}),
+				msgproducer.WithActiveEncryptKeyID("k1"),
+			},
+		},
+	}
 
-	raw, ns := data, aead.NonceSize()
-	nonce, encrypted := raw[:ns], raw[ns:]
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := msgproducer.New(msgproducer.NewOptions(new(kafkaWriterMock), tt.opts...))
+			require.Error(t, err)
+		})
+	}
+}
 
-	decrypted, err := aead.Open(nil, nonce, encrypted, nil)
+func requireMsgDecrypt(t *testing.T, keys map[string]string, msg kafka.Message) []byte {
+	t.Helper()
+
+	keyring, err := msgcrypto.NewKeyring(keys)
+	require.NoError(t, err)
+
+	decrypted, err := keyring.Decrypt(msg)
 	require.NoError(t, err)
 
 	return decrypted
@@ -105,22 +241,41 @@ func requireMsgUnmarshal(t *testing.T, data []byte) msgproducer.Message {
 	t.Helper()
 
 	var rcvMsg struct {
-		ID         string `json:"id"`
-		ChatID     string `json:"chatId"`
-		Body       string `json:"body"`
-		FromClient bool   `json:"fromClient"`
+		ID          string `json:"id"`
+		ChatID      string `json:"chatId"`
+		Body        string `json:"body"`
+		FromClient  bool   `json:"fromClient"`
+		Attachments []struct {
+			ID          string `json:"id"`
+			FileName    string `json:"fileName"`
+			ContentType string `json:"contentType"`
+			Size        int64  `json:"size"`
+			URL         string `json:"url"`
+		} `json:"attachments"`
 	}
 	require.NoError(t, json.Unmarshal(data, &rcvMsg))
 
+	var attachments []msgproducer.Attachment
+	for _, a := range rcvMsg.Attachments {
+		attachments = append(attachments, msgproducer.Attachment{
+			ID:          types.MustParse[types.AttachmentID](a.ID),
+			FileName:    a.FileName,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			URL:         a.URL,
+		})
+	}
+
 	return msgproducer.Message{
-		ID:         types.MustParse[types.MessageID](rcvMsg.ID),
-		ChatID:     types.MustParse[types.ChatID](rcvMsg.ChatID),
-		Body:       rcvMsg.Body,
-		FromClient: rcvMsg.FromClient,
+		ID:          types.MustParse[types.MessageID](rcvMsg.ID),
+		ChatID:      types.MustParse[types.ChatID](rcvMsg.ChatID),
+		Body:        rcvMsg.Body,
+		FromClient:  rcvMsg.FromClient,
+		Attachments: attachments,
 	}
 }
 
-var _ msgproducer.KafkaWriter = (*kafkaWriterMock)(nil)
+var _ msgproducer.Writer = (*kafkaWriterMock)(nil)
 
 type kafkaWriterMock struct {
 	msgs   []kafka.Message
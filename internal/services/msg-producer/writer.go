@@ -8,7 +8,7 @@ import (
 
 const serviceName = "msg-producer"
 
-func NewKafkaWriter(brokers []string, topic string, batchSize int) KafkaWriter {
+func NewKafkaWriter(brokers []string, topic string, batchSize int) Writer {
 	return &kafka.Writer{
 		Addr:         kafka.TCP(brokers...),
 		Topic:        topic,
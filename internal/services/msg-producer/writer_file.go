@@ -0,0 +1,71 @@
+package msgproducer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// FileWriter appends messages to the local JSONL file. Useful for development without a broker.
+type FileWriter struct {
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+type fileRecord struct {
+	Key     string            `json:"key"`
+	Value   []byte            `json:"value"` // Base64-encoded, because the value could be encrypted.
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+func NewFileWriter(path string) (*FileWriter, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644) //nolint:gosec // Not a secret file.
+	if err != nil {
+		return nil, fmt.Errorf("open file: %v", err)
+	}
+	return &FileWriter{f: f, enc: json.NewEncoder(f)}, nil
+}
+
+func (w *FileWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, m := range msgs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := w.enc.Encode(fileRecord{
+			Key:     string(m.Key),
+			Value:   m.Value,
+			Headers: headersToMap(m.Headers),
+		}); err != nil {
+			return fmt.Errorf("write record: %v", err)
+		}
+	}
+	return nil
+}
+
+func (w *FileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.f.Close()
+}
+
+func headersToMap(headers []kafka.Header) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(headers))
+	for _, h := range headers {
+		result[h.Key] = string(h.Value)
+	}
+	return result
+}
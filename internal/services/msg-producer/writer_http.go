@@ -0,0 +1,79 @@
+package msgproducer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/zestagio/chat-service/pkg/msgcrypto"
+)
+
+const (
+	// HTTPHeaderMessageKey is the webhook request header containing the message key (chat ID).
+	HTTPHeaderMessageKey = "X-Message-Key"
+
+	// httpHeaderPrefix prefixes message headers passed as the webhook request headers.
+	httpHeaderPrefix = "X-Message-Header-"
+)
+
+// HTTPWriter posts every message to the webhook URL.
+// The request body is the message value, the key and headers are passed in the request headers.
+type HTTPWriter struct {
+	url string
+	cli *http.Client
+}
+
+func NewHTTPWriter(url string, timeout time.Duration) *HTTPWriter {
+	return &HTTPWriter{
+		url: url,
+		cli: &http.Client{Timeout: timeout},
+	}
+}
+
+func (w *HTTPWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	for _, m := range msgs {
+		if err := w.post(ctx, m); err != nil {
+			return fmt.Errorf("post message %q: %w", m.Key, err)
+		}
+	}
+	return nil
+}
+
+func (w *HTTPWriter) post(ctx context.Context, m kafka.Message) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(m.Value))
+	if err != nil {
+		return fmt.Errorf("build request: %v", err)
+	}
+
+	contentType := "application/json"
+	req.Header.Set(HTTPHeaderMessageKey, string(m.Key))
+	for _, h := range m.Headers {
+		if h.Key == msgcrypto.HeaderKeyID {
+			contentType = "application/octet-stream"
+		}
+		req.Header.Set(httpHeaderPrefix+h.Key, string(h.Value))
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := w.cli.Do(req)
+	if err != nil {
+		return fmt.Errorf("do request: %v", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (w *HTTPWriter) Close() error {
+	w.cli.CloseIdleConnections()
+	return nil
+}
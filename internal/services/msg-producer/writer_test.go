@@ -0,0 +1,143 @@
+package msgproducer_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/pkg/msgcrypto"
+)
+
+const sinkTestKey = "24432646294A404E635266546A576E5A"
+
+func TestFileWriter(t *testing.T) {
+	// Arrange.
+	path := filepath.Join(t.TempDir(), "messages.jsonl")
+
+	wr, err := msgproducer.NewFileWriter(path)
+	require.NoError(t, err)
+
+	s, err := msgproducer.New(msgproducer.NewOptions(wr, msgproducer.WithEncryptKey(sinkTestKey)))
+	require.NoError(t, err)
+
+	msgs := newSinkTestMessages()
+
+	// Action.
+	for _, m := range msgs {
+		require.NoError(t, s.ProduceMessage(context.Background(), m))
+	}
+	require.NoError(t, s.Close())
+
+	// Assert.
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var produced []msgproducer.Message
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec struct {
+			Key     string            `json:"key"`
+			Value   []byte            `json:"value"`
+			Headers map[string]string `json:"headers"`
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &rec))
+
+		m := kafka.Message{Key: []byte(rec.Key), Value: rec.Value}
+		for k, v := range rec.Headers {
+			m.Headers = append(m.Headers, kafka.Header{Key: k, Value: []byte(v)})
+		}
+
+		msg := requireMsgUnmarshal(t, requireMsgDecrypt(t, map[string]string{msgcrypto.DefaultKeyID: sinkTestKey}, m))
+		assert.Equal(t, msg.ChatID.String(), rec.Key)
+		produced = append(produced, msg)
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, msgs, produced)
+}
+
+func TestHTTPWriter(t *testing.T) {
+	// Arrange.
+	var (
+		mu       sync.Mutex
+		produced []msgproducer.Message
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		m := kafka.Message{
+			Key:   []byte(r.Header.Get(msgproducer.HTTPHeaderMessageKey)),
+			Value: body,
+			Headers: []kafka.Header{{
+				Key:   msgcrypto.HeaderKeyID,
+				Value: []byte(r.Header.Get("X-Message-Header-" + msgcrypto.HeaderKeyID)),
+			}},
+		}
+		msg := requireMsgUnmarshal(t, requireMsgDecrypt(t, map[string]string{msgcrypto.DefaultKeyID: sinkTestKey}, m))
+		assert.Equal(t, msg.ChatID.String(), string(m.Key))
+		assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+
+		mu.Lock()
+		produced = append(produced, msg)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	s, err := msgproducer.New(msgproducer.NewOptions(
+		msgproducer.NewHTTPWriter(srv.URL, time.Second),
+		msgproducer.WithEncryptKey(sinkTestKey),
+	))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, s.Close()) }()
+
+	msgs := newSinkTestMessages()
+
+	// Action.
+	for _, m := range msgs {
+		require.NoError(t, s.ProduceMessage(context.Background(), m))
+	}
+
+	// Assert.
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, msgs, produced)
+}
+
+func TestHTTPWriter_UnexpectedStatus(t *testing.T) {
+	// Arrange.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	wr := msgproducer.NewHTTPWriter(srv.URL, time.Second)
+	defer func() { require.NoError(t, wr.Close()) }()
+
+	// Action.
+	err := wr.WriteMessages(context.Background(), kafka.Message{Key: []byte("key"), Value: []byte("{}")})
+
+	// Assert.
+	require.Error(t, err)
+}
+
+func newSinkTestMessages() []msgproducer.Message {
+	return []msgproducer.Message{
+		{ID: types.NewMessageID(), ChatID: types.NewChatID(), Body: "Hello", FromClient: true},
+		{ID: types.NewMessageID(), ChatID: types.NewChatID(), Body: "Hi", FromClient: false},
+	}
+}
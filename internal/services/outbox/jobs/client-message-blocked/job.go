@@ -19,14 +19,19 @@ type eventStream interface {
 	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
 }
 
+type lifecycleProducer interface {
+	ClientMessageBlocked(ctx context.Context, chatID types.ChatID, msgID types.MessageID, clientID types.UserID) error
+}
+
 type messageRepository interface {
 	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
 }
 
 //go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
 type Options struct {
-	eventStream eventStream       `option:"mandatory" validate:"required"`
-	msgRepo     messageRepository `option:"mandatory" validate:"required"`
+	eventStream       eventStream       `option:"mandatory" validate:"required"`
+	lifecycleProducer lifecycleProducer `option:"mandatory" validate:"required"`
+	msgRepo           messageRepository `option:"mandatory" validate:"required"`
 }
 
 type Job struct {
@@ -70,11 +75,18 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 		return fmt.Errorf("get message: %v", err)
 	}
 
-	return j.eventStream.Publish(ctx, msg.AuthorID,
+	if err := j.eventStream.Publish(ctx, msg.AuthorID,
 		eventstream.NewMessageBlockedEvent(
 			types.NewEventID(),
 			msg.InitialRequestID,
 			msg.ID,
 		),
-	)
+	); err != nil {
+		return fmt.Errorf("publish MessageBlockedEvent to client: %v", err)
+	}
+
+	if err := j.lifecycleProducer.ClientMessageBlocked(ctx, msg.ChatID, msg.ID, msg.AuthorID); err != nil {
+		return fmt.Errorf("produce lifecycle event: %v", err)
+	}
+	return nil
 }
@@ -12,6 +12,7 @@ type OptOptionsSetter func(o *Options)
 
 func NewOptions(
 	eventStream eventStream,
+	lifecycleProducer lifecycleProducer,
 	msgRepo messageRepository,
 	options ...OptOptionsSetter,
 ) Options {
@@ -21,6 +22,8 @@ func NewOptions(
 
 	o.eventStream = eventStream
 
+	o.lifecycleProducer = lifecycleProducer
+
 	o.msgRepo = msgRepo
 
 	for _, opt := range options {
@@ -32,6 +35,7 @@ func NewOptions(
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("lifecycleProducer", _validate_Options_lifecycleProducer(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
 	return errs.AsError()
 }
@@ -43,6 +47,13 @@ func _validate_Options_eventStream(o *Options) error {
 	return nil
 }
 
+func _validate_Options_lifecycleProducer(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.lifecycleProducer, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `lifecycleProducer` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_msgRepo(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
@@ -18,23 +18,34 @@ import (
 
 const Name = "client-message-sent"
 
+type attachmentsService interface {
+	URLs(a messagesrepo.Attachment, viewerID types.UserID) (fileURL, thumbnailURL string)
+}
+
 type chatsRepository interface {
 	GetChatManager(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+	GetChatWithOpenProblem(ctx context.Context, managerID types.UserID, chatID types.ChatID) (*chatsrepo.Chat, error)
 }
 
 type eventStream interface {
 	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
 }
 
+type lifecycleProducer interface {
+	ClientMessageSent(ctx context.Context, chatID types.ChatID, msgID types.MessageID, clientID types.UserID) error
+}
+
 type messageRepository interface {
 	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
 }
 
 //go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
 type Options struct {
-	chatsRepo   chatsRepository   `option:"mandatory" validate:"required"`
-	eventStream eventStream       `option:"mandatory" validate:"required"`
-	msgRepo     messageRepository `option:"mandatory" validate:"required"`
+	attachmentsSvc    attachmentsService `option:"mandatory" validate:"required"`
+	chatsRepo         chatsRepository    `option:"mandatory" validate:"required"`
+	eventStream       eventStream        `option:"mandatory" validate:"required"`
+	lifecycleProducer lifecycleProducer  `option:"mandatory" validate:"required"`
+	msgRepo           messageRepository  `option:"mandatory" validate:"required"`
 }
 
 type Job struct {
@@ -78,6 +89,11 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 		return fmt.Errorf("get message: %v", err)
 	}
 
+	if !msg.DeletedAt.IsZero() {
+		j.logger.Info("message was deleted, skip", zap.Stringer("message_id", msg.ID))
+		return nil
+	}
+
 	wg, ctx := errgroup.WithContext(ctx)
 
 	// Send update to client.
@@ -104,6 +120,21 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 			return fmt.Errorf("get chat manager: %v", err)
 		}
 
+		// The edited message has passed AFC again.
+		if !msg.EditedAt.IsZero() {
+			if err := j.eventStream.Publish(ctx, managerID, eventstream.NewMessageEditedEvent(
+				types.NewEventID(),
+				msg.InitialRequestID,
+				msg.ChatID,
+				msg.ID,
+				msg.EditedAt,
+				msg.Body,
+			)); err != nil {
+				return fmt.Errorf("publish MessageEditedEvent to manager: %v", err)
+			}
+			return nil
+		}
+
 		if err := j.eventStream.Publish(ctx, managerID, eventstream.NewNewMessageEvent(
 			types.NewEventID(),
 			msg.InitialRequestID,
@@ -113,11 +144,92 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 			msg.CreatedAt,
 			msg.Body,
 			false,
+			j.eventAttachments(msg.Attachments, managerID),
+			adaptQuote(msg.ReplyTo.ForManager()),
+			false,
 		)); err != nil {
 			return fmt.Errorf("publish NewMessageEvent to manager: %v", err)
 		}
-		return nil
+		return j.publishChatUpdated(ctx, managerID, msg)
 	})
 
+	// Send lifecycle event to analytics. The edit is not a new message.
+	if msg.EditedAt.IsZero() {
+		wg.Go(func() error {
+			if err := j.lifecycleProducer.ClientMessageSent(ctx, msg.ChatID, msg.ID, msg.AuthorID); err != nil {
+				return fmt.Errorf("produce lifecycle event: %v", err)
+			}
+			return nil
+		})
+	}
+
 	return wg.Wait()
 }
+
+// publishChatUpdated refreshes the chat in the manager chat list.
+func (j *Job) publishChatUpdated(ctx context.Context, managerID types.UserID, m *messagesrepo.Message) error {
+	c, err := j.chatsRepo.GetChatWithOpenProblem(ctx, managerID, m.ChatID)
+	if err != nil {
+		if errors.Is(err, chatsrepo.ErrChatNotFound) {
+			// The problem has been resolved meanwhile.
+			return nil
+		}
+		return fmt.Errorf("get chat with open problem: %v", err)
+	}
+
+	if err := j.eventStream.Publish(ctx, managerID, eventstream.NewChatUpdatedEvent(
+		types.NewEventID(),
+		m.InitialRequestID,
+		c.ID,
+		c.ProblemCreatedAt,
+		c.UnreadCount,
+		adaptLastMessage(c.LastMessage),
+		c.WaitingSince,
+	)); err != nil {
+		return fmt.Errorf("publish ChatUpdatedEvent to manager: %v", err)
+	}
+	return nil
+}
+
+func (j *Job) eventAttachments(attachments []messagesrepo.Attachment, viewerID types.UserID) []eventstream.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	result := make([]eventstream.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		fileURL, thumbnailURL := j.attachmentsSvc.URLs(a, viewerID)
+		result = append(result, eventstream.Attachment{
+			ID:           a.ID,
+			FileName:     a.FileName,
+			ContentType:  a.ContentType,
+			Size:         a.Size,
+			URL:          fileURL,
+			ThumbnailURL: thumbnailURL,
+		})
+	}
+	return result
+}
+
+func adaptQuote(q *messagesrepo.Quote) *eventstream.Quote {
+	if q == nil {
+		return nil
+	}
+	return &eventstream.Quote{
+		MessageID: q.MessageID,
+		AuthorID:  q.AuthorID,
+		Preview:   q.Preview,
+	}
+}
+
+func adaptLastMessage(m *chatsrepo.LastMessage) *eventstream.LastMessage {
+	if m == nil {
+		return nil
+	}
+	return &eventstream.LastMessage{
+		MessageID: m.ID,
+		AuthorID:  m.AuthorID,
+		Preview:   m.Preview,
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -11,8 +11,10 @@ import (
 type OptOptionsSetter func(o *Options)
 
 func NewOptions(
+	attachmentsSvc attachmentsService,
 	chatsRepo chatsRepository,
 	eventStream eventStream,
+	lifecycleProducer lifecycleProducer,
 	msgRepo messageRepository,
 	options ...OptOptionsSetter,
 ) Options {
@@ -20,10 +22,14 @@ func NewOptions(
 
 	// Setting defaults from field tag (if present)
 
+	o.attachmentsSvc = attachmentsSvc
+
 	o.chatsRepo = chatsRepo
 
 	o.eventStream = eventStream
 
+	o.lifecycleProducer = lifecycleProducer
+
 	o.msgRepo = msgRepo
 
 	for _, opt := range options {
@@ -34,12 +40,21 @@ func NewOptions(
 
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("attachmentsSvc", _validate_Options_attachmentsSvc(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("chatsRepo", _validate_Options_chatsRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("lifecycleProducer", _validate_Options_lifecycleProducer(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
 	return errs.AsError()
 }
 
+func _validate_Options_attachmentsSvc(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.attachmentsSvc, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `attachmentsSvc` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_chatsRepo(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.chatsRepo, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `chatsRepo` did not pass the test: %w", err)
@@ -54,6 +69,13 @@ func _validate_Options_eventStream(o *Options) error {
 	return nil
 }
 
+func _validate_Options_lifecycleProducer(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.lifecycleProducer, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `lifecycleProducer` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_msgRepo(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
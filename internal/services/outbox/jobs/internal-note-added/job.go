@@ -0,0 +1,97 @@
+package internalnoteaddedjob
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
+	"github.com/zestagio/chat-service/internal/services/outbox"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const Name = "internal-note-added"
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	eventStream eventStream       `option:"mandatory" validate:"required"`
+	msgRepo     messageRepository `option:"mandatory" validate:"required"`
+}
+
+// Job notifies the author about the new internal note.
+// Unlike the usual manager message, the note is never sent to the client and to the message queue.
+type Job struct {
+	outbox.DefaultJob
+	Options
+	logger *zap.Logger
+}
+
+func Must(opts Options) *Job {
+	j, err := New(opts)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+	return &Job{
+		Options: opts,
+		logger:  zap.L().Named("job." + Name),
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	j.logger.Info("start processing", zap.String("payload", payload))
+
+	msgID, err := simpleid.Unmarshal[types.MessageID](payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload: %v", err)
+	}
+
+	m, err := j.msgRepo.GetMessageByID(ctx, msgID)
+	if err != nil {
+		return fmt.Errorf("get message: %v", err)
+	}
+
+	if !m.DeletedAt.IsZero() {
+		j.logger.Info("note was deleted, skip", zap.Stringer("message_id", m.ID))
+		return nil
+	}
+
+	// Send update to manager (to oneself).
+	if err := j.eventStream.Publish(ctx, m.AuthorID, eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		m.InitialRequestID,
+		m.ChatID,
+		m.ID,
+		m.AuthorID,
+		m.CreatedAt,
+		m.Body,
+		m.IsService,
+		nil,
+		nil,
+		true,
+	)); err != nil {
+		return fmt.Errorf("publish NewMessageEvent to manager: %v", err)
+	}
+	return nil
+}
@@ -25,6 +25,17 @@ type eventStream interface {
 	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
 }
 
+type lifecycleProducer interface {
+	ProblemManagerAssigned(
+		ctx context.Context,
+		chatID types.ChatID,
+		problemID types.ProblemID,
+		clientID types.UserID,
+		managerID types.UserID,
+		serviceMsgID types.MessageID,
+	) error
+}
+
 type managerLoadService interface {
 	CanManagerTakeProblem(ctx context.Context, managerID types.UserID) (bool, error)
 }
@@ -35,10 +46,11 @@ type messageRepository interface {
 
 //go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
 type Options struct {
-	chatsRepo   chatsRepository    `option:"mandatory" validate:"required"`
-	eventStream eventStream        `option:"mandatory" validate:"required"`
-	msgRepo     messageRepository  `option:"mandatory" validate:"required"`
-	mLoadSvc    managerLoadService `option:"mandatory" validate:"required"`
+	chatsRepo         chatsRepository    `option:"mandatory" validate:"required"`
+	eventStream       eventStream        `option:"mandatory" validate:"required"`
+	lifecycleProducer lifecycleProducer  `option:"mandatory" validate:"required"`
+	msgRepo           messageRepository  `option:"mandatory" validate:"required"`
+	mLoadSvc          managerLoadService `option:"mandatory" validate:"required"`
 }
 
 type Job struct {
@@ -111,6 +123,9 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 				serviceMsg.CreatedAt,
 				serviceMsg.Body,
 				true,
+				nil,
+				nil,
+				false,
 			),
 		); err != nil {
 			return fmt.Errorf("publish service NewMessageEvent: %v", err)
@@ -134,5 +149,20 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 		return nil
 	})
 
+	// Send lifecycle event to analytics.
+	wg.Go(func() error {
+		if err := j.lifecycleProducer.ProblemManagerAssigned(
+			ctx,
+			serviceMsg.ChatID,
+			serviceMsg.ProblemID,
+			clientID,
+			managerID,
+			serviceMsg.ID,
+		); err != nil {
+			return fmt.Errorf("produce lifecycle event: %v", err)
+		}
+		return nil
+	})
+
 	return wg.Wait()
 }
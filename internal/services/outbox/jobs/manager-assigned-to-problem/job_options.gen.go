@@ -13,6 +13,7 @@ type OptOptionsSetter func(o *Options)
 func NewOptions(
 	chatsRepo chatsRepository,
 	eventStream eventStream,
+	lifecycleProducer lifecycleProducer,
 	msgRepo messageRepository,
 	mLoadSvc managerLoadService,
 	options ...OptOptionsSetter,
@@ -25,6 +26,8 @@ func NewOptions(
 
 	o.eventStream = eventStream
 
+	o.lifecycleProducer = lifecycleProducer
+
 	o.msgRepo = msgRepo
 
 	o.mLoadSvc = mLoadSvc
@@ -39,6 +42,7 @@ func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("chatsRepo", _validate_Options_chatsRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("lifecycleProducer", _validate_Options_lifecycleProducer(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("mLoadSvc", _validate_Options_mLoadSvc(o)))
 	return errs.AsError()
@@ -58,6 +62,13 @@ func _validate_Options_eventStream(o *Options) error {
 	return nil
 }
 
+func _validate_Options_lifecycleProducer(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.lifecycleProducer, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `lifecycleProducer` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_msgRepo(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
@@ -0,0 +1,137 @@
+package messagedeletedjob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
+	"github.com/zestagio/chat-service/internal/services/outbox"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=messagedeletedjobmocks
+
+const Name = "message-deleted"
+
+type chatsRepository interface {
+	GetChatClient(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+	GetChatManager(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+type messageRepository interface {
+	GetDeletionByID(ctx context.Context, deletionID types.MessageDeletionID) (*messagesrepo.Deletion, error)
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	chatsRepo   chatsRepository   `option:"mandatory" validate:"required"`
+	eventStream eventStream       `option:"mandatory" validate:"required"`
+	msgRepo     messageRepository `option:"mandatory" validate:"required"`
+}
+
+// Job notifies the chat participants who have seen the message about its deletion.
+type Job struct {
+	outbox.DefaultJob
+	Options
+	logger *zap.Logger
+}
+
+func Must(opts Options) *Job {
+	j, err := New(opts)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+	return &Job{
+		Options: opts,
+		logger:  zap.L().Named("job." + Name),
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	j.logger.Info("start processing", zap.String("payload", payload))
+
+	deletionID, err := simpleid.Unmarshal[types.MessageDeletionID](payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload: %v", err)
+	}
+
+	d, err := j.msgRepo.GetDeletionByID(ctx, deletionID)
+	if err != nil {
+		return fmt.Errorf("get deletion: %v", err)
+	}
+
+	m, err := j.msgRepo.GetMessageByID(ctx, d.MessageID)
+	if err != nil {
+		return fmt.Errorf("get message: %v", err)
+	}
+
+	newEvent := func() eventstream.Event {
+		return eventstream.NewMessageDeletedEvent(
+			types.NewEventID(),
+			d.RequestID,
+			m.ChatID,
+			m.ID,
+			d.CreatedAt,
+		)
+	}
+
+	wg, ctx := errgroup.WithContext(ctx)
+
+	// Send update to client.
+	if m.IsVisibleForClient {
+		wg.Go(func() error {
+			clientID, err := j.chatsRepo.GetChatClient(ctx, m.ChatID)
+			if err != nil {
+				return fmt.Errorf("get chat client: %v", err)
+			}
+
+			if err := j.eventStream.Publish(ctx, clientID, newEvent()); err != nil {
+				return fmt.Errorf("publish MessageDeletedEvent to client: %v", err)
+			}
+			return nil
+		})
+	}
+
+	// Send update to manager.
+	if m.IsVisibleForManager {
+		wg.Go(func() error {
+			managerID, err := j.chatsRepo.GetChatManager(ctx, m.ChatID)
+			if errors.Is(err, chatsrepo.ErrChatWithoutManager) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("get chat manager: %v", err)
+			}
+
+			if err := j.eventStream.Publish(ctx, managerID, newEvent()); err != nil {
+				return fmt.Errorf("publish MessageDeletedEvent to manager: %v", err)
+			}
+			return nil
+		})
+	}
+
+	return wg.Wait()
+}
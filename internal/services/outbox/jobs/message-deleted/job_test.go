@@ -0,0 +1,170 @@
+package messagedeletedjob_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
+	messagedeletedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-deleted"
+	messagedeletedjobmocks "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-deleted/mocks"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chatsRepo := messagedeletedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := messagedeletedjobmocks.NewMockeventStream(ctrl)
+	msgRepo := messagedeletedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := messagedeletedjob.New(messagedeletedjob.NewOptions(chatsRepo, eventStream, msgRepo))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	d, msg := newDeletionAndMessage(clientID)
+
+	msgRepo.EXPECT().GetDeletionByID(gomock.Any(), d.ID).Return(&d, nil)
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+	chatsRepo.EXPECT().GetChatManager(gomock.Any(), msg.ChatID).Return(managerID, nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, newMessageDeletedEventMatcher(d, msg)).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, newMessageDeletedEventMatcher(d, msg)).Return(nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(d.ID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_NotDeliveredToManager(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chatsRepo := messagedeletedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := messagedeletedjobmocks.NewMockeventStream(ctrl)
+	msgRepo := messagedeletedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := messagedeletedjob.New(messagedeletedjob.NewOptions(chatsRepo, eventStream, msgRepo))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	d, msg := newDeletionAndMessage(clientID)
+	msg.IsVisibleForManager = false
+
+	msgRepo.EXPECT().GetDeletionByID(gomock.Any(), d.ID).Return(&d, nil)
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, newMessageDeletedEventMatcher(d, msg)).Return(nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(d.ID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_ChatWithoutManager(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chatsRepo := messagedeletedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := messagedeletedjobmocks.NewMockeventStream(ctrl)
+	msgRepo := messagedeletedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := messagedeletedjob.New(messagedeletedjob.NewOptions(chatsRepo, eventStream, msgRepo))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	d, msg := newDeletionAndMessage(clientID)
+
+	msgRepo.EXPECT().GetDeletionByID(gomock.Any(), d.ID).Return(&d, nil)
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+	chatsRepo.EXPECT().GetChatManager(gomock.Any(), msg.ChatID).Return(types.UserIDNil, chatsrepo.ErrChatWithoutManager)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, newMessageDeletedEventMatcher(d, msg)).Return(nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(d.ID))
+	require.NoError(t, err)
+}
+
+func newDeletionAndMessage(authorID types.UserID) (messagesrepo.Deletion, messagesrepo.Message) {
+	msgID := types.NewMessageID()
+	deletedAt := time.Now()
+
+	d := messagesrepo.Deletion{
+		ID:            types.NewMessageDeletionID(),
+		MessageID:     msgID,
+		RequestID:     types.NewRequestID(),
+		DeletedBy:     authorID,
+		DeletedByRole: messagesrepo.DeleterRoleClient,
+		CreatedAt:     deletedAt,
+	}
+	msg := messagesrepo.Message{
+		ID:                  msgID,
+		ChatID:              types.NewChatID(),
+		AuthorID:            authorID,
+		CreatedAt:           deletedAt.Add(-time.Minute),
+		DeletedAt:           deletedAt,
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+		InitialRequestID:    types.NewRequestID(),
+	}
+	return d, msg
+}
+
+var _ gomock.Matcher = messageDeletedEventMatcher{}
+
+type messageDeletedEventMatcher struct {
+	*eventstream.MessageDeletedEvent
+}
+
+func newMessageDeletedEventMatcher(d messagesrepo.Deletion, msg messagesrepo.Message) messageDeletedEventMatcher {
+	return messageDeletedEventMatcher{
+		MessageDeletedEvent: &eventstream.MessageDeletedEvent{
+			EventID:   types.EventIDNil, // No possibility to check.
+			RequestID: d.RequestID,
+			ChatID:    msg.ChatID,
+			MessageID: msg.ID,
+			DeletedAt: d.CreatedAt,
+		},
+	}
+}
+
+func (m messageDeletedEventMatcher) Matches(x any) bool {
+	envelope, ok := x.(eventstream.Event)
+	if !ok {
+		return false
+	}
+
+	ev, ok := envelope.(*eventstream.MessageDeletedEvent)
+	if !ok {
+		return false
+	}
+
+	return !ev.EventID.IsZero() &&
+		ev.RequestID == m.RequestID &&
+		ev.ChatID == m.ChatID &&
+		ev.MessageID == m.MessageID &&
+		ev.DeletedAt.Equal(m.DeletedAt)
+}
+
+func (m messageDeletedEventMatcher) String() string {
+	return fmt.Sprintf("%v", m.MessageDeletedEvent)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job.go
+
+// Package messagedeletedjobmocks is a generated GoMock package.
+package messagedeletedjobmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockchatsRepository is a mock of chatsRepository interface.
+type MockchatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockchatsRepositoryMockRecorder
+}
+
+// MockchatsRepositoryMockRecorder is the mock recorder for MockchatsRepository.
+type MockchatsRepositoryMockRecorder struct {
+	mock *MockchatsRepository
+}
+
+// NewMockchatsRepository creates a new mock instance.
+func NewMockchatsRepository(ctrl *gomock.Controller) *MockchatsRepository {
+	mock := &MockchatsRepository{ctrl: ctrl}
+	mock.recorder = &MockchatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockchatsRepository) EXPECT() *MockchatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetChatClient mocks base method.
+func (m *MockchatsRepository) GetChatClient(ctx context.Context, chatID types.ChatID) (types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatClient", ctx, chatID)
+	ret0, _ := ret[0].(types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatClient indicates an expected call of GetChatClient.
+func (mr *MockchatsRepositoryMockRecorder) GetChatClient(ctx, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatClient", reflect.TypeOf((*MockchatsRepository)(nil).GetChatClient), ctx, chatID)
+}
+
+// GetChatManager mocks base method.
+func (m *MockchatsRepository) GetChatManager(ctx context.Context, chatID types.ChatID) (types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatManager", ctx, chatID)
+	ret0, _ := ret[0].(types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatManager indicates an expected call of GetChatManager.
+func (mr *MockchatsRepositoryMockRecorder) GetChatManager(ctx, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatManager", reflect.TypeOf((*MockchatsRepository)(nil).GetChatManager), ctx, chatID)
+}
+
+// MockeventStream is a mock of eventStream interface.
+type MockeventStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventStreamMockRecorder
+}
+
+// MockeventStreamMockRecorder is the mock recorder for MockeventStream.
+type MockeventStreamMockRecorder struct {
+	mock *MockeventStream
+}
+
+// NewMockeventStream creates a new mock instance.
+func NewMockeventStream(ctrl *gomock.Controller) *MockeventStream {
+	mock := &MockeventStream{ctrl: ctrl}
+	mock.recorder = &MockeventStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventStream) EXPECT() *MockeventStreamMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockeventStream) Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, userID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockeventStreamMockRecorder) Publish(ctx, userID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockeventStream)(nil).Publish), ctx, userID, event)
+}
+
+// MockmessageRepository is a mock of messageRepository interface.
+type MockmessageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessageRepositoryMockRecorder
+}
+
+// MockmessageRepositoryMockRecorder is the mock recorder for MockmessageRepository.
+type MockmessageRepositoryMockRecorder struct {
+	mock *MockmessageRepository
+}
+
+// NewMockmessageRepository creates a new mock instance.
+func NewMockmessageRepository(ctrl *gomock.Controller) *MockmessageRepository {
+	mock := &MockmessageRepository{ctrl: ctrl}
+	mock.recorder = &MockmessageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessageRepository) EXPECT() *MockmessageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetDeletionByID mocks base method.
+func (m *MockmessageRepository) GetDeletionByID(ctx context.Context, deletionID types.MessageDeletionID) (*messagesrepo.Deletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletionByID", ctx, deletionID)
+	ret0, _ := ret[0].(*messagesrepo.Deletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletionByID indicates an expected call of GetDeletionByID.
+func (mr *MockmessageRepositoryMockRecorder) GetDeletionByID(ctx, deletionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletionByID", reflect.TypeOf((*MockmessageRepository)(nil).GetDeletionByID), ctx, deletionID)
+}
+
+// GetMessageByID mocks base method.
+func (m *MockmessageRepository) GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageByID", ctx, msgID)
+	ret0, _ := ret[0].(*messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageByID indicates an expected call of GetMessageByID.
+func (mr *MockmessageRepositoryMockRecorder) GetMessageByID(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockmessageRepository)(nil).GetMessageByID), ctx, msgID)
+}
@@ -0,0 +1,187 @@
+package messageeditedjob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
+	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
+	"github.com/zestagio/chat-service/internal/services/outbox"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=messageeditedjobmocks
+
+const Name = "message-edited"
+
+type attachmentsService interface {
+	URLs(a messagesrepo.Attachment, viewerID types.UserID) (fileURL, thumbnailURL string)
+}
+
+type chatsRepository interface {
+	GetChatClient(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+type messageProducer interface {
+	ProduceMessage(ctx context.Context, message msgproducer.Message) error
+}
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	GetRevisionByID(ctx context.Context, revID types.MessageRevisionID) (*messagesrepo.Revision, error)
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	attachmentsSvc attachmentsService `option:"mandatory" validate:"required"`
+	chatsRepo      chatsRepository    `option:"mandatory" validate:"required"`
+	eventStream    eventStream        `option:"mandatory" validate:"required"`
+	msgProducer    messageProducer    `option:"mandatory" validate:"required"`
+	msgRepo        messageRepository  `option:"mandatory" validate:"required"`
+}
+
+// Job delivers the message edit to the chat participants.
+// The edited client message is produced to AFC again and reaches the manager
+// only after the new verdict (see client-message-sent job).
+type Job struct {
+	outbox.DefaultJob
+	Options
+	logger *zap.Logger
+}
+
+func Must(opts Options) *Job {
+	j, err := New(opts)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+	return &Job{
+		Options: opts,
+		logger:  zap.L().Named("job." + Name),
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	j.logger.Info("start processing", zap.String("payload", payload))
+
+	revID, err := simpleid.Unmarshal[types.MessageRevisionID](payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload: %v", err)
+	}
+
+	rev, err := j.msgRepo.GetRevisionByID(ctx, revID)
+	if err != nil {
+		if errors.Is(err, messagesrepo.ErrRevisionNotFound) {
+			// The revisions are removed with the deleted message.
+			j.logger.Info("revision was removed, skip", zap.Stringer("revision_id", revID))
+			return nil
+		}
+		return fmt.Errorf("get revision: %v", err)
+	}
+
+	m, err := j.msgRepo.GetMessageByID(ctx, rev.MessageID)
+	if err != nil {
+		return fmt.Errorf("get message: %v", err)
+	}
+
+	if !m.DeletedAt.IsZero() {
+		j.logger.Info("message was deleted, skip", zap.Stringer("message_id", m.ID))
+		return nil
+	}
+
+	clientID, err := j.chatsRepo.GetChatClient(ctx, m.ChatID)
+	if err != nil {
+		return fmt.Errorf("get chat client: %v", err)
+	}
+	fromClient := m.AuthorID == clientID
+
+	// The internal note never leaves the managers.
+	if !m.IsInternalNote {
+		if err := j.msgProducer.ProduceMessage(ctx, msgproducer.Message{
+			ID:               m.ID,
+			ChatID:           m.ChatID,
+			Body:             m.Body,
+			FromClient:       fromClient,
+			InitialRequestID: rev.EditRequestID,
+			CreatedAt:        m.CreatedAt,
+			Attachments:      j.producerAttachments(m.Attachments),
+		}); err != nil {
+			return fmt.Errorf("produce message to queue: %v", err)
+		}
+	}
+
+	newEvent := func() eventstream.Event {
+		return eventstream.NewMessageEditedEvent(
+			types.NewEventID(),
+			rev.EditRequestID,
+			m.ChatID,
+			m.ID,
+			m.EditedAt,
+			m.Body,
+		)
+	}
+
+	wg, ctx := errgroup.WithContext(ctx)
+
+	// Send update to client.
+	if m.IsVisibleForClient {
+		wg.Go(func() error {
+			if err := j.eventStream.Publish(ctx, clientID, newEvent()); err != nil {
+				return fmt.Errorf("publish MessageEditedEvent to client: %v", err)
+			}
+			return nil
+		})
+	}
+
+	// Send update to manager (to oneself).
+	if !fromClient {
+		wg.Go(func() error {
+			if err := j.eventStream.Publish(ctx, m.AuthorID, newEvent()); err != nil {
+				return fmt.Errorf("publish MessageEditedEvent to manager: %v", err)
+			}
+			return nil
+		})
+	}
+
+	return wg.Wait()
+}
+
+// producerAttachments lets AFC recheck the attachments along with the edited body.
+func (j *Job) producerAttachments(attachments []messagesrepo.Attachment) []msgproducer.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	result := make([]msgproducer.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		fileURL, _ := j.attachmentsSvc.URLs(a, types.UserIDNil)
+		result = append(result, msgproducer.Attachment{
+			ID:          a.ID,
+			FileName:    a.FileName,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			URL:         fileURL,
+		})
+	}
+	return result
+}
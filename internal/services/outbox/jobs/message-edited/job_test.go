@@ -0,0 +1,282 @@
+package messageeditedjob_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
+	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
+	messageeditedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-edited"
+	messageeditedjobmocks "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-edited/mocks"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+func TestJob_Handle_ClientMessage(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachmentsSvc := messageeditedjobmocks.NewMockattachmentsService(ctrl)
+	chatsRepo := messageeditedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := messageeditedjobmocks.NewMockeventStream(ctrl)
+	msgProducer := messageeditedjobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := messageeditedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := messageeditedjob.New(messageeditedjob.NewOptions(attachmentsSvc, chatsRepo, eventStream, msgProducer, msgRepo))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	rev, msg := newRevisionAndMessage(clientID)
+
+	msgRepo.EXPECT().GetRevisionByID(gomock.Any(), rev.ID).Return(&rev, nil)
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
+		ID:               msg.ID,
+		ChatID:           msg.ChatID,
+		Body:             msg.Body,
+		FromClient:       true,
+		InitialRequestID: rev.EditRequestID,
+		CreatedAt:        msg.CreatedAt,
+	}).Return(nil)
+
+	// The manager is notified after the AFC recheck.
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, newMessageEditedEventMatcher(rev, msg)).Return(nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(rev.ID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_WithAttachments(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachmentsSvc := messageeditedjobmocks.NewMockattachmentsService(ctrl)
+	chatsRepo := messageeditedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := messageeditedjobmocks.NewMockeventStream(ctrl)
+	msgProducer := messageeditedjobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := messageeditedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := messageeditedjob.New(messageeditedjob.NewOptions(attachmentsSvc, chatsRepo, eventStream, msgProducer, msgRepo))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	rev, msg := newRevisionAndMessage(clientID)
+	attachment := messagesrepo.Attachment{
+		ID:          types.NewAttachmentID(),
+		ChatID:      msg.ChatID,
+		MessageID:   msg.ID,
+		FileName:    "statement.pdf",
+		ContentType: "application/pdf",
+		Size:        4096,
+	}
+	msg.Attachments = []messagesrepo.Attachment{attachment}
+
+	msgRepo.EXPECT().GetRevisionByID(gomock.Any(), rev.ID).Return(&rev, nil)
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+
+	const fileURL = "/attachments/1?signature=a"
+	attachmentsSvc.EXPECT().URLs(attachment, types.UserIDNil).Return(fileURL, "")
+
+	// The attachments are rechecked along with the edited body.
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
+		ID:               msg.ID,
+		ChatID:           msg.ChatID,
+		Body:             msg.Body,
+		FromClient:       true,
+		InitialRequestID: rev.EditRequestID,
+		CreatedAt:        msg.CreatedAt,
+		Attachments: []msgproducer.Attachment{{
+			ID:          attachment.ID,
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			Size:        attachment.Size,
+			URL:         fileURL,
+		}},
+	}).Return(nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, newMessageEditedEventMatcher(rev, msg)).Return(nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(rev.ID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_ManagerMessage(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachmentsSvc := messageeditedjobmocks.NewMockattachmentsService(ctrl)
+	chatsRepo := messageeditedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := messageeditedjobmocks.NewMockeventStream(ctrl)
+	msgProducer := messageeditedjobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := messageeditedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := messageeditedjob.New(messageeditedjob.NewOptions(attachmentsSvc, chatsRepo, eventStream, msgProducer, msgRepo))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	rev, msg := newRevisionAndMessage(managerID)
+
+	msgRepo.EXPECT().GetRevisionByID(gomock.Any(), rev.ID).Return(&rev, nil)
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
+		ID:               msg.ID,
+		ChatID:           msg.ChatID,
+		Body:             msg.Body,
+		FromClient:       false,
+		InitialRequestID: rev.EditRequestID,
+		CreatedAt:        msg.CreatedAt,
+	}).Return(nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, newMessageEditedEventMatcher(rev, msg)).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, newMessageEditedEventMatcher(rev, msg)).Return(nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(rev.ID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_InternalNote(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachmentsSvc := messageeditedjobmocks.NewMockattachmentsService(ctrl)
+	chatsRepo := messageeditedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := messageeditedjobmocks.NewMockeventStream(ctrl)
+	msgProducer := messageeditedjobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := messageeditedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := messageeditedjob.New(messageeditedjob.NewOptions(attachmentsSvc, chatsRepo, eventStream, msgProducer, msgRepo))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	rev, msg := newRevisionAndMessage(managerID)
+	msg.IsVisibleForClient = false
+	msg.IsInternalNote = true
+
+	msgRepo.EXPECT().GetRevisionByID(gomock.Any(), rev.ID).Return(&rev, nil)
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+
+	// Neither the queue nor the client get the note.
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, newMessageEditedEventMatcher(rev, msg)).Return(nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(rev.ID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_RevisionRemoved(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachmentsSvc := messageeditedjobmocks.NewMockattachmentsService(ctrl)
+	chatsRepo := messageeditedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := messageeditedjobmocks.NewMockeventStream(ctrl)
+	msgProducer := messageeditedjobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := messageeditedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := messageeditedjob.New(messageeditedjob.NewOptions(attachmentsSvc, chatsRepo, eventStream, msgProducer, msgRepo))
+	require.NoError(t, err)
+
+	// The message was deleted together with its revisions before the job.
+	revID := types.NewMessageRevisionID()
+	msgRepo.EXPECT().GetRevisionByID(gomock.Any(), revID).
+		Return(nil, fmt.Errorf("revision %v: %w", revID, messagesrepo.ErrRevisionNotFound))
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(revID))
+	require.NoError(t, err)
+}
+
+func newRevisionAndMessage(authorID types.UserID) (messagesrepo.Revision, messagesrepo.Message) {
+	msgID := types.NewMessageID()
+	editedAt := time.Now()
+
+	rev := messagesrepo.Revision{
+		ID:            types.NewMessageRevisionID(),
+		MessageID:     msgID,
+		Body:          "Hello!",
+		EditRequestID: types.NewRequestID(),
+		CreatedAt:     editedAt,
+	}
+	msg := messagesrepo.Message{
+		ID:               msgID,
+		ChatID:           types.NewChatID(),
+		AuthorID:         authorID,
+		Body:             "Hello, world!",
+		CreatedAt:        editedAt.Add(-time.Minute),
+		EditedAt:         editedAt,
+		InitialRequestID: types.NewRequestID(),
+
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+	}
+	return rev, msg
+}
+
+var _ gomock.Matcher = messageEditedEventMatcher{}
+
+type messageEditedEventMatcher struct {
+	*eventstream.MessageEditedEvent
+}
+
+func newMessageEditedEventMatcher(rev messagesrepo.Revision, msg messagesrepo.Message) messageEditedEventMatcher {
+	return messageEditedEventMatcher{
+		MessageEditedEvent: &eventstream.MessageEditedEvent{
+			EventID:     types.EventIDNil, // No possibility to check.
+			RequestID:   rev.EditRequestID,
+			ChatID:      msg.ChatID,
+			MessageID:   msg.ID,
+			EditedAt:    msg.EditedAt,
+			MessageBody: msg.Body,
+		},
+	}
+}
+
+func (m messageEditedEventMatcher) Matches(x any) bool {
+	envelope, ok := x.(eventstream.Event)
+	if !ok {
+		return false
+	}
+
+	ev, ok := envelope.(*eventstream.MessageEditedEvent)
+	if !ok {
+		return false
+	}
+
+	return !ev.EventID.IsZero() &&
+		ev.RequestID == m.RequestID &&
+		ev.ChatID == m.ChatID &&
+		ev.MessageID == m.MessageID &&
+		ev.EditedAt.Equal(m.EditedAt) &&
+		ev.MessageBody == m.MessageBody
+}
+
+func (m messageEditedEventMatcher) String() string {
+	return fmt.Sprintf("%v", m.MessageEditedEvent)
+}
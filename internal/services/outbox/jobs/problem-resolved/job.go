@@ -3,6 +3,7 @@ package problemresolvedjob
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -25,6 +26,20 @@ type eventStream interface {
 	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
 }
 
+type lifecycleProducer interface {
+	ProblemResolved(
+		ctx context.Context,
+		chatID types.ChatID,
+		problemID types.ProblemID,
+		clientID types.UserID,
+		managerID types.UserID,
+		category string,
+		resolutionCode string,
+		resolutionSummary string,
+		resolveRequestID types.RequestID,
+	) error
+}
+
 type managerLoadService interface {
 	CanManagerTakeProblem(ctx context.Context, managerID types.UserID) (bool, error)
 }
@@ -39,11 +54,15 @@ type problemRepository interface {
 
 //go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
 type Options struct {
-	chatsRepo   chatsRepository    `option:"mandatory" validate:"required"`
-	eventStream eventStream        `option:"mandatory" validate:"required"`
-	mLoadSvc    managerLoadService `option:"mandatory" validate:"required"`
-	msgRepo     messageRepository  `option:"mandatory" validate:"required"`
-	problemRepo problemRepository  `option:"mandatory" validate:"required"`
+	chatsRepo         chatsRepository    `option:"mandatory" validate:"required"`
+	eventStream       eventStream        `option:"mandatory" validate:"required"`
+	lifecycleProducer lifecycleProducer  `option:"mandatory" validate:"required"`
+	mLoadSvc          managerLoadService `option:"mandatory" validate:"required"`
+	msgRepo           messageRepository  `option:"mandatory" validate:"required"`
+	problemRepo       problemRepository  `option:"mandatory" validate:"required"`
+
+	// ratingWindow is how long after the resolution the client can rate the problem.
+	ratingWindow time.Duration `option:"mandatory" validate:"min=1m"`
 }
 
 type Job struct {
@@ -115,9 +134,27 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 			serviceMsg.CreatedAt,
 			serviceMsg.Body,
 			true,
+			nil,
+			nil,
+			false,
 		)); err != nil {
 			return fmt.Errorf("publish service NewMessageEvent to client: %v", err)
 		}
+
+		// Ask the client to rate the resolution right after the farewell message.
+		// The reopened problem is rated once, so the client is not asked again.
+		if !problem.RatedAt.IsZero() {
+			return nil
+		}
+		if err := j.eventStream.Publish(ctx, clientID, eventstream.NewRateProblemRequestEvent(
+			types.NewEventID(),
+			serviceMsg.InitialRequestID,
+			serviceMsg.ChatID,
+			problem.ID,
+			problem.ResolvedAt.Add(j.ratingWindow),
+		)); err != nil {
+			return fmt.Errorf("publish RateProblemRequestEvent to client: %v", err)
+		}
 		return nil
 	})
 
@@ -134,5 +171,23 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 		return nil
 	})
 
+	// Send lifecycle event to analytics.
+	wg.Go(func() error {
+		if err := j.lifecycleProducer.ProblemResolved(
+			ctx,
+			serviceMsg.ChatID,
+			problem.ID,
+			clientID,
+			problem.ManagerID,
+			problem.Category,
+			problem.ResolutionCode,
+			problem.ResolutionSummary,
+			requestID,
+		); err != nil {
+			return fmt.Errorf("produce lifecycle event: %v", err)
+		}
+		return nil
+	})
+
 	return wg.Wait()
 }
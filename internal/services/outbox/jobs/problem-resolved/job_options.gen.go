@@ -3,6 +3,7 @@ package problemresolvedjob
 
 import (
 	fmt461e464ebed9 "fmt"
+	"time"
 
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
@@ -13,9 +14,11 @@ type OptOptionsSetter func(o *Options)
 func NewOptions(
 	chatsRepo chatsRepository,
 	eventStream eventStream,
+	lifecycleProducer lifecycleProducer,
 	mLoadSvc managerLoadService,
 	msgRepo messageRepository,
 	problemRepo problemRepository,
+	ratingWindow time.Duration,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -26,12 +29,16 @@ func NewOptions(
 
 	o.eventStream = eventStream
 
+	o.lifecycleProducer = lifecycleProducer
+
 	o.mLoadSvc = mLoadSvc
 
 	o.msgRepo = msgRepo
 
 	o.problemRepo = problemRepo
 
+	o.ratingWindow = ratingWindow
+
 	for _, opt := range options {
 		opt(&o)
 	}
@@ -42,9 +49,11 @@ func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("chatsRepo", _validate_Options_chatsRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("lifecycleProducer", _validate_Options_lifecycleProducer(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("mLoadSvc", _validate_Options_mLoadSvc(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("problemRepo", _validate_Options_problemRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("ratingWindow", _validate_Options_ratingWindow(o)))
 	return errs.AsError()
 }
 
@@ -62,6 +71,13 @@ func _validate_Options_eventStream(o *Options) error {
 	return nil
 }
 
+func _validate_Options_lifecycleProducer(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.lifecycleProducer, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `lifecycleProducer` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_mLoadSvc(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.mLoadSvc, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `mLoadSvc` did not pass the test: %w", err)
@@ -82,3 +98,10 @@ func _validate_Options_problemRepo(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_ratingWindow(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.ratingWindow, "min=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `ratingWindow` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package reactionschangedjob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
+	"github.com/zestagio/chat-service/internal/services/outbox"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=reactionschangedjobmocks
+
+const Name = "reactions-changed"
+
+type chatsRepository interface {
+	GetChatClient(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+	GetChatManager(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	GetMessageReactions(ctx context.Context, msgID types.MessageID) ([]messagesrepo.Reaction, error)
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	chatsRepo   chatsRepository   `option:"mandatory" validate:"required"`
+	eventStream eventStream       `option:"mandatory" validate:"required"`
+	msgRepo     messageRepository `option:"mandatory" validate:"required"`
+}
+
+// Job delivers the actual reactions on the message to the chat participants who see it.
+// The reactions are read at the moment of processing, so the late job never rolls them back.
+type Job struct {
+	outbox.DefaultJob
+	Options
+	logger *zap.Logger
+}
+
+func Must(opts Options) *Job {
+	j, err := New(opts)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+	return &Job{
+		Options: opts,
+		logger:  zap.L().Named("job." + Name),
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	j.logger.Info("start processing", zap.String("payload", payload))
+
+	p, err := unmarshalPayload(payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload: %v", err)
+	}
+
+	m, err := j.msgRepo.GetMessageByID(ctx, p.MessageID)
+	if err != nil {
+		return fmt.Errorf("get message: %v", err)
+	}
+
+	if !m.DeletedAt.IsZero() {
+		j.logger.Info("message was deleted, skip", zap.Stringer("message_id", m.ID))
+		return nil
+	}
+
+	reactions, err := j.msgRepo.GetMessageReactions(ctx, m.ID)
+	if err != nil {
+		return fmt.Errorf("get message reactions: %v", err)
+	}
+
+	newEvent := func(receiverID types.UserID) eventstream.Event {
+		return eventstream.NewReactionsChangedEvent(
+			types.NewEventID(),
+			p.RequestID,
+			m.ChatID,
+			m.ID,
+			adaptReactions(messagesrepo.CountReactions(reactions, receiverID)),
+		)
+	}
+
+	wg, ctx := errgroup.WithContext(ctx)
+
+	// Send update to client.
+	if m.IsVisibleForClient {
+		wg.Go(func() error {
+			clientID, err := j.chatsRepo.GetChatClient(ctx, m.ChatID)
+			if err != nil {
+				return fmt.Errorf("get chat client: %v", err)
+			}
+
+			if err := j.eventStream.Publish(ctx, clientID, newEvent(clientID)); err != nil {
+				return fmt.Errorf("publish ReactionsChangedEvent to client: %v", err)
+			}
+			return nil
+		})
+	}
+
+	// Send update to manager.
+	if m.IsVisibleForManager {
+		wg.Go(func() error {
+			managerID, err := j.chatsRepo.GetChatManager(ctx, m.ChatID)
+			if errors.Is(err, chatsrepo.ErrChatWithoutManager) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("get chat manager: %v", err)
+			}
+
+			if err := j.eventStream.Publish(ctx, managerID, newEvent(managerID)); err != nil {
+				return fmt.Errorf("publish ReactionsChangedEvent to manager: %v", err)
+			}
+			return nil
+		})
+	}
+
+	return wg.Wait()
+}
+
+func adaptReactions(counts []messagesrepo.ReactionsCount) []eventstream.Reaction {
+	result := make([]eventstream.Reaction, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, eventstream.Reaction{
+			Kind:        string(c.Kind),
+			Count:       c.Count,
+			ReactedByMe: c.ReactedByMe,
+		})
+	}
+	return result
+}
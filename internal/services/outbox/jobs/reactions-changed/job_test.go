@@ -0,0 +1,189 @@
+package reactionschangedjob_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
+	reactionschangedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/reactions-changed"
+	reactionschangedjobmocks "github.com/zestagio/chat-service/internal/services/outbox/jobs/reactions-changed/mocks"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chatsRepo := reactionschangedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := reactionschangedjobmocks.NewMockeventStream(ctrl)
+	msgRepo := reactionschangedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := reactionschangedjob.New(reactionschangedjob.NewOptions(chatsRepo, eventStream, msgRepo))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	reqID := types.NewRequestID()
+	msg := newMessage(managerID)
+	reactions := []messagesrepo.Reaction{
+		{ID: types.NewMessageReactionID(), MessageID: msg.ID, UserID: clientID, Kind: messagesrepo.ReactionKindThumbsUp},
+		{ID: types.NewMessageReactionID(), MessageID: msg.ID, UserID: managerID, Kind: messagesrepo.ReactionKindHeart},
+	}
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	msgRepo.EXPECT().GetMessageReactions(gomock.Any(), msg.ID).Return(reactions, nil)
+	chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+	chatsRepo.EXPECT().GetChatManager(gomock.Any(), msg.ChatID).Return(managerID, nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, newReactionsChangedEventMatcher(reqID, msg, []eventstream.Reaction{
+		{Kind: "thumbs_up", Count: 1, ReactedByMe: true},
+		{Kind: "heart", Count: 1, ReactedByMe: false},
+	})).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, newReactionsChangedEventMatcher(reqID, msg, []eventstream.Reaction{
+		{Kind: "thumbs_up", Count: 1, ReactedByMe: false},
+		{Kind: "heart", Count: 1, ReactedByMe: true},
+	})).Return(nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, reactionschangedjob.MustMarshalPayload(msg.ID, reqID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_LastReactionRemoved(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chatsRepo := reactionschangedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := reactionschangedjobmocks.NewMockeventStream(ctrl)
+	msgRepo := reactionschangedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := reactionschangedjob.New(reactionschangedjob.NewOptions(chatsRepo, eventStream, msgRepo))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	reqID := types.NewRequestID()
+	msg := newMessage(types.NewUserID())
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	msgRepo.EXPECT().GetMessageReactions(gomock.Any(), msg.ID).Return(nil, nil)
+	chatsRepo.EXPECT().GetChatClient(gomock.Any(), msg.ChatID).Return(clientID, nil)
+	chatsRepo.EXPECT().GetChatManager(gomock.Any(), msg.ChatID).Return(types.UserIDNil, chatsrepo.ErrChatWithoutManager)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, newReactionsChangedEventMatcher(reqID, msg, nil)).Return(nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, reactionschangedjob.MustMarshalPayload(msg.ID, reqID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_MessageDeleted(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chatsRepo := reactionschangedjobmocks.NewMockchatsRepository(ctrl)
+	eventStream := reactionschangedjobmocks.NewMockeventStream(ctrl)
+	msgRepo := reactionschangedjobmocks.NewMockmessageRepository(ctrl)
+	job, err := reactionschangedjob.New(reactionschangedjob.NewOptions(chatsRepo, eventStream, msgRepo))
+	require.NoError(t, err)
+
+	msg := newMessage(types.NewUserID())
+	msg.DeletedAt = time.Now()
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, reactionschangedjob.MustMarshalPayload(msg.ID, types.NewRequestID()))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_InvalidPayload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	job, err := reactionschangedjob.New(reactionschangedjob.NewOptions(
+		reactionschangedjobmocks.NewMockchatsRepository(ctrl),
+		reactionschangedjobmocks.NewMockeventStream(ctrl),
+		reactionschangedjobmocks.NewMockmessageRepository(ctrl),
+	))
+	require.NoError(t, err)
+
+	for _, payload := range []string{"", "{}", types.NewMessageID().String()} {
+		require.Error(t, job.Handle(context.Background(), payload))
+	}
+}
+
+func newMessage(authorID types.UserID) messagesrepo.Message {
+	return messagesrepo.Message{
+		ID:                  types.NewMessageID(),
+		ChatID:              types.NewChatID(),
+		AuthorID:            authorID,
+		Body:                "Hello!",
+		CreatedAt:           time.Now().Add(-time.Minute),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+		InitialRequestID:    types.NewRequestID(),
+	}
+}
+
+var _ gomock.Matcher = reactionsChangedEventMatcher{}
+
+type reactionsChangedEventMatcher struct {
+	*eventstream.ReactionsChangedEvent
+}
+
+func newReactionsChangedEventMatcher(
+	reqID types.RequestID,
+	msg messagesrepo.Message,
+	reactions []eventstream.Reaction,
+) reactionsChangedEventMatcher {
+	if reactions == nil {
+		reactions = []eventstream.Reaction{}
+	}
+
+	return reactionsChangedEventMatcher{
+		ReactionsChangedEvent: &eventstream.ReactionsChangedEvent{
+			EventID:   types.EventIDNil, // No possibility to check.
+			RequestID: reqID,
+			ChatID:    msg.ChatID,
+			MessageID: msg.ID,
+			Reactions: reactions,
+		},
+	}
+}
+
+func (m reactionsChangedEventMatcher) Matches(x any) bool {
+	envelope, ok := x.(eventstream.Event)
+	if !ok {
+		return false
+	}
+
+	ev, ok := envelope.(*eventstream.ReactionsChangedEvent)
+	if !ok {
+		return false
+	}
+
+	return !ev.EventID.IsZero() &&
+		ev.RequestID == m.RequestID &&
+		ev.ChatID == m.ChatID &&
+		ev.MessageID == m.MessageID &&
+		reflect.DeepEqual(ev.Reactions, m.Reactions)
+}
+
+func (m reactionsChangedEventMatcher) String() string {
+	return fmt.Sprintf("%v", m.ReactionsChangedEvent)
+}
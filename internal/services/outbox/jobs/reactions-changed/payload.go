@@ -0,0 +1,45 @@
+package reactionschangedjob
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type payload struct {
+	MessageID types.MessageID `json:"messageId"`
+	RequestID types.RequestID `json:"requestId"`
+}
+
+func MustMarshalPayload(msgID types.MessageID, reqID types.RequestID) string {
+	v, err := MarshalPayload(msgID, reqID)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func MarshalPayload(msgID types.MessageID, reqID types.RequestID) (string, error) {
+	if msgID.IsZero() || reqID.IsZero() {
+		return "", errors.New("zero identifier")
+	}
+
+	data, err := json.Marshal(payload{MessageID: msgID, RequestID: reqID})
+	if err != nil {
+		return "", fmt.Errorf("marshal: %v", err)
+	}
+	return string(data), nil
+}
+
+func unmarshalPayload(data string) (payload, error) {
+	var p payload
+	if err := json.Unmarshal([]byte(data), &p); err != nil {
+		return payload{}, fmt.Errorf("unmarshal: %v", err)
+	}
+	if p.MessageID.IsZero() || p.RequestID.IsZero() {
+		return payload{}, errors.New("zero identifier")
+	}
+	return p, nil
+}
@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
 	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
 	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
 	"github.com/zestagio/chat-service/internal/services/outbox"
@@ -18,23 +19,45 @@ import (
 
 const Name = "send-client-message"
 
+type attachmentsService interface {
+	URLs(a messagesrepo.Attachment, viewerID types.UserID) (fileURL, thumbnailURL string)
+}
+
 type eventStream interface {
 	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
 }
 
+type lifecycleProducer interface {
+	ProblemCreated(
+		ctx context.Context,
+		chatID types.ChatID,
+		problemID types.ProblemID,
+		clientID types.UserID,
+		reopenedFromID types.ProblemID,
+	) error
+}
+
 type messageProducer interface {
 	ProduceMessage(ctx context.Context, message msgproducer.Message) error
 }
 
 type messageRepository interface {
 	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	GetProblemFirstMessageID(ctx context.Context, problemID types.ProblemID) (types.MessageID, error)
+}
+
+type problemRepository interface {
+	GetProblemByID(ctx context.Context, problemID types.ProblemID) (*problemsrepo.Problem, error)
 }
 
 //go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
 type Options struct {
-	eventStream eventStream       `option:"mandatory" validate:"required"`
-	msgProducer messageProducer   `option:"mandatory" validate:"required"`
-	msgRepo     messageRepository `option:"mandatory" validate:"required"`
+	attachmentsSvc    attachmentsService `option:"mandatory" validate:"required"`
+	eventStream       eventStream        `option:"mandatory" validate:"required"`
+	lifecycleProducer lifecycleProducer  `option:"mandatory" validate:"required"`
+	msgProducer       messageProducer    `option:"mandatory" validate:"required"`
+	msgRepo           messageRepository  `option:"mandatory" validate:"required"`
+	problemRepo       problemRepository  `option:"mandatory" validate:"required"`
 }
 
 type Job struct {
@@ -78,11 +101,24 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 		return fmt.Errorf("get message: %v", err)
 	}
 
+	// The problem is created even if its first message is deleted before sending.
+	if err := j.produceProblemCreated(ctx, m); err != nil {
+		return err
+	}
+
+	if !m.DeletedAt.IsZero() {
+		j.logger.Info("message was deleted, skip", zap.Stringer("message_id", m.ID))
+		return nil
+	}
+
 	if err := j.msgProducer.ProduceMessage(ctx, msgproducer.Message{
-		ID:         m.ID,
-		ChatID:     m.ChatID,
-		Body:       m.Body,
-		FromClient: true,
+		ID:               m.ID,
+		ChatID:           m.ChatID,
+		Body:             m.Body,
+		FromClient:       true,
+		InitialRequestID: m.InitialRequestID,
+		CreatedAt:        m.CreatedAt,
+		Attachments:      j.producerAttachments(m.Attachments),
 	}); err != nil {
 		return fmt.Errorf("produce message to queue: %v", err)
 	}
@@ -97,6 +133,9 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 			m.CreatedAt,
 			m.Body,
 			m.IsService,
+			j.eventAttachments(m.Attachments, m.AuthorID),
+			adaptQuote(m.ReplyTo.ForClient()),
+			false,
 		),
 	); err != nil {
 		return fmt.Errorf("publish NewMessageEvent to client stream: %v", err)
@@ -104,3 +143,74 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 
 	return nil
 }
+
+// produceProblemCreated sends the lifecycle event if the message started the problem.
+func (j *Job) produceProblemCreated(ctx context.Context, m *messagesrepo.Message) error {
+	firstMsgID, err := j.msgRepo.GetProblemFirstMessageID(ctx, m.ProblemID)
+	if err != nil {
+		return fmt.Errorf("get problem first message: %v", err)
+	}
+	if firstMsgID != m.ID {
+		return nil
+	}
+
+	p, err := j.problemRepo.GetProblemByID(ctx, m.ProblemID)
+	if err != nil {
+		return fmt.Errorf("get problem: %v", err)
+	}
+
+	if err := j.lifecycleProducer.ProblemCreated(ctx, m.ChatID, p.ID, m.AuthorID, p.ReopenedFromID); err != nil {
+		return fmt.Errorf("produce lifecycle event: %v", err)
+	}
+	return nil
+}
+
+func (j *Job) producerAttachments(attachments []messagesrepo.Attachment) []msgproducer.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	result := make([]msgproducer.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		fileURL, _ := j.attachmentsSvc.URLs(a, types.UserIDNil)
+		result = append(result, msgproducer.Attachment{
+			ID:          a.ID,
+			FileName:    a.FileName,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			URL:         fileURL,
+		})
+	}
+	return result
+}
+
+func (j *Job) eventAttachments(attachments []messagesrepo.Attachment, viewerID types.UserID) []eventstream.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	result := make([]eventstream.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		fileURL, thumbnailURL := j.attachmentsSvc.URLs(a, viewerID)
+		result = append(result, eventstream.Attachment{
+			ID:           a.ID,
+			FileName:     a.FileName,
+			ContentType:  a.ContentType,
+			Size:         a.Size,
+			URL:          fileURL,
+			ThumbnailURL: thumbnailURL,
+		})
+	}
+	return result
+}
+
+func adaptQuote(q *messagesrepo.Quote) *eventstream.Quote {
+	if q == nil {
+		return nil
+	}
+	return &eventstream.Quote{
+		MessageID: q.MessageID,
+		AuthorID:  q.AuthorID,
+		Preview:   q.Preview,
+	}
+}
@@ -11,21 +11,30 @@ import (
 type OptOptionsSetter func(o *Options)
 
 func NewOptions(
+	attachmentsSvc attachmentsService,
 	eventStream eventStream,
+	lifecycleProducer lifecycleProducer,
 	msgProducer messageProducer,
 	msgRepo messageRepository,
+	problemRepo problemRepository,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
 
 	// Setting defaults from field tag (if present)
 
+	o.attachmentsSvc = attachmentsSvc
+
 	o.eventStream = eventStream
 
+	o.lifecycleProducer = lifecycleProducer
+
 	o.msgProducer = msgProducer
 
 	o.msgRepo = msgRepo
 
+	o.problemRepo = problemRepo
+
 	for _, opt := range options {
 		opt(&o)
 	}
@@ -34,12 +43,22 @@ func NewOptions(
 
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("attachmentsSvc", _validate_Options_attachmentsSvc(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("lifecycleProducer", _validate_Options_lifecycleProducer(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("msgProducer", _validate_Options_msgProducer(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("problemRepo", _validate_Options_problemRepo(o)))
 	return errs.AsError()
 }
 
+func _validate_Options_attachmentsSvc(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.attachmentsSvc, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `attachmentsSvc` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_eventStream(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.eventStream, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `eventStream` did not pass the test: %w", err)
@@ -47,6 +66,13 @@ func _validate_Options_eventStream(o *Options) error {
 	return nil
 }
 
+func _validate_Options_lifecycleProducer(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.lifecycleProducer, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `lifecycleProducer` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_msgProducer(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgProducer, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `msgProducer` did not pass the test: %w", err)
@@ -60,3 +86,10 @@ func _validate_Options_msgRepo(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_problemRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.problemRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `problemRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package sendclientmessagejob_test
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
 	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
 	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
 	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
@@ -25,10 +27,20 @@ func TestJob_Handle(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	attachmentsSvc := sendclientmessagejobmocks.NewMockattachmentsService(ctrl)
 	eventStream := sendclientmessagejobmocks.NewMockeventStream(ctrl)
+	lifecycleProducer := sendclientmessagejobmocks.NewMocklifecycleProducer(ctrl)
 	msgProducer := sendclientmessagejobmocks.NewMockmessageProducer(ctrl)
 	msgRepo := sendclientmessagejobmocks.NewMockmessageRepository(ctrl)
-	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(eventStream, msgProducer, msgRepo))
+	problemRepo := sendclientmessagejobmocks.NewMockproblemRepository(ctrl)
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(
+		attachmentsSvc,
+		eventStream,
+		lifecycleProducer,
+		msgProducer,
+		msgRepo,
+		problemRepo,
+	))
 	require.NoError(t, err)
 
 	clientID := types.NewUserID()
@@ -49,12 +61,15 @@ func TestJob_Handle(t *testing.T) {
 		InitialRequestID:    types.NewRequestID(),
 	}
 	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+	msgRepo.EXPECT().GetProblemFirstMessageID(gomock.Any(), msg.ProblemID).Return(types.NewMessageID(), nil)
 
 	msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
-		ID:         msgID,
-		ChatID:     chatID,
-		Body:       body,
-		FromClient: true,
+		ID:               msgID,
+		ChatID:           chatID,
+		Body:             body,
+		FromClient:       true,
+		InitialRequestID: msg.InitialRequestID,
+		CreatedAt:        msg.CreatedAt,
 	}).Return(nil)
 
 	eventStream.EXPECT().Publish(gomock.Any(), clientID,
@@ -76,6 +91,252 @@ func TestJob_Handle(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJob_Handle_WithAttachments(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachmentsSvc := sendclientmessagejobmocks.NewMockattachmentsService(ctrl)
+	eventStream := sendclientmessagejobmocks.NewMockeventStream(ctrl)
+	lifecycleProducer := sendclientmessagejobmocks.NewMocklifecycleProducer(ctrl)
+	msgProducer := sendclientmessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendclientmessagejobmocks.NewMockmessageRepository(ctrl)
+	problemRepo := sendclientmessagejobmocks.NewMockproblemRepository(ctrl)
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(
+		attachmentsSvc,
+		eventStream,
+		lifecycleProducer,
+		msgProducer,
+		msgRepo,
+		problemRepo,
+	))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	attachment := messagesrepo.Attachment{
+		ID:          types.NewAttachmentID(),
+		ChatID:      types.NewChatID(),
+		FileName:    "screenshot.png",
+		ContentType: "image/png",
+		Size:        2048,
+	}
+	msg := messagesrepo.Message{
+		ID:                 msgID,
+		ChatID:             attachment.ChatID,
+		AuthorID:           types.NewUserID(),
+		CreatedAt:          time.Now(),
+		IsVisibleForClient: true,
+		InitialRequestID:   types.NewRequestID(),
+		Attachments:        []messagesrepo.Attachment{attachment},
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+	msgRepo.EXPECT().GetProblemFirstMessageID(gomock.Any(), msg.ProblemID).Return(types.NewMessageID(), nil)
+
+	const fileURL, thumbnailURL = "/attachments/1?signature=a", "/attachments/1?signature=b&variant=thumbnail"
+	attachmentsSvc.EXPECT().URLs(attachment, types.UserIDNil).Return(fileURL, thumbnailURL)
+	attachmentsSvc.EXPECT().URLs(attachment, msg.AuthorID).Return(fileURL, thumbnailURL)
+
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
+		ID:               msgID,
+		ChatID:           msg.ChatID,
+		FromClient:       true,
+		InitialRequestID: msg.InitialRequestID,
+		CreatedAt:        msg.CreatedAt,
+		Attachments: []msgproducer.Attachment{{
+			ID:          attachment.ID,
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			Size:        attachment.Size,
+			URL:         fileURL,
+		}},
+	}).Return(nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), msg.AuthorID,
+		newMessageEventMatcher{
+			NewMessageEvent: &eventstream.NewMessageEvent{
+				RequestID: msg.InitialRequestID,
+				ChatID:    msg.ChatID,
+				MessageID: msg.ID,
+				AuthorID:  msg.AuthorID,
+				CreatedAt: msg.CreatedAt,
+				Attachments: []eventstream.Attachment{{
+					ID:           attachment.ID,
+					FileName:     attachment.FileName,
+					ContentType:  attachment.ContentType,
+					Size:         attachment.Size,
+					URL:          fileURL,
+					ThumbnailURL: thumbnailURL,
+				}},
+			},
+		})
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(msgID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_Reply(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachmentsSvc := sendclientmessagejobmocks.NewMockattachmentsService(ctrl)
+	eventStream := sendclientmessagejobmocks.NewMockeventStream(ctrl)
+	lifecycleProducer := sendclientmessagejobmocks.NewMocklifecycleProducer(ctrl)
+	msgProducer := sendclientmessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendclientmessagejobmocks.NewMockmessageRepository(ctrl)
+	problemRepo := sendclientmessagejobmocks.NewMockproblemRepository(ctrl)
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(
+		attachmentsSvc,
+		eventStream,
+		lifecycleProducer,
+		msgProducer,
+		msgRepo,
+		problemRepo,
+	))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	quote := messagesrepo.Quote{
+		MessageID:           types.NewMessageID(),
+		AuthorID:            types.NewUserID(),
+		Preview:             "Do you have the order number?",
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+	}
+	msg := messagesrepo.Message{
+		ID:                 msgID,
+		ChatID:             types.NewChatID(),
+		AuthorID:           types.NewUserID(),
+		Body:               "Yes",
+		CreatedAt:          time.Now(),
+		IsVisibleForClient: true,
+		InitialRequestID:   types.NewRequestID(),
+		ReplyTo:            &quote,
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+	msgRepo.EXPECT().GetProblemFirstMessageID(gomock.Any(), msg.ProblemID).Return(types.NewMessageID(), nil)
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), gomock.Any()).Return(nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), msg.AuthorID,
+		newMessageEventMatcher{
+			NewMessageEvent: &eventstream.NewMessageEvent{
+				RequestID:   msg.InitialRequestID,
+				ChatID:      msg.ChatID,
+				MessageID:   msg.ID,
+				AuthorID:    msg.AuthorID,
+				CreatedAt:   msg.CreatedAt,
+				MessageBody: msg.Body,
+				ReplyTo: &eventstream.Quote{
+					MessageID: quote.MessageID,
+					AuthorID:  quote.AuthorID,
+					Preview:   quote.Preview,
+				},
+			},
+		})
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(msgID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_ProblemCreated(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachmentsSvc := sendclientmessagejobmocks.NewMockattachmentsService(ctrl)
+	eventStream := sendclientmessagejobmocks.NewMockeventStream(ctrl)
+	lifecycleProducer := sendclientmessagejobmocks.NewMocklifecycleProducer(ctrl)
+	msgProducer := sendclientmessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendclientmessagejobmocks.NewMockmessageRepository(ctrl)
+	problemRepo := sendclientmessagejobmocks.NewMockproblemRepository(ctrl)
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(
+		attachmentsSvc,
+		eventStream,
+		lifecycleProducer,
+		msgProducer,
+		msgRepo,
+		problemRepo,
+	))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	problem := problemsrepo.Problem{
+		ID:             types.NewProblemID(),
+		ChatID:         types.NewChatID(),
+		ReopenedFromID: types.NewProblemID(),
+	}
+	msg := messagesrepo.Message{
+		ID:                 msgID,
+		ChatID:             problem.ChatID,
+		ProblemID:          problem.ID,
+		AuthorID:           types.NewUserID(),
+		Body:               "Hello again!",
+		CreatedAt:          time.Now(),
+		IsVisibleForClient: true,
+		InitialRequestID:   types.NewRequestID(),
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+	msgRepo.EXPECT().GetProblemFirstMessageID(gomock.Any(), problem.ID).Return(msgID, nil)
+	problemRepo.EXPECT().GetProblemByID(gomock.Any(), problem.ID).Return(&problem, nil)
+	lifecycleProducer.EXPECT().ProblemCreated(gomock.Any(), msg.ChatID, problem.ID, msg.AuthorID, problem.ReopenedFromID).
+		Return(nil)
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), gomock.Any()).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), msg.AuthorID, gomock.Any()).Return(nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(msgID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_DeletedMessage(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachmentsSvc := sendclientmessagejobmocks.NewMockattachmentsService(ctrl)
+	eventStream := sendclientmessagejobmocks.NewMockeventStream(ctrl)
+	lifecycleProducer := sendclientmessagejobmocks.NewMocklifecycleProducer(ctrl)
+	msgProducer := sendclientmessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendclientmessagejobmocks.NewMockmessageRepository(ctrl)
+	problemRepo := sendclientmessagejobmocks.NewMockproblemRepository(ctrl)
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(
+		attachmentsSvc,
+		eventStream,
+		lifecycleProducer,
+		msgProducer,
+		msgRepo,
+		problemRepo,
+	))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	msg := messagesrepo.Message{
+		ID:                 msgID,
+		ChatID:             types.NewChatID(),
+		AuthorID:           types.NewUserID(),
+		CreatedAt:          time.Now(),
+		DeletedAt:          time.Now(),
+		IsVisibleForClient: true,
+		InitialRequestID:   types.NewRequestID(),
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+	msgRepo.EXPECT().GetProblemFirstMessageID(gomock.Any(), msg.ProblemID).Return(types.NewMessageID(), nil)
+
+	// Action & assert.
+	err = job.Handle(ctx, simpleid.MustMarshal(msgID))
+	require.NoError(t, err)
+}
+
 var _ gomock.Matcher = newMessageEventMatcher{}
 
 type newMessageEventMatcher struct {
@@ -100,7 +361,9 @@ func (m newMessageEventMatcher) Matches(x any) bool {
 		ev.AuthorID == m.AuthorID &&
 		ev.CreatedAt.Equal(m.CreatedAt) &&
 		ev.MessageBody == m.MessageBody &&
-		ev.IsService == m.IsService
+		ev.IsService == m.IsService &&
+		reflect.DeepEqual(ev.Attachments, m.Attachments) &&
+		reflect.DeepEqual(ev.ReplyTo, m.ReplyTo)
 }
 
 func (m newMessageEventMatcher) String() string {
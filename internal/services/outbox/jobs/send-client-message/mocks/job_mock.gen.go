@@ -10,11 +10,50 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
 	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
 	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
 	types "github.com/zestagio/chat-service/internal/types"
 )
 
+// MockattachmentsService is a mock of attachmentsService interface.
+type MockattachmentsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockattachmentsServiceMockRecorder
+}
+
+// MockattachmentsServiceMockRecorder is the mock recorder for MockattachmentsService.
+type MockattachmentsServiceMockRecorder struct {
+	mock *MockattachmentsService
+}
+
+// NewMockattachmentsService creates a new mock instance.
+func NewMockattachmentsService(ctrl *gomock.Controller) *MockattachmentsService {
+	mock := &MockattachmentsService{ctrl: ctrl}
+	mock.recorder = &MockattachmentsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockattachmentsService) EXPECT() *MockattachmentsServiceMockRecorder {
+	return m.recorder
+}
+
+// URLs mocks base method.
+func (m *MockattachmentsService) URLs(a messagesrepo.Attachment, viewerID types.UserID) (string, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URLs", a, viewerID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// URLs indicates an expected call of URLs.
+func (mr *MockattachmentsServiceMockRecorder) URLs(a, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URLs", reflect.TypeOf((*MockattachmentsService)(nil).URLs), a, viewerID)
+}
+
 // MockeventStream is a mock of eventStream interface.
 type MockeventStream struct {
 	ctrl     *gomock.Controller
@@ -52,6 +91,43 @@ func (mr *MockeventStreamMockRecorder) Publish(ctx, userID, event interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockeventStream)(nil).Publish), ctx, userID, event)
 }
 
+// MocklifecycleProducer is a mock of lifecycleProducer interface.
+type MocklifecycleProducer struct {
+	ctrl     *gomock.Controller
+	recorder *MocklifecycleProducerMockRecorder
+}
+
+// MocklifecycleProducerMockRecorder is the mock recorder for MocklifecycleProducer.
+type MocklifecycleProducerMockRecorder struct {
+	mock *MocklifecycleProducer
+}
+
+// NewMocklifecycleProducer creates a new mock instance.
+func NewMocklifecycleProducer(ctrl *gomock.Controller) *MocklifecycleProducer {
+	mock := &MocklifecycleProducer{ctrl: ctrl}
+	mock.recorder = &MocklifecycleProducerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklifecycleProducer) EXPECT() *MocklifecycleProducerMockRecorder {
+	return m.recorder
+}
+
+// ProblemCreated mocks base method.
+func (m *MocklifecycleProducer) ProblemCreated(ctx context.Context, chatID types.ChatID, problemID types.ProblemID, clientID types.UserID, reopenedFromID types.ProblemID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProblemCreated", ctx, chatID, problemID, clientID, reopenedFromID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProblemCreated indicates an expected call of ProblemCreated.
+func (mr *MocklifecycleProducerMockRecorder) ProblemCreated(ctx, chatID, problemID, clientID, reopenedFromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProblemCreated", reflect.TypeOf((*MocklifecycleProducer)(nil).ProblemCreated), ctx, chatID, problemID, clientID, reopenedFromID)
+}
+
 // MockmessageProducer is a mock of messageProducer interface.
 type MockmessageProducer struct {
 	ctrl     *gomock.Controller
@@ -126,3 +202,56 @@ func (mr *MockmessageRepositoryMockRecorder) GetMessageByID(ctx, msgID interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockmessageRepository)(nil).GetMessageByID), ctx, msgID)
 }
+
+// GetProblemFirstMessageID mocks base method.
+func (m *MockmessageRepository) GetProblemFirstMessageID(ctx context.Context, problemID types.ProblemID) (types.MessageID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProblemFirstMessageID", ctx, problemID)
+	ret0, _ := ret[0].(types.MessageID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProblemFirstMessageID indicates an expected call of GetProblemFirstMessageID.
+func (mr *MockmessageRepositoryMockRecorder) GetProblemFirstMessageID(ctx, problemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblemFirstMessageID", reflect.TypeOf((*MockmessageRepository)(nil).GetProblemFirstMessageID), ctx, problemID)
+}
+
+// MockproblemRepository is a mock of problemRepository interface.
+type MockproblemRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemRepositoryMockRecorder
+}
+
+// MockproblemRepositoryMockRecorder is the mock recorder for MockproblemRepository.
+type MockproblemRepositoryMockRecorder struct {
+	mock *MockproblemRepository
+}
+
+// NewMockproblemRepository creates a new mock instance.
+func NewMockproblemRepository(ctrl *gomock.Controller) *MockproblemRepository {
+	mock := &MockproblemRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemRepository) EXPECT() *MockproblemRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetProblemByID mocks base method.
+func (m *MockproblemRepository) GetProblemByID(ctx context.Context, problemID types.ProblemID) (*problemsrepo.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProblemByID", ctx, problemID)
+	ret0, _ := ret[0].(*problemsrepo.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProblemByID indicates an expected call of GetProblemByID.
+func (mr *MockproblemRepositoryMockRecorder) GetProblemByID(ctx, problemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblemByID", reflect.TypeOf((*MockproblemRepository)(nil).GetProblemByID), ctx, problemID)
+}
@@ -2,11 +2,13 @@ package sendmanagermessagejob
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	chatsrepo "github.com/zestagio/chat-service/internal/repositories/chats"
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
 	eventstream "github.com/zestagio/chat-service/internal/services/event-stream"
 	msgproducer "github.com/zestagio/chat-service/internal/services/msg-producer"
@@ -17,8 +19,13 @@ import (
 
 const Name = "send-manager-message"
 
+type attachmentsService interface {
+	URLs(a messagesrepo.Attachment, viewerID types.UserID) (fileURL, thumbnailURL string)
+}
+
 type chatsRepository interface {
 	GetChatClient(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+	GetChatWithOpenProblem(ctx context.Context, managerID types.UserID, chatID types.ChatID) (*chatsrepo.Chat, error)
 }
 
 type eventStream interface {
@@ -35,10 +42,11 @@ type messageRepository interface {
 
 //go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
 type Options struct {
-	chatsRepo   chatsRepository   `option:"mandatory" validate:"required"`
-	eventStream eventStream       `option:"mandatory" validate:"required"`
-	msgProducer messageProducer   `option:"mandatory" validate:"required"`
-	msgRepo     messageRepository `option:"mandatory" validate:"required"`
+	attachmentsSvc attachmentsService `option:"mandatory" validate:"required"`
+	chatsRepo      chatsRepository    `option:"mandatory" validate:"required"`
+	eventStream    eventStream        `option:"mandatory" validate:"required"`
+	msgProducer    messageProducer    `option:"mandatory" validate:"required"`
+	msgRepo        messageRepository  `option:"mandatory" validate:"required"`
 }
 
 type Job struct {
@@ -82,16 +90,24 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 		return fmt.Errorf("get message: %v", err)
 	}
 
+	if !m.DeletedAt.IsZero() {
+		j.logger.Info("message was deleted, skip", zap.Stringer("message_id", m.ID))
+		return nil
+	}
+
 	clientID, err := j.chatsRepo.GetChatClient(ctx, m.ChatID)
 	if err != nil {
 		return fmt.Errorf("get chat client: %v", err)
 	}
 
 	if err := j.msgProducer.ProduceMessage(ctx, msgproducer.Message{
-		ID:         m.ID,
-		ChatID:     m.ChatID,
-		Body:       m.Body,
-		FromClient: false,
+		ID:               m.ID,
+		ChatID:           m.ChatID,
+		Body:             m.Body,
+		FromClient:       false,
+		InitialRequestID: m.InitialRequestID,
+		CreatedAt:        m.CreatedAt,
+		Attachments:      j.producerAttachments(m.Attachments),
 	}); err != nil {
 		return fmt.Errorf("produce message to queue: %v", err)
 	}
@@ -109,12 +125,15 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 			m.CreatedAt,
 			m.Body,
 			m.IsService,
+			j.eventAttachments(m.Attachments, clientID),
+			adaptQuote(m.ReplyTo.ForClient()),
+			false,
 		))
 	})
 
 	// Send update to manager (to oneself).
 	wg.Go(func() error {
-		return j.eventStream.Publish(ctx, m.AuthorID, eventstream.NewNewMessageEvent(
+		if err := j.eventStream.Publish(ctx, m.AuthorID, eventstream.NewNewMessageEvent(
 			types.NewEventID(),
 			m.InitialRequestID,
 			m.ChatID,
@@ -123,8 +142,101 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 			m.CreatedAt,
 			m.Body,
 			m.IsService,
-		))
+			j.eventAttachments(m.Attachments, m.AuthorID),
+			adaptQuote(m.ReplyTo.ForManager()),
+			false,
+		)); err != nil {
+			return err
+		}
+		return j.publishChatUpdated(ctx, m.AuthorID, m)
 	})
 
 	return wg.Wait()
 }
+
+// publishChatUpdated refreshes the chat in the manager chat list.
+func (j *Job) publishChatUpdated(ctx context.Context, managerID types.UserID, m *messagesrepo.Message) error {
+	c, err := j.chatsRepo.GetChatWithOpenProblem(ctx, managerID, m.ChatID)
+	if err != nil {
+		if errors.Is(err, chatsrepo.ErrChatNotFound) {
+			// The problem has been resolved meanwhile.
+			return nil
+		}
+		return fmt.Errorf("get chat with open problem: %v", err)
+	}
+
+	if err := j.eventStream.Publish(ctx, managerID, eventstream.NewChatUpdatedEvent(
+		types.NewEventID(),
+		m.InitialRequestID,
+		c.ID,
+		c.ProblemCreatedAt,
+		c.UnreadCount,
+		adaptLastMessage(c.LastMessage),
+		c.WaitingSince,
+	)); err != nil {
+		return fmt.Errorf("publish ChatUpdatedEvent to manager: %v", err)
+	}
+	return nil
+}
+
+func (j *Job) producerAttachments(attachments []messagesrepo.Attachment) []msgproducer.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	result := make([]msgproducer.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		fileURL, _ := j.attachmentsSvc.URLs(a, types.UserIDNil)
+		result = append(result, msgproducer.Attachment{
+			ID:          a.ID,
+			FileName:    a.FileName,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			URL:         fileURL,
+		})
+	}
+	return result
+}
+
+func (j *Job) eventAttachments(attachments []messagesrepo.Attachment, viewerID types.UserID) []eventstream.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	result := make([]eventstream.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		fileURL, thumbnailURL := j.attachmentsSvc.URLs(a, viewerID)
+		result = append(result, eventstream.Attachment{
+			ID:           a.ID,
+			FileName:     a.FileName,
+			ContentType:  a.ContentType,
+			Size:         a.Size,
+			URL:          fileURL,
+			ThumbnailURL: thumbnailURL,
+		})
+	}
+	return result
+}
+
+func adaptQuote(q *messagesrepo.Quote) *eventstream.Quote {
+	if q == nil {
+		return nil
+	}
+	return &eventstream.Quote{
+		MessageID: q.MessageID,
+		AuthorID:  q.AuthorID,
+		Preview:   q.Preview,
+	}
+}
+
+func adaptLastMessage(m *chatsrepo.LastMessage) *eventstream.LastMessage {
+	if m == nil {
+		return nil
+	}
+	return &eventstream.LastMessage{
+		MessageID: m.ID,
+		AuthorID:  m.AuthorID,
+		Preview:   m.Preview,
+		CreatedAt: m.CreatedAt,
+	}
+}
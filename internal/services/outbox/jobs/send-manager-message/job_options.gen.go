@@ -11,6 +11,7 @@ import (
 type OptOptionsSetter func(o *Options)
 
 func NewOptions(
+	attachmentsSvc attachmentsService,
 	chatsRepo chatsRepository,
 	eventStream eventStream,
 	msgProducer messageProducer,
@@ -21,6 +22,8 @@ func NewOptions(
 
 	// Setting defaults from field tag (if present)
 
+	o.attachmentsSvc = attachmentsSvc
+
 	o.chatsRepo = chatsRepo
 
 	o.eventStream = eventStream
@@ -37,6 +40,7 @@ func NewOptions(
 
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("attachmentsSvc", _validate_Options_attachmentsSvc(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("chatsRepo", _validate_Options_chatsRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("msgProducer", _validate_Options_msgProducer(o)))
@@ -44,6 +48,13 @@ func (o *Options) Validate() error {
 	return errs.AsError()
 }
 
+func _validate_Options_attachmentsSvc(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.attachmentsSvc, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `attachmentsSvc` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_chatsRepo(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.chatsRepo, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `chatsRepo` did not pass the test: %w", err)
@@ -0,0 +1,193 @@
+package verdicttimeoutjob
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	contentfilter "github.com/zestagio/chat-service/internal/services/content-filter"
+	"github.com/zestagio/chat-service/internal/services/outbox"
+	clientmessageblockedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=verdicttimeoutjobmocks
+
+const Name = "verdict-timeout"
+
+// Policy defines what to do with the message AFC has not answered for.
+type Policy string
+
+const (
+	// PolicyRelease makes the message visible for manager as if the verdict was "ok".
+	PolicyRelease Policy = "release"
+	// PolicyBlock blocks the message as if the verdict was "suspicious" before the manual moderation.
+	PolicyBlock Policy = "block"
+	// PolicyModerate sends the message to the manual moderation queue.
+	PolicyModerate Policy = "moderate"
+	// PolicyPreFilter checks the message with the local content filter:
+	// "ok" releases the message, "suspicious" sends it to the manual moderation queue.
+	PolicyPreFilter Policy = "prefilter"
+)
+
+var errNoPreFilter = errors.New("prefilter policy requires content filter")
+
+// timeouts counts the messages timed out waiting for the AFC verdict, by policy.
+var timeouts = expvar.NewMap("afc_verdict_timeouts_total")
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	ApplyVerdict(ctx context.Context, msgID types.MessageID, v messagesrepo.Verdict, src messagesrepo.VerdictSource) error
+	BlockMessage(ctx context.Context, msgID types.MessageID, src messagesrepo.VerdictSource) error
+}
+
+type moderationRepository interface {
+	CreateIfNotExists(ctx context.Context, msgID types.MessageID) (types.ModerationCaseID, error)
+}
+
+type contentFilter interface {
+	Check(body string) contentfilter.Result
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	policy         Policy               `option:"mandatory" validate:"oneof=release block moderate prefilter"`
+	msgRepo        messageRepository    `option:"mandatory" validate:"required"`
+	moderationRepo moderationRepository `option:"mandatory" validate:"required"`
+	outBox         outboxService        `option:"mandatory" validate:"required"`
+	txtor          transactor           `option:"mandatory" validate:"required"`
+
+	// preFilter is required for PolicyPreFilter.
+	preFilter contentFilter
+}
+
+type Job struct {
+	outbox.DefaultJob
+	Options
+	logger *zap.Logger
+}
+
+func Must(opts Options) *Job {
+	j, err := New(opts)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+	if opts.policy == PolicyPreFilter && opts.preFilter == nil {
+		return nil, fmt.Errorf("validate options: %w", errNoPreFilter)
+	}
+	return &Job{
+		Options: opts,
+		logger:  zap.L().Named("job." + Name),
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	j.logger.Info("start processing", zap.String("payload", payload))
+
+	msgID, err := simpleid.Unmarshal[types.MessageID](payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload: %v", err)
+	}
+
+	return j.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		msg, err := j.msgRepo.GetMessageByID(ctx, msgID)
+		if err != nil {
+			return fmt.Errorf("get message: %v", err)
+		}
+		if msg.IsChecked {
+			return nil
+		}
+
+		policy, src := j.policy, messagesrepo.VerdictSourceTimeout
+		fields := []zap.Field{
+			zap.Stringer("message_id", msgID),
+			zap.Time("message_created_at", msg.CreatedAt),
+			zap.String("policy", string(policy)),
+		}
+
+		if policy == PolicyPreFilter {
+			res := j.preFilter.Check(msg.Body)
+
+			policy, src = PolicyRelease, messagesrepo.VerdictSourcePreFilter
+			if res.Verdict == contentfilter.VerdictSuspicious {
+				policy = PolicyModerate
+			}
+			fields = append(fields, zap.String("prefilter_verdict", string(res.Verdict)), zap.String("prefilter_rule", res.Rule))
+			j.logger.Info("message checked with prefilter", fields...)
+		} else {
+			j.logger.Warn("afc verdict timed out", fields...)
+		}
+
+		if err := j.applyPolicy(ctx, policy, src, msgID); err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgAlreadyChecked) {
+				// AFC verdict came in the meantime.
+				return nil
+			}
+			return fmt.Errorf("apply %q policy: %w", policy, err)
+		}
+
+		timeouts.Add(string(j.policy), 1)
+		return nil
+	})
+}
+
+func (j *Job) applyPolicy(ctx context.Context, policy Policy, src messagesrepo.VerdictSource, msgID types.MessageID) error {
+	switch policy {
+	case PolicyRelease:
+		if err := j.msgRepo.ApplyVerdict(ctx, msgID, messagesrepo.VerdictOK, src); err != nil {
+			return fmt.Errorf("mark message as visible for manager: %w", err)
+		}
+		if _, err := j.outBox.Put(ctx, clientmessagesentjob.Name, simpleid.MustMarshal(msgID), time.Now()); err != nil {
+			return fmt.Errorf("put %q job: %v", clientmessagesentjob.Name, err)
+		}
+
+	case PolicyBlock:
+		if err := j.msgRepo.ApplyVerdict(ctx, msgID, messagesrepo.VerdictSuspicious, src); err != nil {
+			return fmt.Errorf("mark message as checked: %w", err)
+		}
+		if err := j.msgRepo.BlockMessage(ctx, msgID, src); err != nil {
+			return fmt.Errorf("block message: %v", err)
+		}
+		if _, err := j.outBox.Put(ctx, clientmessageblockedjob.Name, simpleid.MustMarshal(msgID), time.Now()); err != nil {
+			return fmt.Errorf("put %q job: %v", clientmessageblockedjob.Name, err)
+		}
+
+	case PolicyModerate:
+		if err := j.msgRepo.ApplyVerdict(ctx, msgID, messagesrepo.VerdictSuspicious, src); err != nil {
+			return fmt.Errorf("mark message as checked: %w", err)
+		}
+		if _, err := j.moderationRepo.CreateIfNotExists(ctx, msgID); err != nil {
+			return fmt.Errorf("create moderation case: %v", err)
+		}
+
+	default:
+		return fmt.Errorf("unknown policy %q", policy)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Code generated by options-gen. DO NOT EDIT.
+package verdicttimeoutjob
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	policy Policy,
+	msgRepo messageRepository,
+	moderationRepo moderationRepository,
+	outBox outboxService,
+	txtor transactor,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.policy = policy
+
+	o.msgRepo = msgRepo
+
+	o.moderationRepo = moderationRepo
+
+	o.outBox = outBox
+
+	o.txtor = txtor
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+// preFilter is required for PolicyPreFilter.
+func WithPreFilter(opt contentFilter) OptOptionsSetter {
+	return func(o *Options) {
+		o.preFilter = opt
+
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("policy", _validate_Options_policy(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("moderationRepo", _validate_Options_moderationRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("outBox", _validate_Options_outBox(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_policy(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.policy, "oneof=release block moderate prefilter"); err != nil {
+		return fmt461e464ebed9.Errorf("field `policy` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_moderationRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.moderationRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `moderationRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_outBox(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.outBox, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `outBox` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_txtor(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.txtor, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `txtor` did not pass the test: %w", err)
+	}
+	return nil
+}
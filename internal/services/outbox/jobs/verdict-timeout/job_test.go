@@ -0,0 +1,242 @@
+package verdicttimeoutjob_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	contentfilter "github.com/zestagio/chat-service/internal/services/content-filter"
+	clientmessageblockedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	verdicttimeoutjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/verdict-timeout"
+	verdicttimeoutjobmocks "github.com/zestagio/chat-service/internal/services/outbox/jobs/verdict-timeout/mocks"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+type jobMocks struct {
+	msgRepo        *verdicttimeoutjobmocks.MockmessageRepository
+	moderationRepo *verdicttimeoutjobmocks.MockmoderationRepository
+	outBox         *verdicttimeoutjobmocks.MockoutboxService
+	preFilter      *verdicttimeoutjobmocks.MockcontentFilter
+}
+
+func newJob(t *testing.T, policy verdicttimeoutjob.Policy) (*verdicttimeoutjob.Job, jobMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	m := jobMocks{
+		msgRepo:        verdicttimeoutjobmocks.NewMockmessageRepository(ctrl),
+		moderationRepo: verdicttimeoutjobmocks.NewMockmoderationRepository(ctrl),
+		outBox:         verdicttimeoutjobmocks.NewMockoutboxService(ctrl),
+	}
+
+	txtor := verdicttimeoutjobmocks.NewMocktransactor(ctrl)
+	txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		}).AnyTimes()
+
+	m.preFilter = verdicttimeoutjobmocks.NewMockcontentFilter(ctrl)
+
+	job, err := verdicttimeoutjob.New(verdicttimeoutjob.NewOptions(
+		policy, m.msgRepo, m.moderationRepo, m.outBox, txtor,
+		verdicttimeoutjob.WithPreFilter(m.preFilter),
+	))
+	require.NoError(t, err)
+
+	return job, m
+}
+
+func newMessage(msgID types.MessageID, isChecked bool) *messagesrepo.Message {
+	return &messagesrepo.Message{
+		ID:                 msgID,
+		ChatID:             types.NewChatID(),
+		AuthorID:           types.NewUserID(),
+		Body:               "Hello!",
+		CreatedAt:          time.Now().Add(-time.Hour),
+		IsVisibleForClient: true,
+		IsChecked:          isChecked,
+		InitialRequestID:   types.NewRequestID(),
+	}
+}
+
+func TestNew_InvalidPolicy(t *testing.T) {
+	_, err := verdicttimeoutjob.New(verdicttimeoutjob.NewOptions("ignore", nil, nil, nil, nil))
+	require.Error(t, err)
+}
+
+func TestNew_PreFilterPolicyWithoutFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, err := verdicttimeoutjob.New(verdicttimeoutjob.NewOptions(
+		verdicttimeoutjob.PolicyPreFilter,
+		verdicttimeoutjobmocks.NewMockmessageRepository(ctrl),
+		verdicttimeoutjobmocks.NewMockmoderationRepository(ctrl),
+		verdicttimeoutjobmocks.NewMockoutboxService(ctrl),
+		verdicttimeoutjobmocks.NewMocktransactor(ctrl),
+	))
+	require.Error(t, err)
+}
+
+func TestJob_Handle_MessageAlreadyChecked(t *testing.T) {
+	for _, p := range []verdicttimeoutjob.Policy{
+		verdicttimeoutjob.PolicyRelease,
+		verdicttimeoutjob.PolicyBlock,
+		verdicttimeoutjob.PolicyModerate,
+		verdicttimeoutjob.PolicyPreFilter,
+	} {
+		t.Run(string(p), func(t *testing.T) {
+			// Arrange.
+			job, m := newJob(t, p)
+
+			msgID := types.NewMessageID()
+			m.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(newMessage(msgID, true), nil)
+
+			// Action & assert.
+			err := job.Handle(context.Background(), simpleid.MustMarshal(msgID))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestJob_Handle_Release(t *testing.T) {
+	// Arrange.
+	job, m := newJob(t, verdicttimeoutjob.PolicyRelease)
+
+	msgID := types.NewMessageID()
+	m.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(newMessage(msgID, false), nil)
+	m.msgRepo.EXPECT().ApplyVerdict(gomock.Any(), msgID, messagesrepo.VerdictOK, messagesrepo.VerdictSourceTimeout).Return(nil)
+	m.outBox.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, simpleid.MustMarshal(msgID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action & assert.
+	err := job.Handle(context.Background(), simpleid.MustMarshal(msgID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_Block(t *testing.T) {
+	// Arrange.
+	job, m := newJob(t, verdicttimeoutjob.PolicyBlock)
+
+	msgID := types.NewMessageID()
+	m.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(newMessage(msgID, false), nil)
+	m.msgRepo.EXPECT().ApplyVerdict(gomock.Any(), msgID, messagesrepo.VerdictSuspicious, messagesrepo.VerdictSourceTimeout).
+		Return(nil)
+	m.msgRepo.EXPECT().BlockMessage(gomock.Any(), msgID, messagesrepo.VerdictSourceTimeout).Return(nil)
+	m.outBox.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, simpleid.MustMarshal(msgID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action & assert.
+	err := job.Handle(context.Background(), simpleid.MustMarshal(msgID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_Moderate(t *testing.T) {
+	// Arrange.
+	job, m := newJob(t, verdicttimeoutjob.PolicyModerate)
+
+	msgID := types.NewMessageID()
+	m.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(newMessage(msgID, false), nil)
+	m.msgRepo.EXPECT().ApplyVerdict(gomock.Any(), msgID, messagesrepo.VerdictSuspicious, messagesrepo.VerdictSourceTimeout).
+		Return(nil)
+	m.moderationRepo.EXPECT().CreateIfNotExists(gomock.Any(), msgID).Return(types.NewModerationCaseID(), nil)
+
+	// Action & assert.
+	err := job.Handle(context.Background(), simpleid.MustMarshal(msgID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_PreFilter_OK(t *testing.T) {
+	// Arrange.
+	job, m := newJob(t, verdicttimeoutjob.PolicyPreFilter)
+
+	msgID := types.NewMessageID()
+	msg := newMessage(msgID, false)
+	m.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(msg, nil)
+	m.preFilter.EXPECT().Check(msg.Body).Return(contentfilter.Result{Verdict: contentfilter.VerdictOK})
+	m.msgRepo.EXPECT().ApplyVerdict(gomock.Any(), msgID, messagesrepo.VerdictOK, messagesrepo.VerdictSourcePreFilter).Return(nil)
+	m.outBox.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, simpleid.MustMarshal(msgID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action & assert.
+	err := job.Handle(context.Background(), simpleid.MustMarshal(msgID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_PreFilter_Suspicious(t *testing.T) {
+	// Arrange.
+	job, m := newJob(t, verdicttimeoutjob.PolicyPreFilter)
+
+	msgID := types.NewMessageID()
+	msg := newMessage(msgID, false)
+	m.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(msg, nil)
+	m.preFilter.EXPECT().Check(msg.Body).
+		Return(contentfilter.Result{Verdict: contentfilter.VerdictSuspicious, Rule: "scam"})
+	m.msgRepo.EXPECT().ApplyVerdict(gomock.Any(), msgID, messagesrepo.VerdictSuspicious, messagesrepo.VerdictSourcePreFilter).
+		Return(nil)
+	m.moderationRepo.EXPECT().CreateIfNotExists(gomock.Any(), msgID).Return(types.NewModerationCaseID(), nil)
+
+	// Action & assert.
+	err := job.Handle(context.Background(), simpleid.MustMarshal(msgID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_VerdictCameInTheMeantime(t *testing.T) {
+	// Arrange.
+	job, m := newJob(t, verdicttimeoutjob.PolicyRelease)
+
+	msgID := types.NewMessageID()
+	m.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(newMessage(msgID, false), nil)
+	m.msgRepo.EXPECT().ApplyVerdict(gomock.Any(), msgID, messagesrepo.VerdictOK, messagesrepo.VerdictSourceTimeout).
+		Return(fmt.Errorf("message %v: %w", msgID, messagesrepo.ErrMsgAlreadyChecked))
+
+	// Action & assert.
+	err := job.Handle(context.Background(), simpleid.MustMarshal(msgID))
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_PolicyError(t *testing.T) {
+	// Arrange.
+	job, m := newJob(t, verdicttimeoutjob.PolicyModerate)
+
+	msgID := types.NewMessageID()
+	m.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(newMessage(msgID, false), nil)
+	m.msgRepo.EXPECT().ApplyVerdict(gomock.Any(), msgID, messagesrepo.VerdictSuspicious, messagesrepo.VerdictSourceTimeout).
+		Return(nil)
+	m.moderationRepo.EXPECT().CreateIfNotExists(gomock.Any(), msgID).Return(types.ModerationCaseIDNil, errors.New("unexpected"))
+
+	// Action & assert.
+	err := job.Handle(context.Background(), simpleid.MustMarshal(msgID))
+	require.Error(t, err)
+}
+
+func TestJob_Handle_GetMessageError(t *testing.T) {
+	// Arrange.
+	job, m := newJob(t, verdicttimeoutjob.PolicyRelease)
+
+	msgID := types.NewMessageID()
+	m.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(nil, messagesrepo.ErrMsgNotFound)
+
+	// Action & assert.
+	err := job.Handle(context.Background(), simpleid.MustMarshal(msgID))
+	require.Error(t, err)
+}
+
+func TestJob_Handle_InvalidPayload(t *testing.T) {
+	// Arrange.
+	job, _ := newJob(t, verdicttimeoutjob.PolicyRelease)
+
+	// Action & assert.
+	err := job.Handle(context.Background(), "not-an-id")
+	require.Error(t, err)
+}
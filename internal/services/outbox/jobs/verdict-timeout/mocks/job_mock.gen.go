@@ -0,0 +1,232 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job.go
+
+// Package verdicttimeoutjobmocks is a generated GoMock package.
+package verdicttimeoutjobmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	contentfilter "github.com/zestagio/chat-service/internal/services/content-filter"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockmessageRepository is a mock of messageRepository interface.
+type MockmessageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessageRepositoryMockRecorder
+}
+
+// MockmessageRepositoryMockRecorder is the mock recorder for MockmessageRepository.
+type MockmessageRepositoryMockRecorder struct {
+	mock *MockmessageRepository
+}
+
+// NewMockmessageRepository creates a new mock instance.
+func NewMockmessageRepository(ctrl *gomock.Controller) *MockmessageRepository {
+	mock := &MockmessageRepository{ctrl: ctrl}
+	mock.recorder = &MockmessageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessageRepository) EXPECT() *MockmessageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ApplyVerdict mocks base method.
+func (m *MockmessageRepository) ApplyVerdict(ctx context.Context, msgID types.MessageID, v messagesrepo.Verdict, src messagesrepo.VerdictSource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyVerdict", ctx, msgID, v, src)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyVerdict indicates an expected call of ApplyVerdict.
+func (mr *MockmessageRepositoryMockRecorder) ApplyVerdict(ctx, msgID, v, src interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyVerdict", reflect.TypeOf((*MockmessageRepository)(nil).ApplyVerdict), ctx, msgID, v, src)
+}
+
+// BlockMessage mocks base method.
+func (m *MockmessageRepository) BlockMessage(ctx context.Context, msgID types.MessageID, src messagesrepo.VerdictSource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockMessage", ctx, msgID, src)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockMessage indicates an expected call of BlockMessage.
+func (mr *MockmessageRepositoryMockRecorder) BlockMessage(ctx, msgID, src interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockMessage", reflect.TypeOf((*MockmessageRepository)(nil).BlockMessage), ctx, msgID, src)
+}
+
+// GetMessageByID mocks base method.
+func (m *MockmessageRepository) GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageByID", ctx, msgID)
+	ret0, _ := ret[0].(*messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageByID indicates an expected call of GetMessageByID.
+func (mr *MockmessageRepositoryMockRecorder) GetMessageByID(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockmessageRepository)(nil).GetMessageByID), ctx, msgID)
+}
+
+// MockmoderationRepository is a mock of moderationRepository interface.
+type MockmoderationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmoderationRepositoryMockRecorder
+}
+
+// MockmoderationRepositoryMockRecorder is the mock recorder for MockmoderationRepository.
+type MockmoderationRepositoryMockRecorder struct {
+	mock *MockmoderationRepository
+}
+
+// NewMockmoderationRepository creates a new mock instance.
+func NewMockmoderationRepository(ctrl *gomock.Controller) *MockmoderationRepository {
+	mock := &MockmoderationRepository{ctrl: ctrl}
+	mock.recorder = &MockmoderationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmoderationRepository) EXPECT() *MockmoderationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateIfNotExists mocks base method.
+func (m *MockmoderationRepository) CreateIfNotExists(ctx context.Context, msgID types.MessageID) (types.ModerationCaseID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfNotExists", ctx, msgID)
+	ret0, _ := ret[0].(types.ModerationCaseID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIfNotExists indicates an expected call of CreateIfNotExists.
+func (mr *MockmoderationRepositoryMockRecorder) CreateIfNotExists(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockmoderationRepository)(nil).CreateIfNotExists), ctx, msgID)
+}
+
+// MockcontentFilter is a mock of contentFilter interface.
+type MockcontentFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontentFilterMockRecorder
+}
+
+// MockcontentFilterMockRecorder is the mock recorder for MockcontentFilter.
+type MockcontentFilterMockRecorder struct {
+	mock *MockcontentFilter
+}
+
+// NewMockcontentFilter creates a new mock instance.
+func NewMockcontentFilter(ctrl *gomock.Controller) *MockcontentFilter {
+	mock := &MockcontentFilter{ctrl: ctrl}
+	mock.recorder = &MockcontentFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontentFilter) EXPECT() *MockcontentFilterMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockcontentFilter) Check(body string) contentfilter.Result {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", body)
+	ret0, _ := ret[0].(contentfilter.Result)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockcontentFilterMockRecorder) Check(body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockcontentFilter)(nil).Check), body)
+}
+
+// MockoutboxService is a mock of outboxService interface.
+type MockoutboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxServiceMockRecorder
+}
+
+// MockoutboxServiceMockRecorder is the mock recorder for MockoutboxService.
+type MockoutboxServiceMockRecorder struct {
+	mock *MockoutboxService
+}
+
+// NewMockoutboxService creates a new mock instance.
+func NewMockoutboxService(ctrl *gomock.Controller) *MockoutboxService {
+	mock := &MockoutboxService{ctrl: ctrl}
+	mock.recorder = &MockoutboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
+	return m.recorder
+}
+
+// Put mocks base method.
+func (m *MockoutboxService) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, name, payload, availableAt)
+	ret0, _ := ret[0].(types.JobID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockoutboxServiceMockRecorder) Put(ctx, name, payload, availableAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockoutboxService)(nil).Put), ctx, name, payload, availableAt)
+}
+
+// Mocktransactor is a mock of transactor interface.
+type Mocktransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactorMockRecorder
+}
+
+// MocktransactorMockRecorder is the mock recorder for Mocktransactor.
+type MocktransactorMockRecorder struct {
+	mock *Mocktransactor
+}
+
+// NewMocktransactor creates a new mock instance.
+func NewMocktransactor(ctrl *gomock.Controller) *Mocktransactor {
+	mock := &Mocktransactor{ctrl: ctrl}
+	mock.recorder = &MocktransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocktransactor) EXPECT() *MocktransactorMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *Mocktransactor) RunInTx(ctx context.Context, f func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MocktransactorMockRecorder) RunInTx(ctx, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*Mocktransactor)(nil).RunInTx), ctx, f)
+}
@@ -20,6 +20,7 @@ type jobsRepository interface {
 	FindAndReserveJob(ctx context.Context, until time.Time) (jobsrepo.Job, error)
 	CreateFailedJob(ctx context.Context, name, payload, reason string) error
 	DeleteJob(ctx context.Context, jobID types.JobID) error
+	ReleaseJob(ctx context.Context, jobID types.JobID) error
 }
 
 type transactor interface {
@@ -32,6 +33,9 @@ type Options struct {
 	idleTime   time.Duration `option:"mandatory" validate:"min=100ms,max=10s"`
 	reserveFor time.Duration `option:"mandatory" validate:"min=1s,max=10m"`
 
+	// shutdownGracePeriod is the time given to in-flight jobs to finish after Run's context is done.
+	shutdownGracePeriod time.Duration `default:"5s" validate:"min=0,max=1m"`
+
 	jobsRepo jobsRepository `option:"mandatory" validate:"required"`
 	txtor    transactor     `option:"mandatory" validate:"required"`
 }
@@ -66,15 +70,31 @@ func (s *Service) MustRegisterJob(job Job) {
 	}
 }
 
+// Run processes jobs until ctx is done. After that the workers stop reserving new jobs
+// and wait for the in-flight ones during shutdownGracePeriod. Jobs that were aborted
+// because of the shutdown are released back to the queue without counting the attempt.
 func (s *Service) Run(ctx context.Context) error {
+	handleCtx, abortHandling := context.WithCancel(context.WithoutCancel(ctx))
+	defer abortHandling()
+
 	eg, ctx := errgroup.WithContext(ctx)
 
+	stopAborting := context.AfterFunc(ctx, func() {
+		select {
+		case <-handleCtx.Done():
+		case <-time.After(s.shutdownGracePeriod):
+			zap.L().Named(serviceName).Warn("shutdown grace period exceeded, abort in-flight jobs")
+			abortHandling()
+		}
+	})
+	defer stopAborting()
+
 	for i := 0; i < s.workers; i++ {
 		logger := zap.L().Named(serviceName).With(zap.Int("worker", i+1))
 		eg.Go(func() error {
 			for {
 				// Process all available jobs in one go.
-				if err := s.processAvailableJobs(ctx, logger); err != nil {
+				if err := s.processAvailableJobs(ctx, handleCtx, logger); err != nil {
 					if ctx.Err() != nil {
 						return nil //nolint:nilerr // graceful exit
 					}
@@ -94,7 +114,7 @@ func (s *Service) Run(ctx context.Context) error {
 	return eg.Wait()
 }
 
-func (s *Service) processAvailableJobs(ctx context.Context, log *zap.Logger) error {
+func (s *Service) processAvailableJobs(ctx, handleCtx context.Context, log *zap.Logger) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -102,7 +122,7 @@ func (s *Service) processAvailableJobs(ctx context.Context, log *zap.Logger) err
 		default:
 		}
 
-		if err := s.findAndProcessJob(ctx, log); err != nil {
+		if err := s.findAndProcessJob(ctx, handleCtx, log); err != nil {
 			if errors.Is(err, jobsrepo.ErrNoJobs) {
 				log.Debug("no jobs found to process")
 				return nil
@@ -112,7 +132,9 @@ func (s *Service) processAvailableJobs(ctx context.Context, log *zap.Logger) err
 	}
 }
 
-func (s *Service) findAndProcessJob(ctx context.Context, log *zap.Logger) error {
+// findAndProcessJob reserves the job using ctx and handles it using handleCtx,
+// that outlives ctx for the shutdown grace period.
+func (s *Service) findAndProcessJob(ctx, handleCtx context.Context, log *zap.Logger) error {
 	job, err := s.jobsRepo.FindAndReserveJob(ctx, time.Now().Local().Add(s.reserveFor))
 	if err != nil {
 		return fmt.Errorf("find and reserve job: %w", err)
@@ -126,23 +148,33 @@ func (s *Service) findAndProcessJob(ctx context.Context, log *zap.Logger) error
 	j, ok := s.jobs[job.Name]
 	if !ok {
 		log.Warn("drop to dlq: job is not registered")
-		return s.dlq(ctx, job.ID, job.Name, job.Payload, "unknown job")
+		return s.dlq(handleCtx, job.ID, job.Name, job.Payload, "unknown job")
 	}
 
 	func() {
-		ctx, cancel := context.WithTimeout(ctx, j.ExecutionTimeout())
+		ctx, cancel := context.WithTimeout(handleCtx, j.ExecutionTimeout())
 		defer cancel()
 
 		err = j.Handle(ctx, job.Payload)
 	}()
 
 	if err != nil {
+		if handleCtx.Err() != nil {
+			log.Warn("job aborted on shutdown, release it", zap.Error(err))
+
+			//nolint:contextcheck // handleCtx is already done here.
+			if err := s.jobsRepo.ReleaseJob(context.Background(), job.ID); err != nil {
+				log.Warn("release job error", zap.Error(err))
+			}
+			return nil
+		}
+
 		log.Warn("handle job error", zap.Error(err))
 
 		if job.Attempts >= j.MaxAttempts() {
 			log.Warn("drop to dlq: job max attempts exceeded")
 			return s.dlq(
-				ctx,
+				handleCtx,
 				job.ID,
 				job.Name,
 				job.Payload,
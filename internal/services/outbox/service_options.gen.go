@@ -22,6 +22,7 @@ func NewOptions(
 	o := Options{}
 
 	// Setting defaults from field tag (if present)
+	o.shutdownGracePeriod, _ = time.ParseDuration("5s")
 
 	o.workers = workers
 
@@ -39,11 +40,20 @@ func NewOptions(
 	return o
 }
 
+// shutdownGracePeriod is the time given to in-flight jobs to finish after Run's context is done.
+func WithShutdownGracePeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.shutdownGracePeriod = opt
+
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("workers", _validate_Options_workers(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("idleTime", _validate_Options_idleTime(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("reserveFor", _validate_Options_reserveFor(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("shutdownGracePeriod", _validate_Options_shutdownGracePeriod(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("jobsRepo", _validate_Options_jobsRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
 	return errs.AsError()
@@ -70,6 +80,13 @@ func _validate_Options_reserveFor(o *Options) error {
 	return nil
 }
 
+func _validate_Options_shutdownGracePeriod(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.shutdownGracePeriod, "min=0,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `shutdownGracePeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_jobsRepo(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.jobsRepo, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `jobsRepo` did not pass the test: %w", err)
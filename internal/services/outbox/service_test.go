@@ -227,6 +227,82 @@ func (s *OutboxServiceSuite) TestIfNoJobsThenWorkersSleepForIdleTime() {
 	s.NoError(<-errCh)
 }
 
+func (s *OutboxServiceSuite) TestShutdown_InFlightJobFinishedWithinGracePeriod() {
+	// Arrange.
+	const jobName = "TestShutdown_InFlightJobFinishedWithinGracePeriod"
+
+	started := make(chan struct{})
+	job := newJobMock(jobName, func(ctx context.Context, _ string) error {
+		close(started)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(300 * time.Millisecond):
+			return nil
+		}
+	}, 5*time.Second, 3)
+
+	outboxSvc := s.newOutboxWithGracePeriod(time.Second)
+	outboxSvc.MustRegisterJob(job)
+
+	_, err := outboxSvc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- outboxSvc.Run(ctx) }()
+	<-started
+
+	// Action.
+	cancel()
+
+	// Assert.
+	s.NoError(<-errCh)
+	s.Equal(1, job.ExecutedTimes())
+	s.Equal(0, s.Store.Job.Query().CountX(s.Ctx))
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+}
+
+func (s *OutboxServiceSuite) TestShutdown_AbortedJobReleased() {
+	// Arrange.
+	const jobName = "TestShutdown_AbortedJobReleased"
+
+	started := make(chan struct{})
+	job := newJobMock(jobName, func(ctx context.Context, _ string) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}, 5*time.Second, 3)
+
+	outboxSvc := s.newOutboxWithGracePeriod(100 * time.Millisecond)
+	outboxSvc.MustRegisterJob(job)
+
+	jobID, err := outboxSvc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- outboxSvc.Run(ctx) }()
+	<-started
+
+	// Action.
+	cancel()
+
+	// Assert.
+	s.NoError(<-errCh)
+	s.Equal(1, job.ExecutedTimes())
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+
+	j, err := s.Store.Job.Get(s.Ctx, jobID)
+	s.Require().NoError(err)
+	s.Equal(0, j.Attempts)
+	s.False(j.ReservedUntil.After(time.Now()))
+}
+
 func (s *OutboxServiceSuite) runOutboxFor(timeout time.Duration) {
 	s.T().Helper()
 
@@ -238,6 +314,25 @@ func (s *OutboxServiceSuite) runOutboxFor(timeout time.Duration) {
 	s.NoError(<-errCh) // No error expected because of graceful shutdown via cancel ctx.
 }
 
+func (s *OutboxServiceSuite) newOutboxWithGracePeriod(gracePeriod time.Duration) *outbox.Service {
+	s.T().Helper()
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	outboxSvc, err := outbox.New(outbox.NewOptions(
+		1,
+		idleTime,
+		reserveFor,
+		jobsRepo,
+		s.Database,
+		outbox.WithShutdownGracePeriod(gracePeriod),
+	))
+	s.Require().NoError(err)
+
+	return outboxSvc
+}
+
 func (s *OutboxServiceSuite) runOutbox() (context.CancelFunc, <-chan error) {
 	s.T().Helper()
 
@@ -0,0 +1,41 @@
+// Package problemtaxonomy keeps the configurable problem categories (the contact reasons)
+// and the resolution codes the managers pick from.
+package problemtaxonomy
+
+import (
+	"fmt"
+	"slices"
+)
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	categories      []string `option:"mandatory" validate:"min=1,unique,dive,required"`
+	resolutionCodes []string `option:"mandatory" validate:"min=1,unique,dive,required"`
+}
+
+type Service struct {
+	Options
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+	return &Service{Options: opts}, nil
+}
+
+func (s *Service) Categories() []string {
+	return slices.Clone(s.categories)
+}
+
+func (s *Service) ResolutionCodes() []string {
+	return slices.Clone(s.resolutionCodes)
+}
+
+func (s *Service) HasCategory(category string) bool {
+	return slices.Contains(s.categories, category)
+}
+
+func (s *Service) HasResolutionCode(code string) bool {
+	return slices.Contains(s.resolutionCodes, code)
+}
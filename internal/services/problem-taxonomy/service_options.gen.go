@@ -0,0 +1,51 @@
+// Code generated by options-gen. DO NOT EDIT.
+package problemtaxonomy
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	categories []string,
+	resolutionCodes []string,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.categories = categories
+
+	o.resolutionCodes = resolutionCodes
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("categories", _validate_Options_categories(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("resolutionCodes", _validate_Options_resolutionCodes(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_categories(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.categories, "min=1,unique,dive,required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `categories` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_resolutionCodes(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.resolutionCodes, "min=1,unique,dive,required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `resolutionCodes` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package problemtaxonomy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	problemtaxonomy "github.com/zestagio/chat-service/internal/services/problem-taxonomy"
+)
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		name            string
+		categories      []string
+		resolutionCodes []string
+		wantErr         bool
+	}{
+		{
+			name:            "valid taxonomy",
+			categories:      []string{"cards", "loans"},
+			resolutionCodes: []string{"answered"},
+		},
+		{
+			name:            "no categories",
+			resolutionCodes: []string{"answered"},
+			wantErr:         true,
+		},
+		{
+			name:       "no resolution codes",
+			categories: []string{"cards"},
+			wantErr:    true,
+		},
+		{
+			name:            "duplicated category",
+			categories:      []string{"cards", "cards"},
+			resolutionCodes: []string{"answered"},
+			wantErr:         true,
+		},
+		{
+			name:            "empty resolution code",
+			categories:      []string{"cards"},
+			resolutionCodes: []string{"answered", ""},
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := problemtaxonomy.New(problemtaxonomy.NewOptions(tt.categories, tt.resolutionCodes))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService(t *testing.T) {
+	categories := []string{"cards", "loans"}
+
+	s, err := problemtaxonomy.New(problemtaxonomy.NewOptions(categories, []string{"answered", "escalated"}))
+	require.NoError(t, err)
+
+	assert.True(t, s.HasCategory("loans"))
+	assert.False(t, s.HasCategory("Loans"))
+	assert.True(t, s.HasResolutionCode("escalated"))
+	assert.False(t, s.HasResolutionCode("cards"))
+
+	s.Categories()[0] = "changed"
+	assert.Equal(t, categories, s.Categories())
+	assert.Equal(t, []string{"answered", "escalated"}, s.ResolutionCodes())
+}
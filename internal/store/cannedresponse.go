@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/zestagio/chat-service/internal/store/cannedresponse"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// CannedResponse is the model entity for the CannedResponse schema.
+type CannedResponse struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID types.CannedResponseID `json:"id,omitempty"`
+	// AuthorID holds the value of the "author_id" field.
+	AuthorID types.UserID `json:"author_id,omitempty"`
+	// IsShared holds the value of the "is_shared" field.
+	IsShared bool `json:"is_shared,omitempty"`
+	// Shortcut holds the value of the "shortcut" field.
+	Shortcut string `json:"shortcut,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CannedResponse) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cannedresponse.FieldIsShared:
+			values[i] = new(sql.NullBool)
+		case cannedresponse.FieldShortcut, cannedresponse.FieldBody:
+			values[i] = new(sql.NullString)
+		case cannedresponse.FieldCreatedAt, cannedresponse.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case cannedresponse.FieldID:
+			values[i] = new(types.CannedResponseID)
+		case cannedresponse.FieldAuthorID:
+			values[i] = new(types.UserID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CannedResponse fields.
+func (cr *CannedResponse) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cannedresponse.FieldID:
+			if value, ok := values[i].(*types.CannedResponseID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cr.ID = *value
+			}
+		case cannedresponse.FieldAuthorID:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value != nil {
+				cr.AuthorID = *value
+			}
+		case cannedresponse.FieldIsShared:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_shared", values[i])
+			} else if value.Valid {
+				cr.IsShared = value.Bool
+			}
+		case cannedresponse.FieldShortcut:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shortcut", values[i])
+			} else if value.Valid {
+				cr.Shortcut = value.String
+			}
+		case cannedresponse.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				cr.Body = value.String
+			}
+		case cannedresponse.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cr.CreatedAt = value.Time
+			}
+		case cannedresponse.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cr.UpdatedAt = value.Time
+			}
+		default:
+			cr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CannedResponse.
+// This includes values selected through modifiers, order, etc.
+func (cr *CannedResponse) Value(name string) (ent.Value, error) {
+	return cr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CannedResponse.
+// Note that you need to call CannedResponse.Unwrap() before calling this method if this CannedResponse
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *CannedResponse) Update() *CannedResponseUpdateOne {
+	return NewCannedResponseClient(cr.config).UpdateOne(cr)
+}
+
+// Unwrap unwraps the CannedResponse entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cr *CannedResponse) Unwrap() *CannedResponse {
+	_tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("store: CannedResponse is not a transactional entity")
+	}
+	cr.config.driver = _tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *CannedResponse) String() string {
+	var builder strings.Builder
+	builder.WriteString("CannedResponse(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cr.ID))
+	builder.WriteString("author_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.AuthorID))
+	builder.WriteString(", ")
+	builder.WriteString("is_shared=")
+	builder.WriteString(fmt.Sprintf("%v", cr.IsShared))
+	builder.WriteString(", ")
+	builder.WriteString("shortcut=")
+	builder.WriteString(cr.Shortcut)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(cr.Body)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CannedResponses is a parsable slice of CannedResponse.
+type CannedResponses []*CannedResponse
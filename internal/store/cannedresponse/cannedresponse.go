@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cannedresponse
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const (
+	// Label holds the string label denoting the cannedresponse type in the database.
+	Label = "canned_response"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAuthorID holds the string denoting the author_id field in the database.
+	FieldAuthorID = "author_id"
+	// FieldIsShared holds the string denoting the is_shared field in the database.
+	FieldIsShared = "is_shared"
+	// FieldShortcut holds the string denoting the shortcut field in the database.
+	FieldShortcut = "shortcut"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the cannedresponse in the database.
+	Table = "canned_responses"
+)
+
+// Columns holds all SQL columns for cannedresponse fields.
+var Columns = []string{
+	FieldID,
+	FieldAuthorID,
+	FieldIsShared,
+	FieldShortcut,
+	FieldBody,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIsShared holds the default value on creation for the "is_shared" field.
+	DefaultIsShared bool
+	// ShortcutValidator is a validator for the "shortcut" field. It is called by the builders before save.
+	ShortcutValidator func(string) error
+	// BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	BodyValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() types.CannedResponseID
+)
+
+// OrderOption defines the ordering options for the CannedResponse queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAuthorID orders the results by the author_id field.
+func ByAuthorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorID, opts...).ToFunc()
+}
+
+// ByIsShared orders the results by the is_shared field.
+func ByIsShared(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsShared, opts...).ToFunc()
+}
+
+// ByShortcut orders the results by the shortcut field.
+func ByShortcut(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShortcut, opts...).ToFunc()
+}
+
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
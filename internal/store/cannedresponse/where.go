@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cannedresponse
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id types.CannedResponseID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id types.CannedResponseID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id types.CannedResponseID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...types.CannedResponseID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...types.CannedResponseID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id types.CannedResponseID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id types.CannedResponseID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id types.CannedResponseID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id types.CannedResponseID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLTE(FieldID, id))
+}
+
+// AuthorID applies equality check predicate on the "author_id" field. It's identical to AuthorIDEQ.
+func AuthorID(v types.UserID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// IsShared applies equality check predicate on the "is_shared" field. It's identical to IsSharedEQ.
+func IsShared(v bool) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldIsShared, v))
+}
+
+// Shortcut applies equality check predicate on the "shortcut" field. It's identical to ShortcutEQ.
+func Shortcut(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldShortcut, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldBody, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AuthorIDEQ applies the EQ predicate on the "author_id" field.
+func AuthorIDEQ(v types.UserID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// AuthorIDNEQ applies the NEQ predicate on the "author_id" field.
+func AuthorIDNEQ(v types.UserID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNEQ(FieldAuthorID, v))
+}
+
+// AuthorIDIn applies the In predicate on the "author_id" field.
+func AuthorIDIn(vs ...types.UserID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDNotIn applies the NotIn predicate on the "author_id" field.
+func AuthorIDNotIn(vs ...types.UserID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNotIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDGT applies the GT predicate on the "author_id" field.
+func AuthorIDGT(v types.UserID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGT(FieldAuthorID, v))
+}
+
+// AuthorIDGTE applies the GTE predicate on the "author_id" field.
+func AuthorIDGTE(v types.UserID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGTE(FieldAuthorID, v))
+}
+
+// AuthorIDLT applies the LT predicate on the "author_id" field.
+func AuthorIDLT(v types.UserID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLT(FieldAuthorID, v))
+}
+
+// AuthorIDLTE applies the LTE predicate on the "author_id" field.
+func AuthorIDLTE(v types.UserID) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLTE(FieldAuthorID, v))
+}
+
+// IsSharedEQ applies the EQ predicate on the "is_shared" field.
+func IsSharedEQ(v bool) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldIsShared, v))
+}
+
+// IsSharedNEQ applies the NEQ predicate on the "is_shared" field.
+func IsSharedNEQ(v bool) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNEQ(FieldIsShared, v))
+}
+
+// ShortcutEQ applies the EQ predicate on the "shortcut" field.
+func ShortcutEQ(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldShortcut, v))
+}
+
+// ShortcutNEQ applies the NEQ predicate on the "shortcut" field.
+func ShortcutNEQ(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNEQ(FieldShortcut, v))
+}
+
+// ShortcutIn applies the In predicate on the "shortcut" field.
+func ShortcutIn(vs ...string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldIn(FieldShortcut, vs...))
+}
+
+// ShortcutNotIn applies the NotIn predicate on the "shortcut" field.
+func ShortcutNotIn(vs ...string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNotIn(FieldShortcut, vs...))
+}
+
+// ShortcutGT applies the GT predicate on the "shortcut" field.
+func ShortcutGT(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGT(FieldShortcut, v))
+}
+
+// ShortcutGTE applies the GTE predicate on the "shortcut" field.
+func ShortcutGTE(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGTE(FieldShortcut, v))
+}
+
+// ShortcutLT applies the LT predicate on the "shortcut" field.
+func ShortcutLT(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLT(FieldShortcut, v))
+}
+
+// ShortcutLTE applies the LTE predicate on the "shortcut" field.
+func ShortcutLTE(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLTE(FieldShortcut, v))
+}
+
+// ShortcutContains applies the Contains predicate on the "shortcut" field.
+func ShortcutContains(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldContains(FieldShortcut, v))
+}
+
+// ShortcutHasPrefix applies the HasPrefix predicate on the "shortcut" field.
+func ShortcutHasPrefix(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldHasPrefix(FieldShortcut, v))
+}
+
+// ShortcutHasSuffix applies the HasSuffix predicate on the "shortcut" field.
+func ShortcutHasSuffix(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldHasSuffix(FieldShortcut, v))
+}
+
+// ShortcutEqualFold applies the EqualFold predicate on the "shortcut" field.
+func ShortcutEqualFold(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEqualFold(FieldShortcut, v))
+}
+
+// ShortcutContainsFold applies the ContainsFold predicate on the "shortcut" field.
+func ShortcutContainsFold(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldContainsFold(FieldShortcut, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldContainsFold(FieldBody, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CannedResponse) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CannedResponse) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CannedResponse) predicate.CannedResponse {
+	return predicate.CannedResponse(sql.NotPredicates(p))
+}
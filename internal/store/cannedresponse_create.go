@@ -0,0 +1,728 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/cannedresponse"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// CannedResponseCreate is the builder for creating a CannedResponse entity.
+type CannedResponseCreate struct {
+	config
+	mutation *CannedResponseMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAuthorID sets the "author_id" field.
+func (crc *CannedResponseCreate) SetAuthorID(ti types.UserID) *CannedResponseCreate {
+	crc.mutation.SetAuthorID(ti)
+	return crc
+}
+
+// SetIsShared sets the "is_shared" field.
+func (crc *CannedResponseCreate) SetIsShared(b bool) *CannedResponseCreate {
+	crc.mutation.SetIsShared(b)
+	return crc
+}
+
+// SetNillableIsShared sets the "is_shared" field if the given value is not nil.
+func (crc *CannedResponseCreate) SetNillableIsShared(b *bool) *CannedResponseCreate {
+	if b != nil {
+		crc.SetIsShared(*b)
+	}
+	return crc
+}
+
+// SetShortcut sets the "shortcut" field.
+func (crc *CannedResponseCreate) SetShortcut(s string) *CannedResponseCreate {
+	crc.mutation.SetShortcut(s)
+	return crc
+}
+
+// SetBody sets the "body" field.
+func (crc *CannedResponseCreate) SetBody(s string) *CannedResponseCreate {
+	crc.mutation.SetBody(s)
+	return crc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (crc *CannedResponseCreate) SetCreatedAt(t time.Time) *CannedResponseCreate {
+	crc.mutation.SetCreatedAt(t)
+	return crc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (crc *CannedResponseCreate) SetNillableCreatedAt(t *time.Time) *CannedResponseCreate {
+	if t != nil {
+		crc.SetCreatedAt(*t)
+	}
+	return crc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (crc *CannedResponseCreate) SetUpdatedAt(t time.Time) *CannedResponseCreate {
+	crc.mutation.SetUpdatedAt(t)
+	return crc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (crc *CannedResponseCreate) SetNillableUpdatedAt(t *time.Time) *CannedResponseCreate {
+	if t != nil {
+		crc.SetUpdatedAt(*t)
+	}
+	return crc
+}
+
+// SetID sets the "id" field.
+func (crc *CannedResponseCreate) SetID(tri types.CannedResponseID) *CannedResponseCreate {
+	crc.mutation.SetID(tri)
+	return crc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (crc *CannedResponseCreate) SetNillableID(tri *types.CannedResponseID) *CannedResponseCreate {
+	if tri != nil {
+		crc.SetID(*tri)
+	}
+	return crc
+}
+
+// Mutation returns the CannedResponseMutation object of the builder.
+func (crc *CannedResponseCreate) Mutation() *CannedResponseMutation {
+	return crc.mutation
+}
+
+// Save creates the CannedResponse in the database.
+func (crc *CannedResponseCreate) Save(ctx context.Context) (*CannedResponse, error) {
+	crc.defaults()
+	return withHooks(ctx, crc.sqlSave, crc.mutation, crc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *CannedResponseCreate) SaveX(ctx context.Context) *CannedResponse {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crc *CannedResponseCreate) Exec(ctx context.Context) error {
+	_, err := crc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crc *CannedResponseCreate) ExecX(ctx context.Context) {
+	if err := crc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (crc *CannedResponseCreate) defaults() {
+	if _, ok := crc.mutation.IsShared(); !ok {
+		v := cannedresponse.DefaultIsShared
+		crc.mutation.SetIsShared(v)
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		v := cannedresponse.DefaultCreatedAt()
+		crc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		v := cannedresponse.DefaultUpdatedAt()
+		crc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := crc.mutation.ID(); !ok {
+		v := cannedresponse.DefaultID()
+		crc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (crc *CannedResponseCreate) check() error {
+	if _, ok := crc.mutation.AuthorID(); !ok {
+		return &ValidationError{Name: "author_id", err: errors.New(`store: missing required field "CannedResponse.author_id"`)}
+	}
+	if v, ok := crc.mutation.AuthorID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "author_id", err: fmt.Errorf(`store: validator failed for field "CannedResponse.author_id": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.IsShared(); !ok {
+		return &ValidationError{Name: "is_shared", err: errors.New(`store: missing required field "CannedResponse.is_shared"`)}
+	}
+	if _, ok := crc.mutation.Shortcut(); !ok {
+		return &ValidationError{Name: "shortcut", err: errors.New(`store: missing required field "CannedResponse.shortcut"`)}
+	}
+	if v, ok := crc.mutation.Shortcut(); ok {
+		if err := cannedresponse.ShortcutValidator(v); err != nil {
+			return &ValidationError{Name: "shortcut", err: fmt.Errorf(`store: validator failed for field "CannedResponse.shortcut": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`store: missing required field "CannedResponse.body"`)}
+	}
+	if v, ok := crc.mutation.Body(); ok {
+		if err := cannedresponse.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`store: validator failed for field "CannedResponse.body": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "CannedResponse.created_at"`)}
+	}
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`store: missing required field "CannedResponse.updated_at"`)}
+	}
+	if v, ok := crc.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "CannedResponse.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (crc *CannedResponseCreate) sqlSave(ctx context.Context) (*CannedResponse, error) {
+	if err := crc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.CannedResponseID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	crc.mutation.id = &_node.ID
+	crc.mutation.done = true
+	return _node, nil
+}
+
+func (crc *CannedResponseCreate) createSpec() (*CannedResponse, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CannedResponse{config: crc.config}
+		_spec = sqlgraph.NewCreateSpec(cannedresponse.Table, sqlgraph.NewFieldSpec(cannedresponse.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = crc.conflict
+	if id, ok := crc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := crc.mutation.AuthorID(); ok {
+		_spec.SetField(cannedresponse.FieldAuthorID, field.TypeUUID, value)
+		_node.AuthorID = value
+	}
+	if value, ok := crc.mutation.IsShared(); ok {
+		_spec.SetField(cannedresponse.FieldIsShared, field.TypeBool, value)
+		_node.IsShared = value
+	}
+	if value, ok := crc.mutation.Shortcut(); ok {
+		_spec.SetField(cannedresponse.FieldShortcut, field.TypeString, value)
+		_node.Shortcut = value
+	}
+	if value, ok := crc.mutation.Body(); ok {
+		_spec.SetField(cannedresponse.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := crc.mutation.CreatedAt(); ok {
+		_spec.SetField(cannedresponse.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := crc.mutation.UpdatedAt(); ok {
+		_spec.SetField(cannedresponse.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CannedResponse.Create().
+//		SetAuthorID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CannedResponseUpsert) {
+//			SetAuthorID(v+v).
+//		}).
+//		Exec(ctx)
+func (crc *CannedResponseCreate) OnConflict(opts ...sql.ConflictOption) *CannedResponseUpsertOne {
+	crc.conflict = opts
+	return &CannedResponseUpsertOne{
+		create: crc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CannedResponse.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (crc *CannedResponseCreate) OnConflictColumns(columns ...string) *CannedResponseUpsertOne {
+	crc.conflict = append(crc.conflict, sql.ConflictColumns(columns...))
+	return &CannedResponseUpsertOne{
+		create: crc,
+	}
+}
+
+type (
+	// CannedResponseUpsertOne is the builder for "upsert"-ing
+	//  one CannedResponse node.
+	CannedResponseUpsertOne struct {
+		create *CannedResponseCreate
+	}
+
+	// CannedResponseUpsert is the "OnConflict" setter.
+	CannedResponseUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetShortcut sets the "shortcut" field.
+func (u *CannedResponseUpsert) SetShortcut(v string) *CannedResponseUpsert {
+	u.Set(cannedresponse.FieldShortcut, v)
+	return u
+}
+
+// UpdateShortcut sets the "shortcut" field to the value that was provided on create.
+func (u *CannedResponseUpsert) UpdateShortcut() *CannedResponseUpsert {
+	u.SetExcluded(cannedresponse.FieldShortcut)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *CannedResponseUpsert) SetBody(v string) *CannedResponseUpsert {
+	u.Set(cannedresponse.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *CannedResponseUpsert) UpdateBody() *CannedResponseUpsert {
+	u.SetExcluded(cannedresponse.FieldBody)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CannedResponseUpsert) SetUpdatedAt(v time.Time) *CannedResponseUpsert {
+	u.Set(cannedresponse.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CannedResponseUpsert) UpdateUpdatedAt() *CannedResponseUpsert {
+	u.SetExcluded(cannedresponse.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CannedResponse.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cannedresponse.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CannedResponseUpsertOne) UpdateNewValues() *CannedResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(cannedresponse.FieldID)
+		}
+		if _, exists := u.create.mutation.AuthorID(); exists {
+			s.SetIgnore(cannedresponse.FieldAuthorID)
+		}
+		if _, exists := u.create.mutation.IsShared(); exists {
+			s.SetIgnore(cannedresponse.FieldIsShared)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(cannedresponse.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CannedResponse.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CannedResponseUpsertOne) Ignore() *CannedResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CannedResponseUpsertOne) DoNothing() *CannedResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CannedResponseCreate.OnConflict
+// documentation for more info.
+func (u *CannedResponseUpsertOne) Update(set func(*CannedResponseUpsert)) *CannedResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CannedResponseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetShortcut sets the "shortcut" field.
+func (u *CannedResponseUpsertOne) SetShortcut(v string) *CannedResponseUpsertOne {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.SetShortcut(v)
+	})
+}
+
+// UpdateShortcut sets the "shortcut" field to the value that was provided on create.
+func (u *CannedResponseUpsertOne) UpdateShortcut() *CannedResponseUpsertOne {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.UpdateShortcut()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *CannedResponseUpsertOne) SetBody(v string) *CannedResponseUpsertOne {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *CannedResponseUpsertOne) UpdateBody() *CannedResponseUpsertOne {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CannedResponseUpsertOne) SetUpdatedAt(v time.Time) *CannedResponseUpsertOne {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CannedResponseUpsertOne) UpdateUpdatedAt() *CannedResponseUpsertOne {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CannedResponseUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for CannedResponseCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CannedResponseUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CannedResponseUpsertOne) ID(ctx context.Context) (id types.CannedResponseID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: CannedResponseUpsertOne.ID is not supported by MySQL driver. Use CannedResponseUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CannedResponseUpsertOne) IDX(ctx context.Context) types.CannedResponseID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CannedResponseCreateBulk is the builder for creating many CannedResponse entities in bulk.
+type CannedResponseCreateBulk struct {
+	config
+	err      error
+	builders []*CannedResponseCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CannedResponse entities in the database.
+func (crcb *CannedResponseCreateBulk) Save(ctx context.Context) ([]*CannedResponse, error) {
+	if crcb.err != nil {
+		return nil, crcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(crcb.builders))
+	nodes := make([]*CannedResponse, len(crcb.builders))
+	mutators := make([]Mutator, len(crcb.builders))
+	for i := range crcb.builders {
+		func(i int, root context.Context) {
+			builder := crcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CannedResponseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, crcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = crcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, crcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, crcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (crcb *CannedResponseCreateBulk) SaveX(ctx context.Context) []*CannedResponse {
+	v, err := crcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crcb *CannedResponseCreateBulk) Exec(ctx context.Context) error {
+	_, err := crcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crcb *CannedResponseCreateBulk) ExecX(ctx context.Context) {
+	if err := crcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CannedResponse.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CannedResponseUpsert) {
+//			SetAuthorID(v+v).
+//		}).
+//		Exec(ctx)
+func (crcb *CannedResponseCreateBulk) OnConflict(opts ...sql.ConflictOption) *CannedResponseUpsertBulk {
+	crcb.conflict = opts
+	return &CannedResponseUpsertBulk{
+		create: crcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CannedResponse.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (crcb *CannedResponseCreateBulk) OnConflictColumns(columns ...string) *CannedResponseUpsertBulk {
+	crcb.conflict = append(crcb.conflict, sql.ConflictColumns(columns...))
+	return &CannedResponseUpsertBulk{
+		create: crcb,
+	}
+}
+
+// CannedResponseUpsertBulk is the builder for "upsert"-ing
+// a bulk of CannedResponse nodes.
+type CannedResponseUpsertBulk struct {
+	create *CannedResponseCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CannedResponse.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cannedresponse.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CannedResponseUpsertBulk) UpdateNewValues() *CannedResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(cannedresponse.FieldID)
+			}
+			if _, exists := b.mutation.AuthorID(); exists {
+				s.SetIgnore(cannedresponse.FieldAuthorID)
+			}
+			if _, exists := b.mutation.IsShared(); exists {
+				s.SetIgnore(cannedresponse.FieldIsShared)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(cannedresponse.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CannedResponse.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CannedResponseUpsertBulk) Ignore() *CannedResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CannedResponseUpsertBulk) DoNothing() *CannedResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CannedResponseCreateBulk.OnConflict
+// documentation for more info.
+func (u *CannedResponseUpsertBulk) Update(set func(*CannedResponseUpsert)) *CannedResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CannedResponseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetShortcut sets the "shortcut" field.
+func (u *CannedResponseUpsertBulk) SetShortcut(v string) *CannedResponseUpsertBulk {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.SetShortcut(v)
+	})
+}
+
+// UpdateShortcut sets the "shortcut" field to the value that was provided on create.
+func (u *CannedResponseUpsertBulk) UpdateShortcut() *CannedResponseUpsertBulk {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.UpdateShortcut()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *CannedResponseUpsertBulk) SetBody(v string) *CannedResponseUpsertBulk {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *CannedResponseUpsertBulk) UpdateBody() *CannedResponseUpsertBulk {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CannedResponseUpsertBulk) SetUpdatedAt(v time.Time) *CannedResponseUpsertBulk {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CannedResponseUpsertBulk) UpdateUpdatedAt() *CannedResponseUpsertBulk {
+	return u.Update(func(s *CannedResponseUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CannedResponseUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the CannedResponseCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for CannedResponseCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CannedResponseUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/cannedresponse"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+)
+
+// CannedResponseDelete is the builder for deleting a CannedResponse entity.
+type CannedResponseDelete struct {
+	config
+	hooks    []Hook
+	mutation *CannedResponseMutation
+}
+
+// Where appends a list predicates to the CannedResponseDelete builder.
+func (crd *CannedResponseDelete) Where(ps ...predicate.CannedResponse) *CannedResponseDelete {
+	crd.mutation.Where(ps...)
+	return crd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (crd *CannedResponseDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, crd.sqlExec, crd.mutation, crd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crd *CannedResponseDelete) ExecX(ctx context.Context) int {
+	n, err := crd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (crd *CannedResponseDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cannedresponse.Table, sqlgraph.NewFieldSpec(cannedresponse.FieldID, field.TypeUUID))
+	if ps := crd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, crd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	crd.mutation.done = true
+	return affected, err
+}
+
+// CannedResponseDeleteOne is the builder for deleting a single CannedResponse entity.
+type CannedResponseDeleteOne struct {
+	crd *CannedResponseDelete
+}
+
+// Where appends a list predicates to the CannedResponseDelete builder.
+func (crdo *CannedResponseDeleteOne) Where(ps ...predicate.CannedResponse) *CannedResponseDeleteOne {
+	crdo.crd.mutation.Where(ps...)
+	return crdo
+}
+
+// Exec executes the deletion query.
+func (crdo *CannedResponseDeleteOne) Exec(ctx context.Context) error {
+	n, err := crdo.crd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cannedresponse.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crdo *CannedResponseDeleteOne) ExecX(ctx context.Context) {
+	if err := crdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
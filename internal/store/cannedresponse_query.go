@@ -0,0 +1,576 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/cannedresponse"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// CannedResponseQuery is the builder for querying CannedResponse entities.
+type CannedResponseQuery struct {
+	config
+	ctx        *QueryContext
+	order      []cannedresponse.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CannedResponse
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CannedResponseQuery builder.
+func (crq *CannedResponseQuery) Where(ps ...predicate.CannedResponse) *CannedResponseQuery {
+	crq.predicates = append(crq.predicates, ps...)
+	return crq
+}
+
+// Limit the number of records to be returned by this query.
+func (crq *CannedResponseQuery) Limit(limit int) *CannedResponseQuery {
+	crq.ctx.Limit = &limit
+	return crq
+}
+
+// Offset to start from.
+func (crq *CannedResponseQuery) Offset(offset int) *CannedResponseQuery {
+	crq.ctx.Offset = &offset
+	return crq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (crq *CannedResponseQuery) Unique(unique bool) *CannedResponseQuery {
+	crq.ctx.Unique = &unique
+	return crq
+}
+
+// Order specifies how the records should be ordered.
+func (crq *CannedResponseQuery) Order(o ...cannedresponse.OrderOption) *CannedResponseQuery {
+	crq.order = append(crq.order, o...)
+	return crq
+}
+
+// First returns the first CannedResponse entity from the query.
+// Returns a *NotFoundError when no CannedResponse was found.
+func (crq *CannedResponseQuery) First(ctx context.Context) (*CannedResponse, error) {
+	nodes, err := crq.Limit(1).All(setContextOp(ctx, crq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{cannedresponse.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (crq *CannedResponseQuery) FirstX(ctx context.Context) *CannedResponse {
+	node, err := crq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CannedResponse ID from the query.
+// Returns a *NotFoundError when no CannedResponse ID was found.
+func (crq *CannedResponseQuery) FirstID(ctx context.Context) (id types.CannedResponseID, err error) {
+	var ids []types.CannedResponseID
+	if ids, err = crq.Limit(1).IDs(setContextOp(ctx, crq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{cannedresponse.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (crq *CannedResponseQuery) FirstIDX(ctx context.Context) types.CannedResponseID {
+	id, err := crq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CannedResponse entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CannedResponse entity is found.
+// Returns a *NotFoundError when no CannedResponse entities are found.
+func (crq *CannedResponseQuery) Only(ctx context.Context) (*CannedResponse, error) {
+	nodes, err := crq.Limit(2).All(setContextOp(ctx, crq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{cannedresponse.Label}
+	default:
+		return nil, &NotSingularError{cannedresponse.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (crq *CannedResponseQuery) OnlyX(ctx context.Context) *CannedResponse {
+	node, err := crq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CannedResponse ID in the query.
+// Returns a *NotSingularError when more than one CannedResponse ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (crq *CannedResponseQuery) OnlyID(ctx context.Context) (id types.CannedResponseID, err error) {
+	var ids []types.CannedResponseID
+	if ids, err = crq.Limit(2).IDs(setContextOp(ctx, crq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{cannedresponse.Label}
+	default:
+		err = &NotSingularError{cannedresponse.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (crq *CannedResponseQuery) OnlyIDX(ctx context.Context) types.CannedResponseID {
+	id, err := crq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CannedResponses.
+func (crq *CannedResponseQuery) All(ctx context.Context) ([]*CannedResponse, error) {
+	ctx = setContextOp(ctx, crq.ctx, "All")
+	if err := crq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CannedResponse, *CannedResponseQuery]()
+	return withInterceptors[[]*CannedResponse](ctx, crq, qr, crq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (crq *CannedResponseQuery) AllX(ctx context.Context) []*CannedResponse {
+	nodes, err := crq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CannedResponse IDs.
+func (crq *CannedResponseQuery) IDs(ctx context.Context) (ids []types.CannedResponseID, err error) {
+	if crq.ctx.Unique == nil && crq.path != nil {
+		crq.Unique(true)
+	}
+	ctx = setContextOp(ctx, crq.ctx, "IDs")
+	if err = crq.Select(cannedresponse.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (crq *CannedResponseQuery) IDsX(ctx context.Context) []types.CannedResponseID {
+	ids, err := crq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (crq *CannedResponseQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, crq.ctx, "Count")
+	if err := crq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, crq, querierCount[*CannedResponseQuery](), crq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (crq *CannedResponseQuery) CountX(ctx context.Context) int {
+	count, err := crq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (crq *CannedResponseQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, crq.ctx, "Exist")
+	switch _, err := crq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("store: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (crq *CannedResponseQuery) ExistX(ctx context.Context) bool {
+	exist, err := crq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CannedResponseQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (crq *CannedResponseQuery) Clone() *CannedResponseQuery {
+	if crq == nil {
+		return nil
+	}
+	return &CannedResponseQuery{
+		config:     crq.config,
+		ctx:        crq.ctx.Clone(),
+		order:      append([]cannedresponse.OrderOption{}, crq.order...),
+		inters:     append([]Interceptor{}, crq.inters...),
+		predicates: append([]predicate.CannedResponse{}, crq.predicates...),
+		// clone intermediate query.
+		sql:  crq.sql.Clone(),
+		path: crq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AuthorID types.UserID `json:"author_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CannedResponse.Query().
+//		GroupBy(cannedresponse.FieldAuthorID).
+//		Aggregate(store.Count()).
+//		Scan(ctx, &v)
+func (crq *CannedResponseQuery) GroupBy(field string, fields ...string) *CannedResponseGroupBy {
+	crq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CannedResponseGroupBy{build: crq}
+	grbuild.flds = &crq.ctx.Fields
+	grbuild.label = cannedresponse.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AuthorID types.UserID `json:"author_id,omitempty"`
+//	}
+//
+//	client.CannedResponse.Query().
+//		Select(cannedresponse.FieldAuthorID).
+//		Scan(ctx, &v)
+func (crq *CannedResponseQuery) Select(fields ...string) *CannedResponseSelect {
+	crq.ctx.Fields = append(crq.ctx.Fields, fields...)
+	sbuild := &CannedResponseSelect{CannedResponseQuery: crq}
+	sbuild.label = cannedresponse.Label
+	sbuild.flds, sbuild.scan = &crq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CannedResponseSelect configured with the given aggregations.
+func (crq *CannedResponseQuery) Aggregate(fns ...AggregateFunc) *CannedResponseSelect {
+	return crq.Select().Aggregate(fns...)
+}
+
+func (crq *CannedResponseQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range crq.inters {
+		if inter == nil {
+			return fmt.Errorf("store: uninitialized interceptor (forgotten import store/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, crq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range crq.ctx.Fields {
+		if !cannedresponse.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+		}
+	}
+	if crq.path != nil {
+		prev, err := crq.path(ctx)
+		if err != nil {
+			return err
+		}
+		crq.sql = prev
+	}
+	return nil
+}
+
+func (crq *CannedResponseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CannedResponse, error) {
+	var (
+		nodes = []*CannedResponse{}
+		_spec = crq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CannedResponse).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CannedResponse{config: crq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, crq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (crq *CannedResponseQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := crq.querySpec()
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
+	_spec.Node.Columns = crq.ctx.Fields
+	if len(crq.ctx.Fields) > 0 {
+		_spec.Unique = crq.ctx.Unique != nil && *crq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, crq.driver, _spec)
+}
+
+func (crq *CannedResponseQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(cannedresponse.Table, cannedresponse.Columns, sqlgraph.NewFieldSpec(cannedresponse.FieldID, field.TypeUUID))
+	_spec.From = crq.sql
+	if unique := crq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if crq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := crq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cannedresponse.FieldID)
+		for i := range fields {
+			if fields[i] != cannedresponse.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := crq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := crq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := crq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := crq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (crq *CannedResponseQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(crq.driver.Dialect())
+	t1 := builder.Table(cannedresponse.Table)
+	columns := crq.ctx.Fields
+	if len(columns) == 0 {
+		columns = cannedresponse.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if crq.sql != nil {
+		selector = crq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if crq.ctx.Unique != nil && *crq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range crq.modifiers {
+		m(selector)
+	}
+	for _, p := range crq.predicates {
+		p(selector)
+	}
+	for _, p := range crq.order {
+		p(selector)
+	}
+	if offset := crq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := crq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (crq *CannedResponseQuery) ForUpdate(opts ...sql.LockOption) *CannedResponseQuery {
+	if crq.driver.Dialect() == dialect.Postgres {
+		crq.Unique(false)
+	}
+	crq.modifiers = append(crq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return crq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (crq *CannedResponseQuery) ForShare(opts ...sql.LockOption) *CannedResponseQuery {
+	if crq.driver.Dialect() == dialect.Postgres {
+		crq.Unique(false)
+	}
+	crq.modifiers = append(crq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return crq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (crq *CannedResponseQuery) Modify(modifiers ...func(s *sql.Selector)) *CannedResponseSelect {
+	crq.modifiers = append(crq.modifiers, modifiers...)
+	return crq.Select()
+}
+
+// CannedResponseGroupBy is the group-by builder for CannedResponse entities.
+type CannedResponseGroupBy struct {
+	selector
+	build *CannedResponseQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (crgb *CannedResponseGroupBy) Aggregate(fns ...AggregateFunc) *CannedResponseGroupBy {
+	crgb.fns = append(crgb.fns, fns...)
+	return crgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crgb *CannedResponseGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, crgb.build.ctx, "GroupBy")
+	if err := crgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CannedResponseQuery, *CannedResponseGroupBy](ctx, crgb.build, crgb, crgb.build.inters, v)
+}
+
+func (crgb *CannedResponseGroupBy) sqlScan(ctx context.Context, root *CannedResponseQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(crgb.fns))
+	for _, fn := range crgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*crgb.flds)+len(crgb.fns))
+		for _, f := range *crgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*crgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CannedResponseSelect is the builder for selecting fields of CannedResponse entities.
+type CannedResponseSelect struct {
+	*CannedResponseQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (crs *CannedResponseSelect) Aggregate(fns ...AggregateFunc) *CannedResponseSelect {
+	crs.fns = append(crs.fns, fns...)
+	return crs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crs *CannedResponseSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, crs.ctx, "Select")
+	if err := crs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CannedResponseQuery, *CannedResponseSelect](ctx, crs.CannedResponseQuery, crs, crs.inters, v)
+}
+
+func (crs *CannedResponseSelect) sqlScan(ctx context.Context, root *CannedResponseQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(crs.fns))
+	for _, fn := range crs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*crs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (crs *CannedResponseSelect) Modify(modifiers ...func(s *sql.Selector)) *CannedResponseSelect {
+	crs.modifiers = append(crs.modifiers, modifiers...)
+	return crs
+}
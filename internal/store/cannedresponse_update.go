@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/cannedresponse"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+)
+
+// CannedResponseUpdate is the builder for updating CannedResponse entities.
+type CannedResponseUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CannedResponseMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CannedResponseUpdate builder.
+func (cru *CannedResponseUpdate) Where(ps ...predicate.CannedResponse) *CannedResponseUpdate {
+	cru.mutation.Where(ps...)
+	return cru
+}
+
+// SetShortcut sets the "shortcut" field.
+func (cru *CannedResponseUpdate) SetShortcut(s string) *CannedResponseUpdate {
+	cru.mutation.SetShortcut(s)
+	return cru
+}
+
+// SetNillableShortcut sets the "shortcut" field if the given value is not nil.
+func (cru *CannedResponseUpdate) SetNillableShortcut(s *string) *CannedResponseUpdate {
+	if s != nil {
+		cru.SetShortcut(*s)
+	}
+	return cru
+}
+
+// SetBody sets the "body" field.
+func (cru *CannedResponseUpdate) SetBody(s string) *CannedResponseUpdate {
+	cru.mutation.SetBody(s)
+	return cru
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (cru *CannedResponseUpdate) SetNillableBody(s *string) *CannedResponseUpdate {
+	if s != nil {
+		cru.SetBody(*s)
+	}
+	return cru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cru *CannedResponseUpdate) SetUpdatedAt(t time.Time) *CannedResponseUpdate {
+	cru.mutation.SetUpdatedAt(t)
+	return cru
+}
+
+// Mutation returns the CannedResponseMutation object of the builder.
+func (cru *CannedResponseUpdate) Mutation() *CannedResponseMutation {
+	return cru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cru *CannedResponseUpdate) Save(ctx context.Context) (int, error) {
+	cru.defaults()
+	return withHooks(ctx, cru.sqlSave, cru.mutation, cru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cru *CannedResponseUpdate) SaveX(ctx context.Context) int {
+	affected, err := cru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cru *CannedResponseUpdate) Exec(ctx context.Context) error {
+	_, err := cru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cru *CannedResponseUpdate) ExecX(ctx context.Context) {
+	if err := cru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cru *CannedResponseUpdate) defaults() {
+	if _, ok := cru.mutation.UpdatedAt(); !ok {
+		v := cannedresponse.UpdateDefaultUpdatedAt()
+		cru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cru *CannedResponseUpdate) check() error {
+	if v, ok := cru.mutation.Shortcut(); ok {
+		if err := cannedresponse.ShortcutValidator(v); err != nil {
+			return &ValidationError{Name: "shortcut", err: fmt.Errorf(`store: validator failed for field "CannedResponse.shortcut": %w`, err)}
+		}
+	}
+	if v, ok := cru.mutation.Body(); ok {
+		if err := cannedresponse.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`store: validator failed for field "CannedResponse.body": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cru *CannedResponseUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CannedResponseUpdate {
+	cru.modifiers = append(cru.modifiers, modifiers...)
+	return cru
+}
+
+func (cru *CannedResponseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cannedresponse.Table, cannedresponse.Columns, sqlgraph.NewFieldSpec(cannedresponse.FieldID, field.TypeUUID))
+	if ps := cru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cru.mutation.Shortcut(); ok {
+		_spec.SetField(cannedresponse.FieldShortcut, field.TypeString, value)
+	}
+	if value, ok := cru.mutation.Body(); ok {
+		_spec.SetField(cannedresponse.FieldBody, field.TypeString, value)
+	}
+	if value, ok := cru.mutation.UpdatedAt(); ok {
+		_spec.SetField(cannedresponse.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(cru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cannedresponse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cru.mutation.done = true
+	return n, nil
+}
+
+// CannedResponseUpdateOne is the builder for updating a single CannedResponse entity.
+type CannedResponseUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CannedResponseMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetShortcut sets the "shortcut" field.
+func (cruo *CannedResponseUpdateOne) SetShortcut(s string) *CannedResponseUpdateOne {
+	cruo.mutation.SetShortcut(s)
+	return cruo
+}
+
+// SetNillableShortcut sets the "shortcut" field if the given value is not nil.
+func (cruo *CannedResponseUpdateOne) SetNillableShortcut(s *string) *CannedResponseUpdateOne {
+	if s != nil {
+		cruo.SetShortcut(*s)
+	}
+	return cruo
+}
+
+// SetBody sets the "body" field.
+func (cruo *CannedResponseUpdateOne) SetBody(s string) *CannedResponseUpdateOne {
+	cruo.mutation.SetBody(s)
+	return cruo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (cruo *CannedResponseUpdateOne) SetNillableBody(s *string) *CannedResponseUpdateOne {
+	if s != nil {
+		cruo.SetBody(*s)
+	}
+	return cruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cruo *CannedResponseUpdateOne) SetUpdatedAt(t time.Time) *CannedResponseUpdateOne {
+	cruo.mutation.SetUpdatedAt(t)
+	return cruo
+}
+
+// Mutation returns the CannedResponseMutation object of the builder.
+func (cruo *CannedResponseUpdateOne) Mutation() *CannedResponseMutation {
+	return cruo.mutation
+}
+
+// Where appends a list predicates to the CannedResponseUpdate builder.
+func (cruo *CannedResponseUpdateOne) Where(ps ...predicate.CannedResponse) *CannedResponseUpdateOne {
+	cruo.mutation.Where(ps...)
+	return cruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cruo *CannedResponseUpdateOne) Select(field string, fields ...string) *CannedResponseUpdateOne {
+	cruo.fields = append([]string{field}, fields...)
+	return cruo
+}
+
+// Save executes the query and returns the updated CannedResponse entity.
+func (cruo *CannedResponseUpdateOne) Save(ctx context.Context) (*CannedResponse, error) {
+	cruo.defaults()
+	return withHooks(ctx, cruo.sqlSave, cruo.mutation, cruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cruo *CannedResponseUpdateOne) SaveX(ctx context.Context) *CannedResponse {
+	node, err := cruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cruo *CannedResponseUpdateOne) Exec(ctx context.Context) error {
+	_, err := cruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cruo *CannedResponseUpdateOne) ExecX(ctx context.Context) {
+	if err := cruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cruo *CannedResponseUpdateOne) defaults() {
+	if _, ok := cruo.mutation.UpdatedAt(); !ok {
+		v := cannedresponse.UpdateDefaultUpdatedAt()
+		cruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cruo *CannedResponseUpdateOne) check() error {
+	if v, ok := cruo.mutation.Shortcut(); ok {
+		if err := cannedresponse.ShortcutValidator(v); err != nil {
+			return &ValidationError{Name: "shortcut", err: fmt.Errorf(`store: validator failed for field "CannedResponse.shortcut": %w`, err)}
+		}
+	}
+	if v, ok := cruo.mutation.Body(); ok {
+		if err := cannedresponse.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`store: validator failed for field "CannedResponse.body": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cruo *CannedResponseUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CannedResponseUpdateOne {
+	cruo.modifiers = append(cruo.modifiers, modifiers...)
+	return cruo
+}
+
+func (cruo *CannedResponseUpdateOne) sqlSave(ctx context.Context) (_node *CannedResponse, err error) {
+	if err := cruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cannedresponse.Table, cannedresponse.Columns, sqlgraph.NewFieldSpec(cannedresponse.FieldID, field.TypeUUID))
+	id, ok := cruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "CannedResponse.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cannedresponse.FieldID)
+		for _, f := range fields {
+			if !cannedresponse.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != cannedresponse.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cruo.mutation.Shortcut(); ok {
+		_spec.SetField(cannedresponse.FieldShortcut, field.TypeString, value)
+	}
+	if value, ok := cruo.mutation.Body(); ok {
+		_spec.SetField(cannedresponse.FieldBody, field.TypeString, value)
+	}
+	if value, ok := cruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(cannedresponse.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(cruo.modifiers...)
+	_node = &CannedResponse{config: cruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cannedresponse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cruo.mutation.done = true
+	return _node, nil
+}
@@ -20,6 +20,8 @@ type Chat struct {
 	ID types.ChatID `json:"id,omitempty"`
 	// ClientID holds the value of the "client_id" field.
 	ClientID types.UserID `json:"client_id,omitempty"`
+	// ClientName holds the value of the "client_name" field.
+	ClientName string `json:"client_name,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -30,19 +32,30 @@ type Chat struct {
 
 // ChatEdges holds the relations/edges for other nodes in the graph.
 type ChatEdges struct {
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
 	// Messages holds the value of the messages edge.
 	Messages []*Message `json:"messages,omitempty"`
 	// Problems holds the value of the problems edge.
 	Problems []*Problem `json:"problems,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[0] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
 }
 
 // MessagesOrErr returns the Messages value or an error if the edge
 // was not loaded in eager-loading.
 func (e ChatEdges) MessagesOrErr() ([]*Message, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[1] {
 		return e.Messages, nil
 	}
 	return nil, &NotLoadedError{edge: "messages"}
@@ -51,7 +64,7 @@ func (e ChatEdges) MessagesOrErr() ([]*Message, error) {
 // ProblemsOrErr returns the Problems value or an error if the edge
 // was not loaded in eager-loading.
 func (e ChatEdges) ProblemsOrErr() ([]*Problem, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Problems, nil
 	}
 	return nil, &NotLoadedError{edge: "problems"}
@@ -62,6 +75,8 @@ func (*Chat) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case chat.FieldClientName:
+			values[i] = new(sql.NullString)
 		case chat.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case chat.FieldID:
@@ -95,6 +110,12 @@ func (c *Chat) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				c.ClientID = *value
 			}
+		case chat.FieldClientName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_name", values[i])
+			} else if value.Valid {
+				c.ClientName = value.String
+			}
 		case chat.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -114,6 +135,11 @@ func (c *Chat) Value(name string) (ent.Value, error) {
 	return c.selectValues.Get(name)
 }
 
+// QueryAttachments queries the "attachments" edge of the Chat entity.
+func (c *Chat) QueryAttachments() *AttachmentQuery {
+	return NewChatClient(c.config).QueryAttachments(c)
+}
+
 // QueryMessages queries the "messages" edge of the Chat entity.
 func (c *Chat) QueryMessages() *MessageQuery {
 	return NewChatClient(c.config).QueryMessages(c)
@@ -150,6 +176,9 @@ func (c *Chat) String() string {
 	builder.WriteString("client_id=")
 	builder.WriteString(fmt.Sprintf("%v", c.ClientID))
 	builder.WriteString(", ")
+	builder.WriteString("client_name=")
+	builder.WriteString(c.ClientName)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
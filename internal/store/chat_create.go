@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/attachment"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/message"
 	"github.com/zestagio/chat-service/internal/store/problem"
@@ -32,6 +33,20 @@ func (cc *ChatCreate) SetClientID(ti types.UserID) *ChatCreate {
 	return cc
 }
 
+// SetClientName sets the "client_name" field.
+func (cc *ChatCreate) SetClientName(s string) *ChatCreate {
+	cc.mutation.SetClientName(s)
+	return cc
+}
+
+// SetNillableClientName sets the "client_name" field if the given value is not nil.
+func (cc *ChatCreate) SetNillableClientName(s *string) *ChatCreate {
+	if s != nil {
+		cc.SetClientName(*s)
+	}
+	return cc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cc *ChatCreate) SetCreatedAt(t time.Time) *ChatCreate {
 	cc.mutation.SetCreatedAt(t)
@@ -60,6 +75,21 @@ func (cc *ChatCreate) SetNillableID(ti *types.ChatID) *ChatCreate {
 	return cc
 }
 
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (cc *ChatCreate) AddAttachmentIDs(ids ...types.AttachmentID) *ChatCreate {
+	cc.mutation.AddAttachmentIDs(ids...)
+	return cc
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (cc *ChatCreate) AddAttachments(a ...*Attachment) *ChatCreate {
+	ids := make([]types.AttachmentID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cc.AddAttachmentIDs(ids...)
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (cc *ChatCreate) AddMessageIDs(ids ...types.MessageID) *ChatCreate {
 	cc.mutation.AddMessageIDs(ids...)
@@ -193,10 +223,30 @@ func (cc *ChatCreate) createSpec() (*Chat, *sqlgraph.CreateSpec) {
 		_spec.SetField(chat.FieldClientID, field.TypeUUID, value)
 		_node.ClientID = value
 	}
+	if value, ok := cc.mutation.ClientName(); ok {
+		_spec.SetField(chat.FieldClientName, field.TypeString, value)
+		_node.ClientName = value
+	}
 	if value, ok := cc.mutation.CreatedAt(); ok {
 		_spec.SetField(chat.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if nodes := cc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.AttachmentsTable,
+			Columns: []string{chat.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := cc.mutation.MessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -281,6 +331,24 @@ type (
 	}
 )
 
+// SetClientName sets the "client_name" field.
+func (u *ChatUpsert) SetClientName(v string) *ChatUpsert {
+	u.Set(chat.FieldClientName, v)
+	return u
+}
+
+// UpdateClientName sets the "client_name" field to the value that was provided on create.
+func (u *ChatUpsert) UpdateClientName() *ChatUpsert {
+	u.SetExcluded(chat.FieldClientName)
+	return u
+}
+
+// ClearClientName clears the value of the "client_name" field.
+func (u *ChatUpsert) ClearClientName() *ChatUpsert {
+	u.SetNull(chat.FieldClientName)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -335,6 +403,27 @@ func (u *ChatUpsertOne) Update(set func(*ChatUpsert)) *ChatUpsertOne {
 	return u
 }
 
+// SetClientName sets the "client_name" field.
+func (u *ChatUpsertOne) SetClientName(v string) *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.SetClientName(v)
+	})
+}
+
+// UpdateClientName sets the "client_name" field to the value that was provided on create.
+func (u *ChatUpsertOne) UpdateClientName() *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.UpdateClientName()
+	})
+}
+
+// ClearClientName clears the value of the "client_name" field.
+func (u *ChatUpsertOne) ClearClientName() *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.ClearClientName()
+	})
+}
+
 // Exec executes the query.
 func (u *ChatUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -556,6 +645,27 @@ func (u *ChatUpsertBulk) Update(set func(*ChatUpsert)) *ChatUpsertBulk {
 	return u
 }
 
+// SetClientName sets the "client_name" field.
+func (u *ChatUpsertBulk) SetClientName(v string) *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.SetClientName(v)
+	})
+}
+
+// UpdateClientName sets the "client_name" field to the value that was provided on create.
+func (u *ChatUpsertBulk) UpdateClientName() *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.UpdateClientName()
+	})
+}
+
+// ClearClientName clears the value of the "client_name" field.
+func (u *ChatUpsertBulk) ClearClientName() *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.ClearClientName()
+	})
+}
+
 // Exec executes the query.
 func (u *ChatUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/attachment"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/message"
 	"github.com/zestagio/chat-service/internal/store/predicate"
@@ -21,13 +23,14 @@ import (
 // ChatQuery is the builder for querying Chat entities.
 type ChatQuery struct {
 	config
-	ctx          *QueryContext
-	order        []chat.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Chat
-	withMessages *MessageQuery
-	withProblems *ProblemQuery
-	modifiers    []func(*sql.Selector)
+	ctx             *QueryContext
+	order           []chat.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Chat
+	withAttachments *AttachmentQuery
+	withMessages    *MessageQuery
+	withProblems    *ProblemQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -64,6 +67,28 @@ func (cq *ChatQuery) Order(o ...chat.OrderOption) *ChatQuery {
 	return cq
 }
 
+// QueryAttachments chains the current query on the "attachments" edge.
+func (cq *ChatQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.AttachmentsTable, chat.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryMessages chains the current query on the "messages" edge.
 func (cq *ChatQuery) QueryMessages() *MessageQuery {
 	query := (&MessageClient{config: cq.config}).Query()
@@ -295,19 +320,31 @@ func (cq *ChatQuery) Clone() *ChatQuery {
 		return nil
 	}
 	return &ChatQuery{
-		config:       cq.config,
-		ctx:          cq.ctx.Clone(),
-		order:        append([]chat.OrderOption{}, cq.order...),
-		inters:       append([]Interceptor{}, cq.inters...),
-		predicates:   append([]predicate.Chat{}, cq.predicates...),
-		withMessages: cq.withMessages.Clone(),
-		withProblems: cq.withProblems.Clone(),
+		config:          cq.config,
+		ctx:             cq.ctx.Clone(),
+		order:           append([]chat.OrderOption{}, cq.order...),
+		inters:          append([]Interceptor{}, cq.inters...),
+		predicates:      append([]predicate.Chat{}, cq.predicates...),
+		withAttachments: cq.withAttachments.Clone(),
+		withMessages:    cq.withMessages.Clone(),
+		withProblems:    cq.withProblems.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
 	}
 }
 
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ChatQuery) WithAttachments(opts ...func(*AttachmentQuery)) *ChatQuery {
+	query := (&AttachmentClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withAttachments = query
+	return cq
+}
+
 // WithMessages tells the query-builder to eager-load the nodes that are connected to
 // the "messages" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *ChatQuery) WithMessages(opts ...func(*MessageQuery)) *ChatQuery {
@@ -408,7 +445,8 @@ func (cq *ChatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chat, e
 	var (
 		nodes       = []*Chat{}
 		_spec       = cq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
+			cq.withAttachments != nil,
 			cq.withMessages != nil,
 			cq.withProblems != nil,
 		}
@@ -434,6 +472,13 @@ func (cq *ChatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chat, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := cq.withAttachments; query != nil {
+		if err := cq.loadAttachments(ctx, query, nodes,
+			func(n *Chat) { n.Edges.Attachments = []*Attachment{} },
+			func(n *Chat, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := cq.withMessages; query != nil {
 		if err := cq.loadMessages(ctx, query, nodes,
 			func(n *Chat) { n.Edges.Messages = []*Message{} },
@@ -451,6 +496,36 @@ func (cq *ChatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chat, e
 	return nodes, nil
 }
 
+func (cq *ChatQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*Chat, init func(*Chat), assign func(*Chat, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[types.ChatID]*Chat)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachment.FieldChatID)
+	}
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(chat.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChatID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "chat_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (cq *ChatQuery) loadMessages(ctx context.Context, query *MessageQuery, nodes []*Chat, init func(*Chat), assign func(*Chat, *Message)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[types.ChatID]*Chat)
@@ -599,6 +674,32 @@ func (cq *ChatQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cq *ChatQuery) ForUpdate(opts ...sql.LockOption) *ChatQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cq *ChatQuery) ForShare(opts ...sql.LockOption) *ChatQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cq
+}
+
 // Modify adds a query modifier for attaching custom logic to queries.
 func (cq *ChatQuery) Modify(modifiers ...func(s *sql.Selector)) *ChatSelect {
 	cq.modifiers = append(cq.modifiers, modifiers...)
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/attachment"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/message"
 	"github.com/zestagio/chat-service/internal/store/predicate"
@@ -31,6 +32,41 @@ func (cu *ChatUpdate) Where(ps ...predicate.Chat) *ChatUpdate {
 	return cu
 }
 
+// SetClientName sets the "client_name" field.
+func (cu *ChatUpdate) SetClientName(s string) *ChatUpdate {
+	cu.mutation.SetClientName(s)
+	return cu
+}
+
+// SetNillableClientName sets the "client_name" field if the given value is not nil.
+func (cu *ChatUpdate) SetNillableClientName(s *string) *ChatUpdate {
+	if s != nil {
+		cu.SetClientName(*s)
+	}
+	return cu
+}
+
+// ClearClientName clears the value of the "client_name" field.
+func (cu *ChatUpdate) ClearClientName() *ChatUpdate {
+	cu.mutation.ClearClientName()
+	return cu
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (cu *ChatUpdate) AddAttachmentIDs(ids ...types.AttachmentID) *ChatUpdate {
+	cu.mutation.AddAttachmentIDs(ids...)
+	return cu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (cu *ChatUpdate) AddAttachments(a ...*Attachment) *ChatUpdate {
+	ids := make([]types.AttachmentID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cu.AddAttachmentIDs(ids...)
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (cu *ChatUpdate) AddMessageIDs(ids ...types.MessageID) *ChatUpdate {
 	cu.mutation.AddMessageIDs(ids...)
@@ -66,6 +102,27 @@ func (cu *ChatUpdate) Mutation() *ChatMutation {
 	return cu.mutation
 }
 
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (cu *ChatUpdate) ClearAttachments() *ChatUpdate {
+	cu.mutation.ClearAttachments()
+	return cu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (cu *ChatUpdate) RemoveAttachmentIDs(ids ...types.AttachmentID) *ChatUpdate {
+	cu.mutation.RemoveAttachmentIDs(ids...)
+	return cu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (cu *ChatUpdate) RemoveAttachments(a ...*Attachment) *ChatUpdate {
+	ids := make([]types.AttachmentID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cu.RemoveAttachmentIDs(ids...)
+}
+
 // ClearMessages clears all "messages" edges to the Message entity.
 func (cu *ChatUpdate) ClearMessages() *ChatUpdate {
 	cu.mutation.ClearMessages()
@@ -150,6 +207,57 @@ func (cu *ChatUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := cu.mutation.ClientName(); ok {
+		_spec.SetField(chat.FieldClientName, field.TypeString, value)
+	}
+	if cu.mutation.ClientNameCleared() {
+		_spec.ClearField(chat.FieldClientName, field.TypeString)
+	}
+	if cu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.AttachmentsTable,
+			Columns: []string{chat.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !cu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.AttachmentsTable,
+			Columns: []string{chat.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.AttachmentsTable,
+			Columns: []string{chat.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if cu.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -262,6 +370,41 @@ type ChatUpdateOne struct {
 	modifiers []func(*sql.UpdateBuilder)
 }
 
+// SetClientName sets the "client_name" field.
+func (cuo *ChatUpdateOne) SetClientName(s string) *ChatUpdateOne {
+	cuo.mutation.SetClientName(s)
+	return cuo
+}
+
+// SetNillableClientName sets the "client_name" field if the given value is not nil.
+func (cuo *ChatUpdateOne) SetNillableClientName(s *string) *ChatUpdateOne {
+	if s != nil {
+		cuo.SetClientName(*s)
+	}
+	return cuo
+}
+
+// ClearClientName clears the value of the "client_name" field.
+func (cuo *ChatUpdateOne) ClearClientName() *ChatUpdateOne {
+	cuo.mutation.ClearClientName()
+	return cuo
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (cuo *ChatUpdateOne) AddAttachmentIDs(ids ...types.AttachmentID) *ChatUpdateOne {
+	cuo.mutation.AddAttachmentIDs(ids...)
+	return cuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (cuo *ChatUpdateOne) AddAttachments(a ...*Attachment) *ChatUpdateOne {
+	ids := make([]types.AttachmentID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cuo.AddAttachmentIDs(ids...)
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (cuo *ChatUpdateOne) AddMessageIDs(ids ...types.MessageID) *ChatUpdateOne {
 	cuo.mutation.AddMessageIDs(ids...)
@@ -297,6 +440,27 @@ func (cuo *ChatUpdateOne) Mutation() *ChatMutation {
 	return cuo.mutation
 }
 
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (cuo *ChatUpdateOne) ClearAttachments() *ChatUpdateOne {
+	cuo.mutation.ClearAttachments()
+	return cuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (cuo *ChatUpdateOne) RemoveAttachmentIDs(ids ...types.AttachmentID) *ChatUpdateOne {
+	cuo.mutation.RemoveAttachmentIDs(ids...)
+	return cuo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (cuo *ChatUpdateOne) RemoveAttachments(a ...*Attachment) *ChatUpdateOne {
+	ids := make([]types.AttachmentID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return cuo.RemoveAttachmentIDs(ids...)
+}
+
 // ClearMessages clears all "messages" edges to the Message entity.
 func (cuo *ChatUpdateOne) ClearMessages() *ChatUpdateOne {
 	cuo.mutation.ClearMessages()
@@ -411,6 +575,57 @@ func (cuo *ChatUpdateOne) sqlSave(ctx context.Context) (_node *Chat, err error)
 			}
 		}
 	}
+	if value, ok := cuo.mutation.ClientName(); ok {
+		_spec.SetField(chat.FieldClientName, field.TypeString, value)
+	}
+	if cuo.mutation.ClientNameCleared() {
+		_spec.ClearField(chat.FieldClientName, field.TypeString)
+	}
+	if cuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.AttachmentsTable,
+			Columns: []string{chat.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !cuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.AttachmentsTable,
+			Columns: []string{chat.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.AttachmentsTable,
+			Columns: []string{chat.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if cuo.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
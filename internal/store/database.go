@@ -82,6 +82,16 @@ func (db *Database) Query(ctx context.Context, query string, args ...any) (*sql.
 	return &rows, nil
 }
 
+// Attachment is the client for interacting with the Attachment builders.
+func (db *Database) Attachment(ctx context.Context) *AttachmentClient {
+	return db.loadClient(ctx).Attachment
+}
+
+// CannedResponse is the client for interacting with the CannedResponse builders.
+func (db *Database) CannedResponse(ctx context.Context) *CannedResponseClient {
+	return db.loadClient(ctx).CannedResponse
+}
+
 // Chat is the client for interacting with the Chat builders.
 func (db *Database) Chat(ctx context.Context) *ChatClient {
 	return db.loadClient(ctx).Chat
@@ -102,7 +112,32 @@ func (db *Database) Message(ctx context.Context) *MessageClient {
 	return db.loadClient(ctx).Message
 }
 
+// MessageDeletion is the client for interacting with the MessageDeletion builders.
+func (db *Database) MessageDeletion(ctx context.Context) *MessageDeletionClient {
+	return db.loadClient(ctx).MessageDeletion
+}
+
+// MessageReaction is the client for interacting with the MessageReaction builders.
+func (db *Database) MessageReaction(ctx context.Context) *MessageReactionClient {
+	return db.loadClient(ctx).MessageReaction
+}
+
+// MessageRevision is the client for interacting with the MessageRevision builders.
+func (db *Database) MessageRevision(ctx context.Context) *MessageRevisionClient {
+	return db.loadClient(ctx).MessageRevision
+}
+
+// ModerationCase is the client for interacting with the ModerationCase builders.
+func (db *Database) ModerationCase(ctx context.Context) *ModerationCaseClient {
+	return db.loadClient(ctx).ModerationCase
+}
+
 // Problem is the client for interacting with the Problem builders.
 func (db *Database) Problem(ctx context.Context) *ProblemClient {
 	return db.loadClient(ctx).Problem
 }
+
+// VerdictConflict is the client for interacting with the VerdictConflict builders.
+func (db *Database) VerdictConflict(ctx context.Context) *VerdictConflictClient {
+	return db.loadClient(ctx).VerdictConflict
+}
@@ -12,11 +12,18 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zestagio/chat-service/internal/store/attachment"
+	"github.com/zestagio/chat-service/internal/store/cannedresponse"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/failedjob"
 	"github.com/zestagio/chat-service/internal/store/job"
 	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/store/messagereaction"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
 	"github.com/zestagio/chat-service/internal/store/problem"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +84,18 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chat.Table:      chat.ValidColumn,
-			failedjob.Table: failedjob.ValidColumn,
-			job.Table:       job.ValidColumn,
-			message.Table:   message.ValidColumn,
-			problem.Table:   problem.ValidColumn,
+			attachment.Table:      attachment.ValidColumn,
+			cannedresponse.Table:  cannedresponse.ValidColumn,
+			chat.Table:            chat.ValidColumn,
+			failedjob.Table:       failedjob.ValidColumn,
+			job.Table:             job.ValidColumn,
+			message.Table:         message.ValidColumn,
+			messagedeletion.Table: messagedeletion.ValidColumn,
+			messagereaction.Table: messagereaction.ValidColumn,
+			messagerevision.Table: messagerevision.ValidColumn,
+			moderationcase.Table:  moderationcase.ValidColumn,
+			problem.Table:         problem.ValidColumn,
+			verdictconflict.Table: verdictconflict.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -446,6 +447,32 @@ func (fjq *FailedJobQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (fjq *FailedJobQuery) ForUpdate(opts ...sql.LockOption) *FailedJobQuery {
+	if fjq.driver.Dialect() == dialect.Postgres {
+		fjq.Unique(false)
+	}
+	fjq.modifiers = append(fjq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return fjq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (fjq *FailedJobQuery) ForShare(opts ...sql.LockOption) *FailedJobQuery {
+	if fjq.driver.Dialect() == dialect.Postgres {
+		fjq.Unique(false)
+	}
+	fjq.modifiers = append(fjq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return fjq
+}
+
 // Modify adds a query modifier for attaching custom logic to queries.
 func (fjq *FailedJobQuery) Modify(modifiers ...func(s *sql.Selector)) *FailedJobSelect {
 	fjq.modifiers = append(fjq.modifiers, modifiers...)
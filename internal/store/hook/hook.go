@@ -9,6 +9,30 @@ import (
 	"github.com/zestagio/chat-service/internal/store"
 )
 
+// The AttachmentFunc type is an adapter to allow the use of ordinary
+// function as Attachment mutator.
+type AttachmentFunc func(context.Context, *store.AttachmentMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttachmentFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.AttachmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.AttachmentMutation", m)
+}
+
+// The CannedResponseFunc type is an adapter to allow the use of ordinary
+// function as CannedResponse mutator.
+type CannedResponseFunc func(context.Context, *store.CannedResponseMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CannedResponseFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.CannedResponseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.CannedResponseMutation", m)
+}
+
 // The ChatFunc type is an adapter to allow the use of ordinary
 // function as Chat mutator.
 type ChatFunc func(context.Context, *store.ChatMutation) (store.Value, error)
@@ -57,6 +81,54 @@ func (f MessageFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.MessageMutation", m)
 }
 
+// The MessageDeletionFunc type is an adapter to allow the use of ordinary
+// function as MessageDeletion mutator.
+type MessageDeletionFunc func(context.Context, *store.MessageDeletionMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageDeletionFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.MessageDeletionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.MessageDeletionMutation", m)
+}
+
+// The MessageReactionFunc type is an adapter to allow the use of ordinary
+// function as MessageReaction mutator.
+type MessageReactionFunc func(context.Context, *store.MessageReactionMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageReactionFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.MessageReactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.MessageReactionMutation", m)
+}
+
+// The MessageRevisionFunc type is an adapter to allow the use of ordinary
+// function as MessageRevision mutator.
+type MessageRevisionFunc func(context.Context, *store.MessageRevisionMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageRevisionFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.MessageRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.MessageRevisionMutation", m)
+}
+
+// The ModerationCaseFunc type is an adapter to allow the use of ordinary
+// function as ModerationCase mutator.
+type ModerationCaseFunc func(context.Context, *store.ModerationCaseMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModerationCaseFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.ModerationCaseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.ModerationCaseMutation", m)
+}
+
 // The ProblemFunc type is an adapter to allow the use of ordinary
 // function as Problem mutator.
 type ProblemFunc func(context.Context, *store.ProblemMutation) (store.Value, error)
@@ -69,6 +141,18 @@ func (f ProblemFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.ProblemMutation", m)
 }
 
+// The VerdictConflictFunc type is an adapter to allow the use of ordinary
+// function as VerdictConflict mutator.
+type VerdictConflictFunc func(context.Context, *store.VerdictConflictMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VerdictConflictFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.VerdictConflictMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.VerdictConflictMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, store.Mutation) bool
 
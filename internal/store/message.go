@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
 	"github.com/zestagio/chat-service/internal/store/problem"
 	"github.com/zestagio/chat-service/internal/types"
 )
@@ -32,14 +34,28 @@ type Message struct {
 	IsVisibleForManager bool `json:"is_visible_for_manager,omitempty"`
 	// Body holds the value of the "body" field.
 	Body string `json:"body,omitempty"`
+	// EditedAt holds the value of the "edited_at" field.
+	EditedAt time.Time `json:"edited_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// CheckedAt holds the value of the "checked_at" field.
 	CheckedAt time.Time `json:"checked_at,omitempty"`
+	// Verdict holds the value of the "verdict" field.
+	Verdict message.Verdict `json:"verdict,omitempty"`
+	// VerdictSource holds the value of the "verdict_source" field.
+	VerdictSource message.VerdictSource `json:"verdict_source,omitempty"`
 	// IsBlocked holds the value of the "is_blocked" field.
 	IsBlocked bool `json:"is_blocked,omitempty"`
 	// IsService holds the value of the "is_service" field.
 	IsService bool `json:"is_service,omitempty"`
+	// IsInternalNote holds the value of the "is_internal_note" field.
+	IsInternalNote bool `json:"is_internal_note,omitempty"`
 	// InitialRequestID holds the value of the "initial_request_id" field.
 	InitialRequestID types.RequestID `json:"initial_request_id,omitempty"`
+	// ContentRequestID holds the value of the "content_request_id" field.
+	ContentRequestID types.RequestID `json:"content_request_id,omitempty"`
+	// ReplyToMessageID holds the value of the "reply_to_message_id" field.
+	ReplyToMessageID types.MessageID `json:"reply_to_message_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -54,9 +70,25 @@ type MessageEdges struct {
 	Chat *Chat `json:"chat,omitempty"`
 	// Problem holds the value of the problem edge.
 	Problem *Problem `json:"problem,omitempty"`
+	// ModerationCase holds the value of the moderation_case edge.
+	ModerationCase *ModerationCase `json:"moderation_case,omitempty"`
+	// VerdictConflicts holds the value of the verdict_conflicts edge.
+	VerdictConflicts []*VerdictConflict `json:"verdict_conflicts,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*MessageRevision `json:"revisions,omitempty"`
+	// Deletion holds the value of the deletion edge.
+	Deletion *MessageDeletion `json:"deletion,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// Reactions holds the value of the reactions edge.
+	Reactions []*MessageReaction `json:"reactions,omitempty"`
+	// ReplyTo holds the value of the reply_to edge.
+	ReplyTo *Message `json:"reply_to,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*Message `json:"replies,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [10]bool
 }
 
 // ChatOrErr returns the Chat value or an error if the edge
@@ -81,24 +113,102 @@ func (e MessageEdges) ProblemOrErr() (*Problem, error) {
 	return nil, &NotLoadedError{edge: "problem"}
 }
 
+// ModerationCaseOrErr returns the ModerationCase value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ModerationCaseOrErr() (*ModerationCase, error) {
+	if e.ModerationCase != nil {
+		return e.ModerationCase, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: moderationcase.Label}
+	}
+	return nil, &NotLoadedError{edge: "moderation_case"}
+}
+
+// VerdictConflictsOrErr returns the VerdictConflicts value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) VerdictConflictsOrErr() ([]*VerdictConflict, error) {
+	if e.loadedTypes[3] {
+		return e.VerdictConflicts, nil
+	}
+	return nil, &NotLoadedError{edge: "verdict_conflicts"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) RevisionsOrErr() ([]*MessageRevision, error) {
+	if e.loadedTypes[4] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// DeletionOrErr returns the Deletion value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) DeletionOrErr() (*MessageDeletion, error) {
+	if e.Deletion != nil {
+		return e.Deletion, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: messagedeletion.Label}
+	}
+	return nil, &NotLoadedError{edge: "deletion"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[6] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ReactionsOrErr() ([]*MessageReaction, error) {
+	if e.loadedTypes[7] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
+// ReplyToOrErr returns the ReplyTo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ReplyToOrErr() (*Message, error) {
+	if e.ReplyTo != nil {
+		return e.ReplyTo, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "reply_to"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) RepliesOrErr() ([]*Message, error) {
+	if e.loadedTypes[9] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case message.FieldIsVisibleForClient, message.FieldIsVisibleForManager, message.FieldIsBlocked, message.FieldIsService:
+		case message.FieldIsVisibleForClient, message.FieldIsVisibleForManager, message.FieldIsBlocked, message.FieldIsService, message.FieldIsInternalNote:
 			values[i] = new(sql.NullBool)
-		case message.FieldBody:
+		case message.FieldBody, message.FieldVerdict, message.FieldVerdictSource:
 			values[i] = new(sql.NullString)
-		case message.FieldCheckedAt, message.FieldCreatedAt:
+		case message.FieldEditedAt, message.FieldDeletedAt, message.FieldCheckedAt, message.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case message.FieldChatID:
 			values[i] = new(types.ChatID)
-		case message.FieldID:
+		case message.FieldID, message.FieldReplyToMessageID:
 			values[i] = new(types.MessageID)
 		case message.FieldProblemID:
 			values[i] = new(types.ProblemID)
-		case message.FieldInitialRequestID:
+		case message.FieldInitialRequestID, message.FieldContentRequestID:
 			values[i] = new(types.RequestID)
 		case message.FieldAuthorID:
 			values[i] = new(types.UserID)
@@ -159,12 +269,36 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.Body = value.String
 			}
+		case message.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				m.EditedAt = value.Time
+			}
+		case message.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				m.DeletedAt = value.Time
+			}
 		case message.FieldCheckedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field checked_at", values[i])
 			} else if value.Valid {
 				m.CheckedAt = value.Time
 			}
+		case message.FieldVerdict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verdict", values[i])
+			} else if value.Valid {
+				m.Verdict = message.Verdict(value.String)
+			}
+		case message.FieldVerdictSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verdict_source", values[i])
+			} else if value.Valid {
+				m.VerdictSource = message.VerdictSource(value.String)
+			}
 		case message.FieldIsBlocked:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_blocked", values[i])
@@ -177,12 +311,30 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.IsService = value.Bool
 			}
+		case message.FieldIsInternalNote:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_internal_note", values[i])
+			} else if value.Valid {
+				m.IsInternalNote = value.Bool
+			}
 		case message.FieldInitialRequestID:
 			if value, ok := values[i].(*types.RequestID); !ok {
 				return fmt.Errorf("unexpected type %T for field initial_request_id", values[i])
 			} else if value != nil {
 				m.InitialRequestID = *value
 			}
+		case message.FieldContentRequestID:
+			if value, ok := values[i].(*types.RequestID); !ok {
+				return fmt.Errorf("unexpected type %T for field content_request_id", values[i])
+			} else if value != nil {
+				m.ContentRequestID = *value
+			}
+		case message.FieldReplyToMessageID:
+			if value, ok := values[i].(*types.MessageID); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_to_message_id", values[i])
+			} else if value != nil {
+				m.ReplyToMessageID = *value
+			}
 		case message.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -212,6 +364,46 @@ func (m *Message) QueryProblem() *ProblemQuery {
 	return NewMessageClient(m.config).QueryProblem(m)
 }
 
+// QueryModerationCase queries the "moderation_case" edge of the Message entity.
+func (m *Message) QueryModerationCase() *ModerationCaseQuery {
+	return NewMessageClient(m.config).QueryModerationCase(m)
+}
+
+// QueryVerdictConflicts queries the "verdict_conflicts" edge of the Message entity.
+func (m *Message) QueryVerdictConflicts() *VerdictConflictQuery {
+	return NewMessageClient(m.config).QueryVerdictConflicts(m)
+}
+
+// QueryRevisions queries the "revisions" edge of the Message entity.
+func (m *Message) QueryRevisions() *MessageRevisionQuery {
+	return NewMessageClient(m.config).QueryRevisions(m)
+}
+
+// QueryDeletion queries the "deletion" edge of the Message entity.
+func (m *Message) QueryDeletion() *MessageDeletionQuery {
+	return NewMessageClient(m.config).QueryDeletion(m)
+}
+
+// QueryAttachments queries the "attachments" edge of the Message entity.
+func (m *Message) QueryAttachments() *AttachmentQuery {
+	return NewMessageClient(m.config).QueryAttachments(m)
+}
+
+// QueryReactions queries the "reactions" edge of the Message entity.
+func (m *Message) QueryReactions() *MessageReactionQuery {
+	return NewMessageClient(m.config).QueryReactions(m)
+}
+
+// QueryReplyTo queries the "reply_to" edge of the Message entity.
+func (m *Message) QueryReplyTo() *MessageQuery {
+	return NewMessageClient(m.config).QueryReplyTo(m)
+}
+
+// QueryReplies queries the "replies" edge of the Message entity.
+func (m *Message) QueryReplies() *MessageQuery {
+	return NewMessageClient(m.config).QueryReplies(m)
+}
+
 // Update returns a builder for updating this Message.
 // Note that you need to call Message.Unwrap() before calling this method if this Message
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -253,18 +445,39 @@ func (m *Message) String() string {
 	builder.WriteString("body=")
 	builder.WriteString(m.Body)
 	builder.WriteString(", ")
+	builder.WriteString("edited_at=")
+	builder.WriteString(m.EditedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(m.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("checked_at=")
 	builder.WriteString(m.CheckedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("verdict=")
+	builder.WriteString(fmt.Sprintf("%v", m.Verdict))
+	builder.WriteString(", ")
+	builder.WriteString("verdict_source=")
+	builder.WriteString(fmt.Sprintf("%v", m.VerdictSource))
+	builder.WriteString(", ")
 	builder.WriteString("is_blocked=")
 	builder.WriteString(fmt.Sprintf("%v", m.IsBlocked))
 	builder.WriteString(", ")
 	builder.WriteString("is_service=")
 	builder.WriteString(fmt.Sprintf("%v", m.IsService))
 	builder.WriteString(", ")
+	builder.WriteString("is_internal_note=")
+	builder.WriteString(fmt.Sprintf("%v", m.IsInternalNote))
+	builder.WriteString(", ")
 	builder.WriteString("initial_request_id=")
 	builder.WriteString(fmt.Sprintf("%v", m.InitialRequestID))
 	builder.WriteString(", ")
+	builder.WriteString("content_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.ContentRequestID))
+	builder.WriteString(", ")
+	builder.WriteString("reply_to_message_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.ReplyToMessageID))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -3,6 +3,7 @@
 package message
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -27,20 +28,50 @@ const (
 	FieldIsVisibleForManager = "is_visible_for_manager"
 	// FieldBody holds the string denoting the body field in the database.
 	FieldBody = "body"
+	// FieldEditedAt holds the string denoting the edited_at field in the database.
+	FieldEditedAt = "edited_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldCheckedAt holds the string denoting the checked_at field in the database.
 	FieldCheckedAt = "checked_at"
+	// FieldVerdict holds the string denoting the verdict field in the database.
+	FieldVerdict = "verdict"
+	// FieldVerdictSource holds the string denoting the verdict_source field in the database.
+	FieldVerdictSource = "verdict_source"
 	// FieldIsBlocked holds the string denoting the is_blocked field in the database.
 	FieldIsBlocked = "is_blocked"
 	// FieldIsService holds the string denoting the is_service field in the database.
 	FieldIsService = "is_service"
+	// FieldIsInternalNote holds the string denoting the is_internal_note field in the database.
+	FieldIsInternalNote = "is_internal_note"
 	// FieldInitialRequestID holds the string denoting the initial_request_id field in the database.
 	FieldInitialRequestID = "initial_request_id"
+	// FieldContentRequestID holds the string denoting the content_request_id field in the database.
+	FieldContentRequestID = "content_request_id"
+	// FieldReplyToMessageID holds the string denoting the reply_to_message_id field in the database.
+	FieldReplyToMessageID = "reply_to_message_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeChat holds the string denoting the chat edge name in mutations.
 	EdgeChat = "chat"
 	// EdgeProblem holds the string denoting the problem edge name in mutations.
 	EdgeProblem = "problem"
+	// EdgeModerationCase holds the string denoting the moderation_case edge name in mutations.
+	EdgeModerationCase = "moderation_case"
+	// EdgeVerdictConflicts holds the string denoting the verdict_conflicts edge name in mutations.
+	EdgeVerdictConflicts = "verdict_conflicts"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeDeletion holds the string denoting the deletion edge name in mutations.
+	EdgeDeletion = "deletion"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// EdgeReactions holds the string denoting the reactions edge name in mutations.
+	EdgeReactions = "reactions"
+	// EdgeReplyTo holds the string denoting the reply_to edge name in mutations.
+	EdgeReplyTo = "reply_to"
+	// EdgeReplies holds the string denoting the replies edge name in mutations.
+	EdgeReplies = "replies"
 	// Table holds the table name of the message in the database.
 	Table = "messages"
 	// ChatTable is the table that holds the chat relation/edge.
@@ -57,6 +88,56 @@ const (
 	ProblemInverseTable = "problems"
 	// ProblemColumn is the table column denoting the problem relation/edge.
 	ProblemColumn = "problem_id"
+	// ModerationCaseTable is the table that holds the moderation_case relation/edge.
+	ModerationCaseTable = "moderation_cases"
+	// ModerationCaseInverseTable is the table name for the ModerationCase entity.
+	// It exists in this package in order to avoid circular dependency with the "moderationcase" package.
+	ModerationCaseInverseTable = "moderation_cases"
+	// ModerationCaseColumn is the table column denoting the moderation_case relation/edge.
+	ModerationCaseColumn = "message_id"
+	// VerdictConflictsTable is the table that holds the verdict_conflicts relation/edge.
+	VerdictConflictsTable = "verdict_conflicts"
+	// VerdictConflictsInverseTable is the table name for the VerdictConflict entity.
+	// It exists in this package in order to avoid circular dependency with the "verdictconflict" package.
+	VerdictConflictsInverseTable = "verdict_conflicts"
+	// VerdictConflictsColumn is the table column denoting the verdict_conflicts relation/edge.
+	VerdictConflictsColumn = "message_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "message_revisions"
+	// RevisionsInverseTable is the table name for the MessageRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "messagerevision" package.
+	RevisionsInverseTable = "message_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "message_id"
+	// DeletionTable is the table that holds the deletion relation/edge.
+	DeletionTable = "message_deletions"
+	// DeletionInverseTable is the table name for the MessageDeletion entity.
+	// It exists in this package in order to avoid circular dependency with the "messagedeletion" package.
+	DeletionInverseTable = "message_deletions"
+	// DeletionColumn is the table column denoting the deletion relation/edge.
+	DeletionColumn = "message_id"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "message_id"
+	// ReactionsTable is the table that holds the reactions relation/edge.
+	ReactionsTable = "message_reactions"
+	// ReactionsInverseTable is the table name for the MessageReaction entity.
+	// It exists in this package in order to avoid circular dependency with the "messagereaction" package.
+	ReactionsInverseTable = "message_reactions"
+	// ReactionsColumn is the table column denoting the reactions relation/edge.
+	ReactionsColumn = "message_id"
+	// ReplyToTable is the table that holds the reply_to relation/edge.
+	ReplyToTable = "messages"
+	// ReplyToColumn is the table column denoting the reply_to relation/edge.
+	ReplyToColumn = "reply_to_message_id"
+	// RepliesTable is the table that holds the replies relation/edge.
+	RepliesTable = "messages"
+	// RepliesColumn is the table column denoting the replies relation/edge.
+	RepliesColumn = "reply_to_message_id"
 )
 
 // Columns holds all SQL columns for message fields.
@@ -68,10 +149,17 @@ var Columns = []string{
 	FieldIsVisibleForClient,
 	FieldIsVisibleForManager,
 	FieldBody,
+	FieldEditedAt,
+	FieldDeletedAt,
 	FieldCheckedAt,
+	FieldVerdict,
+	FieldVerdictSource,
 	FieldIsBlocked,
 	FieldIsService,
+	FieldIsInternalNote,
 	FieldInitialRequestID,
+	FieldContentRequestID,
+	FieldReplyToMessageID,
 	FieldCreatedAt,
 }
 
@@ -96,12 +184,62 @@ var (
 	DefaultIsBlocked bool
 	// DefaultIsService holds the default value on creation for the "is_service" field.
 	DefaultIsService bool
+	// DefaultIsInternalNote holds the default value on creation for the "is_internal_note" field.
+	DefaultIsInternalNote bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() types.MessageID
 )
 
+// Verdict defines the type for the "verdict" enum field.
+type Verdict string
+
+// Verdict values.
+const (
+	VerdictOk         Verdict = "ok"
+	VerdictSuspicious Verdict = "suspicious"
+)
+
+func (v Verdict) String() string {
+	return string(v)
+}
+
+// VerdictValidator is a validator for the "verdict" field enum values. It is called by the builders before save.
+func VerdictValidator(v Verdict) error {
+	switch v {
+	case VerdictOk, VerdictSuspicious:
+		return nil
+	default:
+		return fmt.Errorf("message: invalid enum value for verdict field: %q", v)
+	}
+}
+
+// VerdictSource defines the type for the "verdict_source" enum field.
+type VerdictSource string
+
+// VerdictSource values.
+const (
+	VerdictSourceAfc       VerdictSource = "afc"
+	VerdictSourceTimeout   VerdictSource = "timeout"
+	VerdictSourcePrefilter VerdictSource = "prefilter"
+	VerdictSourceModerator VerdictSource = "moderator"
+)
+
+func (vs VerdictSource) String() string {
+	return string(vs)
+}
+
+// VerdictSourceValidator is a validator for the "verdict_source" field enum values. It is called by the builders before save.
+func VerdictSourceValidator(vs VerdictSource) error {
+	switch vs {
+	case VerdictSourceAfc, VerdictSourceTimeout, VerdictSourcePrefilter, VerdictSourceModerator:
+		return nil
+	default:
+		return fmt.Errorf("message: invalid enum value for verdict_source field: %q", vs)
+	}
+}
+
 // OrderOption defines the ordering options for the Message queries.
 type OrderOption func(*sql.Selector)
 
@@ -140,11 +278,31 @@ func ByBody(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBody, opts...).ToFunc()
 }
 
+// ByEditedAt orders the results by the edited_at field.
+func ByEditedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByCheckedAt orders the results by the checked_at field.
 func ByCheckedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCheckedAt, opts...).ToFunc()
 }
 
+// ByVerdict orders the results by the verdict field.
+func ByVerdict(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerdict, opts...).ToFunc()
+}
+
+// ByVerdictSource orders the results by the verdict_source field.
+func ByVerdictSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerdictSource, opts...).ToFunc()
+}
+
 // ByIsBlocked orders the results by the is_blocked field.
 func ByIsBlocked(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsBlocked, opts...).ToFunc()
@@ -155,11 +313,26 @@ func ByIsService(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsService, opts...).ToFunc()
 }
 
+// ByIsInternalNote orders the results by the is_internal_note field.
+func ByIsInternalNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsInternalNote, opts...).ToFunc()
+}
+
 // ByInitialRequestID orders the results by the initial_request_id field.
 func ByInitialRequestID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInitialRequestID, opts...).ToFunc()
 }
 
+// ByContentRequestID orders the results by the content_request_id field.
+func ByContentRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentRequestID, opts...).ToFunc()
+}
+
+// ByReplyToMessageID orders the results by the reply_to_message_id field.
+func ByReplyToMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplyToMessageID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -178,6 +351,97 @@ func ByProblemField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newProblemStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByModerationCaseField orders the results by moderation_case field.
+func ByModerationCaseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModerationCaseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByVerdictConflictsCount orders the results by verdict_conflicts count.
+func ByVerdictConflictsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVerdictConflictsStep(), opts...)
+	}
+}
+
+// ByVerdictConflicts orders the results by verdict_conflicts terms.
+func ByVerdictConflicts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVerdictConflictsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDeletionField orders the results by deletion field.
+func ByDeletionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeletionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAttachmentsCount orders the results by attachments count.
+func ByAttachmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttachmentsStep(), opts...)
+	}
+}
+
+// ByAttachments orders the results by attachments terms.
+func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttachmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReactionsCount orders the results by reactions count.
+func ByReactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReactionsStep(), opts...)
+	}
+}
+
+// ByReactions orders the results by reactions terms.
+func ByReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReplyToField orders the results by reply_to field.
+func ByReplyToField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReplyToStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRepliesCount orders the results by replies count.
+func ByRepliesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRepliesStep(), opts...)
+	}
+}
+
+// ByReplies orders the results by replies terms.
+func ByReplies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRepliesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newChatStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -192,3 +456,59 @@ func newProblemStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ProblemTable, ProblemColumn),
 	)
 }
+func newModerationCaseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModerationCaseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ModerationCaseTable, ModerationCaseColumn),
+	)
+}
+func newVerdictConflictsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VerdictConflictsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VerdictConflictsTable, VerdictConflictsColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newDeletionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeletionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, DeletionTable, DeletionColumn),
+	)
+}
+func newAttachmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttachmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+	)
+}
+func newReactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+	)
+}
+func newReplyToStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReplyToTable, ReplyToColumn),
+	)
+}
+func newRepliesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RepliesTable, RepliesColumn),
+	)
+}
@@ -86,6 +86,16 @@ func Body(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldBody, v))
 }
 
+// EditedAt applies equality check predicate on the "edited_at" field. It's identical to EditedAtEQ.
+func EditedAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // CheckedAt applies equality check predicate on the "checked_at" field. It's identical to CheckedAtEQ.
 func CheckedAt(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCheckedAt, v))
@@ -101,11 +111,26 @@ func IsService(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldIsService, v))
 }
 
+// IsInternalNote applies equality check predicate on the "is_internal_note" field. It's identical to IsInternalNoteEQ.
+func IsInternalNote(v bool) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldIsInternalNote, v))
+}
+
 // InitialRequestID applies equality check predicate on the "initial_request_id" field. It's identical to InitialRequestIDEQ.
 func InitialRequestID(v types.RequestID) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldInitialRequestID, v))
 }
 
+// ContentRequestID applies equality check predicate on the "content_request_id" field. It's identical to ContentRequestIDEQ.
+func ContentRequestID(v types.RequestID) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldContentRequestID, v))
+}
+
+// ReplyToMessageID applies equality check predicate on the "reply_to_message_id" field. It's identical to ReplyToMessageIDEQ.
+func ReplyToMessageID(v types.MessageID) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReplyToMessageID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
@@ -286,6 +311,106 @@ func BodyContainsFold(v string) predicate.Message {
 	return predicate.Message(sql.FieldContainsFold(FieldBody, v))
 }
 
+// EditedAtEQ applies the EQ predicate on the "edited_at" field.
+func EditedAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// EditedAtNEQ applies the NEQ predicate on the "edited_at" field.
+func EditedAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldEditedAt, v))
+}
+
+// EditedAtIn applies the In predicate on the "edited_at" field.
+func EditedAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldEditedAt, vs...))
+}
+
+// EditedAtNotIn applies the NotIn predicate on the "edited_at" field.
+func EditedAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldEditedAt, vs...))
+}
+
+// EditedAtGT applies the GT predicate on the "edited_at" field.
+func EditedAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldEditedAt, v))
+}
+
+// EditedAtGTE applies the GTE predicate on the "edited_at" field.
+func EditedAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldEditedAt, v))
+}
+
+// EditedAtLT applies the LT predicate on the "edited_at" field.
+func EditedAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldEditedAt, v))
+}
+
+// EditedAtLTE applies the LTE predicate on the "edited_at" field.
+func EditedAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldEditedAt, v))
+}
+
+// EditedAtIsNil applies the IsNil predicate on the "edited_at" field.
+func EditedAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldEditedAt))
+}
+
+// EditedAtNotNil applies the NotNil predicate on the "edited_at" field.
+func EditedAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldEditedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // CheckedAtEQ applies the EQ predicate on the "checked_at" field.
 func CheckedAtEQ(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCheckedAt, v))
@@ -336,6 +461,66 @@ func CheckedAtNotNil() predicate.Message {
 	return predicate.Message(sql.FieldNotNull(FieldCheckedAt))
 }
 
+// VerdictEQ applies the EQ predicate on the "verdict" field.
+func VerdictEQ(v Verdict) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldVerdict, v))
+}
+
+// VerdictNEQ applies the NEQ predicate on the "verdict" field.
+func VerdictNEQ(v Verdict) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldVerdict, v))
+}
+
+// VerdictIn applies the In predicate on the "verdict" field.
+func VerdictIn(vs ...Verdict) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldVerdict, vs...))
+}
+
+// VerdictNotIn applies the NotIn predicate on the "verdict" field.
+func VerdictNotIn(vs ...Verdict) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldVerdict, vs...))
+}
+
+// VerdictIsNil applies the IsNil predicate on the "verdict" field.
+func VerdictIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldVerdict))
+}
+
+// VerdictNotNil applies the NotNil predicate on the "verdict" field.
+func VerdictNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldVerdict))
+}
+
+// VerdictSourceEQ applies the EQ predicate on the "verdict_source" field.
+func VerdictSourceEQ(v VerdictSource) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldVerdictSource, v))
+}
+
+// VerdictSourceNEQ applies the NEQ predicate on the "verdict_source" field.
+func VerdictSourceNEQ(v VerdictSource) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldVerdictSource, v))
+}
+
+// VerdictSourceIn applies the In predicate on the "verdict_source" field.
+func VerdictSourceIn(vs ...VerdictSource) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldVerdictSource, vs...))
+}
+
+// VerdictSourceNotIn applies the NotIn predicate on the "verdict_source" field.
+func VerdictSourceNotIn(vs ...VerdictSource) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldVerdictSource, vs...))
+}
+
+// VerdictSourceIsNil applies the IsNil predicate on the "verdict_source" field.
+func VerdictSourceIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldVerdictSource))
+}
+
+// VerdictSourceNotNil applies the NotNil predicate on the "verdict_source" field.
+func VerdictSourceNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldVerdictSource))
+}
+
 // IsBlockedEQ applies the EQ predicate on the "is_blocked" field.
 func IsBlockedEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldIsBlocked, v))
@@ -356,6 +541,16 @@ func IsServiceNEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldNEQ(FieldIsService, v))
 }
 
+// IsInternalNoteEQ applies the EQ predicate on the "is_internal_note" field.
+func IsInternalNoteEQ(v bool) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldIsInternalNote, v))
+}
+
+// IsInternalNoteNEQ applies the NEQ predicate on the "is_internal_note" field.
+func IsInternalNoteNEQ(v bool) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldIsInternalNote, v))
+}
+
 // InitialRequestIDEQ applies the EQ predicate on the "initial_request_id" field.
 func InitialRequestIDEQ(v types.RequestID) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldInitialRequestID, v))
@@ -396,6 +591,86 @@ func InitialRequestIDLTE(v types.RequestID) predicate.Message {
 	return predicate.Message(sql.FieldLTE(FieldInitialRequestID, v))
 }
 
+// ContentRequestIDEQ applies the EQ predicate on the "content_request_id" field.
+func ContentRequestIDEQ(v types.RequestID) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldContentRequestID, v))
+}
+
+// ContentRequestIDNEQ applies the NEQ predicate on the "content_request_id" field.
+func ContentRequestIDNEQ(v types.RequestID) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldContentRequestID, v))
+}
+
+// ContentRequestIDIn applies the In predicate on the "content_request_id" field.
+func ContentRequestIDIn(vs ...types.RequestID) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldContentRequestID, vs...))
+}
+
+// ContentRequestIDNotIn applies the NotIn predicate on the "content_request_id" field.
+func ContentRequestIDNotIn(vs ...types.RequestID) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldContentRequestID, vs...))
+}
+
+// ContentRequestIDGT applies the GT predicate on the "content_request_id" field.
+func ContentRequestIDGT(v types.RequestID) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldContentRequestID, v))
+}
+
+// ContentRequestIDGTE applies the GTE predicate on the "content_request_id" field.
+func ContentRequestIDGTE(v types.RequestID) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldContentRequestID, v))
+}
+
+// ContentRequestIDLT applies the LT predicate on the "content_request_id" field.
+func ContentRequestIDLT(v types.RequestID) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldContentRequestID, v))
+}
+
+// ContentRequestIDLTE applies the LTE predicate on the "content_request_id" field.
+func ContentRequestIDLTE(v types.RequestID) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldContentRequestID, v))
+}
+
+// ContentRequestIDIsNil applies the IsNil predicate on the "content_request_id" field.
+func ContentRequestIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldContentRequestID))
+}
+
+// ContentRequestIDNotNil applies the NotNil predicate on the "content_request_id" field.
+func ContentRequestIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldContentRequestID))
+}
+
+// ReplyToMessageIDEQ applies the EQ predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDEQ(v types.MessageID) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReplyToMessageID, v))
+}
+
+// ReplyToMessageIDNEQ applies the NEQ predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDNEQ(v types.MessageID) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldReplyToMessageID, v))
+}
+
+// ReplyToMessageIDIn applies the In predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDIn(vs ...types.MessageID) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldReplyToMessageID, vs...))
+}
+
+// ReplyToMessageIDNotIn applies the NotIn predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDNotIn(vs ...types.MessageID) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldReplyToMessageID, vs...))
+}
+
+// ReplyToMessageIDIsNil applies the IsNil predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldReplyToMessageID))
+}
+
+// ReplyToMessageIDNotNil applies the NotNil predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldReplyToMessageID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
@@ -482,6 +757,190 @@ func HasProblemWith(preds ...predicate.Problem) predicate.Message {
 	})
 }
 
+// HasModerationCase applies the HasEdge predicate on the "moderation_case" edge.
+func HasModerationCase() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ModerationCaseTable, ModerationCaseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModerationCaseWith applies the HasEdge predicate on the "moderation_case" edge with a given conditions (other predicates).
+func HasModerationCaseWith(preds ...predicate.ModerationCase) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newModerationCaseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVerdictConflicts applies the HasEdge predicate on the "verdict_conflicts" edge.
+func HasVerdictConflicts() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VerdictConflictsTable, VerdictConflictsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVerdictConflictsWith applies the HasEdge predicate on the "verdict_conflicts" edge with a given conditions (other predicates).
+func HasVerdictConflictsWith(preds ...predicate.VerdictConflict) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newVerdictConflictsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.MessageRevision) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeletion applies the HasEdge predicate on the "deletion" edge.
+func HasDeletion() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, DeletionTable, DeletionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeletionWith applies the HasEdge predicate on the "deletion" edge with a given conditions (other predicates).
+func HasDeletionWith(preds ...predicate.MessageDeletion) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newDeletionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReactions applies the HasEdge predicate on the "reactions" edge.
+func HasReactions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReactionsWith applies the HasEdge predicate on the "reactions" edge with a given conditions (other predicates).
+func HasReactionsWith(preds ...predicate.MessageReaction) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newReactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplyTo applies the HasEdge predicate on the "reply_to" edge.
+func HasReplyTo() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReplyToTable, ReplyToColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplyToWith applies the HasEdge predicate on the "reply_to" edge with a given conditions (other predicates).
+func HasReplyToWith(preds ...predicate.Message) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newReplyToStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplies applies the HasEdge predicate on the "replies" edge.
+func HasReplies() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RepliesTable, RepliesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRepliesWith applies the HasEdge predicate on the "replies" edge with a given conditions (other predicates).
+func HasRepliesWith(preds ...predicate.Message) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newRepliesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Message) predicate.Message {
 	return predicate.Message(sql.AndPredicates(predicates...))
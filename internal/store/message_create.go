@@ -12,9 +12,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/attachment"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/store/messagereaction"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
 	"github.com/zestagio/chat-service/internal/store/problem"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
@@ -86,6 +92,34 @@ func (mc *MessageCreate) SetBody(s string) *MessageCreate {
 	return mc
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (mc *MessageCreate) SetEditedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetEditedAt(t)
+	return mc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEditedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetEditedAt(*t)
+	}
+	return mc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mc *MessageCreate) SetDeletedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetDeletedAt(t)
+	return mc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableDeletedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetDeletedAt(*t)
+	}
+	return mc
+}
+
 // SetCheckedAt sets the "checked_at" field.
 func (mc *MessageCreate) SetCheckedAt(t time.Time) *MessageCreate {
 	mc.mutation.SetCheckedAt(t)
@@ -100,6 +134,34 @@ func (mc *MessageCreate) SetNillableCheckedAt(t *time.Time) *MessageCreate {
 	return mc
 }
 
+// SetVerdict sets the "verdict" field.
+func (mc *MessageCreate) SetVerdict(m message.Verdict) *MessageCreate {
+	mc.mutation.SetVerdict(m)
+	return mc
+}
+
+// SetNillableVerdict sets the "verdict" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableVerdict(m *message.Verdict) *MessageCreate {
+	if m != nil {
+		mc.SetVerdict(*m)
+	}
+	return mc
+}
+
+// SetVerdictSource sets the "verdict_source" field.
+func (mc *MessageCreate) SetVerdictSource(ms message.VerdictSource) *MessageCreate {
+	mc.mutation.SetVerdictSource(ms)
+	return mc
+}
+
+// SetNillableVerdictSource sets the "verdict_source" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableVerdictSource(ms *message.VerdictSource) *MessageCreate {
+	if ms != nil {
+		mc.SetVerdictSource(*ms)
+	}
+	return mc
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (mc *MessageCreate) SetIsBlocked(b bool) *MessageCreate {
 	mc.mutation.SetIsBlocked(b)
@@ -128,12 +190,54 @@ func (mc *MessageCreate) SetNillableIsService(b *bool) *MessageCreate {
 	return mc
 }
 
+// SetIsInternalNote sets the "is_internal_note" field.
+func (mc *MessageCreate) SetIsInternalNote(b bool) *MessageCreate {
+	mc.mutation.SetIsInternalNote(b)
+	return mc
+}
+
+// SetNillableIsInternalNote sets the "is_internal_note" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableIsInternalNote(b *bool) *MessageCreate {
+	if b != nil {
+		mc.SetIsInternalNote(*b)
+	}
+	return mc
+}
+
 // SetInitialRequestID sets the "initial_request_id" field.
 func (mc *MessageCreate) SetInitialRequestID(ti types.RequestID) *MessageCreate {
 	mc.mutation.SetInitialRequestID(ti)
 	return mc
 }
 
+// SetContentRequestID sets the "content_request_id" field.
+func (mc *MessageCreate) SetContentRequestID(ti types.RequestID) *MessageCreate {
+	mc.mutation.SetContentRequestID(ti)
+	return mc
+}
+
+// SetNillableContentRequestID sets the "content_request_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableContentRequestID(ti *types.RequestID) *MessageCreate {
+	if ti != nil {
+		mc.SetContentRequestID(*ti)
+	}
+	return mc
+}
+
+// SetReplyToMessageID sets the "reply_to_message_id" field.
+func (mc *MessageCreate) SetReplyToMessageID(ti types.MessageID) *MessageCreate {
+	mc.mutation.SetReplyToMessageID(ti)
+	return mc
+}
+
+// SetNillableReplyToMessageID sets the "reply_to_message_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableReplyToMessageID(ti *types.MessageID) *MessageCreate {
+	if ti != nil {
+		mc.SetReplyToMessageID(*ti)
+	}
+	return mc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (mc *MessageCreate) SetCreatedAt(t time.Time) *MessageCreate {
 	mc.mutation.SetCreatedAt(t)
@@ -172,6 +276,138 @@ func (mc *MessageCreate) SetProblem(p *Problem) *MessageCreate {
 	return mc.SetProblemID(p.ID)
 }
 
+// SetModerationCaseID sets the "moderation_case" edge to the ModerationCase entity by ID.
+func (mc *MessageCreate) SetModerationCaseID(id types.ModerationCaseID) *MessageCreate {
+	mc.mutation.SetModerationCaseID(id)
+	return mc
+}
+
+// SetNillableModerationCaseID sets the "moderation_case" edge to the ModerationCase entity by ID if the given value is not nil.
+func (mc *MessageCreate) SetNillableModerationCaseID(id *types.ModerationCaseID) *MessageCreate {
+	if id != nil {
+		mc = mc.SetModerationCaseID(*id)
+	}
+	return mc
+}
+
+// SetModerationCase sets the "moderation_case" edge to the ModerationCase entity.
+func (mc *MessageCreate) SetModerationCase(m *ModerationCase) *MessageCreate {
+	return mc.SetModerationCaseID(m.ID)
+}
+
+// AddVerdictConflictIDs adds the "verdict_conflicts" edge to the VerdictConflict entity by IDs.
+func (mc *MessageCreate) AddVerdictConflictIDs(ids ...types.VerdictConflictID) *MessageCreate {
+	mc.mutation.AddVerdictConflictIDs(ids...)
+	return mc
+}
+
+// AddVerdictConflicts adds the "verdict_conflicts" edges to the VerdictConflict entity.
+func (mc *MessageCreate) AddVerdictConflicts(v ...*VerdictConflict) *MessageCreate {
+	ids := make([]types.VerdictConflictID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return mc.AddVerdictConflictIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (mc *MessageCreate) AddRevisionIDs(ids ...types.MessageRevisionID) *MessageCreate {
+	mc.mutation.AddRevisionIDs(ids...)
+	return mc
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (mc *MessageCreate) AddRevisions(m ...*MessageRevision) *MessageCreate {
+	ids := make([]types.MessageRevisionID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddRevisionIDs(ids...)
+}
+
+// SetDeletionID sets the "deletion" edge to the MessageDeletion entity by ID.
+func (mc *MessageCreate) SetDeletionID(id types.MessageDeletionID) *MessageCreate {
+	mc.mutation.SetDeletionID(id)
+	return mc
+}
+
+// SetNillableDeletionID sets the "deletion" edge to the MessageDeletion entity by ID if the given value is not nil.
+func (mc *MessageCreate) SetNillableDeletionID(id *types.MessageDeletionID) *MessageCreate {
+	if id != nil {
+		mc = mc.SetDeletionID(*id)
+	}
+	return mc
+}
+
+// SetDeletion sets the "deletion" edge to the MessageDeletion entity.
+func (mc *MessageCreate) SetDeletion(m *MessageDeletion) *MessageCreate {
+	return mc.SetDeletionID(m.ID)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (mc *MessageCreate) AddAttachmentIDs(ids ...types.AttachmentID) *MessageCreate {
+	mc.mutation.AddAttachmentIDs(ids...)
+	return mc
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (mc *MessageCreate) AddAttachments(a ...*Attachment) *MessageCreate {
+	ids := make([]types.AttachmentID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return mc.AddAttachmentIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (mc *MessageCreate) AddReactionIDs(ids ...types.MessageReactionID) *MessageCreate {
+	mc.mutation.AddReactionIDs(ids...)
+	return mc
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (mc *MessageCreate) AddReactions(m ...*MessageReaction) *MessageCreate {
+	ids := make([]types.MessageReactionID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddReactionIDs(ids...)
+}
+
+// SetReplyToID sets the "reply_to" edge to the Message entity by ID.
+func (mc *MessageCreate) SetReplyToID(id types.MessageID) *MessageCreate {
+	mc.mutation.SetReplyToID(id)
+	return mc
+}
+
+// SetNillableReplyToID sets the "reply_to" edge to the Message entity by ID if the given value is not nil.
+func (mc *MessageCreate) SetNillableReplyToID(id *types.MessageID) *MessageCreate {
+	if id != nil {
+		mc = mc.SetReplyToID(*id)
+	}
+	return mc
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (mc *MessageCreate) SetReplyTo(m *Message) *MessageCreate {
+	return mc.SetReplyToID(m.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by IDs.
+func (mc *MessageCreate) AddReplyIDs(ids ...types.MessageID) *MessageCreate {
+	mc.mutation.AddReplyIDs(ids...)
+	return mc
+}
+
+// AddReplies adds the "replies" edges to the Message entity.
+func (mc *MessageCreate) AddReplies(m ...*Message) *MessageCreate {
+	ids := make([]types.MessageID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddReplyIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mc *MessageCreate) Mutation() *MessageMutation {
 	return mc.mutation
@@ -223,6 +459,10 @@ func (mc *MessageCreate) defaults() {
 		v := message.DefaultIsService
 		mc.mutation.SetIsService(v)
 	}
+	if _, ok := mc.mutation.IsInternalNote(); !ok {
+		v := message.DefaultIsInternalNote
+		mc.mutation.SetIsInternalNote(v)
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		v := message.DefaultCreatedAt()
 		mc.mutation.SetCreatedAt(v)
@@ -270,12 +510,25 @@ func (mc *MessageCreate) check() error {
 			return &ValidationError{Name: "body", err: fmt.Errorf(`store: validator failed for field "Message.body": %w`, err)}
 		}
 	}
+	if v, ok := mc.mutation.Verdict(); ok {
+		if err := message.VerdictValidator(v); err != nil {
+			return &ValidationError{Name: "verdict", err: fmt.Errorf(`store: validator failed for field "Message.verdict": %w`, err)}
+		}
+	}
+	if v, ok := mc.mutation.VerdictSource(); ok {
+		if err := message.VerdictSourceValidator(v); err != nil {
+			return &ValidationError{Name: "verdict_source", err: fmt.Errorf(`store: validator failed for field "Message.verdict_source": %w`, err)}
+		}
+	}
 	if _, ok := mc.mutation.IsBlocked(); !ok {
 		return &ValidationError{Name: "is_blocked", err: errors.New(`store: missing required field "Message.is_blocked"`)}
 	}
 	if _, ok := mc.mutation.IsService(); !ok {
 		return &ValidationError{Name: "is_service", err: errors.New(`store: missing required field "Message.is_service"`)}
 	}
+	if _, ok := mc.mutation.IsInternalNote(); !ok {
+		return &ValidationError{Name: "is_internal_note", err: errors.New(`store: missing required field "Message.is_internal_note"`)}
+	}
 	if _, ok := mc.mutation.InitialRequestID(); !ok {
 		return &ValidationError{Name: "initial_request_id", err: errors.New(`store: missing required field "Message.initial_request_id"`)}
 	}
@@ -284,6 +537,16 @@ func (mc *MessageCreate) check() error {
 			return &ValidationError{Name: "initial_request_id", err: fmt.Errorf(`store: validator failed for field "Message.initial_request_id": %w`, err)}
 		}
 	}
+	if v, ok := mc.mutation.ContentRequestID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "content_request_id", err: fmt.Errorf(`store: validator failed for field "Message.content_request_id": %w`, err)}
+		}
+	}
+	if v, ok := mc.mutation.ReplyToMessageID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "reply_to_message_id", err: fmt.Errorf(`store: validator failed for field "Message.reply_to_message_id": %w`, err)}
+		}
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "Message.created_at"`)}
 	}
@@ -350,10 +613,26 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldBody, field.TypeString, value)
 		_node.Body = value
 	}
+	if value, ok := mc.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = value
+	}
+	if value, ok := mc.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
 	if value, ok := mc.mutation.CheckedAt(); ok {
 		_spec.SetField(message.FieldCheckedAt, field.TypeTime, value)
 		_node.CheckedAt = value
 	}
+	if value, ok := mc.mutation.Verdict(); ok {
+		_spec.SetField(message.FieldVerdict, field.TypeEnum, value)
+		_node.Verdict = value
+	}
+	if value, ok := mc.mutation.VerdictSource(); ok {
+		_spec.SetField(message.FieldVerdictSource, field.TypeEnum, value)
+		_node.VerdictSource = value
+	}
 	if value, ok := mc.mutation.IsBlocked(); ok {
 		_spec.SetField(message.FieldIsBlocked, field.TypeBool, value)
 		_node.IsBlocked = value
@@ -362,10 +641,18 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldIsService, field.TypeBool, value)
 		_node.IsService = value
 	}
+	if value, ok := mc.mutation.IsInternalNote(); ok {
+		_spec.SetField(message.FieldIsInternalNote, field.TypeBool, value)
+		_node.IsInternalNote = value
+	}
 	if value, ok := mc.mutation.InitialRequestID(); ok {
 		_spec.SetField(message.FieldInitialRequestID, field.TypeUUID, value)
 		_node.InitialRequestID = value
 	}
+	if value, ok := mc.mutation.ContentRequestID(); ok {
+		_spec.SetField(message.FieldContentRequestID, field.TypeUUID, value)
+		_node.ContentRequestID = value
+	}
 	if value, ok := mc.mutation.CreatedAt(); ok {
 		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -404,6 +691,135 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_node.ProblemID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mc.mutation.ModerationCaseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.ModerationCaseTable,
+			Columns: []string{message.ModerationCaseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.VerdictConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.VerdictConflictsTable,
+			Columns: []string{message.VerdictConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.DeletionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.DeletionTable,
+			Columns: []string{message.DeletionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReplyToMessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -504,6 +920,54 @@ func (u *MessageUpsert) UpdateIsVisibleForManager() *MessageUpsert {
 	return u
 }
 
+// SetBody sets the "body" field.
+func (u *MessageUpsert) SetBody(v string) *MessageUpsert {
+	u.Set(message.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateBody() *MessageUpsert {
+	u.SetExcluded(message.FieldBody)
+	return u
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *MessageUpsert) SetEditedAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldEditedAt, v)
+	return u
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateEditedAt() *MessageUpsert {
+	u.SetExcluded(message.FieldEditedAt)
+	return u
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *MessageUpsert) ClearEditedAt() *MessageUpsert {
+	u.SetNull(message.FieldEditedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MessageUpsert) SetDeletedAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateDeletedAt() *MessageUpsert {
+	u.SetExcluded(message.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MessageUpsert) ClearDeletedAt() *MessageUpsert {
+	u.SetNull(message.FieldDeletedAt)
+	return u
+}
+
 // SetCheckedAt sets the "checked_at" field.
 func (u *MessageUpsert) SetCheckedAt(v time.Time) *MessageUpsert {
 	u.Set(message.FieldCheckedAt, v)
@@ -522,6 +986,42 @@ func (u *MessageUpsert) ClearCheckedAt() *MessageUpsert {
 	return u
 }
 
+// SetVerdict sets the "verdict" field.
+func (u *MessageUpsert) SetVerdict(v message.Verdict) *MessageUpsert {
+	u.Set(message.FieldVerdict, v)
+	return u
+}
+
+// UpdateVerdict sets the "verdict" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateVerdict() *MessageUpsert {
+	u.SetExcluded(message.FieldVerdict)
+	return u
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (u *MessageUpsert) ClearVerdict() *MessageUpsert {
+	u.SetNull(message.FieldVerdict)
+	return u
+}
+
+// SetVerdictSource sets the "verdict_source" field.
+func (u *MessageUpsert) SetVerdictSource(v message.VerdictSource) *MessageUpsert {
+	u.Set(message.FieldVerdictSource, v)
+	return u
+}
+
+// UpdateVerdictSource sets the "verdict_source" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateVerdictSource() *MessageUpsert {
+	u.SetExcluded(message.FieldVerdictSource)
+	return u
+}
+
+// ClearVerdictSource clears the value of the "verdict_source" field.
+func (u *MessageUpsert) ClearVerdictSource() *MessageUpsert {
+	u.SetNull(message.FieldVerdictSource)
+	return u
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (u *MessageUpsert) SetIsBlocked(v bool) *MessageUpsert {
 	u.Set(message.FieldIsBlocked, v)
@@ -534,6 +1034,24 @@ func (u *MessageUpsert) UpdateIsBlocked() *MessageUpsert {
 	return u
 }
 
+// SetContentRequestID sets the "content_request_id" field.
+func (u *MessageUpsert) SetContentRequestID(v types.RequestID) *MessageUpsert {
+	u.Set(message.FieldContentRequestID, v)
+	return u
+}
+
+// UpdateContentRequestID sets the "content_request_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateContentRequestID() *MessageUpsert {
+	u.SetExcluded(message.FieldContentRequestID)
+	return u
+}
+
+// ClearContentRequestID clears the value of the "content_request_id" field.
+func (u *MessageUpsert) ClearContentRequestID() *MessageUpsert {
+	u.SetNull(message.FieldContentRequestID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -554,15 +1072,18 @@ func (u *MessageUpsertOne) UpdateNewValues() *MessageUpsertOne {
 		if _, exists := u.create.mutation.AuthorID(); exists {
 			s.SetIgnore(message.FieldAuthorID)
 		}
-		if _, exists := u.create.mutation.Body(); exists {
-			s.SetIgnore(message.FieldBody)
-		}
 		if _, exists := u.create.mutation.IsService(); exists {
 			s.SetIgnore(message.FieldIsService)
 		}
+		if _, exists := u.create.mutation.IsInternalNote(); exists {
+			s.SetIgnore(message.FieldIsInternalNote)
+		}
 		if _, exists := u.create.mutation.InitialRequestID(); exists {
 			s.SetIgnore(message.FieldInitialRequestID)
 		}
+		if _, exists := u.create.mutation.ReplyToMessageID(); exists {
+			s.SetIgnore(message.FieldReplyToMessageID)
+		}
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(message.FieldCreatedAt)
 		}
@@ -653,6 +1174,62 @@ func (u *MessageUpsertOne) UpdateIsVisibleForManager() *MessageUpsertOne {
 	})
 }
 
+// SetBody sets the "body" field.
+func (u *MessageUpsertOne) SetBody(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateBody() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *MessageUpsertOne) SetEditedAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateEditedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *MessageUpsertOne) ClearEditedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MessageUpsertOne) SetDeletedAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateDeletedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MessageUpsertOne) ClearDeletedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // SetCheckedAt sets the "checked_at" field.
 func (u *MessageUpsertOne) SetCheckedAt(v time.Time) *MessageUpsertOne {
 	return u.Update(func(s *MessageUpsert) {
@@ -674,6 +1251,48 @@ func (u *MessageUpsertOne) ClearCheckedAt() *MessageUpsertOne {
 	})
 }
 
+// SetVerdict sets the "verdict" field.
+func (u *MessageUpsertOne) SetVerdict(v message.Verdict) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetVerdict(v)
+	})
+}
+
+// UpdateVerdict sets the "verdict" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateVerdict() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateVerdict()
+	})
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (u *MessageUpsertOne) ClearVerdict() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearVerdict()
+	})
+}
+
+// SetVerdictSource sets the "verdict_source" field.
+func (u *MessageUpsertOne) SetVerdictSource(v message.VerdictSource) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetVerdictSource(v)
+	})
+}
+
+// UpdateVerdictSource sets the "verdict_source" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateVerdictSource() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateVerdictSource()
+	})
+}
+
+// ClearVerdictSource clears the value of the "verdict_source" field.
+func (u *MessageUpsertOne) ClearVerdictSource() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearVerdictSource()
+	})
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (u *MessageUpsertOne) SetIsBlocked(v bool) *MessageUpsertOne {
 	return u.Update(func(s *MessageUpsert) {
@@ -688,6 +1307,27 @@ func (u *MessageUpsertOne) UpdateIsBlocked() *MessageUpsertOne {
 	})
 }
 
+// SetContentRequestID sets the "content_request_id" field.
+func (u *MessageUpsertOne) SetContentRequestID(v types.RequestID) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetContentRequestID(v)
+	})
+}
+
+// UpdateContentRequestID sets the "content_request_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateContentRequestID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateContentRequestID()
+	})
+}
+
+// ClearContentRequestID clears the value of the "content_request_id" field.
+func (u *MessageUpsertOne) ClearContentRequestID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearContentRequestID()
+	})
+}
+
 // Exec executes the query.
 func (u *MessageUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -874,15 +1514,18 @@ func (u *MessageUpsertBulk) UpdateNewValues() *MessageUpsertBulk {
 			if _, exists := b.mutation.AuthorID(); exists {
 				s.SetIgnore(message.FieldAuthorID)
 			}
-			if _, exists := b.mutation.Body(); exists {
-				s.SetIgnore(message.FieldBody)
-			}
 			if _, exists := b.mutation.IsService(); exists {
 				s.SetIgnore(message.FieldIsService)
 			}
+			if _, exists := b.mutation.IsInternalNote(); exists {
+				s.SetIgnore(message.FieldIsInternalNote)
+			}
 			if _, exists := b.mutation.InitialRequestID(); exists {
 				s.SetIgnore(message.FieldInitialRequestID)
 			}
+			if _, exists := b.mutation.ReplyToMessageID(); exists {
+				s.SetIgnore(message.FieldReplyToMessageID)
+			}
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(message.FieldCreatedAt)
 			}
@@ -974,6 +1617,62 @@ func (u *MessageUpsertBulk) UpdateIsVisibleForManager() *MessageUpsertBulk {
 	})
 }
 
+// SetBody sets the "body" field.
+func (u *MessageUpsertBulk) SetBody(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateBody() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *MessageUpsertBulk) SetEditedAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateEditedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *MessageUpsertBulk) ClearEditedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MessageUpsertBulk) SetDeletedAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateDeletedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MessageUpsertBulk) ClearDeletedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // SetCheckedAt sets the "checked_at" field.
 func (u *MessageUpsertBulk) SetCheckedAt(v time.Time) *MessageUpsertBulk {
 	return u.Update(func(s *MessageUpsert) {
@@ -995,6 +1694,48 @@ func (u *MessageUpsertBulk) ClearCheckedAt() *MessageUpsertBulk {
 	})
 }
 
+// SetVerdict sets the "verdict" field.
+func (u *MessageUpsertBulk) SetVerdict(v message.Verdict) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetVerdict(v)
+	})
+}
+
+// UpdateVerdict sets the "verdict" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateVerdict() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateVerdict()
+	})
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (u *MessageUpsertBulk) ClearVerdict() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearVerdict()
+	})
+}
+
+// SetVerdictSource sets the "verdict_source" field.
+func (u *MessageUpsertBulk) SetVerdictSource(v message.VerdictSource) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetVerdictSource(v)
+	})
+}
+
+// UpdateVerdictSource sets the "verdict_source" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateVerdictSource() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateVerdictSource()
+	})
+}
+
+// ClearVerdictSource clears the value of the "verdict_source" field.
+func (u *MessageUpsertBulk) ClearVerdictSource() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearVerdictSource()
+	})
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (u *MessageUpsertBulk) SetIsBlocked(v bool) *MessageUpsertBulk {
 	return u.Update(func(s *MessageUpsert) {
@@ -1009,6 +1750,27 @@ func (u *MessageUpsertBulk) UpdateIsBlocked() *MessageUpsertBulk {
 	})
 }
 
+// SetContentRequestID sets the "content_request_id" field.
+func (u *MessageUpsertBulk) SetContentRequestID(v types.RequestID) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetContentRequestID(v)
+	})
+}
+
+// UpdateContentRequestID sets the "content_request_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateContentRequestID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateContentRequestID()
+	})
+}
+
+// ClearContentRequestID clears the value of the "content_request_id" field.
+func (u *MessageUpsertBulk) ClearContentRequestID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearContentRequestID()
+	})
+}
+
 // Exec executes the query.
 func (u *MessageUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
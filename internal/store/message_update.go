@@ -11,10 +11,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/attachment"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/store/messagereaction"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
 	"github.com/zestagio/chat-service/internal/store/predicate"
 	"github.com/zestagio/chat-service/internal/store/problem"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
@@ -88,6 +94,60 @@ func (mu *MessageUpdate) SetNillableIsVisibleForManager(b *bool) *MessageUpdate
 	return mu
 }
 
+// SetBody sets the "body" field.
+func (mu *MessageUpdate) SetBody(s string) *MessageUpdate {
+	mu.mutation.SetBody(s)
+	return mu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableBody(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetBody(*s)
+	}
+	return mu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (mu *MessageUpdate) SetEditedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetEditedAt(t)
+	return mu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEditedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetEditedAt(*t)
+	}
+	return mu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (mu *MessageUpdate) ClearEditedAt() *MessageUpdate {
+	mu.mutation.ClearEditedAt()
+	return mu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mu *MessageUpdate) SetDeletedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetDeletedAt(t)
+	return mu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableDeletedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetDeletedAt(*t)
+	}
+	return mu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mu *MessageUpdate) ClearDeletedAt() *MessageUpdate {
+	mu.mutation.ClearDeletedAt()
+	return mu
+}
+
 // SetCheckedAt sets the "checked_at" field.
 func (mu *MessageUpdate) SetCheckedAt(t time.Time) *MessageUpdate {
 	mu.mutation.SetCheckedAt(t)
@@ -108,6 +168,46 @@ func (mu *MessageUpdate) ClearCheckedAt() *MessageUpdate {
 	return mu
 }
 
+// SetVerdict sets the "verdict" field.
+func (mu *MessageUpdate) SetVerdict(m message.Verdict) *MessageUpdate {
+	mu.mutation.SetVerdict(m)
+	return mu
+}
+
+// SetNillableVerdict sets the "verdict" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableVerdict(m *message.Verdict) *MessageUpdate {
+	if m != nil {
+		mu.SetVerdict(*m)
+	}
+	return mu
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (mu *MessageUpdate) ClearVerdict() *MessageUpdate {
+	mu.mutation.ClearVerdict()
+	return mu
+}
+
+// SetVerdictSource sets the "verdict_source" field.
+func (mu *MessageUpdate) SetVerdictSource(ms message.VerdictSource) *MessageUpdate {
+	mu.mutation.SetVerdictSource(ms)
+	return mu
+}
+
+// SetNillableVerdictSource sets the "verdict_source" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableVerdictSource(ms *message.VerdictSource) *MessageUpdate {
+	if ms != nil {
+		mu.SetVerdictSource(*ms)
+	}
+	return mu
+}
+
+// ClearVerdictSource clears the value of the "verdict_source" field.
+func (mu *MessageUpdate) ClearVerdictSource() *MessageUpdate {
+	mu.mutation.ClearVerdictSource()
+	return mu
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (mu *MessageUpdate) SetIsBlocked(b bool) *MessageUpdate {
 	mu.mutation.SetIsBlocked(b)
@@ -122,6 +222,26 @@ func (mu *MessageUpdate) SetNillableIsBlocked(b *bool) *MessageUpdate {
 	return mu
 }
 
+// SetContentRequestID sets the "content_request_id" field.
+func (mu *MessageUpdate) SetContentRequestID(ti types.RequestID) *MessageUpdate {
+	mu.mutation.SetContentRequestID(ti)
+	return mu
+}
+
+// SetNillableContentRequestID sets the "content_request_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableContentRequestID(ti *types.RequestID) *MessageUpdate {
+	if ti != nil {
+		mu.SetContentRequestID(*ti)
+	}
+	return mu
+}
+
+// ClearContentRequestID clears the value of the "content_request_id" field.
+func (mu *MessageUpdate) ClearContentRequestID() *MessageUpdate {
+	mu.mutation.ClearContentRequestID()
+	return mu
+}
+
 // SetChat sets the "chat" edge to the Chat entity.
 func (mu *MessageUpdate) SetChat(c *Chat) *MessageUpdate {
 	return mu.SetChatID(c.ID)
@@ -132,6 +252,119 @@ func (mu *MessageUpdate) SetProblem(p *Problem) *MessageUpdate {
 	return mu.SetProblemID(p.ID)
 }
 
+// SetModerationCaseID sets the "moderation_case" edge to the ModerationCase entity by ID.
+func (mu *MessageUpdate) SetModerationCaseID(id types.ModerationCaseID) *MessageUpdate {
+	mu.mutation.SetModerationCaseID(id)
+	return mu
+}
+
+// SetNillableModerationCaseID sets the "moderation_case" edge to the ModerationCase entity by ID if the given value is not nil.
+func (mu *MessageUpdate) SetNillableModerationCaseID(id *types.ModerationCaseID) *MessageUpdate {
+	if id != nil {
+		mu = mu.SetModerationCaseID(*id)
+	}
+	return mu
+}
+
+// SetModerationCase sets the "moderation_case" edge to the ModerationCase entity.
+func (mu *MessageUpdate) SetModerationCase(m *ModerationCase) *MessageUpdate {
+	return mu.SetModerationCaseID(m.ID)
+}
+
+// AddVerdictConflictIDs adds the "verdict_conflicts" edge to the VerdictConflict entity by IDs.
+func (mu *MessageUpdate) AddVerdictConflictIDs(ids ...types.VerdictConflictID) *MessageUpdate {
+	mu.mutation.AddVerdictConflictIDs(ids...)
+	return mu
+}
+
+// AddVerdictConflicts adds the "verdict_conflicts" edges to the VerdictConflict entity.
+func (mu *MessageUpdate) AddVerdictConflicts(v ...*VerdictConflict) *MessageUpdate {
+	ids := make([]types.VerdictConflictID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return mu.AddVerdictConflictIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (mu *MessageUpdate) AddRevisionIDs(ids ...types.MessageRevisionID) *MessageUpdate {
+	mu.mutation.AddRevisionIDs(ids...)
+	return mu
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (mu *MessageUpdate) AddRevisions(m ...*MessageRevision) *MessageUpdate {
+	ids := make([]types.MessageRevisionID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddRevisionIDs(ids...)
+}
+
+// SetDeletionID sets the "deletion" edge to the MessageDeletion entity by ID.
+func (mu *MessageUpdate) SetDeletionID(id types.MessageDeletionID) *MessageUpdate {
+	mu.mutation.SetDeletionID(id)
+	return mu
+}
+
+// SetNillableDeletionID sets the "deletion" edge to the MessageDeletion entity by ID if the given value is not nil.
+func (mu *MessageUpdate) SetNillableDeletionID(id *types.MessageDeletionID) *MessageUpdate {
+	if id != nil {
+		mu = mu.SetDeletionID(*id)
+	}
+	return mu
+}
+
+// SetDeletion sets the "deletion" edge to the MessageDeletion entity.
+func (mu *MessageUpdate) SetDeletion(m *MessageDeletion) *MessageUpdate {
+	return mu.SetDeletionID(m.ID)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (mu *MessageUpdate) AddAttachmentIDs(ids ...types.AttachmentID) *MessageUpdate {
+	mu.mutation.AddAttachmentIDs(ids...)
+	return mu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (mu *MessageUpdate) AddAttachments(a ...*Attachment) *MessageUpdate {
+	ids := make([]types.AttachmentID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return mu.AddAttachmentIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (mu *MessageUpdate) AddReactionIDs(ids ...types.MessageReactionID) *MessageUpdate {
+	mu.mutation.AddReactionIDs(ids...)
+	return mu
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (mu *MessageUpdate) AddReactions(m ...*MessageReaction) *MessageUpdate {
+	ids := make([]types.MessageReactionID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddReactionIDs(ids...)
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by IDs.
+func (mu *MessageUpdate) AddReplyIDs(ids ...types.MessageID) *MessageUpdate {
+	mu.mutation.AddReplyIDs(ids...)
+	return mu
+}
+
+// AddReplies adds the "replies" edges to the Message entity.
+func (mu *MessageUpdate) AddReplies(m ...*Message) *MessageUpdate {
+	ids := make([]types.MessageID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddReplyIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mu *MessageUpdate) Mutation() *MessageMutation {
 	return mu.mutation
@@ -149,6 +382,123 @@ func (mu *MessageUpdate) ClearProblem() *MessageUpdate {
 	return mu
 }
 
+// ClearModerationCase clears the "moderation_case" edge to the ModerationCase entity.
+func (mu *MessageUpdate) ClearModerationCase() *MessageUpdate {
+	mu.mutation.ClearModerationCase()
+	return mu
+}
+
+// ClearVerdictConflicts clears all "verdict_conflicts" edges to the VerdictConflict entity.
+func (mu *MessageUpdate) ClearVerdictConflicts() *MessageUpdate {
+	mu.mutation.ClearVerdictConflicts()
+	return mu
+}
+
+// RemoveVerdictConflictIDs removes the "verdict_conflicts" edge to VerdictConflict entities by IDs.
+func (mu *MessageUpdate) RemoveVerdictConflictIDs(ids ...types.VerdictConflictID) *MessageUpdate {
+	mu.mutation.RemoveVerdictConflictIDs(ids...)
+	return mu
+}
+
+// RemoveVerdictConflicts removes "verdict_conflicts" edges to VerdictConflict entities.
+func (mu *MessageUpdate) RemoveVerdictConflicts(v ...*VerdictConflict) *MessageUpdate {
+	ids := make([]types.VerdictConflictID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return mu.RemoveVerdictConflictIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (mu *MessageUpdate) ClearRevisions() *MessageUpdate {
+	mu.mutation.ClearRevisions()
+	return mu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (mu *MessageUpdate) RemoveRevisionIDs(ids ...types.MessageRevisionID) *MessageUpdate {
+	mu.mutation.RemoveRevisionIDs(ids...)
+	return mu
+}
+
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (mu *MessageUpdate) RemoveRevisions(m ...*MessageRevision) *MessageUpdate {
+	ids := make([]types.MessageRevisionID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveRevisionIDs(ids...)
+}
+
+// ClearDeletion clears the "deletion" edge to the MessageDeletion entity.
+func (mu *MessageUpdate) ClearDeletion() *MessageUpdate {
+	mu.mutation.ClearDeletion()
+	return mu
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (mu *MessageUpdate) ClearAttachments() *MessageUpdate {
+	mu.mutation.ClearAttachments()
+	return mu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (mu *MessageUpdate) RemoveAttachmentIDs(ids ...types.AttachmentID) *MessageUpdate {
+	mu.mutation.RemoveAttachmentIDs(ids...)
+	return mu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (mu *MessageUpdate) RemoveAttachments(a ...*Attachment) *MessageUpdate {
+	ids := make([]types.AttachmentID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return mu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the MessageReaction entity.
+func (mu *MessageUpdate) ClearReactions() *MessageUpdate {
+	mu.mutation.ClearReactions()
+	return mu
+}
+
+// RemoveReactionIDs removes the "reactions" edge to MessageReaction entities by IDs.
+func (mu *MessageUpdate) RemoveReactionIDs(ids ...types.MessageReactionID) *MessageUpdate {
+	mu.mutation.RemoveReactionIDs(ids...)
+	return mu
+}
+
+// RemoveReactions removes "reactions" edges to MessageReaction entities.
+func (mu *MessageUpdate) RemoveReactions(m ...*MessageReaction) *MessageUpdate {
+	ids := make([]types.MessageReactionID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveReactionIDs(ids...)
+}
+
+// ClearReplies clears all "replies" edges to the Message entity.
+func (mu *MessageUpdate) ClearReplies() *MessageUpdate {
+	mu.mutation.ClearReplies()
+	return mu
+}
+
+// RemoveReplyIDs removes the "replies" edge to Message entities by IDs.
+func (mu *MessageUpdate) RemoveReplyIDs(ids ...types.MessageID) *MessageUpdate {
+	mu.mutation.RemoveReplyIDs(ids...)
+	return mu
+}
+
+// RemoveReplies removes "replies" edges to Message entities.
+func (mu *MessageUpdate) RemoveReplies(m ...*Message) *MessageUpdate {
+	ids := make([]types.MessageID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveReplyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
@@ -188,6 +538,26 @@ func (mu *MessageUpdate) check() error {
 			return &ValidationError{Name: "problem_id", err: fmt.Errorf(`store: validator failed for field "Message.problem_id": %w`, err)}
 		}
 	}
+	if v, ok := mu.mutation.Body(); ok {
+		if err := message.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`store: validator failed for field "Message.body": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Verdict(); ok {
+		if err := message.VerdictValidator(v); err != nil {
+			return &ValidationError{Name: "verdict", err: fmt.Errorf(`store: validator failed for field "Message.verdict": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.VerdictSource(); ok {
+		if err := message.VerdictSourceValidator(v); err != nil {
+			return &ValidationError{Name: "verdict_source", err: fmt.Errorf(`store: validator failed for field "Message.verdict_source": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.ContentRequestID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "content_request_id", err: fmt.Errorf(`store: validator failed for field "Message.content_request_id": %w`, err)}
+		}
+	}
 	if _, ok := mu.mutation.ChatID(); mu.mutation.ChatCleared() && !ok {
 		return errors.New(`store: clearing a required unique edge "Message.chat"`)
 	}
@@ -224,15 +594,48 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.IsVisibleForManager(); ok {
 		_spec.SetField(message.FieldIsVisibleForManager, field.TypeBool, value)
 	}
+	if value, ok := mu.mutation.Body(); ok {
+		_spec.SetField(message.FieldBody, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if mu.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mu.mutation.DeletedAtCleared() {
+		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := mu.mutation.CheckedAt(); ok {
 		_spec.SetField(message.FieldCheckedAt, field.TypeTime, value)
 	}
 	if mu.mutation.CheckedAtCleared() {
 		_spec.ClearField(message.FieldCheckedAt, field.TypeTime)
 	}
+	if value, ok := mu.mutation.Verdict(); ok {
+		_spec.SetField(message.FieldVerdict, field.TypeEnum, value)
+	}
+	if mu.mutation.VerdictCleared() {
+		_spec.ClearField(message.FieldVerdict, field.TypeEnum)
+	}
+	if value, ok := mu.mutation.VerdictSource(); ok {
+		_spec.SetField(message.FieldVerdictSource, field.TypeEnum, value)
+	}
+	if mu.mutation.VerdictSourceCleared() {
+		_spec.ClearField(message.FieldVerdictSource, field.TypeEnum)
+	}
 	if value, ok := mu.mutation.IsBlocked(); ok {
 		_spec.SetField(message.FieldIsBlocked, field.TypeBool, value)
 	}
+	if value, ok := mu.mutation.ContentRequestID(); ok {
+		_spec.SetField(message.FieldContentRequestID, field.TypeUUID, value)
+	}
+	if mu.mutation.ContentRequestIDCleared() {
+		_spec.ClearField(message.FieldContentRequestID, field.TypeUUID)
+	}
 	if mu.mutation.ChatCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -291,145 +694,772 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(mu.modifiers...)
-	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{message.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if mu.mutation.ModerationCaseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.ModerationCaseTable,
+			Columns: []string{message.ModerationCaseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	mu.mutation.done = true
-	return n, nil
-}
-
-// MessageUpdateOne is the builder for updating a single Message entity.
-type MessageUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *MessageMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetChatID sets the "chat_id" field.
-func (muo *MessageUpdateOne) SetChatID(ti types.ChatID) *MessageUpdateOne {
-	muo.mutation.SetChatID(ti)
-	return muo
-}
-
-// SetNillableChatID sets the "chat_id" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableChatID(ti *types.ChatID) *MessageUpdateOne {
-	if ti != nil {
-		muo.SetChatID(*ti)
+	if nodes := mu.mutation.ModerationCaseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.ModerationCaseTable,
+			Columns: []string{message.ModerationCaseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if mu.mutation.VerdictConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.VerdictConflictsTable,
+			Columns: []string{message.VerdictConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedVerdictConflictsIDs(); len(nodes) > 0 && !mu.mutation.VerdictConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.VerdictConflictsTable,
+			Columns: []string{message.VerdictConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.VerdictConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.VerdictConflictsTable,
+			Columns: []string{message.VerdictConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !mu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.DeletionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.DeletionTable,
+			Columns: []string{message.DeletionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.DeletionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.DeletionTable,
+			Columns: []string{message.DeletionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !mu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !mu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !mu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MessageUpdateOne is the builder for updating a single Message entity.
+type MessageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MessageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetChatID sets the "chat_id" field.
+func (muo *MessageUpdateOne) SetChatID(ti types.ChatID) *MessageUpdateOne {
+	muo.mutation.SetChatID(ti)
+	return muo
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableChatID(ti *types.ChatID) *MessageUpdateOne {
+	if ti != nil {
+		muo.SetChatID(*ti)
+	}
+	return muo
+}
+
+// SetProblemID sets the "problem_id" field.
+func (muo *MessageUpdateOne) SetProblemID(ti types.ProblemID) *MessageUpdateOne {
+	muo.mutation.SetProblemID(ti)
+	return muo
+}
+
+// SetNillableProblemID sets the "problem_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableProblemID(ti *types.ProblemID) *MessageUpdateOne {
+	if ti != nil {
+		muo.SetProblemID(*ti)
+	}
+	return muo
+}
+
+// SetIsVisibleForClient sets the "is_visible_for_client" field.
+func (muo *MessageUpdateOne) SetIsVisibleForClient(b bool) *MessageUpdateOne {
+	muo.mutation.SetIsVisibleForClient(b)
+	return muo
+}
+
+// SetNillableIsVisibleForClient sets the "is_visible_for_client" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableIsVisibleForClient(b *bool) *MessageUpdateOne {
+	if b != nil {
+		muo.SetIsVisibleForClient(*b)
+	}
+	return muo
+}
+
+// SetIsVisibleForManager sets the "is_visible_for_manager" field.
+func (muo *MessageUpdateOne) SetIsVisibleForManager(b bool) *MessageUpdateOne {
+	muo.mutation.SetIsVisibleForManager(b)
+	return muo
+}
+
+// SetNillableIsVisibleForManager sets the "is_visible_for_manager" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableIsVisibleForManager(b *bool) *MessageUpdateOne {
+	if b != nil {
+		muo.SetIsVisibleForManager(*b)
+	}
+	return muo
+}
+
+// SetBody sets the "body" field.
+func (muo *MessageUpdateOne) SetBody(s string) *MessageUpdateOne {
+	muo.mutation.SetBody(s)
+	return muo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableBody(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetBody(*s)
+	}
+	return muo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (muo *MessageUpdateOne) SetEditedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetEditedAt(t)
+	return muo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEditedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetEditedAt(*t)
+	}
+	return muo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (muo *MessageUpdateOne) ClearEditedAt() *MessageUpdateOne {
+	muo.mutation.ClearEditedAt()
+	return muo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (muo *MessageUpdateOne) SetDeletedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetDeletedAt(t)
+	return muo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDeletedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetDeletedAt(*t)
+	}
+	return muo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (muo *MessageUpdateOne) ClearDeletedAt() *MessageUpdateOne {
+	muo.mutation.ClearDeletedAt()
+	return muo
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (muo *MessageUpdateOne) SetCheckedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetCheckedAt(t)
+	return muo
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableCheckedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetCheckedAt(*t)
+	}
+	return muo
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (muo *MessageUpdateOne) ClearCheckedAt() *MessageUpdateOne {
+	muo.mutation.ClearCheckedAt()
+	return muo
+}
+
+// SetVerdict sets the "verdict" field.
+func (muo *MessageUpdateOne) SetVerdict(m message.Verdict) *MessageUpdateOne {
+	muo.mutation.SetVerdict(m)
+	return muo
+}
+
+// SetNillableVerdict sets the "verdict" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableVerdict(m *message.Verdict) *MessageUpdateOne {
+	if m != nil {
+		muo.SetVerdict(*m)
+	}
+	return muo
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (muo *MessageUpdateOne) ClearVerdict() *MessageUpdateOne {
+	muo.mutation.ClearVerdict()
+	return muo
+}
+
+// SetVerdictSource sets the "verdict_source" field.
+func (muo *MessageUpdateOne) SetVerdictSource(ms message.VerdictSource) *MessageUpdateOne {
+	muo.mutation.SetVerdictSource(ms)
+	return muo
+}
+
+// SetNillableVerdictSource sets the "verdict_source" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableVerdictSource(ms *message.VerdictSource) *MessageUpdateOne {
+	if ms != nil {
+		muo.SetVerdictSource(*ms)
+	}
+	return muo
+}
+
+// ClearVerdictSource clears the value of the "verdict_source" field.
+func (muo *MessageUpdateOne) ClearVerdictSource() *MessageUpdateOne {
+	muo.mutation.ClearVerdictSource()
+	return muo
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (muo *MessageUpdateOne) SetIsBlocked(b bool) *MessageUpdateOne {
+	muo.mutation.SetIsBlocked(b)
+	return muo
+}
+
+// SetNillableIsBlocked sets the "is_blocked" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableIsBlocked(b *bool) *MessageUpdateOne {
+	if b != nil {
+		muo.SetIsBlocked(*b)
+	}
+	return muo
+}
+
+// SetContentRequestID sets the "content_request_id" field.
+func (muo *MessageUpdateOne) SetContentRequestID(ti types.RequestID) *MessageUpdateOne {
+	muo.mutation.SetContentRequestID(ti)
+	return muo
+}
+
+// SetNillableContentRequestID sets the "content_request_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableContentRequestID(ti *types.RequestID) *MessageUpdateOne {
+	if ti != nil {
+		muo.SetContentRequestID(*ti)
+	}
+	return muo
+}
+
+// ClearContentRequestID clears the value of the "content_request_id" field.
+func (muo *MessageUpdateOne) ClearContentRequestID() *MessageUpdateOne {
+	muo.mutation.ClearContentRequestID()
+	return muo
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (muo *MessageUpdateOne) SetChat(c *Chat) *MessageUpdateOne {
+	return muo.SetChatID(c.ID)
+}
+
+// SetProblem sets the "problem" edge to the Problem entity.
+func (muo *MessageUpdateOne) SetProblem(p *Problem) *MessageUpdateOne {
+	return muo.SetProblemID(p.ID)
+}
+
+// SetModerationCaseID sets the "moderation_case" edge to the ModerationCase entity by ID.
+func (muo *MessageUpdateOne) SetModerationCaseID(id types.ModerationCaseID) *MessageUpdateOne {
+	muo.mutation.SetModerationCaseID(id)
+	return muo
+}
+
+// SetNillableModerationCaseID sets the "moderation_case" edge to the ModerationCase entity by ID if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableModerationCaseID(id *types.ModerationCaseID) *MessageUpdateOne {
+	if id != nil {
+		muo = muo.SetModerationCaseID(*id)
+	}
+	return muo
+}
+
+// SetModerationCase sets the "moderation_case" edge to the ModerationCase entity.
+func (muo *MessageUpdateOne) SetModerationCase(m *ModerationCase) *MessageUpdateOne {
+	return muo.SetModerationCaseID(m.ID)
+}
+
+// AddVerdictConflictIDs adds the "verdict_conflicts" edge to the VerdictConflict entity by IDs.
+func (muo *MessageUpdateOne) AddVerdictConflictIDs(ids ...types.VerdictConflictID) *MessageUpdateOne {
+	muo.mutation.AddVerdictConflictIDs(ids...)
+	return muo
+}
+
+// AddVerdictConflicts adds the "verdict_conflicts" edges to the VerdictConflict entity.
+func (muo *MessageUpdateOne) AddVerdictConflicts(v ...*VerdictConflict) *MessageUpdateOne {
+	ids := make([]types.VerdictConflictID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return muo.AddVerdictConflictIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (muo *MessageUpdateOne) AddRevisionIDs(ids ...types.MessageRevisionID) *MessageUpdateOne {
+	muo.mutation.AddRevisionIDs(ids...)
+	return muo
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (muo *MessageUpdateOne) AddRevisions(m ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]types.MessageRevisionID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddRevisionIDs(ids...)
+}
+
+// SetDeletionID sets the "deletion" edge to the MessageDeletion entity by ID.
+func (muo *MessageUpdateOne) SetDeletionID(id types.MessageDeletionID) *MessageUpdateOne {
+	muo.mutation.SetDeletionID(id)
+	return muo
+}
+
+// SetNillableDeletionID sets the "deletion" edge to the MessageDeletion entity by ID if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDeletionID(id *types.MessageDeletionID) *MessageUpdateOne {
+	if id != nil {
+		muo = muo.SetDeletionID(*id)
+	}
+	return muo
+}
+
+// SetDeletion sets the "deletion" edge to the MessageDeletion entity.
+func (muo *MessageUpdateOne) SetDeletion(m *MessageDeletion) *MessageUpdateOne {
+	return muo.SetDeletionID(m.ID)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (muo *MessageUpdateOne) AddAttachmentIDs(ids ...types.AttachmentID) *MessageUpdateOne {
+	muo.mutation.AddAttachmentIDs(ids...)
+	return muo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (muo *MessageUpdateOne) AddAttachments(a ...*Attachment) *MessageUpdateOne {
+	ids := make([]types.AttachmentID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return muo.AddAttachmentIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (muo *MessageUpdateOne) AddReactionIDs(ids ...types.MessageReactionID) *MessageUpdateOne {
+	muo.mutation.AddReactionIDs(ids...)
+	return muo
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (muo *MessageUpdateOne) AddReactions(m ...*MessageReaction) *MessageUpdateOne {
+	ids := make([]types.MessageReactionID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddReactionIDs(ids...)
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by IDs.
+func (muo *MessageUpdateOne) AddReplyIDs(ids ...types.MessageID) *MessageUpdateOne {
+	muo.mutation.AddReplyIDs(ids...)
+	return muo
+}
+
+// AddReplies adds the "replies" edges to the Message entity.
+func (muo *MessageUpdateOne) AddReplies(m ...*Message) *MessageUpdateOne {
+	ids := make([]types.MessageID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddReplyIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (muo *MessageUpdateOne) Mutation() *MessageMutation {
+	return muo.mutation
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (muo *MessageUpdateOne) ClearChat() *MessageUpdateOne {
+	muo.mutation.ClearChat()
 	return muo
 }
 
-// SetProblemID sets the "problem_id" field.
-func (muo *MessageUpdateOne) SetProblemID(ti types.ProblemID) *MessageUpdateOne {
-	muo.mutation.SetProblemID(ti)
-	return muo
-}
-
-// SetNillableProblemID sets the "problem_id" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableProblemID(ti *types.ProblemID) *MessageUpdateOne {
-	if ti != nil {
-		muo.SetProblemID(*ti)
-	}
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (muo *MessageUpdateOne) ClearProblem() *MessageUpdateOne {
+	muo.mutation.ClearProblem()
 	return muo
 }
 
-// SetIsVisibleForClient sets the "is_visible_for_client" field.
-func (muo *MessageUpdateOne) SetIsVisibleForClient(b bool) *MessageUpdateOne {
-	muo.mutation.SetIsVisibleForClient(b)
+// ClearModerationCase clears the "moderation_case" edge to the ModerationCase entity.
+func (muo *MessageUpdateOne) ClearModerationCase() *MessageUpdateOne {
+	muo.mutation.ClearModerationCase()
 	return muo
 }
 
-// SetNillableIsVisibleForClient sets the "is_visible_for_client" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableIsVisibleForClient(b *bool) *MessageUpdateOne {
-	if b != nil {
-		muo.SetIsVisibleForClient(*b)
-	}
+// ClearVerdictConflicts clears all "verdict_conflicts" edges to the VerdictConflict entity.
+func (muo *MessageUpdateOne) ClearVerdictConflicts() *MessageUpdateOne {
+	muo.mutation.ClearVerdictConflicts()
 	return muo
 }
 
-// SetIsVisibleForManager sets the "is_visible_for_manager" field.
-func (muo *MessageUpdateOne) SetIsVisibleForManager(b bool) *MessageUpdateOne {
-	muo.mutation.SetIsVisibleForManager(b)
+// RemoveVerdictConflictIDs removes the "verdict_conflicts" edge to VerdictConflict entities by IDs.
+func (muo *MessageUpdateOne) RemoveVerdictConflictIDs(ids ...types.VerdictConflictID) *MessageUpdateOne {
+	muo.mutation.RemoveVerdictConflictIDs(ids...)
 	return muo
 }
 
-// SetNillableIsVisibleForManager sets the "is_visible_for_manager" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableIsVisibleForManager(b *bool) *MessageUpdateOne {
-	if b != nil {
-		muo.SetIsVisibleForManager(*b)
+// RemoveVerdictConflicts removes "verdict_conflicts" edges to VerdictConflict entities.
+func (muo *MessageUpdateOne) RemoveVerdictConflicts(v ...*VerdictConflict) *MessageUpdateOne {
+	ids := make([]types.VerdictConflictID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return muo.RemoveVerdictConflictIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (muo *MessageUpdateOne) ClearRevisions() *MessageUpdateOne {
+	muo.mutation.ClearRevisions()
 	return muo
 }
 
-// SetCheckedAt sets the "checked_at" field.
-func (muo *MessageUpdateOne) SetCheckedAt(t time.Time) *MessageUpdateOne {
-	muo.mutation.SetCheckedAt(t)
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (muo *MessageUpdateOne) RemoveRevisionIDs(ids ...types.MessageRevisionID) *MessageUpdateOne {
+	muo.mutation.RemoveRevisionIDs(ids...)
 	return muo
 }
 
-// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableCheckedAt(t *time.Time) *MessageUpdateOne {
-	if t != nil {
-		muo.SetCheckedAt(*t)
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (muo *MessageUpdateOne) RemoveRevisions(m ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]types.MessageRevisionID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
+	return muo.RemoveRevisionIDs(ids...)
+}
+
+// ClearDeletion clears the "deletion" edge to the MessageDeletion entity.
+func (muo *MessageUpdateOne) ClearDeletion() *MessageUpdateOne {
+	muo.mutation.ClearDeletion()
 	return muo
 }
 
-// ClearCheckedAt clears the value of the "checked_at" field.
-func (muo *MessageUpdateOne) ClearCheckedAt() *MessageUpdateOne {
-	muo.mutation.ClearCheckedAt()
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (muo *MessageUpdateOne) ClearAttachments() *MessageUpdateOne {
+	muo.mutation.ClearAttachments()
 	return muo
 }
 
-// SetIsBlocked sets the "is_blocked" field.
-func (muo *MessageUpdateOne) SetIsBlocked(b bool) *MessageUpdateOne {
-	muo.mutation.SetIsBlocked(b)
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (muo *MessageUpdateOne) RemoveAttachmentIDs(ids ...types.AttachmentID) *MessageUpdateOne {
+	muo.mutation.RemoveAttachmentIDs(ids...)
 	return muo
 }
 
-// SetNillableIsBlocked sets the "is_blocked" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableIsBlocked(b *bool) *MessageUpdateOne {
-	if b != nil {
-		muo.SetIsBlocked(*b)
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (muo *MessageUpdateOne) RemoveAttachments(a ...*Attachment) *MessageUpdateOne {
+	ids := make([]types.AttachmentID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return muo
+	return muo.RemoveAttachmentIDs(ids...)
 }
 
-// SetChat sets the "chat" edge to the Chat entity.
-func (muo *MessageUpdateOne) SetChat(c *Chat) *MessageUpdateOne {
-	return muo.SetChatID(c.ID)
+// ClearReactions clears all "reactions" edges to the MessageReaction entity.
+func (muo *MessageUpdateOne) ClearReactions() *MessageUpdateOne {
+	muo.mutation.ClearReactions()
+	return muo
 }
 
-// SetProblem sets the "problem" edge to the Problem entity.
-func (muo *MessageUpdateOne) SetProblem(p *Problem) *MessageUpdateOne {
-	return muo.SetProblemID(p.ID)
+// RemoveReactionIDs removes the "reactions" edge to MessageReaction entities by IDs.
+func (muo *MessageUpdateOne) RemoveReactionIDs(ids ...types.MessageReactionID) *MessageUpdateOne {
+	muo.mutation.RemoveReactionIDs(ids...)
+	return muo
 }
 
-// Mutation returns the MessageMutation object of the builder.
-func (muo *MessageUpdateOne) Mutation() *MessageMutation {
-	return muo.mutation
+// RemoveReactions removes "reactions" edges to MessageReaction entities.
+func (muo *MessageUpdateOne) RemoveReactions(m ...*MessageReaction) *MessageUpdateOne {
+	ids := make([]types.MessageReactionID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveReactionIDs(ids...)
 }
 
-// ClearChat clears the "chat" edge to the Chat entity.
-func (muo *MessageUpdateOne) ClearChat() *MessageUpdateOne {
-	muo.mutation.ClearChat()
+// ClearReplies clears all "replies" edges to the Message entity.
+func (muo *MessageUpdateOne) ClearReplies() *MessageUpdateOne {
+	muo.mutation.ClearReplies()
 	return muo
 }
 
-// ClearProblem clears the "problem" edge to the Problem entity.
-func (muo *MessageUpdateOne) ClearProblem() *MessageUpdateOne {
-	muo.mutation.ClearProblem()
+// RemoveReplyIDs removes the "replies" edge to Message entities by IDs.
+func (muo *MessageUpdateOne) RemoveReplyIDs(ids ...types.MessageID) *MessageUpdateOne {
+	muo.mutation.RemoveReplyIDs(ids...)
 	return muo
 }
 
+// RemoveReplies removes "replies" edges to Message entities.
+func (muo *MessageUpdateOne) RemoveReplies(m ...*Message) *MessageUpdateOne {
+	ids := make([]types.MessageID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveReplyIDs(ids...)
+}
+
 // Where appends a list predicates to the MessageUpdate builder.
 func (muo *MessageUpdateOne) Where(ps ...predicate.Message) *MessageUpdateOne {
 	muo.mutation.Where(ps...)
@@ -482,6 +1512,26 @@ func (muo *MessageUpdateOne) check() error {
 			return &ValidationError{Name: "problem_id", err: fmt.Errorf(`store: validator failed for field "Message.problem_id": %w`, err)}
 		}
 	}
+	if v, ok := muo.mutation.Body(); ok {
+		if err := message.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`store: validator failed for field "Message.body": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Verdict(); ok {
+		if err := message.VerdictValidator(v); err != nil {
+			return &ValidationError{Name: "verdict", err: fmt.Errorf(`store: validator failed for field "Message.verdict": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.VerdictSource(); ok {
+		if err := message.VerdictSourceValidator(v); err != nil {
+			return &ValidationError{Name: "verdict_source", err: fmt.Errorf(`store: validator failed for field "Message.verdict_source": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.ContentRequestID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "content_request_id", err: fmt.Errorf(`store: validator failed for field "Message.content_request_id": %w`, err)}
+		}
+	}
 	if _, ok := muo.mutation.ChatID(); muo.mutation.ChatCleared() && !ok {
 		return errors.New(`store: clearing a required unique edge "Message.chat"`)
 	}
@@ -535,15 +1585,48 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if value, ok := muo.mutation.IsVisibleForManager(); ok {
 		_spec.SetField(message.FieldIsVisibleForManager, field.TypeBool, value)
 	}
+	if value, ok := muo.mutation.Body(); ok {
+		_spec.SetField(message.FieldBody, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if muo.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+	}
+	if muo.mutation.DeletedAtCleared() {
+		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := muo.mutation.CheckedAt(); ok {
 		_spec.SetField(message.FieldCheckedAt, field.TypeTime, value)
 	}
 	if muo.mutation.CheckedAtCleared() {
 		_spec.ClearField(message.FieldCheckedAt, field.TypeTime)
 	}
+	if value, ok := muo.mutation.Verdict(); ok {
+		_spec.SetField(message.FieldVerdict, field.TypeEnum, value)
+	}
+	if muo.mutation.VerdictCleared() {
+		_spec.ClearField(message.FieldVerdict, field.TypeEnum)
+	}
+	if value, ok := muo.mutation.VerdictSource(); ok {
+		_spec.SetField(message.FieldVerdictSource, field.TypeEnum, value)
+	}
+	if muo.mutation.VerdictSourceCleared() {
+		_spec.ClearField(message.FieldVerdictSource, field.TypeEnum)
+	}
 	if value, ok := muo.mutation.IsBlocked(); ok {
 		_spec.SetField(message.FieldIsBlocked, field.TypeBool, value)
 	}
+	if value, ok := muo.mutation.ContentRequestID(); ok {
+		_spec.SetField(message.FieldContentRequestID, field.TypeUUID, value)
+	}
+	if muo.mutation.ContentRequestIDCleared() {
+		_spec.ClearField(message.FieldContentRequestID, field.TypeUUID)
+	}
 	if muo.mutation.ChatCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -602,6 +1685,289 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if muo.mutation.ModerationCaseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.ModerationCaseTable,
+			Columns: []string{message.ModerationCaseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ModerationCaseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.ModerationCaseTable,
+			Columns: []string{message.ModerationCaseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.VerdictConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.VerdictConflictsTable,
+			Columns: []string{message.VerdictConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedVerdictConflictsIDs(); len(nodes) > 0 && !muo.mutation.VerdictConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.VerdictConflictsTable,
+			Columns: []string{message.VerdictConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.VerdictConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.VerdictConflictsTable,
+			Columns: []string{message.VerdictConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !muo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.DeletionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.DeletionTable,
+			Columns: []string{message.DeletionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.DeletionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.DeletionTable,
+			Columns: []string{message.DeletionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !muo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !muo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !muo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(muo.modifiers...)
 	_node = &Message{config: muo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// MessageDeletion is the model entity for the MessageDeletion schema.
+type MessageDeletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID types.MessageDeletionID `json:"id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID types.MessageID `json:"message_id,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID types.RequestID `json:"request_id,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy types.UserID `json:"deleted_by,omitempty"`
+	// DeletedByRole holds the value of the "deleted_by_role" field.
+	DeletedByRole messagedeletion.DeletedByRole `json:"deleted_by_role,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageDeletionQuery when eager-loading is set.
+	Edges        MessageDeletionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MessageDeletionEdges holds the relations/edges for other nodes in the graph.
+type MessageDeletionEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageDeletionEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageDeletion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagedeletion.FieldDeletedByRole:
+			values[i] = new(sql.NullString)
+		case messagedeletion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case messagedeletion.FieldID:
+			values[i] = new(types.MessageDeletionID)
+		case messagedeletion.FieldMessageID:
+			values[i] = new(types.MessageID)
+		case messagedeletion.FieldRequestID:
+			values[i] = new(types.RequestID)
+		case messagedeletion.FieldDeletedBy:
+			values[i] = new(types.UserID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageDeletion fields.
+func (md *MessageDeletion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagedeletion.FieldID:
+			if value, ok := values[i].(*types.MessageDeletionID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				md.ID = *value
+			}
+		case messagedeletion.FieldMessageID:
+			if value, ok := values[i].(*types.MessageID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				md.MessageID = *value
+			}
+		case messagedeletion.FieldRequestID:
+			if value, ok := values[i].(*types.RequestID); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value != nil {
+				md.RequestID = *value
+			}
+		case messagedeletion.FieldDeletedBy:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value != nil {
+				md.DeletedBy = *value
+			}
+		case messagedeletion.FieldDeletedByRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by_role", values[i])
+			} else if value.Valid {
+				md.DeletedByRole = messagedeletion.DeletedByRole(value.String)
+			}
+		case messagedeletion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				md.CreatedAt = value.Time
+			}
+		default:
+			md.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageDeletion.
+// This includes values selected through modifiers, order, etc.
+func (md *MessageDeletion) Value(name string) (ent.Value, error) {
+	return md.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the MessageDeletion entity.
+func (md *MessageDeletion) QueryMessage() *MessageQuery {
+	return NewMessageDeletionClient(md.config).QueryMessage(md)
+}
+
+// Update returns a builder for updating this MessageDeletion.
+// Note that you need to call MessageDeletion.Unwrap() before calling this method if this MessageDeletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (md *MessageDeletion) Update() *MessageDeletionUpdateOne {
+	return NewMessageDeletionClient(md.config).UpdateOne(md)
+}
+
+// Unwrap unwraps the MessageDeletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (md *MessageDeletion) Unwrap() *MessageDeletion {
+	_tx, ok := md.config.driver.(*txDriver)
+	if !ok {
+		panic("store: MessageDeletion is not a transactional entity")
+	}
+	md.config.driver = _tx.drv
+	return md
+}
+
+// String implements the fmt.Stringer.
+func (md *MessageDeletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageDeletion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", md.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", md.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(fmt.Sprintf("%v", md.RequestID))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(fmt.Sprintf("%v", md.DeletedBy))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by_role=")
+	builder.WriteString(fmt.Sprintf("%v", md.DeletedByRole))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(md.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageDeletions is a parsable slice of MessageDeletion.
+type MessageDeletions []*MessageDeletion
@@ -0,0 +1,138 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagedeletion
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const (
+	// Label holds the string label denoting the messagedeletion type in the database.
+	Label = "message_deletion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldRequestID holds the string denoting the request_id field in the database.
+	FieldRequestID = "request_id"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldDeletedByRole holds the string denoting the deleted_by_role field in the database.
+	FieldDeletedByRole = "deleted_by_role"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeMessage holds the string denoting the message edge name in mutations.
+	EdgeMessage = "message"
+	// Table holds the table name of the messagedeletion in the database.
+	Table = "message_deletions"
+	// MessageTable is the table that holds the message relation/edge.
+	MessageTable = "message_deletions"
+	// MessageInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	MessageInverseTable = "messages"
+	// MessageColumn is the table column denoting the message relation/edge.
+	MessageColumn = "message_id"
+)
+
+// Columns holds all SQL columns for messagedeletion fields.
+var Columns = []string{
+	FieldID,
+	FieldMessageID,
+	FieldRequestID,
+	FieldDeletedBy,
+	FieldDeletedByRole,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() types.MessageDeletionID
+)
+
+// DeletedByRole defines the type for the "deleted_by_role" enum field.
+type DeletedByRole string
+
+// DeletedByRole values.
+const (
+	DeletedByRoleClient    DeletedByRole = "client"
+	DeletedByRoleManager   DeletedByRole = "manager"
+	DeletedByRoleModerator DeletedByRole = "moderator"
+)
+
+func (dbr DeletedByRole) String() string {
+	return string(dbr)
+}
+
+// DeletedByRoleValidator is a validator for the "deleted_by_role" field enum values. It is called by the builders before save.
+func DeletedByRoleValidator(dbr DeletedByRole) error {
+	switch dbr {
+	case DeletedByRoleClient, DeletedByRoleManager, DeletedByRoleModerator:
+		return nil
+	default:
+		return fmt.Errorf("messagedeletion: invalid enum value for deleted_by_role field: %q", dbr)
+	}
+}
+
+// OrderOption defines the ordering options for the MessageDeletion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMessageID orders the results by the message_id field.
+func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
+}
+
+// ByRequestID orders the results by the request_id field.
+func ByRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestID, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
+// ByDeletedByRole orders the results by the deleted_by_role field.
+func ByDeletedByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedByRole, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByMessageField orders the results by message field.
+func ByMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMessageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, MessageTable, MessageColumn),
+	)
+}
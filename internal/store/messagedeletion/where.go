@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagedeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id types.MessageDeletionID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id types.MessageDeletionID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id types.MessageDeletionID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...types.MessageDeletionID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...types.MessageDeletionID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id types.MessageDeletionID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id types.MessageDeletionID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id types.MessageDeletionID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id types.MessageDeletionID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLTE(FieldID, id))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v types.MessageID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldMessageID, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v types.RequestID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldRequestID, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v types.UserID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v types.MessageID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v types.MessageID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...types.MessageID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...types.MessageID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v types.RequestID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v types.RequestID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...types.RequestID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...types.RequestID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v types.RequestID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v types.RequestID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v types.RequestID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v types.RequestID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLTE(FieldRequestID, v))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v types.UserID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v types.UserID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...types.UserID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...types.UserID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v types.UserID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v types.UserID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v types.UserID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v types.UserID) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByRoleEQ applies the EQ predicate on the "deleted_by_role" field.
+func DeletedByRoleEQ(v DeletedByRole) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldDeletedByRole, v))
+}
+
+// DeletedByRoleNEQ applies the NEQ predicate on the "deleted_by_role" field.
+func DeletedByRoleNEQ(v DeletedByRole) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldDeletedByRole, v))
+}
+
+// DeletedByRoleIn applies the In predicate on the "deleted_by_role" field.
+func DeletedByRoleIn(vs ...DeletedByRole) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldDeletedByRole, vs...))
+}
+
+// DeletedByRoleNotIn applies the NotIn predicate on the "deleted_by_role" field.
+func DeletedByRoleNotIn(vs ...DeletedByRole) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldDeletedByRole, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.MessageDeletion {
+	return predicate.MessageDeletion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.MessageDeletion {
+	return predicate.MessageDeletion(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageDeletion) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageDeletion) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageDeletion) predicate.MessageDeletion {
+	return predicate.MessageDeletion(sql.NotPredicates(p))
+}
@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// MessageDeletionCreate is the builder for creating a MessageDeletion entity.
+type MessageDeletionCreate struct {
+	config
+	mutation *MessageDeletionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMessageID sets the "message_id" field.
+func (mdc *MessageDeletionCreate) SetMessageID(ti types.MessageID) *MessageDeletionCreate {
+	mdc.mutation.SetMessageID(ti)
+	return mdc
+}
+
+// SetRequestID sets the "request_id" field.
+func (mdc *MessageDeletionCreate) SetRequestID(ti types.RequestID) *MessageDeletionCreate {
+	mdc.mutation.SetRequestID(ti)
+	return mdc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (mdc *MessageDeletionCreate) SetDeletedBy(ti types.UserID) *MessageDeletionCreate {
+	mdc.mutation.SetDeletedBy(ti)
+	return mdc
+}
+
+// SetDeletedByRole sets the "deleted_by_role" field.
+func (mdc *MessageDeletionCreate) SetDeletedByRole(mbr messagedeletion.DeletedByRole) *MessageDeletionCreate {
+	mdc.mutation.SetDeletedByRole(mbr)
+	return mdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mdc *MessageDeletionCreate) SetCreatedAt(t time.Time) *MessageDeletionCreate {
+	mdc.mutation.SetCreatedAt(t)
+	return mdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mdc *MessageDeletionCreate) SetNillableCreatedAt(t *time.Time) *MessageDeletionCreate {
+	if t != nil {
+		mdc.SetCreatedAt(*t)
+	}
+	return mdc
+}
+
+// SetID sets the "id" field.
+func (mdc *MessageDeletionCreate) SetID(tdi types.MessageDeletionID) *MessageDeletionCreate {
+	mdc.mutation.SetID(tdi)
+	return mdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mdc *MessageDeletionCreate) SetNillableID(tdi *types.MessageDeletionID) *MessageDeletionCreate {
+	if tdi != nil {
+		mdc.SetID(*tdi)
+	}
+	return mdc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mdc *MessageDeletionCreate) SetMessage(m *Message) *MessageDeletionCreate {
+	return mdc.SetMessageID(m.ID)
+}
+
+// Mutation returns the MessageDeletionMutation object of the builder.
+func (mdc *MessageDeletionCreate) Mutation() *MessageDeletionMutation {
+	return mdc.mutation
+}
+
+// Save creates the MessageDeletion in the database.
+func (mdc *MessageDeletionCreate) Save(ctx context.Context) (*MessageDeletion, error) {
+	mdc.defaults()
+	return withHooks(ctx, mdc.sqlSave, mdc.mutation, mdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mdc *MessageDeletionCreate) SaveX(ctx context.Context) *MessageDeletion {
+	v, err := mdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mdc *MessageDeletionCreate) Exec(ctx context.Context) error {
+	_, err := mdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdc *MessageDeletionCreate) ExecX(ctx context.Context) {
+	if err := mdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mdc *MessageDeletionCreate) defaults() {
+	if _, ok := mdc.mutation.CreatedAt(); !ok {
+		v := messagedeletion.DefaultCreatedAt()
+		mdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mdc.mutation.ID(); !ok {
+		v := messagedeletion.DefaultID()
+		mdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mdc *MessageDeletionCreate) check() error {
+	if _, ok := mdc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`store: missing required field "MessageDeletion.message_id"`)}
+	}
+	if v, ok := mdc.mutation.MessageID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`store: validator failed for field "MessageDeletion.message_id": %w`, err)}
+		}
+	}
+	if _, ok := mdc.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`store: missing required field "MessageDeletion.request_id"`)}
+	}
+	if v, ok := mdc.mutation.RequestID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`store: validator failed for field "MessageDeletion.request_id": %w`, err)}
+		}
+	}
+	if _, ok := mdc.mutation.DeletedBy(); !ok {
+		return &ValidationError{Name: "deleted_by", err: errors.New(`store: missing required field "MessageDeletion.deleted_by"`)}
+	}
+	if v, ok := mdc.mutation.DeletedBy(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "deleted_by", err: fmt.Errorf(`store: validator failed for field "MessageDeletion.deleted_by": %w`, err)}
+		}
+	}
+	if _, ok := mdc.mutation.DeletedByRole(); !ok {
+		return &ValidationError{Name: "deleted_by_role", err: errors.New(`store: missing required field "MessageDeletion.deleted_by_role"`)}
+	}
+	if v, ok := mdc.mutation.DeletedByRole(); ok {
+		if err := messagedeletion.DeletedByRoleValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_by_role", err: fmt.Errorf(`store: validator failed for field "MessageDeletion.deleted_by_role": %w`, err)}
+		}
+	}
+	if _, ok := mdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "MessageDeletion.created_at"`)}
+	}
+	if v, ok := mdc.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "MessageDeletion.id": %w`, err)}
+		}
+	}
+	if _, ok := mdc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`store: missing required edge "MessageDeletion.message"`)}
+	}
+	return nil
+}
+
+func (mdc *MessageDeletionCreate) sqlSave(ctx context.Context) (*MessageDeletion, error) {
+	if err := mdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.MessageDeletionID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mdc.mutation.id = &_node.ID
+	mdc.mutation.done = true
+	return _node, nil
+}
+
+func (mdc *MessageDeletionCreate) createSpec() (*MessageDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageDeletion{config: mdc.config}
+		_spec = sqlgraph.NewCreateSpec(messagedeletion.Table, sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mdc.conflict
+	if id, ok := mdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mdc.mutation.RequestID(); ok {
+		_spec.SetField(messagedeletion.FieldRequestID, field.TypeUUID, value)
+		_node.RequestID = value
+	}
+	if value, ok := mdc.mutation.DeletedBy(); ok {
+		_spec.SetField(messagedeletion.FieldDeletedBy, field.TypeUUID, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := mdc.mutation.DeletedByRole(); ok {
+		_spec.SetField(messagedeletion.FieldDeletedByRole, field.TypeEnum, value)
+		_node.DeletedByRole = value
+	}
+	if value, ok := mdc.mutation.CreatedAt(); ok {
+		_spec.SetField(messagedeletion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mdc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   messagedeletion.MessageTable,
+			Columns: []string{messagedeletion.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageDeletion.Create().
+//		SetMessageID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageDeletionUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (mdc *MessageDeletionCreate) OnConflict(opts ...sql.ConflictOption) *MessageDeletionUpsertOne {
+	mdc.conflict = opts
+	return &MessageDeletionUpsertOne{
+		create: mdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mdc *MessageDeletionCreate) OnConflictColumns(columns ...string) *MessageDeletionUpsertOne {
+	mdc.conflict = append(mdc.conflict, sql.ConflictColumns(columns...))
+	return &MessageDeletionUpsertOne{
+		create: mdc,
+	}
+}
+
+type (
+	// MessageDeletionUpsertOne is the builder for "upsert"-ing
+	//  one MessageDeletion node.
+	MessageDeletionUpsertOne struct {
+		create *MessageDeletionCreate
+	}
+
+	// MessageDeletionUpsert is the "OnConflict" setter.
+	MessageDeletionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MessageDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagedeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageDeletionUpsertOne) UpdateNewValues() *MessageDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(messagedeletion.FieldID)
+		}
+		if _, exists := u.create.mutation.MessageID(); exists {
+			s.SetIgnore(messagedeletion.FieldMessageID)
+		}
+		if _, exists := u.create.mutation.RequestID(); exists {
+			s.SetIgnore(messagedeletion.FieldRequestID)
+		}
+		if _, exists := u.create.mutation.DeletedBy(); exists {
+			s.SetIgnore(messagedeletion.FieldDeletedBy)
+		}
+		if _, exists := u.create.mutation.DeletedByRole(); exists {
+			s.SetIgnore(messagedeletion.FieldDeletedByRole)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(messagedeletion.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageDeletion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageDeletionUpsertOne) Ignore() *MessageDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageDeletionUpsertOne) DoNothing() *MessageDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageDeletionCreate.OnConflict
+// documentation for more info.
+func (u *MessageDeletionUpsertOne) Update(set func(*MessageDeletionUpsert)) *MessageDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *MessageDeletionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for MessageDeletionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageDeletionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageDeletionUpsertOne) ID(ctx context.Context) (id types.MessageDeletionID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: MessageDeletionUpsertOne.ID is not supported by MySQL driver. Use MessageDeletionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageDeletionUpsertOne) IDX(ctx context.Context) types.MessageDeletionID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageDeletionCreateBulk is the builder for creating many MessageDeletion entities in bulk.
+type MessageDeletionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageDeletionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MessageDeletion entities in the database.
+func (mdcb *MessageDeletionCreateBulk) Save(ctx context.Context) ([]*MessageDeletion, error) {
+	if mdcb.err != nil {
+		return nil, mdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mdcb.builders))
+	nodes := make([]*MessageDeletion, len(mdcb.builders))
+	mutators := make([]Mutator, len(mdcb.builders))
+	for i := range mdcb.builders {
+		func(i int, root context.Context) {
+			builder := mdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mdcb *MessageDeletionCreateBulk) SaveX(ctx context.Context) []*MessageDeletion {
+	v, err := mdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mdcb *MessageDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdcb *MessageDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := mdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageDeletion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageDeletionUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (mdcb *MessageDeletionCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageDeletionUpsertBulk {
+	mdcb.conflict = opts
+	return &MessageDeletionUpsertBulk{
+		create: mdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mdcb *MessageDeletionCreateBulk) OnConflictColumns(columns ...string) *MessageDeletionUpsertBulk {
+	mdcb.conflict = append(mdcb.conflict, sql.ConflictColumns(columns...))
+	return &MessageDeletionUpsertBulk{
+		create: mdcb,
+	}
+}
+
+// MessageDeletionUpsertBulk is the builder for "upsert"-ing
+// a bulk of MessageDeletion nodes.
+type MessageDeletionUpsertBulk struct {
+	create *MessageDeletionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MessageDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagedeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageDeletionUpsertBulk) UpdateNewValues() *MessageDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(messagedeletion.FieldID)
+			}
+			if _, exists := b.mutation.MessageID(); exists {
+				s.SetIgnore(messagedeletion.FieldMessageID)
+			}
+			if _, exists := b.mutation.RequestID(); exists {
+				s.SetIgnore(messagedeletion.FieldRequestID)
+			}
+			if _, exists := b.mutation.DeletedBy(); exists {
+				s.SetIgnore(messagedeletion.FieldDeletedBy)
+			}
+			if _, exists := b.mutation.DeletedByRole(); exists {
+				s.SetIgnore(messagedeletion.FieldDeletedByRole)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(messagedeletion.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageDeletion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageDeletionUpsertBulk) Ignore() *MessageDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageDeletionUpsertBulk) DoNothing() *MessageDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageDeletionCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageDeletionUpsertBulk) Update(set func(*MessageDeletionUpsert)) *MessageDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *MessageDeletionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the MessageDeletionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for MessageDeletionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageDeletionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// MessageDeletionQuery is the builder for querying MessageDeletion entities.
+type MessageDeletionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []messagedeletion.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.MessageDeletion
+	withMessage *MessageQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MessageDeletionQuery builder.
+func (mdq *MessageDeletionQuery) Where(ps ...predicate.MessageDeletion) *MessageDeletionQuery {
+	mdq.predicates = append(mdq.predicates, ps...)
+	return mdq
+}
+
+// Limit the number of records to be returned by this query.
+func (mdq *MessageDeletionQuery) Limit(limit int) *MessageDeletionQuery {
+	mdq.ctx.Limit = &limit
+	return mdq
+}
+
+// Offset to start from.
+func (mdq *MessageDeletionQuery) Offset(offset int) *MessageDeletionQuery {
+	mdq.ctx.Offset = &offset
+	return mdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mdq *MessageDeletionQuery) Unique(unique bool) *MessageDeletionQuery {
+	mdq.ctx.Unique = &unique
+	return mdq
+}
+
+// Order specifies how the records should be ordered.
+func (mdq *MessageDeletionQuery) Order(o ...messagedeletion.OrderOption) *MessageDeletionQuery {
+	mdq.order = append(mdq.order, o...)
+	return mdq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (mdq *MessageDeletionQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: mdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagedeletion.Table, messagedeletion.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, messagedeletion.MessageTable, messagedeletion.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MessageDeletion entity from the query.
+// Returns a *NotFoundError when no MessageDeletion was found.
+func (mdq *MessageDeletionQuery) First(ctx context.Context) (*MessageDeletion, error) {
+	nodes, err := mdq.Limit(1).All(setContextOp(ctx, mdq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{messagedeletion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mdq *MessageDeletionQuery) FirstX(ctx context.Context) *MessageDeletion {
+	node, err := mdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MessageDeletion ID from the query.
+// Returns a *NotFoundError when no MessageDeletion ID was found.
+func (mdq *MessageDeletionQuery) FirstID(ctx context.Context) (id types.MessageDeletionID, err error) {
+	var ids []types.MessageDeletionID
+	if ids, err = mdq.Limit(1).IDs(setContextOp(ctx, mdq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{messagedeletion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mdq *MessageDeletionQuery) FirstIDX(ctx context.Context) types.MessageDeletionID {
+	id, err := mdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MessageDeletion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MessageDeletion entity is found.
+// Returns a *NotFoundError when no MessageDeletion entities are found.
+func (mdq *MessageDeletionQuery) Only(ctx context.Context) (*MessageDeletion, error) {
+	nodes, err := mdq.Limit(2).All(setContextOp(ctx, mdq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{messagedeletion.Label}
+	default:
+		return nil, &NotSingularError{messagedeletion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mdq *MessageDeletionQuery) OnlyX(ctx context.Context) *MessageDeletion {
+	node, err := mdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MessageDeletion ID in the query.
+// Returns a *NotSingularError when more than one MessageDeletion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mdq *MessageDeletionQuery) OnlyID(ctx context.Context) (id types.MessageDeletionID, err error) {
+	var ids []types.MessageDeletionID
+	if ids, err = mdq.Limit(2).IDs(setContextOp(ctx, mdq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{messagedeletion.Label}
+	default:
+		err = &NotSingularError{messagedeletion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mdq *MessageDeletionQuery) OnlyIDX(ctx context.Context) types.MessageDeletionID {
+	id, err := mdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MessageDeletions.
+func (mdq *MessageDeletionQuery) All(ctx context.Context) ([]*MessageDeletion, error) {
+	ctx = setContextOp(ctx, mdq.ctx, "All")
+	if err := mdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MessageDeletion, *MessageDeletionQuery]()
+	return withInterceptors[[]*MessageDeletion](ctx, mdq, qr, mdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mdq *MessageDeletionQuery) AllX(ctx context.Context) []*MessageDeletion {
+	nodes, err := mdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MessageDeletion IDs.
+func (mdq *MessageDeletionQuery) IDs(ctx context.Context) (ids []types.MessageDeletionID, err error) {
+	if mdq.ctx.Unique == nil && mdq.path != nil {
+		mdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mdq.ctx, "IDs")
+	if err = mdq.Select(messagedeletion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mdq *MessageDeletionQuery) IDsX(ctx context.Context) []types.MessageDeletionID {
+	ids, err := mdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mdq *MessageDeletionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mdq.ctx, "Count")
+	if err := mdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mdq, querierCount[*MessageDeletionQuery](), mdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mdq *MessageDeletionQuery) CountX(ctx context.Context) int {
+	count, err := mdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mdq *MessageDeletionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mdq.ctx, "Exist")
+	switch _, err := mdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("store: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mdq *MessageDeletionQuery) ExistX(ctx context.Context) bool {
+	exist, err := mdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MessageDeletionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mdq *MessageDeletionQuery) Clone() *MessageDeletionQuery {
+	if mdq == nil {
+		return nil
+	}
+	return &MessageDeletionQuery{
+		config:      mdq.config,
+		ctx:         mdq.ctx.Clone(),
+		order:       append([]messagedeletion.OrderOption{}, mdq.order...),
+		inters:      append([]Interceptor{}, mdq.inters...),
+		predicates:  append([]predicate.MessageDeletion{}, mdq.predicates...),
+		withMessage: mdq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:  mdq.sql.Clone(),
+		path: mdq.path,
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (mdq *MessageDeletionQuery) WithMessage(opts ...func(*MessageQuery)) *MessageDeletionQuery {
+	query := (&MessageClient{config: mdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mdq.withMessage = query
+	return mdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID types.MessageID `json:"message_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MessageDeletion.Query().
+//		GroupBy(messagedeletion.FieldMessageID).
+//		Aggregate(store.Count()).
+//		Scan(ctx, &v)
+func (mdq *MessageDeletionQuery) GroupBy(field string, fields ...string) *MessageDeletionGroupBy {
+	mdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MessageDeletionGroupBy{build: mdq}
+	grbuild.flds = &mdq.ctx.Fields
+	grbuild.label = messagedeletion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID types.MessageID `json:"message_id,omitempty"`
+//	}
+//
+//	client.MessageDeletion.Query().
+//		Select(messagedeletion.FieldMessageID).
+//		Scan(ctx, &v)
+func (mdq *MessageDeletionQuery) Select(fields ...string) *MessageDeletionSelect {
+	mdq.ctx.Fields = append(mdq.ctx.Fields, fields...)
+	sbuild := &MessageDeletionSelect{MessageDeletionQuery: mdq}
+	sbuild.label = messagedeletion.Label
+	sbuild.flds, sbuild.scan = &mdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MessageDeletionSelect configured with the given aggregations.
+func (mdq *MessageDeletionQuery) Aggregate(fns ...AggregateFunc) *MessageDeletionSelect {
+	return mdq.Select().Aggregate(fns...)
+}
+
+func (mdq *MessageDeletionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mdq.inters {
+		if inter == nil {
+			return fmt.Errorf("store: uninitialized interceptor (forgotten import store/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mdq.ctx.Fields {
+		if !messagedeletion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+		}
+	}
+	if mdq.path != nil {
+		prev, err := mdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mdq.sql = prev
+	}
+	return nil
+}
+
+func (mdq *MessageDeletionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MessageDeletion, error) {
+	var (
+		nodes       = []*MessageDeletion{}
+		_spec       = mdq.querySpec()
+		loadedTypes = [1]bool{
+			mdq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MessageDeletion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MessageDeletion{config: mdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(mdq.modifiers) > 0 {
+		_spec.Modifiers = mdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mdq.withMessage; query != nil {
+		if err := mdq.loadMessage(ctx, query, nodes, nil,
+			func(n *MessageDeletion, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mdq *MessageDeletionQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*MessageDeletion, init func(*MessageDeletion), assign func(*MessageDeletion, *Message)) error {
+	ids := make([]types.MessageID, 0, len(nodes))
+	nodeids := make(map[types.MessageID][]*MessageDeletion)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mdq *MessageDeletionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mdq.querySpec()
+	if len(mdq.modifiers) > 0 {
+		_spec.Modifiers = mdq.modifiers
+	}
+	_spec.Node.Columns = mdq.ctx.Fields
+	if len(mdq.ctx.Fields) > 0 {
+		_spec.Unique = mdq.ctx.Unique != nil && *mdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mdq.driver, _spec)
+}
+
+func (mdq *MessageDeletionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(messagedeletion.Table, messagedeletion.Columns, sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeUUID))
+	_spec.From = mdq.sql
+	if unique := mdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagedeletion.FieldID)
+		for i := range fields {
+			if fields[i] != messagedeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mdq.withMessage != nil {
+			_spec.Node.AddColumnOnce(messagedeletion.FieldMessageID)
+		}
+	}
+	if ps := mdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mdq *MessageDeletionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mdq.driver.Dialect())
+	t1 := builder.Table(messagedeletion.Table)
+	columns := mdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = messagedeletion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mdq.sql != nil {
+		selector = mdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mdq.ctx.Unique != nil && *mdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mdq.modifiers {
+		m(selector)
+	}
+	for _, p := range mdq.predicates {
+		p(selector)
+	}
+	for _, p := range mdq.order {
+		p(selector)
+	}
+	if offset := mdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mdq *MessageDeletionQuery) ForUpdate(opts ...sql.LockOption) *MessageDeletionQuery {
+	if mdq.driver.Dialect() == dialect.Postgres {
+		mdq.Unique(false)
+	}
+	mdq.modifiers = append(mdq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mdq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mdq *MessageDeletionQuery) ForShare(opts ...sql.LockOption) *MessageDeletionQuery {
+	if mdq.driver.Dialect() == dialect.Postgres {
+		mdq.Unique(false)
+	}
+	mdq.modifiers = append(mdq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mdq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mdq *MessageDeletionQuery) Modify(modifiers ...func(s *sql.Selector)) *MessageDeletionSelect {
+	mdq.modifiers = append(mdq.modifiers, modifiers...)
+	return mdq.Select()
+}
+
+// MessageDeletionGroupBy is the group-by builder for MessageDeletion entities.
+type MessageDeletionGroupBy struct {
+	selector
+	build *MessageDeletionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mdgb *MessageDeletionGroupBy) Aggregate(fns ...AggregateFunc) *MessageDeletionGroupBy {
+	mdgb.fns = append(mdgb.fns, fns...)
+	return mdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mdgb *MessageDeletionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mdgb.build.ctx, "GroupBy")
+	if err := mdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageDeletionQuery, *MessageDeletionGroupBy](ctx, mdgb.build, mdgb, mdgb.build.inters, v)
+}
+
+func (mdgb *MessageDeletionGroupBy) sqlScan(ctx context.Context, root *MessageDeletionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mdgb.fns))
+	for _, fn := range mdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mdgb.flds)+len(mdgb.fns))
+		for _, f := range *mdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MessageDeletionSelect is the builder for selecting fields of MessageDeletion entities.
+type MessageDeletionSelect struct {
+	*MessageDeletionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mds *MessageDeletionSelect) Aggregate(fns ...AggregateFunc) *MessageDeletionSelect {
+	mds.fns = append(mds.fns, fns...)
+	return mds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mds *MessageDeletionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mds.ctx, "Select")
+	if err := mds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageDeletionQuery, *MessageDeletionSelect](ctx, mds.MessageDeletionQuery, mds, mds.inters, v)
+}
+
+func (mds *MessageDeletionSelect) sqlScan(ctx context.Context, root *MessageDeletionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mds.fns))
+	for _, fn := range mds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mds *MessageDeletionSelect) Modify(modifiers ...func(s *sql.Selector)) *MessageDeletionSelect {
+	mds.modifiers = append(mds.modifiers, modifiers...)
+	return mds
+}
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+)
+
+// MessageDeletionUpdate is the builder for updating MessageDeletion entities.
+type MessageDeletionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MessageDeletionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MessageDeletionUpdate builder.
+func (mdu *MessageDeletionUpdate) Where(ps ...predicate.MessageDeletion) *MessageDeletionUpdate {
+	mdu.mutation.Where(ps...)
+	return mdu
+}
+
+// Mutation returns the MessageDeletionMutation object of the builder.
+func (mdu *MessageDeletionUpdate) Mutation() *MessageDeletionMutation {
+	return mdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mdu *MessageDeletionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mdu.sqlSave, mdu.mutation, mdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mdu *MessageDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mdu *MessageDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := mdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdu *MessageDeletionUpdate) ExecX(ctx context.Context) {
+	if err := mdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mdu *MessageDeletionUpdate) check() error {
+	if _, ok := mdu.mutation.MessageID(); mdu.mutation.MessageCleared() && !ok {
+		return errors.New(`store: clearing a required unique edge "MessageDeletion.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mdu *MessageDeletionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageDeletionUpdate {
+	mdu.modifiers = append(mdu.modifiers, modifiers...)
+	return mdu
+}
+
+func (mdu *MessageDeletionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagedeletion.Table, messagedeletion.Columns, sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeUUID))
+	if ps := mdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(mdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagedeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mdu.mutation.done = true
+	return n, nil
+}
+
+// MessageDeletionUpdateOne is the builder for updating a single MessageDeletion entity.
+type MessageDeletionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MessageDeletionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the MessageDeletionMutation object of the builder.
+func (mduo *MessageDeletionUpdateOne) Mutation() *MessageDeletionMutation {
+	return mduo.mutation
+}
+
+// Where appends a list predicates to the MessageDeletionUpdate builder.
+func (mduo *MessageDeletionUpdateOne) Where(ps ...predicate.MessageDeletion) *MessageDeletionUpdateOne {
+	mduo.mutation.Where(ps...)
+	return mduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mduo *MessageDeletionUpdateOne) Select(field string, fields ...string) *MessageDeletionUpdateOne {
+	mduo.fields = append([]string{field}, fields...)
+	return mduo
+}
+
+// Save executes the query and returns the updated MessageDeletion entity.
+func (mduo *MessageDeletionUpdateOne) Save(ctx context.Context) (*MessageDeletion, error) {
+	return withHooks(ctx, mduo.sqlSave, mduo.mutation, mduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mduo *MessageDeletionUpdateOne) SaveX(ctx context.Context) *MessageDeletion {
+	node, err := mduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mduo *MessageDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mduo *MessageDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := mduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mduo *MessageDeletionUpdateOne) check() error {
+	if _, ok := mduo.mutation.MessageID(); mduo.mutation.MessageCleared() && !ok {
+		return errors.New(`store: clearing a required unique edge "MessageDeletion.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mduo *MessageDeletionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageDeletionUpdateOne {
+	mduo.modifiers = append(mduo.modifiers, modifiers...)
+	return mduo
+}
+
+func (mduo *MessageDeletionUpdateOne) sqlSave(ctx context.Context) (_node *MessageDeletion, err error) {
+	if err := mduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagedeletion.Table, messagedeletion.Columns, sqlgraph.NewFieldSpec(messagedeletion.FieldID, field.TypeUUID))
+	id, ok := mduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "MessageDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagedeletion.FieldID)
+		for _, f := range fields {
+			if !messagedeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != messagedeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(mduo.modifiers...)
+	_node = &MessageDeletion{config: mduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagedeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,586 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagereaction"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// MessageReactionCreate is the builder for creating a MessageReaction entity.
+type MessageReactionCreate struct {
+	config
+	mutation *MessageReactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMessageID sets the "message_id" field.
+func (mrc *MessageReactionCreate) SetMessageID(ti types.MessageID) *MessageReactionCreate {
+	mrc.mutation.SetMessageID(ti)
+	return mrc
+}
+
+// SetUserID sets the "user_id" field.
+func (mrc *MessageReactionCreate) SetUserID(ti types.UserID) *MessageReactionCreate {
+	mrc.mutation.SetUserID(ti)
+	return mrc
+}
+
+// SetKind sets the "kind" field.
+func (mrc *MessageReactionCreate) SetKind(m messagereaction.Kind) *MessageReactionCreate {
+	mrc.mutation.SetKind(m)
+	return mrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mrc *MessageReactionCreate) SetCreatedAt(t time.Time) *MessageReactionCreate {
+	mrc.mutation.SetCreatedAt(t)
+	return mrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mrc *MessageReactionCreate) SetNillableCreatedAt(t *time.Time) *MessageReactionCreate {
+	if t != nil {
+		mrc.SetCreatedAt(*t)
+	}
+	return mrc
+}
+
+// SetID sets the "id" field.
+func (mrc *MessageReactionCreate) SetID(tri types.MessageReactionID) *MessageReactionCreate {
+	mrc.mutation.SetID(tri)
+	return mrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mrc *MessageReactionCreate) SetNillableID(tri *types.MessageReactionID) *MessageReactionCreate {
+	if tri != nil {
+		mrc.SetID(*tri)
+	}
+	return mrc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mrc *MessageReactionCreate) SetMessage(m *Message) *MessageReactionCreate {
+	return mrc.SetMessageID(m.ID)
+}
+
+// Mutation returns the MessageReactionMutation object of the builder.
+func (mrc *MessageReactionCreate) Mutation() *MessageReactionMutation {
+	return mrc.mutation
+}
+
+// Save creates the MessageReaction in the database.
+func (mrc *MessageReactionCreate) Save(ctx context.Context) (*MessageReaction, error) {
+	mrc.defaults()
+	return withHooks(ctx, mrc.sqlSave, mrc.mutation, mrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mrc *MessageReactionCreate) SaveX(ctx context.Context) *MessageReaction {
+	v, err := mrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrc *MessageReactionCreate) Exec(ctx context.Context) error {
+	_, err := mrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrc *MessageReactionCreate) ExecX(ctx context.Context) {
+	if err := mrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrc *MessageReactionCreate) defaults() {
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		v := messagereaction.DefaultCreatedAt()
+		mrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mrc.mutation.ID(); !ok {
+		v := messagereaction.DefaultID()
+		mrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrc *MessageReactionCreate) check() error {
+	if _, ok := mrc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`store: missing required field "MessageReaction.message_id"`)}
+	}
+	if v, ok := mrc.mutation.MessageID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`store: validator failed for field "MessageReaction.message_id": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`store: missing required field "MessageReaction.user_id"`)}
+	}
+	if v, ok := mrc.mutation.UserID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`store: validator failed for field "MessageReaction.user_id": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`store: missing required field "MessageReaction.kind"`)}
+	}
+	if v, ok := mrc.mutation.Kind(); ok {
+		if err := messagereaction.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`store: validator failed for field "MessageReaction.kind": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "MessageReaction.created_at"`)}
+	}
+	if v, ok := mrc.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "MessageReaction.id": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`store: missing required edge "MessageReaction.message"`)}
+	}
+	return nil
+}
+
+func (mrc *MessageReactionCreate) sqlSave(ctx context.Context) (*MessageReaction, error) {
+	if err := mrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.MessageReactionID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mrc.mutation.id = &_node.ID
+	mrc.mutation.done = true
+	return _node, nil
+}
+
+func (mrc *MessageReactionCreate) createSpec() (*MessageReaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageReaction{config: mrc.config}
+		_spec = sqlgraph.NewCreateSpec(messagereaction.Table, sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mrc.conflict
+	if id, ok := mrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mrc.mutation.UserID(); ok {
+		_spec.SetField(messagereaction.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := mrc.mutation.Kind(); ok {
+		_spec.SetField(messagereaction.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := mrc.mutation.CreatedAt(); ok {
+		_spec.SetField(messagereaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mrc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagereaction.MessageTable,
+			Columns: []string{messagereaction.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageReaction.Create().
+//		SetMessageID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageReactionUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (mrc *MessageReactionCreate) OnConflict(opts ...sql.ConflictOption) *MessageReactionUpsertOne {
+	mrc.conflict = opts
+	return &MessageReactionUpsertOne{
+		create: mrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mrc *MessageReactionCreate) OnConflictColumns(columns ...string) *MessageReactionUpsertOne {
+	mrc.conflict = append(mrc.conflict, sql.ConflictColumns(columns...))
+	return &MessageReactionUpsertOne{
+		create: mrc,
+	}
+}
+
+type (
+	// MessageReactionUpsertOne is the builder for "upsert"-ing
+	//  one MessageReaction node.
+	MessageReactionUpsertOne struct {
+		create *MessageReactionCreate
+	}
+
+	// MessageReactionUpsert is the "OnConflict" setter.
+	MessageReactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagereaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageReactionUpsertOne) UpdateNewValues() *MessageReactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(messagereaction.FieldID)
+		}
+		if _, exists := u.create.mutation.MessageID(); exists {
+			s.SetIgnore(messagereaction.FieldMessageID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(messagereaction.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(messagereaction.FieldKind)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(messagereaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageReactionUpsertOne) Ignore() *MessageReactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageReactionUpsertOne) DoNothing() *MessageReactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageReactionCreate.OnConflict
+// documentation for more info.
+func (u *MessageReactionUpsertOne) Update(set func(*MessageReactionUpsert)) *MessageReactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageReactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *MessageReactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for MessageReactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageReactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageReactionUpsertOne) ID(ctx context.Context) (id types.MessageReactionID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: MessageReactionUpsertOne.ID is not supported by MySQL driver. Use MessageReactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageReactionUpsertOne) IDX(ctx context.Context) types.MessageReactionID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageReactionCreateBulk is the builder for creating many MessageReaction entities in bulk.
+type MessageReactionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageReactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MessageReaction entities in the database.
+func (mrcb *MessageReactionCreateBulk) Save(ctx context.Context) ([]*MessageReaction, error) {
+	if mrcb.err != nil {
+		return nil, mrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mrcb.builders))
+	nodes := make([]*MessageReaction, len(mrcb.builders))
+	mutators := make([]Mutator, len(mrcb.builders))
+	for i := range mrcb.builders {
+		func(i int, root context.Context) {
+			builder := mrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageReactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcb *MessageReactionCreateBulk) SaveX(ctx context.Context) []*MessageReaction {
+	v, err := mrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrcb *MessageReactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcb *MessageReactionCreateBulk) ExecX(ctx context.Context) {
+	if err := mrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageReaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageReactionUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (mrcb *MessageReactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageReactionUpsertBulk {
+	mrcb.conflict = opts
+	return &MessageReactionUpsertBulk{
+		create: mrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mrcb *MessageReactionCreateBulk) OnConflictColumns(columns ...string) *MessageReactionUpsertBulk {
+	mrcb.conflict = append(mrcb.conflict, sql.ConflictColumns(columns...))
+	return &MessageReactionUpsertBulk{
+		create: mrcb,
+	}
+}
+
+// MessageReactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of MessageReaction nodes.
+type MessageReactionUpsertBulk struct {
+	create *MessageReactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagereaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageReactionUpsertBulk) UpdateNewValues() *MessageReactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(messagereaction.FieldID)
+			}
+			if _, exists := b.mutation.MessageID(); exists {
+				s.SetIgnore(messagereaction.FieldMessageID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(messagereaction.FieldUserID)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(messagereaction.FieldKind)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(messagereaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageReactionUpsertBulk) Ignore() *MessageReactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageReactionUpsertBulk) DoNothing() *MessageReactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageReactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageReactionUpsertBulk) Update(set func(*MessageReactionUpsert)) *MessageReactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageReactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *MessageReactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the MessageReactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for MessageReactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageReactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
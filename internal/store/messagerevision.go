@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// MessageRevision is the model entity for the MessageRevision schema.
+type MessageRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID types.MessageRevisionID `json:"id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID types.MessageID `json:"message_id,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// EditRequestID holds the value of the "edit_request_id" field.
+	EditRequestID types.RequestID `json:"edit_request_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageRevisionQuery when eager-loading is set.
+	Edges        MessageRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MessageRevisionEdges holds the relations/edges for other nodes in the graph.
+type MessageRevisionEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageRevisionEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagerevision.FieldBody:
+			values[i] = new(sql.NullString)
+		case messagerevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case messagerevision.FieldMessageID:
+			values[i] = new(types.MessageID)
+		case messagerevision.FieldID:
+			values[i] = new(types.MessageRevisionID)
+		case messagerevision.FieldEditRequestID:
+			values[i] = new(types.RequestID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageRevision fields.
+func (mr *MessageRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagerevision.FieldID:
+			if value, ok := values[i].(*types.MessageRevisionID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mr.ID = *value
+			}
+		case messagerevision.FieldMessageID:
+			if value, ok := values[i].(*types.MessageID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				mr.MessageID = *value
+			}
+		case messagerevision.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				mr.Body = value.String
+			}
+		case messagerevision.FieldEditRequestID:
+			if value, ok := values[i].(*types.RequestID); !ok {
+				return fmt.Errorf("unexpected type %T for field edit_request_id", values[i])
+			} else if value != nil {
+				mr.EditRequestID = *value
+			}
+		case messagerevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mr.CreatedAt = value.Time
+			}
+		default:
+			mr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageRevision.
+// This includes values selected through modifiers, order, etc.
+func (mr *MessageRevision) Value(name string) (ent.Value, error) {
+	return mr.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the MessageRevision entity.
+func (mr *MessageRevision) QueryMessage() *MessageQuery {
+	return NewMessageRevisionClient(mr.config).QueryMessage(mr)
+}
+
+// Update returns a builder for updating this MessageRevision.
+// Note that you need to call MessageRevision.Unwrap() before calling this method if this MessageRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mr *MessageRevision) Update() *MessageRevisionUpdateOne {
+	return NewMessageRevisionClient(mr.config).UpdateOne(mr)
+}
+
+// Unwrap unwraps the MessageRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mr *MessageRevision) Unwrap() *MessageRevision {
+	_tx, ok := mr.config.driver.(*txDriver)
+	if !ok {
+		panic("store: MessageRevision is not a transactional entity")
+	}
+	mr.config.driver = _tx.drv
+	return mr
+}
+
+// String implements the fmt.Stringer.
+func (mr *MessageRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mr.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", mr.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(mr.Body)
+	builder.WriteString(", ")
+	builder.WriteString("edit_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", mr.EditRequestID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageRevisions is a parsable slice of MessageRevision.
+type MessageRevisions []*MessageRevision
@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagerevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id types.MessageRevisionID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id types.MessageRevisionID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id types.MessageRevisionID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...types.MessageRevisionID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...types.MessageRevisionID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id types.MessageRevisionID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id types.MessageRevisionID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id types.MessageRevisionID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id types.MessageRevisionID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldID, id))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v types.MessageID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldMessageID, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldBody, v))
+}
+
+// EditRequestID applies equality check predicate on the "edit_request_id" field. It's identical to EditRequestIDEQ.
+func EditRequestID(v types.RequestID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldEditRequestID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v types.MessageID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v types.MessageID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...types.MessageID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...types.MessageID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldContainsFold(FieldBody, v))
+}
+
+// EditRequestIDEQ applies the EQ predicate on the "edit_request_id" field.
+func EditRequestIDEQ(v types.RequestID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldEditRequestID, v))
+}
+
+// EditRequestIDNEQ applies the NEQ predicate on the "edit_request_id" field.
+func EditRequestIDNEQ(v types.RequestID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldEditRequestID, v))
+}
+
+// EditRequestIDIn applies the In predicate on the "edit_request_id" field.
+func EditRequestIDIn(vs ...types.RequestID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldEditRequestID, vs...))
+}
+
+// EditRequestIDNotIn applies the NotIn predicate on the "edit_request_id" field.
+func EditRequestIDNotIn(vs ...types.RequestID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldEditRequestID, vs...))
+}
+
+// EditRequestIDGT applies the GT predicate on the "edit_request_id" field.
+func EditRequestIDGT(v types.RequestID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldEditRequestID, v))
+}
+
+// EditRequestIDGTE applies the GTE predicate on the "edit_request_id" field.
+func EditRequestIDGTE(v types.RequestID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldEditRequestID, v))
+}
+
+// EditRequestIDLT applies the LT predicate on the "edit_request_id" field.
+func EditRequestIDLT(v types.RequestID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldEditRequestID, v))
+}
+
+// EditRequestIDLTE applies the LTE predicate on the "edit_request_id" field.
+func EditRequestIDLTE(v types.RequestID) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldEditRequestID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.MessageRevision {
+	return predicate.MessageRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.MessageRevision {
+	return predicate.MessageRevision(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageRevision) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageRevision) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageRevision) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.NotPredicates(p))
+}
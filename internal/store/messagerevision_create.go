@@ -0,0 +1,586 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// MessageRevisionCreate is the builder for creating a MessageRevision entity.
+type MessageRevisionCreate struct {
+	config
+	mutation *MessageRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMessageID sets the "message_id" field.
+func (mrc *MessageRevisionCreate) SetMessageID(ti types.MessageID) *MessageRevisionCreate {
+	mrc.mutation.SetMessageID(ti)
+	return mrc
+}
+
+// SetBody sets the "body" field.
+func (mrc *MessageRevisionCreate) SetBody(s string) *MessageRevisionCreate {
+	mrc.mutation.SetBody(s)
+	return mrc
+}
+
+// SetEditRequestID sets the "edit_request_id" field.
+func (mrc *MessageRevisionCreate) SetEditRequestID(ti types.RequestID) *MessageRevisionCreate {
+	mrc.mutation.SetEditRequestID(ti)
+	return mrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mrc *MessageRevisionCreate) SetCreatedAt(t time.Time) *MessageRevisionCreate {
+	mrc.mutation.SetCreatedAt(t)
+	return mrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mrc *MessageRevisionCreate) SetNillableCreatedAt(t *time.Time) *MessageRevisionCreate {
+	if t != nil {
+		mrc.SetCreatedAt(*t)
+	}
+	return mrc
+}
+
+// SetID sets the "id" field.
+func (mrc *MessageRevisionCreate) SetID(tri types.MessageRevisionID) *MessageRevisionCreate {
+	mrc.mutation.SetID(tri)
+	return mrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mrc *MessageRevisionCreate) SetNillableID(tri *types.MessageRevisionID) *MessageRevisionCreate {
+	if tri != nil {
+		mrc.SetID(*tri)
+	}
+	return mrc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mrc *MessageRevisionCreate) SetMessage(m *Message) *MessageRevisionCreate {
+	return mrc.SetMessageID(m.ID)
+}
+
+// Mutation returns the MessageRevisionMutation object of the builder.
+func (mrc *MessageRevisionCreate) Mutation() *MessageRevisionMutation {
+	return mrc.mutation
+}
+
+// Save creates the MessageRevision in the database.
+func (mrc *MessageRevisionCreate) Save(ctx context.Context) (*MessageRevision, error) {
+	mrc.defaults()
+	return withHooks(ctx, mrc.sqlSave, mrc.mutation, mrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mrc *MessageRevisionCreate) SaveX(ctx context.Context) *MessageRevision {
+	v, err := mrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrc *MessageRevisionCreate) Exec(ctx context.Context) error {
+	_, err := mrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrc *MessageRevisionCreate) ExecX(ctx context.Context) {
+	if err := mrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrc *MessageRevisionCreate) defaults() {
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		v := messagerevision.DefaultCreatedAt()
+		mrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mrc.mutation.ID(); !ok {
+		v := messagerevision.DefaultID()
+		mrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrc *MessageRevisionCreate) check() error {
+	if _, ok := mrc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`store: missing required field "MessageRevision.message_id"`)}
+	}
+	if v, ok := mrc.mutation.MessageID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`store: validator failed for field "MessageRevision.message_id": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`store: missing required field "MessageRevision.body"`)}
+	}
+	if v, ok := mrc.mutation.Body(); ok {
+		if err := messagerevision.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`store: validator failed for field "MessageRevision.body": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.EditRequestID(); !ok {
+		return &ValidationError{Name: "edit_request_id", err: errors.New(`store: missing required field "MessageRevision.edit_request_id"`)}
+	}
+	if v, ok := mrc.mutation.EditRequestID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "edit_request_id", err: fmt.Errorf(`store: validator failed for field "MessageRevision.edit_request_id": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "MessageRevision.created_at"`)}
+	}
+	if v, ok := mrc.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "MessageRevision.id": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`store: missing required edge "MessageRevision.message"`)}
+	}
+	return nil
+}
+
+func (mrc *MessageRevisionCreate) sqlSave(ctx context.Context) (*MessageRevision, error) {
+	if err := mrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.MessageRevisionID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mrc.mutation.id = &_node.ID
+	mrc.mutation.done = true
+	return _node, nil
+}
+
+func (mrc *MessageRevisionCreate) createSpec() (*MessageRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageRevision{config: mrc.config}
+		_spec = sqlgraph.NewCreateSpec(messagerevision.Table, sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mrc.conflict
+	if id, ok := mrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mrc.mutation.Body(); ok {
+		_spec.SetField(messagerevision.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := mrc.mutation.EditRequestID(); ok {
+		_spec.SetField(messagerevision.FieldEditRequestID, field.TypeUUID, value)
+		_node.EditRequestID = value
+	}
+	if value, ok := mrc.mutation.CreatedAt(); ok {
+		_spec.SetField(messagerevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mrc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagerevision.MessageTable,
+			Columns: []string{messagerevision.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageRevision.Create().
+//		SetMessageID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageRevisionUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (mrc *MessageRevisionCreate) OnConflict(opts ...sql.ConflictOption) *MessageRevisionUpsertOne {
+	mrc.conflict = opts
+	return &MessageRevisionUpsertOne{
+		create: mrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mrc *MessageRevisionCreate) OnConflictColumns(columns ...string) *MessageRevisionUpsertOne {
+	mrc.conflict = append(mrc.conflict, sql.ConflictColumns(columns...))
+	return &MessageRevisionUpsertOne{
+		create: mrc,
+	}
+}
+
+type (
+	// MessageRevisionUpsertOne is the builder for "upsert"-ing
+	//  one MessageRevision node.
+	MessageRevisionUpsertOne struct {
+		create *MessageRevisionCreate
+	}
+
+	// MessageRevisionUpsert is the "OnConflict" setter.
+	MessageRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagerevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageRevisionUpsertOne) UpdateNewValues() *MessageRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(messagerevision.FieldID)
+		}
+		if _, exists := u.create.mutation.MessageID(); exists {
+			s.SetIgnore(messagerevision.FieldMessageID)
+		}
+		if _, exists := u.create.mutation.Body(); exists {
+			s.SetIgnore(messagerevision.FieldBody)
+		}
+		if _, exists := u.create.mutation.EditRequestID(); exists {
+			s.SetIgnore(messagerevision.FieldEditRequestID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(messagerevision.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageRevisionUpsertOne) Ignore() *MessageRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageRevisionUpsertOne) DoNothing() *MessageRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageRevisionCreate.OnConflict
+// documentation for more info.
+func (u *MessageRevisionUpsertOne) Update(set func(*MessageRevisionUpsert)) *MessageRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *MessageRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for MessageRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageRevisionUpsertOne) ID(ctx context.Context) (id types.MessageRevisionID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: MessageRevisionUpsertOne.ID is not supported by MySQL driver. Use MessageRevisionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageRevisionUpsertOne) IDX(ctx context.Context) types.MessageRevisionID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageRevisionCreateBulk is the builder for creating many MessageRevision entities in bulk.
+type MessageRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MessageRevision entities in the database.
+func (mrcb *MessageRevisionCreateBulk) Save(ctx context.Context) ([]*MessageRevision, error) {
+	if mrcb.err != nil {
+		return nil, mrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mrcb.builders))
+	nodes := make([]*MessageRevision, len(mrcb.builders))
+	mutators := make([]Mutator, len(mrcb.builders))
+	for i := range mrcb.builders {
+		func(i int, root context.Context) {
+			builder := mrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcb *MessageRevisionCreateBulk) SaveX(ctx context.Context) []*MessageRevision {
+	v, err := mrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrcb *MessageRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcb *MessageRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := mrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageRevisionUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (mrcb *MessageRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageRevisionUpsertBulk {
+	mrcb.conflict = opts
+	return &MessageRevisionUpsertBulk{
+		create: mrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mrcb *MessageRevisionCreateBulk) OnConflictColumns(columns ...string) *MessageRevisionUpsertBulk {
+	mrcb.conflict = append(mrcb.conflict, sql.ConflictColumns(columns...))
+	return &MessageRevisionUpsertBulk{
+		create: mrcb,
+	}
+}
+
+// MessageRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of MessageRevision nodes.
+type MessageRevisionUpsertBulk struct {
+	create *MessageRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagerevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageRevisionUpsertBulk) UpdateNewValues() *MessageRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(messagerevision.FieldID)
+			}
+			if _, exists := b.mutation.MessageID(); exists {
+				s.SetIgnore(messagerevision.FieldMessageID)
+			}
+			if _, exists := b.mutation.Body(); exists {
+				s.SetIgnore(messagerevision.FieldBody)
+			}
+			if _, exists := b.mutation.EditRequestID(); exists {
+				s.SetIgnore(messagerevision.FieldEditRequestID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(messagerevision.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageRevisionUpsertBulk) Ignore() *MessageRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageRevisionUpsertBulk) DoNothing() *MessageRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageRevisionUpsertBulk) Update(set func(*MessageRevisionUpsert)) *MessageRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *MessageRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the MessageRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for MessageRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+)
+
+// MessageRevisionUpdate is the builder for updating MessageRevision entities.
+type MessageRevisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MessageRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MessageRevisionUpdate builder.
+func (mru *MessageRevisionUpdate) Where(ps ...predicate.MessageRevision) *MessageRevisionUpdate {
+	mru.mutation.Where(ps...)
+	return mru
+}
+
+// Mutation returns the MessageRevisionMutation object of the builder.
+func (mru *MessageRevisionUpdate) Mutation() *MessageRevisionMutation {
+	return mru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mru *MessageRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mru.sqlSave, mru.mutation, mru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mru *MessageRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mru *MessageRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := mru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mru *MessageRevisionUpdate) ExecX(ctx context.Context) {
+	if err := mru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mru *MessageRevisionUpdate) check() error {
+	if _, ok := mru.mutation.MessageID(); mru.mutation.MessageCleared() && !ok {
+		return errors.New(`store: clearing a required unique edge "MessageRevision.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mru *MessageRevisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageRevisionUpdate {
+	mru.modifiers = append(mru.modifiers, modifiers...)
+	return mru
+}
+
+func (mru *MessageRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagerevision.Table, messagerevision.Columns, sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID))
+	if ps := mru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(mru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagerevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mru.mutation.done = true
+	return n, nil
+}
+
+// MessageRevisionUpdateOne is the builder for updating a single MessageRevision entity.
+type MessageRevisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MessageRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the MessageRevisionMutation object of the builder.
+func (mruo *MessageRevisionUpdateOne) Mutation() *MessageRevisionMutation {
+	return mruo.mutation
+}
+
+// Where appends a list predicates to the MessageRevisionUpdate builder.
+func (mruo *MessageRevisionUpdateOne) Where(ps ...predicate.MessageRevision) *MessageRevisionUpdateOne {
+	mruo.mutation.Where(ps...)
+	return mruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mruo *MessageRevisionUpdateOne) Select(field string, fields ...string) *MessageRevisionUpdateOne {
+	mruo.fields = append([]string{field}, fields...)
+	return mruo
+}
+
+// Save executes the query and returns the updated MessageRevision entity.
+func (mruo *MessageRevisionUpdateOne) Save(ctx context.Context) (*MessageRevision, error) {
+	return withHooks(ctx, mruo.sqlSave, mruo.mutation, mruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mruo *MessageRevisionUpdateOne) SaveX(ctx context.Context) *MessageRevision {
+	node, err := mruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mruo *MessageRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mruo *MessageRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := mruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mruo *MessageRevisionUpdateOne) check() error {
+	if _, ok := mruo.mutation.MessageID(); mruo.mutation.MessageCleared() && !ok {
+		return errors.New(`store: clearing a required unique edge "MessageRevision.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mruo *MessageRevisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageRevisionUpdateOne {
+	mruo.modifiers = append(mruo.modifiers, modifiers...)
+	return mruo
+}
+
+func (mruo *MessageRevisionUpdateOne) sqlSave(ctx context.Context) (_node *MessageRevision, err error) {
+	if err := mruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagerevision.Table, messagerevision.Columns, sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID))
+	id, ok := mruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "MessageRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagerevision.FieldID)
+		for _, f := range fields {
+			if !messagerevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != messagerevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(mruo.modifiers...)
+	_node = &MessageRevision{config: mruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagerevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mruo.mutation.done = true
+	return _node, nil
+}
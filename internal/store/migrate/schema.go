@@ -9,10 +9,85 @@ import (
 )
 
 var (
+	// AttachmentsColumns holds the columns for the "attachments" table.
+	AttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "uploaded_by", Type: field.TypeUUID},
+		{Name: "file_name", Type: field.TypeString, Size: 255},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "storage_key", Type: field.TypeString, Unique: true},
+		{Name: "thumbnail_key", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "chat_id", Type: field.TypeUUID},
+		{Name: "message_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// AttachmentsTable holds the schema information for the "attachments" table.
+	AttachmentsTable = &schema.Table{
+		Name:       "attachments",
+		Columns:    AttachmentsColumns,
+		PrimaryKey: []*schema.Column{AttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachments_chats_attachments",
+				Columns:    []*schema.Column{AttachmentsColumns[8]},
+				RefColumns: []*schema.Column{ChatsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attachments_messages_attachments",
+				Columns:    []*schema.Column{AttachmentsColumns[9]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachment_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[9]},
+			},
+		},
+	}
+	// CannedResponsesColumns holds the columns for the "canned_responses" table.
+	CannedResponsesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "author_id", Type: field.TypeUUID},
+		{Name: "is_shared", Type: field.TypeBool, Default: false},
+		{Name: "shortcut", Type: field.TypeString, Size: 32},
+		{Name: "body", Type: field.TypeString, Size: 3000},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CannedResponsesTable holds the schema information for the "canned_responses" table.
+	CannedResponsesTable = &schema.Table{
+		Name:       "canned_responses",
+		Columns:    CannedResponsesColumns,
+		PrimaryKey: []*schema.Column{CannedResponsesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cannedresponse_author_id_shortcut",
+				Unique:  true,
+				Columns: []*schema.Column{CannedResponsesColumns[1], CannedResponsesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "not is_shared",
+				},
+			},
+			{
+				Name:    "cannedresponse_shortcut",
+				Unique:  true,
+				Columns: []*schema.Column{CannedResponsesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "is_shared",
+				},
+			},
+		},
+	}
 	// ChatsColumns holds the columns for the "chats" table.
 	ChatsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "client_id", Type: field.TypeUUID, Unique: true},
+		{Name: "client_name", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// ChatsTable holds the schema information for the "chats" table.
@@ -58,12 +133,19 @@ var (
 		{Name: "is_visible_for_client", Type: field.TypeBool, Default: false},
 		{Name: "is_visible_for_manager", Type: field.TypeBool, Default: false},
 		{Name: "body", Type: field.TypeString, Size: 3000},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "verdict", Type: field.TypeEnum, Nullable: true, Enums: []string{"ok", "suspicious"}},
+		{Name: "verdict_source", Type: field.TypeEnum, Nullable: true, Enums: []string{"afc", "timeout", "prefilter", "moderator"}},
 		{Name: "is_blocked", Type: field.TypeBool, Default: false},
 		{Name: "is_service", Type: field.TypeBool, Default: false},
+		{Name: "is_internal_note", Type: field.TypeBool, Default: false},
 		{Name: "initial_request_id", Type: field.TypeUUID},
+		{Name: "content_request_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "chat_id", Type: field.TypeUUID},
+		{Name: "reply_to_message_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "problem_id", Type: field.TypeUUID},
 	}
 	// MessagesTable holds the schema information for the "messages" table.
@@ -74,54 +156,186 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_chats_messages",
-				Columns:    []*schema.Column{MessagesColumns[10]},
+				Columns:    []*schema.Column{MessagesColumns[16]},
 				RefColumns: []*schema.Column{ChatsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "messages_messages_replies",
+				Columns:    []*schema.Column{MessagesColumns[17]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "messages_problems_messages",
-				Columns:    []*schema.Column{MessagesColumns[11]},
+				Columns:    []*schema.Column{MessagesColumns[18]},
 				RefColumns: []*schema.Column{ProblemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "message_chat_id_created_at",
+				Name:    "message_chat_id_created_at_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[10], MessagesColumns[9]},
+				Columns: []*schema.Column{MessagesColumns[16], MessagesColumns[15], MessagesColumns[0]},
 				Annotation: &entsql.IndexAnnotation{
 					DescColumns: map[string]bool{
-						MessagesColumns[9].Name: true,
+						MessagesColumns[15].Name: true,
+
+						MessagesColumns[0].Name: true,
 					},
 				},
 			},
 			{
-				Name:    "message_problem_id_created_at",
+				Name:    "message_problem_id_created_at_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[11], MessagesColumns[9]},
+				Columns: []*schema.Column{MessagesColumns[18], MessagesColumns[15], MessagesColumns[0]},
 				Annotation: &entsql.IndexAnnotation{
 					DescColumns: map[string]bool{
-						MessagesColumns[9].Name: true,
+						MessagesColumns[15].Name: true,
+
+						MessagesColumns[0].Name: true,
 					},
 				},
 			},
 			{
 				Name:    "message_initial_request_id",
 				Unique:  true,
-				Columns: []*schema.Column{MessagesColumns[8]},
+				Columns: []*schema.Column{MessagesColumns[13]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "not is_service",
 				},
 			},
 		},
 	}
+	// MessageDeletionsColumns holds the columns for the "message_deletions" table.
+	MessageDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "request_id", Type: field.TypeUUID, Unique: true},
+		{Name: "deleted_by", Type: field.TypeUUID},
+		{Name: "deleted_by_role", Type: field.TypeEnum, Enums: []string{"client", "manager", "moderator"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeUUID, Unique: true},
+	}
+	// MessageDeletionsTable holds the schema information for the "message_deletions" table.
+	MessageDeletionsTable = &schema.Table{
+		Name:       "message_deletions",
+		Columns:    MessageDeletionsColumns,
+		PrimaryKey: []*schema.Column{MessageDeletionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_deletions_messages_deletion",
+				Columns:    []*schema.Column{MessageDeletionsColumns[5]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// MessageReactionsColumns holds the columns for the "message_reactions" table.
+	MessageReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"thumbs_up", "thumbs_down", "heart", "laugh", "surprised", "sad"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeUUID},
+	}
+	// MessageReactionsTable holds the schema information for the "message_reactions" table.
+	MessageReactionsTable = &schema.Table{
+		Name:       "message_reactions",
+		Columns:    MessageReactionsColumns,
+		PrimaryKey: []*schema.Column{MessageReactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_reactions_messages_reactions",
+				Columns:    []*schema.Column{MessageReactionsColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagereaction_message_id_user_id_kind",
+				Unique:  true,
+				Columns: []*schema.Column{MessageReactionsColumns[4], MessageReactionsColumns[1], MessageReactionsColumns[2]},
+			},
+		},
+	}
+	// MessageRevisionsColumns holds the columns for the "message_revisions" table.
+	MessageRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "body", Type: field.TypeString, Size: 3000},
+		{Name: "edit_request_id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeUUID},
+	}
+	// MessageRevisionsTable holds the schema information for the "message_revisions" table.
+	MessageRevisionsTable = &schema.Table{
+		Name:       "message_revisions",
+		Columns:    MessageRevisionsColumns,
+		PrimaryKey: []*schema.Column{MessageRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_revisions_messages_revisions",
+				Columns:    []*schema.Column{MessageRevisionsColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagerevision_message_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessageRevisionsColumns[4], MessageRevisionsColumns[3]},
+			},
+		},
+	}
+	// ModerationCasesColumns holds the columns for the "moderation_cases" table.
+	ModerationCasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "moderator_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "decision", Type: field.TypeEnum, Nullable: true, Enums: []string{"approved", "rejected"}},
+		{Name: "decided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeUUID, Unique: true},
+	}
+	// ModerationCasesTable holds the schema information for the "moderation_cases" table.
+	ModerationCasesTable = &schema.Table{
+		Name:       "moderation_cases",
+		Columns:    ModerationCasesColumns,
+		PrimaryKey: []*schema.Column{ModerationCasesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "moderation_cases_messages_moderation_case",
+				Columns:    []*schema.Column{ModerationCasesColumns[5]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationcase_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationCasesColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "decided_at is null",
+				},
+			},
+		},
+	}
 	// ProblemsColumns holds the columns for the "problems" table.
 	ProblemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "manager_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
 		{Name: "resolve_request_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "resolution_code", Type: field.TypeString, Nullable: true},
+		{Name: "resolution_summary", Type: field.TypeString, Nullable: true},
+		{Name: "rating_score", Type: field.TypeInt, Nullable: true},
+		{Name: "rating_comment", Type: field.TypeString, Nullable: true},
+		{Name: "rated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reopened_from_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "manager_read_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "chat_id", Type: field.TypeUUID},
 	}
@@ -133,7 +347,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "problems_chats_problems",
-				Columns:    []*schema.Column{ProblemsColumns[5]},
+				Columns:    []*schema.Column{ProblemsColumns[13]},
 				RefColumns: []*schema.Column{ChatsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -142,27 +356,76 @@ var (
 			{
 				Name:    "problem_chat_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProblemsColumns[5]},
+				Columns: []*schema.Column{ProblemsColumns[13]},
 			},
 			{
 				Name:    "problem_manager_id",
 				Unique:  false,
 				Columns: []*schema.Column{ProblemsColumns[1]},
 			},
+			{
+				Name:    "problem_rated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProblemsColumns[9]},
+			},
+		},
+	}
+	// VerdictConflictsColumns holds the columns for the "verdict_conflicts" table.
+	VerdictConflictsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "applied_verdict", Type: field.TypeEnum, Enums: []string{"ok", "suspicious"}},
+		{Name: "received_verdict", Type: field.TypeEnum, Enums: []string{"ok", "suspicious"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeUUID},
+	}
+	// VerdictConflictsTable holds the schema information for the "verdict_conflicts" table.
+	VerdictConflictsTable = &schema.Table{
+		Name:       "verdict_conflicts",
+		Columns:    VerdictConflictsColumns,
+		PrimaryKey: []*schema.Column{VerdictConflictsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "verdict_conflicts_messages_verdict_conflicts",
+				Columns:    []*schema.Column{VerdictConflictsColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "verdictconflict_message_id_received_verdict",
+				Unique:  true,
+				Columns: []*schema.Column{VerdictConflictsColumns[4], VerdictConflictsColumns[2]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AttachmentsTable,
+		CannedResponsesTable,
 		ChatsTable,
 		FailedJobsTable,
 		JobsTable,
 		MessagesTable,
+		MessageDeletionsTable,
+		MessageReactionsTable,
+		MessageRevisionsTable,
+		ModerationCasesTable,
 		ProblemsTable,
+		VerdictConflictsTable,
 	}
 )
 
 func init() {
+	AttachmentsTable.ForeignKeys[0].RefTable = ChatsTable
+	AttachmentsTable.ForeignKeys[1].RefTable = MessagesTable
 	MessagesTable.ForeignKeys[0].RefTable = ChatsTable
-	MessagesTable.ForeignKeys[1].RefTable = ProblemsTable
+	MessagesTable.ForeignKeys[1].RefTable = MessagesTable
+	MessagesTable.ForeignKeys[2].RefTable = ProblemsTable
+	MessageDeletionsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageReactionsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageRevisionsTable.ForeignKeys[0].RefTable = MessagesTable
+	ModerationCasesTable.ForeignKeys[0].RefTable = MessagesTable
 	ProblemsTable.ForeignKeys[0].RefTable = ChatsTable
+	VerdictConflictsTable.ForeignKeys[0].RefTable = MessagesTable
 }
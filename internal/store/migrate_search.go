@@ -0,0 +1,38 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// messagesSearchDDL creates the full-text search column and its index.
+// The body is indexed in both Russian and English configurations.
+var messagesSearchDDL = []string{
+	`alter table "messages" add column if not exists "body_tsv" tsvector
+		generated always as (to_tsvector('russian', "body") || to_tsvector('english', "body")) stored`,
+	`create index if not exists "message_body_tsv" on "messages" using gin ("body_tsv")`,
+}
+
+// WithMessagesSearch is the migration option adding the full-text search on messages.
+// Ent cannot describe the generated column, so it is created right after the schema migration
+// in the same transaction. The column is unknown to Ent and is kept as is by the next migrations.
+func WithMessagesSearch() schema.MigrateOption {
+	return schema.WithApplyHook(func(next schema.Applier) schema.Applier {
+		return schema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+			if err := next.Apply(ctx, conn, plan); err != nil {
+				return err
+			}
+
+			for _, q := range messagesSearchDDL {
+				if err := conn.Exec(ctx, q, []any{}, nil); err != nil {
+					return fmt.Errorf("create messages search: %v", err)
+				}
+			}
+			return nil
+		})
+	})
+}
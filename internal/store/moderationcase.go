@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ModerationCase is the model entity for the ModerationCase schema.
+type ModerationCase struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID types.ModerationCaseID `json:"id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID types.MessageID `json:"message_id,omitempty"`
+	// ModeratorID holds the value of the "moderator_id" field.
+	ModeratorID types.UserID `json:"moderator_id,omitempty"`
+	// Decision holds the value of the "decision" field.
+	Decision moderationcase.Decision `json:"decision,omitempty"`
+	// DecidedAt holds the value of the "decided_at" field.
+	DecidedAt time.Time `json:"decided_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ModerationCaseQuery when eager-loading is set.
+	Edges        ModerationCaseEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ModerationCaseEdges holds the relations/edges for other nodes in the graph.
+type ModerationCaseEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModerationCaseEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModerationCase) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case moderationcase.FieldDecision:
+			values[i] = new(sql.NullString)
+		case moderationcase.FieldDecidedAt, moderationcase.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case moderationcase.FieldMessageID:
+			values[i] = new(types.MessageID)
+		case moderationcase.FieldID:
+			values[i] = new(types.ModerationCaseID)
+		case moderationcase.FieldModeratorID:
+			values[i] = new(types.UserID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModerationCase fields.
+func (mc *ModerationCase) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case moderationcase.FieldID:
+			if value, ok := values[i].(*types.ModerationCaseID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mc.ID = *value
+			}
+		case moderationcase.FieldMessageID:
+			if value, ok := values[i].(*types.MessageID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				mc.MessageID = *value
+			}
+		case moderationcase.FieldModeratorID:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field moderator_id", values[i])
+			} else if value != nil {
+				mc.ModeratorID = *value
+			}
+		case moderationcase.FieldDecision:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decision", values[i])
+			} else if value.Valid {
+				mc.Decision = moderationcase.Decision(value.String)
+			}
+		case moderationcase.FieldDecidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_at", values[i])
+			} else if value.Valid {
+				mc.DecidedAt = value.Time
+			}
+		case moderationcase.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mc.CreatedAt = value.Time
+			}
+		default:
+			mc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModerationCase.
+// This includes values selected through modifiers, order, etc.
+func (mc *ModerationCase) Value(name string) (ent.Value, error) {
+	return mc.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the ModerationCase entity.
+func (mc *ModerationCase) QueryMessage() *MessageQuery {
+	return NewModerationCaseClient(mc.config).QueryMessage(mc)
+}
+
+// Update returns a builder for updating this ModerationCase.
+// Note that you need to call ModerationCase.Unwrap() before calling this method if this ModerationCase
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mc *ModerationCase) Update() *ModerationCaseUpdateOne {
+	return NewModerationCaseClient(mc.config).UpdateOne(mc)
+}
+
+// Unwrap unwraps the ModerationCase entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mc *ModerationCase) Unwrap() *ModerationCase {
+	_tx, ok := mc.config.driver.(*txDriver)
+	if !ok {
+		panic("store: ModerationCase is not a transactional entity")
+	}
+	mc.config.driver = _tx.drv
+	return mc
+}
+
+// String implements the fmt.Stringer.
+func (mc *ModerationCase) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModerationCase(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mc.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", mc.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("moderator_id=")
+	builder.WriteString(fmt.Sprintf("%v", mc.ModeratorID))
+	builder.WriteString(", ")
+	builder.WriteString("decision=")
+	builder.WriteString(fmt.Sprintf("%v", mc.Decision))
+	builder.WriteString(", ")
+	builder.WriteString("decided_at=")
+	builder.WriteString(mc.DecidedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModerationCases is a parsable slice of ModerationCase.
+type ModerationCases []*ModerationCase
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationcase
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id types.ModerationCaseID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id types.ModerationCaseID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id types.ModerationCaseID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...types.ModerationCaseID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...types.ModerationCaseID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id types.ModerationCaseID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id types.ModerationCaseID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id types.ModerationCaseID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id types.ModerationCaseID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldLTE(FieldID, id))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v types.MessageID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldMessageID, v))
+}
+
+// ModeratorID applies equality check predicate on the "moderator_id" field. It's identical to ModeratorIDEQ.
+func ModeratorID(v types.UserID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldModeratorID, v))
+}
+
+// DecidedAt applies equality check predicate on the "decided_at" field. It's identical to DecidedAtEQ.
+func DecidedAt(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldDecidedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v types.MessageID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v types.MessageID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...types.MessageID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...types.MessageID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// ModeratorIDEQ applies the EQ predicate on the "moderator_id" field.
+func ModeratorIDEQ(v types.UserID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldModeratorID, v))
+}
+
+// ModeratorIDNEQ applies the NEQ predicate on the "moderator_id" field.
+func ModeratorIDNEQ(v types.UserID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNEQ(FieldModeratorID, v))
+}
+
+// ModeratorIDIn applies the In predicate on the "moderator_id" field.
+func ModeratorIDIn(vs ...types.UserID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldIn(FieldModeratorID, vs...))
+}
+
+// ModeratorIDNotIn applies the NotIn predicate on the "moderator_id" field.
+func ModeratorIDNotIn(vs ...types.UserID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNotIn(FieldModeratorID, vs...))
+}
+
+// ModeratorIDGT applies the GT predicate on the "moderator_id" field.
+func ModeratorIDGT(v types.UserID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldGT(FieldModeratorID, v))
+}
+
+// ModeratorIDGTE applies the GTE predicate on the "moderator_id" field.
+func ModeratorIDGTE(v types.UserID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldGTE(FieldModeratorID, v))
+}
+
+// ModeratorIDLT applies the LT predicate on the "moderator_id" field.
+func ModeratorIDLT(v types.UserID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldLT(FieldModeratorID, v))
+}
+
+// ModeratorIDLTE applies the LTE predicate on the "moderator_id" field.
+func ModeratorIDLTE(v types.UserID) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldLTE(FieldModeratorID, v))
+}
+
+// ModeratorIDIsNil applies the IsNil predicate on the "moderator_id" field.
+func ModeratorIDIsNil() predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldIsNull(FieldModeratorID))
+}
+
+// ModeratorIDNotNil applies the NotNil predicate on the "moderator_id" field.
+func ModeratorIDNotNil() predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNotNull(FieldModeratorID))
+}
+
+// DecisionEQ applies the EQ predicate on the "decision" field.
+func DecisionEQ(v Decision) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldDecision, v))
+}
+
+// DecisionNEQ applies the NEQ predicate on the "decision" field.
+func DecisionNEQ(v Decision) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNEQ(FieldDecision, v))
+}
+
+// DecisionIn applies the In predicate on the "decision" field.
+func DecisionIn(vs ...Decision) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldIn(FieldDecision, vs...))
+}
+
+// DecisionNotIn applies the NotIn predicate on the "decision" field.
+func DecisionNotIn(vs ...Decision) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNotIn(FieldDecision, vs...))
+}
+
+// DecisionIsNil applies the IsNil predicate on the "decision" field.
+func DecisionIsNil() predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldIsNull(FieldDecision))
+}
+
+// DecisionNotNil applies the NotNil predicate on the "decision" field.
+func DecisionNotNil() predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNotNull(FieldDecision))
+}
+
+// DecidedAtEQ applies the EQ predicate on the "decided_at" field.
+func DecidedAtEQ(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldDecidedAt, v))
+}
+
+// DecidedAtNEQ applies the NEQ predicate on the "decided_at" field.
+func DecidedAtNEQ(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNEQ(FieldDecidedAt, v))
+}
+
+// DecidedAtIn applies the In predicate on the "decided_at" field.
+func DecidedAtIn(vs ...time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldIn(FieldDecidedAt, vs...))
+}
+
+// DecidedAtNotIn applies the NotIn predicate on the "decided_at" field.
+func DecidedAtNotIn(vs ...time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNotIn(FieldDecidedAt, vs...))
+}
+
+// DecidedAtGT applies the GT predicate on the "decided_at" field.
+func DecidedAtGT(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldGT(FieldDecidedAt, v))
+}
+
+// DecidedAtGTE applies the GTE predicate on the "decided_at" field.
+func DecidedAtGTE(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldGTE(FieldDecidedAt, v))
+}
+
+// DecidedAtLT applies the LT predicate on the "decided_at" field.
+func DecidedAtLT(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldLT(FieldDecidedAt, v))
+}
+
+// DecidedAtLTE applies the LTE predicate on the "decided_at" field.
+func DecidedAtLTE(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldLTE(FieldDecidedAt, v))
+}
+
+// DecidedAtIsNil applies the IsNil predicate on the "decided_at" field.
+func DecidedAtIsNil() predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldIsNull(FieldDecidedAt))
+}
+
+// DecidedAtNotNil applies the NotNil predicate on the "decided_at" field.
+func DecidedAtNotNil() predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNotNull(FieldDecidedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.ModerationCase {
+	return predicate.ModerationCase(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.ModerationCase {
+	return predicate.ModerationCase(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModerationCase) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModerationCase) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModerationCase) predicate.ModerationCase {
+	return predicate.ModerationCase(sql.NotPredicates(p))
+}
@@ -0,0 +1,782 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ModerationCaseCreate is the builder for creating a ModerationCase entity.
+type ModerationCaseCreate struct {
+	config
+	mutation *ModerationCaseMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMessageID sets the "message_id" field.
+func (mcc *ModerationCaseCreate) SetMessageID(ti types.MessageID) *ModerationCaseCreate {
+	mcc.mutation.SetMessageID(ti)
+	return mcc
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (mcc *ModerationCaseCreate) SetModeratorID(ti types.UserID) *ModerationCaseCreate {
+	mcc.mutation.SetModeratorID(ti)
+	return mcc
+}
+
+// SetNillableModeratorID sets the "moderator_id" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableModeratorID(ti *types.UserID) *ModerationCaseCreate {
+	if ti != nil {
+		mcc.SetModeratorID(*ti)
+	}
+	return mcc
+}
+
+// SetDecision sets the "decision" field.
+func (mcc *ModerationCaseCreate) SetDecision(m moderationcase.Decision) *ModerationCaseCreate {
+	mcc.mutation.SetDecision(m)
+	return mcc
+}
+
+// SetNillableDecision sets the "decision" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableDecision(m *moderationcase.Decision) *ModerationCaseCreate {
+	if m != nil {
+		mcc.SetDecision(*m)
+	}
+	return mcc
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (mcc *ModerationCaseCreate) SetDecidedAt(t time.Time) *ModerationCaseCreate {
+	mcc.mutation.SetDecidedAt(t)
+	return mcc
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableDecidedAt(t *time.Time) *ModerationCaseCreate {
+	if t != nil {
+		mcc.SetDecidedAt(*t)
+	}
+	return mcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mcc *ModerationCaseCreate) SetCreatedAt(t time.Time) *ModerationCaseCreate {
+	mcc.mutation.SetCreatedAt(t)
+	return mcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableCreatedAt(t *time.Time) *ModerationCaseCreate {
+	if t != nil {
+		mcc.SetCreatedAt(*t)
+	}
+	return mcc
+}
+
+// SetID sets the "id" field.
+func (mcc *ModerationCaseCreate) SetID(tci types.ModerationCaseID) *ModerationCaseCreate {
+	mcc.mutation.SetID(tci)
+	return mcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableID(tci *types.ModerationCaseID) *ModerationCaseCreate {
+	if tci != nil {
+		mcc.SetID(*tci)
+	}
+	return mcc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mcc *ModerationCaseCreate) SetMessage(m *Message) *ModerationCaseCreate {
+	return mcc.SetMessageID(m.ID)
+}
+
+// Mutation returns the ModerationCaseMutation object of the builder.
+func (mcc *ModerationCaseCreate) Mutation() *ModerationCaseMutation {
+	return mcc.mutation
+}
+
+// Save creates the ModerationCase in the database.
+func (mcc *ModerationCaseCreate) Save(ctx context.Context) (*ModerationCase, error) {
+	mcc.defaults()
+	return withHooks(ctx, mcc.sqlSave, mcc.mutation, mcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mcc *ModerationCaseCreate) SaveX(ctx context.Context) *ModerationCase {
+	v, err := mcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcc *ModerationCaseCreate) Exec(ctx context.Context) error {
+	_, err := mcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcc *ModerationCaseCreate) ExecX(ctx context.Context) {
+	if err := mcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mcc *ModerationCaseCreate) defaults() {
+	if _, ok := mcc.mutation.CreatedAt(); !ok {
+		v := moderationcase.DefaultCreatedAt()
+		mcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mcc.mutation.ID(); !ok {
+		v := moderationcase.DefaultID()
+		mcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcc *ModerationCaseCreate) check() error {
+	if _, ok := mcc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`store: missing required field "ModerationCase.message_id"`)}
+	}
+	if v, ok := mcc.mutation.MessageID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`store: validator failed for field "ModerationCase.message_id": %w`, err)}
+		}
+	}
+	if v, ok := mcc.mutation.ModeratorID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`store: validator failed for field "ModerationCase.moderator_id": %w`, err)}
+		}
+	}
+	if v, ok := mcc.mutation.Decision(); ok {
+		if err := moderationcase.DecisionValidator(v); err != nil {
+			return &ValidationError{Name: "decision", err: fmt.Errorf(`store: validator failed for field "ModerationCase.decision": %w`, err)}
+		}
+	}
+	if _, ok := mcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "ModerationCase.created_at"`)}
+	}
+	if v, ok := mcc.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "ModerationCase.id": %w`, err)}
+		}
+	}
+	if _, ok := mcc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`store: missing required edge "ModerationCase.message"`)}
+	}
+	return nil
+}
+
+func (mcc *ModerationCaseCreate) sqlSave(ctx context.Context) (*ModerationCase, error) {
+	if err := mcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.ModerationCaseID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mcc.mutation.id = &_node.ID
+	mcc.mutation.done = true
+	return _node, nil
+}
+
+func (mcc *ModerationCaseCreate) createSpec() (*ModerationCase, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationCase{config: mcc.config}
+		_spec = sqlgraph.NewCreateSpec(moderationcase.Table, sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mcc.conflict
+	if id, ok := mcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mcc.mutation.ModeratorID(); ok {
+		_spec.SetField(moderationcase.FieldModeratorID, field.TypeUUID, value)
+		_node.ModeratorID = value
+	}
+	if value, ok := mcc.mutation.Decision(); ok {
+		_spec.SetField(moderationcase.FieldDecision, field.TypeEnum, value)
+		_node.Decision = value
+	}
+	if value, ok := mcc.mutation.DecidedAt(); ok {
+		_spec.SetField(moderationcase.FieldDecidedAt, field.TypeTime, value)
+		_node.DecidedAt = value
+	}
+	if value, ok := mcc.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationcase.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mcc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   moderationcase.MessageTable,
+			Columns: []string{moderationcase.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationCase.Create().
+//		SetMessageID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationCaseUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (mcc *ModerationCaseCreate) OnConflict(opts ...sql.ConflictOption) *ModerationCaseUpsertOne {
+	mcc.conflict = opts
+	return &ModerationCaseUpsertOne{
+		create: mcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mcc *ModerationCaseCreate) OnConflictColumns(columns ...string) *ModerationCaseUpsertOne {
+	mcc.conflict = append(mcc.conflict, sql.ConflictColumns(columns...))
+	return &ModerationCaseUpsertOne{
+		create: mcc,
+	}
+}
+
+type (
+	// ModerationCaseUpsertOne is the builder for "upsert"-ing
+	//  one ModerationCase node.
+	ModerationCaseUpsertOne struct {
+		create *ModerationCaseCreate
+	}
+
+	// ModerationCaseUpsert is the "OnConflict" setter.
+	ModerationCaseUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetModeratorID sets the "moderator_id" field.
+func (u *ModerationCaseUpsert) SetModeratorID(v types.UserID) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldModeratorID, v)
+	return u
+}
+
+// UpdateModeratorID sets the "moderator_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateModeratorID() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldModeratorID)
+	return u
+}
+
+// ClearModeratorID clears the value of the "moderator_id" field.
+func (u *ModerationCaseUpsert) ClearModeratorID() *ModerationCaseUpsert {
+	u.SetNull(moderationcase.FieldModeratorID)
+	return u
+}
+
+// SetDecision sets the "decision" field.
+func (u *ModerationCaseUpsert) SetDecision(v moderationcase.Decision) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldDecision, v)
+	return u
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateDecision() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldDecision)
+	return u
+}
+
+// ClearDecision clears the value of the "decision" field.
+func (u *ModerationCaseUpsert) ClearDecision() *ModerationCaseUpsert {
+	u.SetNull(moderationcase.FieldDecision)
+	return u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *ModerationCaseUpsert) SetDecidedAt(v time.Time) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldDecidedAt, v)
+	return u
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateDecidedAt() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldDecidedAt)
+	return u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *ModerationCaseUpsert) ClearDecidedAt() *ModerationCaseUpsert {
+	u.SetNull(moderationcase.FieldDecidedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(moderationcase.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModerationCaseUpsertOne) UpdateNewValues() *ModerationCaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(moderationcase.FieldID)
+		}
+		if _, exists := u.create.mutation.MessageID(); exists {
+			s.SetIgnore(moderationcase.FieldMessageID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(moderationcase.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModerationCaseUpsertOne) Ignore() *ModerationCaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationCaseUpsertOne) DoNothing() *ModerationCaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationCaseCreate.OnConflict
+// documentation for more info.
+func (u *ModerationCaseUpsertOne) Update(set func(*ModerationCaseUpsert)) *ModerationCaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationCaseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (u *ModerationCaseUpsertOne) SetModeratorID(v types.UserID) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetModeratorID(v)
+	})
+}
+
+// UpdateModeratorID sets the "moderator_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateModeratorID() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateModeratorID()
+	})
+}
+
+// ClearModeratorID clears the value of the "moderator_id" field.
+func (u *ModerationCaseUpsertOne) ClearModeratorID() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearModeratorID()
+	})
+}
+
+// SetDecision sets the "decision" field.
+func (u *ModerationCaseUpsertOne) SetDecision(v moderationcase.Decision) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetDecision(v)
+	})
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateDecision() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateDecision()
+	})
+}
+
+// ClearDecision clears the value of the "decision" field.
+func (u *ModerationCaseUpsertOne) ClearDecision() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearDecision()
+	})
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *ModerationCaseUpsertOne) SetDecidedAt(v time.Time) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetDecidedAt(v)
+	})
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateDecidedAt() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateDecidedAt()
+	})
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *ModerationCaseUpsertOne) ClearDecidedAt() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearDecidedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationCaseUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for ModerationCaseCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationCaseUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModerationCaseUpsertOne) ID(ctx context.Context) (id types.ModerationCaseID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: ModerationCaseUpsertOne.ID is not supported by MySQL driver. Use ModerationCaseUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModerationCaseUpsertOne) IDX(ctx context.Context) types.ModerationCaseID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModerationCaseCreateBulk is the builder for creating many ModerationCase entities in bulk.
+type ModerationCaseCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationCaseCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModerationCase entities in the database.
+func (mccb *ModerationCaseCreateBulk) Save(ctx context.Context) ([]*ModerationCase, error) {
+	if mccb.err != nil {
+		return nil, mccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mccb.builders))
+	nodes := make([]*ModerationCase, len(mccb.builders))
+	mutators := make([]Mutator, len(mccb.builders))
+	for i := range mccb.builders {
+		func(i int, root context.Context) {
+			builder := mccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationCaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mccb *ModerationCaseCreateBulk) SaveX(ctx context.Context) []*ModerationCase {
+	v, err := mccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mccb *ModerationCaseCreateBulk) Exec(ctx context.Context) error {
+	_, err := mccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mccb *ModerationCaseCreateBulk) ExecX(ctx context.Context) {
+	if err := mccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationCase.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationCaseUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (mccb *ModerationCaseCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModerationCaseUpsertBulk {
+	mccb.conflict = opts
+	return &ModerationCaseUpsertBulk{
+		create: mccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mccb *ModerationCaseCreateBulk) OnConflictColumns(columns ...string) *ModerationCaseUpsertBulk {
+	mccb.conflict = append(mccb.conflict, sql.ConflictColumns(columns...))
+	return &ModerationCaseUpsertBulk{
+		create: mccb,
+	}
+}
+
+// ModerationCaseUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModerationCase nodes.
+type ModerationCaseUpsertBulk struct {
+	create *ModerationCaseCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(moderationcase.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModerationCaseUpsertBulk) UpdateNewValues() *ModerationCaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(moderationcase.FieldID)
+			}
+			if _, exists := b.mutation.MessageID(); exists {
+				s.SetIgnore(moderationcase.FieldMessageID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(moderationcase.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModerationCaseUpsertBulk) Ignore() *ModerationCaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationCaseUpsertBulk) DoNothing() *ModerationCaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationCaseCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModerationCaseUpsertBulk) Update(set func(*ModerationCaseUpsert)) *ModerationCaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationCaseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (u *ModerationCaseUpsertBulk) SetModeratorID(v types.UserID) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetModeratorID(v)
+	})
+}
+
+// UpdateModeratorID sets the "moderator_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateModeratorID() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateModeratorID()
+	})
+}
+
+// ClearModeratorID clears the value of the "moderator_id" field.
+func (u *ModerationCaseUpsertBulk) ClearModeratorID() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearModeratorID()
+	})
+}
+
+// SetDecision sets the "decision" field.
+func (u *ModerationCaseUpsertBulk) SetDecision(v moderationcase.Decision) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetDecision(v)
+	})
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateDecision() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateDecision()
+	})
+}
+
+// ClearDecision clears the value of the "decision" field.
+func (u *ModerationCaseUpsertBulk) ClearDecision() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearDecision()
+	})
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *ModerationCaseUpsertBulk) SetDecidedAt(v time.Time) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetDecidedAt(v)
+	})
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateDecidedAt() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateDecidedAt()
+	})
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *ModerationCaseUpsertBulk) ClearDecidedAt() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearDecidedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationCaseUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the ModerationCaseCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for ModerationCaseCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationCaseUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
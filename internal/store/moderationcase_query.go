@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ModerationCaseQuery is the builder for querying ModerationCase entities.
+type ModerationCaseQuery struct {
+	config
+	ctx         *QueryContext
+	order       []moderationcase.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ModerationCase
+	withMessage *MessageQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ModerationCaseQuery builder.
+func (mcq *ModerationCaseQuery) Where(ps ...predicate.ModerationCase) *ModerationCaseQuery {
+	mcq.predicates = append(mcq.predicates, ps...)
+	return mcq
+}
+
+// Limit the number of records to be returned by this query.
+func (mcq *ModerationCaseQuery) Limit(limit int) *ModerationCaseQuery {
+	mcq.ctx.Limit = &limit
+	return mcq
+}
+
+// Offset to start from.
+func (mcq *ModerationCaseQuery) Offset(offset int) *ModerationCaseQuery {
+	mcq.ctx.Offset = &offset
+	return mcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mcq *ModerationCaseQuery) Unique(unique bool) *ModerationCaseQuery {
+	mcq.ctx.Unique = &unique
+	return mcq
+}
+
+// Order specifies how the records should be ordered.
+func (mcq *ModerationCaseQuery) Order(o ...moderationcase.OrderOption) *ModerationCaseQuery {
+	mcq.order = append(mcq.order, o...)
+	return mcq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (mcq *ModerationCaseQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: mcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(moderationcase.Table, moderationcase.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, moderationcase.MessageTable, moderationcase.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ModerationCase entity from the query.
+// Returns a *NotFoundError when no ModerationCase was found.
+func (mcq *ModerationCaseQuery) First(ctx context.Context) (*ModerationCase, error) {
+	nodes, err := mcq.Limit(1).All(setContextOp(ctx, mcq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{moderationcase.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mcq *ModerationCaseQuery) FirstX(ctx context.Context) *ModerationCase {
+	node, err := mcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ModerationCase ID from the query.
+// Returns a *NotFoundError when no ModerationCase ID was found.
+func (mcq *ModerationCaseQuery) FirstID(ctx context.Context) (id types.ModerationCaseID, err error) {
+	var ids []types.ModerationCaseID
+	if ids, err = mcq.Limit(1).IDs(setContextOp(ctx, mcq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{moderationcase.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mcq *ModerationCaseQuery) FirstIDX(ctx context.Context) types.ModerationCaseID {
+	id, err := mcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ModerationCase entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ModerationCase entity is found.
+// Returns a *NotFoundError when no ModerationCase entities are found.
+func (mcq *ModerationCaseQuery) Only(ctx context.Context) (*ModerationCase, error) {
+	nodes, err := mcq.Limit(2).All(setContextOp(ctx, mcq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{moderationcase.Label}
+	default:
+		return nil, &NotSingularError{moderationcase.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mcq *ModerationCaseQuery) OnlyX(ctx context.Context) *ModerationCase {
+	node, err := mcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ModerationCase ID in the query.
+// Returns a *NotSingularError when more than one ModerationCase ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mcq *ModerationCaseQuery) OnlyID(ctx context.Context) (id types.ModerationCaseID, err error) {
+	var ids []types.ModerationCaseID
+	if ids, err = mcq.Limit(2).IDs(setContextOp(ctx, mcq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{moderationcase.Label}
+	default:
+		err = &NotSingularError{moderationcase.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mcq *ModerationCaseQuery) OnlyIDX(ctx context.Context) types.ModerationCaseID {
+	id, err := mcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ModerationCases.
+func (mcq *ModerationCaseQuery) All(ctx context.Context) ([]*ModerationCase, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "All")
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ModerationCase, *ModerationCaseQuery]()
+	return withInterceptors[[]*ModerationCase](ctx, mcq, qr, mcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mcq *ModerationCaseQuery) AllX(ctx context.Context) []*ModerationCase {
+	nodes, err := mcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ModerationCase IDs.
+func (mcq *ModerationCaseQuery) IDs(ctx context.Context) (ids []types.ModerationCaseID, err error) {
+	if mcq.ctx.Unique == nil && mcq.path != nil {
+		mcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mcq.ctx, "IDs")
+	if err = mcq.Select(moderationcase.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mcq *ModerationCaseQuery) IDsX(ctx context.Context) []types.ModerationCaseID {
+	ids, err := mcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mcq *ModerationCaseQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "Count")
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mcq, querierCount[*ModerationCaseQuery](), mcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mcq *ModerationCaseQuery) CountX(ctx context.Context) int {
+	count, err := mcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mcq *ModerationCaseQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "Exist")
+	switch _, err := mcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("store: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mcq *ModerationCaseQuery) ExistX(ctx context.Context) bool {
+	exist, err := mcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ModerationCaseQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mcq *ModerationCaseQuery) Clone() *ModerationCaseQuery {
+	if mcq == nil {
+		return nil
+	}
+	return &ModerationCaseQuery{
+		config:      mcq.config,
+		ctx:         mcq.ctx.Clone(),
+		order:       append([]moderationcase.OrderOption{}, mcq.order...),
+		inters:      append([]Interceptor{}, mcq.inters...),
+		predicates:  append([]predicate.ModerationCase{}, mcq.predicates...),
+		withMessage: mcq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:  mcq.sql.Clone(),
+		path: mcq.path,
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (mcq *ModerationCaseQuery) WithMessage(opts ...func(*MessageQuery)) *ModerationCaseQuery {
+	query := (&MessageClient{config: mcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mcq.withMessage = query
+	return mcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID types.MessageID `json:"message_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ModerationCase.Query().
+//		GroupBy(moderationcase.FieldMessageID).
+//		Aggregate(store.Count()).
+//		Scan(ctx, &v)
+func (mcq *ModerationCaseQuery) GroupBy(field string, fields ...string) *ModerationCaseGroupBy {
+	mcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ModerationCaseGroupBy{build: mcq}
+	grbuild.flds = &mcq.ctx.Fields
+	grbuild.label = moderationcase.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID types.MessageID `json:"message_id,omitempty"`
+//	}
+//
+//	client.ModerationCase.Query().
+//		Select(moderationcase.FieldMessageID).
+//		Scan(ctx, &v)
+func (mcq *ModerationCaseQuery) Select(fields ...string) *ModerationCaseSelect {
+	mcq.ctx.Fields = append(mcq.ctx.Fields, fields...)
+	sbuild := &ModerationCaseSelect{ModerationCaseQuery: mcq}
+	sbuild.label = moderationcase.Label
+	sbuild.flds, sbuild.scan = &mcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ModerationCaseSelect configured with the given aggregations.
+func (mcq *ModerationCaseQuery) Aggregate(fns ...AggregateFunc) *ModerationCaseSelect {
+	return mcq.Select().Aggregate(fns...)
+}
+
+func (mcq *ModerationCaseQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mcq.inters {
+		if inter == nil {
+			return fmt.Errorf("store: uninitialized interceptor (forgotten import store/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mcq.ctx.Fields {
+		if !moderationcase.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+		}
+	}
+	if mcq.path != nil {
+		prev, err := mcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mcq.sql = prev
+	}
+	return nil
+}
+
+func (mcq *ModerationCaseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ModerationCase, error) {
+	var (
+		nodes       = []*ModerationCase{}
+		_spec       = mcq.querySpec()
+		loadedTypes = [1]bool{
+			mcq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ModerationCase).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ModerationCase{config: mcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(mcq.modifiers) > 0 {
+		_spec.Modifiers = mcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mcq.withMessage; query != nil {
+		if err := mcq.loadMessage(ctx, query, nodes, nil,
+			func(n *ModerationCase, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mcq *ModerationCaseQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*ModerationCase, init func(*ModerationCase), assign func(*ModerationCase, *Message)) error {
+	ids := make([]types.MessageID, 0, len(nodes))
+	nodeids := make(map[types.MessageID][]*ModerationCase)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mcq *ModerationCaseQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mcq.querySpec()
+	if len(mcq.modifiers) > 0 {
+		_spec.Modifiers = mcq.modifiers
+	}
+	_spec.Node.Columns = mcq.ctx.Fields
+	if len(mcq.ctx.Fields) > 0 {
+		_spec.Unique = mcq.ctx.Unique != nil && *mcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mcq.driver, _spec)
+}
+
+func (mcq *ModerationCaseQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(moderationcase.Table, moderationcase.Columns, sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeUUID))
+	_spec.From = mcq.sql
+	if unique := mcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationcase.FieldID)
+		for i := range fields {
+			if fields[i] != moderationcase.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mcq.withMessage != nil {
+			_spec.Node.AddColumnOnce(moderationcase.FieldMessageID)
+		}
+	}
+	if ps := mcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mcq *ModerationCaseQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mcq.driver.Dialect())
+	t1 := builder.Table(moderationcase.Table)
+	columns := mcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = moderationcase.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mcq.sql != nil {
+		selector = mcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mcq.ctx.Unique != nil && *mcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mcq.modifiers {
+		m(selector)
+	}
+	for _, p := range mcq.predicates {
+		p(selector)
+	}
+	for _, p := range mcq.order {
+		p(selector)
+	}
+	if offset := mcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mcq *ModerationCaseQuery) ForUpdate(opts ...sql.LockOption) *ModerationCaseQuery {
+	if mcq.driver.Dialect() == dialect.Postgres {
+		mcq.Unique(false)
+	}
+	mcq.modifiers = append(mcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mcq *ModerationCaseQuery) ForShare(opts ...sql.LockOption) *ModerationCaseQuery {
+	if mcq.driver.Dialect() == dialect.Postgres {
+		mcq.Unique(false)
+	}
+	mcq.modifiers = append(mcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mcq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mcq *ModerationCaseQuery) Modify(modifiers ...func(s *sql.Selector)) *ModerationCaseSelect {
+	mcq.modifiers = append(mcq.modifiers, modifiers...)
+	return mcq.Select()
+}
+
+// ModerationCaseGroupBy is the group-by builder for ModerationCase entities.
+type ModerationCaseGroupBy struct {
+	selector
+	build *ModerationCaseQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mcgb *ModerationCaseGroupBy) Aggregate(fns ...AggregateFunc) *ModerationCaseGroupBy {
+	mcgb.fns = append(mcgb.fns, fns...)
+	return mcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcgb *ModerationCaseGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcgb.build.ctx, "GroupBy")
+	if err := mcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ModerationCaseQuery, *ModerationCaseGroupBy](ctx, mcgb.build, mcgb, mcgb.build.inters, v)
+}
+
+func (mcgb *ModerationCaseGroupBy) sqlScan(ctx context.Context, root *ModerationCaseQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mcgb.fns))
+	for _, fn := range mcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mcgb.flds)+len(mcgb.fns))
+		for _, f := range *mcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ModerationCaseSelect is the builder for selecting fields of ModerationCase entities.
+type ModerationCaseSelect struct {
+	*ModerationCaseQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mcs *ModerationCaseSelect) Aggregate(fns ...AggregateFunc) *ModerationCaseSelect {
+	mcs.fns = append(mcs.fns, fns...)
+	return mcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcs *ModerationCaseSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcs.ctx, "Select")
+	if err := mcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ModerationCaseQuery, *ModerationCaseSelect](ctx, mcs.ModerationCaseQuery, mcs, mcs.inters, v)
+}
+
+func (mcs *ModerationCaseSelect) sqlScan(ctx context.Context, root *ModerationCaseQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mcs.fns))
+	for _, fn := range mcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mcs *ModerationCaseSelect) Modify(modifiers ...func(s *sql.Selector)) *ModerationCaseSelect {
+	mcs.modifiers = append(mcs.modifiers, modifiers...)
+	return mcs
+}
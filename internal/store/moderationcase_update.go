@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ModerationCaseUpdate is the builder for updating ModerationCase entities.
+type ModerationCaseUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ModerationCaseMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ModerationCaseUpdate builder.
+func (mcu *ModerationCaseUpdate) Where(ps ...predicate.ModerationCase) *ModerationCaseUpdate {
+	mcu.mutation.Where(ps...)
+	return mcu
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (mcu *ModerationCaseUpdate) SetModeratorID(ti types.UserID) *ModerationCaseUpdate {
+	mcu.mutation.SetModeratorID(ti)
+	return mcu
+}
+
+// SetNillableModeratorID sets the "moderator_id" field if the given value is not nil.
+func (mcu *ModerationCaseUpdate) SetNillableModeratorID(ti *types.UserID) *ModerationCaseUpdate {
+	if ti != nil {
+		mcu.SetModeratorID(*ti)
+	}
+	return mcu
+}
+
+// ClearModeratorID clears the value of the "moderator_id" field.
+func (mcu *ModerationCaseUpdate) ClearModeratorID() *ModerationCaseUpdate {
+	mcu.mutation.ClearModeratorID()
+	return mcu
+}
+
+// SetDecision sets the "decision" field.
+func (mcu *ModerationCaseUpdate) SetDecision(m moderationcase.Decision) *ModerationCaseUpdate {
+	mcu.mutation.SetDecision(m)
+	return mcu
+}
+
+// SetNillableDecision sets the "decision" field if the given value is not nil.
+func (mcu *ModerationCaseUpdate) SetNillableDecision(m *moderationcase.Decision) *ModerationCaseUpdate {
+	if m != nil {
+		mcu.SetDecision(*m)
+	}
+	return mcu
+}
+
+// ClearDecision clears the value of the "decision" field.
+func (mcu *ModerationCaseUpdate) ClearDecision() *ModerationCaseUpdate {
+	mcu.mutation.ClearDecision()
+	return mcu
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (mcu *ModerationCaseUpdate) SetDecidedAt(t time.Time) *ModerationCaseUpdate {
+	mcu.mutation.SetDecidedAt(t)
+	return mcu
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (mcu *ModerationCaseUpdate) SetNillableDecidedAt(t *time.Time) *ModerationCaseUpdate {
+	if t != nil {
+		mcu.SetDecidedAt(*t)
+	}
+	return mcu
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (mcu *ModerationCaseUpdate) ClearDecidedAt() *ModerationCaseUpdate {
+	mcu.mutation.ClearDecidedAt()
+	return mcu
+}
+
+// Mutation returns the ModerationCaseMutation object of the builder.
+func (mcu *ModerationCaseUpdate) Mutation() *ModerationCaseMutation {
+	return mcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mcu *ModerationCaseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mcu.sqlSave, mcu.mutation, mcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcu *ModerationCaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := mcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mcu *ModerationCaseUpdate) Exec(ctx context.Context) error {
+	_, err := mcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcu *ModerationCaseUpdate) ExecX(ctx context.Context) {
+	if err := mcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcu *ModerationCaseUpdate) check() error {
+	if v, ok := mcu.mutation.ModeratorID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`store: validator failed for field "ModerationCase.moderator_id": %w`, err)}
+		}
+	}
+	if v, ok := mcu.mutation.Decision(); ok {
+		if err := moderationcase.DecisionValidator(v); err != nil {
+			return &ValidationError{Name: "decision", err: fmt.Errorf(`store: validator failed for field "ModerationCase.decision": %w`, err)}
+		}
+	}
+	if _, ok := mcu.mutation.MessageID(); mcu.mutation.MessageCleared() && !ok {
+		return errors.New(`store: clearing a required unique edge "ModerationCase.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mcu *ModerationCaseUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModerationCaseUpdate {
+	mcu.modifiers = append(mcu.modifiers, modifiers...)
+	return mcu
+}
+
+func (mcu *ModerationCaseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationcase.Table, moderationcase.Columns, sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeUUID))
+	if ps := mcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcu.mutation.ModeratorID(); ok {
+		_spec.SetField(moderationcase.FieldModeratorID, field.TypeUUID, value)
+	}
+	if mcu.mutation.ModeratorIDCleared() {
+		_spec.ClearField(moderationcase.FieldModeratorID, field.TypeUUID)
+	}
+	if value, ok := mcu.mutation.Decision(); ok {
+		_spec.SetField(moderationcase.FieldDecision, field.TypeEnum, value)
+	}
+	if mcu.mutation.DecisionCleared() {
+		_spec.ClearField(moderationcase.FieldDecision, field.TypeEnum)
+	}
+	if value, ok := mcu.mutation.DecidedAt(); ok {
+		_spec.SetField(moderationcase.FieldDecidedAt, field.TypeTime, value)
+	}
+	if mcu.mutation.DecidedAtCleared() {
+		_spec.ClearField(moderationcase.FieldDecidedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(mcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationcase.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mcu.mutation.done = true
+	return n, nil
+}
+
+// ModerationCaseUpdateOne is the builder for updating a single ModerationCase entity.
+type ModerationCaseUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ModerationCaseMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (mcuo *ModerationCaseUpdateOne) SetModeratorID(ti types.UserID) *ModerationCaseUpdateOne {
+	mcuo.mutation.SetModeratorID(ti)
+	return mcuo
+}
+
+// SetNillableModeratorID sets the "moderator_id" field if the given value is not nil.
+func (mcuo *ModerationCaseUpdateOne) SetNillableModeratorID(ti *types.UserID) *ModerationCaseUpdateOne {
+	if ti != nil {
+		mcuo.SetModeratorID(*ti)
+	}
+	return mcuo
+}
+
+// ClearModeratorID clears the value of the "moderator_id" field.
+func (mcuo *ModerationCaseUpdateOne) ClearModeratorID() *ModerationCaseUpdateOne {
+	mcuo.mutation.ClearModeratorID()
+	return mcuo
+}
+
+// SetDecision sets the "decision" field.
+func (mcuo *ModerationCaseUpdateOne) SetDecision(m moderationcase.Decision) *ModerationCaseUpdateOne {
+	mcuo.mutation.SetDecision(m)
+	return mcuo
+}
+
+// SetNillableDecision sets the "decision" field if the given value is not nil.
+func (mcuo *ModerationCaseUpdateOne) SetNillableDecision(m *moderationcase.Decision) *ModerationCaseUpdateOne {
+	if m != nil {
+		mcuo.SetDecision(*m)
+	}
+	return mcuo
+}
+
+// ClearDecision clears the value of the "decision" field.
+func (mcuo *ModerationCaseUpdateOne) ClearDecision() *ModerationCaseUpdateOne {
+	mcuo.mutation.ClearDecision()
+	return mcuo
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (mcuo *ModerationCaseUpdateOne) SetDecidedAt(t time.Time) *ModerationCaseUpdateOne {
+	mcuo.mutation.SetDecidedAt(t)
+	return mcuo
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (mcuo *ModerationCaseUpdateOne) SetNillableDecidedAt(t *time.Time) *ModerationCaseUpdateOne {
+	if t != nil {
+		mcuo.SetDecidedAt(*t)
+	}
+	return mcuo
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (mcuo *ModerationCaseUpdateOne) ClearDecidedAt() *ModerationCaseUpdateOne {
+	mcuo.mutation.ClearDecidedAt()
+	return mcuo
+}
+
+// Mutation returns the ModerationCaseMutation object of the builder.
+func (mcuo *ModerationCaseUpdateOne) Mutation() *ModerationCaseMutation {
+	return mcuo.mutation
+}
+
+// Where appends a list predicates to the ModerationCaseUpdate builder.
+func (mcuo *ModerationCaseUpdateOne) Where(ps ...predicate.ModerationCase) *ModerationCaseUpdateOne {
+	mcuo.mutation.Where(ps...)
+	return mcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mcuo *ModerationCaseUpdateOne) Select(field string, fields ...string) *ModerationCaseUpdateOne {
+	mcuo.fields = append([]string{field}, fields...)
+	return mcuo
+}
+
+// Save executes the query and returns the updated ModerationCase entity.
+func (mcuo *ModerationCaseUpdateOne) Save(ctx context.Context) (*ModerationCase, error) {
+	return withHooks(ctx, mcuo.sqlSave, mcuo.mutation, mcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcuo *ModerationCaseUpdateOne) SaveX(ctx context.Context) *ModerationCase {
+	node, err := mcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mcuo *ModerationCaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := mcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcuo *ModerationCaseUpdateOne) ExecX(ctx context.Context) {
+	if err := mcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcuo *ModerationCaseUpdateOne) check() error {
+	if v, ok := mcuo.mutation.ModeratorID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`store: validator failed for field "ModerationCase.moderator_id": %w`, err)}
+		}
+	}
+	if v, ok := mcuo.mutation.Decision(); ok {
+		if err := moderationcase.DecisionValidator(v); err != nil {
+			return &ValidationError{Name: "decision", err: fmt.Errorf(`store: validator failed for field "ModerationCase.decision": %w`, err)}
+		}
+	}
+	if _, ok := mcuo.mutation.MessageID(); mcuo.mutation.MessageCleared() && !ok {
+		return errors.New(`store: clearing a required unique edge "ModerationCase.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mcuo *ModerationCaseUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModerationCaseUpdateOne {
+	mcuo.modifiers = append(mcuo.modifiers, modifiers...)
+	return mcuo
+}
+
+func (mcuo *ModerationCaseUpdateOne) sqlSave(ctx context.Context) (_node *ModerationCase, err error) {
+	if err := mcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationcase.Table, moderationcase.Columns, sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeUUID))
+	id, ok := mcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "ModerationCase.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationcase.FieldID)
+		for _, f := range fields {
+			if !moderationcase.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != moderationcase.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcuo.mutation.ModeratorID(); ok {
+		_spec.SetField(moderationcase.FieldModeratorID, field.TypeUUID, value)
+	}
+	if mcuo.mutation.ModeratorIDCleared() {
+		_spec.ClearField(moderationcase.FieldModeratorID, field.TypeUUID)
+	}
+	if value, ok := mcuo.mutation.Decision(); ok {
+		_spec.SetField(moderationcase.FieldDecision, field.TypeEnum, value)
+	}
+	if mcuo.mutation.DecisionCleared() {
+		_spec.ClearField(moderationcase.FieldDecision, field.TypeEnum)
+	}
+	if value, ok := mcuo.mutation.DecidedAt(); ok {
+		_spec.SetField(moderationcase.FieldDecidedAt, field.TypeTime, value)
+	}
+	if mcuo.mutation.DecidedAtCleared() {
+		_spec.ClearField(moderationcase.FieldDecidedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(mcuo.modifiers...)
+	_node = &ModerationCase{config: mcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationcase.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mcuo.mutation.done = true
+	return _node, nil
+}
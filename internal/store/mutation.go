@@ -11,12 +11,19 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/zestagio/chat-service/internal/store/attachment"
+	"github.com/zestagio/chat-service/internal/store/cannedresponse"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/failedjob"
 	"github.com/zestagio/chat-service/internal/store/job"
 	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/store/messagereaction"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
 	"github.com/zestagio/chat-service/internal/store/predicate"
 	"github.com/zestagio/chat-service/internal/store/problem"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
@@ -29,44 +36,55 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChat      = "Chat"
-	TypeFailedJob = "FailedJob"
-	TypeJob       = "Job"
-	TypeMessage   = "Message"
-	TypeProblem   = "Problem"
+	TypeAttachment      = "Attachment"
+	TypeCannedResponse  = "CannedResponse"
+	TypeChat            = "Chat"
+	TypeFailedJob       = "FailedJob"
+	TypeJob             = "Job"
+	TypeMessage         = "Message"
+	TypeMessageDeletion = "MessageDeletion"
+	TypeMessageReaction = "MessageReaction"
+	TypeMessageRevision = "MessageRevision"
+	TypeModerationCase  = "ModerationCase"
+	TypeProblem         = "Problem"
+	TypeVerdictConflict = "VerdictConflict"
 )
 
-// ChatMutation represents an operation that mutates the Chat nodes in the graph.
-type ChatMutation struct {
+// AttachmentMutation represents an operation that mutates the Attachment nodes in the graph.
+type AttachmentMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *types.ChatID
-	client_id       *types.UserID
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	messages        map[types.MessageID]struct{}
-	removedmessages map[types.MessageID]struct{}
-	clearedmessages bool
-	problems        map[types.ProblemID]struct{}
-	removedproblems map[types.ProblemID]struct{}
-	clearedproblems bool
-	done            bool
-	oldValue        func(context.Context) (*Chat, error)
-	predicates      []predicate.Chat
+	op             Op
+	typ            string
+	id             *types.AttachmentID
+	uploaded_by    *types.UserID
+	file_name      *string
+	content_type   *string
+	size           *int64
+	addsize        *int64
+	storage_key    *string
+	thumbnail_key  *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	chat           *types.ChatID
+	clearedchat    bool
+	message        *types.MessageID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*Attachment, error)
+	predicates     []predicate.Attachment
 }
 
-var _ ent.Mutation = (*ChatMutation)(nil)
+var _ ent.Mutation = (*AttachmentMutation)(nil)
 
-// chatOption allows management of the mutation configuration using functional options.
-type chatOption func(*ChatMutation)
+// attachmentOption allows management of the mutation configuration using functional options.
+type attachmentOption func(*AttachmentMutation)
 
-// newChatMutation creates new mutation for the Chat entity.
-func newChatMutation(c config, op Op, opts ...chatOption) *ChatMutation {
-	m := &ChatMutation{
+// newAttachmentMutation creates new mutation for the Attachment entity.
+func newAttachmentMutation(c config, op Op, opts ...attachmentOption) *AttachmentMutation {
+	m := &AttachmentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChat,
+		typ:           TypeAttachment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -75,20 +93,20 @@ func newChatMutation(c config, op Op, opts ...chatOption) *ChatMutation {
 	return m
 }
 
-// withChatID sets the ID field of the mutation.
-func withChatID(id types.ChatID) chatOption {
-	return func(m *ChatMutation) {
+// withAttachmentID sets the ID field of the mutation.
+func withAttachmentID(id types.AttachmentID) attachmentOption {
+	return func(m *AttachmentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Chat
+			value *Attachment
 		)
-		m.oldValue = func(ctx context.Context) (*Chat, error) {
+		m.oldValue = func(ctx context.Context) (*Attachment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Chat.Get(ctx, id)
+					value, err = m.Client().Attachment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -97,10 +115,10 @@ func withChatID(id types.ChatID) chatOption {
 	}
 }
 
-// withChat sets the old Chat of the mutation.
-func withChat(node *Chat) chatOption {
-	return func(m *ChatMutation) {
-		m.oldValue = func(context.Context) (*Chat, error) {
+// withAttachment sets the old Attachment of the mutation.
+func withAttachment(node *Attachment) attachmentOption {
+	return func(m *AttachmentMutation) {
+		m.oldValue = func(context.Context) (*Attachment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -109,7 +127,7 @@ func withChat(node *Chat) chatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChatMutation) Client() *Client {
+func (m AttachmentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -117,7 +135,7 @@ func (m ChatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChatMutation) Tx() (*Tx, error) {
+func (m AttachmentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -127,14 +145,14 @@ func (m ChatMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Chat entities.
-func (m *ChatMutation) SetID(id types.ChatID) {
+// operation is only accepted on creation of Attachment entities.
+func (m *AttachmentMutation) SetID(id types.AttachmentID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChatMutation) ID() (id types.ChatID, exists bool) {
+func (m *AttachmentMutation) ID() (id types.AttachmentID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -145,284 +163,6393 @@ func (m *ChatMutation) ID() (id types.ChatID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChatMutation) IDs(ctx context.Context) ([]types.ChatID, error) {
+func (m *AttachmentMutation) IDs(ctx context.Context) ([]types.AttachmentID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.ChatID{id}, nil
+			return []types.AttachmentID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Chat.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Attachment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetClientID sets the "client_id" field.
-func (m *ChatMutation) SetClientID(ti types.UserID) {
-	m.client_id = &ti
+// SetChatID sets the "chat_id" field.
+func (m *AttachmentMutation) SetChatID(ti types.ChatID) {
+	m.chat = &ti
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *ChatMutation) ClientID() (r types.UserID, exists bool) {
-	v := m.client_id
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *AttachmentMutation) ChatID() (r types.ChatID, exists bool) {
+	v := m.chat
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the Chat entity.
-// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMutation) OldClientID(ctx context.Context) (v types.UserID, err error) {
+func (m *AttachmentMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.ChatID, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *ChatMutation) ResetClientID() {
-	m.client_id = nil
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *AttachmentMutation) ResetChatID() {
+	m.chat = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ChatMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetMessageID sets the "message_id" field.
+func (m *AttachmentMutation) SetMessageID(ti types.MessageID) {
+	m.message = &ti
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ChatMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *AttachmentMutation) MessageID() (r types.MessageID, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Chat entity.
-// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageID returns the old "message_id" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AttachmentMutation) OldMessageID(ctx context.Context) (v types.MessageID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.MessageID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ChatMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearMessageID clears the value of the "message_id" field.
+func (m *AttachmentMutation) ClearMessageID() {
+	m.message = nil
+	m.clearedFields[attachment.FieldMessageID] = struct{}{}
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *ChatMutation) AddMessageIDs(ids ...types.MessageID) {
-	if m.messages == nil {
-		m.messages = make(map[types.MessageID]struct{})
-	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
-	}
+// MessageIDCleared returns if the "message_id" field was cleared in this mutation.
+func (m *AttachmentMutation) MessageIDCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldMessageID]
+	return ok
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *ChatMutation) ClearMessages() {
-	m.clearedmessages = true
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *AttachmentMutation) ResetMessageID() {
+	m.message = nil
+	delete(m.clearedFields, attachment.FieldMessageID)
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *ChatMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// SetUploadedBy sets the "uploaded_by" field.
+func (m *AttachmentMutation) SetUploadedBy(ti types.UserID) {
+	m.uploaded_by = &ti
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *ChatMutation) RemoveMessageIDs(ids ...types.MessageID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[types.MessageID]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+// UploadedBy returns the value of the "uploaded_by" field in the mutation.
+func (m *AttachmentMutation) UploadedBy() (r types.UserID, exists bool) {
+	v := m.uploaded_by
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *ChatMutation) RemovedMessagesIDs() (ids []types.MessageID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// OldUploadedBy returns the old "uploaded_by" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldUploadedBy(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadedBy is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadedBy: %w", err)
+	}
+	return oldValue.UploadedBy, nil
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *ChatMutation) MessagesIDs() (ids []types.MessageID) {
-	for id := range m.messages {
-		ids = append(ids, id)
-	}
-	return
+// ResetUploadedBy resets all changes to the "uploaded_by" field.
+func (m *AttachmentMutation) ResetUploadedBy() {
+	m.uploaded_by = nil
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *ChatMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// SetFileName sets the "file_name" field.
+func (m *AttachmentMutation) SetFileName(s string) {
+	m.file_name = &s
 }
 
-// AddProblemIDs adds the "problems" edge to the Problem entity by ids.
-func (m *ChatMutation) AddProblemIDs(ids ...types.ProblemID) {
-	if m.problems == nil {
-		m.problems = make(map[types.ProblemID]struct{})
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *AttachmentMutation) FileName() (r string, exists bool) {
+	v := m.file_name
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.problems[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldFileName returns the old "file_name" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldFileName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
 	}
+	return oldValue.FileName, nil
 }
 
-// ClearProblems clears the "problems" edge to the Problem entity.
-func (m *ChatMutation) ClearProblems() {
-	m.clearedproblems = true
+// ResetFileName resets all changes to the "file_name" field.
+func (m *AttachmentMutation) ResetFileName() {
+	m.file_name = nil
 }
 
-// ProblemsCleared reports if the "problems" edge to the Problem entity was cleared.
-func (m *ChatMutation) ProblemsCleared() bool {
-	return m.clearedproblems
+// SetContentType sets the "content_type" field.
+func (m *AttachmentMutation) SetContentType(s string) {
+	m.content_type = &s
 }
 
-// RemoveProblemIDs removes the "problems" edge to the Problem entity by IDs.
-func (m *ChatMutation) RemoveProblemIDs(ids ...types.ProblemID) {
-	if m.removedproblems == nil {
-		m.removedproblems = make(map[types.ProblemID]struct{})
-	}
-	for i := range ids {
-		delete(m.problems, ids[i])
-		m.removedproblems[ids[i]] = struct{}{}
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *AttachmentMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedProblems returns the removed IDs of the "problems" edge to the Problem entity.
-func (m *ChatMutation) RemovedProblemsIDs() (ids []types.ProblemID) {
-	for id := range m.removedproblems {
-		ids = append(ids, id)
+// OldContentType returns the old "content_type" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
 }
 
-// ProblemsIDs returns the "problems" edge IDs in the mutation.
-func (m *ChatMutation) ProblemsIDs() (ids []types.ProblemID) {
-	for id := range m.problems {
-		ids = append(ids, id)
+// ResetContentType resets all changes to the "content_type" field.
+func (m *AttachmentMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetSize sets the "size" field.
+func (m *AttachmentMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *AttachmentMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetProblems resets all changes to the "problems" edge.
-func (m *ChatMutation) ResetProblems() {
-	m.problems = nil
-	m.clearedproblems = false
-	m.removedproblems = nil
+// OldSize returns the old "size" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
 }
 
-// Where appends a list predicates to the ChatMutation builder.
-func (m *ChatMutation) Where(ps ...predicate.Chat) {
-	m.predicates = append(m.predicates, ps...)
+// AddSize adds i to the "size" field.
+func (m *AttachmentMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
 }
 
-// WhereP appends storage-level predicates to the ChatMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Chat, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *AttachmentMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ChatMutation) Op() Op {
-	return m.op
+// ResetSize resets all changes to the "size" field.
+func (m *AttachmentMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ChatMutation) SetOp(op Op) {
-	m.op = op
+// SetStorageKey sets the "storage_key" field.
+func (m *AttachmentMutation) SetStorageKey(s string) {
+	m.storage_key = &s
 }
 
-// Type returns the node type of this mutation (Chat).
-func (m *ChatMutation) Type() string {
-	return m.typ
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *AttachmentMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ChatMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.client_id != nil {
-		fields = append(fields, chat.FieldClientID)
+// OldStorageKey returns the old "storage_key" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldStorageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, chat.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
+	}
+	return oldValue.StorageKey, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ChatMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case chat.FieldClientID:
-		return m.ClientID()
-	case chat.FieldCreatedAt:
-		return m.CreatedAt()
-	}
-	return nil, false
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *AttachmentMutation) ResetStorageKey() {
+	m.storage_key = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (m *AttachmentMutation) SetThumbnailKey(s string) {
+	m.thumbnail_key = &s
+}
+
+// ThumbnailKey returns the value of the "thumbnail_key" field in the mutation.
+func (m *AttachmentMutation) ThumbnailKey() (r string, exists bool) {
+	v := m.thumbnail_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailKey returns the old "thumbnail_key" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldThumbnailKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailKey: %w", err)
+	}
+	return oldValue.ThumbnailKey, nil
+}
+
+// ClearThumbnailKey clears the value of the "thumbnail_key" field.
+func (m *AttachmentMutation) ClearThumbnailKey() {
+	m.thumbnail_key = nil
+	m.clearedFields[attachment.FieldThumbnailKey] = struct{}{}
+}
+
+// ThumbnailKeyCleared returns if the "thumbnail_key" field was cleared in this mutation.
+func (m *AttachmentMutation) ThumbnailKeyCleared() bool {
+	_, ok := m.clearedFields[attachment.FieldThumbnailKey]
+	return ok
+}
+
+// ResetThumbnailKey resets all changes to the "thumbnail_key" field.
+func (m *AttachmentMutation) ResetThumbnailKey() {
+	m.thumbnail_key = nil
+	delete(m.clearedFields, attachment.FieldThumbnailKey)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AttachmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AttachmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Attachment entity.
+// If the Attachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AttachmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *AttachmentMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[attachment.FieldChatID] = struct{}{}
+}
+
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *AttachmentMutation) ChatCleared() bool {
+	return m.clearedchat
+}
+
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *AttachmentMutation) ChatIDs() (ids []types.ChatID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChat resets all changes to the "chat" edge.
+func (m *AttachmentMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *AttachmentMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[attachment.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *AttachmentMutation) MessageCleared() bool {
+	return m.MessageIDCleared() || m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *AttachmentMutation) MessageIDs() (ids []types.MessageID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *AttachmentMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the AttachmentMutation builder.
+func (m *AttachmentMutation) Where(ps ...predicate.Attachment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AttachmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AttachmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Attachment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AttachmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AttachmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Attachment).
+func (m *AttachmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AttachmentMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.chat != nil {
+		fields = append(fields, attachment.FieldChatID)
+	}
+	if m.message != nil {
+		fields = append(fields, attachment.FieldMessageID)
+	}
+	if m.uploaded_by != nil {
+		fields = append(fields, attachment.FieldUploadedBy)
+	}
+	if m.file_name != nil {
+		fields = append(fields, attachment.FieldFileName)
+	}
+	if m.content_type != nil {
+		fields = append(fields, attachment.FieldContentType)
+	}
+	if m.size != nil {
+		fields = append(fields, attachment.FieldSize)
+	}
+	if m.storage_key != nil {
+		fields = append(fields, attachment.FieldStorageKey)
+	}
+	if m.thumbnail_key != nil {
+		fields = append(fields, attachment.FieldThumbnailKey)
+	}
+	if m.created_at != nil {
+		fields = append(fields, attachment.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AttachmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case attachment.FieldChatID:
+		return m.ChatID()
+	case attachment.FieldMessageID:
+		return m.MessageID()
+	case attachment.FieldUploadedBy:
+		return m.UploadedBy()
+	case attachment.FieldFileName:
+		return m.FileName()
+	case attachment.FieldContentType:
+		return m.ContentType()
+	case attachment.FieldSize:
+		return m.Size()
+	case attachment.FieldStorageKey:
+		return m.StorageKey()
+	case attachment.FieldThumbnailKey:
+		return m.ThumbnailKey()
+	case attachment.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AttachmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case attachment.FieldChatID:
+		return m.OldChatID(ctx)
+	case attachment.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case attachment.FieldUploadedBy:
+		return m.OldUploadedBy(ctx)
+	case attachment.FieldFileName:
+		return m.OldFileName(ctx)
+	case attachment.FieldContentType:
+		return m.OldContentType(ctx)
+	case attachment.FieldSize:
+		return m.OldSize(ctx)
+	case attachment.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case attachment.FieldThumbnailKey:
+		return m.OldThumbnailKey(ctx)
+	case attachment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Attachment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttachmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case attachment.FieldChatID:
+		v, ok := value.(types.ChatID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case attachment.FieldMessageID:
+		v, ok := value.(types.MessageID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case attachment.FieldUploadedBy:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadedBy(v)
+		return nil
+	case attachment.FieldFileName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileName(v)
+		return nil
+	case attachment.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case attachment.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case attachment.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	case attachment.FieldThumbnailKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailKey(v)
+		return nil
+	case attachment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AttachmentMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, attachment.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AttachmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case attachment.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttachmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case attachment.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AttachmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(attachment.FieldMessageID) {
+		fields = append(fields, attachment.FieldMessageID)
+	}
+	if m.FieldCleared(attachment.FieldThumbnailKey) {
+		fields = append(fields, attachment.FieldThumbnailKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AttachmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AttachmentMutation) ClearField(name string) error {
+	switch name {
+	case attachment.FieldMessageID:
+		m.ClearMessageID()
+		return nil
+	case attachment.FieldThumbnailKey:
+		m.ClearThumbnailKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AttachmentMutation) ResetField(name string) error {
+	switch name {
+	case attachment.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case attachment.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case attachment.FieldUploadedBy:
+		m.ResetUploadedBy()
+		return nil
+	case attachment.FieldFileName:
+		m.ResetFileName()
+		return nil
+	case attachment.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case attachment.FieldSize:
+		m.ResetSize()
+		return nil
+	case attachment.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case attachment.FieldThumbnailKey:
+		m.ResetThumbnailKey()
+		return nil
+	case attachment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AttachmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.chat != nil {
+		edges = append(edges, attachment.EdgeChat)
+	}
+	if m.message != nil {
+		edges = append(edges, attachment.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AttachmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case attachment.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case attachment.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AttachmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AttachmentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AttachmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedchat {
+		edges = append(edges, attachment.EdgeChat)
+	}
+	if m.clearedmessage {
+		edges = append(edges, attachment.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AttachmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case attachment.EdgeChat:
+		return m.clearedchat
+	case attachment.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AttachmentMutation) ClearEdge(name string) error {
+	switch name {
+	case attachment.EdgeChat:
+		m.ClearChat()
+		return nil
+	case attachment.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AttachmentMutation) ResetEdge(name string) error {
+	switch name {
+	case attachment.EdgeChat:
+		m.ResetChat()
+		return nil
+	case attachment.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Attachment edge %s", name)
+}
+
+// CannedResponseMutation represents an operation that mutates the CannedResponse nodes in the graph.
+type CannedResponseMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *types.CannedResponseID
+	author_id     *types.UserID
+	is_shared     *bool
+	shortcut      *string
+	body          *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*CannedResponse, error)
+	predicates    []predicate.CannedResponse
+}
+
+var _ ent.Mutation = (*CannedResponseMutation)(nil)
+
+// cannedresponseOption allows management of the mutation configuration using functional options.
+type cannedresponseOption func(*CannedResponseMutation)
+
+// newCannedResponseMutation creates new mutation for the CannedResponse entity.
+func newCannedResponseMutation(c config, op Op, opts ...cannedresponseOption) *CannedResponseMutation {
+	m := &CannedResponseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCannedResponse,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCannedResponseID sets the ID field of the mutation.
+func withCannedResponseID(id types.CannedResponseID) cannedresponseOption {
+	return func(m *CannedResponseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CannedResponse
+		)
+		m.oldValue = func(ctx context.Context) (*CannedResponse, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CannedResponse.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCannedResponse sets the old CannedResponse of the mutation.
+func withCannedResponse(node *CannedResponse) cannedresponseOption {
+	return func(m *CannedResponseMutation) {
+		m.oldValue = func(context.Context) (*CannedResponse, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CannedResponseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CannedResponseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CannedResponse entities.
+func (m *CannedResponseMutation) SetID(id types.CannedResponseID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CannedResponseMutation) ID() (id types.CannedResponseID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CannedResponseMutation) IDs(ctx context.Context) ([]types.CannedResponseID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.CannedResponseID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CannedResponse.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *CannedResponseMutation) SetAuthorID(ti types.UserID) {
+	m.author_id = &ti
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *CannedResponseMutation) AuthorID() (r types.UserID, exists bool) {
+	v := m.author_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorID returns the old "author_id" field's value of the CannedResponse entity.
+// If the CannedResponse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CannedResponseMutation) OldAuthorID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+	}
+	return oldValue.AuthorID, nil
+}
+
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *CannedResponseMutation) ResetAuthorID() {
+	m.author_id = nil
+}
+
+// SetIsShared sets the "is_shared" field.
+func (m *CannedResponseMutation) SetIsShared(b bool) {
+	m.is_shared = &b
+}
+
+// IsShared returns the value of the "is_shared" field in the mutation.
+func (m *CannedResponseMutation) IsShared() (r bool, exists bool) {
+	v := m.is_shared
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsShared returns the old "is_shared" field's value of the CannedResponse entity.
+// If the CannedResponse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CannedResponseMutation) OldIsShared(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsShared is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsShared requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsShared: %w", err)
+	}
+	return oldValue.IsShared, nil
+}
+
+// ResetIsShared resets all changes to the "is_shared" field.
+func (m *CannedResponseMutation) ResetIsShared() {
+	m.is_shared = nil
+}
+
+// SetShortcut sets the "shortcut" field.
+func (m *CannedResponseMutation) SetShortcut(s string) {
+	m.shortcut = &s
+}
+
+// Shortcut returns the value of the "shortcut" field in the mutation.
+func (m *CannedResponseMutation) Shortcut() (r string, exists bool) {
+	v := m.shortcut
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShortcut returns the old "shortcut" field's value of the CannedResponse entity.
+// If the CannedResponse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CannedResponseMutation) OldShortcut(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShortcut is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShortcut requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShortcut: %w", err)
+	}
+	return oldValue.Shortcut, nil
+}
+
+// ResetShortcut resets all changes to the "shortcut" field.
+func (m *CannedResponseMutation) ResetShortcut() {
+	m.shortcut = nil
+}
+
+// SetBody sets the "body" field.
+func (m *CannedResponseMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *CannedResponseMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the CannedResponse entity.
+// If the CannedResponse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CannedResponseMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *CannedResponseMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CannedResponseMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CannedResponseMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CannedResponse entity.
+// If the CannedResponse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CannedResponseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CannedResponseMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CannedResponseMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CannedResponseMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CannedResponse entity.
+// If the CannedResponse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CannedResponseMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CannedResponseMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the CannedResponseMutation builder.
+func (m *CannedResponseMutation) Where(ps ...predicate.CannedResponse) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CannedResponseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CannedResponseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CannedResponse, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CannedResponseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CannedResponseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CannedResponse).
+func (m *CannedResponseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CannedResponseMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.author_id != nil {
+		fields = append(fields, cannedresponse.FieldAuthorID)
+	}
+	if m.is_shared != nil {
+		fields = append(fields, cannedresponse.FieldIsShared)
+	}
+	if m.shortcut != nil {
+		fields = append(fields, cannedresponse.FieldShortcut)
+	}
+	if m.body != nil {
+		fields = append(fields, cannedresponse.FieldBody)
+	}
+	if m.created_at != nil {
+		fields = append(fields, cannedresponse.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, cannedresponse.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CannedResponseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cannedresponse.FieldAuthorID:
+		return m.AuthorID()
+	case cannedresponse.FieldIsShared:
+		return m.IsShared()
+	case cannedresponse.FieldShortcut:
+		return m.Shortcut()
+	case cannedresponse.FieldBody:
+		return m.Body()
+	case cannedresponse.FieldCreatedAt:
+		return m.CreatedAt()
+	case cannedresponse.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CannedResponseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cannedresponse.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case cannedresponse.FieldIsShared:
+		return m.OldIsShared(ctx)
+	case cannedresponse.FieldShortcut:
+		return m.OldShortcut(ctx)
+	case cannedresponse.FieldBody:
+		return m.OldBody(ctx)
+	case cannedresponse.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case cannedresponse.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CannedResponse field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CannedResponseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cannedresponse.FieldAuthorID:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case cannedresponse.FieldIsShared:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsShared(v)
+		return nil
+	case cannedresponse.FieldShortcut:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShortcut(v)
+		return nil
+	case cannedresponse.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case cannedresponse.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case cannedresponse.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CannedResponse field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CannedResponseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CannedResponseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CannedResponseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CannedResponse numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CannedResponseMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CannedResponseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CannedResponseMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CannedResponse nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CannedResponseMutation) ResetField(name string) error {
+	switch name {
+	case cannedresponse.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case cannedresponse.FieldIsShared:
+		m.ResetIsShared()
+		return nil
+	case cannedresponse.FieldShortcut:
+		m.ResetShortcut()
+		return nil
+	case cannedresponse.FieldBody:
+		m.ResetBody()
+		return nil
+	case cannedresponse.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case cannedresponse.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CannedResponse field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CannedResponseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CannedResponseMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CannedResponseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CannedResponseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CannedResponseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CannedResponseMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CannedResponseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CannedResponse unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CannedResponseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CannedResponse edge %s", name)
+}
+
+// ChatMutation represents an operation that mutates the Chat nodes in the graph.
+type ChatMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *types.ChatID
+	client_id          *types.UserID
+	client_name        *string
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	attachments        map[types.AttachmentID]struct{}
+	removedattachments map[types.AttachmentID]struct{}
+	clearedattachments bool
+	messages           map[types.MessageID]struct{}
+	removedmessages    map[types.MessageID]struct{}
+	clearedmessages    bool
+	problems           map[types.ProblemID]struct{}
+	removedproblems    map[types.ProblemID]struct{}
+	clearedproblems    bool
+	done               bool
+	oldValue           func(context.Context) (*Chat, error)
+	predicates         []predicate.Chat
+}
+
+var _ ent.Mutation = (*ChatMutation)(nil)
+
+// chatOption allows management of the mutation configuration using functional options.
+type chatOption func(*ChatMutation)
+
+// newChatMutation creates new mutation for the Chat entity.
+func newChatMutation(c config, op Op, opts ...chatOption) *ChatMutation {
+	m := &ChatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChatID sets the ID field of the mutation.
+func withChatID(id types.ChatID) chatOption {
+	return func(m *ChatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Chat
+		)
+		m.oldValue = func(ctx context.Context) (*Chat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Chat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChat sets the old Chat of the mutation.
+func withChat(node *Chat) chatOption {
+	return func(m *ChatMutation) {
+		m.oldValue = func(context.Context) (*Chat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Chat entities.
+func (m *ChatMutation) SetID(id types.ChatID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChatMutation) ID() (id types.ChatID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChatMutation) IDs(ctx context.Context) ([]types.ChatID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.ChatID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Chat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetClientID sets the "client_id" field.
+func (m *ChatMutation) SetClientID(ti types.UserID) {
+	m.client_id = &ti
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *ChatMutation) ClientID() (r types.UserID, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMutation) OldClientID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *ChatMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetClientName sets the "client_name" field.
+func (m *ChatMutation) SetClientName(s string) {
+	m.client_name = &s
+}
+
+// ClientName returns the value of the "client_name" field in the mutation.
+func (m *ChatMutation) ClientName() (r string, exists bool) {
+	v := m.client_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientName returns the old "client_name" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMutation) OldClientName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientName: %w", err)
+	}
+	return oldValue.ClientName, nil
+}
+
+// ClearClientName clears the value of the "client_name" field.
+func (m *ChatMutation) ClearClientName() {
+	m.client_name = nil
+	m.clearedFields[chat.FieldClientName] = struct{}{}
+}
+
+// ClientNameCleared returns if the "client_name" field was cleared in this mutation.
+func (m *ChatMutation) ClientNameCleared() bool {
+	_, ok := m.clearedFields[chat.FieldClientName]
+	return ok
+}
+
+// ResetClientName resets all changes to the "client_name" field.
+func (m *ChatMutation) ResetClientName() {
+	m.client_name = nil
+	delete(m.clearedFields, chat.FieldClientName)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChatMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChatMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChatMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *ChatMutation) AddAttachmentIDs(ids ...types.AttachmentID) {
+	if m.attachments == nil {
+		m.attachments = make(map[types.AttachmentID]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *ChatMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *ChatMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *ChatMutation) RemoveAttachmentIDs(ids ...types.AttachmentID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[types.AttachmentID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *ChatMutation) RemovedAttachmentsIDs() (ids []types.AttachmentID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *ChatMutation) AttachmentsIDs() (ids []types.AttachmentID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *ChatMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *ChatMutation) AddMessageIDs(ids ...types.MessageID) {
+	if m.messages == nil {
+		m.messages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *ChatMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *ChatMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *ChatMutation) RemoveMessageIDs(ids ...types.MessageID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *ChatMutation) RemovedMessagesIDs() (ids []types.MessageID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *ChatMutation) MessagesIDs() (ids []types.MessageID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *ChatMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddProblemIDs adds the "problems" edge to the Problem entity by ids.
+func (m *ChatMutation) AddProblemIDs(ids ...types.ProblemID) {
+	if m.problems == nil {
+		m.problems = make(map[types.ProblemID]struct{})
+	}
+	for i := range ids {
+		m.problems[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProblems clears the "problems" edge to the Problem entity.
+func (m *ChatMutation) ClearProblems() {
+	m.clearedproblems = true
+}
+
+// ProblemsCleared reports if the "problems" edge to the Problem entity was cleared.
+func (m *ChatMutation) ProblemsCleared() bool {
+	return m.clearedproblems
+}
+
+// RemoveProblemIDs removes the "problems" edge to the Problem entity by IDs.
+func (m *ChatMutation) RemoveProblemIDs(ids ...types.ProblemID) {
+	if m.removedproblems == nil {
+		m.removedproblems = make(map[types.ProblemID]struct{})
+	}
+	for i := range ids {
+		delete(m.problems, ids[i])
+		m.removedproblems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProblems returns the removed IDs of the "problems" edge to the Problem entity.
+func (m *ChatMutation) RemovedProblemsIDs() (ids []types.ProblemID) {
+	for id := range m.removedproblems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProblemsIDs returns the "problems" edge IDs in the mutation.
+func (m *ChatMutation) ProblemsIDs() (ids []types.ProblemID) {
+	for id := range m.problems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProblems resets all changes to the "problems" edge.
+func (m *ChatMutation) ResetProblems() {
+	m.problems = nil
+	m.clearedproblems = false
+	m.removedproblems = nil
+}
+
+// Where appends a list predicates to the ChatMutation builder.
+func (m *ChatMutation) Where(ps ...predicate.Chat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Chat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Chat).
+func (m *ChatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChatMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.client_id != nil {
+		fields = append(fields, chat.FieldClientID)
+	}
+	if m.client_name != nil {
+		fields = append(fields, chat.FieldClientName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, chat.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chat.FieldClientID:
+		return m.ClientID()
+	case chat.FieldClientName:
+		return m.ClientName()
+	case chat.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chat.FieldClientID:
+		return m.OldClientID(ctx)
+	case chat.FieldClientName:
+		return m.OldClientName(ctx)
+	case chat.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Chat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chat.FieldClientID:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case chat.FieldClientName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientName(v)
+		return nil
+	case chat.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Chat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChatMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChatMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Chat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChatMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(chat.FieldClientName) {
+		fields = append(fields, chat.FieldClientName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChatMutation) ClearField(name string) error {
+	switch name {
+	case chat.FieldClientName:
+		m.ClearClientName()
+		return nil
+	}
+	return fmt.Errorf("unknown Chat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChatMutation) ResetField(name string) error {
+	switch name {
+	case chat.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case chat.FieldClientName:
+		m.ResetClientName()
+		return nil
+	case chat.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Chat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.attachments != nil {
+		edges = append(edges, chat.EdgeAttachments)
+	}
+	if m.messages != nil {
+		edges = append(edges, chat.EdgeMessages)
+	}
+	if m.problems != nil {
+		edges = append(edges, chat.EdgeProblems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case chat.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeProblems:
+		ids := make([]ent.Value, 0, len(m.problems))
+		for id := range m.problems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedattachments != nil {
+		edges = append(edges, chat.EdgeAttachments)
+	}
+	if m.removedmessages != nil {
+		edges = append(edges, chat.EdgeMessages)
+	}
+	if m.removedproblems != nil {
+		edges = append(edges, chat.EdgeProblems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChatMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case chat.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeProblems:
+		ids := make([]ent.Value, 0, len(m.removedproblems))
+		for id := range m.removedproblems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedattachments {
+		edges = append(edges, chat.EdgeAttachments)
+	}
+	if m.clearedmessages {
+		edges = append(edges, chat.EdgeMessages)
+	}
+	if m.clearedproblems {
+		edges = append(edges, chat.EdgeProblems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case chat.EdgeAttachments:
+		return m.clearedattachments
+	case chat.EdgeMessages:
+		return m.clearedmessages
+	case chat.EdgeProblems:
+		return m.clearedproblems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChatMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Chat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChatMutation) ResetEdge(name string) error {
+	switch name {
+	case chat.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	case chat.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	case chat.EdgeProblems:
+		m.ResetProblems()
+		return nil
+	}
+	return fmt.Errorf("unknown Chat edge %s", name)
+}
+
+// FailedJobMutation represents an operation that mutates the FailedJob nodes in the graph.
+type FailedJobMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *types.FailedJobID
+	name          *string
+	payload       *string
+	reason        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FailedJob, error)
+	predicates    []predicate.FailedJob
+}
+
+var _ ent.Mutation = (*FailedJobMutation)(nil)
+
+// failedjobOption allows management of the mutation configuration using functional options.
+type failedjobOption func(*FailedJobMutation)
+
+// newFailedJobMutation creates new mutation for the FailedJob entity.
+func newFailedJobMutation(c config, op Op, opts ...failedjobOption) *FailedJobMutation {
+	m := &FailedJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFailedJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFailedJobID sets the ID field of the mutation.
+func withFailedJobID(id types.FailedJobID) failedjobOption {
+	return func(m *FailedJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FailedJob
+		)
+		m.oldValue = func(ctx context.Context) (*FailedJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FailedJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFailedJob sets the old FailedJob of the mutation.
+func withFailedJob(node *FailedJob) failedjobOption {
+	return func(m *FailedJobMutation) {
+		m.oldValue = func(context.Context) (*FailedJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FailedJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FailedJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FailedJob entities.
+func (m *FailedJobMutation) SetID(id types.FailedJobID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FailedJobMutation) ID() (id types.FailedJobID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FailedJobMutation) IDs(ctx context.Context) ([]types.FailedJobID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.FailedJobID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FailedJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *FailedJobMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FailedJobMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedJobMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FailedJobMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *FailedJobMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *FailedJobMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedJobMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *FailedJobMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *FailedJobMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *FailedJobMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedJobMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *FailedJobMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FailedJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FailedJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FailedJobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the FailedJobMutation builder.
+func (m *FailedJobMutation) Where(ps ...predicate.FailedJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FailedJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FailedJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FailedJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FailedJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FailedJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FailedJob).
+func (m *FailedJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FailedJobMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, failedjob.FieldName)
+	}
+	if m.payload != nil {
+		fields = append(fields, failedjob.FieldPayload)
+	}
+	if m.reason != nil {
+		fields = append(fields, failedjob.FieldReason)
+	}
+	if m.created_at != nil {
+		fields = append(fields, failedjob.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FailedJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case failedjob.FieldName:
+		return m.Name()
+	case failedjob.FieldPayload:
+		return m.Payload()
+	case failedjob.FieldReason:
+		return m.Reason()
+	case failedjob.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FailedJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case failedjob.FieldName:
+		return m.OldName(ctx)
+	case failedjob.FieldPayload:
+		return m.OldPayload(ctx)
+	case failedjob.FieldReason:
+		return m.OldReason(ctx)
+	case failedjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FailedJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case failedjob.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case failedjob.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case failedjob.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case failedjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FailedJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FailedJobMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FailedJobMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FailedJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FailedJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FailedJobMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FailedJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FailedJobMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FailedJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FailedJobMutation) ResetField(name string) error {
+	switch name {
+	case failedjob.FieldName:
+		m.ResetName()
+		return nil
+	case failedjob.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case failedjob.FieldReason:
+		m.ResetReason()
+		return nil
+	case failedjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FailedJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FailedJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FailedJobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FailedJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FailedJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FailedJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FailedJobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FailedJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FailedJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FailedJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FailedJob edge %s", name)
+}
+
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *types.JobID
+	name           *string
+	payload        *string
+	attempts       *int
+	addattempts    *int
+	available_at   *time.Time
+	reserved_until *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Job, error)
+	predicates     []predicate.Job
+}
+
+var _ ent.Mutation = (*JobMutation)(nil)
+
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobID sets the ID field of the mutation.
+func withJobID(id types.JobID) jobOption {
+	return func(m *JobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Job
+		)
+		m.oldValue = func(ctx context.Context) (*Job, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Job.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id types.JobID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobMutation) ID() (id types.JobID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobMutation) IDs(ctx context.Context) ([]types.JobID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.JobID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *JobMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *JobMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *JobMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *JobMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *JobMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *JobMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (m *JobMutation) SetAvailableAt(t time.Time) {
+	m.available_at = &t
+}
+
+// AvailableAt returns the value of the "available_at" field in the mutation.
+func (m *JobMutation) AvailableAt() (r time.Time, exists bool) {
+	v := m.available_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvailableAt returns the old "available_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAvailableAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvailableAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvailableAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvailableAt: %w", err)
+	}
+	return oldValue.AvailableAt, nil
+}
+
+// ResetAvailableAt resets all changes to the "available_at" field.
+func (m *JobMutation) ResetAvailableAt() {
+	m.available_at = nil
+}
+
+// SetReservedUntil sets the "reserved_until" field.
+func (m *JobMutation) SetReservedUntil(t time.Time) {
+	m.reserved_until = &t
+}
+
+// ReservedUntil returns the value of the "reserved_until" field in the mutation.
+func (m *JobMutation) ReservedUntil() (r time.Time, exists bool) {
+	v := m.reserved_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReservedUntil returns the old "reserved_until" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldReservedUntil(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReservedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReservedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReservedUntil: %w", err)
+	}
+	return oldValue.ReservedUntil, nil
+}
+
+// ResetReservedUntil resets all changes to the "reserved_until" field.
+func (m *JobMutation) ResetReservedUntil() {
+	m.reserved_until = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, job.FieldName)
+	}
+	if m.payload != nil {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.available_at != nil {
+		fields = append(fields, job.FieldAvailableAt)
+	}
+	if m.reserved_until != nil {
+		fields = append(fields, job.FieldReservedUntil)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldName:
+		return m.Name()
+	case job.FieldPayload:
+		return m.Payload()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldAvailableAt:
+		return m.AvailableAt()
+	case job.FieldReservedUntil:
+		return m.ReservedUntil()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldName:
+		return m.OldName(ctx)
+	case job.FieldPayload:
+		return m.OldPayload(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldAvailableAt:
+		return m.OldAvailableAt(ctx)
+	case job.FieldReservedUntil:
+		return m.OldReservedUntil(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case job.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldAvailableAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvailableAt(v)
+		return nil
+	case job.FieldReservedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReservedUntil(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldName:
+		m.ResetName()
+		return nil
+	case job.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldAvailableAt:
+		m.ResetAvailableAt()
+		return nil
+	case job.FieldReservedUntil:
+		m.ResetReservedUntil()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *types.MessageID
+	author_id                *types.UserID
+	is_visible_for_client    *bool
+	is_visible_for_manager   *bool
+	body                     *string
+	edited_at                *time.Time
+	deleted_at               *time.Time
+	checked_at               *time.Time
+	verdict                  *message.Verdict
+	verdict_source           *message.VerdictSource
+	is_blocked               *bool
+	is_service               *bool
+	is_internal_note         *bool
+	initial_request_id       *types.RequestID
+	content_request_id       *types.RequestID
+	created_at               *time.Time
+	clearedFields            map[string]struct{}
+	chat                     *types.ChatID
+	clearedchat              bool
+	problem                  *types.ProblemID
+	clearedproblem           bool
+	moderation_case          *types.ModerationCaseID
+	clearedmoderation_case   bool
+	verdict_conflicts        map[types.VerdictConflictID]struct{}
+	removedverdict_conflicts map[types.VerdictConflictID]struct{}
+	clearedverdict_conflicts bool
+	revisions                map[types.MessageRevisionID]struct{}
+	removedrevisions         map[types.MessageRevisionID]struct{}
+	clearedrevisions         bool
+	deletion                 *types.MessageDeletionID
+	cleareddeletion          bool
+	attachments              map[types.AttachmentID]struct{}
+	removedattachments       map[types.AttachmentID]struct{}
+	clearedattachments       bool
+	reactions                map[types.MessageReactionID]struct{}
+	removedreactions         map[types.MessageReactionID]struct{}
+	clearedreactions         bool
+	reply_to                 *types.MessageID
+	clearedreply_to          bool
+	replies                  map[types.MessageID]struct{}
+	removedreplies           map[types.MessageID]struct{}
+	clearedreplies           bool
+	done                     bool
+	oldValue                 func(context.Context) (*Message, error)
+	predicates               []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id types.MessageID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id types.MessageID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]types.MessageID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.MessageID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *MessageMutation) SetChatID(ti types.ChatID) {
+	m.chat = &ti
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *MessageMutation) ChatID() (r types.ChatID, exists bool) {
+	v := m.chat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *MessageMutation) ResetChatID() {
+	m.chat = nil
+}
+
+// SetProblemID sets the "problem_id" field.
+func (m *MessageMutation) SetProblemID(ti types.ProblemID) {
+	m.problem = &ti
+}
+
+// ProblemID returns the value of the "problem_id" field in the mutation.
+func (m *MessageMutation) ProblemID() (r types.ProblemID, exists bool) {
+	v := m.problem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProblemID returns the old "problem_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldProblemID(ctx context.Context) (v types.ProblemID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProblemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+	}
+	return oldValue.ProblemID, nil
+}
+
+// ResetProblemID resets all changes to the "problem_id" field.
+func (m *MessageMutation) ResetProblemID() {
+	m.problem = nil
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *MessageMutation) SetAuthorID(ti types.UserID) {
+	m.author_id = &ti
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *MessageMutation) AuthorID() (r types.UserID, exists bool) {
+	v := m.author_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorID returns the old "author_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAuthorID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+	}
+	return oldValue.AuthorID, nil
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (m *MessageMutation) ClearAuthorID() {
+	m.author_id = nil
+	m.clearedFields[message.FieldAuthorID] = struct{}{}
+}
+
+// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
+func (m *MessageMutation) AuthorIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldAuthorID]
+	return ok
+}
+
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *MessageMutation) ResetAuthorID() {
+	m.author_id = nil
+	delete(m.clearedFields, message.FieldAuthorID)
+}
+
+// SetIsVisibleForClient sets the "is_visible_for_client" field.
+func (m *MessageMutation) SetIsVisibleForClient(b bool) {
+	m.is_visible_for_client = &b
+}
+
+// IsVisibleForClient returns the value of the "is_visible_for_client" field in the mutation.
+func (m *MessageMutation) IsVisibleForClient() (r bool, exists bool) {
+	v := m.is_visible_for_client
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVisibleForClient returns the old "is_visible_for_client" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsVisibleForClient(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVisibleForClient is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVisibleForClient requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVisibleForClient: %w", err)
+	}
+	return oldValue.IsVisibleForClient, nil
+}
+
+// ResetIsVisibleForClient resets all changes to the "is_visible_for_client" field.
+func (m *MessageMutation) ResetIsVisibleForClient() {
+	m.is_visible_for_client = nil
+}
+
+// SetIsVisibleForManager sets the "is_visible_for_manager" field.
+func (m *MessageMutation) SetIsVisibleForManager(b bool) {
+	m.is_visible_for_manager = &b
+}
+
+// IsVisibleForManager returns the value of the "is_visible_for_manager" field in the mutation.
+func (m *MessageMutation) IsVisibleForManager() (r bool, exists bool) {
+	v := m.is_visible_for_manager
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVisibleForManager returns the old "is_visible_for_manager" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsVisibleForManager(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVisibleForManager is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVisibleForManager requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVisibleForManager: %w", err)
+	}
+	return oldValue.IsVisibleForManager, nil
+}
+
+// ResetIsVisibleForManager resets all changes to the "is_visible_for_manager" field.
+func (m *MessageMutation) ResetIsVisibleForManager() {
+	m.is_visible_for_manager = nil
+}
+
+// SetBody sets the "body" field.
+func (m *MessageMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *MessageMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *MessageMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *MessageMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *MessageMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEditedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *MessageMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[message.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *MessageMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *MessageMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, message.FieldEditedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *MessageMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *MessageMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[message.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *MessageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *MessageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, message.FieldDeletedAt)
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (m *MessageMutation) SetCheckedAt(t time.Time) {
+	m.checked_at = &t
+}
+
+// CheckedAt returns the value of the "checked_at" field in the mutation.
+func (m *MessageMutation) CheckedAt() (r time.Time, exists bool) {
+	v := m.checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedAt returns the old "checked_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+	}
+	return oldValue.CheckedAt, nil
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (m *MessageMutation) ClearCheckedAt() {
+	m.checked_at = nil
+	m.clearedFields[message.FieldCheckedAt] = struct{}{}
+}
+
+// CheckedAtCleared returns if the "checked_at" field was cleared in this mutation.
+func (m *MessageMutation) CheckedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldCheckedAt]
+	return ok
+}
+
+// ResetCheckedAt resets all changes to the "checked_at" field.
+func (m *MessageMutation) ResetCheckedAt() {
+	m.checked_at = nil
+	delete(m.clearedFields, message.FieldCheckedAt)
+}
+
+// SetVerdict sets the "verdict" field.
+func (m *MessageMutation) SetVerdict(value message.Verdict) {
+	m.verdict = &value
+}
+
+// Verdict returns the value of the "verdict" field in the mutation.
+func (m *MessageMutation) Verdict() (r message.Verdict, exists bool) {
+	v := m.verdict
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerdict returns the old "verdict" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldVerdict(ctx context.Context) (v message.Verdict, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerdict is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerdict requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerdict: %w", err)
+	}
+	return oldValue.Verdict, nil
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (m *MessageMutation) ClearVerdict() {
+	m.verdict = nil
+	m.clearedFields[message.FieldVerdict] = struct{}{}
+}
+
+// VerdictCleared returns if the "verdict" field was cleared in this mutation.
+func (m *MessageMutation) VerdictCleared() bool {
+	_, ok := m.clearedFields[message.FieldVerdict]
+	return ok
+}
+
+// ResetVerdict resets all changes to the "verdict" field.
+func (m *MessageMutation) ResetVerdict() {
+	m.verdict = nil
+	delete(m.clearedFields, message.FieldVerdict)
+}
+
+// SetVerdictSource sets the "verdict_source" field.
+func (m *MessageMutation) SetVerdictSource(ms message.VerdictSource) {
+	m.verdict_source = &ms
+}
+
+// VerdictSource returns the value of the "verdict_source" field in the mutation.
+func (m *MessageMutation) VerdictSource() (r message.VerdictSource, exists bool) {
+	v := m.verdict_source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerdictSource returns the old "verdict_source" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldVerdictSource(ctx context.Context) (v message.VerdictSource, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerdictSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerdictSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerdictSource: %w", err)
+	}
+	return oldValue.VerdictSource, nil
+}
+
+// ClearVerdictSource clears the value of the "verdict_source" field.
+func (m *MessageMutation) ClearVerdictSource() {
+	m.verdict_source = nil
+	m.clearedFields[message.FieldVerdictSource] = struct{}{}
+}
+
+// VerdictSourceCleared returns if the "verdict_source" field was cleared in this mutation.
+func (m *MessageMutation) VerdictSourceCleared() bool {
+	_, ok := m.clearedFields[message.FieldVerdictSource]
+	return ok
+}
+
+// ResetVerdictSource resets all changes to the "verdict_source" field.
+func (m *MessageMutation) ResetVerdictSource() {
+	m.verdict_source = nil
+	delete(m.clearedFields, message.FieldVerdictSource)
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (m *MessageMutation) SetIsBlocked(b bool) {
+	m.is_blocked = &b
+}
+
+// IsBlocked returns the value of the "is_blocked" field in the mutation.
+func (m *MessageMutation) IsBlocked() (r bool, exists bool) {
+	v := m.is_blocked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBlocked returns the old "is_blocked" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+	}
+	return oldValue.IsBlocked, nil
+}
+
+// ResetIsBlocked resets all changes to the "is_blocked" field.
+func (m *MessageMutation) ResetIsBlocked() {
+	m.is_blocked = nil
+}
+
+// SetIsService sets the "is_service" field.
+func (m *MessageMutation) SetIsService(b bool) {
+	m.is_service = &b
+}
+
+// IsService returns the value of the "is_service" field in the mutation.
+func (m *MessageMutation) IsService() (r bool, exists bool) {
+	v := m.is_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsService returns the old "is_service" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsService(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsService: %w", err)
+	}
+	return oldValue.IsService, nil
+}
+
+// ResetIsService resets all changes to the "is_service" field.
+func (m *MessageMutation) ResetIsService() {
+	m.is_service = nil
+}
+
+// SetIsInternalNote sets the "is_internal_note" field.
+func (m *MessageMutation) SetIsInternalNote(b bool) {
+	m.is_internal_note = &b
+}
+
+// IsInternalNote returns the value of the "is_internal_note" field in the mutation.
+func (m *MessageMutation) IsInternalNote() (r bool, exists bool) {
+	v := m.is_internal_note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsInternalNote returns the old "is_internal_note" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsInternalNote(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsInternalNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsInternalNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsInternalNote: %w", err)
+	}
+	return oldValue.IsInternalNote, nil
+}
+
+// ResetIsInternalNote resets all changes to the "is_internal_note" field.
+func (m *MessageMutation) ResetIsInternalNote() {
+	m.is_internal_note = nil
+}
+
+// SetInitialRequestID sets the "initial_request_id" field.
+func (m *MessageMutation) SetInitialRequestID(ti types.RequestID) {
+	m.initial_request_id = &ti
+}
+
+// InitialRequestID returns the value of the "initial_request_id" field in the mutation.
+func (m *MessageMutation) InitialRequestID() (r types.RequestID, exists bool) {
+	v := m.initial_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInitialRequestID returns the old "initial_request_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldInitialRequestID(ctx context.Context) (v types.RequestID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInitialRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInitialRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitialRequestID: %w", err)
+	}
+	return oldValue.InitialRequestID, nil
+}
+
+// ResetInitialRequestID resets all changes to the "initial_request_id" field.
+func (m *MessageMutation) ResetInitialRequestID() {
+	m.initial_request_id = nil
+}
+
+// SetContentRequestID sets the "content_request_id" field.
+func (m *MessageMutation) SetContentRequestID(ti types.RequestID) {
+	m.content_request_id = &ti
+}
+
+// ContentRequestID returns the value of the "content_request_id" field in the mutation.
+func (m *MessageMutation) ContentRequestID() (r types.RequestID, exists bool) {
+	v := m.content_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentRequestID returns the old "content_request_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContentRequestID(ctx context.Context) (v types.RequestID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentRequestID: %w", err)
+	}
+	return oldValue.ContentRequestID, nil
+}
+
+// ClearContentRequestID clears the value of the "content_request_id" field.
+func (m *MessageMutation) ClearContentRequestID() {
+	m.content_request_id = nil
+	m.clearedFields[message.FieldContentRequestID] = struct{}{}
+}
+
+// ContentRequestIDCleared returns if the "content_request_id" field was cleared in this mutation.
+func (m *MessageMutation) ContentRequestIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldContentRequestID]
+	return ok
+}
+
+// ResetContentRequestID resets all changes to the "content_request_id" field.
+func (m *MessageMutation) ResetContentRequestID() {
+	m.content_request_id = nil
+	delete(m.clearedFields, message.FieldContentRequestID)
+}
+
+// SetReplyToMessageID sets the "reply_to_message_id" field.
+func (m *MessageMutation) SetReplyToMessageID(ti types.MessageID) {
+	m.reply_to = &ti
+}
+
+// ReplyToMessageID returns the value of the "reply_to_message_id" field in the mutation.
+func (m *MessageMutation) ReplyToMessageID() (r types.MessageID, exists bool) {
+	v := m.reply_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToMessageID returns the old "reply_to_message_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReplyToMessageID(ctx context.Context) (v types.MessageID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToMessageID: %w", err)
+	}
+	return oldValue.ReplyToMessageID, nil
+}
+
+// ClearReplyToMessageID clears the value of the "reply_to_message_id" field.
+func (m *MessageMutation) ClearReplyToMessageID() {
+	m.reply_to = nil
+	m.clearedFields[message.FieldReplyToMessageID] = struct{}{}
+}
+
+// ReplyToMessageIDCleared returns if the "reply_to_message_id" field was cleared in this mutation.
+func (m *MessageMutation) ReplyToMessageIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldReplyToMessageID]
+	return ok
+}
+
+// ResetReplyToMessageID resets all changes to the "reply_to_message_id" field.
+func (m *MessageMutation) ResetReplyToMessageID() {
+	m.reply_to = nil
+	delete(m.clearedFields, message.FieldReplyToMessageID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *MessageMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[message.FieldChatID] = struct{}{}
+}
+
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *MessageMutation) ChatCleared() bool {
+	return m.clearedchat
+}
+
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ChatIDs() (ids []types.ChatID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChat resets all changes to the "chat" edge.
+func (m *MessageMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (m *MessageMutation) ClearProblem() {
+	m.clearedproblem = true
+	m.clearedFields[message.FieldProblemID] = struct{}{}
+}
+
+// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
+func (m *MessageMutation) ProblemCleared() bool {
+	return m.clearedproblem
+}
+
+// ProblemIDs returns the "problem" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProblemID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ProblemIDs() (ids []types.ProblemID) {
+	if id := m.problem; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProblem resets all changes to the "problem" edge.
+func (m *MessageMutation) ResetProblem() {
+	m.problem = nil
+	m.clearedproblem = false
+}
+
+// SetModerationCaseID sets the "moderation_case" edge to the ModerationCase entity by id.
+func (m *MessageMutation) SetModerationCaseID(id types.ModerationCaseID) {
+	m.moderation_case = &id
+}
+
+// ClearModerationCase clears the "moderation_case" edge to the ModerationCase entity.
+func (m *MessageMutation) ClearModerationCase() {
+	m.clearedmoderation_case = true
+}
+
+// ModerationCaseCleared reports if the "moderation_case" edge to the ModerationCase entity was cleared.
+func (m *MessageMutation) ModerationCaseCleared() bool {
+	return m.clearedmoderation_case
+}
+
+// ModerationCaseID returns the "moderation_case" edge ID in the mutation.
+func (m *MessageMutation) ModerationCaseID() (id types.ModerationCaseID, exists bool) {
+	if m.moderation_case != nil {
+		return *m.moderation_case, true
+	}
+	return
+}
+
+// ModerationCaseIDs returns the "moderation_case" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModerationCaseID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ModerationCaseIDs() (ids []types.ModerationCaseID) {
+	if id := m.moderation_case; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModerationCase resets all changes to the "moderation_case" edge.
+func (m *MessageMutation) ResetModerationCase() {
+	m.moderation_case = nil
+	m.clearedmoderation_case = false
+}
+
+// AddVerdictConflictIDs adds the "verdict_conflicts" edge to the VerdictConflict entity by ids.
+func (m *MessageMutation) AddVerdictConflictIDs(ids ...types.VerdictConflictID) {
+	if m.verdict_conflicts == nil {
+		m.verdict_conflicts = make(map[types.VerdictConflictID]struct{})
+	}
+	for i := range ids {
+		m.verdict_conflicts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVerdictConflicts clears the "verdict_conflicts" edge to the VerdictConflict entity.
+func (m *MessageMutation) ClearVerdictConflicts() {
+	m.clearedverdict_conflicts = true
+}
+
+// VerdictConflictsCleared reports if the "verdict_conflicts" edge to the VerdictConflict entity was cleared.
+func (m *MessageMutation) VerdictConflictsCleared() bool {
+	return m.clearedverdict_conflicts
+}
+
+// RemoveVerdictConflictIDs removes the "verdict_conflicts" edge to the VerdictConflict entity by IDs.
+func (m *MessageMutation) RemoveVerdictConflictIDs(ids ...types.VerdictConflictID) {
+	if m.removedverdict_conflicts == nil {
+		m.removedverdict_conflicts = make(map[types.VerdictConflictID]struct{})
+	}
+	for i := range ids {
+		delete(m.verdict_conflicts, ids[i])
+		m.removedverdict_conflicts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVerdictConflicts returns the removed IDs of the "verdict_conflicts" edge to the VerdictConflict entity.
+func (m *MessageMutation) RemovedVerdictConflictsIDs() (ids []types.VerdictConflictID) {
+	for id := range m.removedverdict_conflicts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VerdictConflictsIDs returns the "verdict_conflicts" edge IDs in the mutation.
+func (m *MessageMutation) VerdictConflictsIDs() (ids []types.VerdictConflictID) {
+	for id := range m.verdict_conflicts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVerdictConflicts resets all changes to the "verdict_conflicts" edge.
+func (m *MessageMutation) ResetVerdictConflicts() {
+	m.verdict_conflicts = nil
+	m.clearedverdict_conflicts = false
+	m.removedverdict_conflicts = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by ids.
+func (m *MessageMutation) AddRevisionIDs(ids ...types.MessageRevisionID) {
+	if m.revisions == nil {
+		m.revisions = make(map[types.MessageRevisionID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the MessageRevision entity.
+func (m *MessageMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the MessageRevision entity was cleared.
+func (m *MessageMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the MessageRevision entity by IDs.
+func (m *MessageMutation) RemoveRevisionIDs(ids ...types.MessageRevisionID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[types.MessageRevisionID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the MessageRevision entity.
+func (m *MessageMutation) RemovedRevisionsIDs() (ids []types.MessageRevisionID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *MessageMutation) RevisionsIDs() (ids []types.MessageRevisionID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *MessageMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// SetDeletionID sets the "deletion" edge to the MessageDeletion entity by id.
+func (m *MessageMutation) SetDeletionID(id types.MessageDeletionID) {
+	m.deletion = &id
+}
+
+// ClearDeletion clears the "deletion" edge to the MessageDeletion entity.
+func (m *MessageMutation) ClearDeletion() {
+	m.cleareddeletion = true
+}
+
+// DeletionCleared reports if the "deletion" edge to the MessageDeletion entity was cleared.
+func (m *MessageMutation) DeletionCleared() bool {
+	return m.cleareddeletion
+}
+
+// DeletionID returns the "deletion" edge ID in the mutation.
+func (m *MessageMutation) DeletionID() (id types.MessageDeletionID, exists bool) {
+	if m.deletion != nil {
+		return *m.deletion, true
+	}
+	return
+}
+
+// DeletionIDs returns the "deletion" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeletionID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) DeletionIDs() (ids []types.MessageDeletionID) {
+	if id := m.deletion; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeletion resets all changes to the "deletion" edge.
+func (m *MessageMutation) ResetDeletion() {
+	m.deletion = nil
+	m.cleareddeletion = false
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *MessageMutation) AddAttachmentIDs(ids ...types.AttachmentID) {
+	if m.attachments == nil {
+		m.attachments = make(map[types.AttachmentID]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *MessageMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *MessageMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *MessageMutation) RemoveAttachmentIDs(ids ...types.AttachmentID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[types.AttachmentID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *MessageMutation) RemovedAttachmentsIDs() (ids []types.AttachmentID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *MessageMutation) AttachmentsIDs() (ids []types.AttachmentID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *MessageMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by ids.
+func (m *MessageMutation) AddReactionIDs(ids ...types.MessageReactionID) {
+	if m.reactions == nil {
+		m.reactions = make(map[types.MessageReactionID]struct{})
+	}
+	for i := range ids {
+		m.reactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReactions clears the "reactions" edge to the MessageReaction entity.
+func (m *MessageMutation) ClearReactions() {
+	m.clearedreactions = true
+}
+
+// ReactionsCleared reports if the "reactions" edge to the MessageReaction entity was cleared.
+func (m *MessageMutation) ReactionsCleared() bool {
+	return m.clearedreactions
+}
+
+// RemoveReactionIDs removes the "reactions" edge to the MessageReaction entity by IDs.
+func (m *MessageMutation) RemoveReactionIDs(ids ...types.MessageReactionID) {
+	if m.removedreactions == nil {
+		m.removedreactions = make(map[types.MessageReactionID]struct{})
+	}
+	for i := range ids {
+		delete(m.reactions, ids[i])
+		m.removedreactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReactions returns the removed IDs of the "reactions" edge to the MessageReaction entity.
+func (m *MessageMutation) RemovedReactionsIDs() (ids []types.MessageReactionID) {
+	for id := range m.removedreactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReactionsIDs returns the "reactions" edge IDs in the mutation.
+func (m *MessageMutation) ReactionsIDs() (ids []types.MessageReactionID) {
+	for id := range m.reactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReactions resets all changes to the "reactions" edge.
+func (m *MessageMutation) ResetReactions() {
+	m.reactions = nil
+	m.clearedreactions = false
+	m.removedreactions = nil
+}
+
+// SetReplyToID sets the "reply_to" edge to the Message entity by id.
+func (m *MessageMutation) SetReplyToID(id types.MessageID) {
+	m.reply_to = &id
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (m *MessageMutation) ClearReplyTo() {
+	m.clearedreply_to = true
+	m.clearedFields[message.FieldReplyToMessageID] = struct{}{}
+}
+
+// ReplyToCleared reports if the "reply_to" edge to the Message entity was cleared.
+func (m *MessageMutation) ReplyToCleared() bool {
+	return m.ReplyToMessageIDCleared() || m.clearedreply_to
+}
+
+// ReplyToID returns the "reply_to" edge ID in the mutation.
+func (m *MessageMutation) ReplyToID() (id types.MessageID, exists bool) {
+	if m.reply_to != nil {
+		return *m.reply_to, true
+	}
+	return
+}
+
+// ReplyToIDs returns the "reply_to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReplyToID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ReplyToIDs() (ids []types.MessageID) {
+	if id := m.reply_to; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReplyTo resets all changes to the "reply_to" edge.
+func (m *MessageMutation) ResetReplyTo() {
+	m.reply_to = nil
+	m.clearedreply_to = false
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by ids.
+func (m *MessageMutation) AddReplyIDs(ids ...types.MessageID) {
+	if m.replies == nil {
+		m.replies = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplies clears the "replies" edge to the Message entity.
+func (m *MessageMutation) ClearReplies() {
+	m.clearedreplies = true
+}
+
+// RepliesCleared reports if the "replies" edge to the Message entity was cleared.
+func (m *MessageMutation) RepliesCleared() bool {
+	return m.clearedreplies
+}
+
+// RemoveReplyIDs removes the "replies" edge to the Message entity by IDs.
+func (m *MessageMutation) RemoveReplyIDs(ids ...types.MessageID) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the Message entity.
+func (m *MessageMutation) RemovedRepliesIDs() (ids []types.MessageID) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *MessageMutation) RepliesIDs() (ids []types.MessageID) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplies resets all changes to the "replies" edge.
+func (m *MessageMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.chat != nil {
+		fields = append(fields, message.FieldChatID)
+	}
+	if m.problem != nil {
+		fields = append(fields, message.FieldProblemID)
+	}
+	if m.author_id != nil {
+		fields = append(fields, message.FieldAuthorID)
+	}
+	if m.is_visible_for_client != nil {
+		fields = append(fields, message.FieldIsVisibleForClient)
+	}
+	if m.is_visible_for_manager != nil {
+		fields = append(fields, message.FieldIsVisibleForManager)
+	}
+	if m.body != nil {
+		fields = append(fields, message.FieldBody)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	if m.checked_at != nil {
+		fields = append(fields, message.FieldCheckedAt)
+	}
+	if m.verdict != nil {
+		fields = append(fields, message.FieldVerdict)
+	}
+	if m.verdict_source != nil {
+		fields = append(fields, message.FieldVerdictSource)
+	}
+	if m.is_blocked != nil {
+		fields = append(fields, message.FieldIsBlocked)
+	}
+	if m.is_service != nil {
+		fields = append(fields, message.FieldIsService)
+	}
+	if m.is_internal_note != nil {
+		fields = append(fields, message.FieldIsInternalNote)
+	}
+	if m.initial_request_id != nil {
+		fields = append(fields, message.FieldInitialRequestID)
+	}
+	if m.content_request_id != nil {
+		fields = append(fields, message.FieldContentRequestID)
+	}
+	if m.reply_to != nil {
+		fields = append(fields, message.FieldReplyToMessageID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldChatID:
+		return m.ChatID()
+	case message.FieldProblemID:
+		return m.ProblemID()
+	case message.FieldAuthorID:
+		return m.AuthorID()
+	case message.FieldIsVisibleForClient:
+		return m.IsVisibleForClient()
+	case message.FieldIsVisibleForManager:
+		return m.IsVisibleForManager()
+	case message.FieldBody:
+		return m.Body()
+	case message.FieldEditedAt:
+		return m.EditedAt()
+	case message.FieldDeletedAt:
+		return m.DeletedAt()
+	case message.FieldCheckedAt:
+		return m.CheckedAt()
+	case message.FieldVerdict:
+		return m.Verdict()
+	case message.FieldVerdictSource:
+		return m.VerdictSource()
+	case message.FieldIsBlocked:
+		return m.IsBlocked()
+	case message.FieldIsService:
+		return m.IsService()
+	case message.FieldIsInternalNote:
+		return m.IsInternalNote()
+	case message.FieldInitialRequestID:
+		return m.InitialRequestID()
+	case message.FieldContentRequestID:
+		return m.ContentRequestID()
+	case message.FieldReplyToMessageID:
+		return m.ReplyToMessageID()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldChatID:
+		return m.OldChatID(ctx)
+	case message.FieldProblemID:
+		return m.OldProblemID(ctx)
+	case message.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case message.FieldIsVisibleForClient:
+		return m.OldIsVisibleForClient(ctx)
+	case message.FieldIsVisibleForManager:
+		return m.OldIsVisibleForManager(ctx)
+	case message.FieldBody:
+		return m.OldBody(ctx)
+	case message.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case message.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case message.FieldCheckedAt:
+		return m.OldCheckedAt(ctx)
+	case message.FieldVerdict:
+		return m.OldVerdict(ctx)
+	case message.FieldVerdictSource:
+		return m.OldVerdictSource(ctx)
+	case message.FieldIsBlocked:
+		return m.OldIsBlocked(ctx)
+	case message.FieldIsService:
+		return m.OldIsService(ctx)
+	case message.FieldIsInternalNote:
+		return m.OldIsInternalNote(ctx)
+	case message.FieldInitialRequestID:
+		return m.OldInitialRequestID(ctx)
+	case message.FieldContentRequestID:
+		return m.OldContentRequestID(ctx)
+	case message.FieldReplyToMessageID:
+		return m.OldReplyToMessageID(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldChatID:
+		v, ok := value.(types.ChatID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case message.FieldProblemID:
+		v, ok := value.(types.ProblemID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProblemID(v)
+		return nil
+	case message.FieldAuthorID:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case message.FieldIsVisibleForClient:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVisibleForClient(v)
+		return nil
+	case message.FieldIsVisibleForManager:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVisibleForManager(v)
+		return nil
+	case message.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case message.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case message.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case message.FieldCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedAt(v)
+		return nil
+	case message.FieldVerdict:
+		v, ok := value.(message.Verdict)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerdict(v)
+		return nil
+	case message.FieldVerdictSource:
+		v, ok := value.(message.VerdictSource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerdictSource(v)
+		return nil
+	case message.FieldIsBlocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBlocked(v)
+		return nil
+	case message.FieldIsService:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsService(v)
+		return nil
+	case message.FieldIsInternalNote:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsInternalNote(v)
+		return nil
+	case message.FieldInitialRequestID:
+		v, ok := value.(types.RequestID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitialRequestID(v)
+		return nil
+	case message.FieldContentRequestID:
+		v, ok := value.(types.RequestID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentRequestID(v)
+		return nil
+	case message.FieldReplyToMessageID:
+		v, ok := value.(types.MessageID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToMessageID(v)
+		return nil
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldAuthorID) {
+		fields = append(fields, message.FieldAuthorID)
+	}
+	if m.FieldCleared(message.FieldEditedAt) {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.FieldCleared(message.FieldDeletedAt) {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	if m.FieldCleared(message.FieldCheckedAt) {
+		fields = append(fields, message.FieldCheckedAt)
+	}
+	if m.FieldCleared(message.FieldVerdict) {
+		fields = append(fields, message.FieldVerdict)
+	}
+	if m.FieldCleared(message.FieldVerdictSource) {
+		fields = append(fields, message.FieldVerdictSource)
+	}
+	if m.FieldCleared(message.FieldContentRequestID) {
+		fields = append(fields, message.FieldContentRequestID)
+	}
+	if m.FieldCleared(message.FieldReplyToMessageID) {
+		fields = append(fields, message.FieldReplyToMessageID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldAuthorID:
+		m.ClearAuthorID()
+		return nil
+	case message.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	case message.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case message.FieldCheckedAt:
+		m.ClearCheckedAt()
+		return nil
+	case message.FieldVerdict:
+		m.ClearVerdict()
+		return nil
+	case message.FieldVerdictSource:
+		m.ClearVerdictSource()
+		return nil
+	case message.FieldContentRequestID:
+		m.ClearContentRequestID()
+		return nil
+	case message.FieldReplyToMessageID:
+		m.ClearReplyToMessageID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case message.FieldProblemID:
+		m.ResetProblemID()
+		return nil
+	case message.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case message.FieldIsVisibleForClient:
+		m.ResetIsVisibleForClient()
+		return nil
+	case message.FieldIsVisibleForManager:
+		m.ResetIsVisibleForManager()
+		return nil
+	case message.FieldBody:
+		m.ResetBody()
+		return nil
+	case message.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case message.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case message.FieldCheckedAt:
+		m.ResetCheckedAt()
+		return nil
+	case message.FieldVerdict:
+		m.ResetVerdict()
+		return nil
+	case message.FieldVerdictSource:
+		m.ResetVerdictSource()
+		return nil
+	case message.FieldIsBlocked:
+		m.ResetIsBlocked()
+		return nil
+	case message.FieldIsService:
+		m.ResetIsService()
+		return nil
+	case message.FieldIsInternalNote:
+		m.ResetIsInternalNote()
+		return nil
+	case message.FieldInitialRequestID:
+		m.ResetInitialRequestID()
+		return nil
+	case message.FieldContentRequestID:
+		m.ResetContentRequestID()
+		return nil
+	case message.FieldReplyToMessageID:
+		m.ResetReplyToMessageID()
+		return nil
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.chat != nil {
+		edges = append(edges, message.EdgeChat)
+	}
+	if m.problem != nil {
+		edges = append(edges, message.EdgeProblem)
+	}
+	if m.moderation_case != nil {
+		edges = append(edges, message.EdgeModerationCase)
+	}
+	if m.verdict_conflicts != nil {
+		edges = append(edges, message.EdgeVerdictConflicts)
+	}
+	if m.revisions != nil {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.deletion != nil {
+		edges = append(edges, message.EdgeDeletion)
+	}
+	if m.attachments != nil {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.reactions != nil {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.reply_to != nil {
+		edges = append(edges, message.EdgeReplyTo)
+	}
+	if m.replies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeProblem:
+		if id := m.problem; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeModerationCase:
+		if id := m.moderation_case; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeVerdictConflicts:
+		ids := make([]ent.Value, 0, len(m.verdict_conflicts))
+		for id := range m.verdict_conflicts {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeDeletion:
+		if id := m.deletion; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.reactions))
+		for id := range m.reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReplyTo:
+		if id := m.reply_to; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removedverdict_conflicts != nil {
+		edges = append(edges, message.EdgeVerdictConflicts)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.removedattachments != nil {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.removedreactions != nil {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.removedreplies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeVerdictConflicts:
+		ids := make([]ent.Value, 0, len(m.removedverdict_conflicts))
+		for id := range m.removedverdict_conflicts {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.removedreactions))
+		for id := range m.removedreactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.clearedchat {
+		edges = append(edges, message.EdgeChat)
+	}
+	if m.clearedproblem {
+		edges = append(edges, message.EdgeProblem)
+	}
+	if m.clearedmoderation_case {
+		edges = append(edges, message.EdgeModerationCase)
+	}
+	if m.clearedverdict_conflicts {
+		edges = append(edges, message.EdgeVerdictConflicts)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.cleareddeletion {
+		edges = append(edges, message.EdgeDeletion)
+	}
+	if m.clearedattachments {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.clearedreactions {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.clearedreply_to {
+		edges = append(edges, message.EdgeReplyTo)
+	}
+	if m.clearedreplies {
+		edges = append(edges, message.EdgeReplies)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeChat:
+		return m.clearedchat
+	case message.EdgeProblem:
+		return m.clearedproblem
+	case message.EdgeModerationCase:
+		return m.clearedmoderation_case
+	case message.EdgeVerdictConflicts:
+		return m.clearedverdict_conflicts
+	case message.EdgeRevisions:
+		return m.clearedrevisions
+	case message.EdgeDeletion:
+		return m.cleareddeletion
+	case message.EdgeAttachments:
+		return m.clearedattachments
+	case message.EdgeReactions:
+		return m.clearedreactions
+	case message.EdgeReplyTo:
+		return m.clearedreply_to
+	case message.EdgeReplies:
+		return m.clearedreplies
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeChat:
+		m.ClearChat()
+		return nil
+	case message.EdgeProblem:
+		m.ClearProblem()
+		return nil
+	case message.EdgeModerationCase:
+		m.ClearModerationCase()
+		return nil
+	case message.EdgeDeletion:
+		m.ClearDeletion()
+		return nil
+	case message.EdgeReplyTo:
+		m.ClearReplyTo()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeChat:
+		m.ResetChat()
+		return nil
+	case message.EdgeProblem:
+		m.ResetProblem()
+		return nil
+	case message.EdgeModerationCase:
+		m.ResetModerationCase()
+		return nil
+	case message.EdgeVerdictConflicts:
+		m.ResetVerdictConflicts()
+		return nil
+	case message.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case message.EdgeDeletion:
+		m.ResetDeletion()
+		return nil
+	case message.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	case message.EdgeReactions:
+		m.ResetReactions()
+		return nil
+	case message.EdgeReplyTo:
+		m.ResetReplyTo()
+		return nil
+	case message.EdgeReplies:
+		m.ResetReplies()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// MessageDeletionMutation represents an operation that mutates the MessageDeletion nodes in the graph.
+type MessageDeletionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *types.MessageDeletionID
+	request_id      *types.RequestID
+	deleted_by      *types.UserID
+	deleted_by_role *messagedeletion.DeletedByRole
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	message         *types.MessageID
+	clearedmessage  bool
+	done            bool
+	oldValue        func(context.Context) (*MessageDeletion, error)
+	predicates      []predicate.MessageDeletion
+}
+
+var _ ent.Mutation = (*MessageDeletionMutation)(nil)
+
+// messagedeletionOption allows management of the mutation configuration using functional options.
+type messagedeletionOption func(*MessageDeletionMutation)
+
+// newMessageDeletionMutation creates new mutation for the MessageDeletion entity.
+func newMessageDeletionMutation(c config, op Op, opts ...messagedeletionOption) *MessageDeletionMutation {
+	m := &MessageDeletionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageDeletion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageDeletionID sets the ID field of the mutation.
+func withMessageDeletionID(id types.MessageDeletionID) messagedeletionOption {
+	return func(m *MessageDeletionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageDeletion
+		)
+		m.oldValue = func(ctx context.Context) (*MessageDeletion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageDeletion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageDeletion sets the old MessageDeletion of the mutation.
+func withMessageDeletion(node *MessageDeletion) messagedeletionOption {
+	return func(m *MessageDeletionMutation) {
+		m.oldValue = func(context.Context) (*MessageDeletion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageDeletionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageDeletionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageDeletion entities.
+func (m *MessageDeletionMutation) SetID(id types.MessageDeletionID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageDeletionMutation) ID() (id types.MessageDeletionID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageDeletionMutation) IDs(ctx context.Context) ([]types.MessageDeletionID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.MessageDeletionID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageDeletion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageDeletionMutation) SetMessageID(ti types.MessageID) {
+	m.message = &ti
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageDeletionMutation) MessageID() (r types.MessageID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageDeletion entity.
+// If the MessageDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageDeletionMutation) OldMessageID(ctx context.Context) (v types.MessageID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageDeletionMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetRequestID sets the "request_id" field.
+func (m *MessageDeletionMutation) SetRequestID(ti types.RequestID) {
+	m.request_id = &ti
+}
+
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *MessageDeletionMutation) RequestID() (r types.RequestID, exists bool) {
+	v := m.request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestID returns the old "request_id" field's value of the MessageDeletion entity.
+// If the MessageDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageDeletionMutation) OldRequestID(ctx context.Context) (v types.RequestID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+	}
+	return oldValue.RequestID, nil
+}
+
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *MessageDeletionMutation) ResetRequestID() {
+	m.request_id = nil
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *MessageDeletionMutation) SetDeletedBy(ti types.UserID) {
+	m.deleted_by = &ti
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *MessageDeletionMutation) DeletedBy() (r types.UserID, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the MessageDeletion entity.
+// If the MessageDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageDeletionMutation) OldDeletedBy(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *MessageDeletionMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+}
+
+// SetDeletedByRole sets the "deleted_by_role" field.
+func (m *MessageDeletionMutation) SetDeletedByRole(mbr messagedeletion.DeletedByRole) {
+	m.deleted_by_role = &mbr
+}
+
+// DeletedByRole returns the value of the "deleted_by_role" field in the mutation.
+func (m *MessageDeletionMutation) DeletedByRole() (r messagedeletion.DeletedByRole, exists bool) {
+	v := m.deleted_by_role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedByRole returns the old "deleted_by_role" field's value of the MessageDeletion entity.
+// If the MessageDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageDeletionMutation) OldDeletedByRole(ctx context.Context) (v messagedeletion.DeletedByRole, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedByRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedByRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedByRole: %w", err)
+	}
+	return oldValue.DeletedByRole, nil
+}
+
+// ResetDeletedByRole resets all changes to the "deleted_by_role" field.
+func (m *MessageDeletionMutation) ResetDeletedByRole() {
+	m.deleted_by_role = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageDeletionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageDeletionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageDeletion entity.
+// If the MessageDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageDeletionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageDeletionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageDeletionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagedeletion.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageDeletionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageDeletionMutation) MessageIDs() (ids []types.MessageID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageDeletionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MessageDeletionMutation builder.
+func (m *MessageDeletionMutation) Where(ps ...predicate.MessageDeletion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageDeletionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageDeletionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageDeletion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageDeletionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageDeletionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageDeletion).
+func (m *MessageDeletionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageDeletionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.message != nil {
+		fields = append(fields, messagedeletion.FieldMessageID)
+	}
+	if m.request_id != nil {
+		fields = append(fields, messagedeletion.FieldRequestID)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, messagedeletion.FieldDeletedBy)
+	}
+	if m.deleted_by_role != nil {
+		fields = append(fields, messagedeletion.FieldDeletedByRole)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messagedeletion.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageDeletionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagedeletion.FieldMessageID:
+		return m.MessageID()
+	case messagedeletion.FieldRequestID:
+		return m.RequestID()
+	case messagedeletion.FieldDeletedBy:
+		return m.DeletedBy()
+	case messagedeletion.FieldDeletedByRole:
+		return m.DeletedByRole()
+	case messagedeletion.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageDeletionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagedeletion.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagedeletion.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case messagedeletion.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case messagedeletion.FieldDeletedByRole:
+		return m.OldDeletedByRole(ctx)
+	case messagedeletion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageDeletion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageDeletionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagedeletion.FieldMessageID:
+		v, ok := value.(types.MessageID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messagedeletion.FieldRequestID:
+		v, ok := value.(types.RequestID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case messagedeletion.FieldDeletedBy:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case messagedeletion.FieldDeletedByRole:
+		v, ok := value.(messagedeletion.DeletedByRole)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedByRole(v)
+		return nil
+	case messagedeletion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageDeletion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageDeletionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageDeletionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageDeletionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageDeletion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageDeletionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageDeletionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageDeletionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageDeletion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageDeletionMutation) ResetField(name string) error {
+	switch name {
+	case messagedeletion.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case messagedeletion.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case messagedeletion.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case messagedeletion.FieldDeletedByRole:
+		m.ResetDeletedByRole()
+		return nil
+	case messagedeletion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageDeletion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageDeletionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, messagedeletion.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageDeletionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagedeletion.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageDeletionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageDeletionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageDeletionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, messagedeletion.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageDeletionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagedeletion.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageDeletionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagedeletion.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageDeletion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageDeletionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagedeletion.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageDeletion edge %s", name)
+}
+
+// MessageReactionMutation represents an operation that mutates the MessageReaction nodes in the graph.
+type MessageReactionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *types.MessageReactionID
+	user_id        *types.UserID
+	kind           *messagereaction.Kind
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *types.MessageID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*MessageReaction, error)
+	predicates     []predicate.MessageReaction
+}
+
+var _ ent.Mutation = (*MessageReactionMutation)(nil)
+
+// messagereactionOption allows management of the mutation configuration using functional options.
+type messagereactionOption func(*MessageReactionMutation)
+
+// newMessageReactionMutation creates new mutation for the MessageReaction entity.
+func newMessageReactionMutation(c config, op Op, opts ...messagereactionOption) *MessageReactionMutation {
+	m := &MessageReactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageReaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageReactionID sets the ID field of the mutation.
+func withMessageReactionID(id types.MessageReactionID) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageReaction
+		)
+		m.oldValue = func(ctx context.Context) (*MessageReaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageReaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageReaction sets the old MessageReaction of the mutation.
+func withMessageReaction(node *MessageReaction) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		m.oldValue = func(context.Context) (*MessageReaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageReactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageReactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageReaction entities.
+func (m *MessageReactionMutation) SetID(id types.MessageReactionID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageReactionMutation) ID() (id types.MessageReactionID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageReactionMutation) IDs(ctx context.Context) ([]types.MessageReactionID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.MessageReactionID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageReaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageReactionMutation) SetMessageID(ti types.MessageID) {
+	m.message = &ti
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageReactionMutation) MessageID() (r types.MessageID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldMessageID(ctx context.Context) (v types.MessageID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageReactionMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MessageReactionMutation) SetUserID(ti types.UserID) {
+	m.user_id = &ti
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MessageReactionMutation) UserID() (r types.UserID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldUserID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MessageReactionMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *MessageReactionMutation) SetKind(value messagereaction.Kind) {
+	m.kind = &value
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *MessageReactionMutation) Kind() (r messagereaction.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldKind(ctx context.Context) (v messagereaction.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *MessageReactionMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageReactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageReactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageReactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageReactionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagereaction.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageReactionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageReactionMutation) MessageIDs() (ids []types.MessageID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageReactionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MessageReactionMutation builder.
+func (m *MessageReactionMutation) Where(ps ...predicate.MessageReaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageReactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageReactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageReaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageReactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageReactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageReaction).
+func (m *MessageReactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageReactionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.message != nil {
+		fields = append(fields, messagereaction.FieldMessageID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, messagereaction.FieldUserID)
+	}
+	if m.kind != nil {
+		fields = append(fields, messagereaction.FieldKind)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messagereaction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageReactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagereaction.FieldMessageID:
+		return m.MessageID()
+	case messagereaction.FieldUserID:
+		return m.UserID()
+	case messagereaction.FieldKind:
+		return m.Kind()
+	case messagereaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MessageReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case chat.FieldClientID:
-		return m.OldClientID(ctx)
-	case chat.FieldCreatedAt:
+	case messagereaction.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagereaction.FieldUserID:
+		return m.OldUserID(ctx)
+	case messagereaction.FieldKind:
+		return m.OldKind(ctx)
+	case messagereaction.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Chat field %s", name)
+	return nil, fmt.Errorf("unknown MessageReaction field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChatMutation) SetField(name string, value ent.Value) error {
+func (m *MessageReactionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case chat.FieldClientID:
+	case messagereaction.FieldMessageID:
+		v, ok := value.(types.MessageID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messagereaction.FieldUserID:
 		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetUserID(v)
 		return nil
-	case chat.FieldCreatedAt:
+	case messagereaction.FieldKind:
+		v, ok := value.(messagereaction.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case messagereaction.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -430,201 +6557,172 @@ func (m *ChatMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Chat field %s", name)
+	return fmt.Errorf("unknown MessageReaction field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChatMutation) AddedFields() []string {
+func (m *MessageReactionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChatMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MessageReactionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChatMutation) AddField(name string, value ent.Value) error {
+func (m *MessageReactionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Chat numeric field %s", name)
+	return fmt.Errorf("unknown MessageReaction numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChatMutation) ClearedFields() []string {
+func (m *MessageReactionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChatMutation) FieldCleared(name string) bool {
+func (m *MessageReactionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChatMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Chat nullable field %s", name)
+func (m *MessageReactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageReaction nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChatMutation) ResetField(name string) error {
+func (m *MessageReactionMutation) ResetField(name string) error {
 	switch name {
-	case chat.FieldClientID:
-		m.ResetClientID()
+	case messagereaction.FieldMessageID:
+		m.ResetMessageID()
 		return nil
-	case chat.FieldCreatedAt:
+	case messagereaction.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case messagereaction.FieldKind:
+		m.ResetKind()
+		return nil
+	case messagereaction.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Chat field %s", name)
+	return fmt.Errorf("unknown MessageReaction field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChatMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.messages != nil {
-		edges = append(edges, chat.EdgeMessages)
-	}
-	if m.problems != nil {
-		edges = append(edges, chat.EdgeProblems)
+func (m *MessageReactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, messagereaction.EdgeMessage)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChatMutation) AddedIDs(name string) []ent.Value {
+func (m *MessageReactionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case chat.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	case chat.EdgeProblems:
-		ids := make([]ent.Value, 0, len(m.problems))
-		for id := range m.problems {
-			ids = append(ids, id)
+	case messagereaction.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChatMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmessages != nil {
-		edges = append(edges, chat.EdgeMessages)
-	}
-	if m.removedproblems != nil {
-		edges = append(edges, chat.EdgeProblems)
-	}
+func (m *MessageReactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChatMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case chat.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	case chat.EdgeProblems:
-		ids := make([]ent.Value, 0, len(m.removedproblems))
-		for id := range m.removedproblems {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *MessageReactionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChatMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmessages {
-		edges = append(edges, chat.EdgeMessages)
-	}
-	if m.clearedproblems {
-		edges = append(edges, chat.EdgeProblems)
+func (m *MessageReactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, messagereaction.EdgeMessage)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChatMutation) EdgeCleared(name string) bool {
+func (m *MessageReactionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case chat.EdgeMessages:
-		return m.clearedmessages
-	case chat.EdgeProblems:
-		return m.clearedproblems
+	case messagereaction.EdgeMessage:
+		return m.clearedmessage
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChatMutation) ClearEdge(name string) error {
+func (m *MessageReactionMutation) ClearEdge(name string) error {
 	switch name {
+	case messagereaction.EdgeMessage:
+		m.ClearMessage()
+		return nil
 	}
-	return fmt.Errorf("unknown Chat unique edge %s", name)
+	return fmt.Errorf("unknown MessageReaction unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChatMutation) ResetEdge(name string) error {
+func (m *MessageReactionMutation) ResetEdge(name string) error {
 	switch name {
-	case chat.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	case chat.EdgeProblems:
-		m.ResetProblems()
+	case messagereaction.EdgeMessage:
+		m.ResetMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Chat edge %s", name)
+	return fmt.Errorf("unknown MessageReaction edge %s", name)
 }
 
-// FailedJobMutation represents an operation that mutates the FailedJob nodes in the graph.
-type FailedJobMutation struct {
+// MessageRevisionMutation represents an operation that mutates the MessageRevision nodes in the graph.
+type MessageRevisionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *types.FailedJobID
-	name          *string
-	payload       *string
-	reason        *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*FailedJob, error)
-	predicates    []predicate.FailedJob
+	op              Op
+	typ             string
+	id              *types.MessageRevisionID
+	body            *string
+	edit_request_id *types.RequestID
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	message         *types.MessageID
+	clearedmessage  bool
+	done            bool
+	oldValue        func(context.Context) (*MessageRevision, error)
+	predicates      []predicate.MessageRevision
 }
 
-var _ ent.Mutation = (*FailedJobMutation)(nil)
+var _ ent.Mutation = (*MessageRevisionMutation)(nil)
 
-// failedjobOption allows management of the mutation configuration using functional options.
-type failedjobOption func(*FailedJobMutation)
+// messagerevisionOption allows management of the mutation configuration using functional options.
+type messagerevisionOption func(*MessageRevisionMutation)
 
-// newFailedJobMutation creates new mutation for the FailedJob entity.
-func newFailedJobMutation(c config, op Op, opts ...failedjobOption) *FailedJobMutation {
-	m := &FailedJobMutation{
+// newMessageRevisionMutation creates new mutation for the MessageRevision entity.
+func newMessageRevisionMutation(c config, op Op, opts ...messagerevisionOption) *MessageRevisionMutation {
+	m := &MessageRevisionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFailedJob,
+		typ:           TypeMessageRevision,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -633,20 +6731,20 @@ func newFailedJobMutation(c config, op Op, opts ...failedjobOption) *FailedJobMu
 	return m
 }
 
-// withFailedJobID sets the ID field of the mutation.
-func withFailedJobID(id types.FailedJobID) failedjobOption {
-	return func(m *FailedJobMutation) {
+// withMessageRevisionID sets the ID field of the mutation.
+func withMessageRevisionID(id types.MessageRevisionID) messagerevisionOption {
+	return func(m *MessageRevisionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FailedJob
+			value *MessageRevision
 		)
-		m.oldValue = func(ctx context.Context) (*FailedJob, error) {
+		m.oldValue = func(ctx context.Context) (*MessageRevision, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FailedJob.Get(ctx, id)
+					value, err = m.Client().MessageRevision.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -655,10 +6753,10 @@ func withFailedJobID(id types.FailedJobID) failedjobOption {
 	}
 }
 
-// withFailedJob sets the old FailedJob of the mutation.
-func withFailedJob(node *FailedJob) failedjobOption {
-	return func(m *FailedJobMutation) {
-		m.oldValue = func(context.Context) (*FailedJob, error) {
+// withMessageRevision sets the old MessageRevision of the mutation.
+func withMessageRevision(node *MessageRevision) messagerevisionOption {
+	return func(m *MessageRevisionMutation) {
+		m.oldValue = func(context.Context) (*MessageRevision, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -667,7 +6765,7 @@ func withFailedJob(node *FailedJob) failedjobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FailedJobMutation) Client() *Client {
+func (m MessageRevisionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -675,7 +6773,7 @@ func (m FailedJobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FailedJobMutation) Tx() (*Tx, error) {
+func (m MessageRevisionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -685,14 +6783,14 @@ func (m FailedJobMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of FailedJob entities.
-func (m *FailedJobMutation) SetID(id types.FailedJobID) {
+// operation is only accepted on creation of MessageRevision entities.
+func (m *MessageRevisionMutation) SetID(id types.MessageRevisionID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FailedJobMutation) ID() (id types.FailedJobID, exists bool) {
+func (m *MessageRevisionMutation) ID() (id types.MessageRevisionID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -703,136 +6801,136 @@ func (m *FailedJobMutation) ID() (id types.FailedJobID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FailedJobMutation) IDs(ctx context.Context) ([]types.FailedJobID, error) {
+func (m *MessageRevisionMutation) IDs(ctx context.Context) ([]types.MessageRevisionID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.FailedJobID{id}, nil
+			return []types.MessageRevisionID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FailedJob.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MessageRevision.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *FailedJobMutation) SetName(s string) {
-	m.name = &s
+// SetMessageID sets the "message_id" field.
+func (m *MessageRevisionMutation) SetMessageID(ti types.MessageID) {
+	m.message = &ti
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FailedJobMutation) Name() (r string, exists bool) {
-	v := m.name
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageRevisionMutation) MessageID() (r types.MessageID, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageID returns the old "message_id" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *MessageRevisionMutation) OldMessageID(ctx context.Context) (v types.MessageID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.MessageID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FailedJobMutation) ResetName() {
-	m.name = nil
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageRevisionMutation) ResetMessageID() {
+	m.message = nil
 }
 
-// SetPayload sets the "payload" field.
-func (m *FailedJobMutation) SetPayload(s string) {
-	m.payload = &s
+// SetBody sets the "body" field.
+func (m *MessageRevisionMutation) SetBody(s string) {
+	m.body = &s
 }
 
-// Payload returns the value of the "payload" field in the mutation.
-func (m *FailedJobMutation) Payload() (r string, exists bool) {
-	v := m.payload
+// Body returns the value of the "body" field in the mutation.
+func (m *MessageRevisionMutation) Body() (r string, exists bool) {
+	v := m.body
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPayload returns the old "payload" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// OldBody returns the old "body" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldPayload(ctx context.Context) (v string, err error) {
+func (m *MessageRevisionMutation) OldBody(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPayload requires an ID field in the mutation")
+		return v, errors.New("OldBody requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
 	}
-	return oldValue.Payload, nil
+	return oldValue.Body, nil
 }
 
-// ResetPayload resets all changes to the "payload" field.
-func (m *FailedJobMutation) ResetPayload() {
-	m.payload = nil
+// ResetBody resets all changes to the "body" field.
+func (m *MessageRevisionMutation) ResetBody() {
+	m.body = nil
 }
 
-// SetReason sets the "reason" field.
-func (m *FailedJobMutation) SetReason(s string) {
-	m.reason = &s
+// SetEditRequestID sets the "edit_request_id" field.
+func (m *MessageRevisionMutation) SetEditRequestID(ti types.RequestID) {
+	m.edit_request_id = &ti
 }
 
-// Reason returns the value of the "reason" field in the mutation.
-func (m *FailedJobMutation) Reason() (r string, exists bool) {
-	v := m.reason
+// EditRequestID returns the value of the "edit_request_id" field in the mutation.
+func (m *MessageRevisionMutation) EditRequestID() (r types.RequestID, exists bool) {
+	v := m.edit_request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReason returns the old "reason" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// OldEditRequestID returns the old "edit_request_id" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldReason(ctx context.Context) (v string, err error) {
+func (m *MessageRevisionMutation) OldEditRequestID(ctx context.Context) (v types.RequestID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldEditRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
+		return v, errors.New("OldEditRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldEditRequestID: %w", err)
 	}
-	return oldValue.Reason, nil
+	return oldValue.EditRequestID, nil
 }
 
-// ResetReason resets all changes to the "reason" field.
-func (m *FailedJobMutation) ResetReason() {
-	m.reason = nil
+// ResetEditRequestID resets all changes to the "edit_request_id" field.
+func (m *MessageRevisionMutation) ResetEditRequestID() {
+	m.edit_request_id = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *FailedJobMutation) SetCreatedAt(t time.Time) {
+func (m *MessageRevisionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FailedJobMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *MessageRevisionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -840,10 +6938,10 @@ func (m *FailedJobMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MessageRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -858,19 +6956,46 @@ func (m *FailedJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FailedJobMutation) ResetCreatedAt() {
+func (m *MessageRevisionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the FailedJobMutation builder.
-func (m *FailedJobMutation) Where(ps ...predicate.FailedJob) {
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageRevisionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagerevision.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageRevisionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageRevisionMutation) MessageIDs() (ids []types.MessageID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageRevisionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MessageRevisionMutation builder.
+func (m *MessageRevisionMutation) Where(ps ...predicate.MessageRevision) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FailedJobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MessageRevisionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FailedJobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FailedJob, len(ps))
+func (m *MessageRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageRevision, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -878,36 +7003,36 @@ func (m *FailedJobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FailedJobMutation) Op() Op {
+func (m *MessageRevisionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FailedJobMutation) SetOp(op Op) {
+func (m *MessageRevisionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FailedJob).
-func (m *FailedJobMutation) Type() string {
+// Type returns the node type of this mutation (MessageRevision).
+func (m *MessageRevisionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FailedJobMutation) Fields() []string {
+func (m *MessageRevisionMutation) Fields() []string {
 	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, failedjob.FieldName)
+	if m.message != nil {
+		fields = append(fields, messagerevision.FieldMessageID)
 	}
-	if m.payload != nil {
-		fields = append(fields, failedjob.FieldPayload)
+	if m.body != nil {
+		fields = append(fields, messagerevision.FieldBody)
 	}
-	if m.reason != nil {
-		fields = append(fields, failedjob.FieldReason)
+	if m.edit_request_id != nil {
+		fields = append(fields, messagerevision.FieldEditRequestID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, failedjob.FieldCreatedAt)
+		fields = append(fields, messagerevision.FieldCreatedAt)
 	}
 	return fields
 }
@@ -915,15 +7040,15 @@ func (m *FailedJobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FailedJobMutation) Field(name string) (ent.Value, bool) {
+func (m *MessageRevisionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case failedjob.FieldName:
-		return m.Name()
-	case failedjob.FieldPayload:
-		return m.Payload()
-	case failedjob.FieldReason:
-		return m.Reason()
-	case failedjob.FieldCreatedAt:
+	case messagerevision.FieldMessageID:
+		return m.MessageID()
+	case messagerevision.FieldBody:
+		return m.Body()
+	case messagerevision.FieldEditRequestID:
+		return m.EditRequestID()
+	case messagerevision.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -932,47 +7057,47 @@ func (m *FailedJobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FailedJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MessageRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case failedjob.FieldName:
-		return m.OldName(ctx)
-	case failedjob.FieldPayload:
-		return m.OldPayload(ctx)
-	case failedjob.FieldReason:
-		return m.OldReason(ctx)
-	case failedjob.FieldCreatedAt:
+	case messagerevision.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagerevision.FieldBody:
+		return m.OldBody(ctx)
+	case messagerevision.FieldEditRequestID:
+		return m.OldEditRequestID(ctx)
+	case messagerevision.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown FailedJob field %s", name)
+	return nil, fmt.Errorf("unknown MessageRevision field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
+func (m *MessageRevisionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case failedjob.FieldName:
-		v, ok := value.(string)
+	case messagerevision.FieldMessageID:
+		v, ok := value.(types.MessageID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetMessageID(v)
 		return nil
-	case failedjob.FieldPayload:
+	case messagerevision.FieldBody:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPayload(v)
+		m.SetBody(v)
 		return nil
-	case failedjob.FieldReason:
-		v, ok := value.(string)
+	case messagerevision.FieldEditRequestID:
+		v, ok := value.(types.RequestID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
+		m.SetEditRequestID(v)
 		return nil
-	case failedjob.FieldCreatedAt:
+	case messagerevision.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -980,148 +7105,173 @@ func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FailedJob field %s", name)
+	return fmt.Errorf("unknown MessageRevision field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FailedJobMutation) AddedFields() []string {
+func (m *MessageRevisionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FailedJobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MessageRevisionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FailedJobMutation) AddField(name string, value ent.Value) error {
+func (m *MessageRevisionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown FailedJob numeric field %s", name)
+	return fmt.Errorf("unknown MessageRevision numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FailedJobMutation) ClearedFields() []string {
+func (m *MessageRevisionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FailedJobMutation) FieldCleared(name string) bool {
+func (m *MessageRevisionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FailedJobMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown FailedJob nullable field %s", name)
+func (m *MessageRevisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageRevision nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FailedJobMutation) ResetField(name string) error {
+func (m *MessageRevisionMutation) ResetField(name string) error {
 	switch name {
-	case failedjob.FieldName:
-		m.ResetName()
+	case messagerevision.FieldMessageID:
+		m.ResetMessageID()
 		return nil
-	case failedjob.FieldPayload:
-		m.ResetPayload()
+	case messagerevision.FieldBody:
+		m.ResetBody()
 		return nil
-	case failedjob.FieldReason:
-		m.ResetReason()
+	case messagerevision.FieldEditRequestID:
+		m.ResetEditRequestID()
 		return nil
-	case failedjob.FieldCreatedAt:
+	case messagerevision.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown FailedJob field %s", name)
+	return fmt.Errorf("unknown MessageRevision field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FailedJobMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, messagerevision.EdgeMessage)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FailedJobMutation) AddedIDs(name string) []ent.Value {
+func (m *MessageRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagerevision.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FailedJobMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FailedJobMutation) RemovedIDs(name string) []ent.Value {
+func (m *MessageRevisionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FailedJobMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, messagerevision.EdgeMessage)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FailedJobMutation) EdgeCleared(name string) bool {
+func (m *MessageRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagerevision.EdgeMessage:
+		return m.clearedmessage
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FailedJobMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown FailedJob unique edge %s", name)
+func (m *MessageRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagerevision.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FailedJobMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown FailedJob edge %s", name)
+func (m *MessageRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagerevision.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision edge %s", name)
 }
 
-// JobMutation represents an operation that mutates the Job nodes in the graph.
-type JobMutation struct {
+// ModerationCaseMutation represents an operation that mutates the ModerationCase nodes in the graph.
+type ModerationCaseMutation struct {
 	config
 	op             Op
 	typ            string
-	id             *types.JobID
-	name           *string
-	payload        *string
-	attempts       *int
-	addattempts    *int
-	available_at   *time.Time
-	reserved_until *time.Time
+	id             *types.ModerationCaseID
+	moderator_id   *types.UserID
+	decision       *moderationcase.Decision
+	decided_at     *time.Time
 	created_at     *time.Time
 	clearedFields  map[string]struct{}
+	message        *types.MessageID
+	clearedmessage bool
 	done           bool
-	oldValue       func(context.Context) (*Job, error)
-	predicates     []predicate.Job
+	oldValue       func(context.Context) (*ModerationCase, error)
+	predicates     []predicate.ModerationCase
 }
 
-var _ ent.Mutation = (*JobMutation)(nil)
+var _ ent.Mutation = (*ModerationCaseMutation)(nil)
 
-// jobOption allows management of the mutation configuration using functional options.
-type jobOption func(*JobMutation)
+// moderationcaseOption allows management of the mutation configuration using functional options.
+type moderationcaseOption func(*ModerationCaseMutation)
 
-// newJobMutation creates new mutation for the Job entity.
-func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
-	m := &JobMutation{
+// newModerationCaseMutation creates new mutation for the ModerationCase entity.
+func newModerationCaseMutation(c config, op Op, opts ...moderationcaseOption) *ModerationCaseMutation {
+	m := &ModerationCaseMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeJob,
+		typ:           TypeModerationCase,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1130,20 +7280,20 @@ func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
 	return m
 }
 
-// withJobID sets the ID field of the mutation.
-func withJobID(id types.JobID) jobOption {
-	return func(m *JobMutation) {
+// withModerationCaseID sets the ID field of the mutation.
+func withModerationCaseID(id types.ModerationCaseID) moderationcaseOption {
+	return func(m *ModerationCaseMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Job
+			value *ModerationCase
 		)
-		m.oldValue = func(ctx context.Context) (*Job, error) {
+		m.oldValue = func(ctx context.Context) (*ModerationCase, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Job.Get(ctx, id)
+					value, err = m.Client().ModerationCase.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1152,10 +7302,10 @@ func withJobID(id types.JobID) jobOption {
 	}
 }
 
-// withJob sets the old Job of the mutation.
-func withJob(node *Job) jobOption {
-	return func(m *JobMutation) {
-		m.oldValue = func(context.Context) (*Job, error) {
+// withModerationCase sets the old ModerationCase of the mutation.
+func withModerationCase(node *ModerationCase) moderationcaseOption {
+	return func(m *ModerationCaseMutation) {
+		m.oldValue = func(context.Context) (*ModerationCase, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1164,7 +7314,7 @@ func withJob(node *Job) jobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JobMutation) Client() *Client {
+func (m ModerationCaseMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1172,7 +7322,7 @@ func (m JobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m JobMutation) Tx() (*Tx, error) {
+func (m ModerationCaseMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -1182,14 +7332,14 @@ func (m JobMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Job entities.
-func (m *JobMutation) SetID(id types.JobID) {
+// operation is only accepted on creation of ModerationCase entities.
+func (m *ModerationCaseMutation) SetID(id types.ModerationCaseID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *JobMutation) ID() (id types.JobID, exists bool) {
+func (m *ModerationCaseMutation) ID() (id types.ModerationCaseID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1200,228 +7350,211 @@ func (m *JobMutation) ID() (id types.JobID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *JobMutation) IDs(ctx context.Context) ([]types.JobID, error) {
+func (m *ModerationCaseMutation) IDs(ctx context.Context) ([]types.ModerationCaseID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.JobID{id}, nil
+			return []types.ModerationCaseID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ModerationCase.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *JobMutation) SetName(s string) {
-	m.name = &s
+// SetMessageID sets the "message_id" field.
+func (m *ModerationCaseMutation) SetMessageID(ti types.MessageID) {
+	m.message = &ti
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *JobMutation) Name() (r string, exists bool) {
-	v := m.name
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *ModerationCaseMutation) MessageID() (r types.MessageID, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageID returns the old "message_id" field's value of the ModerationCase entity.
+// If the ModerationCase object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ModerationCaseMutation) OldMessageID(ctx context.Context) (v types.MessageID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.MessageID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *JobMutation) ResetName() {
-	m.name = nil
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *ModerationCaseMutation) ResetMessageID() {
+	m.message = nil
 }
 
-// SetPayload sets the "payload" field.
-func (m *JobMutation) SetPayload(s string) {
-	m.payload = &s
+// SetModeratorID sets the "moderator_id" field.
+func (m *ModerationCaseMutation) SetModeratorID(ti types.UserID) {
+	m.moderator_id = &ti
 }
 
-// Payload returns the value of the "payload" field in the mutation.
-func (m *JobMutation) Payload() (r string, exists bool) {
-	v := m.payload
+// ModeratorID returns the value of the "moderator_id" field in the mutation.
+func (m *ModerationCaseMutation) ModeratorID() (r types.UserID, exists bool) {
+	v := m.moderator_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPayload returns the old "payload" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldModeratorID returns the old "moderator_id" field's value of the ModerationCase entity.
+// If the ModerationCase object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldPayload(ctx context.Context) (v string, err error) {
+func (m *ModerationCaseMutation) OldModeratorID(ctx context.Context) (v types.UserID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+		return v, errors.New("OldModeratorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPayload requires an ID field in the mutation")
+		return v, errors.New("OldModeratorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+		return v, fmt.Errorf("querying old value for OldModeratorID: %w", err)
 	}
-	return oldValue.Payload, nil
+	return oldValue.ModeratorID, nil
 }
 
-// ResetPayload resets all changes to the "payload" field.
-func (m *JobMutation) ResetPayload() {
-	m.payload = nil
+// ClearModeratorID clears the value of the "moderator_id" field.
+func (m *ModerationCaseMutation) ClearModeratorID() {
+	m.moderator_id = nil
+	m.clearedFields[moderationcase.FieldModeratorID] = struct{}{}
 }
 
-// SetAttempts sets the "attempts" field.
-func (m *JobMutation) SetAttempts(i int) {
-	m.attempts = &i
-	m.addattempts = nil
+// ModeratorIDCleared returns if the "moderator_id" field was cleared in this mutation.
+func (m *ModerationCaseMutation) ModeratorIDCleared() bool {
+	_, ok := m.clearedFields[moderationcase.FieldModeratorID]
+	return ok
 }
 
-// Attempts returns the value of the "attempts" field in the mutation.
-func (m *JobMutation) Attempts() (r int, exists bool) {
-	v := m.attempts
+// ResetModeratorID resets all changes to the "moderator_id" field.
+func (m *ModerationCaseMutation) ResetModeratorID() {
+	m.moderator_id = nil
+	delete(m.clearedFields, moderationcase.FieldModeratorID)
+}
+
+// SetDecision sets the "decision" field.
+func (m *ModerationCaseMutation) SetDecision(value moderationcase.Decision) {
+	m.decision = &value
+}
+
+// Decision returns the value of the "decision" field in the mutation.
+func (m *ModerationCaseMutation) Decision() (r moderationcase.Decision, exists bool) {
+	v := m.decision
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAttempts returns the old "attempts" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldDecision returns the old "decision" field's value of the ModerationCase entity.
+// If the ModerationCase object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+func (m *ModerationCaseMutation) OldDecision(ctx context.Context) (v moderationcase.Decision, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+		return v, errors.New("OldDecision is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttempts requires an ID field in the mutation")
+		return v, errors.New("OldDecision requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+		return v, fmt.Errorf("querying old value for OldDecision: %w", err)
 	}
-	return oldValue.Attempts, nil
+	return oldValue.Decision, nil
 }
 
-// AddAttempts adds i to the "attempts" field.
-func (m *JobMutation) AddAttempts(i int) {
-	if m.addattempts != nil {
-		*m.addattempts += i
-	} else {
-		m.addattempts = &i
-	}
+// ClearDecision clears the value of the "decision" field.
+func (m *ModerationCaseMutation) ClearDecision() {
+	m.decision = nil
+	m.clearedFields[moderationcase.FieldDecision] = struct{}{}
 }
 
-// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
-func (m *JobMutation) AddedAttempts() (r int, exists bool) {
-	v := m.addattempts
-	if v == nil {
-		return
-	}
-	return *v, true
+// DecisionCleared returns if the "decision" field was cleared in this mutation.
+func (m *ModerationCaseMutation) DecisionCleared() bool {
+	_, ok := m.clearedFields[moderationcase.FieldDecision]
+	return ok
 }
 
-// ResetAttempts resets all changes to the "attempts" field.
-func (m *JobMutation) ResetAttempts() {
-	m.attempts = nil
-	m.addattempts = nil
+// ResetDecision resets all changes to the "decision" field.
+func (m *ModerationCaseMutation) ResetDecision() {
+	m.decision = nil
+	delete(m.clearedFields, moderationcase.FieldDecision)
 }
 
-// SetAvailableAt sets the "available_at" field.
-func (m *JobMutation) SetAvailableAt(t time.Time) {
-	m.available_at = &t
+// SetDecidedAt sets the "decided_at" field.
+func (m *ModerationCaseMutation) SetDecidedAt(t time.Time) {
+	m.decided_at = &t
 }
 
-// AvailableAt returns the value of the "available_at" field in the mutation.
-func (m *JobMutation) AvailableAt() (r time.Time, exists bool) {
-	v := m.available_at
+// DecidedAt returns the value of the "decided_at" field in the mutation.
+func (m *ModerationCaseMutation) DecidedAt() (r time.Time, exists bool) {
+	v := m.decided_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvailableAt returns the old "available_at" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldDecidedAt returns the old "decided_at" field's value of the ModerationCase entity.
+// If the ModerationCase object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldAvailableAt(ctx context.Context) (v time.Time, err error) {
+func (m *ModerationCaseMutation) OldDecidedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvailableAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDecidedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvailableAt requires an ID field in the mutation")
+		return v, errors.New("OldDecidedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvailableAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDecidedAt: %w", err)
 	}
-	return oldValue.AvailableAt, nil
-}
-
-// ResetAvailableAt resets all changes to the "available_at" field.
-func (m *JobMutation) ResetAvailableAt() {
-	m.available_at = nil
-}
-
-// SetReservedUntil sets the "reserved_until" field.
-func (m *JobMutation) SetReservedUntil(t time.Time) {
-	m.reserved_until = &t
+	return oldValue.DecidedAt, nil
 }
 
-// ReservedUntil returns the value of the "reserved_until" field in the mutation.
-func (m *JobMutation) ReservedUntil() (r time.Time, exists bool) {
-	v := m.reserved_until
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (m *ModerationCaseMutation) ClearDecidedAt() {
+	m.decided_at = nil
+	m.clearedFields[moderationcase.FieldDecidedAt] = struct{}{}
 }
 
-// OldReservedUntil returns the old "reserved_until" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldReservedUntil(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReservedUntil is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReservedUntil requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReservedUntil: %w", err)
-	}
-	return oldValue.ReservedUntil, nil
+// DecidedAtCleared returns if the "decided_at" field was cleared in this mutation.
+func (m *ModerationCaseMutation) DecidedAtCleared() bool {
+	_, ok := m.clearedFields[moderationcase.FieldDecidedAt]
+	return ok
 }
 
-// ResetReservedUntil resets all changes to the "reserved_until" field.
-func (m *JobMutation) ResetReservedUntil() {
-	m.reserved_until = nil
+// ResetDecidedAt resets all changes to the "decided_at" field.
+func (m *ModerationCaseMutation) ResetDecidedAt() {
+	m.decided_at = nil
+	delete(m.clearedFields, moderationcase.FieldDecidedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *JobMutation) SetCreatedAt(t time.Time) {
+func (m *ModerationCaseMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ModerationCaseMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1429,10 +7562,10 @@ func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ModerationCase entity.
+// If the ModerationCase object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ModerationCaseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1447,19 +7580,46 @@ func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *JobMutation) ResetCreatedAt() {
+func (m *ModerationCaseMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the JobMutation builder.
-func (m *JobMutation) Where(ps ...predicate.Job) {
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *ModerationCaseMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[moderationcase.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *ModerationCaseMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *ModerationCaseMutation) MessageIDs() (ids []types.MessageID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *ModerationCaseMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the ModerationCaseMutation builder.
+func (m *ModerationCaseMutation) Where(ps ...predicate.ModerationCase) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ModerationCaseMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Job, len(ps))
+func (m *ModerationCaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModerationCase, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1467,42 +7627,39 @@ func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *JobMutation) Op() Op {
+func (m *ModerationCaseMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *JobMutation) SetOp(op Op) {
+func (m *ModerationCaseMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Job).
-func (m *JobMutation) Type() string {
+// Type returns the node type of this mutation (ModerationCase).
+func (m *ModerationCaseMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *JobMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, job.FieldName)
-	}
-	if m.payload != nil {
-		fields = append(fields, job.FieldPayload)
+func (m *ModerationCaseMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.message != nil {
+		fields = append(fields, moderationcase.FieldMessageID)
 	}
-	if m.attempts != nil {
-		fields = append(fields, job.FieldAttempts)
+	if m.moderator_id != nil {
+		fields = append(fields, moderationcase.FieldModeratorID)
 	}
-	if m.available_at != nil {
-		fields = append(fields, job.FieldAvailableAt)
+	if m.decision != nil {
+		fields = append(fields, moderationcase.FieldDecision)
 	}
-	if m.reserved_until != nil {
-		fields = append(fields, job.FieldReservedUntil)
+	if m.decided_at != nil {
+		fields = append(fields, moderationcase.FieldDecidedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, job.FieldCreatedAt)
+		fields = append(fields, moderationcase.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1510,19 +7667,17 @@ func (m *JobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *JobMutation) Field(name string) (ent.Value, bool) {
+func (m *ModerationCaseMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case job.FieldName:
-		return m.Name()
-	case job.FieldPayload:
-		return m.Payload()
-	case job.FieldAttempts:
-		return m.Attempts()
-	case job.FieldAvailableAt:
-		return m.AvailableAt()
-	case job.FieldReservedUntil:
-		return m.ReservedUntil()
-	case job.FieldCreatedAt:
+	case moderationcase.FieldMessageID:
+		return m.MessageID()
+	case moderationcase.FieldModeratorID:
+		return m.ModeratorID()
+	case moderationcase.FieldDecision:
+		return m.Decision()
+	case moderationcase.FieldDecidedAt:
+		return m.DecidedAt()
+	case moderationcase.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1531,65 +7686,56 @@ func (m *JobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ModerationCaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case job.FieldName:
-		return m.OldName(ctx)
-	case job.FieldPayload:
-		return m.OldPayload(ctx)
-	case job.FieldAttempts:
-		return m.OldAttempts(ctx)
-	case job.FieldAvailableAt:
-		return m.OldAvailableAt(ctx)
-	case job.FieldReservedUntil:
-		return m.OldReservedUntil(ctx)
-	case job.FieldCreatedAt:
+	case moderationcase.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case moderationcase.FieldModeratorID:
+		return m.OldModeratorID(ctx)
+	case moderationcase.FieldDecision:
+		return m.OldDecision(ctx)
+	case moderationcase.FieldDecidedAt:
+		return m.OldDecidedAt(ctx)
+	case moderationcase.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Job field %s", name)
+	return nil, fmt.Errorf("unknown ModerationCase field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobMutation) SetField(name string, value ent.Value) error {
+func (m *ModerationCaseMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case job.FieldName:
-		v, ok := value.(string)
+	case moderationcase.FieldMessageID:
+		v, ok := value.(types.MessageID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
-		return nil
-	case job.FieldPayload:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPayload(v)
+		m.SetMessageID(v)
 		return nil
-	case job.FieldAttempts:
-		v, ok := value.(int)
+	case moderationcase.FieldModeratorID:
+		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAttempts(v)
+		m.SetModeratorID(v)
 		return nil
-	case job.FieldAvailableAt:
-		v, ok := value.(time.Time)
+	case moderationcase.FieldDecision:
+		v, ok := value.(moderationcase.Decision)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvailableAt(v)
+		m.SetDecision(v)
 		return nil
-	case job.FieldReservedUntil:
+	case moderationcase.FieldDecidedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReservedUntil(v)
+		m.SetDecidedAt(v)
 		return nil
-	case job.FieldCreatedAt:
+	case moderationcase.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1597,175 +7743,209 @@ func (m *JobMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Job field %s", name)
+	return fmt.Errorf("unknown ModerationCase field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *JobMutation) AddedFields() []string {
-	var fields []string
-	if m.addattempts != nil {
-		fields = append(fields, job.FieldAttempts)
-	}
-	return fields
+func (m *ModerationCaseMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case job.FieldAttempts:
-		return m.AddedAttempts()
-	}
+func (m *ModerationCaseMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobMutation) AddField(name string, value ent.Value) error {
+func (m *ModerationCaseMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case job.FieldAttempts:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAttempts(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Job numeric field %s", name)
+	return fmt.Errorf("unknown ModerationCase numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *JobMutation) ClearedFields() []string {
-	return nil
+func (m *ModerationCaseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(moderationcase.FieldModeratorID) {
+		fields = append(fields, moderationcase.FieldModeratorID)
+	}
+	if m.FieldCleared(moderationcase.FieldDecision) {
+		fields = append(fields, moderationcase.FieldDecision)
+	}
+	if m.FieldCleared(moderationcase.FieldDecidedAt) {
+		fields = append(fields, moderationcase.FieldDecidedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *JobMutation) FieldCleared(name string) bool {
+func (m *ModerationCaseMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *JobMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Job nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *JobMutation) ResetField(name string) error {
+func (m *ModerationCaseMutation) ClearField(name string) error {
 	switch name {
-	case job.FieldName:
-		m.ResetName()
+	case moderationcase.FieldModeratorID:
+		m.ClearModeratorID()
 		return nil
-	case job.FieldPayload:
-		m.ResetPayload()
+	case moderationcase.FieldDecision:
+		m.ClearDecision()
+		return nil
+	case moderationcase.FieldDecidedAt:
+		m.ClearDecidedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationCase nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModerationCaseMutation) ResetField(name string) error {
+	switch name {
+	case moderationcase.FieldMessageID:
+		m.ResetMessageID()
 		return nil
-	case job.FieldAttempts:
-		m.ResetAttempts()
+	case moderationcase.FieldModeratorID:
+		m.ResetModeratorID()
 		return nil
-	case job.FieldAvailableAt:
-		m.ResetAvailableAt()
+	case moderationcase.FieldDecision:
+		m.ResetDecision()
 		return nil
-	case job.FieldReservedUntil:
-		m.ResetReservedUntil()
+	case moderationcase.FieldDecidedAt:
+		m.ResetDecidedAt()
 		return nil
-	case job.FieldCreatedAt:
+	case moderationcase.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Job field %s", name)
+	return fmt.Errorf("unknown ModerationCase field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JobMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ModerationCaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, moderationcase.EdgeMessage)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *JobMutation) AddedIDs(name string) []ent.Value {
+func (m *ModerationCaseMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case moderationcase.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JobMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ModerationCaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+func (m *ModerationCaseMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JobMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ModerationCaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, moderationcase.EdgeMessage)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *JobMutation) EdgeCleared(name string) bool {
+func (m *ModerationCaseMutation) EdgeCleared(name string) bool {
+	switch name {
+	case moderationcase.EdgeMessage:
+		return m.clearedmessage
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *JobMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Job unique edge %s", name)
+func (m *ModerationCaseMutation) ClearEdge(name string) error {
+	switch name {
+	case moderationcase.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationCase unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *JobMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Job edge %s", name)
+func (m *ModerationCaseMutation) ResetEdge(name string) error {
+	switch name {
+	case moderationcase.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationCase edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// ProblemMutation represents an operation that mutates the Problem nodes in the graph.
+type ProblemMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *types.MessageID
-	author_id              *types.UserID
-	is_visible_for_client  *bool
-	is_visible_for_manager *bool
-	body                   *string
-	checked_at             *time.Time
-	is_blocked             *bool
-	is_service             *bool
-	initial_request_id     *types.RequestID
-	created_at             *time.Time
-	clearedFields          map[string]struct{}
-	chat                   *types.ChatID
-	clearedchat            bool
-	problem                *types.ProblemID
-	clearedproblem         bool
-	done                   bool
-	oldValue               func(context.Context) (*Message, error)
-	predicates             []predicate.Message
+	op                 Op
+	typ                string
+	id                 *types.ProblemID
+	manager_id         *types.UserID
+	resolved_at        *time.Time
+	resolve_request_id *types.RequestID
+	category           *string
+	resolution_code    *string
+	resolution_summary *string
+	rating_score       *int
+	addrating_score    *int
+	rating_comment     *string
+	rated_at           *time.Time
+	reopened_from_id   *types.ProblemID
+	manager_read_at    *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	chat               *types.ChatID
+	clearedchat        bool
+	messages           map[types.MessageID]struct{}
+	removedmessages    map[types.MessageID]struct{}
+	clearedmessages    bool
+	done               bool
+	oldValue           func(context.Context) (*Problem, error)
+	predicates         []predicate.Problem
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*ProblemMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// problemOption allows management of the mutation configuration using functional options.
+type problemOption func(*ProblemMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newProblemMutation creates new mutation for the Problem entity.
+func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation {
+	m := &ProblemMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeProblem,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1774,20 +7954,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id types.MessageID) messageOption {
-	return func(m *MessageMutation) {
+// withProblemID sets the ID field of the mutation.
+func withProblemID(id types.ProblemID) problemOption {
+	return func(m *ProblemMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *Problem
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*Problem, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().Problem.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1796,10 +7976,10 @@ func withMessageID(id types.MessageID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withProblem sets the old Problem of the mutation.
+func withProblem(node *Problem) problemOption {
+	return func(m *ProblemMutation) {
+		m.oldValue = func(context.Context) (*Problem, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1808,7 +7988,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m ProblemMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1816,7 +7996,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m ProblemMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -1826,14 +8006,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id types.MessageID) {
+// operation is only accepted on creation of Problem entities.
+func (m *ProblemMutation) SetID(id types.ProblemID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
+func (m *ProblemMutation) ID() (id types.ProblemID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1844,28 +8024,28 @@ func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]types.MessageID, error) {
+func (m *ProblemMutation) IDs(ctx context.Context) ([]types.ProblemID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.MessageID{id}, nil
+			return []types.ProblemID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Problem.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetChatID sets the "chat_id" field.
-func (m *MessageMutation) SetChatID(ti types.ChatID) {
+func (m *ProblemMutation) SetChatID(ti types.ChatID) {
 	m.chat = &ti
 }
 
 // ChatID returns the value of the "chat_id" field in the mutation.
-func (m *MessageMutation) ChatID() (r types.ChatID, exists bool) {
+func (m *ProblemMutation) ChatID() (r types.ChatID, exists bool) {
 	v := m.chat
 	if v == nil {
 		return
@@ -1873,10 +8053,10 @@ func (m *MessageMutation) ChatID() (r types.ChatID, exists bool) {
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
+func (m *ProblemMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
@@ -1891,367 +8071,577 @@ func (m *MessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err er
 }
 
 // ResetChatID resets all changes to the "chat_id" field.
-func (m *MessageMutation) ResetChatID() {
+func (m *ProblemMutation) ResetChatID() {
 	m.chat = nil
 }
 
-// SetProblemID sets the "problem_id" field.
-func (m *MessageMutation) SetProblemID(ti types.ProblemID) {
-	m.problem = &ti
+// SetManagerID sets the "manager_id" field.
+func (m *ProblemMutation) SetManagerID(ti types.UserID) {
+	m.manager_id = &ti
 }
 
-// ProblemID returns the value of the "problem_id" field in the mutation.
-func (m *MessageMutation) ProblemID() (r types.ProblemID, exists bool) {
-	v := m.problem
+// ManagerID returns the value of the "manager_id" field in the mutation.
+func (m *ProblemMutation) ManagerID() (r types.UserID, exists bool) {
+	v := m.manager_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProblemID returns the old "problem_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldManagerID returns the old "manager_id" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldProblemID(ctx context.Context) (v types.ProblemID, err error) {
+func (m *ProblemMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
+		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProblemID requires an ID field in the mutation")
+		return v, errors.New("OldManagerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+		return v, fmt.Errorf("querying old value for OldManagerID: %w", err)
 	}
-	return oldValue.ProblemID, nil
+	return oldValue.ManagerID, nil
+}
+
+// ClearManagerID clears the value of the "manager_id" field.
+func (m *ProblemMutation) ClearManagerID() {
+	m.manager_id = nil
+	m.clearedFields[problem.FieldManagerID] = struct{}{}
+}
+
+// ManagerIDCleared returns if the "manager_id" field was cleared in this mutation.
+func (m *ProblemMutation) ManagerIDCleared() bool {
+	_, ok := m.clearedFields[problem.FieldManagerID]
+	return ok
+}
+
+// ResetManagerID resets all changes to the "manager_id" field.
+func (m *ProblemMutation) ResetManagerID() {
+	m.manager_id = nil
+	delete(m.clearedFields, problem.FieldManagerID)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *ProblemMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *ProblemMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldResolvedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *ProblemMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[problem.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *ProblemMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[problem.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *ProblemMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, problem.FieldResolvedAt)
+}
+
+// SetResolveRequestID sets the "resolve_request_id" field.
+func (m *ProblemMutation) SetResolveRequestID(ti types.RequestID) {
+	m.resolve_request_id = &ti
+}
+
+// ResolveRequestID returns the value of the "resolve_request_id" field in the mutation.
+func (m *ProblemMutation) ResolveRequestID() (r types.RequestID, exists bool) {
+	v := m.resolve_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolveRequestID returns the old "resolve_request_id" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemMutation) OldResolveRequestID(ctx context.Context) (v types.RequestID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolveRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolveRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolveRequestID: %w", err)
+	}
+	return oldValue.ResolveRequestID, nil
+}
+
+// ClearResolveRequestID clears the value of the "resolve_request_id" field.
+func (m *ProblemMutation) ClearResolveRequestID() {
+	m.resolve_request_id = nil
+	m.clearedFields[problem.FieldResolveRequestID] = struct{}{}
+}
+
+// ResolveRequestIDCleared returns if the "resolve_request_id" field was cleared in this mutation.
+func (m *ProblemMutation) ResolveRequestIDCleared() bool {
+	_, ok := m.clearedFields[problem.FieldResolveRequestID]
+	return ok
 }
 
-// ResetProblemID resets all changes to the "problem_id" field.
-func (m *MessageMutation) ResetProblemID() {
-	m.problem = nil
+// ResetResolveRequestID resets all changes to the "resolve_request_id" field.
+func (m *ProblemMutation) ResetResolveRequestID() {
+	m.resolve_request_id = nil
+	delete(m.clearedFields, problem.FieldResolveRequestID)
 }
 
-// SetAuthorID sets the "author_id" field.
-func (m *MessageMutation) SetAuthorID(ti types.UserID) {
-	m.author_id = &ti
+// SetCategory sets the "category" field.
+func (m *ProblemMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// AuthorID returns the value of the "author_id" field in the mutation.
-func (m *MessageMutation) AuthorID() (r types.UserID, exists bool) {
-	v := m.author_id
+// Category returns the value of the "category" field in the mutation.
+func (m *ProblemMutation) Category() (r string, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthorID returns the old "author_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldAuthorID(ctx context.Context) (v types.UserID, err error) {
+func (m *ProblemMutation) OldCategory(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return oldValue.AuthorID, nil
+	return oldValue.Category, nil
 }
 
-// ClearAuthorID clears the value of the "author_id" field.
-func (m *MessageMutation) ClearAuthorID() {
-	m.author_id = nil
-	m.clearedFields[message.FieldAuthorID] = struct{}{}
+// ClearCategory clears the value of the "category" field.
+func (m *ProblemMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[problem.FieldCategory] = struct{}{}
 }
 
-// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
-func (m *MessageMutation) AuthorIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldAuthorID]
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *ProblemMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[problem.FieldCategory]
 	return ok
 }
 
-// ResetAuthorID resets all changes to the "author_id" field.
-func (m *MessageMutation) ResetAuthorID() {
-	m.author_id = nil
-	delete(m.clearedFields, message.FieldAuthorID)
+// ResetCategory resets all changes to the "category" field.
+func (m *ProblemMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, problem.FieldCategory)
 }
 
-// SetIsVisibleForClient sets the "is_visible_for_client" field.
-func (m *MessageMutation) SetIsVisibleForClient(b bool) {
-	m.is_visible_for_client = &b
+// SetResolutionCode sets the "resolution_code" field.
+func (m *ProblemMutation) SetResolutionCode(s string) {
+	m.resolution_code = &s
 }
 
-// IsVisibleForClient returns the value of the "is_visible_for_client" field in the mutation.
-func (m *MessageMutation) IsVisibleForClient() (r bool, exists bool) {
-	v := m.is_visible_for_client
+// ResolutionCode returns the value of the "resolution_code" field in the mutation.
+func (m *ProblemMutation) ResolutionCode() (r string, exists bool) {
+	v := m.resolution_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsVisibleForClient returns the old "is_visible_for_client" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldResolutionCode returns the old "resolution_code" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsVisibleForClient(ctx context.Context) (v bool, err error) {
+func (m *ProblemMutation) OldResolutionCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVisibleForClient is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolutionCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVisibleForClient requires an ID field in the mutation")
+		return v, errors.New("OldResolutionCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVisibleForClient: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolutionCode: %w", err)
 	}
-	return oldValue.IsVisibleForClient, nil
+	return oldValue.ResolutionCode, nil
 }
 
-// ResetIsVisibleForClient resets all changes to the "is_visible_for_client" field.
-func (m *MessageMutation) ResetIsVisibleForClient() {
-	m.is_visible_for_client = nil
+// ClearResolutionCode clears the value of the "resolution_code" field.
+func (m *ProblemMutation) ClearResolutionCode() {
+	m.resolution_code = nil
+	m.clearedFields[problem.FieldResolutionCode] = struct{}{}
 }
 
-// SetIsVisibleForManager sets the "is_visible_for_manager" field.
-func (m *MessageMutation) SetIsVisibleForManager(b bool) {
-	m.is_visible_for_manager = &b
+// ResolutionCodeCleared returns if the "resolution_code" field was cleared in this mutation.
+func (m *ProblemMutation) ResolutionCodeCleared() bool {
+	_, ok := m.clearedFields[problem.FieldResolutionCode]
+	return ok
 }
 
-// IsVisibleForManager returns the value of the "is_visible_for_manager" field in the mutation.
-func (m *MessageMutation) IsVisibleForManager() (r bool, exists bool) {
-	v := m.is_visible_for_manager
+// ResetResolutionCode resets all changes to the "resolution_code" field.
+func (m *ProblemMutation) ResetResolutionCode() {
+	m.resolution_code = nil
+	delete(m.clearedFields, problem.FieldResolutionCode)
+}
+
+// SetResolutionSummary sets the "resolution_summary" field.
+func (m *ProblemMutation) SetResolutionSummary(s string) {
+	m.resolution_summary = &s
+}
+
+// ResolutionSummary returns the value of the "resolution_summary" field in the mutation.
+func (m *ProblemMutation) ResolutionSummary() (r string, exists bool) {
+	v := m.resolution_summary
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsVisibleForManager returns the old "is_visible_for_manager" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldResolutionSummary returns the old "resolution_summary" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsVisibleForManager(ctx context.Context) (v bool, err error) {
+func (m *ProblemMutation) OldResolutionSummary(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVisibleForManager is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolutionSummary is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVisibleForManager requires an ID field in the mutation")
+		return v, errors.New("OldResolutionSummary requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVisibleForManager: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolutionSummary: %w", err)
 	}
-	return oldValue.IsVisibleForManager, nil
+	return oldValue.ResolutionSummary, nil
 }
 
-// ResetIsVisibleForManager resets all changes to the "is_visible_for_manager" field.
-func (m *MessageMutation) ResetIsVisibleForManager() {
-	m.is_visible_for_manager = nil
+// ClearResolutionSummary clears the value of the "resolution_summary" field.
+func (m *ProblemMutation) ClearResolutionSummary() {
+	m.resolution_summary = nil
+	m.clearedFields[problem.FieldResolutionSummary] = struct{}{}
 }
 
-// SetBody sets the "body" field.
-func (m *MessageMutation) SetBody(s string) {
-	m.body = &s
+// ResolutionSummaryCleared returns if the "resolution_summary" field was cleared in this mutation.
+func (m *ProblemMutation) ResolutionSummaryCleared() bool {
+	_, ok := m.clearedFields[problem.FieldResolutionSummary]
+	return ok
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *MessageMutation) Body() (r string, exists bool) {
-	v := m.body
+// ResetResolutionSummary resets all changes to the "resolution_summary" field.
+func (m *ProblemMutation) ResetResolutionSummary() {
+	m.resolution_summary = nil
+	delete(m.clearedFields, problem.FieldResolutionSummary)
+}
+
+// SetRatingScore sets the "rating_score" field.
+func (m *ProblemMutation) SetRatingScore(i int) {
+	m.rating_score = &i
+	m.addrating_score = nil
+}
+
+// RatingScore returns the value of the "rating_score" field in the mutation.
+func (m *ProblemMutation) RatingScore() (r int, exists bool) {
+	v := m.rating_score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldRatingScore returns the old "rating_score" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
+func (m *ProblemMutation) OldRatingScore(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldRatingScore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+		return v, errors.New("OldRatingScore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldRatingScore: %w", err)
 	}
-	return oldValue.Body, nil
+	return oldValue.RatingScore, nil
 }
 
-// ResetBody resets all changes to the "body" field.
-func (m *MessageMutation) ResetBody() {
-	m.body = nil
+// AddRatingScore adds i to the "rating_score" field.
+func (m *ProblemMutation) AddRatingScore(i int) {
+	if m.addrating_score != nil {
+		*m.addrating_score += i
+	} else {
+		m.addrating_score = &i
+	}
 }
 
-// SetCheckedAt sets the "checked_at" field.
-func (m *MessageMutation) SetCheckedAt(t time.Time) {
-	m.checked_at = &t
+// AddedRatingScore returns the value that was added to the "rating_score" field in this mutation.
+func (m *ProblemMutation) AddedRatingScore() (r int, exists bool) {
+	v := m.addrating_score
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CheckedAt returns the value of the "checked_at" field in the mutation.
-func (m *MessageMutation) CheckedAt() (r time.Time, exists bool) {
-	v := m.checked_at
+// ClearRatingScore clears the value of the "rating_score" field.
+func (m *ProblemMutation) ClearRatingScore() {
+	m.rating_score = nil
+	m.addrating_score = nil
+	m.clearedFields[problem.FieldRatingScore] = struct{}{}
+}
+
+// RatingScoreCleared returns if the "rating_score" field was cleared in this mutation.
+func (m *ProblemMutation) RatingScoreCleared() bool {
+	_, ok := m.clearedFields[problem.FieldRatingScore]
+	return ok
+}
+
+// ResetRatingScore resets all changes to the "rating_score" field.
+func (m *ProblemMutation) ResetRatingScore() {
+	m.rating_score = nil
+	m.addrating_score = nil
+	delete(m.clearedFields, problem.FieldRatingScore)
+}
+
+// SetRatingComment sets the "rating_comment" field.
+func (m *ProblemMutation) SetRatingComment(s string) {
+	m.rating_comment = &s
+}
+
+// RatingComment returns the value of the "rating_comment" field in the mutation.
+func (m *ProblemMutation) RatingComment() (r string, exists bool) {
+	v := m.rating_comment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCheckedAt returns the old "checked_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldRatingComment returns the old "rating_comment" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProblemMutation) OldRatingComment(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRatingComment is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+		return v, errors.New("OldRatingComment requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRatingComment: %w", err)
 	}
-	return oldValue.CheckedAt, nil
+	return oldValue.RatingComment, nil
 }
 
-// ClearCheckedAt clears the value of the "checked_at" field.
-func (m *MessageMutation) ClearCheckedAt() {
-	m.checked_at = nil
-	m.clearedFields[message.FieldCheckedAt] = struct{}{}
+// ClearRatingComment clears the value of the "rating_comment" field.
+func (m *ProblemMutation) ClearRatingComment() {
+	m.rating_comment = nil
+	m.clearedFields[problem.FieldRatingComment] = struct{}{}
 }
 
-// CheckedAtCleared returns if the "checked_at" field was cleared in this mutation.
-func (m *MessageMutation) CheckedAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldCheckedAt]
+// RatingCommentCleared returns if the "rating_comment" field was cleared in this mutation.
+func (m *ProblemMutation) RatingCommentCleared() bool {
+	_, ok := m.clearedFields[problem.FieldRatingComment]
 	return ok
 }
 
-// ResetCheckedAt resets all changes to the "checked_at" field.
-func (m *MessageMutation) ResetCheckedAt() {
-	m.checked_at = nil
-	delete(m.clearedFields, message.FieldCheckedAt)
+// ResetRatingComment resets all changes to the "rating_comment" field.
+func (m *ProblemMutation) ResetRatingComment() {
+	m.rating_comment = nil
+	delete(m.clearedFields, problem.FieldRatingComment)
 }
 
-// SetIsBlocked sets the "is_blocked" field.
-func (m *MessageMutation) SetIsBlocked(b bool) {
-	m.is_blocked = &b
+// SetRatedAt sets the "rated_at" field.
+func (m *ProblemMutation) SetRatedAt(t time.Time) {
+	m.rated_at = &t
 }
 
-// IsBlocked returns the value of the "is_blocked" field in the mutation.
-func (m *MessageMutation) IsBlocked() (r bool, exists bool) {
-	v := m.is_blocked
+// RatedAt returns the value of the "rated_at" field in the mutation.
+func (m *ProblemMutation) RatedAt() (r time.Time, exists bool) {
+	v := m.rated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBlocked returns the old "is_blocked" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldRatedAt returns the old "rated_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+func (m *ProblemMutation) OldRatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+		return v, errors.New("OldRatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+		return v, errors.New("OldRatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+		return v, fmt.Errorf("querying old value for OldRatedAt: %w", err)
 	}
-	return oldValue.IsBlocked, nil
+	return oldValue.RatedAt, nil
 }
 
-// ResetIsBlocked resets all changes to the "is_blocked" field.
-func (m *MessageMutation) ResetIsBlocked() {
-	m.is_blocked = nil
+// ClearRatedAt clears the value of the "rated_at" field.
+func (m *ProblemMutation) ClearRatedAt() {
+	m.rated_at = nil
+	m.clearedFields[problem.FieldRatedAt] = struct{}{}
 }
 
-// SetIsService sets the "is_service" field.
-func (m *MessageMutation) SetIsService(b bool) {
-	m.is_service = &b
+// RatedAtCleared returns if the "rated_at" field was cleared in this mutation.
+func (m *ProblemMutation) RatedAtCleared() bool {
+	_, ok := m.clearedFields[problem.FieldRatedAt]
+	return ok
 }
 
-// IsService returns the value of the "is_service" field in the mutation.
-func (m *MessageMutation) IsService() (r bool, exists bool) {
-	v := m.is_service
+// ResetRatedAt resets all changes to the "rated_at" field.
+func (m *ProblemMutation) ResetRatedAt() {
+	m.rated_at = nil
+	delete(m.clearedFields, problem.FieldRatedAt)
+}
+
+// SetReopenedFromID sets the "reopened_from_id" field.
+func (m *ProblemMutation) SetReopenedFromID(ti types.ProblemID) {
+	m.reopened_from_id = &ti
+}
+
+// ReopenedFromID returns the value of the "reopened_from_id" field in the mutation.
+func (m *ProblemMutation) ReopenedFromID() (r types.ProblemID, exists bool) {
+	v := m.reopened_from_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsService returns the old "is_service" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldReopenedFromID returns the old "reopened_from_id" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsService(ctx context.Context) (v bool, err error) {
+func (m *ProblemMutation) OldReopenedFromID(ctx context.Context) (v types.ProblemID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsService is only allowed on UpdateOne operations")
+		return v, errors.New("OldReopenedFromID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsService requires an ID field in the mutation")
+		return v, errors.New("OldReopenedFromID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsService: %w", err)
+		return v, fmt.Errorf("querying old value for OldReopenedFromID: %w", err)
 	}
-	return oldValue.IsService, nil
+	return oldValue.ReopenedFromID, nil
 }
 
-// ResetIsService resets all changes to the "is_service" field.
-func (m *MessageMutation) ResetIsService() {
-	m.is_service = nil
+// ClearReopenedFromID clears the value of the "reopened_from_id" field.
+func (m *ProblemMutation) ClearReopenedFromID() {
+	m.reopened_from_id = nil
+	m.clearedFields[problem.FieldReopenedFromID] = struct{}{}
 }
 
-// SetInitialRequestID sets the "initial_request_id" field.
-func (m *MessageMutation) SetInitialRequestID(ti types.RequestID) {
-	m.initial_request_id = &ti
+// ReopenedFromIDCleared returns if the "reopened_from_id" field was cleared in this mutation.
+func (m *ProblemMutation) ReopenedFromIDCleared() bool {
+	_, ok := m.clearedFields[problem.FieldReopenedFromID]
+	return ok
 }
 
-// InitialRequestID returns the value of the "initial_request_id" field in the mutation.
-func (m *MessageMutation) InitialRequestID() (r types.RequestID, exists bool) {
-	v := m.initial_request_id
+// ResetReopenedFromID resets all changes to the "reopened_from_id" field.
+func (m *ProblemMutation) ResetReopenedFromID() {
+	m.reopened_from_id = nil
+	delete(m.clearedFields, problem.FieldReopenedFromID)
+}
+
+// SetManagerReadAt sets the "manager_read_at" field.
+func (m *ProblemMutation) SetManagerReadAt(t time.Time) {
+	m.manager_read_at = &t
+}
+
+// ManagerReadAt returns the value of the "manager_read_at" field in the mutation.
+func (m *ProblemMutation) ManagerReadAt() (r time.Time, exists bool) {
+	v := m.manager_read_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInitialRequestID returns the old "initial_request_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldManagerReadAt returns the old "manager_read_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldInitialRequestID(ctx context.Context) (v types.RequestID, err error) {
+func (m *ProblemMutation) OldManagerReadAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInitialRequestID is only allowed on UpdateOne operations")
+		return v, errors.New("OldManagerReadAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInitialRequestID requires an ID field in the mutation")
+		return v, errors.New("OldManagerReadAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInitialRequestID: %w", err)
+		return v, fmt.Errorf("querying old value for OldManagerReadAt: %w", err)
 	}
-	return oldValue.InitialRequestID, nil
+	return oldValue.ManagerReadAt, nil
 }
 
-// ResetInitialRequestID resets all changes to the "initial_request_id" field.
-func (m *MessageMutation) ResetInitialRequestID() {
-	m.initial_request_id = nil
+// ClearManagerReadAt clears the value of the "manager_read_at" field.
+func (m *ProblemMutation) ClearManagerReadAt() {
+	m.manager_read_at = nil
+	m.clearedFields[problem.FieldManagerReadAt] = struct{}{}
+}
+
+// ManagerReadAtCleared returns if the "manager_read_at" field was cleared in this mutation.
+func (m *ProblemMutation) ManagerReadAtCleared() bool {
+	_, ok := m.clearedFields[problem.FieldManagerReadAt]
+	return ok
+}
+
+// ResetManagerReadAt resets all changes to the "manager_read_at" field.
+func (m *ProblemMutation) ResetManagerReadAt() {
+	m.manager_read_at = nil
+	delete(m.clearedFields, problem.FieldManagerReadAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
+func (m *ProblemMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ProblemMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2259,10 +8649,10 @@ func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProblemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2277,25 +8667,25 @@ func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
+func (m *ProblemMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // ClearChat clears the "chat" edge to the Chat entity.
-func (m *MessageMutation) ClearChat() {
+func (m *ProblemMutation) ClearChat() {
 	m.clearedchat = true
-	m.clearedFields[message.FieldChatID] = struct{}{}
+	m.clearedFields[problem.FieldChatID] = struct{}{}
 }
 
 // ChatCleared reports if the "chat" edge to the Chat entity was cleared.
-func (m *MessageMutation) ChatCleared() bool {
+func (m *ProblemMutation) ChatCleared() bool {
 	return m.clearedchat
 }
 
 // ChatIDs returns the "chat" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ChatID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ChatIDs() (ids []types.ChatID) {
+func (m *ProblemMutation) ChatIDs() (ids []types.ChatID) {
 	if id := m.chat; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2303,47 +8693,74 @@ func (m *MessageMutation) ChatIDs() (ids []types.ChatID) {
 }
 
 // ResetChat resets all changes to the "chat" edge.
-func (m *MessageMutation) ResetChat() {
+func (m *ProblemMutation) ResetChat() {
 	m.chat = nil
 	m.clearedchat = false
 }
 
-// ClearProblem clears the "problem" edge to the Problem entity.
-func (m *MessageMutation) ClearProblem() {
-	m.clearedproblem = true
-	m.clearedFields[message.FieldProblemID] = struct{}{}
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *ProblemMutation) AddMessageIDs(ids ...types.MessageID) {
+	if m.messages == nil {
+		m.messages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
 }
 
-// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
-func (m *MessageMutation) ProblemCleared() bool {
-	return m.clearedproblem
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *ProblemMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *ProblemMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *ProblemMutation) RemoveMessageIDs(ids ...types.MessageID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *ProblemMutation) RemovedMessagesIDs() (ids []types.MessageID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ProblemIDs returns the "problem" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProblemID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ProblemIDs() (ids []types.ProblemID) {
-	if id := m.problem; id != nil {
-		ids = append(ids, *id)
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *ProblemMutation) MessagesIDs() (ids []types.MessageID) {
+	for id := range m.messages {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetProblem resets all changes to the "problem" edge.
-func (m *MessageMutation) ResetProblem() {
-	m.problem = nil
-	m.clearedproblem = false
+// ResetMessages resets all changes to the "messages" edge.
+func (m *ProblemMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the ProblemMutation builder.
+func (m *ProblemMutation) Where(ps ...predicate.Problem) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProblemMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Problem, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2351,57 +8768,63 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *ProblemMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *ProblemMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (Problem).
+func (m *ProblemMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *ProblemMutation) Fields() []string {
+	fields := make([]string, 0, 13)
 	if m.chat != nil {
-		fields = append(fields, message.FieldChatID)
+		fields = append(fields, problem.FieldChatID)
 	}
-	if m.problem != nil {
-		fields = append(fields, message.FieldProblemID)
+	if m.manager_id != nil {
+		fields = append(fields, problem.FieldManagerID)
 	}
-	if m.author_id != nil {
-		fields = append(fields, message.FieldAuthorID)
+	if m.resolved_at != nil {
+		fields = append(fields, problem.FieldResolvedAt)
 	}
-	if m.is_visible_for_client != nil {
-		fields = append(fields, message.FieldIsVisibleForClient)
+	if m.resolve_request_id != nil {
+		fields = append(fields, problem.FieldResolveRequestID)
 	}
-	if m.is_visible_for_manager != nil {
-		fields = append(fields, message.FieldIsVisibleForManager)
+	if m.category != nil {
+		fields = append(fields, problem.FieldCategory)
 	}
-	if m.body != nil {
-		fields = append(fields, message.FieldBody)
+	if m.resolution_code != nil {
+		fields = append(fields, problem.FieldResolutionCode)
 	}
-	if m.checked_at != nil {
-		fields = append(fields, message.FieldCheckedAt)
+	if m.resolution_summary != nil {
+		fields = append(fields, problem.FieldResolutionSummary)
 	}
-	if m.is_blocked != nil {
-		fields = append(fields, message.FieldIsBlocked)
+	if m.rating_score != nil {
+		fields = append(fields, problem.FieldRatingScore)
 	}
-	if m.is_service != nil {
-		fields = append(fields, message.FieldIsService)
+	if m.rating_comment != nil {
+		fields = append(fields, problem.FieldRatingComment)
 	}
-	if m.initial_request_id != nil {
-		fields = append(fields, message.FieldInitialRequestID)
+	if m.rated_at != nil {
+		fields = append(fields, problem.FieldRatedAt)
+	}
+	if m.reopened_from_id != nil {
+		fields = append(fields, problem.FieldReopenedFromID)
+	}
+	if m.manager_read_at != nil {
+		fields = append(fields, problem.FieldManagerReadAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
+		fields = append(fields, problem.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2409,29 +8832,33 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		return m.ChatID()
-	case message.FieldProblemID:
-		return m.ProblemID()
-	case message.FieldAuthorID:
-		return m.AuthorID()
-	case message.FieldIsVisibleForClient:
-		return m.IsVisibleForClient()
-	case message.FieldIsVisibleForManager:
-		return m.IsVisibleForManager()
-	case message.FieldBody:
-		return m.Body()
-	case message.FieldCheckedAt:
-		return m.CheckedAt()
-	case message.FieldIsBlocked:
-		return m.IsBlocked()
-	case message.FieldIsService:
-		return m.IsService()
-	case message.FieldInitialRequestID:
-		return m.InitialRequestID()
-	case message.FieldCreatedAt:
+	case problem.FieldManagerID:
+		return m.ManagerID()
+	case problem.FieldResolvedAt:
+		return m.ResolvedAt()
+	case problem.FieldResolveRequestID:
+		return m.ResolveRequestID()
+	case problem.FieldCategory:
+		return m.Category()
+	case problem.FieldResolutionCode:
+		return m.ResolutionCode()
+	case problem.FieldResolutionSummary:
+		return m.ResolutionSummary()
+	case problem.FieldRatingScore:
+		return m.RatingScore()
+	case problem.FieldRatingComment:
+		return m.RatingComment()
+	case problem.FieldRatedAt:
+		return m.RatedAt()
+	case problem.FieldReopenedFromID:
+		return m.ReopenedFromID()
+	case problem.FieldManagerReadAt:
+		return m.ManagerReadAt()
+	case problem.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -2440,110 +8867,128 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProblemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		return m.OldChatID(ctx)
-	case message.FieldProblemID:
-		return m.OldProblemID(ctx)
-	case message.FieldAuthorID:
-		return m.OldAuthorID(ctx)
-	case message.FieldIsVisibleForClient:
-		return m.OldIsVisibleForClient(ctx)
-	case message.FieldIsVisibleForManager:
-		return m.OldIsVisibleForManager(ctx)
-	case message.FieldBody:
-		return m.OldBody(ctx)
-	case message.FieldCheckedAt:
-		return m.OldCheckedAt(ctx)
-	case message.FieldIsBlocked:
-		return m.OldIsBlocked(ctx)
-	case message.FieldIsService:
-		return m.OldIsService(ctx)
-	case message.FieldInitialRequestID:
-		return m.OldInitialRequestID(ctx)
-	case message.FieldCreatedAt:
+	case problem.FieldManagerID:
+		return m.OldManagerID(ctx)
+	case problem.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case problem.FieldResolveRequestID:
+		return m.OldResolveRequestID(ctx)
+	case problem.FieldCategory:
+		return m.OldCategory(ctx)
+	case problem.FieldResolutionCode:
+		return m.OldResolutionCode(ctx)
+	case problem.FieldResolutionSummary:
+		return m.OldResolutionSummary(ctx)
+	case problem.FieldRatingScore:
+		return m.OldRatingScore(ctx)
+	case problem.FieldRatingComment:
+		return m.OldRatingComment(ctx)
+	case problem.FieldRatedAt:
+		return m.OldRatedAt(ctx)
+	case problem.FieldReopenedFromID:
+		return m.OldReopenedFromID(ctx)
+	case problem.FieldManagerReadAt:
+		return m.OldManagerReadAt(ctx)
+	case problem.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown Problem field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *ProblemMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		v, ok := value.(types.ChatID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChatID(v)
 		return nil
-	case message.FieldProblemID:
-		v, ok := value.(types.ProblemID)
+	case problem.FieldManagerID:
+		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProblemID(v)
+		m.SetManagerID(v)
 		return nil
-	case message.FieldAuthorID:
-		v, ok := value.(types.UserID)
+	case problem.FieldResolvedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthorID(v)
+		m.SetResolvedAt(v)
 		return nil
-	case message.FieldIsVisibleForClient:
-		v, ok := value.(bool)
+	case problem.FieldResolveRequestID:
+		v, ok := value.(types.RequestID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsVisibleForClient(v)
+		m.SetResolveRequestID(v)
 		return nil
-	case message.FieldIsVisibleForManager:
-		v, ok := value.(bool)
+	case problem.FieldCategory:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsVisibleForManager(v)
+		m.SetCategory(v)
 		return nil
-	case message.FieldBody:
+	case problem.FieldResolutionCode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBody(v)
+		m.SetResolutionCode(v)
 		return nil
-	case message.FieldCheckedAt:
-		v, ok := value.(time.Time)
+	case problem.FieldResolutionSummary:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCheckedAt(v)
+		m.SetResolutionSummary(v)
 		return nil
-	case message.FieldIsBlocked:
-		v, ok := value.(bool)
+	case problem.FieldRatingScore:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsBlocked(v)
+		m.SetRatingScore(v)
 		return nil
-	case message.FieldIsService:
-		v, ok := value.(bool)
+	case problem.FieldRatingComment:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsService(v)
+		m.SetRatingComment(v)
 		return nil
-	case message.FieldInitialRequestID:
-		v, ok := value.(types.RequestID)
+	case problem.FieldRatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInitialRequestID(v)
+		m.SetRatedAt(v)
 		return nil
-	case message.FieldCreatedAt:
+	case problem.FieldReopenedFromID:
+		v, ok := value.(types.ProblemID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReopenedFromID(v)
+		return nil
+	case problem.FieldManagerReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManagerReadAt(v)
+		return nil
+	case problem.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2551,230 +8996,311 @@ func (m *MessageMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Problem field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
-	return nil
+func (m *ProblemMutation) AddedFields() []string {
+	var fields []string
+	if m.addrating_score != nil {
+		fields = append(fields, problem.FieldRatingScore)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProblemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case problem.FieldRatingScore:
+		return m.AddedRatingScore()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *ProblemMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case problem.FieldRatingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRatingScore(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown Problem numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *ProblemMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldAuthorID) {
-		fields = append(fields, message.FieldAuthorID)
+	if m.FieldCleared(problem.FieldManagerID) {
+		fields = append(fields, problem.FieldManagerID)
 	}
-	if m.FieldCleared(message.FieldCheckedAt) {
-		fields = append(fields, message.FieldCheckedAt)
+	if m.FieldCleared(problem.FieldResolvedAt) {
+		fields = append(fields, problem.FieldResolvedAt)
+	}
+	if m.FieldCleared(problem.FieldResolveRequestID) {
+		fields = append(fields, problem.FieldResolveRequestID)
+	}
+	if m.FieldCleared(problem.FieldCategory) {
+		fields = append(fields, problem.FieldCategory)
+	}
+	if m.FieldCleared(problem.FieldResolutionCode) {
+		fields = append(fields, problem.FieldResolutionCode)
+	}
+	if m.FieldCleared(problem.FieldResolutionSummary) {
+		fields = append(fields, problem.FieldResolutionSummary)
+	}
+	if m.FieldCleared(problem.FieldRatingScore) {
+		fields = append(fields, problem.FieldRatingScore)
+	}
+	if m.FieldCleared(problem.FieldRatingComment) {
+		fields = append(fields, problem.FieldRatingComment)
+	}
+	if m.FieldCleared(problem.FieldRatedAt) {
+		fields = append(fields, problem.FieldRatedAt)
+	}
+	if m.FieldCleared(problem.FieldReopenedFromID) {
+		fields = append(fields, problem.FieldReopenedFromID)
+	}
+	if m.FieldCleared(problem.FieldManagerReadAt) {
+		fields = append(fields, problem.FieldManagerReadAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *ProblemMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *ProblemMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldAuthorID:
-		m.ClearAuthorID()
+	case problem.FieldManagerID:
+		m.ClearManagerID()
+		return nil
+	case problem.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	case problem.FieldResolveRequestID:
+		m.ClearResolveRequestID()
+		return nil
+	case problem.FieldCategory:
+		m.ClearCategory()
+		return nil
+	case problem.FieldResolutionCode:
+		m.ClearResolutionCode()
+		return nil
+	case problem.FieldResolutionSummary:
+		m.ClearResolutionSummary()
+		return nil
+	case problem.FieldRatingScore:
+		m.ClearRatingScore()
+		return nil
+	case problem.FieldRatingComment:
+		m.ClearRatingComment()
+		return nil
+	case problem.FieldRatedAt:
+		m.ClearRatedAt()
 		return nil
-	case message.FieldCheckedAt:
-		m.ClearCheckedAt()
+	case problem.FieldReopenedFromID:
+		m.ClearReopenedFromID()
+		return nil
+	case problem.FieldManagerReadAt:
+		m.ClearManagerReadAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown Problem nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *ProblemMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		m.ResetChatID()
 		return nil
-	case message.FieldProblemID:
-		m.ResetProblemID()
+	case problem.FieldManagerID:
+		m.ResetManagerID()
 		return nil
-	case message.FieldAuthorID:
-		m.ResetAuthorID()
+	case problem.FieldResolvedAt:
+		m.ResetResolvedAt()
 		return nil
-	case message.FieldIsVisibleForClient:
-		m.ResetIsVisibleForClient()
+	case problem.FieldResolveRequestID:
+		m.ResetResolveRequestID()
 		return nil
-	case message.FieldIsVisibleForManager:
-		m.ResetIsVisibleForManager()
+	case problem.FieldCategory:
+		m.ResetCategory()
 		return nil
-	case message.FieldBody:
-		m.ResetBody()
+	case problem.FieldResolutionCode:
+		m.ResetResolutionCode()
 		return nil
-	case message.FieldCheckedAt:
-		m.ResetCheckedAt()
+	case problem.FieldResolutionSummary:
+		m.ResetResolutionSummary()
 		return nil
-	case message.FieldIsBlocked:
-		m.ResetIsBlocked()
+	case problem.FieldRatingScore:
+		m.ResetRatingScore()
 		return nil
-	case message.FieldIsService:
-		m.ResetIsService()
+	case problem.FieldRatingComment:
+		m.ResetRatingComment()
 		return nil
-	case message.FieldInitialRequestID:
-		m.ResetInitialRequestID()
+	case problem.FieldRatedAt:
+		m.ResetRatedAt()
 		return nil
-	case message.FieldCreatedAt:
+	case problem.FieldReopenedFromID:
+		m.ResetReopenedFromID()
+		return nil
+	case problem.FieldManagerReadAt:
+		m.ResetManagerReadAt()
+		return nil
+	case problem.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Problem field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
+func (m *ProblemMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.chat != nil {
-		edges = append(edges, message.EdgeChat)
+		edges = append(edges, problem.EdgeChat)
 	}
-	if m.problem != nil {
-		edges = append(edges, message.EdgeProblem)
+	if m.messages != nil {
+		edges = append(edges, problem.EdgeMessages)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *ProblemMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		if id := m.chat; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeProblem:
-		if id := m.problem; id != nil {
-			return []ent.Value{*id}
+	case problem.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
+func (m *ProblemMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
+	if m.removedmessages != nil {
+		edges = append(edges, problem.EdgeMessages)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProblemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case problem.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
+func (m *ProblemMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.clearedchat {
-		edges = append(edges, message.EdgeChat)
+		edges = append(edges, problem.EdgeChat)
 	}
-	if m.clearedproblem {
-		edges = append(edges, message.EdgeProblem)
+	if m.clearedmessages {
+		edges = append(edges, problem.EdgeMessages)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *ProblemMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		return m.clearedchat
-	case message.EdgeProblem:
-		return m.clearedproblem
+	case problem.EdgeMessages:
+		return m.clearedmessages
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *ProblemMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		m.ClearChat()
 		return nil
-	case message.EdgeProblem:
-		m.ClearProblem()
-		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown Problem unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *ProblemMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		m.ResetChat()
 		return nil
-	case message.EdgeProblem:
-		m.ResetProblem()
+	case problem.EdgeMessages:
+		m.ResetMessages()
 		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown Problem edge %s", name)
 }
 
-// ProblemMutation represents an operation that mutates the Problem nodes in the graph.
-type ProblemMutation struct {
+// VerdictConflictMutation represents an operation that mutates the VerdictConflict nodes in the graph.
+type VerdictConflictMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *types.ProblemID
-	manager_id         *types.UserID
-	resolved_at        *time.Time
-	resolve_request_id *types.RequestID
-	created_at         *time.Time
-	clearedFields      map[string]struct{}
-	chat               *types.ChatID
-	clearedchat        bool
-	messages           map[types.MessageID]struct{}
-	removedmessages    map[types.MessageID]struct{}
-	clearedmessages    bool
-	done               bool
-	oldValue           func(context.Context) (*Problem, error)
-	predicates         []predicate.Problem
-}
-
-var _ ent.Mutation = (*ProblemMutation)(nil)
-
-// problemOption allows management of the mutation configuration using functional options.
-type problemOption func(*ProblemMutation)
-
-// newProblemMutation creates new mutation for the Problem entity.
-func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation {
-	m := &ProblemMutation{
+	op               Op
+	typ              string
+	id               *types.VerdictConflictID
+	applied_verdict  *verdictconflict.AppliedVerdict
+	received_verdict *verdictconflict.ReceivedVerdict
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	message          *types.MessageID
+	clearedmessage   bool
+	done             bool
+	oldValue         func(context.Context) (*VerdictConflict, error)
+	predicates       []predicate.VerdictConflict
+}
+
+var _ ent.Mutation = (*VerdictConflictMutation)(nil)
+
+// verdictconflictOption allows management of the mutation configuration using functional options.
+type verdictconflictOption func(*VerdictConflictMutation)
+
+// newVerdictConflictMutation creates new mutation for the VerdictConflict entity.
+func newVerdictConflictMutation(c config, op Op, opts ...verdictconflictOption) *VerdictConflictMutation {
+	m := &VerdictConflictMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProblem,
+		typ:           TypeVerdictConflict,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2783,20 +9309,20 @@ func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation
 	return m
 }
 
-// withProblemID sets the ID field of the mutation.
-func withProblemID(id types.ProblemID) problemOption {
-	return func(m *ProblemMutation) {
+// withVerdictConflictID sets the ID field of the mutation.
+func withVerdictConflictID(id types.VerdictConflictID) verdictconflictOption {
+	return func(m *VerdictConflictMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Problem
+			value *VerdictConflict
 		)
-		m.oldValue = func(ctx context.Context) (*Problem, error) {
+		m.oldValue = func(ctx context.Context) (*VerdictConflict, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Problem.Get(ctx, id)
+					value, err = m.Client().VerdictConflict.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2805,10 +9331,10 @@ func withProblemID(id types.ProblemID) problemOption {
 	}
 }
 
-// withProblem sets the old Problem of the mutation.
-func withProblem(node *Problem) problemOption {
-	return func(m *ProblemMutation) {
-		m.oldValue = func(context.Context) (*Problem, error) {
+// withVerdictConflict sets the old VerdictConflict of the mutation.
+func withVerdictConflict(node *VerdictConflict) verdictconflictOption {
+	return func(m *VerdictConflictMutation) {
+		m.oldValue = func(context.Context) (*VerdictConflict, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2817,7 +9343,7 @@ func withProblem(node *Problem) problemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProblemMutation) Client() *Client {
+func (m VerdictConflictMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2825,7 +9351,7 @@ func (m ProblemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProblemMutation) Tx() (*Tx, error) {
+func (m VerdictConflictMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -2835,14 +9361,14 @@ func (m ProblemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Problem entities.
-func (m *ProblemMutation) SetID(id types.ProblemID) {
+// operation is only accepted on creation of VerdictConflict entities.
+func (m *VerdictConflictMutation) SetID(id types.VerdictConflictID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProblemMutation) ID() (id types.ProblemID, exists bool) {
+func (m *VerdictConflictMutation) ID() (id types.VerdictConflictID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2853,211 +9379,136 @@ func (m *ProblemMutation) ID() (id types.ProblemID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProblemMutation) IDs(ctx context.Context) ([]types.ProblemID, error) {
+func (m *VerdictConflictMutation) IDs(ctx context.Context) ([]types.VerdictConflictID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.ProblemID{id}, nil
+			return []types.VerdictConflictID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Problem.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().VerdictConflict.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *ProblemMutation) SetChatID(ti types.ChatID) {
-	m.chat = &ti
-}
-
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *ProblemMutation) ChatID() (r types.ChatID, exists bool) {
-	v := m.chat
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldChatID returns the old "chat_id" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
-	}
-	return oldValue.ChatID, nil
-}
-
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *ProblemMutation) ResetChatID() {
-	m.chat = nil
-}
-
-// SetManagerID sets the "manager_id" field.
-func (m *ProblemMutation) SetManagerID(ti types.UserID) {
-	m.manager_id = &ti
+// SetMessageID sets the "message_id" field.
+func (m *VerdictConflictMutation) SetMessageID(ti types.MessageID) {
+	m.message = &ti
 }
 
-// ManagerID returns the value of the "manager_id" field in the mutation.
-func (m *ProblemMutation) ManagerID() (r types.UserID, exists bool) {
-	v := m.manager_id
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *VerdictConflictMutation) MessageID() (r types.MessageID, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldManagerID returns the old "manager_id" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageID returns the old "message_id" field's value of the VerdictConflict entity.
+// If the VerdictConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
+func (m *VerdictConflictMutation) OldMessageID(ctx context.Context) (v types.MessageID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldManagerID requires an ID field in the mutation")
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldManagerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
 	}
-	return oldValue.ManagerID, nil
-}
-
-// ClearManagerID clears the value of the "manager_id" field.
-func (m *ProblemMutation) ClearManagerID() {
-	m.manager_id = nil
-	m.clearedFields[problem.FieldManagerID] = struct{}{}
-}
-
-// ManagerIDCleared returns if the "manager_id" field was cleared in this mutation.
-func (m *ProblemMutation) ManagerIDCleared() bool {
-	_, ok := m.clearedFields[problem.FieldManagerID]
-	return ok
+	return oldValue.MessageID, nil
 }
 
-// ResetManagerID resets all changes to the "manager_id" field.
-func (m *ProblemMutation) ResetManagerID() {
-	m.manager_id = nil
-	delete(m.clearedFields, problem.FieldManagerID)
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *VerdictConflictMutation) ResetMessageID() {
+	m.message = nil
 }
 
-// SetResolvedAt sets the "resolved_at" field.
-func (m *ProblemMutation) SetResolvedAt(t time.Time) {
-	m.resolved_at = &t
+// SetAppliedVerdict sets the "applied_verdict" field.
+func (m *VerdictConflictMutation) SetAppliedVerdict(vv verdictconflict.AppliedVerdict) {
+	m.applied_verdict = &vv
 }
 
-// ResolvedAt returns the value of the "resolved_at" field in the mutation.
-func (m *ProblemMutation) ResolvedAt() (r time.Time, exists bool) {
-	v := m.resolved_at
+// AppliedVerdict returns the value of the "applied_verdict" field in the mutation.
+func (m *VerdictConflictMutation) AppliedVerdict() (r verdictconflict.AppliedVerdict, exists bool) {
+	v := m.applied_verdict
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolvedAt returns the old "resolved_at" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldAppliedVerdict returns the old "applied_verdict" field's value of the VerdictConflict entity.
+// If the VerdictConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldResolvedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VerdictConflictMutation) OldAppliedVerdict(ctx context.Context) (v verdictconflict.AppliedVerdict, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAppliedVerdict is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+		return v, errors.New("OldAppliedVerdict requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAppliedVerdict: %w", err)
 	}
-	return oldValue.ResolvedAt, nil
-}
-
-// ClearResolvedAt clears the value of the "resolved_at" field.
-func (m *ProblemMutation) ClearResolvedAt() {
-	m.resolved_at = nil
-	m.clearedFields[problem.FieldResolvedAt] = struct{}{}
+	return oldValue.AppliedVerdict, nil
 }
 
-// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
-func (m *ProblemMutation) ResolvedAtCleared() bool {
-	_, ok := m.clearedFields[problem.FieldResolvedAt]
-	return ok
-}
-
-// ResetResolvedAt resets all changes to the "resolved_at" field.
-func (m *ProblemMutation) ResetResolvedAt() {
-	m.resolved_at = nil
-	delete(m.clearedFields, problem.FieldResolvedAt)
+// ResetAppliedVerdict resets all changes to the "applied_verdict" field.
+func (m *VerdictConflictMutation) ResetAppliedVerdict() {
+	m.applied_verdict = nil
 }
 
-// SetResolveRequestID sets the "resolve_request_id" field.
-func (m *ProblemMutation) SetResolveRequestID(ti types.RequestID) {
-	m.resolve_request_id = &ti
+// SetReceivedVerdict sets the "received_verdict" field.
+func (m *VerdictConflictMutation) SetReceivedVerdict(vv verdictconflict.ReceivedVerdict) {
+	m.received_verdict = &vv
 }
 
-// ResolveRequestID returns the value of the "resolve_request_id" field in the mutation.
-func (m *ProblemMutation) ResolveRequestID() (r types.RequestID, exists bool) {
-	v := m.resolve_request_id
+// ReceivedVerdict returns the value of the "received_verdict" field in the mutation.
+func (m *VerdictConflictMutation) ReceivedVerdict() (r verdictconflict.ReceivedVerdict, exists bool) {
+	v := m.received_verdict
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolveRequestID returns the old "resolve_request_id" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldReceivedVerdict returns the old "received_verdict" field's value of the VerdictConflict entity.
+// If the VerdictConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldResolveRequestID(ctx context.Context) (v types.RequestID, err error) {
+func (m *VerdictConflictMutation) OldReceivedVerdict(ctx context.Context) (v verdictconflict.ReceivedVerdict, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolveRequestID is only allowed on UpdateOne operations")
+		return v, errors.New("OldReceivedVerdict is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolveRequestID requires an ID field in the mutation")
+		return v, errors.New("OldReceivedVerdict requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolveRequestID: %w", err)
+		return v, fmt.Errorf("querying old value for OldReceivedVerdict: %w", err)
 	}
-	return oldValue.ResolveRequestID, nil
-}
-
-// ClearResolveRequestID clears the value of the "resolve_request_id" field.
-func (m *ProblemMutation) ClearResolveRequestID() {
-	m.resolve_request_id = nil
-	m.clearedFields[problem.FieldResolveRequestID] = struct{}{}
-}
-
-// ResolveRequestIDCleared returns if the "resolve_request_id" field was cleared in this mutation.
-func (m *ProblemMutation) ResolveRequestIDCleared() bool {
-	_, ok := m.clearedFields[problem.FieldResolveRequestID]
-	return ok
+	return oldValue.ReceivedVerdict, nil
 }
 
-// ResetResolveRequestID resets all changes to the "resolve_request_id" field.
-func (m *ProblemMutation) ResetResolveRequestID() {
-	m.resolve_request_id = nil
-	delete(m.clearedFields, problem.FieldResolveRequestID)
+// ResetReceivedVerdict resets all changes to the "received_verdict" field.
+func (m *VerdictConflictMutation) ResetReceivedVerdict() {
+	m.received_verdict = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ProblemMutation) SetCreatedAt(t time.Time) {
+func (m *VerdictConflictMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProblemMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *VerdictConflictMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3065,10 +9516,10 @@ func (m *ProblemMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the VerdictConflict entity.
+// If the VerdictConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VerdictConflictMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3083,100 +9534,46 @@ func (m *ProblemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProblemMutation) ResetCreatedAt() {
+func (m *VerdictConflictMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// ClearChat clears the "chat" edge to the Chat entity.
-func (m *ProblemMutation) ClearChat() {
-	m.clearedchat = true
-	m.clearedFields[problem.FieldChatID] = struct{}{}
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *VerdictConflictMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[verdictconflict.FieldMessageID] = struct{}{}
 }
 
-// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
-func (m *ProblemMutation) ChatCleared() bool {
-	return m.clearedchat
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *VerdictConflictMutation) MessageCleared() bool {
+	return m.clearedmessage
 }
 
-// ChatIDs returns the "chat" edge IDs in the mutation.
+// MessageIDs returns the "message" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChatID instead. It exists only for internal usage by the builders.
-func (m *ProblemMutation) ChatIDs() (ids []types.ChatID) {
-	if id := m.chat; id != nil {
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *VerdictConflictMutation) MessageIDs() (ids []types.MessageID) {
+	if id := m.message; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetChat resets all changes to the "chat" edge.
-func (m *ProblemMutation) ResetChat() {
-	m.chat = nil
-	m.clearedchat = false
-}
-
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *ProblemMutation) AddMessageIDs(ids ...types.MessageID) {
-	if m.messages == nil {
-		m.messages = make(map[types.MessageID]struct{})
-	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
-	}
-}
-
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *ProblemMutation) ClearMessages() {
-	m.clearedmessages = true
-}
-
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *ProblemMutation) MessagesCleared() bool {
-	return m.clearedmessages
-}
-
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *ProblemMutation) RemoveMessageIDs(ids ...types.MessageID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[types.MessageID]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *ProblemMutation) RemovedMessagesIDs() (ids []types.MessageID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *ProblemMutation) MessagesIDs() (ids []types.MessageID) {
-	for id := range m.messages {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetMessages resets all changes to the "messages" edge.
-func (m *ProblemMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// ResetMessage resets all changes to the "message" edge.
+func (m *VerdictConflictMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
 }
 
-// Where appends a list predicates to the ProblemMutation builder.
-func (m *ProblemMutation) Where(ps ...predicate.Problem) {
+// Where appends a list predicates to the VerdictConflictMutation builder.
+func (m *VerdictConflictMutation) Where(ps ...predicate.VerdictConflict) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProblemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VerdictConflictMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Problem, len(ps))
+func (m *VerdictConflictMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VerdictConflict, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3184,39 +9581,36 @@ func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProblemMutation) Op() Op {
+func (m *VerdictConflictMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProblemMutation) SetOp(op Op) {
+func (m *VerdictConflictMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Problem).
-func (m *ProblemMutation) Type() string {
+// Type returns the node type of this mutation (VerdictConflict).
+func (m *VerdictConflictMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProblemMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.chat != nil {
-		fields = append(fields, problem.FieldChatID)
-	}
-	if m.manager_id != nil {
-		fields = append(fields, problem.FieldManagerID)
+func (m *VerdictConflictMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.message != nil {
+		fields = append(fields, verdictconflict.FieldMessageID)
 	}
-	if m.resolved_at != nil {
-		fields = append(fields, problem.FieldResolvedAt)
+	if m.applied_verdict != nil {
+		fields = append(fields, verdictconflict.FieldAppliedVerdict)
 	}
-	if m.resolve_request_id != nil {
-		fields = append(fields, problem.FieldResolveRequestID)
+	if m.received_verdict != nil {
+		fields = append(fields, verdictconflict.FieldReceivedVerdict)
 	}
 	if m.created_at != nil {
-		fields = append(fields, problem.FieldCreatedAt)
+		fields = append(fields, verdictconflict.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3224,17 +9618,15 @@ func (m *ProblemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
+func (m *VerdictConflictMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case problem.FieldChatID:
-		return m.ChatID()
-	case problem.FieldManagerID:
-		return m.ManagerID()
-	case problem.FieldResolvedAt:
-		return m.ResolvedAt()
-	case problem.FieldResolveRequestID:
-		return m.ResolveRequestID()
-	case problem.FieldCreatedAt:
+	case verdictconflict.FieldMessageID:
+		return m.MessageID()
+	case verdictconflict.FieldAppliedVerdict:
+		return m.AppliedVerdict()
+	case verdictconflict.FieldReceivedVerdict:
+		return m.ReceivedVerdict()
+	case verdictconflict.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -3243,56 +9635,47 @@ func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProblemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VerdictConflictMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case problem.FieldChatID:
-		return m.OldChatID(ctx)
-	case problem.FieldManagerID:
-		return m.OldManagerID(ctx)
-	case problem.FieldResolvedAt:
-		return m.OldResolvedAt(ctx)
-	case problem.FieldResolveRequestID:
-		return m.OldResolveRequestID(ctx)
-	case problem.FieldCreatedAt:
+	case verdictconflict.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case verdictconflict.FieldAppliedVerdict:
+		return m.OldAppliedVerdict(ctx)
+	case verdictconflict.FieldReceivedVerdict:
+		return m.OldReceivedVerdict(ctx)
+	case verdictconflict.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Problem field %s", name)
+	return nil, fmt.Errorf("unknown VerdictConflict field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemMutation) SetField(name string, value ent.Value) error {
+func (m *VerdictConflictMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case problem.FieldChatID:
-		v, ok := value.(types.ChatID)
+	case verdictconflict.FieldMessageID:
+		v, ok := value.(types.MessageID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatID(v)
-		return nil
-	case problem.FieldManagerID:
-		v, ok := value.(types.UserID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetManagerID(v)
+		m.SetMessageID(v)
 		return nil
-	case problem.FieldResolvedAt:
-		v, ok := value.(time.Time)
+	case verdictconflict.FieldAppliedVerdict:
+		v, ok := value.(verdictconflict.AppliedVerdict)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolvedAt(v)
+		m.SetAppliedVerdict(v)
 		return nil
-	case problem.FieldResolveRequestID:
-		v, ok := value.(types.RequestID)
+	case verdictconflict.FieldReceivedVerdict:
+		v, ok := value.(verdictconflict.ReceivedVerdict)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolveRequestID(v)
+		m.SetReceivedVerdict(v)
 		return nil
-	case problem.FieldCreatedAt:
+	case verdictconflict.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3300,192 +9683,140 @@ func (m *ProblemMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Problem field %s", name)
+	return fmt.Errorf("unknown VerdictConflict field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProblemMutation) AddedFields() []string {
+func (m *VerdictConflictMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProblemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VerdictConflictMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemMutation) AddField(name string, value ent.Value) error {
+func (m *VerdictConflictMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Problem numeric field %s", name)
+	return fmt.Errorf("unknown VerdictConflict numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProblemMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(problem.FieldManagerID) {
-		fields = append(fields, problem.FieldManagerID)
-	}
-	if m.FieldCleared(problem.FieldResolvedAt) {
-		fields = append(fields, problem.FieldResolvedAt)
-	}
-	if m.FieldCleared(problem.FieldResolveRequestID) {
-		fields = append(fields, problem.FieldResolveRequestID)
-	}
-	return fields
+func (m *VerdictConflictMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProblemMutation) FieldCleared(name string) bool {
+func (m *VerdictConflictMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProblemMutation) ClearField(name string) error {
-	switch name {
-	case problem.FieldManagerID:
-		m.ClearManagerID()
-		return nil
-	case problem.FieldResolvedAt:
-		m.ClearResolvedAt()
-		return nil
-	case problem.FieldResolveRequestID:
-		m.ClearResolveRequestID()
-		return nil
-	}
-	return fmt.Errorf("unknown Problem nullable field %s", name)
+func (m *VerdictConflictMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown VerdictConflict nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProblemMutation) ResetField(name string) error {
+func (m *VerdictConflictMutation) ResetField(name string) error {
 	switch name {
-	case problem.FieldChatID:
-		m.ResetChatID()
-		return nil
-	case problem.FieldManagerID:
-		m.ResetManagerID()
+	case verdictconflict.FieldMessageID:
+		m.ResetMessageID()
 		return nil
-	case problem.FieldResolvedAt:
-		m.ResetResolvedAt()
+	case verdictconflict.FieldAppliedVerdict:
+		m.ResetAppliedVerdict()
 		return nil
-	case problem.FieldResolveRequestID:
-		m.ResetResolveRequestID()
+	case verdictconflict.FieldReceivedVerdict:
+		m.ResetReceivedVerdict()
 		return nil
-	case problem.FieldCreatedAt:
+	case verdictconflict.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Problem field %s", name)
+	return fmt.Errorf("unknown VerdictConflict field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProblemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.chat != nil {
-		edges = append(edges, problem.EdgeChat)
-	}
-	if m.messages != nil {
-		edges = append(edges, problem.EdgeMessages)
+func (m *VerdictConflictMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, verdictconflict.EdgeMessage)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProblemMutation) AddedIDs(name string) []ent.Value {
+func (m *VerdictConflictMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case problem.EdgeChat:
-		if id := m.chat; id != nil {
+	case verdictconflict.EdgeMessage:
+		if id := m.message; id != nil {
 			return []ent.Value{*id}
 		}
-	case problem.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProblemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmessages != nil {
-		edges = append(edges, problem.EdgeMessages)
-	}
+func (m *VerdictConflictMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProblemMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case problem.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *VerdictConflictMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProblemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedchat {
-		edges = append(edges, problem.EdgeChat)
-	}
-	if m.clearedmessages {
-		edges = append(edges, problem.EdgeMessages)
+func (m *VerdictConflictMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, verdictconflict.EdgeMessage)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProblemMutation) EdgeCleared(name string) bool {
+func (m *VerdictConflictMutation) EdgeCleared(name string) bool {
 	switch name {
-	case problem.EdgeChat:
-		return m.clearedchat
-	case problem.EdgeMessages:
-		return m.clearedmessages
+	case verdictconflict.EdgeMessage:
+		return m.clearedmessage
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProblemMutation) ClearEdge(name string) error {
+func (m *VerdictConflictMutation) ClearEdge(name string) error {
 	switch name {
-	case problem.EdgeChat:
-		m.ClearChat()
+	case verdictconflict.EdgeMessage:
+		m.ClearMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Problem unique edge %s", name)
+	return fmt.Errorf("unknown VerdictConflict unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProblemMutation) ResetEdge(name string) error {
+func (m *VerdictConflictMutation) ResetEdge(name string) error {
 	switch name {
-	case problem.EdgeChat:
-		m.ResetChat()
-		return nil
-	case problem.EdgeMessages:
-		m.ResetMessages()
+	case verdictconflict.EdgeMessage:
+		m.ResetMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Problem edge %s", name)
+	return fmt.Errorf("unknown VerdictConflict edge %s", name)
 }
@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Attachment is the predicate function for attachment builders.
+type Attachment func(*sql.Selector)
+
+// CannedResponse is the predicate function for cannedresponse builders.
+type CannedResponse func(*sql.Selector)
+
 // Chat is the predicate function for chat builders.
 type Chat func(*sql.Selector)
 
@@ -18,5 +24,20 @@ type Job func(*sql.Selector)
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// MessageDeletion is the predicate function for messagedeletion builders.
+type MessageDeletion func(*sql.Selector)
+
+// MessageReaction is the predicate function for messagereaction builders.
+type MessageReaction func(*sql.Selector)
+
+// MessageRevision is the predicate function for messagerevision builders.
+type MessageRevision func(*sql.Selector)
+
+// ModerationCase is the predicate function for moderationcase builders.
+type ModerationCase func(*sql.Selector)
+
 // Problem is the predicate function for problem builders.
 type Problem func(*sql.Selector)
+
+// VerdictConflict is the predicate function for verdictconflict builders.
+type VerdictConflict func(*sql.Selector)
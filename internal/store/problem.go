@@ -27,6 +27,22 @@ type Problem struct {
 	ResolvedAt time.Time `json:"resolved_at,omitempty"`
 	// ResolveRequestID holds the value of the "resolve_request_id" field.
 	ResolveRequestID types.RequestID `json:"resolve_request_id,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category,omitempty"`
+	// ResolutionCode holds the value of the "resolution_code" field.
+	ResolutionCode string `json:"resolution_code,omitempty"`
+	// ResolutionSummary holds the value of the "resolution_summary" field.
+	ResolutionSummary string `json:"resolution_summary,omitempty"`
+	// RatingScore holds the value of the "rating_score" field.
+	RatingScore int `json:"rating_score,omitempty"`
+	// RatingComment holds the value of the "rating_comment" field.
+	RatingComment string `json:"rating_comment,omitempty"`
+	// RatedAt holds the value of the "rated_at" field.
+	RatedAt time.Time `json:"rated_at,omitempty"`
+	// ReopenedFromID holds the value of the "reopened_from_id" field.
+	ReopenedFromID types.ProblemID `json:"reopened_from_id,omitempty"`
+	// ManagerReadAt holds the value of the "manager_read_at" field.
+	ManagerReadAt time.Time `json:"manager_read_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -71,11 +87,15 @@ func (*Problem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case problem.FieldResolvedAt, problem.FieldCreatedAt:
+		case problem.FieldRatingScore:
+			values[i] = new(sql.NullInt64)
+		case problem.FieldCategory, problem.FieldResolutionCode, problem.FieldResolutionSummary, problem.FieldRatingComment:
+			values[i] = new(sql.NullString)
+		case problem.FieldResolvedAt, problem.FieldRatedAt, problem.FieldManagerReadAt, problem.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case problem.FieldChatID:
 			values[i] = new(types.ChatID)
-		case problem.FieldID:
+		case problem.FieldID, problem.FieldReopenedFromID:
 			values[i] = new(types.ProblemID)
 		case problem.FieldResolveRequestID:
 			values[i] = new(types.RequestID)
@@ -126,6 +146,54 @@ func (pr *Problem) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				pr.ResolveRequestID = *value
 			}
+		case problem.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				pr.Category = value.String
+			}
+		case problem.FieldResolutionCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_code", values[i])
+			} else if value.Valid {
+				pr.ResolutionCode = value.String
+			}
+		case problem.FieldResolutionSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_summary", values[i])
+			} else if value.Valid {
+				pr.ResolutionSummary = value.String
+			}
+		case problem.FieldRatingScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating_score", values[i])
+			} else if value.Valid {
+				pr.RatingScore = int(value.Int64)
+			}
+		case problem.FieldRatingComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rating_comment", values[i])
+			} else if value.Valid {
+				pr.RatingComment = value.String
+			}
+		case problem.FieldRatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rated_at", values[i])
+			} else if value.Valid {
+				pr.RatedAt = value.Time
+			}
+		case problem.FieldReopenedFromID:
+			if value, ok := values[i].(*types.ProblemID); !ok {
+				return fmt.Errorf("unexpected type %T for field reopened_from_id", values[i])
+			} else if value != nil {
+				pr.ReopenedFromID = *value
+			}
+		case problem.FieldManagerReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field manager_read_at", values[i])
+			} else if value.Valid {
+				pr.ManagerReadAt = value.Time
+			}
 		case problem.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -190,6 +258,30 @@ func (pr *Problem) String() string {
 	builder.WriteString("resolve_request_id=")
 	builder.WriteString(fmt.Sprintf("%v", pr.ResolveRequestID))
 	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(pr.Category)
+	builder.WriteString(", ")
+	builder.WriteString("resolution_code=")
+	builder.WriteString(pr.ResolutionCode)
+	builder.WriteString(", ")
+	builder.WriteString("resolution_summary=")
+	builder.WriteString(pr.ResolutionSummary)
+	builder.WriteString(", ")
+	builder.WriteString("rating_score=")
+	builder.WriteString(fmt.Sprintf("%v", pr.RatingScore))
+	builder.WriteString(", ")
+	builder.WriteString("rating_comment=")
+	builder.WriteString(pr.RatingComment)
+	builder.WriteString(", ")
+	builder.WriteString("rated_at=")
+	builder.WriteString(pr.RatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reopened_from_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ReopenedFromID))
+	builder.WriteString(", ")
+	builder.WriteString("manager_read_at=")
+	builder.WriteString(pr.ManagerReadAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -23,6 +23,22 @@ const (
 	FieldResolvedAt = "resolved_at"
 	// FieldResolveRequestID holds the string denoting the resolve_request_id field in the database.
 	FieldResolveRequestID = "resolve_request_id"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldResolutionCode holds the string denoting the resolution_code field in the database.
+	FieldResolutionCode = "resolution_code"
+	// FieldResolutionSummary holds the string denoting the resolution_summary field in the database.
+	FieldResolutionSummary = "resolution_summary"
+	// FieldRatingScore holds the string denoting the rating_score field in the database.
+	FieldRatingScore = "rating_score"
+	// FieldRatingComment holds the string denoting the rating_comment field in the database.
+	FieldRatingComment = "rating_comment"
+	// FieldRatedAt holds the string denoting the rated_at field in the database.
+	FieldRatedAt = "rated_at"
+	// FieldReopenedFromID holds the string denoting the reopened_from_id field in the database.
+	FieldReopenedFromID = "reopened_from_id"
+	// FieldManagerReadAt holds the string denoting the manager_read_at field in the database.
+	FieldManagerReadAt = "manager_read_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeChat holds the string denoting the chat edge name in mutations.
@@ -54,6 +70,14 @@ var Columns = []string{
 	FieldManagerID,
 	FieldResolvedAt,
 	FieldResolveRequestID,
+	FieldCategory,
+	FieldResolutionCode,
+	FieldResolutionSummary,
+	FieldRatingScore,
+	FieldRatingComment,
+	FieldRatedAt,
+	FieldReopenedFromID,
+	FieldManagerReadAt,
 	FieldCreatedAt,
 }
 
@@ -68,6 +92,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// RatingScoreValidator is a validator for the "rating_score" field. It is called by the builders before save.
+	RatingScoreValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -102,6 +128,46 @@ func ByResolveRequestID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResolveRequestID, opts...).ToFunc()
 }
 
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByResolutionCode orders the results by the resolution_code field.
+func ByResolutionCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionCode, opts...).ToFunc()
+}
+
+// ByResolutionSummary orders the results by the resolution_summary field.
+func ByResolutionSummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionSummary, opts...).ToFunc()
+}
+
+// ByRatingScore orders the results by the rating_score field.
+func ByRatingScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRatingScore, opts...).ToFunc()
+}
+
+// ByRatingComment orders the results by the rating_comment field.
+func ByRatingComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRatingComment, opts...).ToFunc()
+}
+
+// ByRatedAt orders the results by the rated_at field.
+func ByRatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRatedAt, opts...).ToFunc()
+}
+
+// ByReopenedFromID orders the results by the reopened_from_id field.
+func ByReopenedFromID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReopenedFromID, opts...).ToFunc()
+}
+
+// ByManagerReadAt orders the results by the manager_read_at field.
+func ByManagerReadAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManagerReadAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
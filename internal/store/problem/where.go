@@ -76,6 +76,46 @@ func ResolveRequestID(v types.RequestID) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldResolveRequestID, v))
 }
 
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldCategory, v))
+}
+
+// ResolutionCode applies equality check predicate on the "resolution_code" field. It's identical to ResolutionCodeEQ.
+func ResolutionCode(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldResolutionCode, v))
+}
+
+// ResolutionSummary applies equality check predicate on the "resolution_summary" field. It's identical to ResolutionSummaryEQ.
+func ResolutionSummary(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldResolutionSummary, v))
+}
+
+// RatingScore applies equality check predicate on the "rating_score" field. It's identical to RatingScoreEQ.
+func RatingScore(v int) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldRatingScore, v))
+}
+
+// RatingComment applies equality check predicate on the "rating_comment" field. It's identical to RatingCommentEQ.
+func RatingComment(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldRatingComment, v))
+}
+
+// RatedAt applies equality check predicate on the "rated_at" field. It's identical to RatedAtEQ.
+func RatedAt(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldRatedAt, v))
+}
+
+// ReopenedFromID applies equality check predicate on the "reopened_from_id" field. It's identical to ReopenedFromIDEQ.
+func ReopenedFromID(v types.ProblemID) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldReopenedFromID, v))
+}
+
+// ManagerReadAt applies equality check predicate on the "manager_read_at" field. It's identical to ManagerReadAtEQ.
+func ManagerReadAt(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldManagerReadAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldCreatedAt, v))
@@ -251,6 +291,506 @@ func ResolveRequestIDNotNil() predicate.Problem {
 	return predicate.Problem(sql.FieldNotNull(FieldResolveRequestID))
 }
 
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldCategory))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// ResolutionCodeEQ applies the EQ predicate on the "resolution_code" field.
+func ResolutionCodeEQ(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldResolutionCode, v))
+}
+
+// ResolutionCodeNEQ applies the NEQ predicate on the "resolution_code" field.
+func ResolutionCodeNEQ(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldResolutionCode, v))
+}
+
+// ResolutionCodeIn applies the In predicate on the "resolution_code" field.
+func ResolutionCodeIn(vs ...string) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldResolutionCode, vs...))
+}
+
+// ResolutionCodeNotIn applies the NotIn predicate on the "resolution_code" field.
+func ResolutionCodeNotIn(vs ...string) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldResolutionCode, vs...))
+}
+
+// ResolutionCodeGT applies the GT predicate on the "resolution_code" field.
+func ResolutionCodeGT(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldResolutionCode, v))
+}
+
+// ResolutionCodeGTE applies the GTE predicate on the "resolution_code" field.
+func ResolutionCodeGTE(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldResolutionCode, v))
+}
+
+// ResolutionCodeLT applies the LT predicate on the "resolution_code" field.
+func ResolutionCodeLT(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldResolutionCode, v))
+}
+
+// ResolutionCodeLTE applies the LTE predicate on the "resolution_code" field.
+func ResolutionCodeLTE(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldResolutionCode, v))
+}
+
+// ResolutionCodeContains applies the Contains predicate on the "resolution_code" field.
+func ResolutionCodeContains(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldContains(FieldResolutionCode, v))
+}
+
+// ResolutionCodeHasPrefix applies the HasPrefix predicate on the "resolution_code" field.
+func ResolutionCodeHasPrefix(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldHasPrefix(FieldResolutionCode, v))
+}
+
+// ResolutionCodeHasSuffix applies the HasSuffix predicate on the "resolution_code" field.
+func ResolutionCodeHasSuffix(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldHasSuffix(FieldResolutionCode, v))
+}
+
+// ResolutionCodeIsNil applies the IsNil predicate on the "resolution_code" field.
+func ResolutionCodeIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldResolutionCode))
+}
+
+// ResolutionCodeNotNil applies the NotNil predicate on the "resolution_code" field.
+func ResolutionCodeNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldResolutionCode))
+}
+
+// ResolutionCodeEqualFold applies the EqualFold predicate on the "resolution_code" field.
+func ResolutionCodeEqualFold(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEqualFold(FieldResolutionCode, v))
+}
+
+// ResolutionCodeContainsFold applies the ContainsFold predicate on the "resolution_code" field.
+func ResolutionCodeContainsFold(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldContainsFold(FieldResolutionCode, v))
+}
+
+// ResolutionSummaryEQ applies the EQ predicate on the "resolution_summary" field.
+func ResolutionSummaryEQ(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldResolutionSummary, v))
+}
+
+// ResolutionSummaryNEQ applies the NEQ predicate on the "resolution_summary" field.
+func ResolutionSummaryNEQ(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldResolutionSummary, v))
+}
+
+// ResolutionSummaryIn applies the In predicate on the "resolution_summary" field.
+func ResolutionSummaryIn(vs ...string) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldResolutionSummary, vs...))
+}
+
+// ResolutionSummaryNotIn applies the NotIn predicate on the "resolution_summary" field.
+func ResolutionSummaryNotIn(vs ...string) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldResolutionSummary, vs...))
+}
+
+// ResolutionSummaryGT applies the GT predicate on the "resolution_summary" field.
+func ResolutionSummaryGT(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldResolutionSummary, v))
+}
+
+// ResolutionSummaryGTE applies the GTE predicate on the "resolution_summary" field.
+func ResolutionSummaryGTE(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldResolutionSummary, v))
+}
+
+// ResolutionSummaryLT applies the LT predicate on the "resolution_summary" field.
+func ResolutionSummaryLT(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldResolutionSummary, v))
+}
+
+// ResolutionSummaryLTE applies the LTE predicate on the "resolution_summary" field.
+func ResolutionSummaryLTE(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldResolutionSummary, v))
+}
+
+// ResolutionSummaryContains applies the Contains predicate on the "resolution_summary" field.
+func ResolutionSummaryContains(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldContains(FieldResolutionSummary, v))
+}
+
+// ResolutionSummaryHasPrefix applies the HasPrefix predicate on the "resolution_summary" field.
+func ResolutionSummaryHasPrefix(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldHasPrefix(FieldResolutionSummary, v))
+}
+
+// ResolutionSummaryHasSuffix applies the HasSuffix predicate on the "resolution_summary" field.
+func ResolutionSummaryHasSuffix(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldHasSuffix(FieldResolutionSummary, v))
+}
+
+// ResolutionSummaryIsNil applies the IsNil predicate on the "resolution_summary" field.
+func ResolutionSummaryIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldResolutionSummary))
+}
+
+// ResolutionSummaryNotNil applies the NotNil predicate on the "resolution_summary" field.
+func ResolutionSummaryNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldResolutionSummary))
+}
+
+// ResolutionSummaryEqualFold applies the EqualFold predicate on the "resolution_summary" field.
+func ResolutionSummaryEqualFold(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEqualFold(FieldResolutionSummary, v))
+}
+
+// ResolutionSummaryContainsFold applies the ContainsFold predicate on the "resolution_summary" field.
+func ResolutionSummaryContainsFold(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldContainsFold(FieldResolutionSummary, v))
+}
+
+// RatingScoreEQ applies the EQ predicate on the "rating_score" field.
+func RatingScoreEQ(v int) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldRatingScore, v))
+}
+
+// RatingScoreNEQ applies the NEQ predicate on the "rating_score" field.
+func RatingScoreNEQ(v int) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldRatingScore, v))
+}
+
+// RatingScoreIn applies the In predicate on the "rating_score" field.
+func RatingScoreIn(vs ...int) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldRatingScore, vs...))
+}
+
+// RatingScoreNotIn applies the NotIn predicate on the "rating_score" field.
+func RatingScoreNotIn(vs ...int) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldRatingScore, vs...))
+}
+
+// RatingScoreGT applies the GT predicate on the "rating_score" field.
+func RatingScoreGT(v int) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldRatingScore, v))
+}
+
+// RatingScoreGTE applies the GTE predicate on the "rating_score" field.
+func RatingScoreGTE(v int) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldRatingScore, v))
+}
+
+// RatingScoreLT applies the LT predicate on the "rating_score" field.
+func RatingScoreLT(v int) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldRatingScore, v))
+}
+
+// RatingScoreLTE applies the LTE predicate on the "rating_score" field.
+func RatingScoreLTE(v int) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldRatingScore, v))
+}
+
+// RatingScoreIsNil applies the IsNil predicate on the "rating_score" field.
+func RatingScoreIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldRatingScore))
+}
+
+// RatingScoreNotNil applies the NotNil predicate on the "rating_score" field.
+func RatingScoreNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldRatingScore))
+}
+
+// RatingCommentEQ applies the EQ predicate on the "rating_comment" field.
+func RatingCommentEQ(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldRatingComment, v))
+}
+
+// RatingCommentNEQ applies the NEQ predicate on the "rating_comment" field.
+func RatingCommentNEQ(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldRatingComment, v))
+}
+
+// RatingCommentIn applies the In predicate on the "rating_comment" field.
+func RatingCommentIn(vs ...string) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldRatingComment, vs...))
+}
+
+// RatingCommentNotIn applies the NotIn predicate on the "rating_comment" field.
+func RatingCommentNotIn(vs ...string) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldRatingComment, vs...))
+}
+
+// RatingCommentGT applies the GT predicate on the "rating_comment" field.
+func RatingCommentGT(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldRatingComment, v))
+}
+
+// RatingCommentGTE applies the GTE predicate on the "rating_comment" field.
+func RatingCommentGTE(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldRatingComment, v))
+}
+
+// RatingCommentLT applies the LT predicate on the "rating_comment" field.
+func RatingCommentLT(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldRatingComment, v))
+}
+
+// RatingCommentLTE applies the LTE predicate on the "rating_comment" field.
+func RatingCommentLTE(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldRatingComment, v))
+}
+
+// RatingCommentContains applies the Contains predicate on the "rating_comment" field.
+func RatingCommentContains(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldContains(FieldRatingComment, v))
+}
+
+// RatingCommentHasPrefix applies the HasPrefix predicate on the "rating_comment" field.
+func RatingCommentHasPrefix(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldHasPrefix(FieldRatingComment, v))
+}
+
+// RatingCommentHasSuffix applies the HasSuffix predicate on the "rating_comment" field.
+func RatingCommentHasSuffix(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldHasSuffix(FieldRatingComment, v))
+}
+
+// RatingCommentIsNil applies the IsNil predicate on the "rating_comment" field.
+func RatingCommentIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldRatingComment))
+}
+
+// RatingCommentNotNil applies the NotNil predicate on the "rating_comment" field.
+func RatingCommentNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldRatingComment))
+}
+
+// RatingCommentEqualFold applies the EqualFold predicate on the "rating_comment" field.
+func RatingCommentEqualFold(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldEqualFold(FieldRatingComment, v))
+}
+
+// RatingCommentContainsFold applies the ContainsFold predicate on the "rating_comment" field.
+func RatingCommentContainsFold(v string) predicate.Problem {
+	return predicate.Problem(sql.FieldContainsFold(FieldRatingComment, v))
+}
+
+// RatedAtEQ applies the EQ predicate on the "rated_at" field.
+func RatedAtEQ(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldRatedAt, v))
+}
+
+// RatedAtNEQ applies the NEQ predicate on the "rated_at" field.
+func RatedAtNEQ(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldRatedAt, v))
+}
+
+// RatedAtIn applies the In predicate on the "rated_at" field.
+func RatedAtIn(vs ...time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldRatedAt, vs...))
+}
+
+// RatedAtNotIn applies the NotIn predicate on the "rated_at" field.
+func RatedAtNotIn(vs ...time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldRatedAt, vs...))
+}
+
+// RatedAtGT applies the GT predicate on the "rated_at" field.
+func RatedAtGT(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldRatedAt, v))
+}
+
+// RatedAtGTE applies the GTE predicate on the "rated_at" field.
+func RatedAtGTE(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldRatedAt, v))
+}
+
+// RatedAtLT applies the LT predicate on the "rated_at" field.
+func RatedAtLT(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldRatedAt, v))
+}
+
+// RatedAtLTE applies the LTE predicate on the "rated_at" field.
+func RatedAtLTE(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldRatedAt, v))
+}
+
+// RatedAtIsNil applies the IsNil predicate on the "rated_at" field.
+func RatedAtIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldRatedAt))
+}
+
+// RatedAtNotNil applies the NotNil predicate on the "rated_at" field.
+func RatedAtNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldRatedAt))
+}
+
+// ReopenedFromIDEQ applies the EQ predicate on the "reopened_from_id" field.
+func ReopenedFromIDEQ(v types.ProblemID) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldReopenedFromID, v))
+}
+
+// ReopenedFromIDNEQ applies the NEQ predicate on the "reopened_from_id" field.
+func ReopenedFromIDNEQ(v types.ProblemID) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldReopenedFromID, v))
+}
+
+// ReopenedFromIDIn applies the In predicate on the "reopened_from_id" field.
+func ReopenedFromIDIn(vs ...types.ProblemID) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldReopenedFromID, vs...))
+}
+
+// ReopenedFromIDNotIn applies the NotIn predicate on the "reopened_from_id" field.
+func ReopenedFromIDNotIn(vs ...types.ProblemID) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldReopenedFromID, vs...))
+}
+
+// ReopenedFromIDGT applies the GT predicate on the "reopened_from_id" field.
+func ReopenedFromIDGT(v types.ProblemID) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldReopenedFromID, v))
+}
+
+// ReopenedFromIDGTE applies the GTE predicate on the "reopened_from_id" field.
+func ReopenedFromIDGTE(v types.ProblemID) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldReopenedFromID, v))
+}
+
+// ReopenedFromIDLT applies the LT predicate on the "reopened_from_id" field.
+func ReopenedFromIDLT(v types.ProblemID) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldReopenedFromID, v))
+}
+
+// ReopenedFromIDLTE applies the LTE predicate on the "reopened_from_id" field.
+func ReopenedFromIDLTE(v types.ProblemID) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldReopenedFromID, v))
+}
+
+// ReopenedFromIDIsNil applies the IsNil predicate on the "reopened_from_id" field.
+func ReopenedFromIDIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldReopenedFromID))
+}
+
+// ReopenedFromIDNotNil applies the NotNil predicate on the "reopened_from_id" field.
+func ReopenedFromIDNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldReopenedFromID))
+}
+
+// ManagerReadAtEQ applies the EQ predicate on the "manager_read_at" field.
+func ManagerReadAtEQ(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldManagerReadAt, v))
+}
+
+// ManagerReadAtNEQ applies the NEQ predicate on the "manager_read_at" field.
+func ManagerReadAtNEQ(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldManagerReadAt, v))
+}
+
+// ManagerReadAtIn applies the In predicate on the "manager_read_at" field.
+func ManagerReadAtIn(vs ...time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldManagerReadAt, vs...))
+}
+
+// ManagerReadAtNotIn applies the NotIn predicate on the "manager_read_at" field.
+func ManagerReadAtNotIn(vs ...time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldManagerReadAt, vs...))
+}
+
+// ManagerReadAtGT applies the GT predicate on the "manager_read_at" field.
+func ManagerReadAtGT(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldManagerReadAt, v))
+}
+
+// ManagerReadAtGTE applies the GTE predicate on the "manager_read_at" field.
+func ManagerReadAtGTE(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldManagerReadAt, v))
+}
+
+// ManagerReadAtLT applies the LT predicate on the "manager_read_at" field.
+func ManagerReadAtLT(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldManagerReadAt, v))
+}
+
+// ManagerReadAtLTE applies the LTE predicate on the "manager_read_at" field.
+func ManagerReadAtLTE(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldManagerReadAt, v))
+}
+
+// ManagerReadAtIsNil applies the IsNil predicate on the "manager_read_at" field.
+func ManagerReadAtIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldManagerReadAt))
+}
+
+// ManagerReadAtNotNil applies the NotNil predicate on the "manager_read_at" field.
+func ManagerReadAtNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldManagerReadAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldCreatedAt, v))
@@ -74,6 +74,118 @@ func (pc *ProblemCreate) SetNillableResolveRequestID(ti *types.RequestID) *Probl
 	return pc
 }
 
+// SetCategory sets the "category" field.
+func (pc *ProblemCreate) SetCategory(s string) *ProblemCreate {
+	pc.mutation.SetCategory(s)
+	return pc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableCategory(s *string) *ProblemCreate {
+	if s != nil {
+		pc.SetCategory(*s)
+	}
+	return pc
+}
+
+// SetResolutionCode sets the "resolution_code" field.
+func (pc *ProblemCreate) SetResolutionCode(s string) *ProblemCreate {
+	pc.mutation.SetResolutionCode(s)
+	return pc
+}
+
+// SetNillableResolutionCode sets the "resolution_code" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableResolutionCode(s *string) *ProblemCreate {
+	if s != nil {
+		pc.SetResolutionCode(*s)
+	}
+	return pc
+}
+
+// SetResolutionSummary sets the "resolution_summary" field.
+func (pc *ProblemCreate) SetResolutionSummary(s string) *ProblemCreate {
+	pc.mutation.SetResolutionSummary(s)
+	return pc
+}
+
+// SetNillableResolutionSummary sets the "resolution_summary" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableResolutionSummary(s *string) *ProblemCreate {
+	if s != nil {
+		pc.SetResolutionSummary(*s)
+	}
+	return pc
+}
+
+// SetRatingScore sets the "rating_score" field.
+func (pc *ProblemCreate) SetRatingScore(i int) *ProblemCreate {
+	pc.mutation.SetRatingScore(i)
+	return pc
+}
+
+// SetNillableRatingScore sets the "rating_score" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableRatingScore(i *int) *ProblemCreate {
+	if i != nil {
+		pc.SetRatingScore(*i)
+	}
+	return pc
+}
+
+// SetRatingComment sets the "rating_comment" field.
+func (pc *ProblemCreate) SetRatingComment(s string) *ProblemCreate {
+	pc.mutation.SetRatingComment(s)
+	return pc
+}
+
+// SetNillableRatingComment sets the "rating_comment" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableRatingComment(s *string) *ProblemCreate {
+	if s != nil {
+		pc.SetRatingComment(*s)
+	}
+	return pc
+}
+
+// SetRatedAt sets the "rated_at" field.
+func (pc *ProblemCreate) SetRatedAt(t time.Time) *ProblemCreate {
+	pc.mutation.SetRatedAt(t)
+	return pc
+}
+
+// SetNillableRatedAt sets the "rated_at" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableRatedAt(t *time.Time) *ProblemCreate {
+	if t != nil {
+		pc.SetRatedAt(*t)
+	}
+	return pc
+}
+
+// SetReopenedFromID sets the "reopened_from_id" field.
+func (pc *ProblemCreate) SetReopenedFromID(ti types.ProblemID) *ProblemCreate {
+	pc.mutation.SetReopenedFromID(ti)
+	return pc
+}
+
+// SetNillableReopenedFromID sets the "reopened_from_id" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableReopenedFromID(ti *types.ProblemID) *ProblemCreate {
+	if ti != nil {
+		pc.SetReopenedFromID(*ti)
+	}
+	return pc
+}
+
+// SetManagerReadAt sets the "manager_read_at" field.
+func (pc *ProblemCreate) SetManagerReadAt(t time.Time) *ProblemCreate {
+	pc.mutation.SetManagerReadAt(t)
+	return pc
+}
+
+// SetNillableManagerReadAt sets the "manager_read_at" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableManagerReadAt(t *time.Time) *ProblemCreate {
+	if t != nil {
+		pc.SetManagerReadAt(*t)
+	}
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *ProblemCreate) SetCreatedAt(t time.Time) *ProblemCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -187,6 +299,16 @@ func (pc *ProblemCreate) check() error {
 			return &ValidationError{Name: "resolve_request_id", err: fmt.Errorf(`store: validator failed for field "Problem.resolve_request_id": %w`, err)}
 		}
 	}
+	if v, ok := pc.mutation.RatingScore(); ok {
+		if err := problem.RatingScoreValidator(v); err != nil {
+			return &ValidationError{Name: "rating_score", err: fmt.Errorf(`store: validator failed for field "Problem.rating_score": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.ReopenedFromID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "reopened_from_id", err: fmt.Errorf(`store: validator failed for field "Problem.reopened_from_id": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "Problem.created_at"`)}
 	}
@@ -246,6 +368,38 @@ func (pc *ProblemCreate) createSpec() (*Problem, *sqlgraph.CreateSpec) {
 		_spec.SetField(problem.FieldResolveRequestID, field.TypeUUID, value)
 		_node.ResolveRequestID = value
 	}
+	if value, ok := pc.mutation.Category(); ok {
+		_spec.SetField(problem.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := pc.mutation.ResolutionCode(); ok {
+		_spec.SetField(problem.FieldResolutionCode, field.TypeString, value)
+		_node.ResolutionCode = value
+	}
+	if value, ok := pc.mutation.ResolutionSummary(); ok {
+		_spec.SetField(problem.FieldResolutionSummary, field.TypeString, value)
+		_node.ResolutionSummary = value
+	}
+	if value, ok := pc.mutation.RatingScore(); ok {
+		_spec.SetField(problem.FieldRatingScore, field.TypeInt, value)
+		_node.RatingScore = value
+	}
+	if value, ok := pc.mutation.RatingComment(); ok {
+		_spec.SetField(problem.FieldRatingComment, field.TypeString, value)
+		_node.RatingComment = value
+	}
+	if value, ok := pc.mutation.RatedAt(); ok {
+		_spec.SetField(problem.FieldRatedAt, field.TypeTime, value)
+		_node.RatedAt = value
+	}
+	if value, ok := pc.mutation.ReopenedFromID(); ok {
+		_spec.SetField(problem.FieldReopenedFromID, field.TypeUUID, value)
+		_node.ReopenedFromID = value
+	}
+	if value, ok := pc.mutation.ManagerReadAt(); ok {
+		_spec.SetField(problem.FieldManagerReadAt, field.TypeTime, value)
+		_node.ManagerReadAt = value
+	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(problem.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -401,6 +555,156 @@ func (u *ProblemUpsert) ClearResolveRequestID() *ProblemUpsert {
 	return u
 }
 
+// SetCategory sets the "category" field.
+func (u *ProblemUpsert) SetCategory(v string) *ProblemUpsert {
+	u.Set(problem.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *ProblemUpsert) UpdateCategory() *ProblemUpsert {
+	u.SetExcluded(problem.FieldCategory)
+	return u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *ProblemUpsert) ClearCategory() *ProblemUpsert {
+	u.SetNull(problem.FieldCategory)
+	return u
+}
+
+// SetResolutionCode sets the "resolution_code" field.
+func (u *ProblemUpsert) SetResolutionCode(v string) *ProblemUpsert {
+	u.Set(problem.FieldResolutionCode, v)
+	return u
+}
+
+// UpdateResolutionCode sets the "resolution_code" field to the value that was provided on create.
+func (u *ProblemUpsert) UpdateResolutionCode() *ProblemUpsert {
+	u.SetExcluded(problem.FieldResolutionCode)
+	return u
+}
+
+// ClearResolutionCode clears the value of the "resolution_code" field.
+func (u *ProblemUpsert) ClearResolutionCode() *ProblemUpsert {
+	u.SetNull(problem.FieldResolutionCode)
+	return u
+}
+
+// SetResolutionSummary sets the "resolution_summary" field.
+func (u *ProblemUpsert) SetResolutionSummary(v string) *ProblemUpsert {
+	u.Set(problem.FieldResolutionSummary, v)
+	return u
+}
+
+// UpdateResolutionSummary sets the "resolution_summary" field to the value that was provided on create.
+func (u *ProblemUpsert) UpdateResolutionSummary() *ProblemUpsert {
+	u.SetExcluded(problem.FieldResolutionSummary)
+	return u
+}
+
+// ClearResolutionSummary clears the value of the "resolution_summary" field.
+func (u *ProblemUpsert) ClearResolutionSummary() *ProblemUpsert {
+	u.SetNull(problem.FieldResolutionSummary)
+	return u
+}
+
+// SetRatingScore sets the "rating_score" field.
+func (u *ProblemUpsert) SetRatingScore(v int) *ProblemUpsert {
+	u.Set(problem.FieldRatingScore, v)
+	return u
+}
+
+// UpdateRatingScore sets the "rating_score" field to the value that was provided on create.
+func (u *ProblemUpsert) UpdateRatingScore() *ProblemUpsert {
+	u.SetExcluded(problem.FieldRatingScore)
+	return u
+}
+
+// AddRatingScore adds v to the "rating_score" field.
+func (u *ProblemUpsert) AddRatingScore(v int) *ProblemUpsert {
+	u.Add(problem.FieldRatingScore, v)
+	return u
+}
+
+// ClearRatingScore clears the value of the "rating_score" field.
+func (u *ProblemUpsert) ClearRatingScore() *ProblemUpsert {
+	u.SetNull(problem.FieldRatingScore)
+	return u
+}
+
+// SetRatingComment sets the "rating_comment" field.
+func (u *ProblemUpsert) SetRatingComment(v string) *ProblemUpsert {
+	u.Set(problem.FieldRatingComment, v)
+	return u
+}
+
+// UpdateRatingComment sets the "rating_comment" field to the value that was provided on create.
+func (u *ProblemUpsert) UpdateRatingComment() *ProblemUpsert {
+	u.SetExcluded(problem.FieldRatingComment)
+	return u
+}
+
+// ClearRatingComment clears the value of the "rating_comment" field.
+func (u *ProblemUpsert) ClearRatingComment() *ProblemUpsert {
+	u.SetNull(problem.FieldRatingComment)
+	return u
+}
+
+// SetRatedAt sets the "rated_at" field.
+func (u *ProblemUpsert) SetRatedAt(v time.Time) *ProblemUpsert {
+	u.Set(problem.FieldRatedAt, v)
+	return u
+}
+
+// UpdateRatedAt sets the "rated_at" field to the value that was provided on create.
+func (u *ProblemUpsert) UpdateRatedAt() *ProblemUpsert {
+	u.SetExcluded(problem.FieldRatedAt)
+	return u
+}
+
+// ClearRatedAt clears the value of the "rated_at" field.
+func (u *ProblemUpsert) ClearRatedAt() *ProblemUpsert {
+	u.SetNull(problem.FieldRatedAt)
+	return u
+}
+
+// SetReopenedFromID sets the "reopened_from_id" field.
+func (u *ProblemUpsert) SetReopenedFromID(v types.ProblemID) *ProblemUpsert {
+	u.Set(problem.FieldReopenedFromID, v)
+	return u
+}
+
+// UpdateReopenedFromID sets the "reopened_from_id" field to the value that was provided on create.
+func (u *ProblemUpsert) UpdateReopenedFromID() *ProblemUpsert {
+	u.SetExcluded(problem.FieldReopenedFromID)
+	return u
+}
+
+// ClearReopenedFromID clears the value of the "reopened_from_id" field.
+func (u *ProblemUpsert) ClearReopenedFromID() *ProblemUpsert {
+	u.SetNull(problem.FieldReopenedFromID)
+	return u
+}
+
+// SetManagerReadAt sets the "manager_read_at" field.
+func (u *ProblemUpsert) SetManagerReadAt(v time.Time) *ProblemUpsert {
+	u.Set(problem.FieldManagerReadAt, v)
+	return u
+}
+
+// UpdateManagerReadAt sets the "manager_read_at" field to the value that was provided on create.
+func (u *ProblemUpsert) UpdateManagerReadAt() *ProblemUpsert {
+	u.SetExcluded(problem.FieldManagerReadAt)
+	return u
+}
+
+// ClearManagerReadAt clears the value of the "manager_read_at" field.
+func (u *ProblemUpsert) ClearManagerReadAt() *ProblemUpsert {
+	u.SetNull(problem.FieldManagerReadAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -529,6 +833,181 @@ func (u *ProblemUpsertOne) ClearResolveRequestID() *ProblemUpsertOne {
 	})
 }
 
+// SetCategory sets the "category" field.
+func (u *ProblemUpsertOne) SetCategory(v string) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *ProblemUpsertOne) UpdateCategory() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *ProblemUpsertOne) ClearCategory() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetResolutionCode sets the "resolution_code" field.
+func (u *ProblemUpsertOne) SetResolutionCode(v string) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetResolutionCode(v)
+	})
+}
+
+// UpdateResolutionCode sets the "resolution_code" field to the value that was provided on create.
+func (u *ProblemUpsertOne) UpdateResolutionCode() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateResolutionCode()
+	})
+}
+
+// ClearResolutionCode clears the value of the "resolution_code" field.
+func (u *ProblemUpsertOne) ClearResolutionCode() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearResolutionCode()
+	})
+}
+
+// SetResolutionSummary sets the "resolution_summary" field.
+func (u *ProblemUpsertOne) SetResolutionSummary(v string) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetResolutionSummary(v)
+	})
+}
+
+// UpdateResolutionSummary sets the "resolution_summary" field to the value that was provided on create.
+func (u *ProblemUpsertOne) UpdateResolutionSummary() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateResolutionSummary()
+	})
+}
+
+// ClearResolutionSummary clears the value of the "resolution_summary" field.
+func (u *ProblemUpsertOne) ClearResolutionSummary() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearResolutionSummary()
+	})
+}
+
+// SetRatingScore sets the "rating_score" field.
+func (u *ProblemUpsertOne) SetRatingScore(v int) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetRatingScore(v)
+	})
+}
+
+// AddRatingScore adds v to the "rating_score" field.
+func (u *ProblemUpsertOne) AddRatingScore(v int) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.AddRatingScore(v)
+	})
+}
+
+// UpdateRatingScore sets the "rating_score" field to the value that was provided on create.
+func (u *ProblemUpsertOne) UpdateRatingScore() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateRatingScore()
+	})
+}
+
+// ClearRatingScore clears the value of the "rating_score" field.
+func (u *ProblemUpsertOne) ClearRatingScore() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearRatingScore()
+	})
+}
+
+// SetRatingComment sets the "rating_comment" field.
+func (u *ProblemUpsertOne) SetRatingComment(v string) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetRatingComment(v)
+	})
+}
+
+// UpdateRatingComment sets the "rating_comment" field to the value that was provided on create.
+func (u *ProblemUpsertOne) UpdateRatingComment() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateRatingComment()
+	})
+}
+
+// ClearRatingComment clears the value of the "rating_comment" field.
+func (u *ProblemUpsertOne) ClearRatingComment() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearRatingComment()
+	})
+}
+
+// SetRatedAt sets the "rated_at" field.
+func (u *ProblemUpsertOne) SetRatedAt(v time.Time) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetRatedAt(v)
+	})
+}
+
+// UpdateRatedAt sets the "rated_at" field to the value that was provided on create.
+func (u *ProblemUpsertOne) UpdateRatedAt() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateRatedAt()
+	})
+}
+
+// ClearRatedAt clears the value of the "rated_at" field.
+func (u *ProblemUpsertOne) ClearRatedAt() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearRatedAt()
+	})
+}
+
+// SetReopenedFromID sets the "reopened_from_id" field.
+func (u *ProblemUpsertOne) SetReopenedFromID(v types.ProblemID) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetReopenedFromID(v)
+	})
+}
+
+// UpdateReopenedFromID sets the "reopened_from_id" field to the value that was provided on create.
+func (u *ProblemUpsertOne) UpdateReopenedFromID() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateReopenedFromID()
+	})
+}
+
+// ClearReopenedFromID clears the value of the "reopened_from_id" field.
+func (u *ProblemUpsertOne) ClearReopenedFromID() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearReopenedFromID()
+	})
+}
+
+// SetManagerReadAt sets the "manager_read_at" field.
+func (u *ProblemUpsertOne) SetManagerReadAt(v time.Time) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetManagerReadAt(v)
+	})
+}
+
+// UpdateManagerReadAt sets the "manager_read_at" field to the value that was provided on create.
+func (u *ProblemUpsertOne) UpdateManagerReadAt() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateManagerReadAt()
+	})
+}
+
+// ClearManagerReadAt clears the value of the "manager_read_at" field.
+func (u *ProblemUpsertOne) ClearManagerReadAt() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearManagerReadAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ProblemUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -824,6 +1303,181 @@ func (u *ProblemUpsertBulk) ClearResolveRequestID() *ProblemUpsertBulk {
 	})
 }
 
+// SetCategory sets the "category" field.
+func (u *ProblemUpsertBulk) SetCategory(v string) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *ProblemUpsertBulk) UpdateCategory() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *ProblemUpsertBulk) ClearCategory() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetResolutionCode sets the "resolution_code" field.
+func (u *ProblemUpsertBulk) SetResolutionCode(v string) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetResolutionCode(v)
+	})
+}
+
+// UpdateResolutionCode sets the "resolution_code" field to the value that was provided on create.
+func (u *ProblemUpsertBulk) UpdateResolutionCode() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateResolutionCode()
+	})
+}
+
+// ClearResolutionCode clears the value of the "resolution_code" field.
+func (u *ProblemUpsertBulk) ClearResolutionCode() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearResolutionCode()
+	})
+}
+
+// SetResolutionSummary sets the "resolution_summary" field.
+func (u *ProblemUpsertBulk) SetResolutionSummary(v string) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetResolutionSummary(v)
+	})
+}
+
+// UpdateResolutionSummary sets the "resolution_summary" field to the value that was provided on create.
+func (u *ProblemUpsertBulk) UpdateResolutionSummary() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateResolutionSummary()
+	})
+}
+
+// ClearResolutionSummary clears the value of the "resolution_summary" field.
+func (u *ProblemUpsertBulk) ClearResolutionSummary() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearResolutionSummary()
+	})
+}
+
+// SetRatingScore sets the "rating_score" field.
+func (u *ProblemUpsertBulk) SetRatingScore(v int) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetRatingScore(v)
+	})
+}
+
+// AddRatingScore adds v to the "rating_score" field.
+func (u *ProblemUpsertBulk) AddRatingScore(v int) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.AddRatingScore(v)
+	})
+}
+
+// UpdateRatingScore sets the "rating_score" field to the value that was provided on create.
+func (u *ProblemUpsertBulk) UpdateRatingScore() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateRatingScore()
+	})
+}
+
+// ClearRatingScore clears the value of the "rating_score" field.
+func (u *ProblemUpsertBulk) ClearRatingScore() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearRatingScore()
+	})
+}
+
+// SetRatingComment sets the "rating_comment" field.
+func (u *ProblemUpsertBulk) SetRatingComment(v string) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetRatingComment(v)
+	})
+}
+
+// UpdateRatingComment sets the "rating_comment" field to the value that was provided on create.
+func (u *ProblemUpsertBulk) UpdateRatingComment() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateRatingComment()
+	})
+}
+
+// ClearRatingComment clears the value of the "rating_comment" field.
+func (u *ProblemUpsertBulk) ClearRatingComment() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearRatingComment()
+	})
+}
+
+// SetRatedAt sets the "rated_at" field.
+func (u *ProblemUpsertBulk) SetRatedAt(v time.Time) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetRatedAt(v)
+	})
+}
+
+// UpdateRatedAt sets the "rated_at" field to the value that was provided on create.
+func (u *ProblemUpsertBulk) UpdateRatedAt() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateRatedAt()
+	})
+}
+
+// ClearRatedAt clears the value of the "rated_at" field.
+func (u *ProblemUpsertBulk) ClearRatedAt() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearRatedAt()
+	})
+}
+
+// SetReopenedFromID sets the "reopened_from_id" field.
+func (u *ProblemUpsertBulk) SetReopenedFromID(v types.ProblemID) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetReopenedFromID(v)
+	})
+}
+
+// UpdateReopenedFromID sets the "reopened_from_id" field to the value that was provided on create.
+func (u *ProblemUpsertBulk) UpdateReopenedFromID() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateReopenedFromID()
+	})
+}
+
+// ClearReopenedFromID clears the value of the "reopened_from_id" field.
+func (u *ProblemUpsertBulk) ClearReopenedFromID() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearReopenedFromID()
+	})
+}
+
+// SetManagerReadAt sets the "manager_read_at" field.
+func (u *ProblemUpsertBulk) SetManagerReadAt(v time.Time) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetManagerReadAt(v)
+	})
+}
+
+// UpdateManagerReadAt sets the "manager_read_at" field to the value that was provided on create.
+func (u *ProblemUpsertBulk) UpdateManagerReadAt() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateManagerReadAt()
+	})
+}
+
+// ClearManagerReadAt clears the value of the "manager_read_at" field.
+func (u *ProblemUpsertBulk) ClearManagerReadAt() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearManagerReadAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ProblemUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
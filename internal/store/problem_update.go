@@ -106,6 +106,173 @@ func (pu *ProblemUpdate) ClearResolveRequestID() *ProblemUpdate {
 	return pu
 }
 
+// SetCategory sets the "category" field.
+func (pu *ProblemUpdate) SetCategory(s string) *ProblemUpdate {
+	pu.mutation.SetCategory(s)
+	return pu
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (pu *ProblemUpdate) SetNillableCategory(s *string) *ProblemUpdate {
+	if s != nil {
+		pu.SetCategory(*s)
+	}
+	return pu
+}
+
+// ClearCategory clears the value of the "category" field.
+func (pu *ProblemUpdate) ClearCategory() *ProblemUpdate {
+	pu.mutation.ClearCategory()
+	return pu
+}
+
+// SetResolutionCode sets the "resolution_code" field.
+func (pu *ProblemUpdate) SetResolutionCode(s string) *ProblemUpdate {
+	pu.mutation.SetResolutionCode(s)
+	return pu
+}
+
+// SetNillableResolutionCode sets the "resolution_code" field if the given value is not nil.
+func (pu *ProblemUpdate) SetNillableResolutionCode(s *string) *ProblemUpdate {
+	if s != nil {
+		pu.SetResolutionCode(*s)
+	}
+	return pu
+}
+
+// ClearResolutionCode clears the value of the "resolution_code" field.
+func (pu *ProblemUpdate) ClearResolutionCode() *ProblemUpdate {
+	pu.mutation.ClearResolutionCode()
+	return pu
+}
+
+// SetResolutionSummary sets the "resolution_summary" field.
+func (pu *ProblemUpdate) SetResolutionSummary(s string) *ProblemUpdate {
+	pu.mutation.SetResolutionSummary(s)
+	return pu
+}
+
+// SetNillableResolutionSummary sets the "resolution_summary" field if the given value is not nil.
+func (pu *ProblemUpdate) SetNillableResolutionSummary(s *string) *ProblemUpdate {
+	if s != nil {
+		pu.SetResolutionSummary(*s)
+	}
+	return pu
+}
+
+// ClearResolutionSummary clears the value of the "resolution_summary" field.
+func (pu *ProblemUpdate) ClearResolutionSummary() *ProblemUpdate {
+	pu.mutation.ClearResolutionSummary()
+	return pu
+}
+
+// SetRatingScore sets the "rating_score" field.
+func (pu *ProblemUpdate) SetRatingScore(i int) *ProblemUpdate {
+	pu.mutation.ResetRatingScore()
+	pu.mutation.SetRatingScore(i)
+	return pu
+}
+
+// SetNillableRatingScore sets the "rating_score" field if the given value is not nil.
+func (pu *ProblemUpdate) SetNillableRatingScore(i *int) *ProblemUpdate {
+	if i != nil {
+		pu.SetRatingScore(*i)
+	}
+	return pu
+}
+
+// AddRatingScore adds i to the "rating_score" field.
+func (pu *ProblemUpdate) AddRatingScore(i int) *ProblemUpdate {
+	pu.mutation.AddRatingScore(i)
+	return pu
+}
+
+// ClearRatingScore clears the value of the "rating_score" field.
+func (pu *ProblemUpdate) ClearRatingScore() *ProblemUpdate {
+	pu.mutation.ClearRatingScore()
+	return pu
+}
+
+// SetRatingComment sets the "rating_comment" field.
+func (pu *ProblemUpdate) SetRatingComment(s string) *ProblemUpdate {
+	pu.mutation.SetRatingComment(s)
+	return pu
+}
+
+// SetNillableRatingComment sets the "rating_comment" field if the given value is not nil.
+func (pu *ProblemUpdate) SetNillableRatingComment(s *string) *ProblemUpdate {
+	if s != nil {
+		pu.SetRatingComment(*s)
+	}
+	return pu
+}
+
+// ClearRatingComment clears the value of the "rating_comment" field.
+func (pu *ProblemUpdate) ClearRatingComment() *ProblemUpdate {
+	pu.mutation.ClearRatingComment()
+	return pu
+}
+
+// SetRatedAt sets the "rated_at" field.
+func (pu *ProblemUpdate) SetRatedAt(t time.Time) *ProblemUpdate {
+	pu.mutation.SetRatedAt(t)
+	return pu
+}
+
+// SetNillableRatedAt sets the "rated_at" field if the given value is not nil.
+func (pu *ProblemUpdate) SetNillableRatedAt(t *time.Time) *ProblemUpdate {
+	if t != nil {
+		pu.SetRatedAt(*t)
+	}
+	return pu
+}
+
+// ClearRatedAt clears the value of the "rated_at" field.
+func (pu *ProblemUpdate) ClearRatedAt() *ProblemUpdate {
+	pu.mutation.ClearRatedAt()
+	return pu
+}
+
+// SetReopenedFromID sets the "reopened_from_id" field.
+func (pu *ProblemUpdate) SetReopenedFromID(ti types.ProblemID) *ProblemUpdate {
+	pu.mutation.SetReopenedFromID(ti)
+	return pu
+}
+
+// SetNillableReopenedFromID sets the "reopened_from_id" field if the given value is not nil.
+func (pu *ProblemUpdate) SetNillableReopenedFromID(ti *types.ProblemID) *ProblemUpdate {
+	if ti != nil {
+		pu.SetReopenedFromID(*ti)
+	}
+	return pu
+}
+
+// ClearReopenedFromID clears the value of the "reopened_from_id" field.
+func (pu *ProblemUpdate) ClearReopenedFromID() *ProblemUpdate {
+	pu.mutation.ClearReopenedFromID()
+	return pu
+}
+
+// SetManagerReadAt sets the "manager_read_at" field.
+func (pu *ProblemUpdate) SetManagerReadAt(t time.Time) *ProblemUpdate {
+	pu.mutation.SetManagerReadAt(t)
+	return pu
+}
+
+// SetNillableManagerReadAt sets the "manager_read_at" field if the given value is not nil.
+func (pu *ProblemUpdate) SetNillableManagerReadAt(t *time.Time) *ProblemUpdate {
+	if t != nil {
+		pu.SetManagerReadAt(*t)
+	}
+	return pu
+}
+
+// ClearManagerReadAt clears the value of the "manager_read_at" field.
+func (pu *ProblemUpdate) ClearManagerReadAt() *ProblemUpdate {
+	pu.mutation.ClearManagerReadAt()
+	return pu
+}
+
 // SetChat sets the "chat" edge to the Chat entity.
 func (pu *ProblemUpdate) SetChat(c *Chat) *ProblemUpdate {
 	return pu.SetChatID(c.ID)
@@ -202,6 +369,16 @@ func (pu *ProblemUpdate) check() error {
 			return &ValidationError{Name: "resolve_request_id", err: fmt.Errorf(`store: validator failed for field "Problem.resolve_request_id": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.RatingScore(); ok {
+		if err := problem.RatingScoreValidator(v); err != nil {
+			return &ValidationError{Name: "rating_score", err: fmt.Errorf(`store: validator failed for field "Problem.rating_score": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.ReopenedFromID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "reopened_from_id", err: fmt.Errorf(`store: validator failed for field "Problem.reopened_from_id": %w`, err)}
+		}
+	}
 	if _, ok := pu.mutation.ChatID(); pu.mutation.ChatCleared() && !ok {
 		return errors.New(`store: clearing a required unique edge "Problem.chat"`)
 	}
@@ -244,6 +421,57 @@ func (pu *ProblemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.ResolveRequestIDCleared() {
 		_spec.ClearField(problem.FieldResolveRequestID, field.TypeUUID)
 	}
+	if value, ok := pu.mutation.Category(); ok {
+		_spec.SetField(problem.FieldCategory, field.TypeString, value)
+	}
+	if pu.mutation.CategoryCleared() {
+		_spec.ClearField(problem.FieldCategory, field.TypeString)
+	}
+	if value, ok := pu.mutation.ResolutionCode(); ok {
+		_spec.SetField(problem.FieldResolutionCode, field.TypeString, value)
+	}
+	if pu.mutation.ResolutionCodeCleared() {
+		_spec.ClearField(problem.FieldResolutionCode, field.TypeString)
+	}
+	if value, ok := pu.mutation.ResolutionSummary(); ok {
+		_spec.SetField(problem.FieldResolutionSummary, field.TypeString, value)
+	}
+	if pu.mutation.ResolutionSummaryCleared() {
+		_spec.ClearField(problem.FieldResolutionSummary, field.TypeString)
+	}
+	if value, ok := pu.mutation.RatingScore(); ok {
+		_spec.SetField(problem.FieldRatingScore, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedRatingScore(); ok {
+		_spec.AddField(problem.FieldRatingScore, field.TypeInt, value)
+	}
+	if pu.mutation.RatingScoreCleared() {
+		_spec.ClearField(problem.FieldRatingScore, field.TypeInt)
+	}
+	if value, ok := pu.mutation.RatingComment(); ok {
+		_spec.SetField(problem.FieldRatingComment, field.TypeString, value)
+	}
+	if pu.mutation.RatingCommentCleared() {
+		_spec.ClearField(problem.FieldRatingComment, field.TypeString)
+	}
+	if value, ok := pu.mutation.RatedAt(); ok {
+		_spec.SetField(problem.FieldRatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.RatedAtCleared() {
+		_spec.ClearField(problem.FieldRatedAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.ReopenedFromID(); ok {
+		_spec.SetField(problem.FieldReopenedFromID, field.TypeUUID, value)
+	}
+	if pu.mutation.ReopenedFromIDCleared() {
+		_spec.ClearField(problem.FieldReopenedFromID, field.TypeUUID)
+	}
+	if value, ok := pu.mutation.ManagerReadAt(); ok {
+		_spec.SetField(problem.FieldManagerReadAt, field.TypeTime, value)
+	}
+	if pu.mutation.ManagerReadAtCleared() {
+		_spec.ClearField(problem.FieldManagerReadAt, field.TypeTime)
+	}
 	if pu.mutation.ChatCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -414,6 +642,173 @@ func (puo *ProblemUpdateOne) ClearResolveRequestID() *ProblemUpdateOne {
 	return puo
 }
 
+// SetCategory sets the "category" field.
+func (puo *ProblemUpdateOne) SetCategory(s string) *ProblemUpdateOne {
+	puo.mutation.SetCategory(s)
+	return puo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (puo *ProblemUpdateOne) SetNillableCategory(s *string) *ProblemUpdateOne {
+	if s != nil {
+		puo.SetCategory(*s)
+	}
+	return puo
+}
+
+// ClearCategory clears the value of the "category" field.
+func (puo *ProblemUpdateOne) ClearCategory() *ProblemUpdateOne {
+	puo.mutation.ClearCategory()
+	return puo
+}
+
+// SetResolutionCode sets the "resolution_code" field.
+func (puo *ProblemUpdateOne) SetResolutionCode(s string) *ProblemUpdateOne {
+	puo.mutation.SetResolutionCode(s)
+	return puo
+}
+
+// SetNillableResolutionCode sets the "resolution_code" field if the given value is not nil.
+func (puo *ProblemUpdateOne) SetNillableResolutionCode(s *string) *ProblemUpdateOne {
+	if s != nil {
+		puo.SetResolutionCode(*s)
+	}
+	return puo
+}
+
+// ClearResolutionCode clears the value of the "resolution_code" field.
+func (puo *ProblemUpdateOne) ClearResolutionCode() *ProblemUpdateOne {
+	puo.mutation.ClearResolutionCode()
+	return puo
+}
+
+// SetResolutionSummary sets the "resolution_summary" field.
+func (puo *ProblemUpdateOne) SetResolutionSummary(s string) *ProblemUpdateOne {
+	puo.mutation.SetResolutionSummary(s)
+	return puo
+}
+
+// SetNillableResolutionSummary sets the "resolution_summary" field if the given value is not nil.
+func (puo *ProblemUpdateOne) SetNillableResolutionSummary(s *string) *ProblemUpdateOne {
+	if s != nil {
+		puo.SetResolutionSummary(*s)
+	}
+	return puo
+}
+
+// ClearResolutionSummary clears the value of the "resolution_summary" field.
+func (puo *ProblemUpdateOne) ClearResolutionSummary() *ProblemUpdateOne {
+	puo.mutation.ClearResolutionSummary()
+	return puo
+}
+
+// SetRatingScore sets the "rating_score" field.
+func (puo *ProblemUpdateOne) SetRatingScore(i int) *ProblemUpdateOne {
+	puo.mutation.ResetRatingScore()
+	puo.mutation.SetRatingScore(i)
+	return puo
+}
+
+// SetNillableRatingScore sets the "rating_score" field if the given value is not nil.
+func (puo *ProblemUpdateOne) SetNillableRatingScore(i *int) *ProblemUpdateOne {
+	if i != nil {
+		puo.SetRatingScore(*i)
+	}
+	return puo
+}
+
+// AddRatingScore adds i to the "rating_score" field.
+func (puo *ProblemUpdateOne) AddRatingScore(i int) *ProblemUpdateOne {
+	puo.mutation.AddRatingScore(i)
+	return puo
+}
+
+// ClearRatingScore clears the value of the "rating_score" field.
+func (puo *ProblemUpdateOne) ClearRatingScore() *ProblemUpdateOne {
+	puo.mutation.ClearRatingScore()
+	return puo
+}
+
+// SetRatingComment sets the "rating_comment" field.
+func (puo *ProblemUpdateOne) SetRatingComment(s string) *ProblemUpdateOne {
+	puo.mutation.SetRatingComment(s)
+	return puo
+}
+
+// SetNillableRatingComment sets the "rating_comment" field if the given value is not nil.
+func (puo *ProblemUpdateOne) SetNillableRatingComment(s *string) *ProblemUpdateOne {
+	if s != nil {
+		puo.SetRatingComment(*s)
+	}
+	return puo
+}
+
+// ClearRatingComment clears the value of the "rating_comment" field.
+func (puo *ProblemUpdateOne) ClearRatingComment() *ProblemUpdateOne {
+	puo.mutation.ClearRatingComment()
+	return puo
+}
+
+// SetRatedAt sets the "rated_at" field.
+func (puo *ProblemUpdateOne) SetRatedAt(t time.Time) *ProblemUpdateOne {
+	puo.mutation.SetRatedAt(t)
+	return puo
+}
+
+// SetNillableRatedAt sets the "rated_at" field if the given value is not nil.
+func (puo *ProblemUpdateOne) SetNillableRatedAt(t *time.Time) *ProblemUpdateOne {
+	if t != nil {
+		puo.SetRatedAt(*t)
+	}
+	return puo
+}
+
+// ClearRatedAt clears the value of the "rated_at" field.
+func (puo *ProblemUpdateOne) ClearRatedAt() *ProblemUpdateOne {
+	puo.mutation.ClearRatedAt()
+	return puo
+}
+
+// SetReopenedFromID sets the "reopened_from_id" field.
+func (puo *ProblemUpdateOne) SetReopenedFromID(ti types.ProblemID) *ProblemUpdateOne {
+	puo.mutation.SetReopenedFromID(ti)
+	return puo
+}
+
+// SetNillableReopenedFromID sets the "reopened_from_id" field if the given value is not nil.
+func (puo *ProblemUpdateOne) SetNillableReopenedFromID(ti *types.ProblemID) *ProblemUpdateOne {
+	if ti != nil {
+		puo.SetReopenedFromID(*ti)
+	}
+	return puo
+}
+
+// ClearReopenedFromID clears the value of the "reopened_from_id" field.
+func (puo *ProblemUpdateOne) ClearReopenedFromID() *ProblemUpdateOne {
+	puo.mutation.ClearReopenedFromID()
+	return puo
+}
+
+// SetManagerReadAt sets the "manager_read_at" field.
+func (puo *ProblemUpdateOne) SetManagerReadAt(t time.Time) *ProblemUpdateOne {
+	puo.mutation.SetManagerReadAt(t)
+	return puo
+}
+
+// SetNillableManagerReadAt sets the "manager_read_at" field if the given value is not nil.
+func (puo *ProblemUpdateOne) SetNillableManagerReadAt(t *time.Time) *ProblemUpdateOne {
+	if t != nil {
+		puo.SetManagerReadAt(*t)
+	}
+	return puo
+}
+
+// ClearManagerReadAt clears the value of the "manager_read_at" field.
+func (puo *ProblemUpdateOne) ClearManagerReadAt() *ProblemUpdateOne {
+	puo.mutation.ClearManagerReadAt()
+	return puo
+}
+
 // SetChat sets the "chat" edge to the Chat entity.
 func (puo *ProblemUpdateOne) SetChat(c *Chat) *ProblemUpdateOne {
 	return puo.SetChatID(c.ID)
@@ -523,6 +918,16 @@ func (puo *ProblemUpdateOne) check() error {
 			return &ValidationError{Name: "resolve_request_id", err: fmt.Errorf(`store: validator failed for field "Problem.resolve_request_id": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.RatingScore(); ok {
+		if err := problem.RatingScoreValidator(v); err != nil {
+			return &ValidationError{Name: "rating_score", err: fmt.Errorf(`store: validator failed for field "Problem.rating_score": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.ReopenedFromID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "reopened_from_id", err: fmt.Errorf(`store: validator failed for field "Problem.reopened_from_id": %w`, err)}
+		}
+	}
 	if _, ok := puo.mutation.ChatID(); puo.mutation.ChatCleared() && !ok {
 		return errors.New(`store: clearing a required unique edge "Problem.chat"`)
 	}
@@ -582,6 +987,57 @@ func (puo *ProblemUpdateOne) sqlSave(ctx context.Context) (_node *Problem, err e
 	if puo.mutation.ResolveRequestIDCleared() {
 		_spec.ClearField(problem.FieldResolveRequestID, field.TypeUUID)
 	}
+	if value, ok := puo.mutation.Category(); ok {
+		_spec.SetField(problem.FieldCategory, field.TypeString, value)
+	}
+	if puo.mutation.CategoryCleared() {
+		_spec.ClearField(problem.FieldCategory, field.TypeString)
+	}
+	if value, ok := puo.mutation.ResolutionCode(); ok {
+		_spec.SetField(problem.FieldResolutionCode, field.TypeString, value)
+	}
+	if puo.mutation.ResolutionCodeCleared() {
+		_spec.ClearField(problem.FieldResolutionCode, field.TypeString)
+	}
+	if value, ok := puo.mutation.ResolutionSummary(); ok {
+		_spec.SetField(problem.FieldResolutionSummary, field.TypeString, value)
+	}
+	if puo.mutation.ResolutionSummaryCleared() {
+		_spec.ClearField(problem.FieldResolutionSummary, field.TypeString)
+	}
+	if value, ok := puo.mutation.RatingScore(); ok {
+		_spec.SetField(problem.FieldRatingScore, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedRatingScore(); ok {
+		_spec.AddField(problem.FieldRatingScore, field.TypeInt, value)
+	}
+	if puo.mutation.RatingScoreCleared() {
+		_spec.ClearField(problem.FieldRatingScore, field.TypeInt)
+	}
+	if value, ok := puo.mutation.RatingComment(); ok {
+		_spec.SetField(problem.FieldRatingComment, field.TypeString, value)
+	}
+	if puo.mutation.RatingCommentCleared() {
+		_spec.ClearField(problem.FieldRatingComment, field.TypeString)
+	}
+	if value, ok := puo.mutation.RatedAt(); ok {
+		_spec.SetField(problem.FieldRatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.RatedAtCleared() {
+		_spec.ClearField(problem.FieldRatedAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.ReopenedFromID(); ok {
+		_spec.SetField(problem.FieldReopenedFromID, field.TypeUUID, value)
+	}
+	if puo.mutation.ReopenedFromIDCleared() {
+		_spec.ClearField(problem.FieldReopenedFromID, field.TypeUUID)
+	}
+	if value, ok := puo.mutation.ManagerReadAt(); ok {
+		_spec.SetField(problem.FieldManagerReadAt, field.TypeTime, value)
+	}
+	if puo.mutation.ManagerReadAtCleared() {
+		_spec.ClearField(problem.FieldManagerReadAt, field.TypeTime)
+	}
 	if puo.mutation.ChatCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
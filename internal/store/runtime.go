@@ -5,12 +5,19 @@ package store
 import (
 	"time"
 
+	"github.com/zestagio/chat-service/internal/store/attachment"
+	"github.com/zestagio/chat-service/internal/store/cannedresponse"
 	"github.com/zestagio/chat-service/internal/store/chat"
 	"github.com/zestagio/chat-service/internal/store/failedjob"
 	"github.com/zestagio/chat-service/internal/store/job"
 	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/messagedeletion"
+	"github.com/zestagio/chat-service/internal/store/messagereaction"
+	"github.com/zestagio/chat-service/internal/store/messagerevision"
+	"github.com/zestagio/chat-service/internal/store/moderationcase"
 	"github.com/zestagio/chat-service/internal/store/problem"
 	"github.com/zestagio/chat-service/internal/store/schema"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
@@ -18,10 +25,106 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescFileName is the schema descriptor for file_name field.
+	attachmentDescFileName := attachmentFields[4].Descriptor()
+	// attachment.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	attachment.FileNameValidator = func() func(string) error {
+		validators := attachmentDescFileName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(file_name string) error {
+			for _, fn := range fns {
+				if err := fn(file_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attachmentDescContentType is the schema descriptor for content_type field.
+	attachmentDescContentType := attachmentFields[5].Descriptor()
+	// attachment.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	attachment.ContentTypeValidator = attachmentDescContentType.Validators[0].(func(string) error)
+	// attachmentDescSize is the schema descriptor for size field.
+	attachmentDescSize := attachmentFields[6].Descriptor()
+	// attachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachment.SizeValidator = attachmentDescSize.Validators[0].(func(int64) error)
+	// attachmentDescStorageKey is the schema descriptor for storage_key field.
+	attachmentDescStorageKey := attachmentFields[7].Descriptor()
+	// attachment.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	attachment.StorageKeyValidator = attachmentDescStorageKey.Validators[0].(func(string) error)
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentFields[9].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	// attachmentDescID is the schema descriptor for id field.
+	attachmentDescID := attachmentFields[0].Descriptor()
+	// attachment.DefaultID holds the default value on creation for the id field.
+	attachment.DefaultID = attachmentDescID.Default.(func() types.AttachmentID)
+	cannedresponseFields := schema.CannedResponse{}.Fields()
+	_ = cannedresponseFields
+	// cannedresponseDescIsShared is the schema descriptor for is_shared field.
+	cannedresponseDescIsShared := cannedresponseFields[2].Descriptor()
+	// cannedresponse.DefaultIsShared holds the default value on creation for the is_shared field.
+	cannedresponse.DefaultIsShared = cannedresponseDescIsShared.Default.(bool)
+	// cannedresponseDescShortcut is the schema descriptor for shortcut field.
+	cannedresponseDescShortcut := cannedresponseFields[3].Descriptor()
+	// cannedresponse.ShortcutValidator is a validator for the "shortcut" field. It is called by the builders before save.
+	cannedresponse.ShortcutValidator = func() func(string) error {
+		validators := cannedresponseDescShortcut.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(shortcut string) error {
+			for _, fn := range fns {
+				if err := fn(shortcut); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// cannedresponseDescBody is the schema descriptor for body field.
+	cannedresponseDescBody := cannedresponseFields[4].Descriptor()
+	// cannedresponse.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	cannedresponse.BodyValidator = func() func(string) error {
+		validators := cannedresponseDescBody.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(body string) error {
+			for _, fn := range fns {
+				if err := fn(body); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// cannedresponseDescCreatedAt is the schema descriptor for created_at field.
+	cannedresponseDescCreatedAt := cannedresponseFields[5].Descriptor()
+	// cannedresponse.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cannedresponse.DefaultCreatedAt = cannedresponseDescCreatedAt.Default.(func() time.Time)
+	// cannedresponseDescUpdatedAt is the schema descriptor for updated_at field.
+	cannedresponseDescUpdatedAt := cannedresponseFields[6].Descriptor()
+	// cannedresponse.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	cannedresponse.DefaultUpdatedAt = cannedresponseDescUpdatedAt.Default.(func() time.Time)
+	// cannedresponse.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	cannedresponse.UpdateDefaultUpdatedAt = cannedresponseDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// cannedresponseDescID is the schema descriptor for id field.
+	cannedresponseDescID := cannedresponseFields[0].Descriptor()
+	// cannedresponse.DefaultID holds the default value on creation for the id field.
+	cannedresponse.DefaultID = cannedresponseDescID.Default.(func() types.CannedResponseID)
 	chatFields := schema.Chat{}.Fields()
 	_ = chatFields
 	// chatDescCreatedAt is the schema descriptor for created_at field.
-	chatDescCreatedAt := chatFields[2].Descriptor()
+	chatDescCreatedAt := chatFields[3].Descriptor()
 	// chat.DefaultCreatedAt holds the default value on creation for the created_at field.
 	chat.DefaultCreatedAt = chatDescCreatedAt.Default.(func() time.Time)
 	// chatDescID is the schema descriptor for id field.
@@ -105,45 +208,107 @@ func init() {
 	// messageDescBody is the schema descriptor for body field.
 	messageDescBody := messageFields[6].Descriptor()
 	// message.BodyValidator is a validator for the "body" field. It is called by the builders before save.
-	message.BodyValidator = func() func(string) error {
-		validators := messageDescBody.Validators
-		fns := [...]func(string) error{
-			validators[0].(func(string) error),
-			validators[1].(func(string) error),
-		}
-		return func(body string) error {
-			for _, fn := range fns {
-				if err := fn(body); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
+	message.BodyValidator = messageDescBody.Validators[0].(func(string) error)
 	// messageDescIsBlocked is the schema descriptor for is_blocked field.
-	messageDescIsBlocked := messageFields[8].Descriptor()
+	messageDescIsBlocked := messageFields[12].Descriptor()
 	// message.DefaultIsBlocked holds the default value on creation for the is_blocked field.
 	message.DefaultIsBlocked = messageDescIsBlocked.Default.(bool)
 	// messageDescIsService is the schema descriptor for is_service field.
-	messageDescIsService := messageFields[9].Descriptor()
+	messageDescIsService := messageFields[13].Descriptor()
 	// message.DefaultIsService holds the default value on creation for the is_service field.
 	message.DefaultIsService = messageDescIsService.Default.(bool)
+	// messageDescIsInternalNote is the schema descriptor for is_internal_note field.
+	messageDescIsInternalNote := messageFields[14].Descriptor()
+	// message.DefaultIsInternalNote holds the default value on creation for the is_internal_note field.
+	message.DefaultIsInternalNote = messageDescIsInternalNote.Default.(bool)
 	// messageDescCreatedAt is the schema descriptor for created_at field.
-	messageDescCreatedAt := messageFields[11].Descriptor()
+	messageDescCreatedAt := messageFields[18].Descriptor()
 	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
 	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
 	// messageDescID is the schema descriptor for id field.
 	messageDescID := messageFields[0].Descriptor()
 	// message.DefaultID holds the default value on creation for the id field.
 	message.DefaultID = messageDescID.Default.(func() types.MessageID)
+	messagedeletionFields := schema.MessageDeletion{}.Fields()
+	_ = messagedeletionFields
+	// messagedeletionDescCreatedAt is the schema descriptor for created_at field.
+	messagedeletionDescCreatedAt := messagedeletionFields[5].Descriptor()
+	// messagedeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagedeletion.DefaultCreatedAt = messagedeletionDescCreatedAt.Default.(func() time.Time)
+	// messagedeletionDescID is the schema descriptor for id field.
+	messagedeletionDescID := messagedeletionFields[0].Descriptor()
+	// messagedeletion.DefaultID holds the default value on creation for the id field.
+	messagedeletion.DefaultID = messagedeletionDescID.Default.(func() types.MessageDeletionID)
+	messagereactionFields := schema.MessageReaction{}.Fields()
+	_ = messagereactionFields
+	// messagereactionDescCreatedAt is the schema descriptor for created_at field.
+	messagereactionDescCreatedAt := messagereactionFields[4].Descriptor()
+	// messagereaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagereaction.DefaultCreatedAt = messagereactionDescCreatedAt.Default.(func() time.Time)
+	// messagereactionDescID is the schema descriptor for id field.
+	messagereactionDescID := messagereactionFields[0].Descriptor()
+	// messagereaction.DefaultID holds the default value on creation for the id field.
+	messagereaction.DefaultID = messagereactionDescID.Default.(func() types.MessageReactionID)
+	messagerevisionFields := schema.MessageRevision{}.Fields()
+	_ = messagerevisionFields
+	// messagerevisionDescBody is the schema descriptor for body field.
+	messagerevisionDescBody := messagerevisionFields[2].Descriptor()
+	// messagerevision.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	messagerevision.BodyValidator = func() func(string) error {
+		validators := messagerevisionDescBody.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(body string) error {
+			for _, fn := range fns {
+				if err := fn(body); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// messagerevisionDescCreatedAt is the schema descriptor for created_at field.
+	messagerevisionDescCreatedAt := messagerevisionFields[4].Descriptor()
+	// messagerevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagerevision.DefaultCreatedAt = messagerevisionDescCreatedAt.Default.(func() time.Time)
+	// messagerevisionDescID is the schema descriptor for id field.
+	messagerevisionDescID := messagerevisionFields[0].Descriptor()
+	// messagerevision.DefaultID holds the default value on creation for the id field.
+	messagerevision.DefaultID = messagerevisionDescID.Default.(func() types.MessageRevisionID)
+	moderationcaseFields := schema.ModerationCase{}.Fields()
+	_ = moderationcaseFields
+	// moderationcaseDescCreatedAt is the schema descriptor for created_at field.
+	moderationcaseDescCreatedAt := moderationcaseFields[5].Descriptor()
+	// moderationcase.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationcase.DefaultCreatedAt = moderationcaseDescCreatedAt.Default.(func() time.Time)
+	// moderationcaseDescID is the schema descriptor for id field.
+	moderationcaseDescID := moderationcaseFields[0].Descriptor()
+	// moderationcase.DefaultID holds the default value on creation for the id field.
+	moderationcase.DefaultID = moderationcaseDescID.Default.(func() types.ModerationCaseID)
 	problemFields := schema.Problem{}.Fields()
 	_ = problemFields
+	// problemDescRatingScore is the schema descriptor for rating_score field.
+	problemDescRatingScore := problemFields[8].Descriptor()
+	// problem.RatingScoreValidator is a validator for the "rating_score" field. It is called by the builders before save.
+	problem.RatingScoreValidator = problemDescRatingScore.Validators[0].(func(int) error)
 	// problemDescCreatedAt is the schema descriptor for created_at field.
-	problemDescCreatedAt := problemFields[5].Descriptor()
+	problemDescCreatedAt := problemFields[13].Descriptor()
 	// problem.DefaultCreatedAt holds the default value on creation for the created_at field.
 	problem.DefaultCreatedAt = problemDescCreatedAt.Default.(func() time.Time)
 	// problemDescID is the schema descriptor for id field.
 	problemDescID := problemFields[0].Descriptor()
 	// problem.DefaultID holds the default value on creation for the id field.
 	problem.DefaultID = problemDescID.Default.(func() types.ProblemID)
+	verdictconflictFields := schema.VerdictConflict{}.Fields()
+	_ = verdictconflictFields
+	// verdictconflictDescCreatedAt is the schema descriptor for created_at field.
+	verdictconflictDescCreatedAt := verdictconflictFields[4].Descriptor()
+	// verdictconflict.DefaultCreatedAt holds the default value on creation for the created_at field.
+	verdictconflict.DefaultCreatedAt = verdictconflictDescCreatedAt.Default.(func() time.Time)
+	// verdictconflictDescID is the schema descriptor for id field.
+	verdictconflictDescID := verdictconflictFields[0].Descriptor()
+	// verdictconflict.DefaultID holds the default value on creation for the id field.
+	verdictconflict.DefaultID = verdictconflictDescID.Default.(func() types.VerdictConflictID)
 }
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const attachmentFileNameMaxLength = 255
+
+// Attachment holds the schema definition for the Attachment entity.
+// The file itself is kept in the attachments storage, the entity keeps its metadata.
+type Attachment struct {
+	ent.Schema
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.AttachmentID{}).Default(types.NewAttachmentID).Unique().Immutable(),
+		field.UUID("chat_id", types.ChatID{}).Immutable(),
+		field.UUID("message_id", types.MessageID{}).Optional(), // Empty until the message is sent.
+		field.UUID("uploaded_by", types.UserID{}).Immutable(),
+		field.String("file_name").NotEmpty().MaxLen(attachmentFileNameMaxLength).Immutable(),
+		field.String("content_type").NotEmpty().Immutable(),
+		field.Int64("size").Positive().Immutable(),
+		field.String("storage_key").NotEmpty().Unique().Immutable(),
+		field.String("thumbnail_key").Optional().Immutable(), // Empty if the file is not an image.
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Attachment.
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The attachment has one chat.
+		edge.From("chat", Chat.Type).
+			Ref("attachments").
+			Field("chat_id").
+			Required().Unique().Immutable(),
+
+		// The attachment has at most one message.
+		edge.From("message", Message.Type).
+			Ref("attachments").
+			Field("message_id").
+			Unique(),
+	}
+}
+
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		// Loading attachments of the messages.
+		index.Fields("message_id"),
+	}
+}
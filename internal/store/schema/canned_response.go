@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const (
+	cannedResponseShortcutMaxLength = 32
+	cannedResponseBodyMaxLength     = 3000
+)
+
+// CannedResponse holds the schema definition for the CannedResponse entity.
+// It is the ready answer the manager sends instead of typing it again.
+type CannedResponse struct {
+	ent.Schema
+}
+
+// Fields of the CannedResponse.
+func (CannedResponse) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.CannedResponseID{}).Default(types.NewCannedResponseID).Unique().Immutable(),
+		field.UUID("author_id", types.UserID{}).Immutable(),
+		field.Bool("is_shared").Default(false).Immutable(), // Shared with the whole team or personal.
+		field.String("shortcut").NotEmpty().MaxLen(cannedResponseShortcutMaxLength),
+		field.Text("body").NotEmpty().MaxLen(cannedResponseBodyMaxLength), // May contain template variables.
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (CannedResponse) Indexes() []ent.Index {
+	return []ent.Index{
+		// The shortcut is unique among the personal responses of the manager.
+		index.Fields("author_id", "shortcut").
+			Unique().
+			Annotations(entsql.IndexWhere("not is_shared")),
+
+		// The shortcut is unique among the shared responses.
+		index.Fields("shortcut").
+			Unique().
+			Annotations(entsql.IndexWhere("is_shared")),
+	}
+}
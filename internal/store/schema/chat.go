@@ -19,6 +19,7 @@ func (Chat) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", types.ChatID{}).Default(types.NewChatID).Unique().Immutable(),
 		field.UUID("client_id", types.UserID{}).Unique().Immutable(),
+		field.String("client_name").Optional(), // From the client token claims, empty if unknown.
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
 }
@@ -26,6 +27,7 @@ func (Chat) Fields() []ent.Field {
 // Edges of the Chat.
 func (Chat) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.To("attachments", Attachment.Type),
 		edge.To("messages", Message.Type),
 		edge.To("problems", Problem.Type),
 	}
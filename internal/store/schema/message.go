@@ -27,11 +27,18 @@ func (Message) Fields() []ent.Field {
 		field.UUID("author_id", types.UserID{}).Optional().Immutable(),
 		field.Bool("is_visible_for_client").Default(false),
 		field.Bool("is_visible_for_manager").Default(false),
-		field.Text("body").NotEmpty().MaxLen(messageBodyMaxLength).Immutable(),
+		field.Text("body").MaxLen(messageBodyMaxLength), // Empty if the message consists of attachments only.
+		field.Time("edited_at").Optional(),
+		field.Time("deleted_at").Optional(),
 		field.Time("checked_at").Optional(),
+		field.Enum("verdict").Values("ok", "suspicious").Optional(),                                // The applied decision.
+		field.Enum("verdict_source").Values("afc", "timeout", "prefilter", "moderator").Optional(), // Who made the decision.
 		field.Bool("is_blocked").Default(false),
 		field.Bool("is_service").Default(false).Immutable(),
+		field.Bool("is_internal_note").Default(false).Immutable(), // Visible for managers only.
 		field.UUID("initial_request_id", types.RequestID{}).Immutable(),
+		field.UUID("content_request_id", types.RequestID{}).Optional(), // The request the body comes from: initial or last edit.
+		field.UUID("reply_to_message_id", types.MessageID{}).Optional().Immutable(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
 }
@@ -50,21 +57,45 @@ func (Message) Edges() []ent.Edge {
 			Ref("messages").
 			Field("problem_id").
 			Required().Unique(),
+
+		// The message has at most one moderation case.
+		edge.To("moderation_case", ModerationCase.Type).Unique(),
+
+		// The message has verdicts conflicting with the applied one.
+		edge.To("verdict_conflicts", VerdictConflict.Type),
+
+		// The message has previous versions of the body.
+		edge.To("revisions", MessageRevision.Type),
+
+		// The message has at most one deletion.
+		edge.To("deletion", MessageDeletion.Type).Unique(),
+
+		// The message has attached files.
+		edge.To("attachments", Attachment.Type),
+
+		// The message has reactions of the chat participants.
+		edge.To("reactions", MessageReaction.Type),
+
+		// The message may quote another message of the chat.
+		edge.To("replies", Message.Type).
+			From("reply_to").
+			Field("reply_to_message_id").
+			Unique().Immutable(),
 	}
 }
 
 func (Message) Indexes() []ent.Index {
 	return []ent.Index{
 		// Getting client chat history.
-		index.Fields("chat_id", "created_at").
+		index.Fields("chat_id", "created_at", "id").
 			Annotations(
-				entsql.DescColumns("created_at"),
+				entsql.DescColumns("created_at", "id"),
 			),
 
 		// Getting problem history (for manager).
-		index.Fields("problem_id", "created_at").
+		index.Fields("problem_id", "created_at", "id").
 			Annotations(
-				entsql.DescColumns("created_at"),
+				entsql.DescColumns("created_at", "id"),
 			),
 
 		// Idempotency of `send message` usecase.
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// MessageDeletion holds the schema definition for the MessageDeletion entity.
+// It is the audit record of the message soft deletion.
+type MessageDeletion struct {
+	ent.Schema
+}
+
+// Fields of the MessageDeletion.
+func (MessageDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.MessageDeletionID{}).Default(types.NewMessageDeletionID).Unique().Immutable(),
+		field.UUID("message_id", types.MessageID{}).Unique().Immutable(),
+		field.UUID("request_id", types.RequestID{}).Unique().Immutable(),
+		field.UUID("deleted_by", types.UserID{}).Immutable(),
+		field.Enum("deleted_by_role").Values("client", "manager", "moderator").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the MessageDeletion.
+func (MessageDeletion) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The deletion has one message.
+		edge.From("message", Message.Type).
+			Ref("deletion").
+			Field("message_id").
+			Required().Unique().Immutable(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// MessageReaction holds the schema definition for the MessageReaction entity.
+// It is the lightweight acknowledgement of the message by the chat participant.
+type MessageReaction struct {
+	ent.Schema
+}
+
+// Fields of the MessageReaction.
+func (MessageReaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.MessageReactionID{}).Default(types.NewMessageReactionID).Unique().Immutable(),
+		field.UUID("message_id", types.MessageID{}).Immutable(),
+		field.UUID("user_id", types.UserID{}).Immutable(),
+		field.Enum("kind").Values("thumbs_up", "thumbs_down", "heart", "laugh", "surprised", "sad").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the MessageReaction.
+func (MessageReaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The reaction has one message.
+		edge.From("message", Message.Type).
+			Ref("reactions").
+			Field("message_id").
+			Required().Unique().Immutable(),
+	}
+}
+
+func (MessageReaction) Indexes() []ent.Index {
+	return []ent.Index{
+		// The user puts the reaction of each kind once.
+		index.Fields("message_id", "user_id", "kind").Unique(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// MessageRevision holds the schema definition for the MessageRevision entity.
+// It is the previous version of the edited message body.
+type MessageRevision struct {
+	ent.Schema
+}
+
+// Fields of the MessageRevision.
+func (MessageRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.MessageRevisionID{}).Default(types.NewMessageRevisionID).Unique().Immutable(),
+		field.UUID("message_id", types.MessageID{}).Immutable(),
+		field.Text("body").NotEmpty().MaxLen(messageBodyMaxLength).Immutable(),
+		field.UUID("edit_request_id", types.RequestID{}).Unique().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the MessageRevision.
+func (MessageRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The revision has one message.
+		edge.From("message", Message.Type).
+			Ref("revisions").
+			Field("message_id").
+			Required().Unique().Immutable(),
+	}
+}
+
+func (MessageRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		// Getting message revisions in order.
+		index.Fields("message_id", "created_at"),
+	}
+}
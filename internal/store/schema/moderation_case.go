@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ModerationCase holds the schema definition for the ModerationCase entity.
+// The case is opened for the message that got the "suspicious" AFC verdict
+// and is closed by the moderator's decision.
+type ModerationCase struct {
+	ent.Schema
+}
+
+// Fields of the ModerationCase.
+func (ModerationCase) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.ModerationCaseID{}).Default(types.NewModerationCaseID).Unique().Immutable(),
+		field.UUID("message_id", types.MessageID{}).Unique().Immutable(),
+		field.UUID("moderator_id", types.UserID{}).Optional(),
+		field.Enum("decision").Values("approved", "rejected").Optional(),
+		field.Time("decided_at").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ModerationCase.
+func (ModerationCase) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The case has one message.
+		edge.From("message", Message.Type).
+			Ref("moderation_case").
+			Field("message_id").
+			Required().Unique().Immutable(),
+	}
+}
+
+func (ModerationCase) Indexes() []ent.Index {
+	return []ent.Index{
+		// Getting moderation queue.
+		index.Fields("created_at").
+			Annotations(entsql.IndexWhere("decided_at is null")),
+	}
+}
@@ -23,6 +23,18 @@ func (Problem) Fields() []ent.Field {
 		field.UUID("manager_id", types.UserID{}).Optional(),
 		field.Time("resolved_at").Optional(),
 		field.UUID("resolve_request_id", types.RequestID{}).Optional().Unique(),
+		// The contact reason and the resolution are validated against the problem taxonomy from config.
+		field.String("category").Optional(),
+		field.String("resolution_code").Optional(),
+		field.String("resolution_summary").Optional(),
+		// The client rates the resolved problem once, the score is from 1 to 5.
+		field.Int("rating_score").Optional().Range(1, 5),
+		field.String("rating_comment").Optional(),
+		field.Time("rated_at").Optional(),
+		// The problem resolved recently that the client has written again about, the previous manager was busy.
+		field.UUID("reopened_from_id", types.ProblemID{}).Optional(),
+		// The manager has read the messages created before this time.
+		field.Time("manager_read_at").Optional(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
 }
@@ -48,5 +60,8 @@ func (Problem) Indexes() []ent.Index {
 
 		// Getting open problems for manager.
 		index.Fields("manager_id"),
+
+		// Satisfaction scores for the period.
+		index.Fields("rated_at"),
 	}
 }
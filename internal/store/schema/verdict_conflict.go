@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// VerdictConflict holds the schema definition for the VerdictConflict entity.
+// It is the audit record of the AFC verdict that came after the message had been checked
+// and differs from the applied decision.
+type VerdictConflict struct {
+	ent.Schema
+}
+
+// Fields of the VerdictConflict.
+func (VerdictConflict) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.VerdictConflictID{}).Default(types.NewVerdictConflictID).Unique().Immutable(),
+		field.UUID("message_id", types.MessageID{}).Immutable(),
+		field.Enum("applied_verdict").Values("ok", "suspicious").Immutable(),
+		field.Enum("received_verdict").Values("ok", "suspicious").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the VerdictConflict.
+func (VerdictConflict) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The conflict has one message.
+		edge.From("message", Message.Type).
+			Ref("verdict_conflicts").
+			Field("message_id").
+			Required().Unique().Immutable(),
+	}
+}
+
+func (VerdictConflict) Indexes() []ent.Index {
+	return []ent.Index{
+		// Redelivered conflicting verdict is recorded once.
+		index.Fields("message_id", "received_verdict").Unique(),
+	}
+}
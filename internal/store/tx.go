@@ -14,6 +14,10 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// CannedResponse is the client for interacting with the CannedResponse builders.
+	CannedResponse *CannedResponseClient
 	// Chat is the client for interacting with the Chat builders.
 	Chat *ChatClient
 	// FailedJob is the client for interacting with the FailedJob builders.
@@ -22,8 +26,18 @@ type Tx struct {
 	Job *JobClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageDeletion is the client for interacting with the MessageDeletion builders.
+	MessageDeletion *MessageDeletionClient
+	// MessageReaction is the client for interacting with the MessageReaction builders.
+	MessageReaction *MessageReactionClient
+	// MessageRevision is the client for interacting with the MessageRevision builders.
+	MessageRevision *MessageRevisionClient
+	// ModerationCase is the client for interacting with the ModerationCase builders.
+	ModerationCase *ModerationCaseClient
 	// Problem is the client for interacting with the Problem builders.
 	Problem *ProblemClient
+	// VerdictConflict is the client for interacting with the VerdictConflict builders.
+	VerdictConflict *VerdictConflictClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,11 +169,18 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.CannedResponse = NewCannedResponseClient(tx.config)
 	tx.Chat = NewChatClient(tx.config)
 	tx.FailedJob = NewFailedJobClient(tx.config)
 	tx.Job = NewJobClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.MessageDeletion = NewMessageDeletionClient(tx.config)
+	tx.MessageReaction = NewMessageReactionClient(tx.config)
+	tx.MessageRevision = NewMessageRevisionClient(tx.config)
+	tx.ModerationCase = NewModerationCaseClient(tx.config)
 	tx.Problem = NewProblemClient(tx.config)
+	tx.VerdictConflict = NewVerdictConflictClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -169,7 +190,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Chat.QueryXXX(), the query will be executed
+// applies a query, for example: Attachment.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
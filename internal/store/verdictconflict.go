@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// VerdictConflict is the model entity for the VerdictConflict schema.
+type VerdictConflict struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID types.VerdictConflictID `json:"id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID types.MessageID `json:"message_id,omitempty"`
+	// AppliedVerdict holds the value of the "applied_verdict" field.
+	AppliedVerdict verdictconflict.AppliedVerdict `json:"applied_verdict,omitempty"`
+	// ReceivedVerdict holds the value of the "received_verdict" field.
+	ReceivedVerdict verdictconflict.ReceivedVerdict `json:"received_verdict,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VerdictConflictQuery when eager-loading is set.
+	Edges        VerdictConflictEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// VerdictConflictEdges holds the relations/edges for other nodes in the graph.
+type VerdictConflictEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VerdictConflictEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VerdictConflict) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case verdictconflict.FieldAppliedVerdict, verdictconflict.FieldReceivedVerdict:
+			values[i] = new(sql.NullString)
+		case verdictconflict.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case verdictconflict.FieldMessageID:
+			values[i] = new(types.MessageID)
+		case verdictconflict.FieldID:
+			values[i] = new(types.VerdictConflictID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VerdictConflict fields.
+func (vc *VerdictConflict) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case verdictconflict.FieldID:
+			if value, ok := values[i].(*types.VerdictConflictID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				vc.ID = *value
+			}
+		case verdictconflict.FieldMessageID:
+			if value, ok := values[i].(*types.MessageID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				vc.MessageID = *value
+			}
+		case verdictconflict.FieldAppliedVerdict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_verdict", values[i])
+			} else if value.Valid {
+				vc.AppliedVerdict = verdictconflict.AppliedVerdict(value.String)
+			}
+		case verdictconflict.FieldReceivedVerdict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field received_verdict", values[i])
+			} else if value.Valid {
+				vc.ReceivedVerdict = verdictconflict.ReceivedVerdict(value.String)
+			}
+		case verdictconflict.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				vc.CreatedAt = value.Time
+			}
+		default:
+			vc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VerdictConflict.
+// This includes values selected through modifiers, order, etc.
+func (vc *VerdictConflict) Value(name string) (ent.Value, error) {
+	return vc.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the VerdictConflict entity.
+func (vc *VerdictConflict) QueryMessage() *MessageQuery {
+	return NewVerdictConflictClient(vc.config).QueryMessage(vc)
+}
+
+// Update returns a builder for updating this VerdictConflict.
+// Note that you need to call VerdictConflict.Unwrap() before calling this method if this VerdictConflict
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vc *VerdictConflict) Update() *VerdictConflictUpdateOne {
+	return NewVerdictConflictClient(vc.config).UpdateOne(vc)
+}
+
+// Unwrap unwraps the VerdictConflict entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vc *VerdictConflict) Unwrap() *VerdictConflict {
+	_tx, ok := vc.config.driver.(*txDriver)
+	if !ok {
+		panic("store: VerdictConflict is not a transactional entity")
+	}
+	vc.config.driver = _tx.drv
+	return vc
+}
+
+// String implements the fmt.Stringer.
+func (vc *VerdictConflict) String() string {
+	var builder strings.Builder
+	builder.WriteString("VerdictConflict(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vc.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", vc.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("applied_verdict=")
+	builder.WriteString(fmt.Sprintf("%v", vc.AppliedVerdict))
+	builder.WriteString(", ")
+	builder.WriteString("received_verdict=")
+	builder.WriteString(fmt.Sprintf("%v", vc.ReceivedVerdict))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(vc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VerdictConflicts is a parsable slice of VerdictConflict.
+type VerdictConflicts []*VerdictConflict
@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package verdictconflict
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const (
+	// Label holds the string label denoting the verdictconflict type in the database.
+	Label = "verdict_conflict"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldAppliedVerdict holds the string denoting the applied_verdict field in the database.
+	FieldAppliedVerdict = "applied_verdict"
+	// FieldReceivedVerdict holds the string denoting the received_verdict field in the database.
+	FieldReceivedVerdict = "received_verdict"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeMessage holds the string denoting the message edge name in mutations.
+	EdgeMessage = "message"
+	// Table holds the table name of the verdictconflict in the database.
+	Table = "verdict_conflicts"
+	// MessageTable is the table that holds the message relation/edge.
+	MessageTable = "verdict_conflicts"
+	// MessageInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	MessageInverseTable = "messages"
+	// MessageColumn is the table column denoting the message relation/edge.
+	MessageColumn = "message_id"
+)
+
+// Columns holds all SQL columns for verdictconflict fields.
+var Columns = []string{
+	FieldID,
+	FieldMessageID,
+	FieldAppliedVerdict,
+	FieldReceivedVerdict,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() types.VerdictConflictID
+)
+
+// AppliedVerdict defines the type for the "applied_verdict" enum field.
+type AppliedVerdict string
+
+// AppliedVerdict values.
+const (
+	AppliedVerdictOk         AppliedVerdict = "ok"
+	AppliedVerdictSuspicious AppliedVerdict = "suspicious"
+)
+
+func (av AppliedVerdict) String() string {
+	return string(av)
+}
+
+// AppliedVerdictValidator is a validator for the "applied_verdict" field enum values. It is called by the builders before save.
+func AppliedVerdictValidator(av AppliedVerdict) error {
+	switch av {
+	case AppliedVerdictOk, AppliedVerdictSuspicious:
+		return nil
+	default:
+		return fmt.Errorf("verdictconflict: invalid enum value for applied_verdict field: %q", av)
+	}
+}
+
+// ReceivedVerdict defines the type for the "received_verdict" enum field.
+type ReceivedVerdict string
+
+// ReceivedVerdict values.
+const (
+	ReceivedVerdictOk         ReceivedVerdict = "ok"
+	ReceivedVerdictSuspicious ReceivedVerdict = "suspicious"
+)
+
+func (rv ReceivedVerdict) String() string {
+	return string(rv)
+}
+
+// ReceivedVerdictValidator is a validator for the "received_verdict" field enum values. It is called by the builders before save.
+func ReceivedVerdictValidator(rv ReceivedVerdict) error {
+	switch rv {
+	case ReceivedVerdictOk, ReceivedVerdictSuspicious:
+		return nil
+	default:
+		return fmt.Errorf("verdictconflict: invalid enum value for received_verdict field: %q", rv)
+	}
+}
+
+// OrderOption defines the ordering options for the VerdictConflict queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMessageID orders the results by the message_id field.
+func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
+}
+
+// ByAppliedVerdict orders the results by the applied_verdict field.
+func ByAppliedVerdict(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedVerdict, opts...).ToFunc()
+}
+
+// ByReceivedVerdict orders the results by the received_verdict field.
+func ByReceivedVerdict(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedVerdict, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByMessageField orders the results by message field.
+func ByMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMessageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+	)
+}
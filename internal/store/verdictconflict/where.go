@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package verdictconflict
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id types.VerdictConflictID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id types.VerdictConflictID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id types.VerdictConflictID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...types.VerdictConflictID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...types.VerdictConflictID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id types.VerdictConflictID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id types.VerdictConflictID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id types.VerdictConflictID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id types.VerdictConflictID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldLTE(FieldID, id))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v types.MessageID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldEQ(FieldMessageID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v types.MessageID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v types.MessageID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...types.MessageID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...types.MessageID) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// AppliedVerdictEQ applies the EQ predicate on the "applied_verdict" field.
+func AppliedVerdictEQ(v AppliedVerdict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldEQ(FieldAppliedVerdict, v))
+}
+
+// AppliedVerdictNEQ applies the NEQ predicate on the "applied_verdict" field.
+func AppliedVerdictNEQ(v AppliedVerdict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldNEQ(FieldAppliedVerdict, v))
+}
+
+// AppliedVerdictIn applies the In predicate on the "applied_verdict" field.
+func AppliedVerdictIn(vs ...AppliedVerdict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldIn(FieldAppliedVerdict, vs...))
+}
+
+// AppliedVerdictNotIn applies the NotIn predicate on the "applied_verdict" field.
+func AppliedVerdictNotIn(vs ...AppliedVerdict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldNotIn(FieldAppliedVerdict, vs...))
+}
+
+// ReceivedVerdictEQ applies the EQ predicate on the "received_verdict" field.
+func ReceivedVerdictEQ(v ReceivedVerdict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldEQ(FieldReceivedVerdict, v))
+}
+
+// ReceivedVerdictNEQ applies the NEQ predicate on the "received_verdict" field.
+func ReceivedVerdictNEQ(v ReceivedVerdict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldNEQ(FieldReceivedVerdict, v))
+}
+
+// ReceivedVerdictIn applies the In predicate on the "received_verdict" field.
+func ReceivedVerdictIn(vs ...ReceivedVerdict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldIn(FieldReceivedVerdict, vs...))
+}
+
+// ReceivedVerdictNotIn applies the NotIn predicate on the "received_verdict" field.
+func ReceivedVerdictNotIn(vs ...ReceivedVerdict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldNotIn(FieldReceivedVerdict, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.VerdictConflict {
+	return predicate.VerdictConflict(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.VerdictConflict {
+	return predicate.VerdictConflict(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VerdictConflict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VerdictConflict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VerdictConflict) predicate.VerdictConflict {
+	return predicate.VerdictConflict(sql.NotPredicates(p))
+}
@@ -0,0 +1,586 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// VerdictConflictCreate is the builder for creating a VerdictConflict entity.
+type VerdictConflictCreate struct {
+	config
+	mutation *VerdictConflictMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMessageID sets the "message_id" field.
+func (vcc *VerdictConflictCreate) SetMessageID(ti types.MessageID) *VerdictConflictCreate {
+	vcc.mutation.SetMessageID(ti)
+	return vcc
+}
+
+// SetAppliedVerdict sets the "applied_verdict" field.
+func (vcc *VerdictConflictCreate) SetAppliedVerdict(vv verdictconflict.AppliedVerdict) *VerdictConflictCreate {
+	vcc.mutation.SetAppliedVerdict(vv)
+	return vcc
+}
+
+// SetReceivedVerdict sets the "received_verdict" field.
+func (vcc *VerdictConflictCreate) SetReceivedVerdict(vv verdictconflict.ReceivedVerdict) *VerdictConflictCreate {
+	vcc.mutation.SetReceivedVerdict(vv)
+	return vcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vcc *VerdictConflictCreate) SetCreatedAt(t time.Time) *VerdictConflictCreate {
+	vcc.mutation.SetCreatedAt(t)
+	return vcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vcc *VerdictConflictCreate) SetNillableCreatedAt(t *time.Time) *VerdictConflictCreate {
+	if t != nil {
+		vcc.SetCreatedAt(*t)
+	}
+	return vcc
+}
+
+// SetID sets the "id" field.
+func (vcc *VerdictConflictCreate) SetID(tci types.VerdictConflictID) *VerdictConflictCreate {
+	vcc.mutation.SetID(tci)
+	return vcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (vcc *VerdictConflictCreate) SetNillableID(tci *types.VerdictConflictID) *VerdictConflictCreate {
+	if tci != nil {
+		vcc.SetID(*tci)
+	}
+	return vcc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (vcc *VerdictConflictCreate) SetMessage(m *Message) *VerdictConflictCreate {
+	return vcc.SetMessageID(m.ID)
+}
+
+// Mutation returns the VerdictConflictMutation object of the builder.
+func (vcc *VerdictConflictCreate) Mutation() *VerdictConflictMutation {
+	return vcc.mutation
+}
+
+// Save creates the VerdictConflict in the database.
+func (vcc *VerdictConflictCreate) Save(ctx context.Context) (*VerdictConflict, error) {
+	vcc.defaults()
+	return withHooks(ctx, vcc.sqlSave, vcc.mutation, vcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vcc *VerdictConflictCreate) SaveX(ctx context.Context) *VerdictConflict {
+	v, err := vcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vcc *VerdictConflictCreate) Exec(ctx context.Context) error {
+	_, err := vcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcc *VerdictConflictCreate) ExecX(ctx context.Context) {
+	if err := vcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vcc *VerdictConflictCreate) defaults() {
+	if _, ok := vcc.mutation.CreatedAt(); !ok {
+		v := verdictconflict.DefaultCreatedAt()
+		vcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := vcc.mutation.ID(); !ok {
+		v := verdictconflict.DefaultID()
+		vcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcc *VerdictConflictCreate) check() error {
+	if _, ok := vcc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`store: missing required field "VerdictConflict.message_id"`)}
+	}
+	if v, ok := vcc.mutation.MessageID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`store: validator failed for field "VerdictConflict.message_id": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.AppliedVerdict(); !ok {
+		return &ValidationError{Name: "applied_verdict", err: errors.New(`store: missing required field "VerdictConflict.applied_verdict"`)}
+	}
+	if v, ok := vcc.mutation.AppliedVerdict(); ok {
+		if err := verdictconflict.AppliedVerdictValidator(v); err != nil {
+			return &ValidationError{Name: "applied_verdict", err: fmt.Errorf(`store: validator failed for field "VerdictConflict.applied_verdict": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.ReceivedVerdict(); !ok {
+		return &ValidationError{Name: "received_verdict", err: errors.New(`store: missing required field "VerdictConflict.received_verdict"`)}
+	}
+	if v, ok := vcc.mutation.ReceivedVerdict(); ok {
+		if err := verdictconflict.ReceivedVerdictValidator(v); err != nil {
+			return &ValidationError{Name: "received_verdict", err: fmt.Errorf(`store: validator failed for field "VerdictConflict.received_verdict": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "VerdictConflict.created_at"`)}
+	}
+	if v, ok := vcc.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "VerdictConflict.id": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`store: missing required edge "VerdictConflict.message"`)}
+	}
+	return nil
+}
+
+func (vcc *VerdictConflictCreate) sqlSave(ctx context.Context) (*VerdictConflict, error) {
+	if err := vcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.VerdictConflictID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	vcc.mutation.id = &_node.ID
+	vcc.mutation.done = true
+	return _node, nil
+}
+
+func (vcc *VerdictConflictCreate) createSpec() (*VerdictConflict, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VerdictConflict{config: vcc.config}
+		_spec = sqlgraph.NewCreateSpec(verdictconflict.Table, sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = vcc.conflict
+	if id, ok := vcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := vcc.mutation.AppliedVerdict(); ok {
+		_spec.SetField(verdictconflict.FieldAppliedVerdict, field.TypeEnum, value)
+		_node.AppliedVerdict = value
+	}
+	if value, ok := vcc.mutation.ReceivedVerdict(); ok {
+		_spec.SetField(verdictconflict.FieldReceivedVerdict, field.TypeEnum, value)
+		_node.ReceivedVerdict = value
+	}
+	if value, ok := vcc.mutation.CreatedAt(); ok {
+		_spec.SetField(verdictconflict.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := vcc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   verdictconflict.MessageTable,
+			Columns: []string{verdictconflict.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VerdictConflict.Create().
+//		SetMessageID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VerdictConflictUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (vcc *VerdictConflictCreate) OnConflict(opts ...sql.ConflictOption) *VerdictConflictUpsertOne {
+	vcc.conflict = opts
+	return &VerdictConflictUpsertOne{
+		create: vcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VerdictConflict.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vcc *VerdictConflictCreate) OnConflictColumns(columns ...string) *VerdictConflictUpsertOne {
+	vcc.conflict = append(vcc.conflict, sql.ConflictColumns(columns...))
+	return &VerdictConflictUpsertOne{
+		create: vcc,
+	}
+}
+
+type (
+	// VerdictConflictUpsertOne is the builder for "upsert"-ing
+	//  one VerdictConflict node.
+	VerdictConflictUpsertOne struct {
+		create *VerdictConflictCreate
+	}
+
+	// VerdictConflictUpsert is the "OnConflict" setter.
+	VerdictConflictUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.VerdictConflict.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(verdictconflict.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VerdictConflictUpsertOne) UpdateNewValues() *VerdictConflictUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(verdictconflict.FieldID)
+		}
+		if _, exists := u.create.mutation.MessageID(); exists {
+			s.SetIgnore(verdictconflict.FieldMessageID)
+		}
+		if _, exists := u.create.mutation.AppliedVerdict(); exists {
+			s.SetIgnore(verdictconflict.FieldAppliedVerdict)
+		}
+		if _, exists := u.create.mutation.ReceivedVerdict(); exists {
+			s.SetIgnore(verdictconflict.FieldReceivedVerdict)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(verdictconflict.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VerdictConflict.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VerdictConflictUpsertOne) Ignore() *VerdictConflictUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VerdictConflictUpsertOne) DoNothing() *VerdictConflictUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VerdictConflictCreate.OnConflict
+// documentation for more info.
+func (u *VerdictConflictUpsertOne) Update(set func(*VerdictConflictUpsert)) *VerdictConflictUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VerdictConflictUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *VerdictConflictUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for VerdictConflictCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VerdictConflictUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VerdictConflictUpsertOne) ID(ctx context.Context) (id types.VerdictConflictID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: VerdictConflictUpsertOne.ID is not supported by MySQL driver. Use VerdictConflictUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VerdictConflictUpsertOne) IDX(ctx context.Context) types.VerdictConflictID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VerdictConflictCreateBulk is the builder for creating many VerdictConflict entities in bulk.
+type VerdictConflictCreateBulk struct {
+	config
+	err      error
+	builders []*VerdictConflictCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VerdictConflict entities in the database.
+func (vccb *VerdictConflictCreateBulk) Save(ctx context.Context) ([]*VerdictConflict, error) {
+	if vccb.err != nil {
+		return nil, vccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vccb.builders))
+	nodes := make([]*VerdictConflict, len(vccb.builders))
+	mutators := make([]Mutator, len(vccb.builders))
+	for i := range vccb.builders {
+		func(i int, root context.Context) {
+			builder := vccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VerdictConflictMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vccb *VerdictConflictCreateBulk) SaveX(ctx context.Context) []*VerdictConflict {
+	v, err := vccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vccb *VerdictConflictCreateBulk) Exec(ctx context.Context) error {
+	_, err := vccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vccb *VerdictConflictCreateBulk) ExecX(ctx context.Context) {
+	if err := vccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VerdictConflict.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VerdictConflictUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (vccb *VerdictConflictCreateBulk) OnConflict(opts ...sql.ConflictOption) *VerdictConflictUpsertBulk {
+	vccb.conflict = opts
+	return &VerdictConflictUpsertBulk{
+		create: vccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VerdictConflict.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vccb *VerdictConflictCreateBulk) OnConflictColumns(columns ...string) *VerdictConflictUpsertBulk {
+	vccb.conflict = append(vccb.conflict, sql.ConflictColumns(columns...))
+	return &VerdictConflictUpsertBulk{
+		create: vccb,
+	}
+}
+
+// VerdictConflictUpsertBulk is the builder for "upsert"-ing
+// a bulk of VerdictConflict nodes.
+type VerdictConflictUpsertBulk struct {
+	create *VerdictConflictCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VerdictConflict.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(verdictconflict.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VerdictConflictUpsertBulk) UpdateNewValues() *VerdictConflictUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(verdictconflict.FieldID)
+			}
+			if _, exists := b.mutation.MessageID(); exists {
+				s.SetIgnore(verdictconflict.FieldMessageID)
+			}
+			if _, exists := b.mutation.AppliedVerdict(); exists {
+				s.SetIgnore(verdictconflict.FieldAppliedVerdict)
+			}
+			if _, exists := b.mutation.ReceivedVerdict(); exists {
+				s.SetIgnore(verdictconflict.FieldReceivedVerdict)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(verdictconflict.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VerdictConflict.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VerdictConflictUpsertBulk) Ignore() *VerdictConflictUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VerdictConflictUpsertBulk) DoNothing() *VerdictConflictUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VerdictConflictCreateBulk.OnConflict
+// documentation for more info.
+func (u *VerdictConflictUpsertBulk) Update(set func(*VerdictConflictUpsert)) *VerdictConflictUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VerdictConflictUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *VerdictConflictUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the VerdictConflictCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for VerdictConflictCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VerdictConflictUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
+)
+
+// VerdictConflictDelete is the builder for deleting a VerdictConflict entity.
+type VerdictConflictDelete struct {
+	config
+	hooks    []Hook
+	mutation *VerdictConflictMutation
+}
+
+// Where appends a list predicates to the VerdictConflictDelete builder.
+func (vcd *VerdictConflictDelete) Where(ps ...predicate.VerdictConflict) *VerdictConflictDelete {
+	vcd.mutation.Where(ps...)
+	return vcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vcd *VerdictConflictDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vcd.sqlExec, vcd.mutation, vcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcd *VerdictConflictDelete) ExecX(ctx context.Context) int {
+	n, err := vcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vcd *VerdictConflictDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(verdictconflict.Table, sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID))
+	if ps := vcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vcd.mutation.done = true
+	return affected, err
+}
+
+// VerdictConflictDeleteOne is the builder for deleting a single VerdictConflict entity.
+type VerdictConflictDeleteOne struct {
+	vcd *VerdictConflictDelete
+}
+
+// Where appends a list predicates to the VerdictConflictDelete builder.
+func (vcdo *VerdictConflictDeleteOne) Where(ps ...predicate.VerdictConflict) *VerdictConflictDeleteOne {
+	vcdo.vcd.mutation.Where(ps...)
+	return vcdo
+}
+
+// Exec executes the deletion query.
+func (vcdo *VerdictConflictDeleteOne) Exec(ctx context.Context) error {
+	n, err := vcdo.vcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{verdictconflict.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcdo *VerdictConflictDeleteOne) ExecX(ctx context.Context) {
+	if err := vcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
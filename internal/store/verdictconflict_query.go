@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/message"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+// VerdictConflictQuery is the builder for querying VerdictConflict entities.
+type VerdictConflictQuery struct {
+	config
+	ctx         *QueryContext
+	order       []verdictconflict.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.VerdictConflict
+	withMessage *MessageQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VerdictConflictQuery builder.
+func (vcq *VerdictConflictQuery) Where(ps ...predicate.VerdictConflict) *VerdictConflictQuery {
+	vcq.predicates = append(vcq.predicates, ps...)
+	return vcq
+}
+
+// Limit the number of records to be returned by this query.
+func (vcq *VerdictConflictQuery) Limit(limit int) *VerdictConflictQuery {
+	vcq.ctx.Limit = &limit
+	return vcq
+}
+
+// Offset to start from.
+func (vcq *VerdictConflictQuery) Offset(offset int) *VerdictConflictQuery {
+	vcq.ctx.Offset = &offset
+	return vcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vcq *VerdictConflictQuery) Unique(unique bool) *VerdictConflictQuery {
+	vcq.ctx.Unique = &unique
+	return vcq
+}
+
+// Order specifies how the records should be ordered.
+func (vcq *VerdictConflictQuery) Order(o ...verdictconflict.OrderOption) *VerdictConflictQuery {
+	vcq.order = append(vcq.order, o...)
+	return vcq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (vcq *VerdictConflictQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: vcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(verdictconflict.Table, verdictconflict.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, verdictconflict.MessageTable, verdictconflict.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first VerdictConflict entity from the query.
+// Returns a *NotFoundError when no VerdictConflict was found.
+func (vcq *VerdictConflictQuery) First(ctx context.Context) (*VerdictConflict, error) {
+	nodes, err := vcq.Limit(1).All(setContextOp(ctx, vcq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{verdictconflict.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vcq *VerdictConflictQuery) FirstX(ctx context.Context) *VerdictConflict {
+	node, err := vcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VerdictConflict ID from the query.
+// Returns a *NotFoundError when no VerdictConflict ID was found.
+func (vcq *VerdictConflictQuery) FirstID(ctx context.Context) (id types.VerdictConflictID, err error) {
+	var ids []types.VerdictConflictID
+	if ids, err = vcq.Limit(1).IDs(setContextOp(ctx, vcq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{verdictconflict.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vcq *VerdictConflictQuery) FirstIDX(ctx context.Context) types.VerdictConflictID {
+	id, err := vcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VerdictConflict entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VerdictConflict entity is found.
+// Returns a *NotFoundError when no VerdictConflict entities are found.
+func (vcq *VerdictConflictQuery) Only(ctx context.Context) (*VerdictConflict, error) {
+	nodes, err := vcq.Limit(2).All(setContextOp(ctx, vcq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{verdictconflict.Label}
+	default:
+		return nil, &NotSingularError{verdictconflict.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vcq *VerdictConflictQuery) OnlyX(ctx context.Context) *VerdictConflict {
+	node, err := vcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VerdictConflict ID in the query.
+// Returns a *NotSingularError when more than one VerdictConflict ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vcq *VerdictConflictQuery) OnlyID(ctx context.Context) (id types.VerdictConflictID, err error) {
+	var ids []types.VerdictConflictID
+	if ids, err = vcq.Limit(2).IDs(setContextOp(ctx, vcq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{verdictconflict.Label}
+	default:
+		err = &NotSingularError{verdictconflict.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vcq *VerdictConflictQuery) OnlyIDX(ctx context.Context) types.VerdictConflictID {
+	id, err := vcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VerdictConflicts.
+func (vcq *VerdictConflictQuery) All(ctx context.Context) ([]*VerdictConflict, error) {
+	ctx = setContextOp(ctx, vcq.ctx, "All")
+	if err := vcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VerdictConflict, *VerdictConflictQuery]()
+	return withInterceptors[[]*VerdictConflict](ctx, vcq, qr, vcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vcq *VerdictConflictQuery) AllX(ctx context.Context) []*VerdictConflict {
+	nodes, err := vcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VerdictConflict IDs.
+func (vcq *VerdictConflictQuery) IDs(ctx context.Context) (ids []types.VerdictConflictID, err error) {
+	if vcq.ctx.Unique == nil && vcq.path != nil {
+		vcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vcq.ctx, "IDs")
+	if err = vcq.Select(verdictconflict.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vcq *VerdictConflictQuery) IDsX(ctx context.Context) []types.VerdictConflictID {
+	ids, err := vcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vcq *VerdictConflictQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vcq.ctx, "Count")
+	if err := vcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vcq, querierCount[*VerdictConflictQuery](), vcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vcq *VerdictConflictQuery) CountX(ctx context.Context) int {
+	count, err := vcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vcq *VerdictConflictQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vcq.ctx, "Exist")
+	switch _, err := vcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("store: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vcq *VerdictConflictQuery) ExistX(ctx context.Context) bool {
+	exist, err := vcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VerdictConflictQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vcq *VerdictConflictQuery) Clone() *VerdictConflictQuery {
+	if vcq == nil {
+		return nil
+	}
+	return &VerdictConflictQuery{
+		config:      vcq.config,
+		ctx:         vcq.ctx.Clone(),
+		order:       append([]verdictconflict.OrderOption{}, vcq.order...),
+		inters:      append([]Interceptor{}, vcq.inters...),
+		predicates:  append([]predicate.VerdictConflict{}, vcq.predicates...),
+		withMessage: vcq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:  vcq.sql.Clone(),
+		path: vcq.path,
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (vcq *VerdictConflictQuery) WithMessage(opts ...func(*MessageQuery)) *VerdictConflictQuery {
+	query := (&MessageClient{config: vcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vcq.withMessage = query
+	return vcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID types.MessageID `json:"message_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VerdictConflict.Query().
+//		GroupBy(verdictconflict.FieldMessageID).
+//		Aggregate(store.Count()).
+//		Scan(ctx, &v)
+func (vcq *VerdictConflictQuery) GroupBy(field string, fields ...string) *VerdictConflictGroupBy {
+	vcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VerdictConflictGroupBy{build: vcq}
+	grbuild.flds = &vcq.ctx.Fields
+	grbuild.label = verdictconflict.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID types.MessageID `json:"message_id,omitempty"`
+//	}
+//
+//	client.VerdictConflict.Query().
+//		Select(verdictconflict.FieldMessageID).
+//		Scan(ctx, &v)
+func (vcq *VerdictConflictQuery) Select(fields ...string) *VerdictConflictSelect {
+	vcq.ctx.Fields = append(vcq.ctx.Fields, fields...)
+	sbuild := &VerdictConflictSelect{VerdictConflictQuery: vcq}
+	sbuild.label = verdictconflict.Label
+	sbuild.flds, sbuild.scan = &vcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VerdictConflictSelect configured with the given aggregations.
+func (vcq *VerdictConflictQuery) Aggregate(fns ...AggregateFunc) *VerdictConflictSelect {
+	return vcq.Select().Aggregate(fns...)
+}
+
+func (vcq *VerdictConflictQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vcq.inters {
+		if inter == nil {
+			return fmt.Errorf("store: uninitialized interceptor (forgotten import store/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vcq.ctx.Fields {
+		if !verdictconflict.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+		}
+	}
+	if vcq.path != nil {
+		prev, err := vcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vcq.sql = prev
+	}
+	return nil
+}
+
+func (vcq *VerdictConflictQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VerdictConflict, error) {
+	var (
+		nodes       = []*VerdictConflict{}
+		_spec       = vcq.querySpec()
+		loadedTypes = [1]bool{
+			vcq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VerdictConflict).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VerdictConflict{config: vcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(vcq.modifiers) > 0 {
+		_spec.Modifiers = vcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := vcq.withMessage; query != nil {
+		if err := vcq.loadMessage(ctx, query, nodes, nil,
+			func(n *VerdictConflict, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (vcq *VerdictConflictQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*VerdictConflict, init func(*VerdictConflict), assign func(*VerdictConflict, *Message)) error {
+	ids := make([]types.MessageID, 0, len(nodes))
+	nodeids := make(map[types.MessageID][]*VerdictConflict)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (vcq *VerdictConflictQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vcq.querySpec()
+	if len(vcq.modifiers) > 0 {
+		_spec.Modifiers = vcq.modifiers
+	}
+	_spec.Node.Columns = vcq.ctx.Fields
+	if len(vcq.ctx.Fields) > 0 {
+		_spec.Unique = vcq.ctx.Unique != nil && *vcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vcq.driver, _spec)
+}
+
+func (vcq *VerdictConflictQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(verdictconflict.Table, verdictconflict.Columns, sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID))
+	_spec.From = vcq.sql
+	if unique := vcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, verdictconflict.FieldID)
+		for i := range fields {
+			if fields[i] != verdictconflict.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if vcq.withMessage != nil {
+			_spec.Node.AddColumnOnce(verdictconflict.FieldMessageID)
+		}
+	}
+	if ps := vcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vcq *VerdictConflictQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vcq.driver.Dialect())
+	t1 := builder.Table(verdictconflict.Table)
+	columns := vcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = verdictconflict.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vcq.sql != nil {
+		selector = vcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vcq.ctx.Unique != nil && *vcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range vcq.modifiers {
+		m(selector)
+	}
+	for _, p := range vcq.predicates {
+		p(selector)
+	}
+	for _, p := range vcq.order {
+		p(selector)
+	}
+	if offset := vcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (vcq *VerdictConflictQuery) ForUpdate(opts ...sql.LockOption) *VerdictConflictQuery {
+	if vcq.driver.Dialect() == dialect.Postgres {
+		vcq.Unique(false)
+	}
+	vcq.modifiers = append(vcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return vcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (vcq *VerdictConflictQuery) ForShare(opts ...sql.LockOption) *VerdictConflictQuery {
+	if vcq.driver.Dialect() == dialect.Postgres {
+		vcq.Unique(false)
+	}
+	vcq.modifiers = append(vcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return vcq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vcq *VerdictConflictQuery) Modify(modifiers ...func(s *sql.Selector)) *VerdictConflictSelect {
+	vcq.modifiers = append(vcq.modifiers, modifiers...)
+	return vcq.Select()
+}
+
+// VerdictConflictGroupBy is the group-by builder for VerdictConflict entities.
+type VerdictConflictGroupBy struct {
+	selector
+	build *VerdictConflictQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vcgb *VerdictConflictGroupBy) Aggregate(fns ...AggregateFunc) *VerdictConflictGroupBy {
+	vcgb.fns = append(vcgb.fns, fns...)
+	return vcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vcgb *VerdictConflictGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vcgb.build.ctx, "GroupBy")
+	if err := vcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VerdictConflictQuery, *VerdictConflictGroupBy](ctx, vcgb.build, vcgb, vcgb.build.inters, v)
+}
+
+func (vcgb *VerdictConflictGroupBy) sqlScan(ctx context.Context, root *VerdictConflictQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vcgb.fns))
+	for _, fn := range vcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vcgb.flds)+len(vcgb.fns))
+		for _, f := range *vcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VerdictConflictSelect is the builder for selecting fields of VerdictConflict entities.
+type VerdictConflictSelect struct {
+	*VerdictConflictQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vcs *VerdictConflictSelect) Aggregate(fns ...AggregateFunc) *VerdictConflictSelect {
+	vcs.fns = append(vcs.fns, fns...)
+	return vcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vcs *VerdictConflictSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vcs.ctx, "Select")
+	if err := vcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VerdictConflictQuery, *VerdictConflictSelect](ctx, vcs.VerdictConflictQuery, vcs, vcs.inters, v)
+}
+
+func (vcs *VerdictConflictSelect) sqlScan(ctx context.Context, root *VerdictConflictQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vcs.fns))
+	for _, fn := range vcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vcs *VerdictConflictSelect) Modify(modifiers ...func(s *sql.Selector)) *VerdictConflictSelect {
+	vcs.modifiers = append(vcs.modifiers, modifiers...)
+	return vcs
+}
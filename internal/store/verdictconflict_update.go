@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zestagio/chat-service/internal/store/predicate"
+	"github.com/zestagio/chat-service/internal/store/verdictconflict"
+)
+
+// VerdictConflictUpdate is the builder for updating VerdictConflict entities.
+type VerdictConflictUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *VerdictConflictMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the VerdictConflictUpdate builder.
+func (vcu *VerdictConflictUpdate) Where(ps ...predicate.VerdictConflict) *VerdictConflictUpdate {
+	vcu.mutation.Where(ps...)
+	return vcu
+}
+
+// Mutation returns the VerdictConflictMutation object of the builder.
+func (vcu *VerdictConflictUpdate) Mutation() *VerdictConflictMutation {
+	return vcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vcu *VerdictConflictUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, vcu.sqlSave, vcu.mutation, vcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcu *VerdictConflictUpdate) SaveX(ctx context.Context) int {
+	affected, err := vcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vcu *VerdictConflictUpdate) Exec(ctx context.Context) error {
+	_, err := vcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcu *VerdictConflictUpdate) ExecX(ctx context.Context) {
+	if err := vcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcu *VerdictConflictUpdate) check() error {
+	if _, ok := vcu.mutation.MessageID(); vcu.mutation.MessageCleared() && !ok {
+		return errors.New(`store: clearing a required unique edge "VerdictConflict.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (vcu *VerdictConflictUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VerdictConflictUpdate {
+	vcu.modifiers = append(vcu.modifiers, modifiers...)
+	return vcu
+}
+
+func (vcu *VerdictConflictUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(verdictconflict.Table, verdictconflict.Columns, sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID))
+	if ps := vcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(vcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, vcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verdictconflict.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vcu.mutation.done = true
+	return n, nil
+}
+
+// VerdictConflictUpdateOne is the builder for updating a single VerdictConflict entity.
+type VerdictConflictUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *VerdictConflictMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the VerdictConflictMutation object of the builder.
+func (vcuo *VerdictConflictUpdateOne) Mutation() *VerdictConflictMutation {
+	return vcuo.mutation
+}
+
+// Where appends a list predicates to the VerdictConflictUpdate builder.
+func (vcuo *VerdictConflictUpdateOne) Where(ps ...predicate.VerdictConflict) *VerdictConflictUpdateOne {
+	vcuo.mutation.Where(ps...)
+	return vcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vcuo *VerdictConflictUpdateOne) Select(field string, fields ...string) *VerdictConflictUpdateOne {
+	vcuo.fields = append([]string{field}, fields...)
+	return vcuo
+}
+
+// Save executes the query and returns the updated VerdictConflict entity.
+func (vcuo *VerdictConflictUpdateOne) Save(ctx context.Context) (*VerdictConflict, error) {
+	return withHooks(ctx, vcuo.sqlSave, vcuo.mutation, vcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcuo *VerdictConflictUpdateOne) SaveX(ctx context.Context) *VerdictConflict {
+	node, err := vcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vcuo *VerdictConflictUpdateOne) Exec(ctx context.Context) error {
+	_, err := vcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcuo *VerdictConflictUpdateOne) ExecX(ctx context.Context) {
+	if err := vcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcuo *VerdictConflictUpdateOne) check() error {
+	if _, ok := vcuo.mutation.MessageID(); vcuo.mutation.MessageCleared() && !ok {
+		return errors.New(`store: clearing a required unique edge "VerdictConflict.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (vcuo *VerdictConflictUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VerdictConflictUpdateOne {
+	vcuo.modifiers = append(vcuo.modifiers, modifiers...)
+	return vcuo
+}
+
+func (vcuo *VerdictConflictUpdateOne) sqlSave(ctx context.Context) (_node *VerdictConflict, err error) {
+	if err := vcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(verdictconflict.Table, verdictconflict.Columns, sqlgraph.NewFieldSpec(verdictconflict.FieldID, field.TypeUUID))
+	id, ok := vcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "VerdictConflict.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, verdictconflict.FieldID)
+		for _, f := range fields {
+			if !verdictconflict.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != verdictconflict.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(vcuo.modifiers...)
+	_node = &VerdictConflict{config: vcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verdictconflict.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vcuo.mutation.done = true
+	return _node, nil
+}
@@ -42,7 +42,7 @@ func PrepareDB(ctx context.Context, t *testing.T, dbName string) (st *store.Clie
 	migrationLock.Lock()
 	{
 		// NOTE: Schema migration is not thread-safe :(
-		err = client.Schema.Create(ctx)
+		err = client.Schema.Create(ctx, store.WithMessagesSearch())
 	}
 	migrationLock.Unlock()
 	require.NoError(t, err)
@@ -0,0 +1,109 @@
+// Package tracing implements the minimal W3C Trace Context (https://www.w3.org/TR/trace-context/)
+// to correlate the chat messages with the processing in the external services (e.g. AFC).
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+const (
+	version     = "00"
+	flagSampled = 0x01
+)
+
+var ErrInvalidTraceParent = errors.New("invalid traceparent")
+
+// TraceParent is the value of the W3C traceparent header.
+type TraceParent struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Flags   byte
+}
+
+// FromRequestID starts the new span of the trace identified by the request ID.
+// So the trace of the message sent by the user is found by the initial X-Request-ID.
+func FromRequestID(reqID types.RequestID) TraceParent {
+	tp := TraceParent{TraceID: reqID, Flags: flagSampled}
+	tp.SpanID = newSpanID()
+	return tp
+}
+
+// Parse parses the traceparent header value.
+func Parse(s string) (TraceParent, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 4 || parts[0] != version {
+		return TraceParent{}, fmt.Errorf("%w: %q", ErrInvalidTraceParent, s)
+	}
+
+	var tp TraceParent
+	if err := decodeHex(tp.TraceID[:], parts[1]); err != nil {
+		return TraceParent{}, fmt.Errorf("%w: trace id: %v", ErrInvalidTraceParent, err)
+	}
+	if err := decodeHex(tp.SpanID[:], parts[2]); err != nil {
+		return TraceParent{}, fmt.Errorf("%w: span id: %v", ErrInvalidTraceParent, err)
+	}
+
+	var flags [1]byte
+	if err := decodeHex(flags[:], parts[3]); err != nil {
+		return TraceParent{}, fmt.Errorf("%w: flags: %v", ErrInvalidTraceParent, err)
+	}
+	tp.Flags = flags[0]
+
+	if tp.TraceID == [16]byte{} || tp.SpanID == [8]byte{} {
+		return TraceParent{}, fmt.Errorf("%w: zero id", ErrInvalidTraceParent)
+	}
+	return tp, nil
+}
+
+// Child returns the new span of the same trace.
+func (tp TraceParent) Child() TraceParent {
+	tp.SpanID = newSpanID()
+	return tp
+}
+
+func (tp TraceParent) TraceIDString() string {
+	return hex.EncodeToString(tp.TraceID[:])
+}
+
+func (tp TraceParent) SpanIDString() string {
+	return hex.EncodeToString(tp.SpanID[:])
+}
+
+func (tp TraceParent) String() string {
+	return fmt.Sprintf("%s-%s-%s-%02x", version, tp.TraceIDString(), tp.SpanIDString(), tp.Flags)
+}
+
+func (tp TraceParent) IsZero() bool {
+	return tp.TraceID == [16]byte{}
+}
+
+type ctxKey struct{}
+
+func WithTraceParent(ctx context.Context, tp TraceParent) context.Context {
+	return context.WithValue(ctx, ctxKey{}, tp)
+}
+
+func FromContext(ctx context.Context) (TraceParent, bool) {
+	tp, ok := ctx.Value(ctxKey{}).(TraceParent)
+	return tp, ok
+}
+
+func decodeHex(dst []byte, s string) error {
+	if len(s) != hex.EncodedLen(len(dst)) || strings.ToLower(s) != s {
+		return fmt.Errorf("want %d lowercase hex chars", hex.EncodedLen(len(dst)))
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err
+}
+
+func newSpanID() (id [8]byte) {
+	_, _ = rand.Read(id[:])
+	return id
+}
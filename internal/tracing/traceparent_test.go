@@ -0,0 +1,62 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zestagio/chat-service/internal/tracing"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+func TestParse(t *testing.T) {
+	const valid = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	tp, err := tracing.Parse(valid)
+	require.NoError(t, err)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", tp.TraceIDString())
+	assert.Equal(t, "00f067aa0ba902b7", tp.SpanIDString())
+	assert.Equal(t, valid, tp.String())
+
+	for _, invalid := range []string{
+		"",
+		"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-zz",
+	} {
+		_, err := tracing.Parse(invalid)
+		require.ErrorIs(t, err, tracing.ErrInvalidTraceParent, invalid)
+	}
+}
+
+func TestFromRequestID(t *testing.T) {
+	reqID := types.MustParse[types.RequestID]("4bf92f35-77b3-4da6-a3ce-929d0e0e4736")
+
+	tp := tracing.FromRequestID(reqID)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", tp.TraceIDString())
+	assert.NotEqual(t, [8]byte{}, tp.SpanID)
+
+	parsed, err := tracing.Parse(tp.String())
+	require.NoError(t, err)
+	assert.Equal(t, tp, parsed)
+
+	child := tp.Child()
+	assert.Equal(t, tp.TraceID, child.TraceID)
+	assert.NotEqual(t, tp.SpanID, child.SpanID)
+}
+
+func TestContext(t *testing.T) {
+	_, ok := tracing.FromContext(context.Background())
+	assert.False(t, ok)
+
+	tp := tracing.FromRequestID(types.NewRequestID())
+	got, ok := tracing.FromContext(tracing.WithTraceParent(context.Background(), tp))
+	require.True(t, ok)
+	assert.Equal(t, tp, got)
+}
@@ -8,6 +8,114 @@ import (
 	"github.com/google/uuid"
 )
 
+type AttachmentID uuid.UUID
+
+var AttachmentIDNil AttachmentID
+
+func NewAttachmentID() AttachmentID {
+	return AttachmentID(uuid.New())
+}
+
+func (id AttachmentID) String() string {
+	return (uuid.UUID)(id).String()
+}
+
+func (id *AttachmentID) Scan(src interface{}) error {
+	return (*uuid.UUID)(id).Scan(src)
+}
+
+func (id AttachmentID) Value() (driver.Value, error) {
+	return (uuid.UUID)(id).Value()
+}
+
+func (id AttachmentID) MarshalText() ([]byte, error) {
+	return (uuid.UUID)(id).MarshalText()
+}
+
+func (id *AttachmentID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+func (id AttachmentID) IsZero() bool {
+	return id.String() == uuid.Nil.String()
+}
+
+func (id AttachmentID) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case AttachmentID:
+		return id.String() == x.String()
+	}
+	return false
+}
+
+func (id AttachmentID) Validate() error {
+	if id.IsZero() {
+		return errors.New("zero AttachmentID")
+	}
+	return nil
+}
+
+func (id AttachmentID) AsPointer() *AttachmentID {
+	if id.IsZero() {
+		return nil
+	}
+	return &id
+}
+
+type CannedResponseID uuid.UUID
+
+var CannedResponseIDNil CannedResponseID
+
+func NewCannedResponseID() CannedResponseID {
+	return CannedResponseID(uuid.New())
+}
+
+func (id CannedResponseID) String() string {
+	return (uuid.UUID)(id).String()
+}
+
+func (id *CannedResponseID) Scan(src interface{}) error {
+	return (*uuid.UUID)(id).Scan(src)
+}
+
+func (id CannedResponseID) Value() (driver.Value, error) {
+	return (uuid.UUID)(id).Value()
+}
+
+func (id CannedResponseID) MarshalText() ([]byte, error) {
+	return (uuid.UUID)(id).MarshalText()
+}
+
+func (id *CannedResponseID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+func (id CannedResponseID) IsZero() bool {
+	return id.String() == uuid.Nil.String()
+}
+
+func (id CannedResponseID) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case CannedResponseID:
+		return id.String() == x.String()
+	}
+	return false
+}
+
+func (id CannedResponseID) Validate() error {
+	if id.IsZero() {
+		return errors.New("zero CannedResponseID")
+	}
+	return nil
+}
+
+func (id CannedResponseID) AsPointer() *CannedResponseID {
+	if id.IsZero() {
+		return nil
+	}
+	return &id
+}
+
 type EventID uuid.UUID
 
 var EventIDNil EventID
@@ -278,6 +386,222 @@ func (id MessageID) AsPointer() *MessageID {
 	return &id
 }
 
+type MessageDeletionID uuid.UUID
+
+var MessageDeletionIDNil MessageDeletionID
+
+func NewMessageDeletionID() MessageDeletionID {
+	return MessageDeletionID(uuid.New())
+}
+
+func (id MessageDeletionID) String() string {
+	return (uuid.UUID)(id).String()
+}
+
+func (id *MessageDeletionID) Scan(src interface{}) error {
+	return (*uuid.UUID)(id).Scan(src)
+}
+
+func (id MessageDeletionID) Value() (driver.Value, error) {
+	return (uuid.UUID)(id).Value()
+}
+
+func (id MessageDeletionID) MarshalText() ([]byte, error) {
+	return (uuid.UUID)(id).MarshalText()
+}
+
+func (id *MessageDeletionID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+func (id MessageDeletionID) IsZero() bool {
+	return id.String() == uuid.Nil.String()
+}
+
+func (id MessageDeletionID) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case MessageDeletionID:
+		return id.String() == x.String()
+	}
+	return false
+}
+
+func (id MessageDeletionID) Validate() error {
+	if id.IsZero() {
+		return errors.New("zero MessageDeletionID")
+	}
+	return nil
+}
+
+func (id MessageDeletionID) AsPointer() *MessageDeletionID {
+	if id.IsZero() {
+		return nil
+	}
+	return &id
+}
+
+type MessageReactionID uuid.UUID
+
+var MessageReactionIDNil MessageReactionID
+
+func NewMessageReactionID() MessageReactionID {
+	return MessageReactionID(uuid.New())
+}
+
+func (id MessageReactionID) String() string {
+	return (uuid.UUID)(id).String()
+}
+
+func (id *MessageReactionID) Scan(src interface{}) error {
+	return (*uuid.UUID)(id).Scan(src)
+}
+
+func (id MessageReactionID) Value() (driver.Value, error) {
+	return (uuid.UUID)(id).Value()
+}
+
+func (id MessageReactionID) MarshalText() ([]byte, error) {
+	return (uuid.UUID)(id).MarshalText()
+}
+
+func (id *MessageReactionID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+func (id MessageReactionID) IsZero() bool {
+	return id.String() == uuid.Nil.String()
+}
+
+func (id MessageReactionID) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case MessageReactionID:
+		return id.String() == x.String()
+	}
+	return false
+}
+
+func (id MessageReactionID) Validate() error {
+	if id.IsZero() {
+		return errors.New("zero MessageReactionID")
+	}
+	return nil
+}
+
+func (id MessageReactionID) AsPointer() *MessageReactionID {
+	if id.IsZero() {
+		return nil
+	}
+	return &id
+}
+
+type MessageRevisionID uuid.UUID
+
+var MessageRevisionIDNil MessageRevisionID
+
+func NewMessageRevisionID() MessageRevisionID {
+	return MessageRevisionID(uuid.New())
+}
+
+func (id MessageRevisionID) String() string {
+	return (uuid.UUID)(id).String()
+}
+
+func (id *MessageRevisionID) Scan(src interface{}) error {
+	return (*uuid.UUID)(id).Scan(src)
+}
+
+func (id MessageRevisionID) Value() (driver.Value, error) {
+	return (uuid.UUID)(id).Value()
+}
+
+func (id MessageRevisionID) MarshalText() ([]byte, error) {
+	return (uuid.UUID)(id).MarshalText()
+}
+
+func (id *MessageRevisionID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+func (id MessageRevisionID) IsZero() bool {
+	return id.String() == uuid.Nil.String()
+}
+
+func (id MessageRevisionID) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case MessageRevisionID:
+		return id.String() == x.String()
+	}
+	return false
+}
+
+func (id MessageRevisionID) Validate() error {
+	if id.IsZero() {
+		return errors.New("zero MessageRevisionID")
+	}
+	return nil
+}
+
+func (id MessageRevisionID) AsPointer() *MessageRevisionID {
+	if id.IsZero() {
+		return nil
+	}
+	return &id
+}
+
+type ModerationCaseID uuid.UUID
+
+var ModerationCaseIDNil ModerationCaseID
+
+func NewModerationCaseID() ModerationCaseID {
+	return ModerationCaseID(uuid.New())
+}
+
+func (id ModerationCaseID) String() string {
+	return (uuid.UUID)(id).String()
+}
+
+func (id *ModerationCaseID) Scan(src interface{}) error {
+	return (*uuid.UUID)(id).Scan(src)
+}
+
+func (id ModerationCaseID) Value() (driver.Value, error) {
+	return (uuid.UUID)(id).Value()
+}
+
+func (id ModerationCaseID) MarshalText() ([]byte, error) {
+	return (uuid.UUID)(id).MarshalText()
+}
+
+func (id *ModerationCaseID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+func (id ModerationCaseID) IsZero() bool {
+	return id.String() == uuid.Nil.String()
+}
+
+func (id ModerationCaseID) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case ModerationCaseID:
+		return id.String() == x.String()
+	}
+	return false
+}
+
+func (id ModerationCaseID) Validate() error {
+	if id.IsZero() {
+		return errors.New("zero ModerationCaseID")
+	}
+	return nil
+}
+
+func (id ModerationCaseID) AsPointer() *ModerationCaseID {
+	if id.IsZero() {
+		return nil
+	}
+	return &id
+}
+
 type ProblemID uuid.UUID
 
 var ProblemIDNil ProblemID
@@ -440,8 +764,62 @@ func (id UserID) AsPointer() *UserID {
 	return &id
 }
 
+type VerdictConflictID uuid.UUID
+
+var VerdictConflictIDNil VerdictConflictID
+
+func NewVerdictConflictID() VerdictConflictID {
+	return VerdictConflictID(uuid.New())
+}
+
+func (id VerdictConflictID) String() string {
+	return (uuid.UUID)(id).String()
+}
+
+func (id *VerdictConflictID) Scan(src interface{}) error {
+	return (*uuid.UUID)(id).Scan(src)
+}
+
+func (id VerdictConflictID) Value() (driver.Value, error) {
+	return (uuid.UUID)(id).Value()
+}
+
+func (id VerdictConflictID) MarshalText() ([]byte, error) {
+	return (uuid.UUID)(id).MarshalText()
+}
+
+func (id *VerdictConflictID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+func (id VerdictConflictID) IsZero() bool {
+	return id.String() == uuid.Nil.String()
+}
+
+func (id VerdictConflictID) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case VerdictConflictID:
+		return id.String() == x.String()
+	}
+	return false
+}
+
+func (id VerdictConflictID) Validate() error {
+	if id.IsZero() {
+		return errors.New("zero VerdictConflictID")
+	}
+	return nil
+}
+
+func (id VerdictConflictID) AsPointer() *VerdictConflictID {
+	if id.IsZero() {
+		return nil
+	}
+	return &id
+}
+
 type TypeSet = interface {
-	EventID | ChatID | FailedJobID | JobID | MessageID | ProblemID | RequestID | UserID
+	AttachmentID | CannedResponseID | EventID | ChatID | FailedJobID | JobID | MessageID | MessageDeletionID | MessageReactionID | MessageRevisionID | ModerationCaseID | ProblemID | RequestID | UserID | VerdictConflictID
 }
 
 func Parse[T TypeSet](s string) (T, error) {
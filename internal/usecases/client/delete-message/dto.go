@@ -0,0 +1,23 @@
+package deletemessage
+
+import (
+	"time"
+
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID        types.RequestID `validate:"required"`
+	ClientID  types.UserID    `validate:"required"`
+	MessageID types.MessageID `validate:"required"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	MessageID types.MessageID
+	DeletedAt time.Time
+}
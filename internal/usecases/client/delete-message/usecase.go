@@ -0,0 +1,114 @@
+package deletemessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	messagedeletedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-deleted"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=deletemessagemocks
+
+var (
+	ErrInvalidRequest      = errors.New("invalid request")
+	ErrMessageNotFound     = errors.New("message not found")
+	ErrDeleteWindowExpired = errors.New("delete window expired")
+)
+
+type messagesRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	GetDeletionByRequestID(ctx context.Context, reqID types.RequestID) (*messagesrepo.Deletion, error)
+	DeleteMessage(
+		ctx context.Context,
+		reqID types.RequestID,
+		msgID types.MessageID,
+		deletedBy types.UserID,
+		role messagesrepo.DeleterRole,
+	) (*messagesrepo.Deletion, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
+	outBox       outboxService      `option:"mandatory" validate:"required"`
+	txtor        transactor         `option:"mandatory" validate:"required"`
+	deleteWindow time.Duration      `option:"mandatory" validate:"min=1s"`
+}
+
+// UseCase soft-deletes the client's own message within the delete window.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	var deletion *messagesrepo.Deletion
+
+	if err := u.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		d, err := u.msgRepo.GetDeletionByRequestID(ctx, req.ID)
+		if nil == err {
+			deletion = d
+			return nil
+		}
+		if !errors.Is(err, messagesrepo.ErrDeletionNotFound) {
+			return fmt.Errorf("get deletion by request id: %v", err)
+		}
+
+		m, err := u.msgRepo.GetMessageByID(ctx, req.MessageID)
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgNotFound) {
+				return fmt.Errorf("%w: %v", ErrMessageNotFound, err)
+			}
+			return fmt.Errorf("get message: %v", err)
+		}
+		if m.AuthorID != req.ClientID || m.IsService {
+			return fmt.Errorf("%w: client is not the author", ErrMessageNotFound)
+		}
+		if time.Since(m.CreatedAt) > u.deleteWindow {
+			return ErrDeleteWindowExpired
+		}
+
+		d, err = u.msgRepo.DeleteMessage(ctx, req.ID, m.ID, req.ClientID, messagesrepo.DeleterRoleClient)
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgAlreadyDeleted) {
+				return fmt.Errorf("%w: %v", ErrMessageNotFound, err)
+			}
+			return fmt.Errorf("delete message: %v", err)
+		}
+
+		_, err = u.outBox.Put(ctx, messagedeletedjob.Name, simpleid.MustMarshal(d.ID), time.Now())
+		if err != nil {
+			return fmt.Errorf("create `message deleted` job: %v", err)
+		}
+
+		deletion = d
+		return nil
+	}); err != nil {
+		return Response{}, fmt.Errorf("`delete client message` tx: %w", err)
+	}
+
+	return Response{
+		MessageID: deletion.MessageID,
+		DeletedAt: deletion.CreatedAt,
+	}, nil
+}
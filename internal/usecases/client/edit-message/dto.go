@@ -0,0 +1,24 @@
+package editmessage
+
+import (
+	"time"
+
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID          types.RequestID `validate:"required"`
+	ClientID    types.UserID    `validate:"required"`
+	MessageID   types.MessageID `validate:"required"`
+	MessageBody string          `validate:"required,max=3000"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	MessageID types.MessageID
+	EditedAt  time.Time
+}
@@ -0,0 +1,94 @@
+package editmessage_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/client/edit-message"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request editmessage.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: editmessage.Request{
+				ID:          types.NewRequestID(),
+				ClientID:    types.NewUserID(),
+				MessageID:   types.NewMessageID(),
+				MessageBody: "Hello, guys!",
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: editmessage.Request{
+				ID:          types.RequestIDNil,
+				ClientID:    types.NewUserID(),
+				MessageID:   types.NewMessageID(),
+				MessageBody: "Hello, guys!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require client id",
+			request: editmessage.Request{
+				ID:          types.NewRequestID(),
+				ClientID:    types.UserIDNil,
+				MessageID:   types.NewMessageID(),
+				MessageBody: "Hello, guys!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require message id",
+			request: editmessage.Request{
+				ID:          types.NewRequestID(),
+				ClientID:    types.NewUserID(),
+				MessageID:   types.MessageIDNil,
+				MessageBody: "Hello, guys!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require message body",
+			request: editmessage.Request{
+				ID:          types.NewRequestID(),
+				ClientID:    types.NewUserID(),
+				MessageID:   types.NewMessageID(),
+				MessageBody: "",
+			},
+			wantErr: true,
+		},
+		{
+			name: "too big message body",
+			request: editmessage.Request{
+				ID:          types.NewRequestID(),
+				ClientID:    types.NewUserID(),
+				MessageID:   types.NewMessageID(),
+				MessageBody: strings.Repeat("x", 3001),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
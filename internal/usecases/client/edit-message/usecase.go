@@ -0,0 +1,135 @@
+package editmessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	messageeditedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-edited"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	verdicttimeoutjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/verdict-timeout"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=editmessagemocks
+
+var (
+	ErrInvalidRequest     = errors.New("invalid request")
+	ErrMessageNotFound    = errors.New("message not found")
+	ErrMessageNotEditable = errors.New("message is not editable")
+	ErrEditWindowExpired  = errors.New("edit window expired")
+)
+
+type messagesRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	GetRevisionByEditRequestID(ctx context.Context, reqID types.RequestID) (*messagesrepo.Revision, error)
+	EditMessage(
+		ctx context.Context,
+		reqID types.RequestID,
+		msgID types.MessageID,
+		msgBody string,
+		recheck bool,
+	) (*messagesrepo.Revision, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo    messagesRepository `option:"mandatory" validate:"required"`
+	outBox     outboxService      `option:"mandatory" validate:"required"`
+	txtor      transactor         `option:"mandatory" validate:"required"`
+	editWindow time.Duration      `option:"mandatory" validate:"min=1s"`
+
+	// verdictTimeoutEnabled enables the verdict timeout job scheduling for the edited message.
+	verdictTimeoutEnabled bool
+	// verdictTimeout is the time to wait for the AFC verdict.
+	verdictTimeout time.Duration `validate:"min=0"`
+}
+
+// UseCase edits the client message that has been delivered to manager.
+// The edited message is hidden from manager until it passes AFC again.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	var rev *messagesrepo.Revision
+
+	if err := u.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		r, err := u.msgRepo.GetRevisionByEditRequestID(ctx, req.ID)
+		if nil == err {
+			rev = r
+			return nil
+		}
+		if !errors.Is(err, messagesrepo.ErrRevisionNotFound) {
+			return fmt.Errorf("get revision by edit request id: %v", err)
+		}
+
+		m, err := u.msgRepo.GetMessageByID(ctx, req.MessageID)
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgNotFound) {
+				return fmt.Errorf("%w: %v", ErrMessageNotFound, err)
+			}
+			return fmt.Errorf("get message: %v", err)
+		}
+		if m.AuthorID != req.ClientID {
+			return fmt.Errorf("%w: client is not the author", ErrMessageNotFound)
+		}
+		if m.IsService || m.IsBlocked || !m.IsVisibleForManager {
+			return fmt.Errorf("%w: message was not delivered", ErrMessageNotEditable)
+		}
+		if !m.DeletedAt.IsZero() {
+			return fmt.Errorf("%w: message was deleted", ErrMessageNotEditable)
+		}
+		if time.Since(m.CreatedAt) > u.editWindow {
+			return ErrEditWindowExpired
+		}
+
+		r, err = u.msgRepo.EditMessage(ctx, req.ID, m.ID, req.MessageBody, true)
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgNotEditable) {
+				return fmt.Errorf("%w: %v", ErrMessageNotEditable, err)
+			}
+			return fmt.Errorf("edit message: %v", err)
+		}
+
+		_, err = u.outBox.Put(ctx, messageeditedjob.Name, simpleid.MustMarshal(r.ID), time.Now())
+		if err != nil {
+			return fmt.Errorf("create `message edited` job: %v", err)
+		}
+
+		if u.verdictTimeoutEnabled {
+			_, err = u.outBox.Put(ctx, verdicttimeoutjob.Name, simpleid.MustMarshal(m.ID), time.Now().Add(u.verdictTimeout))
+			if err != nil {
+				return fmt.Errorf("create `verdict timeout` job: %v", err)
+			}
+		}
+
+		rev = r
+		return nil
+	}); err != nil {
+		return Response{}, fmt.Errorf("`edit client message` tx: %w", err)
+	}
+
+	return Response{
+		MessageID: rev.MessageID,
+		EditedAt:  rev.CreatedAt,
+	}, nil
+}
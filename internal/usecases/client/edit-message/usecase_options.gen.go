@@ -0,0 +1,98 @@
+// Code generated by options-gen. DO NOT EDIT.
+package editmessage
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	msgRepo messagesRepository,
+	outBox outboxService,
+	txtor transactor,
+	editWindow time.Duration,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.msgRepo = msgRepo
+
+	o.outBox = outBox
+
+	o.txtor = txtor
+
+	o.editWindow = editWindow
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+// verdictTimeoutEnabled enables the verdict timeout job scheduling for the edited message.
+func WithVerdictTimeoutEnabled(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.verdictTimeoutEnabled = opt
+
+	}
+}
+
+// verdictTimeout is the time to wait for the AFC verdict.
+func WithVerdictTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.verdictTimeout = opt
+
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("outBox", _validate_Options_outBox(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("editWindow", _validate_Options_editWindow(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("verdictTimeout", _validate_Options_verdictTimeout(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_outBox(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.outBox, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `outBox` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_txtor(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.txtor, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `txtor` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_editWindow(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.editWindow, "min=1s"); err != nil {
+		return fmt461e464ebed9.Errorf("field `editWindow` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_verdictTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.verdictTimeout, "min=0"); err != nil {
+		return fmt461e464ebed9.Errorf("field `verdictTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,299 @@
+package editmessage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	messageeditedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-edited"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	verdicttimeoutjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/verdict-timeout"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/client/edit-message"
+	editmessagemocks "github.com/zestagio/chat-service/internal/usecases/client/edit-message/mocks"
+)
+
+const editWindow = 15 * time.Minute
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl      *gomock.Controller
+	msgRepo   *editmessagemocks.MockmessagesRepository
+	outBoxSvc *editmessagemocks.MockoutboxService
+	txtor     *editmessagemocks.Mocktransactor
+	uCase     editmessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = editmessagemocks.NewMockmessagesRepository(s.ctrl)
+	s.outBoxSvc = editmessagemocks.NewMockoutboxService(s.ctrl)
+	s.txtor = editmessagemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = editmessage.New(editmessage.NewOptions(s.msgRepo, s.outBoxSvc, s.txtor, editWindow))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := editmessage.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, editmessage.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestRevisionFound() {
+	// Arrange.
+	req := s.newRequest()
+	rev := messagesrepo.Revision{
+		ID:            types.NewMessageRevisionID(),
+		MessageID:     req.MessageID,
+		EditRequestID: req.ID,
+		CreatedAt:     time.Now(),
+	}
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetRevisionByEditRequestID(gomock.Any(), req.ID).Return(&rev, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.MessageID, resp.MessageID)
+	s.Equal(rev.CreatedAt, resp.EditedAt)
+}
+
+func (s *UseCaseSuite) TestMessageNotFound() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(nil, messagesrepo.ErrMsgNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, editmessage.ErrMessageNotFound)
+}
+
+func (s *UseCaseSuite) TestNotAuthor() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	msg.AuthorID = types.NewUserID()
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, editmessage.ErrMessageNotFound)
+}
+
+func (s *UseCaseSuite) TestMessageNotDelivered() {
+	for _, tt := range []struct {
+		name   string
+		modify func(m *messagesrepo.Message)
+	}{
+		{name: "not checked", modify: func(m *messagesrepo.Message) { m.IsVisibleForManager = false }},
+		{name: "blocked", modify: func(m *messagesrepo.Message) { m.IsVisibleForManager, m.IsBlocked = false, true }},
+		{name: "deleted", modify: func(m *messagesrepo.Message) { m.DeletedAt = time.Now() }},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			req := s.newRequest()
+			msg := s.newMessage(req)
+			tt.modify(&msg)
+
+			s.expectTx()
+			s.expectNoRevision(req.ID)
+			s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+
+			// Action.
+			_, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().ErrorIs(err, editmessage.ErrMessageNotEditable)
+		})
+	}
+}
+
+func (s *UseCaseSuite) TestEditWindowExpired() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	msg.CreatedAt = time.Now().Add(-editWindow - time.Second)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, editmessage.ErrEditWindowExpired)
+}
+
+func (s *UseCaseSuite) TestMessageModerated() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.msgRepo.EXPECT().EditMessage(gomock.Any(), req.ID, req.MessageID, req.MessageBody, true).
+		Return(nil, messagesrepo.ErrMsgNotEditable)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, editmessage.ErrMessageNotEditable)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	rev := s.newRevision(req)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.msgRepo.EXPECT().EditMessage(gomock.Any(), req.ID, req.MessageID, req.MessageBody, true).Return(&rev, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), messageeditedjob.Name, simpleid.MustMarshal(rev.ID), gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	rev := s.newRevision(req)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.msgRepo.EXPECT().EditMessage(gomock.Any(), req.ID, req.MessageID, req.MessageBody, true).Return(&rev, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), messageeditedjob.Name, simpleid.MustMarshal(rev.ID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.MessageID, resp.MessageID)
+	s.Equal(rev.CreatedAt, resp.EditedAt)
+}
+
+func (s *UseCaseSuite) TestSuccess_WithVerdictTimeout() {
+	// Arrange.
+	uCase, err := editmessage.New(editmessage.NewOptions(s.msgRepo, s.outBoxSvc, s.txtor, editWindow,
+		editmessage.WithVerdictTimeoutEnabled(true),
+		editmessage.WithVerdictTimeout(time.Minute),
+	))
+	s.Require().NoError(err)
+
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	rev := s.newRevision(req)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.msgRepo.EXPECT().EditMessage(gomock.Any(), req.ID, req.MessageID, req.MessageBody, true).Return(&rev, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), messageeditedjob.Name, simpleid.MustMarshal(rev.ID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), verdicttimeoutjob.Name, simpleid.MustMarshal(msg.ID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	_, err = uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) newRequest() editmessage.Request {
+	return editmessage.Request{
+		ID:          types.NewRequestID(),
+		ClientID:    types.NewUserID(),
+		MessageID:   types.NewMessageID(),
+		MessageBody: "Hello, world!",
+	}
+}
+
+func (s *UseCaseSuite) newMessage(req editmessage.Request) messagesrepo.Message {
+	return messagesrepo.Message{
+		ID:                  req.MessageID,
+		ChatID:              types.NewChatID(),
+		AuthorID:            req.ClientID,
+		Body:                "Hello!",
+		CreatedAt:           time.Now().Add(-time.Minute),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+		IsChecked:           true,
+		InitialRequestID:    types.NewRequestID(),
+	}
+}
+
+func (s *UseCaseSuite) newRevision(req editmessage.Request) messagesrepo.Revision {
+	return messagesrepo.Revision{
+		ID:            types.NewMessageRevisionID(),
+		MessageID:     req.MessageID,
+		Body:          "Hello!",
+		EditRequestID: req.ID,
+		CreatedAt:     time.Now(),
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
+
+func (s *UseCaseSuite) expectNoRevision(reqID types.RequestID) {
+	s.msgRepo.EXPECT().GetRevisionByEditRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrRevisionNotFound)
+}
@@ -13,6 +13,9 @@ type Request struct {
 	ClientID types.UserID    `validate:"required"`
 	PageSize int             `validate:"omitempty,gte=10,lte=100"`
 	Cursor   string          `validate:"omitempty,base64url"`
+
+	// AroundMessageID is the message to open the history at, it requires PageSize. Zero for the usual paging.
+	AroundMessageID types.MessageID
 }
 
 func (r Request) Validate() error {
@@ -22,12 +25,16 @@ func (r Request) Validate() error {
 	if r.Cursor != "" && r.PageSize != 0 {
 		return errors.New("either cursor or page size must be specified, not both")
 	}
+	if r.Cursor != "" && !r.AroundMessageID.IsZero() {
+		return errors.New("cursor cannot be used with around message id")
+	}
 	return validator.Validator.Struct(r)
 }
 
 type Response struct {
 	Messages   []Message
-	NextCursor string
+	NextCursor string // The cursor to the older messages. Empty if there are no ones.
+	PrevCursor string // The cursor to the newer messages. Empty if the page is the newest one.
 }
 
 type Message struct {
@@ -35,7 +42,34 @@ type Message struct {
 	AuthorID   types.UserID
 	Body       string
 	CreatedAt  time.Time
+	EditedAt   time.Time // Zero if the message has not been edited.
+	DeletedAt  time.Time // Zero if the message has not been deleted.
 	IsReceived bool
 	IsBlocked  bool
 	IsService  bool
+
+	Attachments []Attachment
+	Reactions   []Reaction
+	ReplyTo     *Quote // Nil if the message is not a reply.
+}
+
+type Attachment struct {
+	ID           types.AttachmentID
+	FileName     string
+	ContentType  string
+	Size         int64
+	URL          string
+	ThumbnailURL string // Empty if the attachment has no thumbnail.
+}
+
+type Reaction struct {
+	Kind        string
+	Count       int
+	ReactedByMe bool
+}
+
+type Quote struct {
+	MessageID types.MessageID
+	AuthorID  types.UserID // Zero if the quoted message is a service one or is hidden.
+	Preview   string       // Empty if the quoted message is deleted or is hidden.
 }
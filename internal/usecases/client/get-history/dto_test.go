@@ -36,8 +36,28 @@ func TestRequest_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "around message",
+			request: gethistory.Request{
+				ID:              types.NewRequestID(),
+				ClientID:        types.NewUserID(),
+				PageSize:        50,
+				AroundMessageID: types.NewMessageID(),
+			},
+			wantErr: false,
+		},
 
 		// Negative.
+		{
+			name: "around message with cursor",
+			request: gethistory.Request{
+				ID:              types.NewRequestID(),
+				ClientID:        types.NewUserID(),
+				Cursor:          "eyJwYWdlX3NpemUiOjUwLCJsYXN0IjoxNjcwNTAyNTAyfQ==", // {"page_size":50,"last":1670502502}
+				AroundMessageID: types.NewMessageID(),
+			},
+			wantErr: true,
+		},
 		{
 			name: "neither cursor nor pagesize specified",
 			request: gethistory.Request{
@@ -13,6 +13,96 @@ import (
 	types "github.com/zestagio/chat-service/internal/types"
 )
 
+// MockcursorCodec is a mock of cursorCodec interface.
+type MockcursorCodec struct {
+	ctrl     *gomock.Controller
+	recorder *MockcursorCodecMockRecorder
+}
+
+// MockcursorCodecMockRecorder is the mock recorder for MockcursorCodec.
+type MockcursorCodecMockRecorder struct {
+	mock *MockcursorCodec
+}
+
+// NewMockcursorCodec creates a new mock instance.
+func NewMockcursorCodec(ctrl *gomock.Controller) *MockcursorCodec {
+	mock := &MockcursorCodec{ctrl: ctrl}
+	mock.recorder = &MockcursorCodecMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcursorCodec) EXPECT() *MockcursorCodecMockRecorder {
+	return m.recorder
+}
+
+// Decode mocks base method.
+func (m *MockcursorCodec) Decode(in string, to any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decode", in, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decode indicates an expected call of Decode.
+func (mr *MockcursorCodecMockRecorder) Decode(in, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decode", reflect.TypeOf((*MockcursorCodec)(nil).Decode), in, to)
+}
+
+// Encode mocks base method.
+func (m *MockcursorCodec) Encode(data any) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encode", data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encode indicates an expected call of Encode.
+func (mr *MockcursorCodecMockRecorder) Encode(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encode", reflect.TypeOf((*MockcursorCodec)(nil).Encode), data)
+}
+
+// MockattachmentsService is a mock of attachmentsService interface.
+type MockattachmentsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockattachmentsServiceMockRecorder
+}
+
+// MockattachmentsServiceMockRecorder is the mock recorder for MockattachmentsService.
+type MockattachmentsServiceMockRecorder struct {
+	mock *MockattachmentsService
+}
+
+// NewMockattachmentsService creates a new mock instance.
+func NewMockattachmentsService(ctrl *gomock.Controller) *MockattachmentsService {
+	mock := &MockattachmentsService{ctrl: ctrl}
+	mock.recorder = &MockattachmentsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockattachmentsService) EXPECT() *MockattachmentsServiceMockRecorder {
+	return m.recorder
+}
+
+// URLs mocks base method.
+func (m *MockattachmentsService) URLs(a messagesrepo.Attachment, viewerID types.UserID) (string, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URLs", a, viewerID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// URLs indicates an expected call of URLs.
+func (mr *MockattachmentsServiceMockRecorder) URLs(a, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URLs", reflect.TypeOf((*MockattachmentsService)(nil).URLs), a, viewerID)
+}
+
 // MockmessagesRepository is a mock of messagesRepository interface.
 type MockmessagesRepository struct {
 	ctrl     *gomock.Controller
@@ -51,3 +141,20 @@ func (mr *MockmessagesRepositoryMockRecorder) GetClientChatMessages(ctx, clientI
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientChatMessages", reflect.TypeOf((*MockmessagesRepository)(nil).GetClientChatMessages), ctx, clientID, pageSize, cursor)
 }
+
+// GetClientChatMessagesAround mocks base method.
+func (m *MockmessagesRepository) GetClientChatMessagesAround(ctx context.Context, clientID types.UserID, msgID types.MessageID, pageSize int) ([]messagesrepo.Message, *messagesrepo.Cursor, *messagesrepo.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientChatMessagesAround", ctx, clientID, msgID, pageSize)
+	ret0, _ := ret[0].([]messagesrepo.Message)
+	ret1, _ := ret[1].(*messagesrepo.Cursor)
+	ret2, _ := ret[2].(*messagesrepo.Cursor)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetClientChatMessagesAround indicates an expected call of GetClientChatMessagesAround.
+func (mr *MockmessagesRepositoryMockRecorder) GetClientChatMessagesAround(ctx, clientID, msgID, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientChatMessagesAround", reflect.TypeOf((*MockmessagesRepository)(nil).GetClientChatMessagesAround), ctx, clientID, msgID, pageSize)
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/zestagio/chat-service/internal/cursor"
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
 	"github.com/zestagio/chat-service/internal/types"
 )
@@ -13,10 +12,20 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=gethistorymocks
 
 var (
-	ErrInvalidRequest = errors.New("invalid request")
-	ErrInvalidCursor  = errors.New("invalid cursor")
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrInvalidCursor   = errors.New("invalid cursor")
+	ErrMessageNotFound = errors.New("message not found")
 )
 
+type cursorCodec interface {
+	Encode(data any) (string, error)
+	Decode(in string, to any) error
+}
+
+type attachmentsService interface {
+	URLs(a messagesrepo.Attachment, viewerID types.UserID) (fileURL, thumbnailURL string)
+}
+
 type messagesRepository interface {
 	GetClientChatMessages(
 		ctx context.Context,
@@ -24,11 +33,19 @@ type messagesRepository interface {
 		pageSize int,
 		cursor *messagesrepo.Cursor,
 	) ([]messagesrepo.Message, *messagesrepo.Cursor, error)
+	GetClientChatMessagesAround(
+		ctx context.Context,
+		clientID types.UserID,
+		msgID types.MessageID,
+		pageSize int,
+	) ([]messagesrepo.Message, *messagesrepo.Cursor, *messagesrepo.Cursor, error)
 }
 
 //go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
 type Options struct {
-	msgRepo messagesRepository `option:"mandatory" validate:"required"`
+	attachmentsSvc attachmentsService `option:"mandatory" validate:"required"`
+	cursorCodec    cursorCodec        `option:"mandatory" validate:"required"`
+	msgRepo        messagesRepository `option:"mandatory" validate:"required"`
 }
 
 type UseCase struct {
@@ -44,28 +61,19 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
 	}
 
-	var c *messagesrepo.Cursor
-	if req.Cursor != "" {
-		if err := cursor.Decode(req.Cursor, &c); err != nil {
-			return Response{}, fmt.Errorf("decode cursor: %w: %v", ErrInvalidCursor, err)
-		}
+	msgs, older, newer, err := u.getMessages(ctx, req)
+	if err != nil {
+		return Response{}, err
 	}
 
-	msgs, next, err := u.msgRepo.GetClientChatMessages(ctx, req.ClientID, req.PageSize, c)
+	nextCursor, err := u.encodeCursor(older)
 	if err != nil {
-		if errors.Is(err, messagesrepo.ErrInvalidCursor) {
-			return Response{}, fmt.Errorf("get client chat messages: %w: %v", ErrInvalidCursor, err)
-		}
-		return Response{}, fmt.Errorf("get client chat messages: %v", err)
+		return Response{}, fmt.Errorf("encode next cursor: %v", err)
 	}
 
-	var nextCursor string
-	if next != nil {
-		data, err := cursor.Encode(next)
-		if err != nil {
-			return Response{}, fmt.Errorf("encode next cursor: %v", err)
-		}
-		nextCursor = data
+	prevCursor, err := u.encodeCursor(newer)
+	if err != nil {
+		return Response{}, fmt.Errorf("encode prev cursor: %v", err)
 	}
 
 	result := make([]Message, 0, len(msgs))
@@ -75,14 +83,112 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 			AuthorID:   m.AuthorID,
 			Body:       m.Body,
 			CreatedAt:  m.CreatedAt,
+			EditedAt:   m.EditedAt,
+			DeletedAt:  m.DeletedAt,
 			IsReceived: m.IsVisibleForManager && !m.IsBlocked,
 			IsBlocked:  m.IsBlocked,
 			IsService:  m.IsService,
+
+			Attachments: u.adaptAttachments(m.Attachments, req.ClientID),
+			Reactions:   adaptReactions(messagesrepo.CountReactions(m.Reactions, req.ClientID)),
+			ReplyTo:     adaptQuote(m.ReplyTo.ForClient()),
 		})
 	}
 
 	return Response{
 		Messages:   result,
 		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
 	}, nil
 }
+
+// getMessages returns the requested page and the cursors to the older and the newer messages.
+func (u UseCase) getMessages(
+	ctx context.Context,
+	req Request,
+) (msgs []messagesrepo.Message, older, newer *messagesrepo.Cursor, err error) {
+	if !req.AroundMessageID.IsZero() {
+		msgs, older, newer, err = u.msgRepo.GetClientChatMessagesAround(ctx, req.ClientID, req.AroundMessageID, req.PageSize)
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgNotFound) {
+				return nil, nil, nil, fmt.Errorf("get client chat messages around: %w: %v", ErrMessageNotFound, err)
+			}
+			return nil, nil, nil, fmt.Errorf("get client chat messages around: %v", err)
+		}
+		return msgs, older, newer, nil
+	}
+
+	var c *messagesrepo.Cursor
+	if req.Cursor != "" {
+		if err := u.cursorCodec.Decode(req.Cursor, &c); err != nil {
+			return nil, nil, nil, fmt.Errorf("decode cursor: %w: %v", ErrInvalidCursor, err)
+		}
+	}
+
+	msgs, next, err := u.msgRepo.GetClientChatMessages(ctx, req.ClientID, req.PageSize, c)
+	if err != nil {
+		if errors.Is(err, messagesrepo.ErrInvalidCursor) {
+			return nil, nil, nil, fmt.Errorf("get client chat messages: %w: %v", ErrInvalidCursor, err)
+		}
+		return nil, nil, nil, fmt.Errorf("get client chat messages: %v", err)
+	}
+
+	if c != nil && c.Forward {
+		return msgs, messagesrepo.BackCursor(c, msgs), next, nil
+	}
+	return msgs, next, messagesrepo.BackCursor(c, msgs), nil
+}
+
+func (u UseCase) encodeCursor(c *messagesrepo.Cursor) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	return u.cursorCodec.Encode(c)
+}
+
+func (u UseCase) adaptAttachments(aa []messagesrepo.Attachment, viewerID types.UserID) []Attachment {
+	if len(aa) == 0 {
+		return nil
+	}
+
+	result := make([]Attachment, 0, len(aa))
+	for _, a := range aa {
+		url, thumbnailURL := u.attachmentsSvc.URLs(a, viewerID)
+		result = append(result, Attachment{
+			ID:           a.ID,
+			FileName:     a.FileName,
+			ContentType:  a.ContentType,
+			Size:         a.Size,
+			URL:          url,
+			ThumbnailURL: thumbnailURL,
+		})
+	}
+	return result
+}
+
+func adaptReactions(counts []messagesrepo.ReactionsCount) []Reaction {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	result := make([]Reaction, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, Reaction{
+			Kind:        string(c.Kind),
+			Count:       c.Count,
+			ReactedByMe: c.ReactedByMe,
+		})
+	}
+	return result
+}
+
+func adaptQuote(q *messagesrepo.Quote) *Quote {
+	if q == nil {
+		return nil
+	}
+	return &Quote{
+		MessageID: q.MessageID,
+		AuthorID:  q.AuthorID,
+		Preview:   q.Preview,
+	}
+}
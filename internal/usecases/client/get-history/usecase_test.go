@@ -21,9 +21,11 @@ import (
 type UseCaseSuite struct {
 	testingh.ContextSuite
 
-	ctrl    *gomock.Controller
-	msgRepo *gethistorymocks.MockmessagesRepository
-	uCase   gethistory.UseCase
+	ctrl           *gomock.Controller
+	codec          *cursor.Codec
+	attachmentsSvc *gethistorymocks.MockattachmentsService
+	msgRepo        *gethistorymocks.MockmessagesRepository
+	uCase          gethistory.UseCase
 }
 
 func TestUseCaseSuite(t *testing.T) {
@@ -33,10 +35,14 @@ func TestUseCaseSuite(t *testing.T) {
 
 func (s *UseCaseSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
+	s.attachmentsSvc = gethistorymocks.NewMockattachmentsService(s.ctrl)
 	s.msgRepo = gethistorymocks.NewMockmessagesRepository(s.ctrl)
 
 	var err error
-	s.uCase, err = gethistory.New(gethistory.NewOptions(s.msgRepo))
+	s.codec, err = cursor.NewCodec(cursor.NewOptions("0123456789abcdef"))
+	s.Require().NoError(err)
+
+	s.uCase, err = gethistory.New(gethistory.NewOptions(s.attachmentsSvc, s.codec, s.msgRepo))
 	s.Require().NoError(err)
 
 	s.ContextSuite.SetupTest()
@@ -78,12 +84,32 @@ func (s *UseCaseSuite) TestCursorDecodingError() {
 	s.Empty(resp.NextCursor)
 }
 
+func (s *UseCaseSuite) TestUnsignedCursor() {
+	// Arrange.
+	unsigned, err := cursor.Encode(messagesrepo.Cursor{PageSize: 100, LastCreatedAt: time.Now()})
+	s.Require().NoError(err)
+
+	req := gethistory.Request{
+		ID:       types.NewRequestID(),
+		ClientID: types.NewUserID(),
+		Cursor:   unsigned,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, gethistory.ErrInvalidCursor)
+	s.Require().ErrorContains(err, cursor.ErrInvalidSignature.Error())
+	s.Empty(resp.Messages)
+}
+
 func (s *UseCaseSuite) TestGetClientChatMessages_InvalidCursor() {
 	// Arrange.
 	clientID := types.NewUserID()
 
 	c := messagesrepo.Cursor{PageSize: -1, LastCreatedAt: time.Now()}
-	cursorWithNegativePageSize, err := cursor.Encode(c)
+	cursorWithNegativePageSize, err := s.codec.Encode(c)
 	s.Require().NoError(err)
 
 	s.msgRepo.EXPECT().GetClientChatMessages(s.Ctx, clientID, 0, messagesrepo.NewCursorMatcher(c)).
@@ -186,6 +212,130 @@ func (s *UseCaseSuite) TestGetClientChatMessages_Success_SinglePage() {
 	})
 }
 
+func (s *UseCaseSuite) TestGetClientChatMessages_Success_Attachments() {
+	// Arrange.
+	chatID := types.NewChatID()
+	clientID := types.NewUserID()
+	msgs := s.createMessages(2, clientID, chatID)
+
+	a := messagesrepo.Attachment{
+		ID:           types.NewAttachmentID(),
+		ChatID:       chatID,
+		MessageID:    msgs[0].ID,
+		UploadedBy:   clientID,
+		FileName:     "cat.png",
+		ContentType:  "image/png",
+		Size:         1024,
+		StorageKey:   "key",
+		ThumbnailKey: "key.thumbnail",
+	}
+	msgs[0].Attachments = []messagesrepo.Attachment{a}
+
+	s.msgRepo.EXPECT().GetClientChatMessages(s.Ctx, clientID, 10, (*messagesrepo.Cursor)(nil)).
+		Return(msgs, nil, nil)
+	s.attachmentsSvc.EXPECT().URLs(a, clientID).Return("/attachments/1", "/attachments/1?variant=thumbnail")
+
+	req := gethistory.Request{
+		ID:       types.NewRequestID(),
+		ClientID: clientID,
+		PageSize: 10,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Messages, 2)
+	s.Equal([]gethistory.Attachment{{
+		ID:           a.ID,
+		FileName:     "cat.png",
+		ContentType:  "image/png",
+		Size:         1024,
+		URL:          "/attachments/1",
+		ThumbnailURL: "/attachments/1?variant=thumbnail",
+	}}, resp.Messages[0].Attachments)
+	s.Empty(resp.Messages[1].Attachments)
+}
+
+func (s *UseCaseSuite) TestGetClientChatMessages_Success_Reactions() {
+	// Arrange.
+	chatID := types.NewChatID()
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	msgs := s.createMessages(2, clientID, chatID)
+	msgs[0].Reactions = []messagesrepo.Reaction{
+		{ID: types.NewMessageReactionID(), MessageID: msgs[0].ID, UserID: managerID, Kind: messagesrepo.ReactionKindHeart},
+		{ID: types.NewMessageReactionID(), MessageID: msgs[0].ID, UserID: clientID, Kind: messagesrepo.ReactionKindHeart},
+		{ID: types.NewMessageReactionID(), MessageID: msgs[0].ID, UserID: managerID, Kind: messagesrepo.ReactionKindLaugh},
+	}
+
+	s.msgRepo.EXPECT().GetClientChatMessages(s.Ctx, clientID, 10, (*messagesrepo.Cursor)(nil)).
+		Return(msgs, nil, nil)
+
+	req := gethistory.Request{
+		ID:       types.NewRequestID(),
+		ClientID: clientID,
+		PageSize: 10,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Messages, 2)
+	s.Equal([]gethistory.Reaction{
+		{Kind: "heart", Count: 2, ReactedByMe: true},
+		{Kind: "laugh", Count: 1, ReactedByMe: false},
+	}, resp.Messages[0].Reactions)
+	s.Empty(resp.Messages[1].Reactions)
+}
+
+func (s *UseCaseSuite) TestGetClientChatMessages_Success_ReplyTo() {
+	// Arrange.
+	chatID := types.NewChatID()
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	msgs := s.createMessages(3, clientID, chatID)
+	msgs[0].ReplyTo = &messagesrepo.Quote{
+		MessageID:           msgs[1].ID,
+		AuthorID:            managerID,
+		Preview:             "Do you have the order number?",
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+	}
+	msgs[1].ReplyTo = &messagesrepo.Quote{
+		MessageID:           msgs[2].ID,
+		AuthorID:            clientID,
+		Preview:             "Secret",
+		IsVisibleForManager: true,
+	}
+
+	s.msgRepo.EXPECT().GetClientChatMessages(s.Ctx, clientID, 10, (*messagesrepo.Cursor)(nil)).
+		Return(msgs, nil, nil)
+
+	req := gethistory.Request{
+		ID:       types.NewRequestID(),
+		ClientID: clientID,
+		PageSize: 10,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Messages, 3)
+	s.Equal(&gethistory.Quote{
+		MessageID: msgs[1].ID,
+		AuthorID:  managerID,
+		Preview:   "Do you have the order number?",
+	}, resp.Messages[0].ReplyTo)
+	s.Equal(&gethistory.Quote{MessageID: msgs[2].ID}, resp.Messages[1].ReplyTo)
+	s.Nil(resp.Messages[2].ReplyTo)
+}
+
 func (s *UseCaseSuite) TestGetClientChatMessages_Success_FirstPage() {
 	// Arrange.
 	const messagesCount = 10
@@ -212,6 +362,7 @@ func (s *UseCaseSuite) TestGetClientChatMessages_Success_FirstPage() {
 
 	// Assert.
 	s.NotEmpty(resp.NextCursor)
+	s.Empty(resp.PrevCursor)
 	s.Require().Len(resp.Messages, messagesCount)
 }
 
@@ -228,7 +379,7 @@ func (s *UseCaseSuite) TestGetClientChatMessages_Success_LastPage() {
 	s.msgRepo.EXPECT().GetClientChatMessages(s.Ctx, clientID, 0, messagesrepo.NewCursorMatcher(c)).
 		Return(expectedMsgs, nil, nil)
 
-	cursorStr, err := cursor.Encode(c)
+	cursorStr, err := s.codec.Encode(c)
 	s.Require().NoError(err)
 
 	req := gethistory.Request{
@@ -243,7 +394,101 @@ func (s *UseCaseSuite) TestGetClientChatMessages_Success_LastPage() {
 
 	// Assert.
 	s.Empty(resp.NextCursor)
+	s.NotEmpty(resp.PrevCursor)
+	s.Require().Len(resp.Messages, messagesCount)
+}
+
+func (s *UseCaseSuite) TestGetClientChatMessages_Success_ForwardPage() {
+	// Arrange.
+	const messagesCount = 10
+
+	chatID := types.NewChatID()
+	clientID := types.NewUserID()
+	expectedMsgs := s.createMessages(messagesCount, clientID, chatID)
+
+	c := messagesrepo.Cursor{PageSize: messagesCount, LastCreatedAt: time.Now().Add(-time.Hour), Forward: true}
+	s.msgRepo.EXPECT().GetClientChatMessages(s.Ctx, clientID, 0, messagesrepo.NewCursorMatcher(c)).
+		Return(expectedMsgs, nil, nil)
+
+	cursorStr, err := s.codec.Encode(c)
+	s.Require().NoError(err)
+
+	req := gethistory.Request{
+		ID:       types.NewRequestID(),
+		ClientID: clientID,
+		Cursor:   cursorStr,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Empty(resp.PrevCursor)
+	s.Require().NotEmpty(resp.NextCursor)
 	s.Require().Len(resp.Messages, messagesCount)
+
+	var next messagesrepo.Cursor
+	s.Require().NoError(s.codec.Decode(resp.NextCursor, &next))
+	s.False(next.Forward)
+	s.True(next.LastCreatedAt.Equal(expectedMsgs[messagesCount-1].CreatedAt))
+}
+
+func (s *UseCaseSuite) TestGetClientChatMessagesAround_MessageNotFound() {
+	// Arrange.
+	clientID := types.NewUserID()
+	msgID := types.NewMessageID()
+
+	s.msgRepo.EXPECT().GetClientChatMessagesAround(s.Ctx, clientID, msgID, 20).
+		Return(nil, nil, nil, messagesrepo.ErrMsgNotFound)
+
+	req := gethistory.Request{
+		ID:              types.NewRequestID(),
+		ClientID:        clientID,
+		PageSize:        20,
+		AroundMessageID: msgID,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, gethistory.ErrMessageNotFound)
+}
+
+func (s *UseCaseSuite) TestGetClientChatMessagesAround_Success() {
+	// Arrange.
+	const messagesCount = 10
+
+	chatID := types.NewChatID()
+	clientID := types.NewUserID()
+	expectedMsgs := s.createMessages(messagesCount, clientID, chatID)
+	msgID := expectedMsgs[messagesCount/2].ID
+
+	older := &messagesrepo.Cursor{PageSize: messagesCount, LastCreatedAt: expectedMsgs[messagesCount-1].CreatedAt}
+	newer := &messagesrepo.Cursor{PageSize: messagesCount, LastCreatedAt: expectedMsgs[0].CreatedAt, Forward: true}
+	s.msgRepo.EXPECT().GetClientChatMessagesAround(s.Ctx, clientID, msgID, messagesCount).
+		Return(expectedMsgs, older, newer, nil)
+
+	req := gethistory.Request{
+		ID:              types.NewRequestID(),
+		ClientID:        clientID,
+		PageSize:        messagesCount,
+		AroundMessageID: msgID,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Require().Len(resp.Messages, messagesCount)
+
+	var next, prev messagesrepo.Cursor
+	s.Require().NoError(s.codec.Decode(resp.NextCursor, &next))
+	s.Require().NoError(s.codec.Decode(resp.PrevCursor, &prev))
+	s.False(next.Forward)
+	s.True(prev.Forward)
 }
 
 func (s *UseCaseSuite) createMessages(count int, authorID types.UserID, chatID types.ChatID) []messagesrepo.Message {
@@ -0,0 +1,20 @@
+package rateproblem
+
+import (
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID        types.RequestID `validate:"required"`
+	ClientID  types.UserID    `validate:"required"`
+	ProblemID types.ProblemID `validate:"required"`
+	Score     int             `validate:"min=1,max=5"`
+	Comment   string          `validate:"max=1000"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct{}
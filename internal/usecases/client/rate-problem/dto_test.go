@@ -0,0 +1,116 @@
+package rateproblem_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	rateproblem "github.com/zestagio/chat-service/internal/usecases/client/rate-problem"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request rateproblem.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: rateproblem.Request{
+				ID:        types.NewRequestID(),
+				ClientID:  types.NewUserID(),
+				ProblemID: types.NewProblemID(),
+				Score:     5,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid request with comment",
+			request: rateproblem.Request{
+				ID:        types.NewRequestID(),
+				ClientID:  types.NewUserID(),
+				ProblemID: types.NewProblemID(),
+				Score:     1,
+				Comment:   strings.Repeat("a", 1000),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: rateproblem.Request{
+				ID:        types.RequestIDNil,
+				ClientID:  types.NewUserID(),
+				ProblemID: types.NewProblemID(),
+				Score:     5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require client id",
+			request: rateproblem.Request{
+				ID:        types.NewRequestID(),
+				ClientID:  types.UserIDNil,
+				ProblemID: types.NewProblemID(),
+				Score:     5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require problem id",
+			request: rateproblem.Request{
+				ID:        types.NewRequestID(),
+				ClientID:  types.NewUserID(),
+				ProblemID: types.ProblemIDNil,
+				Score:     5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "score too low",
+			request: rateproblem.Request{
+				ID:        types.NewRequestID(),
+				ClientID:  types.NewUserID(),
+				ProblemID: types.NewProblemID(),
+				Score:     0,
+			},
+			wantErr: true,
+		},
+		{
+			name: "score too high",
+			request: rateproblem.Request{
+				ID:        types.NewRequestID(),
+				ClientID:  types.NewUserID(),
+				ProblemID: types.NewProblemID(),
+				Score:     6,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long comment",
+			request: rateproblem.Request{
+				ID:        types.NewRequestID(),
+				ClientID:  types.NewUserID(),
+				ProblemID: types.NewProblemID(),
+				Score:     5,
+				Comment:   strings.Repeat("a", 1001),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package rateproblemmocks is a generated GoMock package.
+package rateproblemmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetClientProblem mocks base method.
+func (m *MockproblemsRepository) GetClientProblem(ctx context.Context, clientID types.UserID, problemID types.ProblemID) (*problemsrepo.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientProblem", ctx, clientID, problemID)
+	ret0, _ := ret[0].(*problemsrepo.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientProblem indicates an expected call of GetClientProblem.
+func (mr *MockproblemsRepositoryMockRecorder) GetClientProblem(ctx, clientID, problemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientProblem", reflect.TypeOf((*MockproblemsRepository)(nil).GetClientProblem), ctx, clientID, problemID)
+}
+
+// RateProblem mocks base method.
+func (m *MockproblemsRepository) RateProblem(ctx context.Context, problemID types.ProblemID, score int, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateProblem", ctx, problemID, score, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RateProblem indicates an expected call of RateProblem.
+func (mr *MockproblemsRepositoryMockRecorder) RateProblem(ctx, problemID, score, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateProblem", reflect.TypeOf((*MockproblemsRepository)(nil).RateProblem), ctx, problemID, score, comment)
+}
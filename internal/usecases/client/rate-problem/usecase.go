@@ -0,0 +1,72 @@
+package rateproblem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=rateproblemmocks
+
+var (
+	ErrInvalidRequest      = errors.New("invalid request")
+	ErrProblemNotFound     = errors.New("problem not found")
+	ErrProblemNotResolved  = errors.New("problem is not resolved yet")
+	ErrAlreadyRated        = errors.New("problem already rated")
+	ErrRatingWindowExpired = errors.New("rating window expired")
+)
+
+type problemsRepository interface {
+	GetClientProblem(ctx context.Context, clientID types.UserID, problemID types.ProblemID) (*problemsrepo.Problem, error)
+	RateProblem(ctx context.Context, problemID types.ProblemID, score int, comment string) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	ratingWindow time.Duration      `option:"mandatory" validate:"min=1m"`
+}
+
+// UseCase saves the client's satisfaction score of the resolved problem.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	p, err := u.problemsRepo.GetClientProblem(ctx, req.ClientID, req.ProblemID)
+	if err != nil {
+		if errors.Is(err, problemsrepo.ErrProblemNotFound) {
+			return Response{}, fmt.Errorf("%w: %v", ErrProblemNotFound, err)
+		}
+		return Response{}, fmt.Errorf("get client problem: %v", err)
+	}
+	if p.ResolvedAt.IsZero() {
+		return Response{}, ErrProblemNotResolved
+	}
+	if !p.RatedAt.IsZero() {
+		return Response{}, ErrAlreadyRated
+	}
+	if time.Since(p.ResolvedAt) > u.ratingWindow {
+		return Response{}, ErrRatingWindowExpired
+	}
+
+	if err := u.problemsRepo.RateProblem(ctx, p.ID, req.Score, req.Comment); err != nil {
+		if errors.Is(err, problemsrepo.ErrProblemAlreadyRated) {
+			return Response{}, fmt.Errorf("%w: %v", ErrAlreadyRated, err)
+		}
+		return Response{}, fmt.Errorf("rate problem: %v", err)
+	}
+	return Response{}, nil
+}
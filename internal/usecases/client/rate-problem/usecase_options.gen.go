@@ -0,0 +1,52 @@
+// Code generated by options-gen. DO NOT EDIT.
+package rateproblem
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	problemsRepo problemsRepository,
+	ratingWindow time.Duration,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.problemsRepo = problemsRepo
+
+	o.ratingWindow = ratingWindow
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("ratingWindow", _validate_Options_ratingWindow(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_problemsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.problemsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `problemsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_ratingWindow(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.ratingWindow, "min=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `ratingWindow` did not pass the test: %w", err)
+	}
+	return nil
+}
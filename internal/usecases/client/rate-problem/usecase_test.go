@@ -0,0 +1,194 @@
+package rateproblem_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	rateproblem "github.com/zestagio/chat-service/internal/usecases/client/rate-problem"
+	rateproblemmocks "github.com/zestagio/chat-service/internal/usecases/client/rate-problem/mocks"
+)
+
+const ratingWindow = 72 * time.Hour
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	problemRepo *rateproblemmocks.MockproblemsRepository
+	uCase       rateproblem.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.problemRepo = rateproblemmocks.NewMockproblemsRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = rateproblem.New(rateproblem.NewOptions(s.problemRepo, ratingWindow))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := rateproblem.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, rateproblem.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestGetClientProblem_Errors() {
+	for _, tt := range []struct {
+		name   string
+		err    error
+		expErr error
+	}{
+		{
+			name:   "problem not found",
+			err:    fmt.Errorf("problem: %w", problemsrepo.ErrProblemNotFound),
+			expErr: rateproblem.ErrProblemNotFound,
+		},
+		{
+			name: "unexpected error",
+			err:  errors.New("unexpected"),
+		},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			req := s.newRequest()
+			s.problemRepo.EXPECT().GetClientProblem(gomock.Any(), req.ClientID, req.ProblemID).Return(nil, tt.err)
+
+			// Action.
+			_, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().Error(err)
+			if tt.expErr != nil {
+				s.Require().ErrorIs(err, tt.expErr)
+			}
+		})
+	}
+}
+
+func (s *UseCaseSuite) TestProblemCannotBeRated() {
+	for _, tt := range []struct {
+		name    string
+		problem problemsrepo.Problem
+		expErr  error
+	}{
+		{
+			name:    "problem is open",
+			problem: problemsrepo.Problem{},
+			expErr:  rateproblem.ErrProblemNotResolved,
+		},
+		{
+			name: "problem already rated",
+			problem: problemsrepo.Problem{
+				ResolvedAt:  time.Now().Add(-time.Hour),
+				RatingScore: 5,
+				RatedAt:     time.Now().Add(-time.Minute),
+			},
+			expErr: rateproblem.ErrAlreadyRated,
+		},
+		{
+			name:    "rating window expired",
+			problem: problemsrepo.Problem{ResolvedAt: time.Now().Add(-ratingWindow - time.Minute)},
+			expErr:  rateproblem.ErrRatingWindowExpired,
+		},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			req := s.newRequest()
+			p := tt.problem
+			p.ID = req.ProblemID
+			s.problemRepo.EXPECT().GetClientProblem(gomock.Any(), req.ClientID, req.ProblemID).Return(&p, nil)
+
+			// Action.
+			_, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().ErrorIs(err, tt.expErr)
+		})
+	}
+}
+
+func (s *UseCaseSuite) TestRateProblem_AlreadyRatedConcurrently() {
+	// Arrange.
+	req := s.newRequest()
+	s.expectResolvedProblem(req)
+	s.problemRepo.EXPECT().RateProblem(gomock.Any(), req.ProblemID, req.Score, req.Comment).
+		Return(fmt.Errorf("problem: %w", problemsrepo.ErrProblemAlreadyRated))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, rateproblem.ErrAlreadyRated)
+}
+
+func (s *UseCaseSuite) TestRateProblem_UnexpectedError() {
+	// Arrange.
+	req := s.newRequest()
+	s.expectResolvedProblem(req)
+	s.problemRepo.EXPECT().RateProblem(gomock.Any(), req.ProblemID, req.Score, req.Comment).
+		Return(errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.Require().NotErrorIs(err, rateproblem.ErrAlreadyRated)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	s.expectResolvedProblem(req)
+	s.problemRepo.EXPECT().RateProblem(gomock.Any(), req.ProblemID, req.Score, req.Comment).Return(nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) newRequest() rateproblem.Request {
+	return rateproblem.Request{
+		ID:        types.NewRequestID(),
+		ClientID:  types.NewUserID(),
+		ProblemID: types.NewProblemID(),
+		Score:     4,
+		Comment:   "Quick answer",
+	}
+}
+
+func (s *UseCaseSuite) expectResolvedProblem(req rateproblem.Request) {
+	s.problemRepo.EXPECT().GetClientProblem(gomock.Any(), req.ClientID, req.ProblemID).Return(&problemsrepo.Problem{
+		ID:         req.ProblemID,
+		ResolvedAt: time.Now().Add(-time.Hour),
+	}, nil)
+}
@@ -0,0 +1,134 @@
+package reacttomessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	reactionschangedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/reactions-changed"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=reacttomessagemocks
+
+var (
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrMessageNotFound = errors.New("message not found")
+)
+
+type chatsRepository interface {
+	GetChatClient(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type messagesRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	GetMessageReactions(ctx context.Context, msgID types.MessageID) ([]messagesrepo.Reaction, error)
+	AddReaction(ctx context.Context, msgID types.MessageID, userID types.UserID, kind messagesrepo.ReactionKind) (bool, error)
+	RemoveReaction(ctx context.Context, msgID types.MessageID, userID types.UserID, kind messagesrepo.ReactionKind) (bool, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	chatsRepo chatsRepository    `option:"mandatory" validate:"required"`
+	msgRepo   messagesRepository `option:"mandatory" validate:"required"`
+	outBox    outboxService      `option:"mandatory" validate:"required"`
+	txtor     transactor         `option:"mandatory" validate:"required"`
+}
+
+// UseCase puts the reaction on or takes it off the visible message of the client's chat.
+// Putting the same reaction twice or taking off the absent one changes nothing.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	var reactions []messagesrepo.Reaction
+
+	if err := u.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		m, err := u.msgRepo.GetMessageByID(ctx, req.MessageID)
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgNotFound) {
+				return fmt.Errorf("%w: %v", ErrMessageNotFound, err)
+			}
+			return fmt.Errorf("get message: %v", err)
+		}
+		if !m.IsVisibleForClient || m.IsService || !m.DeletedAt.IsZero() {
+			return fmt.Errorf("%w: message cannot be reacted by client", ErrMessageNotFound)
+		}
+
+		clientID, err := u.chatsRepo.GetChatClient(ctx, m.ChatID)
+		if err != nil {
+			return fmt.Errorf("get chat client: %v", err)
+		}
+		if clientID != req.ClientID {
+			return fmt.Errorf("%w: foreign chat", ErrMessageNotFound)
+		}
+
+		changed, err := u.changeReaction(ctx, req.Operation, m.ID, req.ClientID, messagesrepo.ReactionKind(req.Kind))
+		if err != nil {
+			return fmt.Errorf("%s reaction: %v", req.Operation, err)
+		}
+
+		if changed {
+			payload := reactionschangedjob.MustMarshalPayload(m.ID, req.ID)
+			if _, err := u.outBox.Put(ctx, reactionschangedjob.Name, payload, time.Now()); err != nil {
+				return fmt.Errorf("create `reactions changed` job: %v", err)
+			}
+		}
+
+		reactions, err = u.msgRepo.GetMessageReactions(ctx, m.ID)
+		if err != nil {
+			return fmt.Errorf("get message reactions: %v", err)
+		}
+		return nil
+	}); err != nil {
+		return Response{}, fmt.Errorf("`react to message` tx: %w", err)
+	}
+
+	counts := messagesrepo.CountReactions(reactions, req.ClientID)
+	result := make([]Reaction, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, Reaction{
+			Kind:        string(c.Kind),
+			Count:       c.Count,
+			ReactedByMe: c.ReactedByMe,
+		})
+	}
+
+	return Response{
+		MessageID: req.MessageID,
+		Reactions: result,
+	}, nil
+}
+
+// changeReaction reports whether the operation has changed the reactions on the message.
+func (u UseCase) changeReaction(
+	ctx context.Context,
+	op Operation,
+	msgID types.MessageID,
+	clientID types.UserID,
+	kind messagesrepo.ReactionKind,
+) (bool, error) {
+	if op == OperationRemove {
+		return u.msgRepo.RemoveReaction(ctx, msgID, clientID, kind)
+	}
+	return u.msgRepo.AddReaction(ctx, msgID, clientID, kind)
+}
@@ -1,6 +1,7 @@
 package sendmessage
 
 import (
+	"errors"
 	"time"
 
 	"github.com/zestagio/chat-service/internal/types"
@@ -10,10 +11,25 @@ import (
 type Request struct {
 	ID          types.RequestID `validate:"required"`
 	ClientID    types.UserID    `validate:"required"`
-	MessageBody string          `validate:"required,max=3000"`
+	MessageBody string          `validate:"max=3000"`
+
+	// AttachmentIDs are the files uploaded by the client before the sending.
+	AttachmentIDs []types.AttachmentID `validate:"max=10,unique,dive,required"`
+
+	// ClientName is the client name from the token. It is remembered in the chat, so keep empty if unknown.
+	// The name longer than maxClientNameLength runes is truncated.
+	ClientName string
+
+	// ReplyToMessageID is the message of the chat the client answers to. Zero if the message is not a reply.
+	ReplyToMessageID types.MessageID
 }
 
+var errEmptyMessage = errors.New("message body or attachments are required")
+
 func (r Request) Validate() error {
+	if r.MessageBody == "" && len(r.AttachmentIDs) == 0 {
+		return errEmptyMessage
+	}
 	return validator.Validator.Struct(r)
 }
 
@@ -11,6 +11,8 @@ import (
 )
 
 func TestRequest_Validate(t *testing.T) {
+	attachmentID := types.NewAttachmentID()
+
 	cases := []struct {
 		name    string
 		request sendmessage.Request
@@ -26,6 +28,16 @@ func TestRequest_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "attachments only",
+			request: sendmessage.Request{
+				ID:            types.NewRequestID(),
+				ClientID:      types.NewUserID(),
+				MessageBody:   "",
+				AttachmentIDs: []types.AttachmentID{types.NewAttachmentID(), types.NewAttachmentID()},
+			},
+			wantErr: false,
+		},
 
 		// Negative.
 		{
@@ -64,6 +76,46 @@ func TestRequest_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "require message body or attachments",
+			request: sendmessage.Request{
+				ID:            types.NewRequestID(),
+				ClientID:      types.NewUserID(),
+				MessageBody:   "",
+				AttachmentIDs: []types.AttachmentID{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated attachments",
+			request: sendmessage.Request{
+				ID:            types.NewRequestID(),
+				ClientID:      types.NewUserID(),
+				MessageBody:   "Hello, guys!",
+				AttachmentIDs: []types.AttachmentID{attachmentID, attachmentID},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many attachments",
+			request: sendmessage.Request{
+				ID:            types.NewRequestID(),
+				ClientID:      types.NewUserID(),
+				MessageBody:   "Hello, guys!",
+				AttachmentIDs: newAttachmentIDs(11),
+			},
+			wantErr: true,
+		},
+		{
+			name: "nil attachment id",
+			request: sendmessage.Request{
+				ID:            types.NewRequestID(),
+				ClientID:      types.NewUserID(),
+				MessageBody:   "Hello, guys!",
+				AttachmentIDs: []types.AttachmentID{types.AttachmentIDNil},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range cases {
@@ -77,3 +129,11 @@ func TestRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func newAttachmentIDs(n int) []types.AttachmentID {
+	ids := make([]types.AttachmentID, n)
+	for i := range ids {
+		ids[i] = types.NewAttachmentID()
+	}
+	return ids
+}
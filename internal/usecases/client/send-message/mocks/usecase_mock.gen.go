@@ -11,6 +11,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
 	types "github.com/zestagio/chat-service/internal/types"
 )
 
@@ -52,6 +53,58 @@ func (mr *MockchatsRepositoryMockRecorder) CreateIfNotExists(ctx, userID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockchatsRepository)(nil).CreateIfNotExists), ctx, userID)
 }
 
+// SetClientName mocks base method.
+func (m *MockchatsRepository) SetClientName(ctx context.Context, chatID types.ChatID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetClientName", ctx, chatID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetClientName indicates an expected call of SetClientName.
+func (mr *MockchatsRepositoryMockRecorder) SetClientName(ctx, chatID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClientName", reflect.TypeOf((*MockchatsRepository)(nil).SetClientName), ctx, chatID, name)
+}
+
+// MockmanagerLoadService is a mock of managerLoadService interface.
+type MockmanagerLoadService struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagerLoadServiceMockRecorder
+}
+
+// MockmanagerLoadServiceMockRecorder is the mock recorder for MockmanagerLoadService.
+type MockmanagerLoadServiceMockRecorder struct {
+	mock *MockmanagerLoadService
+}
+
+// NewMockmanagerLoadService creates a new mock instance.
+func NewMockmanagerLoadService(ctrl *gomock.Controller) *MockmanagerLoadService {
+	mock := &MockmanagerLoadService{ctrl: ctrl}
+	mock.recorder = &MockmanagerLoadServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagerLoadService) EXPECT() *MockmanagerLoadServiceMockRecorder {
+	return m.recorder
+}
+
+// CanManagerTakeProblem mocks base method.
+func (m *MockmanagerLoadService) CanManagerTakeProblem(ctx context.Context, managerID types.UserID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanManagerTakeProblem", ctx, managerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanManagerTakeProblem indicates an expected call of CanManagerTakeProblem.
+func (mr *MockmanagerLoadServiceMockRecorder) CanManagerTakeProblem(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanManagerTakeProblem", reflect.TypeOf((*MockmanagerLoadService)(nil).CanManagerTakeProblem), ctx, managerID)
+}
+
 // MockmessagesRepository is a mock of messagesRepository interface.
 type MockmessagesRepository struct {
 	ctrl     *gomock.Controller
@@ -75,19 +128,63 @@ func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AttachToMessage mocks base method.
+func (m *MockmessagesRepository) AttachToMessage(ctx context.Context, msgID types.MessageID, chatID types.ChatID, authorID types.UserID, ids []types.AttachmentID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachToMessage", ctx, msgID, chatID, authorID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachToMessage indicates an expected call of AttachToMessage.
+func (mr *MockmessagesRepositoryMockRecorder) AttachToMessage(ctx, msgID, chatID, authorID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachToMessage", reflect.TypeOf((*MockmessagesRepository)(nil).AttachToMessage), ctx, msgID, chatID, authorID, ids)
+}
+
 // CreateClientVisible mocks base method.
-func (m *MockmessagesRepository) CreateClientVisible(ctx context.Context, reqID types.RequestID, problemID types.ProblemID, chatID types.ChatID, authorID types.UserID, msgBody string) (*messagesrepo.Message, error) {
+func (m *MockmessagesRepository) CreateClientVisible(ctx context.Context, reqID types.RequestID, problemID types.ProblemID, chatID types.ChatID, authorID types.UserID, msgBody string, replyToID types.MessageID) (*messagesrepo.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateClientVisible", ctx, reqID, problemID, chatID, authorID, msgBody)
+	ret := m.ctrl.Call(m, "CreateClientVisible", ctx, reqID, problemID, chatID, authorID, msgBody, replyToID)
 	ret0, _ := ret[0].(*messagesrepo.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateClientVisible indicates an expected call of CreateClientVisible.
-func (mr *MockmessagesRepositoryMockRecorder) CreateClientVisible(ctx, reqID, problemID, chatID, authorID, msgBody interface{}) *gomock.Call {
+func (mr *MockmessagesRepositoryMockRecorder) CreateClientVisible(ctx, reqID, problemID, chatID, authorID, msgBody, replyToID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClientVisible", reflect.TypeOf((*MockmessagesRepository)(nil).CreateClientVisible), ctx, reqID, problemID, chatID, authorID, msgBody, replyToID)
+}
+
+// CreateServiceMessageForClient mocks base method.
+func (m *MockmessagesRepository) CreateServiceMessageForClient(ctx context.Context, reqID types.RequestID, problemID types.ProblemID, chatID types.ChatID, msgBody string) (types.MessageID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceMessageForClient", ctx, reqID, problemID, chatID, msgBody)
+	ret0, _ := ret[0].(types.MessageID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceMessageForClient indicates an expected call of CreateServiceMessageForClient.
+func (mr *MockmessagesRepositoryMockRecorder) CreateServiceMessageForClient(ctx, reqID, problemID, chatID, msgBody interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceMessageForClient", reflect.TypeOf((*MockmessagesRepository)(nil).CreateServiceMessageForClient), ctx, reqID, problemID, chatID, msgBody)
+}
+
+// GetMessageByID mocks base method.
+func (m *MockmessagesRepository) GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageByID", ctx, msgID)
+	ret0, _ := ret[0].(*messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageByID indicates an expected call of GetMessageByID.
+func (mr *MockmessagesRepositoryMockRecorder) GetMessageByID(ctx, msgID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClientVisible", reflect.TypeOf((*MockmessagesRepository)(nil).CreateClientVisible), ctx, reqID, problemID, chatID, authorID, msgBody)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockmessagesRepository)(nil).GetMessageByID), ctx, msgID)
 }
 
 // GetMessageByRequestID mocks base method.
@@ -181,6 +278,50 @@ func (mr *MockproblemsRepositoryMockRecorder) CreateIfNotExists(ctx, chatID inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockproblemsRepository)(nil).CreateIfNotExists), ctx, chatID)
 }
 
+// CreateReopenedProblem mocks base method.
+func (m *MockproblemsRepository) CreateReopenedProblem(ctx context.Context, chatID types.ChatID, reopenedFromID types.ProblemID) (types.ProblemID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReopenedProblem", ctx, chatID, reopenedFromID)
+	ret0, _ := ret[0].(types.ProblemID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReopenedProblem indicates an expected call of CreateReopenedProblem.
+func (mr *MockproblemsRepositoryMockRecorder) CreateReopenedProblem(ctx, chatID, reopenedFromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReopenedProblem", reflect.TypeOf((*MockproblemsRepository)(nil).CreateReopenedProblem), ctx, chatID, reopenedFromID)
+}
+
+// GetRecentlyResolvedProblem mocks base method.
+func (m *MockproblemsRepository) GetRecentlyResolvedProblem(ctx context.Context, chatID types.ChatID, since time.Time) (*problemsrepo.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentlyResolvedProblem", ctx, chatID, since)
+	ret0, _ := ret[0].(*problemsrepo.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentlyResolvedProblem indicates an expected call of GetRecentlyResolvedProblem.
+func (mr *MockproblemsRepositoryMockRecorder) GetRecentlyResolvedProblem(ctx, chatID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentlyResolvedProblem", reflect.TypeOf((*MockproblemsRepository)(nil).GetRecentlyResolvedProblem), ctx, chatID, since)
+}
+
+// ReopenProblem mocks base method.
+func (m *MockproblemsRepository) ReopenProblem(ctx context.Context, problemID types.ProblemID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenProblem", ctx, problemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReopenProblem indicates an expected call of ReopenProblem.
+func (mr *MockproblemsRepositoryMockRecorder) ReopenProblem(ctx, problemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenProblem", reflect.TypeOf((*MockproblemsRepository)(nil).ReopenProblem), ctx, problemID)
+}
+
 // Mocktransactor is a mock of transactor interface.
 type Mocktransactor struct {
 	ctrl     *gomock.Controller
@@ -7,9 +7,13 @@ import (
 	"time"
 
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	managerassignedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/manager-assigned-to-problem"
 	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
 	sendclientmessagejob "github.com/zestagio/chat-service/internal/services/outbox/jobs/send-client-message"
+	verdicttimeoutjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/verdict-timeout"
 	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/pkg/strutil"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=sendmessagemocks
@@ -18,13 +22,23 @@ var (
 	ErrInvalidRequest    = errors.New("invalid request")
 	ErrChatNotCreated    = errors.New("chat not created")
 	ErrProblemNotCreated = errors.New("problem not created")
+	ErrAttachmentInvalid = errors.New("attachment is unknown or already sent")
+	ErrReplyToInvalid    = errors.New("replied message is unknown or not visible")
 )
 
+const maxClientNameLength = 256
+
 type chatsRepository interface {
 	CreateIfNotExists(ctx context.Context, userID types.UserID) (types.ChatID, error)
+	SetClientName(ctx context.Context, chatID types.ChatID, name string) error
+}
+
+type managerLoadService interface {
+	CanManagerTakeProblem(ctx context.Context, managerID types.UserID) (bool, error)
 }
 
 type messagesRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
 	GetMessageByRequestID(ctx context.Context, reqID types.RequestID) (*messagesrepo.Message, error)
 	CreateClientVisible(
 		ctx context.Context,
@@ -33,7 +47,22 @@ type messagesRepository interface {
 		chatID types.ChatID,
 		authorID types.UserID,
 		msgBody string,
+		replyToID types.MessageID,
 	) (*messagesrepo.Message, error)
+	AttachToMessage(
+		ctx context.Context,
+		msgID types.MessageID,
+		chatID types.ChatID,
+		authorID types.UserID,
+		ids []types.AttachmentID,
+	) error
+	CreateServiceMessageForClient(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		msgBody string,
+	) (types.MessageID, error)
 }
 
 type outboxService interface {
@@ -42,6 +71,9 @@ type outboxService interface {
 
 type problemsRepository interface {
 	CreateIfNotExists(ctx context.Context, chatID types.ChatID) (types.ProblemID, error)
+	GetRecentlyResolvedProblem(ctx context.Context, chatID types.ChatID, since time.Time) (*problemsrepo.Problem, error)
+	ReopenProblem(ctx context.Context, problemID types.ProblemID) error
+	CreateReopenedProblem(ctx context.Context, chatID types.ChatID, reopenedFromID types.ProblemID) (types.ProblemID, error)
 }
 
 type transactor interface {
@@ -51,10 +83,21 @@ type transactor interface {
 //go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
 type Options struct {
 	chatsRepo    chatsRepository    `option:"mandatory" validate:"required"`
+	mLoadSvc     managerLoadService `option:"mandatory" validate:"required"`
 	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
 	outBox       outboxService      `option:"mandatory" validate:"required"`
 	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
 	txtor        transactor         `option:"mandatory" validate:"required"`
+
+	// verdictTimeoutEnabled enables the verdict timeout job scheduling.
+	// The zero verdictTimeout means the timeout policy is applied right after the message sending.
+	verdictTimeoutEnabled bool
+	// verdictTimeout is the time to wait for the AFC verdict.
+	verdictTimeout time.Duration `validate:"min=0"`
+
+	// reopenWindow is the time since the problem resolution to continue it with the next client message.
+	// The zero reopenWindow means the next message always starts the new problem.
+	reopenWindow time.Duration `validate:"min=0"`
 }
 
 type UseCase struct {
@@ -87,21 +130,56 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 			return fmt.Errorf("%w: %v", ErrChatNotCreated, err)
 		}
 
-		problemID, err := u.problemsRepo.CreateIfNotExists(ctx, chatID)
+		if req.ClientName != "" {
+			if err := u.chatsRepo.SetClientName(ctx, chatID, strutil.Truncate(req.ClientName, maxClientNameLength)); err != nil {
+				return fmt.Errorf("set chat client name: %v", err)
+			}
+		}
+
+		problemID, reopenedFor, err := u.getProblem(ctx, chatID)
 		if err != nil {
 			return fmt.Errorf("%w: %v", ErrProblemNotCreated, err)
 		}
 
-		m, err = u.msgRepo.CreateClientVisible(ctx, req.ID, problemID, chatID, req.ClientID, req.MessageBody)
+		if !req.ReplyToMessageID.IsZero() {
+			if err := u.checkReplyTo(ctx, chatID, req.ReplyToMessageID); err != nil {
+				return err
+			}
+		}
+
+		m, err = u.msgRepo.CreateClientVisible(ctx, req.ID, problemID, chatID, req.ClientID, req.MessageBody, req.ReplyToMessageID)
 		if err != nil {
 			return fmt.Errorf("create client visible message: %v", err)
 		}
 
+		if len(req.AttachmentIDs) > 0 {
+			err = u.msgRepo.AttachToMessage(ctx, m.ID, chatID, req.ClientID, req.AttachmentIDs)
+			if errors.Is(err, messagesrepo.ErrAttachmentNotFound) {
+				return fmt.Errorf("%w: %v", ErrAttachmentInvalid, err)
+			}
+			if err != nil {
+				return fmt.Errorf("attach to message: %v", err)
+			}
+		}
+
 		_, err = u.outBox.Put(ctx, sendclientmessagejob.Name, simpleid.MustMarshal(m.ID), time.Now())
 		if err != nil {
 			return fmt.Errorf("create `send client message` job: %v", err)
 		}
 
+		if !reopenedFor.IsZero() {
+			if err := u.notifyProblemReopened(ctx, req.ID, problemID, chatID, reopenedFor); err != nil {
+				return err
+			}
+		}
+
+		if u.verdictTimeoutEnabled {
+			_, err = u.outBox.Put(ctx, verdicttimeoutjob.Name, simpleid.MustMarshal(m.ID), time.Now().Add(u.verdictTimeout))
+			if err != nil {
+				return fmt.Errorf("create `verdict timeout` job: %v", err)
+			}
+		}
+
 		msg = m
 		return nil
 	}); err != nil {
@@ -114,3 +192,77 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 		CreatedAt: msg.CreatedAt,
 	}, nil
 }
+
+// getProblem returns the open problem of the chat or creates the new one.
+// The problem resolved within the reopen window goes back to its manager if they can take one more problem,
+// the manager is returned in this case. Otherwise the new problem refers to the resolved one and is queued.
+func (u UseCase) getProblem(ctx context.Context, chatID types.ChatID) (types.ProblemID, types.UserID, error) {
+	if u.reopenWindow == 0 {
+		problemID, err := u.problemsRepo.CreateIfNotExists(ctx, chatID)
+		return problemID, types.UserIDNil, err
+	}
+
+	resolved, err := u.problemsRepo.GetRecentlyResolvedProblem(ctx, chatID, time.Now().Add(-u.reopenWindow))
+	if errors.Is(err, problemsrepo.ErrProblemNotFound) {
+		problemID, err := u.problemsRepo.CreateIfNotExists(ctx, chatID)
+		return problemID, types.UserIDNil, err
+	}
+	if err != nil {
+		return types.ProblemIDNil, types.UserIDNil, fmt.Errorf("get recently resolved problem: %v", err)
+	}
+
+	canTakeMore, err := u.mLoadSvc.CanManagerTakeProblem(ctx, resolved.ManagerID)
+	if err != nil {
+		return types.ProblemIDNil, types.UserIDNil, fmt.Errorf("manager load service call: %v", err)
+	}
+	if !canTakeMore {
+		problemID, err := u.problemsRepo.CreateReopenedProblem(ctx, chatID, resolved.ID)
+		return problemID, types.UserIDNil, err
+	}
+
+	if err := u.problemsRepo.ReopenProblem(ctx, resolved.ID); err != nil {
+		if errors.Is(err, problemsrepo.ErrProblemNotFound) {
+			// Already reopened by the concurrent message.
+			problemID, err := u.problemsRepo.CreateIfNotExists(ctx, chatID)
+			return problemID, types.UserIDNil, err
+		}
+		return types.ProblemIDNil, types.UserIDNil, fmt.Errorf("reopen problem: %v", err)
+	}
+	return resolved.ID, resolved.ManagerID, nil
+}
+
+// notifyProblemReopened tells the client who will answer and returns the chat to the manager's list.
+func (u UseCase) notifyProblemReopened(
+	ctx context.Context,
+	reqID types.RequestID,
+	problemID types.ProblemID,
+	chatID types.ChatID,
+	managerID types.UserID,
+) error {
+	notifyText := fmt.Sprintf("Your question has been reopened, manager %s will answer you", managerID)
+	notifyMsgID, err := u.msgRepo.CreateServiceMessageForClient(ctx, reqID, problemID, chatID, notifyText)
+	if err != nil {
+		return fmt.Errorf("create service message for client: %v", err)
+	}
+
+	if _, err := u.outBox.Put(ctx, managerassignedjob.Name, simpleid.MustMarshal(notifyMsgID), time.Now()); err != nil {
+		return fmt.Errorf("create `manager assigned to problem` job: %v", err)
+	}
+	return nil
+}
+
+// checkReplyTo makes sure the client answers to the not deleted message of their chat.
+func (u UseCase) checkReplyTo(ctx context.Context, chatID types.ChatID, msgID types.MessageID) error {
+	m, err := u.msgRepo.GetMessageByID(ctx, msgID)
+	if errors.Is(err, messagesrepo.ErrMsgNotFound) {
+		return fmt.Errorf("%w: %v", ErrReplyToInvalid, err)
+	}
+	if err != nil {
+		return fmt.Errorf("get replied message: %v", err)
+	}
+
+	if m.ChatID != chatID || !m.IsVisibleForClient || !m.DeletedAt.IsZero() {
+		return fmt.Errorf("%w: message %v", ErrReplyToInvalid, msgID)
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	jobsrepo "github.com/zestagio/chat-service/internal/repositories/jobs"
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
 	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	managerload "github.com/zestagio/chat-service/internal/services/manager-load"
 	"github.com/zestagio/chat-service/internal/services/outbox"
 	"github.com/zestagio/chat-service/internal/testingh"
 	"github.com/zestagio/chat-service/internal/types"
@@ -55,8 +56,12 @@ func (s *UseCaseIntegrationSuite) SetupSuite() {
 	problemRepo, err := problemsrepo.New(problemsrepo.NewOptions(s.Database))
 	s.Require().NoError(err)
 
+	managerLoad, err := managerload.New(managerload.NewOptions(5, problemRepo))
+	s.Require().NoError(err)
+
 	s.uCase, err = sendmessage.New(sendmessage.NewOptions(
 		chatRepo,
+		managerLoad,
 		msgRepo,
 		outBoxSvc,
 		problemRepo,
@@ -68,6 +73,7 @@ func (s *UseCaseIntegrationSuite) SetupSuite() {
 	s.msgRepoMock = sendmessagemocks.NewMockmessagesRepository(s.ctrl)
 	s.uCaseWithMsgRepoMock, err = sendmessage.New(sendmessage.NewOptions(
 		chatRepo,
+		managerLoad,
 		s.msgRepoMock,
 		outBoxSvc,
 		problemRepo,
@@ -150,7 +156,8 @@ func (s *UseCaseIntegrationSuite) TestAllOrNothing() {
 	const msgBody = "Broken"
 
 	s.msgRepoMock.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
-	s.msgRepoMock.EXPECT().CreateClientVisible(gomock.Any(), reqID, gomock.Any(), gomock.Any(), clientID, msgBody).
+	s.msgRepoMock.EXPECT().
+		CreateClientVisible(gomock.Any(), reqID, gomock.Any(), gomock.Any(), clientID, msgBody, types.MessageIDNil).
 		Return(nil, errors.New("unexpected"))
 
 	// Action.
@@ -3,6 +3,7 @@ package sendmessage
 
 import (
 	fmt461e464ebed9 "fmt"
+	"time"
 
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
@@ -12,6 +13,7 @@ type OptOptionsSetter func(o *Options)
 
 func NewOptions(
 	chatsRepo chatsRepository,
+	mLoadSvc managerLoadService,
 	msgRepo messagesRepository,
 	outBox outboxService,
 	problemsRepo problemsRepository,
@@ -24,6 +26,8 @@ func NewOptions(
 
 	o.chatsRepo = chatsRepo
 
+	o.mLoadSvc = mLoadSvc
+
 	o.msgRepo = msgRepo
 
 	o.outBox = outBox
@@ -38,13 +42,42 @@ func NewOptions(
 	return o
 }
 
+// verdictTimeoutEnabled enables the verdict timeout job scheduling.
+// The zero verdictTimeout means the timeout policy is applied right after the message sending.
+func WithVerdictTimeoutEnabled(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.verdictTimeoutEnabled = opt
+
+	}
+}
+
+// verdictTimeout is the time to wait for the AFC verdict.
+func WithVerdictTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.verdictTimeout = opt
+
+	}
+}
+
+// reopenWindow is the time since the problem resolution to continue it with the next client message.
+// The zero reopenWindow means the next message always starts the new problem.
+func WithReopenWindow(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.reopenWindow = opt
+
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("chatsRepo", _validate_Options_chatsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("mLoadSvc", _validate_Options_mLoadSvc(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("outBox", _validate_Options_outBox(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("verdictTimeout", _validate_Options_verdictTimeout(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("reopenWindow", _validate_Options_reopenWindow(o)))
 	return errs.AsError()
 }
 
@@ -55,6 +88,13 @@ func _validate_Options_chatsRepo(o *Options) error {
 	return nil
 }
 
+func _validate_Options_mLoadSvc(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.mLoadSvc, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `mLoadSvc` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_msgRepo(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
@@ -82,3 +122,17 @@ func _validate_Options_txtor(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_verdictTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.verdictTimeout, "min=0"); err != nil {
+		return fmt461e464ebed9.Errorf("field `verdictTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_reopenWindow(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.reopenWindow, "min=0"); err != nil {
+		return fmt461e464ebed9.Errorf("field `reopenWindow` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,7 +12,11 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	managerassignedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/manager-assigned-to-problem"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
 	sendclientmessagejob "github.com/zestagio/chat-service/internal/services/outbox/jobs/send-client-message"
+	verdicttimeoutjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/verdict-timeout"
 	"github.com/zestagio/chat-service/internal/testingh"
 	"github.com/zestagio/chat-service/internal/types"
 	sendmessage "github.com/zestagio/chat-service/internal/usecases/client/send-message"
@@ -23,6 +28,7 @@ type UseCaseSuite struct {
 
 	ctrl        *gomock.Controller
 	chatRepo    *sendmessagemocks.MockchatsRepository
+	mLoadSvc    *sendmessagemocks.MockmanagerLoadService
 	msgRepo     *sendmessagemocks.MockmessagesRepository
 	outBoxSvc   *sendmessagemocks.MockoutboxService
 	problemRepo *sendmessagemocks.MockproblemsRepository
@@ -38,13 +44,16 @@ func TestUseCaseSuite(t *testing.T) {
 func (s *UseCaseSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.chatRepo = sendmessagemocks.NewMockchatsRepository(s.ctrl)
+	s.mLoadSvc = sendmessagemocks.NewMockmanagerLoadService(s.ctrl)
 	s.msgRepo = sendmessagemocks.NewMockmessagesRepository(s.ctrl)
 	s.outBoxSvc = sendmessagemocks.NewMockoutboxService(s.ctrl)
 	s.problemRepo = sendmessagemocks.NewMockproblemsRepository(s.ctrl)
 	s.txtor = sendmessagemocks.NewMocktransactor(s.ctrl)
 
 	var err error
-	s.uCase, err = sendmessage.New(sendmessage.NewOptions(s.chatRepo, s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor))
+	s.uCase, err = sendmessage.New(sendmessage.NewOptions(
+		s.chatRepo, s.mLoadSvc, s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor,
+	))
 	s.Require().NoError(err)
 
 	s.ContextSuite.SetupTest()
@@ -202,7 +211,7 @@ func (s *UseCaseSuite) TestCreateMessageError() {
 	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
 	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
 	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
-	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
+	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody, types.MessageIDNil).
 		Return(nil, errors.New("unexpected"))
 
 	req := sendmessage.Request{
@@ -233,7 +242,7 @@ func (s *UseCaseSuite) TestPutJobError() {
 	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
 	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
 	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
-	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
+	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody, types.MessageIDNil).
 		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
 	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
 		Return(types.JobIDNil, errors.New("unexpected"))
@@ -267,7 +276,7 @@ func (s *UseCaseSuite) TestTransactionError() {
 	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
 	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
 	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
-	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
+	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody, types.MessageIDNil).
 		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
 	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
 		Return(types.NewJobID(), nil)
@@ -305,7 +314,7 @@ func (s *UseCaseSuite) TestNewMsgCreatedSuccessfully() {
 	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
 	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
 	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
-	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
+	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody, types.MessageIDNil).
 		Return(&messagesrepo.Message{
 			ID:                  messageID,
 			ChatID:              chatID,
@@ -335,3 +344,447 @@ func (s *UseCaseSuite) TestNewMsgCreatedSuccessfully() {
 	s.Require().Equal(messageID, resp.MessageID)
 	s.Require().True(createdAt.Equal(resp.CreatedAt))
 }
+
+func (s *UseCaseSuite) TestClientNameRemembered() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	clientID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	const msgBody = "Hello!"
+	messageID := types.NewMessageID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
+	s.chatRepo.EXPECT().SetClientName(gomock.Any(), chatID, "Eric").Return(nil)
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody, types.MessageIDNil).
+		Return(&messagesrepo.Message{ID: messageID, ChatID: chatID, AuthorID: clientID, Body: msgBody}, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	req := sendmessage.Request{
+		ID:          reqID,
+		ClientID:    clientID,
+		MessageBody: msgBody,
+		ClientName:  "Eric",
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(messageID, resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestLongClientNameTruncated() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	clientID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	const msgBody = "Hello!"
+	messageID := types.NewMessageID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
+	s.chatRepo.EXPECT().SetClientName(gomock.Any(), chatID, strings.Repeat("Я", 256)).Return(nil)
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody, types.MessageIDNil).
+		Return(&messagesrepo.Message{ID: messageID, ChatID: chatID, AuthorID: clientID, Body: msgBody}, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	req := sendmessage.Request{
+		ID:          reqID,
+		ClientID:    clientID,
+		MessageBody: msgBody,
+		ClientName:  strings.Repeat("Я", 300),
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(messageID, resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestNewMsgWithAttachmentsCreated() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	clientID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	messageID := types.NewMessageID()
+	attachmentIDs := []types.AttachmentID{types.NewAttachmentID(), types.NewAttachmentID()}
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, "", types.MessageIDNil).
+		Return(&messagesrepo.Message{ID: messageID, ChatID: chatID, AuthorID: clientID}, nil)
+	s.msgRepo.EXPECT().AttachToMessage(gomock.Any(), messageID, chatID, clientID, attachmentIDs).Return(nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	req := sendmessage.Request{
+		ID:            reqID,
+		ClientID:      clientID,
+		AttachmentIDs: attachmentIDs,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(messageID, resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestAttachmentInvalid() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	clientID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	messageID := types.NewMessageID()
+	attachmentIDs := []types.AttachmentID{types.NewAttachmentID()}
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, "Hello!", types.MessageIDNil).
+		Return(&messagesrepo.Message{ID: messageID, ChatID: chatID, AuthorID: clientID}, nil)
+	s.msgRepo.EXPECT().AttachToMessage(gomock.Any(), messageID, chatID, clientID, attachmentIDs).
+		Return(messagesrepo.ErrAttachmentNotFound)
+
+	req := sendmessage.Request{
+		ID:            reqID,
+		ClientID:      clientID,
+		MessageBody:   "Hello!",
+		AttachmentIDs: attachmentIDs,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, sendmessage.ErrAttachmentInvalid)
+}
+
+func (s *UseCaseSuite) TestReplyCreated() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	clientID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	messageID := types.NewMessageID()
+	replyToID := types.NewMessageID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), replyToID).
+		Return(&messagesrepo.Message{ID: replyToID, ChatID: chatID, IsVisibleForClient: true}, nil)
+	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, "Yes", replyToID).
+		Return(&messagesrepo.Message{ID: messageID, ChatID: chatID, AuthorID: clientID}, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	req := sendmessage.Request{
+		ID:               reqID,
+		ClientID:         clientID,
+		MessageBody:      "Yes",
+		ReplyToMessageID: replyToID,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(messageID, resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestReplyToInvalid() {
+	chatID := types.NewChatID()
+	replyToID := types.NewMessageID()
+
+	cases := []struct {
+		name    string
+		replyTo *messagesrepo.Message
+		err     error
+	}{
+		{
+			name: "unknown message",
+			err:  messagesrepo.ErrMsgNotFound,
+		},
+		{
+			name:    "message of another chat",
+			replyTo: &messagesrepo.Message{ID: replyToID, ChatID: types.NewChatID(), IsVisibleForClient: true},
+		},
+		{
+			name:    "message invisible for client",
+			replyTo: &messagesrepo.Message{ID: replyToID, ChatID: chatID, IsVisibleForManager: true},
+		},
+		{
+			name:    "deleted message",
+			replyTo: &messagesrepo.Message{ID: replyToID, ChatID: chatID, IsVisibleForClient: true, DeletedAt: time.Now()},
+		},
+	}
+
+	for _, tt := range cases {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			clientID := types.NewUserID()
+
+			s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+			s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
+			s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
+			s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(types.NewProblemID(), nil)
+			s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), replyToID).Return(tt.replyTo, tt.err)
+
+			req := sendmessage.Request{
+				ID:               reqID,
+				ClientID:         clientID,
+				MessageBody:      "Yes",
+				ReplyToMessageID: replyToID,
+			}
+
+			// Action.
+			_, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().ErrorIs(err, sendmessage.ErrReplyToInvalid)
+		})
+	}
+}
+
+func (s *UseCaseSuite) TestNewMsgCreated_VerdictTimeoutJobScheduled() {
+	// Arrange.
+	const verdictTimeout = 10 * time.Minute
+
+	uCase, err := sendmessage.New(sendmessage.NewOptions(
+		s.chatRepo, s.mLoadSvc, s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor,
+		sendmessage.WithVerdictTimeoutEnabled(true),
+		sendmessage.WithVerdictTimeout(verdictTimeout),
+	))
+	s.Require().NoError(err)
+
+	reqID := types.NewRequestID()
+	clientID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	const msgBody = "Hello!"
+	messageID := types.NewMessageID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
+	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody, types.MessageIDNil).
+		Return(&messagesrepo.Message{
+			ID:                 messageID,
+			ChatID:             chatID,
+			AuthorID:           clientID,
+			Body:               msgBody,
+			CreatedAt:          time.Now(),
+			IsVisibleForClient: true,
+		}, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), verdicttimeoutjob.Name, simpleid.MustMarshal(messageID), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, availableAt time.Time) (types.JobID, error) {
+			s.WithinDuration(time.Now().Add(verdictTimeout), availableAt, time.Minute)
+			return types.NewJobID(), nil
+		})
+
+	req := sendmessage.Request{
+		ID:          reqID,
+		ClientID:    clientID,
+		MessageBody: msgBody,
+	}
+
+	// Action.
+	resp, err := uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Equal(messageID, resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestReopenProblem() {
+	const reopenWindow = 10 * time.Minute
+
+	uCase, err := sendmessage.New(sendmessage.NewOptions(
+		s.chatRepo, s.mLoadSvc, s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor,
+		sendmessage.WithReopenWindow(reopenWindow),
+	))
+	s.Require().NoError(err)
+
+	const msgBody = "One more question"
+
+	for _, tt := range []struct {
+		name    string
+		prepare func(reqID types.RequestID, chatID types.ChatID, problemID types.ProblemID)
+	}{
+		{
+			name: "nothing to reopen",
+			prepare: func(_ types.RequestID, chatID types.ChatID, problemID types.ProblemID) {
+				s.problemRepo.EXPECT().GetRecentlyResolvedProblem(gomock.Any(), chatID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ types.ChatID, since time.Time) (*problemsrepo.Problem, error) {
+						s.WithinDuration(time.Now().Add(-reopenWindow), since, time.Minute)
+						return nil, problemsrepo.ErrProblemNotFound
+					})
+				s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+			},
+		},
+		{
+			name: "manager can take problem",
+			prepare: func(reqID types.RequestID, chatID types.ChatID, problemID types.ProblemID) {
+				managerID := types.NewUserID()
+				serviceMsgID := types.NewMessageID()
+
+				s.problemRepo.EXPECT().GetRecentlyResolvedProblem(gomock.Any(), chatID, gomock.Any()).
+					Return(&problemsrepo.Problem{ID: problemID, ChatID: chatID, ManagerID: managerID}, nil)
+				s.mLoadSvc.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(true, nil)
+				s.problemRepo.EXPECT().ReopenProblem(gomock.Any(), problemID).Return(nil)
+				s.msgRepo.EXPECT().CreateServiceMessageForClient(gomock.Any(), reqID, problemID, chatID, gomock.Any()).
+					Return(serviceMsgID, nil)
+				s.outBoxSvc.EXPECT().Put(gomock.Any(), managerassignedjob.Name, simpleid.MustMarshal(serviceMsgID), gomock.Any()).
+					Return(types.NewJobID(), nil)
+			},
+		},
+		{
+			name: "manager is busy",
+			prepare: func(_ types.RequestID, chatID types.ChatID, problemID types.ProblemID) {
+				managerID := types.NewUserID()
+				resolvedID := types.NewProblemID()
+
+				s.problemRepo.EXPECT().GetRecentlyResolvedProblem(gomock.Any(), chatID, gomock.Any()).
+					Return(&problemsrepo.Problem{ID: resolvedID, ChatID: chatID, ManagerID: managerID}, nil)
+				s.mLoadSvc.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(false, nil)
+				s.problemRepo.EXPECT().CreateReopenedProblem(gomock.Any(), chatID, resolvedID).Return(problemID, nil)
+			},
+		},
+		{
+			name: "reopened by concurrent message",
+			prepare: func(_ types.RequestID, chatID types.ChatID, problemID types.ProblemID) {
+				managerID := types.NewUserID()
+
+				s.problemRepo.EXPECT().GetRecentlyResolvedProblem(gomock.Any(), chatID, gomock.Any()).
+					Return(&problemsrepo.Problem{ID: problemID, ChatID: chatID, ManagerID: managerID}, nil)
+				s.mLoadSvc.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(true, nil)
+				s.problemRepo.EXPECT().ReopenProblem(gomock.Any(), problemID).Return(problemsrepo.ErrProblemNotFound)
+				s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
+			},
+		},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			reqID := types.NewRequestID()
+			clientID := types.NewUserID()
+			chatID := types.NewChatID()
+			problemID := types.NewProblemID()
+			messageID := types.NewMessageID()
+
+			s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+			s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
+			s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
+			tt.prepare(reqID, chatID, problemID)
+			s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody, types.MessageIDNil).
+				Return(&messagesrepo.Message{
+					ID:                 messageID,
+					ChatID:             chatID,
+					AuthorID:           clientID,
+					Body:               msgBody,
+					CreatedAt:          time.Now(),
+					IsVisibleForClient: true,
+				}, nil)
+			s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, simpleid.MustMarshal(messageID), gomock.Any()).
+				Return(types.NewJobID(), nil)
+
+			req := sendmessage.Request{
+				ID:          reqID,
+				ClientID:    clientID,
+				MessageBody: msgBody,
+			}
+
+			// Action.
+			resp, err := uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().NoError(err)
+			s.Equal(messageID, resp.MessageID)
+		})
+	}
+}
+
+func (s *UseCaseSuite) TestReopenProblem_ManagerLoadError() {
+	// Arrange.
+	uCase, err := sendmessage.New(sendmessage.NewOptions(
+		s.chatRepo, s.mLoadSvc, s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor,
+		sendmessage.WithReopenWindow(10*time.Minute),
+	))
+	s.Require().NoError(err)
+
+	reqID := types.NewRequestID()
+	clientID := types.NewUserID()
+	chatID := types.NewChatID()
+	managerID := types.NewUserID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.chatRepo.EXPECT().CreateIfNotExists(gomock.Any(), clientID).Return(chatID, nil)
+	s.problemRepo.EXPECT().GetRecentlyResolvedProblem(gomock.Any(), chatID, gomock.Any()).
+		Return(&problemsrepo.Problem{ID: types.NewProblemID(), ChatID: chatID, ManagerID: managerID}, nil)
+	s.mLoadSvc.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(false, errors.New("unexpected"))
+
+	req := sendmessage.Request{
+		ID:          reqID,
+		ClientID:    clientID,
+		MessageBody: "Hello!",
+	}
+
+	// Action.
+	_, err = uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, sendmessage.ErrProblemNotCreated)
+}
@@ -0,0 +1,26 @@
+package uploadattachment
+
+import (
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID       types.RequestID `validate:"required"`
+	ClientID types.UserID    `validate:"required"`
+	FileName string
+	Data     []byte `validate:"min=1"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	AttachmentID types.AttachmentID
+	FileName     string
+	ContentType  string
+	Size         int64
+	URL          string
+	ThumbnailURL string
+}
@@ -0,0 +1,82 @@
+package uploadattachment_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/client/upload-attachment"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request uploadattachment.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: uploadattachment.Request{
+				ID:       types.NewRequestID(),
+				ClientID: types.NewUserID(),
+				FileName: "statement.pdf",
+				Data:     []byte("%PDF-1.7"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "no file name",
+			request: uploadattachment.Request{
+				ID:       types.NewRequestID(),
+				ClientID: types.NewUserID(),
+				Data:     []byte("%PDF-1.7"),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: uploadattachment.Request{
+				ID:       types.RequestIDNil,
+				ClientID: types.NewUserID(),
+				FileName: "statement.pdf",
+				Data:     []byte("%PDF-1.7"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require client id",
+			request: uploadattachment.Request{
+				ID:       types.NewRequestID(),
+				ClientID: types.UserIDNil,
+				FileName: "statement.pdf",
+				Data:     []byte("%PDF-1.7"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require data",
+			request: uploadattachment.Request{
+				ID:       types.NewRequestID(),
+				ClientID: types.NewUserID(),
+				FileName: "statement.pdf",
+				Data:     []byte{},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
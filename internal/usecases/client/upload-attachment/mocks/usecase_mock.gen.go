@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package uploadattachmentmocks is a generated GoMock package.
+package uploadattachmentmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockattachmentsService is a mock of attachmentsService interface.
+type MockattachmentsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockattachmentsServiceMockRecorder
+}
+
+// MockattachmentsServiceMockRecorder is the mock recorder for MockattachmentsService.
+type MockattachmentsServiceMockRecorder struct {
+	mock *MockattachmentsService
+}
+
+// NewMockattachmentsService creates a new mock instance.
+func NewMockattachmentsService(ctrl *gomock.Controller) *MockattachmentsService {
+	mock := &MockattachmentsService{ctrl: ctrl}
+	mock.recorder = &MockattachmentsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockattachmentsService) EXPECT() *MockattachmentsServiceMockRecorder {
+	return m.recorder
+}
+
+// URLs mocks base method.
+func (m *MockattachmentsService) URLs(a messagesrepo.Attachment, viewerID types.UserID) (string, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URLs", a, viewerID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// URLs indicates an expected call of URLs.
+func (mr *MockattachmentsServiceMockRecorder) URLs(a, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URLs", reflect.TypeOf((*MockattachmentsService)(nil).URLs), a, viewerID)
+}
+
+// Upload mocks base method.
+func (m *MockattachmentsService) Upload(ctx context.Context, chatID types.ChatID, uploadedBy types.UserID, fileName string, data []byte) (*messagesrepo.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, chatID, uploadedBy, fileName, data)
+	ret0, _ := ret[0].(*messagesrepo.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockattachmentsServiceMockRecorder) Upload(ctx, chatID, uploadedBy, fileName, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockattachmentsService)(nil).Upload), ctx, chatID, uploadedBy, fileName, data)
+}
+
+// MockchatsRepository is a mock of chatsRepository interface.
+type MockchatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockchatsRepositoryMockRecorder
+}
+
+// MockchatsRepositoryMockRecorder is the mock recorder for MockchatsRepository.
+type MockchatsRepositoryMockRecorder struct {
+	mock *MockchatsRepository
+}
+
+// NewMockchatsRepository creates a new mock instance.
+func NewMockchatsRepository(ctrl *gomock.Controller) *MockchatsRepository {
+	mock := &MockchatsRepository{ctrl: ctrl}
+	mock.recorder = &MockchatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockchatsRepository) EXPECT() *MockchatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateIfNotExists mocks base method.
+func (m *MockchatsRepository) CreateIfNotExists(ctx context.Context, userID types.UserID) (types.ChatID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfNotExists", ctx, userID)
+	ret0, _ := ret[0].(types.ChatID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIfNotExists indicates an expected call of CreateIfNotExists.
+func (mr *MockchatsRepositoryMockRecorder) CreateIfNotExists(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockchatsRepository)(nil).CreateIfNotExists), ctx, userID)
+}
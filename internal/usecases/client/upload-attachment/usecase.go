@@ -0,0 +1,82 @@
+package uploadattachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/services/attachments"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=uploadattachmentmocks
+
+var (
+	ErrInvalidRequest        = errors.New("invalid request")
+	ErrChatNotCreated        = errors.New("chat not created")
+	ErrFileTooLarge          = errors.New("file is too large")
+	ErrContentTypeNotAllowed = errors.New("content type is not allowed")
+)
+
+type attachmentsService interface {
+	Upload(
+		ctx context.Context,
+		chatID types.ChatID,
+		uploadedBy types.UserID,
+		fileName string,
+		data []byte,
+	) (*messagesrepo.Attachment, error)
+	URLs(a messagesrepo.Attachment, viewerID types.UserID) (fileURL, thumbnailURL string)
+}
+
+type chatsRepository interface {
+	CreateIfNotExists(ctx context.Context, userID types.UserID) (types.ChatID, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	attachmentsSvc attachmentsService `option:"mandatory" validate:"required"`
+	chatsRepo      chatsRepository    `option:"mandatory" validate:"required"`
+}
+
+// UseCase uploads the file to the client's chat. The file is attached to the message on the sending.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	chatID, err := u.chatsRepo.CreateIfNotExists(ctx, req.ClientID)
+	if err != nil {
+		return Response{}, fmt.Errorf("%w: %v", ErrChatNotCreated, err)
+	}
+
+	a, err := u.attachmentsSvc.Upload(ctx, chatID, req.ClientID, req.FileName, req.Data)
+	if err != nil {
+		switch {
+		case errors.Is(err, attachments.ErrFileTooLarge):
+			return Response{}, fmt.Errorf("%w: %v", ErrFileTooLarge, err)
+		case errors.Is(err, attachments.ErrContentTypeNotAllowed):
+			return Response{}, fmt.Errorf("%w: %v", ErrContentTypeNotAllowed, err)
+		}
+		return Response{}, fmt.Errorf("upload: %v", err)
+	}
+
+	url, thumbnailURL := u.attachmentsSvc.URLs(*a, req.ClientID)
+	return Response{
+		AttachmentID: a.ID,
+		FileName:     a.FileName,
+		ContentType:  a.ContentType,
+		Size:         a.Size,
+		URL:          url,
+		ThumbnailURL: thumbnailURL,
+	}, nil
+}
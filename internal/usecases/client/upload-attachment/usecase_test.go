@@ -0,0 +1,151 @@
+package uploadattachment_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/services/attachments"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/client/upload-attachment"
+	uploadattachmentmocks "github.com/zestagio/chat-service/internal/usecases/client/upload-attachment/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl           *gomock.Controller
+	attachmentsSvc *uploadattachmentmocks.MockattachmentsService
+	chatsRepo      *uploadattachmentmocks.MockchatsRepository
+	uCase          uploadattachment.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.attachmentsSvc = uploadattachmentmocks.NewMockattachmentsService(s.ctrl)
+	s.chatsRepo = uploadattachmentmocks.NewMockchatsRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = uploadattachment.New(uploadattachment.NewOptions(s.attachmentsSvc, s.chatsRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := uploadattachment.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, uploadattachment.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestCreateChatError() {
+	// Arrange.
+	req := s.newRequest()
+	s.chatsRepo.EXPECT().CreateIfNotExists(gomock.Any(), req.ClientID).Return(types.ChatIDNil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, uploadattachment.ErrChatNotCreated)
+}
+
+func (s *UseCaseSuite) TestUploadErrors() {
+	for _, tt := range []struct {
+		name   string
+		err    error
+		expErr error
+	}{
+		{name: "too large", err: attachments.ErrFileTooLarge, expErr: uploadattachment.ErrFileTooLarge},
+		{
+			name:   "content type not allowed",
+			err:    attachments.ErrContentTypeNotAllowed,
+			expErr: uploadattachment.ErrContentTypeNotAllowed,
+		},
+		{name: "unknown", err: errors.New("unexpected"), expErr: nil},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			req := s.newRequest()
+			chatID := types.NewChatID()
+
+			s.chatsRepo.EXPECT().CreateIfNotExists(gomock.Any(), req.ClientID).Return(chatID, nil)
+			s.attachmentsSvc.EXPECT().Upload(gomock.Any(), chatID, req.ClientID, req.FileName, req.Data).
+				Return(nil, tt.err)
+
+			// Action.
+			_, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().Error(err)
+			if tt.expErr != nil {
+				s.ErrorIs(err, tt.expErr)
+			}
+		})
+	}
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	chatID := types.NewChatID()
+	a := messagesrepo.Attachment{
+		ID:           types.NewAttachmentID(),
+		ChatID:       chatID,
+		UploadedBy:   req.ClientID,
+		FileName:     req.FileName,
+		ContentType:  "image/png",
+		Size:         int64(len(req.Data)),
+		StorageKey:   "key",
+		ThumbnailKey: "key.thumbnail",
+	}
+
+	s.chatsRepo.EXPECT().CreateIfNotExists(gomock.Any(), req.ClientID).Return(chatID, nil)
+	s.attachmentsSvc.EXPECT().Upload(gomock.Any(), chatID, req.ClientID, req.FileName, req.Data).Return(&a, nil)
+	s.attachmentsSvc.EXPECT().URLs(a, req.ClientID).Return("/attachments/1", "/attachments/1?variant=thumbnail")
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(uploadattachment.Response{
+		AttachmentID: a.ID,
+		FileName:     "cat.png",
+		ContentType:  "image/png",
+		Size:         a.Size,
+		URL:          "/attachments/1",
+		ThumbnailURL: "/attachments/1?variant=thumbnail",
+	}, resp)
+}
+
+func (s *UseCaseSuite) newRequest() uploadattachment.Request {
+	return uploadattachment.Request{
+		ID:       types.NewRequestID(),
+		ClientID: types.NewUserID(),
+		FileName: "cat.png",
+		Data:     []byte("\x89PNG\r\n\x1a\n"),
+	}
+}
@@ -0,0 +1,93 @@
+package addinternalnote_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	addinternalnote "github.com/zestagio/chat-service/internal/usecases/manager/add-internal-note"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request addinternalnote.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: addinternalnote.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: "The client is going to close the account",
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: addinternalnote.Request{
+				ID:          types.RequestIDNil,
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: "The client is going to close the account",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: addinternalnote.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.UserIDNil,
+				ChatID:      types.NewChatID(),
+				MessageBody: "The client is going to close the account",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require chat id",
+			request: addinternalnote.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.ChatIDNil,
+				MessageBody: "The client is going to close the account",
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty body",
+			request: addinternalnote.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long body",
+			request: addinternalnote.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: strings.Repeat("a", 3001),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package addinternalnote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	internalnoteaddedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/internal-note-added"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=addinternalnotemocks
+
+var ErrAssignedProblemNotFound = errors.New("assigned problem not found")
+
+type messagesRepository interface {
+	CreateInternalNote(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		authorID types.UserID,
+		msgBody string,
+	) (*messagesrepo.Message, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type problemsRepository interface {
+	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
+	outBox       outboxService      `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	txtor        transactor         `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, err
+	}
+
+	// The note belongs to the problem, like the usual messages do.
+	problemID, err := u.problemsRepo.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
+	if errors.Is(err, problemsrepo.ErrAssignedProblemNotFound) {
+		return Response{}, fmt.Errorf("%w: %v", ErrAssignedProblemNotFound, err)
+	}
+	if err != nil {
+		return Response{}, fmt.Errorf("get assigned problem: %v", err)
+	}
+
+	var msg *messagesrepo.Message
+
+	if err := u.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		m, err := u.msgRepo.CreateInternalNote(ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody)
+		if err != nil {
+			return fmt.Errorf("create internal note: %v", err)
+		}
+
+		_, err = u.outBox.Put(ctx, internalnoteaddedjob.Name, simpleid.MustMarshal(m.ID), time.Now())
+		if err != nil {
+			return fmt.Errorf("create `internal note added` job: %v", err)
+		}
+
+		msg = m
+		return nil
+	}); err != nil {
+		return Response{}, fmt.Errorf("`add internal note` tx: %w", err)
+	}
+
+	return Response{
+		MessageID: msg.ID,
+		CreatedAt: msg.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,255 @@
+package addinternalnote_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	internalnoteaddedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/internal-note-added"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	addinternalnote "github.com/zestagio/chat-service/internal/usecases/manager/add-internal-note"
+	addinternalnotemocks "github.com/zestagio/chat-service/internal/usecases/manager/add-internal-note/mocks"
+)
+
+const noteBody = "The client has asked for the refund twice"
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	msgRepo     *addinternalnotemocks.MockmessagesRepository
+	problemRepo *addinternalnotemocks.MockproblemsRepository
+	txtor       *addinternalnotemocks.Mocktransactor
+	outBoxSvc   *addinternalnotemocks.MockoutboxService
+	uCase       addinternalnote.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = addinternalnotemocks.NewMockmessagesRepository(s.ctrl)
+	s.outBoxSvc = addinternalnotemocks.NewMockoutboxService(s.ctrl)
+	s.problemRepo = addinternalnotemocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = addinternalnotemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = addinternalnote.New(addinternalnote.NewOptions(s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := addinternalnote.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestAssignedProblemNotFound() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrAssignedProblemNotFound)
+
+	req := addinternalnote.Request{
+		ID:          types.NewRequestID(),
+		ManagerID:   managerID,
+		ChatID:      chatID,
+		MessageBody: noteBody,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, addinternalnote.ErrAssignedProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestGetAssignedProblemIDError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).
+		Return(types.ProblemIDNil, errors.New("unexpected"))
+
+	req := addinternalnote.Request{
+		ID:          types.NewRequestID(),
+		ManagerID:   managerID,
+		ChatID:      chatID,
+		MessageBody: noteBody,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.NotErrorIs(err, addinternalnote.ErrAssignedProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestCreateInternalNoteError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	s.msgRepo.EXPECT().CreateInternalNote(gomock.Any(), reqID, problemID, chatID, managerID, noteBody).
+		Return(nil, errors.New("unexpected"))
+
+	req := addinternalnote.Request{
+		ID:          reqID,
+		ManagerID:   managerID,
+		ChatID:      chatID,
+		MessageBody: noteBody,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	s.msgRepo.EXPECT().CreateInternalNote(gomock.Any(), reqID, problemID, chatID, managerID, noteBody).
+		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
+
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), internalnoteaddedjob.Name, gomock.Any(), gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+
+	req := addinternalnote.Request{
+		ID:          reqID,
+		ManagerID:   managerID,
+		ChatID:      chatID,
+		MessageBody: noteBody,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestTransactionError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			_ = f(ctx)
+			return sql.ErrTxDone
+		})
+
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	s.msgRepo.EXPECT().CreateInternalNote(gomock.Any(), reqID, problemID, chatID, managerID, noteBody).
+		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
+
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), internalnoteaddedjob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	req := addinternalnote.Request{
+		ID:          reqID,
+		ManagerID:   managerID,
+		ChatID:      chatID,
+		MessageBody: noteBody,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccessStory() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	messageID := types.NewMessageID()
+	createdAt := time.Now()
+	s.msgRepo.EXPECT().CreateInternalNote(gomock.Any(), reqID, problemID, chatID, managerID, noteBody).
+		Return(&messagesrepo.Message{
+			ID:        messageID,
+			CreatedAt: createdAt,
+		}, nil)
+
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), internalnoteaddedjob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	req := addinternalnote.Request{
+		ID:          reqID,
+		ManagerID:   managerID,
+		ChatID:      chatID,
+		MessageBody: noteBody,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(messageID, resp.MessageID)
+	s.True(resp.CreatedAt.Equal(createdAt))
+}
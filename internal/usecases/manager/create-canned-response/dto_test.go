@@ -0,0 +1,113 @@
+package createcannedresponse_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	createcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/create-canned-response"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request createcannedresponse.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: createcannedresponse.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Shortcut:  "hello",
+				Body:      "Hello, {{client_name}}!",
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: createcannedresponse.Request{
+				ID:        types.RequestIDNil,
+				ManagerID: types.NewUserID(),
+				Shortcut:  "hello",
+				Body:      "Hello!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: createcannedresponse.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.UserIDNil,
+				Shortcut:  "hello",
+				Body:      "Hello!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "shortcut with spaces",
+			request: createcannedresponse.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Shortcut:  "hello there",
+				Body:      "Hello!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long shortcut",
+			request: createcannedresponse.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Shortcut:  strings.Repeat("a", 33),
+				Body:      "Hello!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require body",
+			request: createcannedresponse.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Shortcut:  "hello",
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long body",
+			request: createcannedresponse.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Shortcut:  "hello",
+				Body:      strings.Repeat("a", 3001),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown template variable",
+			request: createcannedresponse.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Shortcut:  "hello",
+				Body:      "Your balance is {{balance}}",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
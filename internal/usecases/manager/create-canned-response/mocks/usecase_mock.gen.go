@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package createcannedresponsemocks is a generated GoMock package.
+package createcannedresponsemocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockcannedResponsesRepository is a mock of cannedResponsesRepository interface.
+type MockcannedResponsesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockcannedResponsesRepositoryMockRecorder
+}
+
+// MockcannedResponsesRepositoryMockRecorder is the mock recorder for MockcannedResponsesRepository.
+type MockcannedResponsesRepositoryMockRecorder struct {
+	mock *MockcannedResponsesRepository
+}
+
+// NewMockcannedResponsesRepository creates a new mock instance.
+func NewMockcannedResponsesRepository(ctrl *gomock.Controller) *MockcannedResponsesRepository {
+	mock := &MockcannedResponsesRepository{ctrl: ctrl}
+	mock.recorder = &MockcannedResponsesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcannedResponsesRepository) EXPECT() *MockcannedResponsesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockcannedResponsesRepository) Create(ctx context.Context, authorID types.UserID, isShared bool, shortcut, body string) (*cannedresponsesrepo.CannedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, authorID, isShared, shortcut, body)
+	ret0, _ := ret[0].(*cannedresponsesrepo.CannedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockcannedResponsesRepositoryMockRecorder) Create(ctx, authorID, isShared, shortcut, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockcannedResponsesRepository)(nil).Create), ctx, authorID, isShared, shortcut, body)
+}
@@ -0,0 +1,69 @@
+package createcannedresponse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=createcannedresponsemocks
+
+var (
+	ErrInvalidRequest = errors.New("invalid request")
+	ErrForbidden      = errors.New("shared canned responses are managed by team leads")
+	ErrShortcutTaken  = errors.New("shortcut is already taken")
+)
+
+type cannedResponsesRepository interface {
+	Create(
+		ctx context.Context,
+		authorID types.UserID,
+		isShared bool,
+		shortcut string,
+		body string,
+	) (*cannedresponsesrepo.CannedResponse, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	cannedResponsesRepo cannedResponsesRepository `option:"mandatory" validate:"required"`
+}
+
+// UseCase adds the personal canned response of the manager or the shared one of the team.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	if req.IsShared && !req.CanManageShared {
+		return Response{}, ErrForbidden
+	}
+
+	cr, err := u.cannedResponsesRepo.Create(ctx, req.ManagerID, req.IsShared, req.Shortcut, req.Body)
+	if err != nil {
+		if errors.Is(err, cannedresponsesrepo.ErrShortcutTaken) {
+			return Response{}, fmt.Errorf("%w: %v", ErrShortcutTaken, err)
+		}
+		return Response{}, fmt.Errorf("create canned response: %v", err)
+	}
+
+	return Response{CannedResponse: CannedResponse{
+		ID:        cr.ID,
+		AuthorID:  cr.AuthorID,
+		IsShared:  cr.IsShared,
+		Shortcut:  cr.Shortcut,
+		Body:      cr.Body,
+		UpdatedAt: cr.UpdatedAt,
+	}}, nil
+}
@@ -0,0 +1,161 @@
+package createcannedresponse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	createcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/create-canned-response"
+	createcannedresponsemocks "github.com/zestagio/chat-service/internal/usecases/manager/create-canned-response/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl       *gomock.Controller
+	cannedRepo *createcannedresponsemocks.MockcannedResponsesRepository
+	uCase      createcannedresponse.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.cannedRepo = createcannedresponsemocks.NewMockcannedResponsesRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = createcannedresponse.New(createcannedresponse.NewOptions(s.cannedRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := createcannedresponse.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, createcannedresponse.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestSharedResponseForbidden() {
+	// Arrange.
+	req := createcannedresponse.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		IsShared:  true,
+		Shortcut:  "hello",
+		Body:      "Hello!",
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, createcannedresponse.ErrForbidden)
+}
+
+func (s *UseCaseSuite) TestShortcutTaken() {
+	// Arrange.
+	managerID := types.NewUserID()
+
+	s.cannedRepo.EXPECT().Create(s.Ctx, managerID, false, "hello", "Hello!").
+		Return(nil, cannedresponsesrepo.ErrShortcutTaken)
+
+	req := createcannedresponse.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		Shortcut:  "hello",
+		Body:      "Hello!",
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, createcannedresponse.ErrShortcutTaken)
+}
+
+func (s *UseCaseSuite) TestCreateError() {
+	// Arrange.
+	managerID := types.NewUserID()
+
+	s.cannedRepo.EXPECT().Create(s.Ctx, managerID, false, "hello", "Hello!").
+		Return(nil, errors.New("unexpected"))
+
+	req := createcannedresponse.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		Shortcut:  "hello",
+		Body:      "Hello!",
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSharedResponseCreated() {
+	// Arrange.
+	managerID := types.NewUserID()
+	crID := types.NewCannedResponseID()
+	updatedAt := time.Now()
+	const body = "Hello, {{client_name}}!"
+
+	s.cannedRepo.EXPECT().Create(s.Ctx, managerID, true, "hello", body).
+		Return(&cannedresponsesrepo.CannedResponse{
+			ID:        crID,
+			AuthorID:  managerID,
+			IsShared:  true,
+			Shortcut:  "hello",
+			Body:      body,
+			CreatedAt: updatedAt,
+			UpdatedAt: updatedAt,
+		}, nil)
+
+	req := createcannedresponse.Request{
+		ID:              types.NewRequestID(),
+		ManagerID:       managerID,
+		IsShared:        true,
+		Shortcut:        "hello",
+		Body:            body,
+		CanManageShared: true,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(createcannedresponse.CannedResponse{
+		ID:        crID,
+		AuthorID:  managerID,
+		IsShared:  true,
+		Shortcut:  "hello",
+		Body:      body,
+		UpdatedAt: updatedAt,
+	}, resp.CannedResponse)
+}
@@ -0,0 +1,23 @@
+package deletecannedresponse
+
+import (
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID               types.RequestID        `validate:"required"`
+	ManagerID        types.UserID           `validate:"required"`
+	CannedResponseID types.CannedResponseID `validate:"required"`
+
+	// CanManageShared is true if the manager is allowed to edit the responses of the team.
+	CanManageShared bool
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	CannedResponseID types.CannedResponseID
+}
@@ -0,0 +1,69 @@
+package deletecannedresponse_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	deletecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/delete-canned-response"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request deletecannedresponse.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: deletecannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.NewUserID(),
+				CannedResponseID: types.NewCannedResponseID(),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: deletecannedresponse.Request{
+				ID:               types.RequestIDNil,
+				ManagerID:        types.NewUserID(),
+				CannedResponseID: types.NewCannedResponseID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: deletecannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.UserIDNil,
+				CannedResponseID: types.NewCannedResponseID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require canned response id",
+			request: deletecannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.NewUserID(),
+				CannedResponseID: types.CannedResponseIDNil,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package deletecannedresponse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	deletecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/delete-canned-response"
+	deletecannedresponsemocks "github.com/zestagio/chat-service/internal/usecases/manager/delete-canned-response/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl       *gomock.Controller
+	cannedRepo *deletecannedresponsemocks.MockcannedResponsesRepository
+	uCase      deletecannedresponse.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.cannedRepo = deletecannedresponsemocks.NewMockcannedResponsesRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = deletecannedresponse.New(deletecannedresponse.NewOptions(s.cannedRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := deletecannedresponse.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, deletecannedresponse.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestCannedResponseNotFound() {
+	// Arrange.
+	crID := types.NewCannedResponseID()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(nil, cannedresponsesrepo.ErrCannedResponseNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, s.newRequest(types.NewUserID(), crID, false))
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, deletecannedresponse.ErrCannedResponseNotFound)
+}
+
+func (s *UseCaseSuite) TestPersonalResponseOfAnotherManager() {
+	// Arrange.
+	crID := types.NewCannedResponseID()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       crID,
+		AuthorID: types.NewUserID(),
+	}, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, s.newRequest(types.NewUserID(), crID, true))
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, deletecannedresponse.ErrCannedResponseNotFound)
+}
+
+func (s *UseCaseSuite) TestSharedResponseForbidden() {
+	// Arrange.
+	managerID := types.NewUserID()
+	crID := types.NewCannedResponseID()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       crID,
+		AuthorID: managerID,
+		IsShared: true,
+	}, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, s.newRequest(managerID, crID, false))
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, deletecannedresponse.ErrForbidden)
+}
+
+func (s *UseCaseSuite) TestDeleteError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	crID := types.NewCannedResponseID()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       crID,
+		AuthorID: managerID,
+	}, nil)
+	s.cannedRepo.EXPECT().Delete(s.Ctx, crID).Return(errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, s.newRequest(managerID, crID, false))
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestPersonalResponseDeleted() {
+	// Arrange.
+	managerID := types.NewUserID()
+	crID := types.NewCannedResponseID()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       crID,
+		AuthorID: managerID,
+	}, nil)
+	s.cannedRepo.EXPECT().Delete(s.Ctx, crID).Return(nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, s.newRequest(managerID, crID, false))
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(crID, resp.CannedResponseID)
+}
+
+func (s *UseCaseSuite) newRequest(
+	managerID types.UserID,
+	crID types.CannedResponseID,
+	canManageShared bool,
+) deletecannedresponse.Request {
+	return deletecannedresponse.Request{
+		ID:               types.NewRequestID(),
+		ManagerID:        managerID,
+		CannedResponseID: crID,
+		CanManageShared:  canManageShared,
+	}
+}
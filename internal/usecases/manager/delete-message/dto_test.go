@@ -0,0 +1,69 @@
+package deletemessage_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/manager/delete-message"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request deletemessage.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: deletemessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				MessageID: types.NewMessageID(),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: deletemessage.Request{
+				ID:        types.RequestIDNil,
+				ManagerID: types.NewUserID(),
+				MessageID: types.NewMessageID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: deletemessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.UserIDNil,
+				MessageID: types.NewMessageID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require message id",
+			request: deletemessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				MessageID: types.MessageIDNil,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package deletemessage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	messagedeletedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-deleted"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/manager/delete-message"
+	deletemessagemocks "github.com/zestagio/chat-service/internal/usecases/manager/delete-message/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	msgRepo      *deletemessagemocks.MockmessagesRepository
+	outBoxSvc    *deletemessagemocks.MockoutboxService
+	problemsRepo *deletemessagemocks.MockproblemsRepository
+	txtor        *deletemessagemocks.Mocktransactor
+	uCase        deletemessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = deletemessagemocks.NewMockmessagesRepository(s.ctrl)
+	s.outBoxSvc = deletemessagemocks.NewMockoutboxService(s.ctrl)
+	s.problemsRepo = deletemessagemocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = deletemessagemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = deletemessage.New(deletemessage.NewOptions(s.msgRepo, s.outBoxSvc, s.problemsRepo, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := deletemessage.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, deletemessage.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestDeletionFound() {
+	// Arrange.
+	req := s.newRequest()
+	d := s.newDeletion(req)
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetDeletionByRequestID(gomock.Any(), req.ID).Return(&d, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.MessageID, resp.MessageID)
+	s.Equal(d.CreatedAt, resp.DeletedAt)
+}
+
+func (s *UseCaseSuite) TestMessageNotVisibleForManager() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	msg.IsVisibleForManager = false
+
+	s.expectTx()
+	s.expectNoDeletion(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, deletemessage.ErrMessageNotFound)
+}
+
+func (s *UseCaseSuite) TestChatOfAnotherManager() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+
+	s.expectTx()
+	s.expectNoDeletion(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrAssignedProblemNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, deletemessage.ErrMessageNotFound)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	d := s.newDeletion(req)
+
+	s.expectTx()
+	s.expectNoDeletion(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).Return(msg.ProblemID, nil)
+	s.msgRepo.EXPECT().DeleteMessage(gomock.Any(), req.ID, req.MessageID, req.ManagerID, messagesrepo.DeleterRoleManager).
+		Return(&d, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), messagedeletedjob.Name, simpleid.MustMarshal(d.ID), gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	msg.CreatedAt = time.Now().Add(-24 * time.Hour)
+	d := s.newDeletion(req)
+
+	s.expectTx()
+	s.expectNoDeletion(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).Return(msg.ProblemID, nil)
+	s.msgRepo.EXPECT().DeleteMessage(gomock.Any(), req.ID, req.MessageID, req.ManagerID, messagesrepo.DeleterRoleManager).
+		Return(&d, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), messagedeletedjob.Name, simpleid.MustMarshal(d.ID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.MessageID, resp.MessageID)
+	s.Equal(d.CreatedAt, resp.DeletedAt)
+}
+
+func (s *UseCaseSuite) newRequest() deletemessage.Request {
+	return deletemessage.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		MessageID: types.NewMessageID(),
+	}
+}
+
+func (s *UseCaseSuite) newMessage(req deletemessage.Request) messagesrepo.Message {
+	return messagesrepo.Message{
+		ID:                  req.MessageID,
+		ChatID:              types.NewChatID(),
+		ProblemID:           types.NewProblemID(),
+		AuthorID:            types.NewUserID(),
+		Body:                "My card number is 4242 4242 4242 4242",
+		CreatedAt:           time.Now().Add(-time.Minute),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+		IsChecked:           true,
+		InitialRequestID:    types.NewRequestID(),
+	}
+}
+
+func (s *UseCaseSuite) newDeletion(req deletemessage.Request) messagesrepo.Deletion {
+	return messagesrepo.Deletion{
+		ID:            types.NewMessageDeletionID(),
+		MessageID:     req.MessageID,
+		RequestID:     req.ID,
+		DeletedBy:     req.ManagerID,
+		DeletedByRole: messagesrepo.DeleterRoleManager,
+		CreatedAt:     time.Now(),
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
+
+func (s *UseCaseSuite) expectNoDeletion(reqID types.RequestID) {
+	s.msgRepo.EXPECT().GetDeletionByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrDeletionNotFound)
+}
@@ -0,0 +1,136 @@
+package editmessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	messageeditedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-edited"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=editmessagemocks
+
+var (
+	ErrInvalidRequest     = errors.New("invalid request")
+	ErrMessageNotFound    = errors.New("message not found")
+	ErrMessageNotEditable = errors.New("message is not editable")
+	ErrEditWindowExpired  = errors.New("edit window expired")
+)
+
+type messagesRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	GetRevisionByEditRequestID(ctx context.Context, reqID types.RequestID) (*messagesrepo.Revision, error)
+	EditMessage(
+		ctx context.Context,
+		reqID types.RequestID,
+		msgID types.MessageID,
+		msgBody string,
+		recheck bool,
+	) (*messagesrepo.Revision, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type problemsRepository interface {
+	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
+	outBox       outboxService      `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	txtor        transactor         `option:"mandatory" validate:"required"`
+	editWindow   time.Duration      `option:"mandatory" validate:"min=1s"`
+}
+
+// UseCase edits the manager message of the problem the manager is still working on.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	var rev *messagesrepo.Revision
+
+	if err := u.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		r, err := u.msgRepo.GetRevisionByEditRequestID(ctx, req.ID)
+		if nil == err {
+			rev = r
+			return nil
+		}
+		if !errors.Is(err, messagesrepo.ErrRevisionNotFound) {
+			return fmt.Errorf("get revision by edit request id: %v", err)
+		}
+
+		m, err := u.msgRepo.GetMessageByID(ctx, req.MessageID)
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgNotFound) {
+				return fmt.Errorf("%w: %v", ErrMessageNotFound, err)
+			}
+			return fmt.Errorf("get message: %v", err)
+		}
+		if m.AuthorID != req.ManagerID {
+			return fmt.Errorf("%w: manager is not the author", ErrMessageNotFound)
+		}
+		if !m.DeletedAt.IsZero() {
+			return fmt.Errorf("%w: message was deleted", ErrMessageNotEditable)
+		}
+
+		problemID, err := u.problemsRepo.GetAssignedProblemID(ctx, req.ManagerID, m.ChatID)
+		if err != nil {
+			if errors.Is(err, problemsrepo.ErrAssignedProblemNotFound) {
+				return fmt.Errorf("%w: %v", ErrMessageNotEditable, err)
+			}
+			return fmt.Errorf("get assigned problem: %v", err)
+		}
+		if problemID != m.ProblemID {
+			return fmt.Errorf("%w: problem of the message is resolved", ErrMessageNotEditable)
+		}
+
+		if time.Since(m.CreatedAt) > u.editWindow {
+			return ErrEditWindowExpired
+		}
+
+		r, err = u.msgRepo.EditMessage(ctx, req.ID, m.ID, req.MessageBody, false)
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgNotEditable) {
+				return fmt.Errorf("%w: %v", ErrMessageNotEditable, err)
+			}
+			return fmt.Errorf("edit message: %v", err)
+		}
+
+		_, err = u.outBox.Put(ctx, messageeditedjob.Name, simpleid.MustMarshal(r.ID), time.Now())
+		if err != nil {
+			return fmt.Errorf("create `message edited` job: %v", err)
+		}
+
+		rev = r
+		return nil
+	}); err != nil {
+		return Response{}, fmt.Errorf("`edit manager message` tx: %w", err)
+	}
+
+	return Response{
+		MessageID: rev.MessageID,
+		EditedAt:  rev.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,283 @@
+package editmessage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	messageeditedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-edited"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	editmessage "github.com/zestagio/chat-service/internal/usecases/manager/edit-message"
+	editmessagemocks "github.com/zestagio/chat-service/internal/usecases/manager/edit-message/mocks"
+)
+
+const editWindow = 15 * time.Minute
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	msgRepo      *editmessagemocks.MockmessagesRepository
+	outBoxSvc    *editmessagemocks.MockoutboxService
+	problemsRepo *editmessagemocks.MockproblemsRepository
+	txtor        *editmessagemocks.Mocktransactor
+	uCase        editmessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = editmessagemocks.NewMockmessagesRepository(s.ctrl)
+	s.outBoxSvc = editmessagemocks.NewMockoutboxService(s.ctrl)
+	s.problemsRepo = editmessagemocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = editmessagemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = editmessage.New(editmessage.NewOptions(s.msgRepo, s.outBoxSvc, s.problemsRepo, s.txtor, editWindow))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := editmessage.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, editmessage.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestRevisionFound() {
+	// Arrange.
+	req := s.newRequest()
+	rev := s.newRevision(req)
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetRevisionByEditRequestID(gomock.Any(), req.ID).Return(&rev, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.MessageID, resp.MessageID)
+	s.Equal(rev.CreatedAt, resp.EditedAt)
+}
+
+func (s *UseCaseSuite) TestNotAuthor() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	msg.AuthorID = types.NewUserID()
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, editmessage.ErrMessageNotFound)
+}
+
+func (s *UseCaseSuite) TestMessageDeleted() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	msg.DeletedAt = time.Now()
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, editmessage.ErrMessageNotEditable)
+}
+
+func (s *UseCaseSuite) TestProblemResolved() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrAssignedProblemNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, editmessage.ErrMessageNotEditable)
+}
+
+func (s *UseCaseSuite) TestMessageOfPreviousProblem() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).
+		Return(types.NewProblemID(), nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, editmessage.ErrMessageNotEditable)
+}
+
+func (s *UseCaseSuite) TestEditWindowExpired() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	msg.CreatedAt = time.Now().Add(-editWindow - time.Second)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).Return(msg.ProblemID, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, editmessage.ErrEditWindowExpired)
+}
+
+func (s *UseCaseSuite) TestMessageDeletedConcurrently() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).Return(msg.ProblemID, nil)
+	s.msgRepo.EXPECT().EditMessage(gomock.Any(), req.ID, req.MessageID, req.MessageBody, false).
+		Return(nil, messagesrepo.ErrMsgNotEditable)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, editmessage.ErrMessageNotEditable)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	rev := s.newRevision(req)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).Return(msg.ProblemID, nil)
+	s.msgRepo.EXPECT().EditMessage(gomock.Any(), req.ID, req.MessageID, req.MessageBody, false).Return(&rev, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), messageeditedjob.Name, simpleid.MustMarshal(rev.ID), gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	rev := s.newRevision(req)
+
+	s.expectTx()
+	s.expectNoRevision(req.ID)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).Return(msg.ProblemID, nil)
+	s.msgRepo.EXPECT().EditMessage(gomock.Any(), req.ID, req.MessageID, req.MessageBody, false).Return(&rev, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), messageeditedjob.Name, simpleid.MustMarshal(rev.ID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.MessageID, resp.MessageID)
+	s.Equal(rev.CreatedAt, resp.EditedAt)
+}
+
+func (s *UseCaseSuite) newRequest() editmessage.Request {
+	return editmessage.Request{
+		ID:          types.NewRequestID(),
+		ManagerID:   types.NewUserID(),
+		MessageID:   types.NewMessageID(),
+		MessageBody: "Hello, world!",
+	}
+}
+
+func (s *UseCaseSuite) newMessage(req editmessage.Request) messagesrepo.Message {
+	return messagesrepo.Message{
+		ID:                  req.MessageID,
+		ChatID:              types.NewChatID(),
+		ProblemID:           types.NewProblemID(),
+		AuthorID:            req.ManagerID,
+		Body:                "Hello!",
+		CreatedAt:           time.Now().Add(-time.Minute),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+		IsChecked:           true,
+		InitialRequestID:    types.NewRequestID(),
+	}
+}
+
+func (s *UseCaseSuite) newRevision(req editmessage.Request) messagesrepo.Revision {
+	return messagesrepo.Revision{
+		ID:            types.NewMessageRevisionID(),
+		MessageID:     req.MessageID,
+		Body:          "Hello!",
+		EditRequestID: req.ID,
+		CreatedAt:     time.Now(),
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
+
+func (s *UseCaseSuite) expectNoRevision(reqID types.RequestID) {
+	s.msgRepo.EXPECT().GetRevisionByEditRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrRevisionNotFound)
+}
@@ -0,0 +1,33 @@
+package getcannedresponses
+
+import (
+	"time"
+
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID        types.RequestID `validate:"required"`
+	ManagerID types.UserID    `validate:"required"`
+
+	// ShortcutPrefix narrows the responses down to the ones which shortcuts start with it. Empty means all.
+	ShortcutPrefix string `validate:"max=32"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	CannedResponses []CannedResponse
+}
+
+type CannedResponse struct {
+	ID        types.CannedResponseID
+	AuthorID  types.UserID
+	IsShared  bool
+	Shortcut  string
+	Body      string
+	UpdatedAt time.Time
+}
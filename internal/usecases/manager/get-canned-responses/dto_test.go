@@ -0,0 +1,76 @@
+package getcannedresponses_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	getcannedresponses "github.com/zestagio/chat-service/internal/usecases/manager/get-canned-responses"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request getcannedresponses.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: getcannedresponses.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid request with prefix",
+			request: getcannedresponses.Request{
+				ID:             types.NewRequestID(),
+				ManagerID:      types.NewUserID(),
+				ShortcutPrefix: "he",
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: getcannedresponses.Request{
+				ID:        types.RequestIDNil,
+				ManagerID: types.NewUserID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: getcannedresponses.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.UserIDNil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long prefix",
+			request: getcannedresponses.Request{
+				ID:             types.NewRequestID(),
+				ManagerID:      types.NewUserID(),
+				ShortcutPrefix: strings.Repeat("a", 33),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
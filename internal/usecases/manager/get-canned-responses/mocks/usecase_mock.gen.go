@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package getcannedresponsesmocks is a generated GoMock package.
+package getcannedresponsesmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockcannedResponsesRepository is a mock of cannedResponsesRepository interface.
+type MockcannedResponsesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockcannedResponsesRepositoryMockRecorder
+}
+
+// MockcannedResponsesRepositoryMockRecorder is the mock recorder for MockcannedResponsesRepository.
+type MockcannedResponsesRepositoryMockRecorder struct {
+	mock *MockcannedResponsesRepository
+}
+
+// NewMockcannedResponsesRepository creates a new mock instance.
+func NewMockcannedResponsesRepository(ctrl *gomock.Controller) *MockcannedResponsesRepository {
+	mock := &MockcannedResponsesRepository{ctrl: ctrl}
+	mock.recorder = &MockcannedResponsesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcannedResponsesRepository) EXPECT() *MockcannedResponsesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockcannedResponsesRepository) Search(ctx context.Context, managerID types.UserID, shortcutPrefix string, limit int) ([]cannedresponsesrepo.CannedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, managerID, shortcutPrefix, limit)
+	ret0, _ := ret[0].([]cannedresponsesrepo.CannedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockcannedResponsesRepositoryMockRecorder) Search(ctx, managerID, shortcutPrefix, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockcannedResponsesRepository)(nil).Search), ctx, managerID, shortcutPrefix, limit)
+}
@@ -0,0 +1,63 @@
+package getcannedresponses
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getcannedresponsesmocks
+
+const responsesLimit = 100
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+type cannedResponsesRepository interface {
+	Search(
+		ctx context.Context,
+		managerID types.UserID,
+		shortcutPrefix string,
+		limit int,
+	) ([]cannedresponsesrepo.CannedResponse, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	cannedResponsesRepo cannedResponsesRepository `option:"mandatory" validate:"required"`
+}
+
+// UseCase returns the personal canned responses of the manager and the shared ones of the team.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	responses, err := u.cannedResponsesRepo.Search(ctx, req.ManagerID, req.ShortcutPrefix, responsesLimit)
+	if err != nil {
+		return Response{}, fmt.Errorf("search canned responses: %v", err)
+	}
+
+	result := make([]CannedResponse, 0, len(responses))
+	for _, cr := range responses {
+		result = append(result, CannedResponse{
+			ID:        cr.ID,
+			AuthorID:  cr.AuthorID,
+			IsShared:  cr.IsShared,
+			Shortcut:  cr.Shortcut,
+			Body:      cr.Body,
+			UpdatedAt: cr.UpdatedAt,
+		})
+	}
+	return Response{CannedResponses: result}, nil
+}
@@ -0,0 +1,103 @@
+package getcannedresponses_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	getcannedresponses "github.com/zestagio/chat-service/internal/usecases/manager/get-canned-responses"
+	getcannedresponsesmocks "github.com/zestagio/chat-service/internal/usecases/manager/get-canned-responses/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl       *gomock.Controller
+	cannedRepo *getcannedresponsesmocks.MockcannedResponsesRepository
+	uCase      getcannedresponses.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.cannedRepo = getcannedresponsesmocks.NewMockcannedResponsesRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = getcannedresponses.New(getcannedresponses.NewOptions(s.cannedRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := getcannedresponses.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, getcannedresponses.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestSearchError() {
+	// Arrange.
+	managerID := types.NewUserID()
+
+	s.cannedRepo.EXPECT().Search(s.Ctx, managerID, "he", gomock.Any()).Return(nil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, getcannedresponses.Request{
+		ID:             types.NewRequestID(),
+		ManagerID:      managerID,
+		ShortcutPrefix: "he",
+	})
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	managerID := types.NewUserID()
+	teamLeadID := types.NewUserID()
+	crID1 := types.NewCannedResponseID()
+	crID2 := types.NewCannedResponseID()
+	updatedAt := time.Now()
+
+	s.cannedRepo.EXPECT().Search(s.Ctx, managerID, "he", gomock.Any()).Return([]cannedresponsesrepo.CannedResponse{
+		{ID: crID1, AuthorID: managerID, Shortcut: "hello", Body: "Hello!", UpdatedAt: updatedAt},
+		{ID: crID2, AuthorID: teamLeadID, IsShared: true, Shortcut: "help", Body: "How can I help?", UpdatedAt: updatedAt},
+	}, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, getcannedresponses.Request{
+		ID:             types.NewRequestID(),
+		ManagerID:      managerID,
+		ShortcutPrefix: "he",
+	})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal([]getcannedresponses.CannedResponse{
+		{ID: crID1, AuthorID: managerID, Shortcut: "hello", Body: "Hello!", UpdatedAt: updatedAt},
+		{ID: crID2, AuthorID: teamLeadID, IsShared: true, Shortcut: "help", Body: "How can I help?", UpdatedAt: updatedAt},
+	}, resp.CannedResponses)
+}
@@ -38,8 +38,30 @@ func TestRequest_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "around message",
+			request: getchathistory.Request{
+				ID:              types.NewRequestID(),
+				ManagerID:       types.NewUserID(),
+				ChatID:          types.NewChatID(),
+				PageSize:        50,
+				AroundMessageID: types.NewMessageID(),
+			},
+			wantErr: false,
+		},
 
 		// Negative.
+		{
+			name: "around message with cursor",
+			request: getchathistory.Request{
+				ID:              types.NewRequestID(),
+				ManagerID:       types.NewUserID(),
+				ChatID:          types.NewChatID(),
+				Cursor:          "eyJwYWdlX3NpemUiOjUwLCJsYXN0IjoxNjcwNTAyNTAyfQ==", // {"page_size":50,"last":1670502502}
+				AroundMessageID: types.NewMessageID(),
+			},
+			wantErr: true,
+		},
 		{
 			name: "neither cursor nor pagesize specified",
 			request: getchathistory.Request{
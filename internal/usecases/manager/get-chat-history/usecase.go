@@ -2,15 +2,29 @@ package getchathistory
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/zestagio/chat-service/internal/cursor"
 	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getchathistorymocks
 
+var (
+	ErrMessageNotFound = errors.New("message not found")
+	ErrProblemNotFound = errors.New("problem not found")
+)
+
+type cursorCodec interface {
+	Encode(data any) (string, error)
+	Decode(in string, to any) error
+}
+
+type attachmentsService interface {
+	URLs(a messagesrepo.Attachment, viewerID types.UserID) (fileURL, thumbnailURL string)
+}
+
 type messagesRepository interface {
 	GetProblemMessages(
 		ctx context.Context,
@@ -18,16 +32,30 @@ type messagesRepository interface {
 		pageSize int,
 		cursor *messagesrepo.Cursor,
 	) ([]messagesrepo.Message, *messagesrepo.Cursor, error)
+	GetProblemMessagesAround(
+		ctx context.Context,
+		problemID types.ProblemID,
+		msgID types.MessageID,
+		pageSize int,
+	) ([]messagesrepo.Message, *messagesrepo.Cursor, *messagesrepo.Cursor, error)
 }
 
 type problemsRepository interface {
 	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+	IsProblemOfHandledChat(
+		ctx context.Context,
+		managerID types.UserID,
+		chatID types.ChatID,
+		problemID types.ProblemID,
+	) (bool, error)
 }
 
 //go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
 type Options struct {
-	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
-	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	attachmentsSvc attachmentsService `option:"mandatory" validate:"required"`
+	cursorCodec    cursorCodec        `option:"mandatory" validate:"required"`
+	msgRepo        messagesRepository `option:"mandatory" validate:"required"`
+	problemsRepo   problemsRepository `option:"mandatory" validate:"required"`
 }
 
 type UseCase struct {
@@ -45,28 +73,29 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 
 	var c *messagesrepo.Cursor
 	if req.Cursor != "" {
-		if err := cursor.Decode(req.Cursor, &c); err != nil {
+		if err := u.cursorCodec.Decode(req.Cursor, &c); err != nil {
 			return Response{}, fmt.Errorf("decode cursor: %v", err)
 		}
 	}
 
-	currentProblemID, err := u.problemsRepo.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
+	problemID, err := u.getProblemID(ctx, req)
 	if err != nil {
-		return Response{}, fmt.Errorf("get assigned problem: %v", err)
+		return Response{}, err
 	}
 
-	msgs, next, err := u.msgRepo.GetProblemMessages(ctx, currentProblemID, req.PageSize, c)
+	msgs, older, newer, err := u.getMessages(ctx, problemID, req, c)
 	if err != nil {
-		return Response{}, fmt.Errorf("get manager chat messages: %v", err)
+		return Response{}, err
 	}
 
-	var nextCursor string
-	if next != nil {
-		data, err := cursor.Encode(next)
-		if err != nil {
-			return Response{}, fmt.Errorf("encode next cursor: %v", err)
-		}
-		nextCursor = data
+	nextCursor, err := u.encodeCursor(older)
+	if err != nil {
+		return Response{}, fmt.Errorf("encode next cursor: %v", err)
+	}
+
+	prevCursor, err := u.encodeCursor(newer)
+	if err != nil {
+		return Response{}, fmt.Errorf("encode prev cursor: %v", err)
 	}
 
 	result := make([]Message, 0, len(msgs))
@@ -76,11 +105,124 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 			AuthorID:  m.AuthorID,
 			Body:      m.Body,
 			CreatedAt: m.CreatedAt,
+			EditedAt:  m.EditedAt,
+			DeletedAt: m.DeletedAt,
+
+			Attachments: u.adaptAttachments(m.Attachments, req.ManagerID),
+			Reactions:   adaptReactions(messagesrepo.CountReactions(m.Reactions, req.ManagerID)),
+			ReplyTo:     adaptQuote(m.ReplyTo.ForManager()),
+
+			IsInternalNote: m.IsInternalNote,
 		})
 	}
 
 	return Response{
 		Messages:   result,
 		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
 	}, nil
 }
+
+// getProblemID returns the requested problem if the manager has handled its chat,
+// otherwise the problem currently assigned to the manager.
+func (u UseCase) getProblemID(ctx context.Context, req Request) (types.ProblemID, error) {
+	if req.ProblemID.IsZero() {
+		problemID, err := u.problemsRepo.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
+		if err != nil {
+			return types.ProblemIDNil, fmt.Errorf("get assigned problem: %v", err)
+		}
+		return problemID, nil
+	}
+
+	ok, err := u.problemsRepo.IsProblemOfHandledChat(ctx, req.ManagerID, req.ChatID, req.ProblemID)
+	if err != nil {
+		return types.ProblemIDNil, fmt.Errorf("check problem of handled chat: %v", err)
+	}
+	if !ok {
+		return types.ProblemIDNil, fmt.Errorf("%w: %v of not handled chat", ErrProblemNotFound, req.ProblemID)
+	}
+	return req.ProblemID, nil
+}
+
+// getMessages returns the requested page and the cursors to the older and the newer messages.
+func (u UseCase) getMessages(
+	ctx context.Context,
+	problemID types.ProblemID,
+	req Request,
+	c *messagesrepo.Cursor,
+) (msgs []messagesrepo.Message, older, newer *messagesrepo.Cursor, err error) {
+	if !req.AroundMessageID.IsZero() {
+		msgs, older, newer, err = u.msgRepo.GetProblemMessagesAround(ctx, problemID, req.AroundMessageID, req.PageSize)
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrMsgNotFound) {
+				return nil, nil, nil, fmt.Errorf("get manager chat messages around: %w: %v", ErrMessageNotFound, err)
+			}
+			return nil, nil, nil, fmt.Errorf("get manager chat messages around: %v", err)
+		}
+		return msgs, older, newer, nil
+	}
+
+	msgs, next, err := u.msgRepo.GetProblemMessages(ctx, problemID, req.PageSize, c)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("get manager chat messages: %v", err)
+	}
+
+	if c != nil && c.Forward {
+		return msgs, messagesrepo.BackCursor(c, msgs), next, nil
+	}
+	return msgs, next, messagesrepo.BackCursor(c, msgs), nil
+}
+
+func (u UseCase) encodeCursor(c *messagesrepo.Cursor) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	return u.cursorCodec.Encode(c)
+}
+
+func (u UseCase) adaptAttachments(aa []messagesrepo.Attachment, viewerID types.UserID) []Attachment {
+	if len(aa) == 0 {
+		return nil
+	}
+
+	result := make([]Attachment, 0, len(aa))
+	for _, a := range aa {
+		url, thumbnailURL := u.attachmentsSvc.URLs(a, viewerID)
+		result = append(result, Attachment{
+			ID:           a.ID,
+			FileName:     a.FileName,
+			ContentType:  a.ContentType,
+			Size:         a.Size,
+			URL:          url,
+			ThumbnailURL: thumbnailURL,
+		})
+	}
+	return result
+}
+
+func adaptReactions(counts []messagesrepo.ReactionsCount) []Reaction {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	result := make([]Reaction, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, Reaction{
+			Kind:        string(c.Kind),
+			Count:       c.Count,
+			ReactedByMe: c.ReactedByMe,
+		})
+	}
+	return result
+}
+
+func adaptQuote(q *messagesrepo.Quote) *Quote {
+	if q == nil {
+		return nil
+	}
+	return &Quote{
+		MessageID: q.MessageID,
+		AuthorID:  q.AuthorID,
+		Preview:   q.Preview,
+	}
+}
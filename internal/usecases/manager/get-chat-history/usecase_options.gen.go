@@ -11,6 +11,8 @@ import (
 type OptOptionsSetter func(o *Options)
 
 func NewOptions(
+	attachmentsSvc attachmentsService,
+	cursorCodec cursorCodec,
 	msgRepo messagesRepository,
 	problemsRepo problemsRepository,
 	options ...OptOptionsSetter,
@@ -19,6 +21,10 @@ func NewOptions(
 
 	// Setting defaults from field tag (if present)
 
+	o.attachmentsSvc = attachmentsSvc
+
+	o.cursorCodec = cursorCodec
+
 	o.msgRepo = msgRepo
 
 	o.problemsRepo = problemsRepo
@@ -31,11 +37,27 @@ func NewOptions(
 
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("attachmentsSvc", _validate_Options_attachmentsSvc(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("cursorCodec", _validate_Options_cursorCodec(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
 	return errs.AsError()
 }
 
+func _validate_Options_attachmentsSvc(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.attachmentsSvc, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `attachmentsSvc` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_cursorCodec(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.cursorCodec, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `cursorCodec` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_msgRepo(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
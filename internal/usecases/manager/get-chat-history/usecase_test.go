@@ -20,10 +20,12 @@ import (
 type UseCaseSuite struct {
 	testingh.ContextSuite
 
-	ctrl        *gomock.Controller
-	problemRepo *getchathistorymocks.MockproblemsRepository
-	msgRepo     *getchathistorymocks.MockmessagesRepository
-	uCase       getchathistory.UseCase
+	ctrl           *gomock.Controller
+	codec          *cursor.Codec
+	attachmentsSvc *getchathistorymocks.MockattachmentsService
+	problemRepo    *getchathistorymocks.MockproblemsRepository
+	msgRepo        *getchathistorymocks.MockmessagesRepository
+	uCase          getchathistory.UseCase
 }
 
 func TestUseCaseSuite(t *testing.T) {
@@ -33,11 +35,15 @@ func TestUseCaseSuite(t *testing.T) {
 
 func (s *UseCaseSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
+	s.attachmentsSvc = getchathistorymocks.NewMockattachmentsService(s.ctrl)
 	s.problemRepo = getchathistorymocks.NewMockproblemsRepository(s.ctrl)
 	s.msgRepo = getchathistorymocks.NewMockmessagesRepository(s.ctrl)
 
 	var err error
-	s.uCase, err = getchathistory.New(getchathistory.NewOptions(s.msgRepo, s.problemRepo))
+	s.codec, err = cursor.NewCodec(cursor.NewOptions("0123456789abcdef"))
+	s.Require().NoError(err)
+
+	s.uCase, err = getchathistory.New(getchathistory.NewOptions(s.attachmentsSvc, s.codec, s.msgRepo, s.problemRepo))
 	s.Require().NoError(err)
 
 	s.ContextSuite.SetupTest()
@@ -80,6 +86,26 @@ func (s *UseCaseSuite) TestCursorDecodingError() {
 	s.Empty(resp.NextCursor)
 }
 
+func (s *UseCaseSuite) TestUnsignedCursor() {
+	// Arrange.
+	unsigned, err := cursor.Encode(messagesrepo.Cursor{PageSize: 100, LastCreatedAt: time.Now()})
+	s.Require().NoError(err)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		ChatID:    types.NewChatID(),
+		Cursor:    unsigned,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorContains(err, cursor.ErrInvalidSignature.Error())
+	s.Empty(resp.Messages)
+}
+
 func (s *UseCaseSuite) TestGetAssignedProblemID_Error() {
 	// Arrange.
 	managerID := types.NewUserID()
@@ -166,6 +192,161 @@ func (s *UseCaseSuite) TestGetProblemMessages_Success_SinglePage() {
 	}
 }
 
+func (s *UseCaseSuite) TestGetProblemMessages_Success_Attachments() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	msgs := s.createMessages(1, chatID)
+	a := messagesrepo.Attachment{
+		ID:          types.NewAttachmentID(),
+		ChatID:      chatID,
+		MessageID:   msgs[0].ID,
+		UploadedBy:  msgs[0].AuthorID,
+		FileName:    "statement.pdf",
+		ContentType: "application/pdf",
+		Size:        2048,
+		StorageKey:  "key",
+	}
+	msgs[0].Attachments = []messagesrepo.Attachment{a}
+
+	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, problemID, 10, (*messagesrepo.Cursor)(nil)).
+		Return(msgs, nil, nil)
+	s.attachmentsSvc.EXPECT().URLs(a, managerID).Return("/attachments/1", "")
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		PageSize:  10,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Messages, 1)
+	s.Equal([]getchathistory.Attachment{{
+		ID:          a.ID,
+		FileName:    "statement.pdf",
+		ContentType: "application/pdf",
+		Size:        2048,
+		URL:         "/attachments/1",
+	}}, resp.Messages[0].Attachments)
+}
+
+func (s *UseCaseSuite) TestGetProblemMessages_Success_Reactions() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	msgs := s.createMessages(1, chatID)
+	msgs[0].Reactions = []messagesrepo.Reaction{
+		{ID: types.NewMessageReactionID(), MessageID: msgs[0].ID, UserID: msgs[0].AuthorID, Kind: messagesrepo.ReactionKindThumbsUp},
+	}
+
+	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, problemID, 10, (*messagesrepo.Cursor)(nil)).
+		Return(msgs, nil, nil)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		PageSize:  10,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Messages, 1)
+	s.Equal([]getchathistory.Reaction{
+		{Kind: "thumbs_up", Count: 1, ReactedByMe: false},
+	}, resp.Messages[0].Reactions)
+}
+
+func (s *UseCaseSuite) TestGetProblemMessages_Success_ReplyTo() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	msgs := s.createMessages(2, chatID)
+	msgs[0].ReplyTo = &messagesrepo.Quote{
+		MessageID:           msgs[1].ID,
+		AuthorID:            msgs[1].AuthorID,
+		Preview:             "Where is my order?",
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+	}
+	msgs[1].ReplyTo = &messagesrepo.Quote{
+		MessageID:          types.NewMessageID(),
+		Preview:            "Blocked",
+		IsVisibleForClient: true,
+	}
+
+	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, problemID, 10, (*messagesrepo.Cursor)(nil)).
+		Return(msgs, nil, nil)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		PageSize:  10,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Messages, 2)
+	s.Equal(&getchathistory.Quote{
+		MessageID: msgs[1].ID,
+		AuthorID:  msgs[1].AuthorID,
+		Preview:   "Where is my order?",
+	}, resp.Messages[0].ReplyTo)
+	s.Equal(&getchathistory.Quote{MessageID: msgs[1].ReplyTo.MessageID}, resp.Messages[1].ReplyTo)
+}
+
+func (s *UseCaseSuite) TestGetProblemMessages_Success_InternalNote() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	msgs := s.createMessages(2, chatID)
+	msgs[1].IsVisibleForClient = false
+	msgs[1].IsInternalNote = true
+
+	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, problemID, 10, (*messagesrepo.Cursor)(nil)).
+		Return(msgs, nil, nil)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		PageSize:  10,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Messages, 2)
+	s.False(resp.Messages[0].IsInternalNote)
+	s.True(resp.Messages[1].IsInternalNote)
+}
+
 func (s *UseCaseSuite) TestGetProblemMessages_Success_FirstPage() {
 	// Arrange.
 	const messagesCount = 10
@@ -195,6 +376,7 @@ func (s *UseCaseSuite) TestGetProblemMessages_Success_FirstPage() {
 
 	// Assert.
 	s.NotEmpty(resp.NextCursor)
+	s.Empty(resp.PrevCursor)
 	s.Require().Len(resp.Messages, messagesCount)
 }
 
@@ -213,7 +395,7 @@ func (s *UseCaseSuite) TestGetClientChatMessages_Success_LastPage() {
 	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, problemID, 0, messagesrepo.NewCursorMatcher(c)).
 		Return(expectedMsgs, nil, nil)
 
-	cursorStr, err := cursor.Encode(c)
+	cursorStr, err := s.codec.Encode(c)
 	s.Require().NoError(err)
 
 	req := getchathistory.Request{
@@ -229,7 +411,157 @@ func (s *UseCaseSuite) TestGetClientChatMessages_Success_LastPage() {
 
 	// Assert.
 	s.Empty(resp.NextCursor)
+	s.NotEmpty(resp.PrevCursor)
+	s.Require().Len(resp.Messages, messagesCount)
+}
+
+func (s *UseCaseSuite) TestGetProblemMessages_Success_ForwardPage() {
+	// Arrange.
+	const messagesCount = 10
+
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	expectedMsgs := s.createMessages(messagesCount, chatID)
+	c := messagesrepo.Cursor{PageSize: messagesCount, LastCreatedAt: time.Now().Add(-time.Hour), Forward: true}
+	newer := &messagesrepo.Cursor{PageSize: messagesCount, LastCreatedAt: expectedMsgs[0].CreatedAt, Forward: true}
+	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, problemID, 0, messagesrepo.NewCursorMatcher(c)).
+		Return(expectedMsgs, newer, nil)
+
+	cursorStr, err := s.codec.Encode(c)
+	s.Require().NoError(err)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		Cursor:    cursorStr,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+	s.Require().NoError(err)
+
+	// Assert.
 	s.Require().Len(resp.Messages, messagesCount)
+
+	var next, prev messagesrepo.Cursor
+	s.Require().NoError(s.codec.Decode(resp.NextCursor, &next))
+	s.Require().NoError(s.codec.Decode(resp.PrevCursor, &prev))
+	s.False(next.Forward)
+	s.True(next.LastCreatedAt.Equal(expectedMsgs[messagesCount-1].CreatedAt))
+	s.True(prev.Forward)
+	s.True(prev.LastCreatedAt.Equal(expectedMsgs[0].CreatedAt))
+}
+
+func (s *UseCaseSuite) TestGetProblemMessagesAround_MessageNotFound() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	msgID := types.NewMessageID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+	s.msgRepo.EXPECT().GetProblemMessagesAround(s.Ctx, problemID, msgID, 20).
+		Return(nil, nil, nil, messagesrepo.ErrMsgNotFound)
+
+	req := getchathistory.Request{
+		ID:              types.NewRequestID(),
+		ManagerID:       managerID,
+		ChatID:          chatID,
+		PageSize:        20,
+		AroundMessageID: msgID,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getchathistory.ErrMessageNotFound)
+}
+
+func (s *UseCaseSuite) TestGetProblemMessagesAround_Success() {
+	// Arrange.
+	const messagesCount = 10
+
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	expectedMsgs := s.createMessages(messagesCount, chatID)
+	msgID := expectedMsgs[messagesCount/2].ID
+	older := &messagesrepo.Cursor{PageSize: messagesCount, LastCreatedAt: expectedMsgs[messagesCount-1].CreatedAt}
+	s.msgRepo.EXPECT().GetProblemMessagesAround(s.Ctx, problemID, msgID, messagesCount).
+		Return(expectedMsgs, older, nil, nil)
+
+	req := getchathistory.Request{
+		ID:              types.NewRequestID(),
+		ManagerID:       managerID,
+		ChatID:          chatID,
+		PageSize:        messagesCount,
+		AroundMessageID: msgID,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Require().Len(resp.Messages, messagesCount)
+	s.NotEmpty(resp.NextCursor)
+	s.Empty(resp.PrevCursor)
+}
+
+func (s *UseCaseSuite) TestGetProblemMessages_ProblemOfHandledChat() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	resolvedProblemID := types.NewProblemID()
+	s.problemRepo.EXPECT().IsProblemOfHandledChat(gomock.Any(), managerID, chatID, resolvedProblemID).Return(true, nil)
+
+	expectedMsgs := s.createMessages(3, chatID)
+	s.msgRepo.EXPECT().GetProblemMessages(s.Ctx, resolvedProblemID, 10, (*messagesrepo.Cursor)(nil)).
+		Return(expectedMsgs, nil, nil)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		PageSize:  10,
+		ProblemID: resolvedProblemID,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Len(resp.Messages, len(expectedMsgs))
+}
+
+func (s *UseCaseSuite) TestGetProblemMessages_ProblemOfNotHandledChat() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().IsProblemOfHandledChat(gomock.Any(), managerID, chatID, problemID).Return(false, nil)
+
+	req := getchathistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		PageSize:  10,
+		ProblemID: problemID,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getchathistory.ErrProblemNotFound)
+	s.Empty(resp.Messages)
 }
 
 func (s *UseCaseSuite) createMessages(count int, chatID types.ChatID) []messagesrepo.Message {
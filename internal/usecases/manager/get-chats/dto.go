@@ -1,6 +1,8 @@
 package getchats
 
 import (
+	"time"
+
 	"github.com/zestagio/chat-service/internal/types"
 	"github.com/zestagio/chat-service/internal/validator"
 )
@@ -21,4 +23,18 @@ type Response struct {
 type Chat struct {
 	ID       types.ChatID
 	ClientID types.UserID
+
+	ProblemCreatedAt time.Time
+	UnreadCount      int
+	LastMessage      *LastMessage    // Nil if there are no messages yet.
+	WaitingSince     time.Time       // Zero if the client is not waiting for the answer.
+	Category         string          // Empty until the manager sets it.
+	ReopenedFromID   types.ProblemID // Zero if the problem is not a continuation of the resolved one.
+}
+
+type LastMessage struct {
+	ID        types.MessageID
+	AuthorID  types.UserID // Zero if the message is a service one.
+	Preview   string
+	CreatedAt time.Time
 }
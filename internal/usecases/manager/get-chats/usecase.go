@@ -40,8 +40,14 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 	result := make([]Chat, 0, len(openChats))
 	for _, c := range openChats {
 		result = append(result, Chat{
-			ID:       c.ID,
-			ClientID: c.ClientID,
+			ID:               c.ID,
+			ClientID:         c.ClientID,
+			ProblemCreatedAt: c.ProblemCreatedAt,
+			UnreadCount:      c.UnreadCount,
+			LastMessage:      adaptLastMessage(c.LastMessage),
+			WaitingSince:     c.WaitingSince,
+			Category:         c.Category,
+			ReopenedFromID:   c.ReopenedFromID,
 		})
 	}
 
@@ -49,3 +55,15 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 		Chats: result,
 	}, nil
 }
+
+func adaptLastMessage(m *chatsrepo.LastMessage) *LastMessage {
+	if m == nil {
+		return nil
+	}
+	return &LastMessage{
+		ID:        m.ID,
+		AuthorID:  m.AuthorID,
+		Preview:   m.Preview,
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -3,6 +3,7 @@ package getchats_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -77,10 +78,26 @@ func (s *UseCaseSuite) TestSuccessStory() {
 	// Arrange.
 	managerID := types.NewUserID()
 
+	now := time.Now()
+	reopenedFromID := types.NewProblemID()
+	lastMsgID, clientID := types.NewMessageID(), types.NewUserID()
 	repoResp := []chatsrepo.Chat{
-		{ID: types.NewChatID(), ClientID: types.NewUserID()},
-		{ID: types.NewChatID(), ClientID: types.NewUserID()},
-		{ID: types.NewChatID(), ClientID: types.NewUserID()},
+		{
+			ID:               types.NewChatID(),
+			ClientID:         clientID,
+			ProblemCreatedAt: now.Add(-time.Hour),
+			UnreadCount:      2,
+			LastMessage: &chatsrepo.LastMessage{
+				ID:        lastMsgID,
+				AuthorID:  clientID,
+				Preview:   "Where is my money?",
+				CreatedAt: now.Add(-time.Minute),
+			},
+			WaitingSince: now.Add(-2 * time.Minute),
+			Category:     "cards",
+		},
+		{ID: types.NewChatID(), ClientID: types.NewUserID(), ProblemCreatedAt: now.Add(-time.Minute)},
+		{ID: types.NewChatID(), ClientID: types.NewUserID(), ProblemCreatedAt: now, ReopenedFromID: reopenedFromID},
 	}
 	s.chatsRepoMock.EXPECT().GetChatsWithOpenProblems(gomock.Any(), managerID).Return(repoResp, nil)
 
@@ -96,9 +113,22 @@ func (s *UseCaseSuite) TestSuccessStory() {
 	s.Require().NoError(err)
 	s.Equal(getchats.Response{
 		Chats: []getchats.Chat{
-			{ID: repoResp[0].ID, ClientID: repoResp[0].ClientID},
-			{ID: repoResp[1].ID, ClientID: repoResp[1].ClientID},
-			{ID: repoResp[2].ID, ClientID: repoResp[2].ClientID},
+			{
+				ID:               repoResp[0].ID,
+				ClientID:         clientID,
+				ProblemCreatedAt: now.Add(-time.Hour),
+				UnreadCount:      2,
+				LastMessage: &getchats.LastMessage{
+					ID:        lastMsgID,
+					AuthorID:  clientID,
+					Preview:   "Where is my money?",
+					CreatedAt: now.Add(-time.Minute),
+				},
+				WaitingSince: now.Add(-2 * time.Minute),
+				Category:     "cards",
+			},
+			{ID: repoResp[1].ID, ClientID: repoResp[1].ClientID, ProblemCreatedAt: now.Add(-time.Minute)},
+			{ID: repoResp[2].ID, ClientID: repoResp[2].ClientID, ProblemCreatedAt: now, ReopenedFromID: reopenedFromID},
 		},
 	}, resp)
 }
@@ -0,0 +1,34 @@
+package getsatisfactionscores
+
+import (
+	"time"
+
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID          types.RequestID `validate:"required"`
+	ManagerID   types.UserID    `validate:"required"`
+	From        time.Time       `validate:"required"`
+	To          time.Time       `validate:"required,gtfield=From"`
+	AllManagers bool
+
+	// CanSeeAllManagers is true if the manager is allowed to see the scores of the whole team.
+	CanSeeAllManagers bool
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	Scores []ManagerScore
+}
+
+type ManagerScore struct {
+	ManagerID      types.UserID
+	RatingsCount   int
+	AverageScore   float64
+	SatisfiedCount int
+}
@@ -0,0 +1,97 @@
+package getsatisfactionscores_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	getsatisfactionscores "github.com/zestagio/chat-service/internal/usecases/manager/get-satisfaction-scores"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	to := time.Now()
+	from := to.Add(-7 * 24 * time.Hour)
+
+	cases := []struct {
+		name    string
+		request getsatisfactionscores.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: getsatisfactionscores.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				From:      from,
+				To:        to,
+			},
+			wantErr: false,
+		},
+		{
+			name: "all managers",
+			request: getsatisfactionscores.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				From:        from,
+				To:          to,
+				AllManagers: true,
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: getsatisfactionscores.Request{
+				ID:        types.RequestIDNil,
+				ManagerID: types.NewUserID(),
+				From:      from,
+				To:        to,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: getsatisfactionscores.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.UserIDNil,
+				From:      from,
+				To:        to,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require from",
+			request: getsatisfactionscores.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				To:        to,
+			},
+			wantErr: true,
+		},
+		{
+			name: "period end before start",
+			request: getsatisfactionscores.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				From:      to,
+				To:        from,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
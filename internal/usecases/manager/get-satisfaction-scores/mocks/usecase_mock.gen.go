@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package getsatisfactionscoresmocks is a generated GoMock package.
+package getsatisfactionscoresmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSatisfactionScores mocks base method.
+func (m *MockproblemsRepository) GetSatisfactionScores(ctx context.Context, from, to time.Time, managerID types.UserID) ([]problemsrepo.ManagerSatisfaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSatisfactionScores", ctx, from, to, managerID)
+	ret0, _ := ret[0].([]problemsrepo.ManagerSatisfaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSatisfactionScores indicates an expected call of GetSatisfactionScores.
+func (mr *MockproblemsRepositoryMockRecorder) GetSatisfactionScores(ctx, from, to, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSatisfactionScores", reflect.TypeOf((*MockproblemsRepository)(nil).GetSatisfactionScores), ctx, from, to, managerID)
+}
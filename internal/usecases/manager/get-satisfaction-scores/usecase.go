@@ -0,0 +1,70 @@
+package getsatisfactionscores
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getsatisfactionscoresmocks
+
+var (
+	ErrInvalidRequest = errors.New("invalid request")
+	ErrForbidden      = errors.New("scores of all managers are available to team leads")
+)
+
+type problemsRepository interface {
+	GetSatisfactionScores(
+		ctx context.Context,
+		from, to time.Time,
+		managerID types.UserID,
+	) ([]problemsrepo.ManagerSatisfaction, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+}
+
+// UseCase aggregates the client ratings of the resolved problems per manager for the period.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	managerID := req.ManagerID
+	if req.AllManagers {
+		if !req.CanSeeAllManagers {
+			return Response{}, ErrForbidden
+		}
+		managerID = types.UserIDNil
+	}
+
+	scores, err := u.problemsRepo.GetSatisfactionScores(ctx, req.From, req.To, managerID)
+	if err != nil {
+		return Response{}, fmt.Errorf("get satisfaction scores: %v", err)
+	}
+
+	result := make([]ManagerScore, 0, len(scores))
+	for _, s := range scores {
+		result = append(result, ManagerScore{
+			ManagerID:      s.ManagerID,
+			RatingsCount:   s.RatingsCount,
+			AverageScore:   s.AverageScore,
+			SatisfiedCount: s.SatisfiedCount,
+		})
+	}
+	return Response{Scores: result}, nil
+}
@@ -0,0 +1,160 @@
+package getsatisfactionscores_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	getsatisfactionscores "github.com/zestagio/chat-service/internal/usecases/manager/get-satisfaction-scores"
+	getsatisfactionscoresmocks "github.com/zestagio/chat-service/internal/usecases/manager/get-satisfaction-scores/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	problemsRepo *getsatisfactionscoresmocks.MockproblemsRepository
+	uCase        getsatisfactionscores.UseCase
+
+	from time.Time
+	to   time.Time
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.problemsRepo = getsatisfactionscoresmocks.NewMockproblemsRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = getsatisfactionscores.New(getsatisfactionscores.NewOptions(s.problemsRepo))
+	s.Require().NoError(err)
+
+	s.to = time.Now()
+	s.from = s.to.Add(-30 * 24 * time.Hour)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := getsatisfactionscores.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, getsatisfactionscores.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestAllManagersForbidden() {
+	// Arrange.
+	req := getsatisfactionscores.Request{
+		ID:          types.NewRequestID(),
+		ManagerID:   types.NewUserID(),
+		From:        s.from,
+		To:          s.to,
+		AllManagers: true,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, getsatisfactionscores.ErrForbidden)
+}
+
+func (s *UseCaseSuite) TestRepoError() {
+	// Arrange.
+	managerID := types.NewUserID()
+
+	s.problemsRepo.EXPECT().GetSatisfactionScores(s.Ctx, s.from, s.to, managerID).
+		Return(nil, errors.New("unexpected"))
+
+	req := getsatisfactionscores.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		From:      s.from,
+		To:        s.to,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestOwnScores() {
+	// Arrange.
+	managerID := types.NewUserID()
+
+	s.problemsRepo.EXPECT().GetSatisfactionScores(s.Ctx, s.from, s.to, managerID).
+		Return([]problemsrepo.ManagerSatisfaction{
+			{ManagerID: managerID, RatingsCount: 4, AverageScore: 4.25, SatisfiedCount: 3},
+		}, nil)
+
+	req := getsatisfactionscores.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		From:      s.from,
+		To:        s.to,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal([]getsatisfactionscores.ManagerScore{
+		{ManagerID: managerID, RatingsCount: 4, AverageScore: 4.25, SatisfiedCount: 3},
+	}, resp.Scores)
+}
+
+func (s *UseCaseSuite) TestAllManagersScores() {
+	// Arrange.
+	manager1ID := types.NewUserID()
+	manager2ID := types.NewUserID()
+
+	s.problemsRepo.EXPECT().GetSatisfactionScores(s.Ctx, s.from, s.to, types.UserIDNil).
+		Return([]problemsrepo.ManagerSatisfaction{
+			{ManagerID: manager1ID, RatingsCount: 2, AverageScore: 5, SatisfiedCount: 2},
+			{ManagerID: manager2ID, RatingsCount: 1, AverageScore: 1, SatisfiedCount: 0},
+		}, nil)
+
+	req := getsatisfactionscores.Request{
+		ID:                types.NewRequestID(),
+		ManagerID:         manager1ID,
+		From:              s.from,
+		To:                s.to,
+		AllManagers:       true,
+		CanSeeAllManagers: true,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal([]getsatisfactionscores.ManagerScore{
+		{ManagerID: manager1ID, RatingsCount: 2, AverageScore: 5, SatisfiedCount: 2},
+		{ManagerID: manager2ID, RatingsCount: 1, AverageScore: 1, SatisfiedCount: 0},
+	}, resp.Scores)
+}
@@ -0,0 +1,52 @@
+package markchatasread
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=markchatasreadmocks
+
+var ErrAssignedProblemNotFound = errors.New("assigned problem not found")
+
+type problemsRepository interface {
+	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+	MarkReadByManager(ctx context.Context, problemID types.ProblemID) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+}
+
+// UseCase resets the unread counter of the chat in the manager chat list.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, err
+	}
+
+	problemID, err := u.problemsRepo.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
+	if err != nil {
+		if errors.Is(err, problemsrepo.ErrAssignedProblemNotFound) {
+			return Response{}, ErrAssignedProblemNotFound
+		}
+		return Response{}, fmt.Errorf("get assigned problem: %v", err)
+	}
+
+	if err := u.problemsRepo.MarkReadByManager(ctx, problemID); err != nil {
+		return Response{}, fmt.Errorf("mark read by manager: %v", err)
+	}
+	return Response{}, nil
+}
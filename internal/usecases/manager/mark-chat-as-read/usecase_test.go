@@ -0,0 +1,143 @@
+package markchatasread_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	markchatasread "github.com/zestagio/chat-service/internal/usecases/manager/mark-chat-as-read"
+	markchatasreadmocks "github.com/zestagio/chat-service/internal/usecases/manager/mark-chat-as-read/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	problemRepo *markchatasreadmocks.MockproblemsRepository
+	uCase       markchatasread.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.problemRepo = markchatasreadmocks.NewMockproblemsRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = markchatasread.New(markchatasread.NewOptions(s.problemRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := markchatasread.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestGetAssignedProblemID_UnexpectedError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).
+		Return(types.ProblemIDNil, errors.New("unexpected"))
+
+	req := markchatasread.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.Require().NotErrorIs(err, markchatasread.ErrAssignedProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestGetAssignedProblemID_ProblemNotFoundError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrAssignedProblemNotFound)
+
+	req := markchatasread.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, markchatasread.ErrAssignedProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestMarkReadByManagerError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+	s.problemRepo.EXPECT().MarkReadByManager(gomock.Any(), problemID).Return(errors.New("unexpected"))
+
+	req := markchatasread.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+	s.problemRepo.EXPECT().MarkReadByManager(gomock.Any(), problemID).Return(nil)
+
+	req := markchatasread.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
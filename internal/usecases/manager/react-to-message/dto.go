@@ -0,0 +1,36 @@
+package reacttomessage
+
+import (
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Operation string
+
+const (
+	OperationAdd    Operation = "add"
+	OperationRemove Operation = "remove"
+)
+
+type Request struct {
+	ID        types.RequestID `validate:"required"`
+	ManagerID types.UserID    `validate:"required"`
+	MessageID types.MessageID `validate:"required"`
+	Kind      string          `validate:"required,oneof=thumbs_up thumbs_down heart laugh surprised sad"`
+	Operation Operation       `validate:"required,oneof=add remove"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	MessageID types.MessageID
+	Reactions []Reaction // The actual reactions on the message.
+}
+
+type Reaction struct {
+	Kind        string
+	Count       int
+	ReactedByMe bool
+}
@@ -0,0 +1,120 @@
+package reacttomessage_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	reacttomessage "github.com/zestagio/chat-service/internal/usecases/manager/react-to-message"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request reacttomessage.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: reacttomessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				MessageID: types.NewMessageID(),
+				Kind:      "thumbs_up",
+				Operation: reacttomessage.OperationAdd,
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: reacttomessage.Request{
+				ID:        types.RequestIDNil,
+				ManagerID: types.NewUserID(),
+				MessageID: types.NewMessageID(),
+				Kind:      "thumbs_up",
+				Operation: reacttomessage.OperationAdd,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: reacttomessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.UserIDNil,
+				MessageID: types.NewMessageID(),
+				Kind:      "thumbs_up",
+				Operation: reacttomessage.OperationAdd,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require message id",
+			request: reacttomessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				MessageID: types.MessageIDNil,
+				Kind:      "thumbs_up",
+				Operation: reacttomessage.OperationAdd,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require kind",
+			request: reacttomessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				MessageID: types.NewMessageID(),
+				Kind:      "",
+				Operation: reacttomessage.OperationAdd,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown kind",
+			request: reacttomessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				MessageID: types.NewMessageID(),
+				Kind:      "👍",
+				Operation: reacttomessage.OperationAdd,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require operation",
+			request: reacttomessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				MessageID: types.NewMessageID(),
+				Kind:      "thumbs_up",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown operation",
+			request: reacttomessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				MessageID: types.NewMessageID(),
+				Kind:      "thumbs_up",
+				Operation: "toggle",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
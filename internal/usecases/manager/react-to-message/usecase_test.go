@@ -0,0 +1,236 @@
+package reacttomessage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	reactionschangedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/reactions-changed"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	reacttomessage "github.com/zestagio/chat-service/internal/usecases/manager/react-to-message"
+	reacttomessagemocks "github.com/zestagio/chat-service/internal/usecases/manager/react-to-message/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	msgRepo      *reacttomessagemocks.MockmessagesRepository
+	outBoxSvc    *reacttomessagemocks.MockoutboxService
+	problemsRepo *reacttomessagemocks.MockproblemsRepository
+	txtor        *reacttomessagemocks.Mocktransactor
+	uCase        reacttomessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = reacttomessagemocks.NewMockmessagesRepository(s.ctrl)
+	s.outBoxSvc = reacttomessagemocks.NewMockoutboxService(s.ctrl)
+	s.problemsRepo = reacttomessagemocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = reacttomessagemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = reacttomessage.New(reacttomessage.NewOptions(s.msgRepo, s.outBoxSvc, s.problemsRepo, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := reacttomessage.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, reacttomessage.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestMessageNotFound() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(nil, messagesrepo.ErrMsgNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, reacttomessage.ErrMessageNotFound)
+}
+
+func (s *UseCaseSuite) TestMessageCannotBeReacted() {
+	for _, tt := range []struct {
+		name   string
+		modify func(m *messagesrepo.Message)
+	}{
+		{name: "invisible message", modify: func(m *messagesrepo.Message) { m.IsVisibleForManager = false }},
+		{name: "service message", modify: func(m *messagesrepo.Message) { m.AuthorID, m.IsService = types.UserIDNil, true }},
+		{name: "deleted message", modify: func(m *messagesrepo.Message) { m.DeletedAt = time.Now() }},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			req := s.newRequest()
+			msg := s.newMessage(req)
+			tt.modify(&msg)
+
+			s.expectTx()
+			s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+
+			// Action.
+			_, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().ErrorIs(err, reacttomessage.ErrMessageNotFound)
+		})
+	}
+}
+
+func (s *UseCaseSuite) TestChatNotAssigned() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrAssignedProblemNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, reacttomessage.ErrMessageNotFound)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).
+		Return(msg.ProblemID, nil)
+	s.msgRepo.EXPECT().AddReaction(gomock.Any(), msg.ID, req.ManagerID, messagesrepo.ReactionKind(req.Kind)).Return(true, nil)
+	payload := reactionschangedjob.MustMarshalPayload(msg.ID, req.ID)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), reactionschangedjob.Name, payload, gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	msg := s.newMessage(req)
+	reactions := s.newReactions(req)
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).
+		Return(msg.ProblemID, nil)
+	s.msgRepo.EXPECT().AddReaction(gomock.Any(), msg.ID, req.ManagerID, messagesrepo.ReactionKind(req.Kind)).Return(true, nil)
+	payload := reactionschangedjob.MustMarshalPayload(msg.ID, req.ID)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), reactionschangedjob.Name, payload, gomock.Any()).Return(types.NewJobID(), nil)
+	s.msgRepo.EXPECT().GetMessageReactions(gomock.Any(), msg.ID).Return(reactions, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.MessageID, resp.MessageID)
+	s.Equal([]reacttomessage.Reaction{
+		{Kind: "thumbs_up", Count: 2, ReactedByMe: true},
+		{Kind: "heart", Count: 1, ReactedByMe: false},
+	}, resp.Reactions)
+}
+
+func (s *UseCaseSuite) TestRemoveAbsentReaction() {
+	// Arrange.
+	req := s.newRequest()
+	req.Operation = reacttomessage.OperationRemove
+	msg := s.newMessage(req)
+	reactions := s.newReactions(req)
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), req.MessageID).Return(&msg, nil)
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, msg.ChatID).
+		Return(msg.ProblemID, nil)
+	s.msgRepo.EXPECT().RemoveReaction(gomock.Any(), msg.ID, req.ManagerID, messagesrepo.ReactionKind(req.Kind)).Return(false, nil)
+	s.msgRepo.EXPECT().GetMessageReactions(gomock.Any(), msg.ID).Return(reactions, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Len(resp.Reactions, 2)
+}
+
+func (s *UseCaseSuite) newRequest() reacttomessage.Request {
+	return reacttomessage.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		MessageID: types.NewMessageID(),
+		Kind:      "thumbs_up",
+		Operation: reacttomessage.OperationAdd,
+	}
+}
+
+func (s *UseCaseSuite) newMessage(req reacttomessage.Request) messagesrepo.Message {
+	return messagesrepo.Message{
+		ID:                  req.MessageID,
+		ChatID:              types.NewChatID(),
+		ProblemID:           types.NewProblemID(),
+		AuthorID:            types.NewUserID(),
+		Body:                "Please, confirm the payment in the app",
+		CreatedAt:           time.Now().Add(-time.Minute),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+		IsChecked:           true,
+		InitialRequestID:    types.NewRequestID(),
+	}
+}
+
+func (s *UseCaseSuite) newReactions(req reacttomessage.Request) []messagesrepo.Reaction {
+	otherID := types.NewUserID()
+	return []messagesrepo.Reaction{
+		{ID: types.NewMessageReactionID(), MessageID: req.MessageID, UserID: otherID, Kind: messagesrepo.ReactionKindThumbsUp},
+		{ID: types.NewMessageReactionID(), MessageID: req.MessageID, UserID: otherID, Kind: messagesrepo.ReactionKindHeart},
+		{ID: types.NewMessageReactionID(), MessageID: req.MessageID, UserID: req.ManagerID, Kind: messagesrepo.ReactionKindThumbsUp},
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
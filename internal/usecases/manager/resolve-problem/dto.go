@@ -9,6 +9,9 @@ type Request struct {
 	ID        types.RequestID `validate:"required"`
 	ManagerID types.UserID    `validate:"required"`
 	ChatID    types.ChatID    `validate:"required"`
+
+	ResolutionCode    string `validate:"required"`
+	ResolutionSummary string `validate:"max=1000"`
 }
 
 func (r Request) Validate() error {
@@ -1,6 +1,7 @@
 package resolveproblem_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,20 @@ func TestRequest_Validate(t *testing.T) {
 				ID:        types.NewRequestID(),
 				ManagerID: types.NewUserID(),
 				ChatID:    types.NewChatID(),
+
+				ResolutionCode: "answered",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid request with summary",
+			request: resolveproblem.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+
+				ResolutionCode:    "answered",
+				ResolutionSummary: strings.Repeat("a", 1000),
 			},
 			wantErr: false,
 		},
@@ -33,6 +48,8 @@ func TestRequest_Validate(t *testing.T) {
 				ID:        types.RequestIDNil,
 				ManagerID: types.NewUserID(),
 				ChatID:    types.NewChatID(),
+
+				ResolutionCode: "answered",
 			},
 			wantErr: true,
 		},
@@ -42,6 +59,8 @@ func TestRequest_Validate(t *testing.T) {
 				ID:        types.NewRequestID(),
 				ManagerID: types.UserIDNil,
 				ChatID:    types.NewChatID(),
+
+				ResolutionCode: "answered",
 			},
 			wantErr: true,
 		},
@@ -54,6 +73,27 @@ func TestRequest_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "require resolution code",
+			request: resolveproblem.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long summary",
+			request: resolveproblem.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+
+				ResolutionCode:    "answered",
+				ResolutionSummary: strings.Repeat("a", 1001),
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range cases {
@@ -128,17 +128,54 @@ func (mr *MockproblemsRepositoryMockRecorder) GetAssignedProblemID(ctx, managerI
 }
 
 // ResolveProblem mocks base method.
-func (m *MockproblemsRepository) ResolveProblem(ctx context.Context, requestID types.RequestID, problemID types.ProblemID) error {
+func (m *MockproblemsRepository) ResolveProblem(ctx context.Context, requestID types.RequestID, problemID types.ProblemID, resolutionCode, resolutionSummary string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResolveProblem", ctx, requestID, problemID)
+	ret := m.ctrl.Call(m, "ResolveProblem", ctx, requestID, problemID, resolutionCode, resolutionSummary)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ResolveProblem indicates an expected call of ResolveProblem.
-func (mr *MockproblemsRepositoryMockRecorder) ResolveProblem(ctx, requestID, problemID interface{}) *gomock.Call {
+func (mr *MockproblemsRepositoryMockRecorder) ResolveProblem(ctx, requestID, problemID, resolutionCode, resolutionSummary interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveProblem", reflect.TypeOf((*MockproblemsRepository)(nil).ResolveProblem), ctx, requestID, problemID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveProblem", reflect.TypeOf((*MockproblemsRepository)(nil).ResolveProblem), ctx, requestID, problemID, resolutionCode, resolutionSummary)
+}
+
+// MockproblemTaxonomy is a mock of problemTaxonomy interface.
+type MockproblemTaxonomy struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemTaxonomyMockRecorder
+}
+
+// MockproblemTaxonomyMockRecorder is the mock recorder for MockproblemTaxonomy.
+type MockproblemTaxonomyMockRecorder struct {
+	mock *MockproblemTaxonomy
+}
+
+// NewMockproblemTaxonomy creates a new mock instance.
+func NewMockproblemTaxonomy(ctrl *gomock.Controller) *MockproblemTaxonomy {
+	mock := &MockproblemTaxonomy{ctrl: ctrl}
+	mock.recorder = &MockproblemTaxonomyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemTaxonomy) EXPECT() *MockproblemTaxonomyMockRecorder {
+	return m.recorder
+}
+
+// HasResolutionCode mocks base method.
+func (m *MockproblemTaxonomy) HasResolutionCode(code string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasResolutionCode", code)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasResolutionCode indicates an expected call of HasResolutionCode.
+func (mr *MockproblemTaxonomyMockRecorder) HasResolutionCode(code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasResolutionCode", reflect.TypeOf((*MockproblemTaxonomy)(nil).HasResolutionCode), code)
 }
 
 // Mocktransactor is a mock of transactor interface.
@@ -14,7 +14,10 @@ import (
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=resolveproblemmocks
 
-var ErrAssignedProblemNotFound = errors.New("assigned problem not found")
+var (
+	ErrAssignedProblemNotFound = errors.New("assigned problem not found")
+	ErrUnknownResolutionCode   = errors.New("unknown resolution code")
+)
 
 const notifyText = `Your question has been marked as resolved.
 Thank you for being with us!`
@@ -35,7 +38,17 @@ type outboxService interface {
 
 type problemsRepository interface {
 	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
-	ResolveProblem(ctx context.Context, requestID types.RequestID, problemID types.ProblemID) error
+	ResolveProblem(
+		ctx context.Context,
+		requestID types.RequestID,
+		problemID types.ProblemID,
+		resolutionCode string,
+		resolutionSummary string,
+	) error
+}
+
+type problemTaxonomy interface {
+	HasResolutionCode(code string) bool
 }
 
 type transactor interface {
@@ -47,6 +60,7 @@ type Options struct {
 	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
 	outBox       outboxService      `option:"mandatory" validate:"required"`
 	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	taxonomy     problemTaxonomy    `option:"mandatory" validate:"required"`
 	txtor        transactor         `option:"mandatory" validate:"required"`
 }
 
@@ -63,6 +77,10 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 		return Response{}, err
 	}
 
+	if !u.taxonomy.HasResolutionCode(req.ResolutionCode) {
+		return Response{}, fmt.Errorf("%w: %q", ErrUnknownResolutionCode, req.ResolutionCode)
+	}
+
 	problemID, err := u.problemsRepo.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
 	if err != nil {
 		if errors.Is(err, problemsrepo.ErrAssignedProblemNotFound) {
@@ -72,7 +90,7 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 	}
 
 	if err := u.txtor.RunInTx(ctx, func(ctx context.Context) error {
-		if err := u.problemsRepo.ResolveProblem(ctx, req.ID, problemID); err != nil {
+		if err := u.problemsRepo.ResolveProblem(ctx, req.ID, problemID, req.ResolutionCode, req.ResolutionSummary); err != nil {
 			return fmt.Errorf("resolve problem: %v", err)
 		}
 
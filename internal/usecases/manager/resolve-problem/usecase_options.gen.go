@@ -14,6 +14,7 @@ func NewOptions(
 	msgRepo messagesRepository,
 	outBox outboxService,
 	problemsRepo problemsRepository,
+	taxonomy problemTaxonomy,
 	txtor transactor,
 	options ...OptOptionsSetter,
 ) Options {
@@ -27,6 +28,8 @@ func NewOptions(
 
 	o.problemsRepo = problemsRepo
 
+	o.taxonomy = taxonomy
+
 	o.txtor = txtor
 
 	for _, opt := range options {
@@ -40,6 +43,7 @@ func (o *Options) Validate() error {
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("outBox", _validate_Options_outBox(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("taxonomy", _validate_Options_taxonomy(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
 	return errs.AsError()
 }
@@ -65,6 +69,13 @@ func _validate_Options_problemsRepo(o *Options) error {
 	return nil
 }
 
+func _validate_Options_taxonomy(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.taxonomy, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `taxonomy` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_txtor(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.txtor, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `txtor` did not pass the test: %w", err)
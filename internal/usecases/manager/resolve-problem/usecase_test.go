@@ -11,6 +11,7 @@ import (
 
 	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
 	problemresolvedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/problem-resolved"
+	problemtaxonomy "github.com/zestagio/chat-service/internal/services/problem-taxonomy"
 	"github.com/zestagio/chat-service/internal/testingh"
 	"github.com/zestagio/chat-service/internal/types"
 	resolveproblem "github.com/zestagio/chat-service/internal/usecases/manager/resolve-problem"
@@ -40,8 +41,10 @@ func (s *UseCaseSuite) SetupTest() {
 	s.problemRepo = resolveproblemmocks.NewMockproblemsRepository(s.ctrl)
 	s.txtor = resolveproblemmocks.NewMocktransactor(s.ctrl)
 
-	var err error
-	s.uCase, err = resolveproblem.New(resolveproblem.NewOptions(s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor))
+	taxonomy, err := problemtaxonomy.New(problemtaxonomy.NewOptions([]string{"cards"}, []string{"answered", "escalated"}))
+	s.Require().NoError(err)
+
+	s.uCase, err = resolveproblem.New(resolveproblem.NewOptions(s.msgRepo, s.outBoxSvc, s.problemRepo, taxonomy, s.txtor))
 	s.Require().NoError(err)
 
 	s.ContextSuite.SetupTest()
@@ -64,6 +67,23 @@ func (s *UseCaseSuite) TestRequestValidationError() {
 	s.Require().Error(err)
 }
 
+func (s *UseCaseSuite) TestUnknownResolutionCode() {
+	// Arrange.
+	req := resolveproblem.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		ChatID:    types.NewChatID(),
+
+		ResolutionCode: "cards",
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, resolveproblem.ErrUnknownResolutionCode)
+}
+
 func (s *UseCaseSuite) TestGetAssignedProblemID_UnexpectedError() {
 	// Arrange.
 	reqID := types.NewRequestID()
@@ -77,6 +97,8 @@ func (s *UseCaseSuite) TestGetAssignedProblemID_UnexpectedError() {
 		ID:        reqID,
 		ManagerID: managerID,
 		ChatID:    chatID,
+
+		ResolutionCode: "answered",
 	}
 
 	// Action.
@@ -99,6 +121,8 @@ func (s *UseCaseSuite) TestGetAssignedProblemID_ProblemNotFoundError() {
 		ID:        reqID,
 		ManagerID: managerID,
 		ChatID:    chatID,
+
+		ResolutionCode: "answered",
 	}
 
 	// Action.
@@ -121,12 +145,14 @@ func (s *UseCaseSuite) TestResolveProblemError() {
 
 	problemID := types.NewProblemID()
 	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
-	s.problemRepo.EXPECT().ResolveProblem(gomock.Any(), reqID, problemID).Return(errors.New("unexpected"))
+	s.problemRepo.EXPECT().ResolveProblem(gomock.Any(), reqID, problemID, "answered", "").Return(errors.New("unexpected"))
 
 	req := resolveproblem.Request{
 		ID:        reqID,
 		ManagerID: managerID,
 		ChatID:    chatID,
+
+		ResolutionCode: "answered",
 	}
 
 	// Action.
@@ -149,7 +175,7 @@ func (s *UseCaseSuite) TestCreateServiceMessageForClientError() {
 
 	problemID := types.NewProblemID()
 	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
-	s.problemRepo.EXPECT().ResolveProblem(gomock.Any(), reqID, problemID).Return(nil)
+	s.problemRepo.EXPECT().ResolveProblem(gomock.Any(), reqID, problemID, "answered", "").Return(nil)
 
 	s.msgRepo.EXPECT().CreateServiceMessageForClient(gomock.Any(), reqID, problemID, chatID, gomock.Any()).
 		Return(types.MessageIDNil, errors.New("unexpected"))
@@ -158,6 +184,8 @@ func (s *UseCaseSuite) TestCreateServiceMessageForClientError() {
 		ID:        reqID,
 		ManagerID: managerID,
 		ChatID:    chatID,
+
+		ResolutionCode: "answered",
 	}
 
 	// Action.
@@ -180,7 +208,7 @@ func (s *UseCaseSuite) TestPutJobError() {
 
 	problemID := types.NewProblemID()
 	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
-	s.problemRepo.EXPECT().ResolveProblem(gomock.Any(), reqID, problemID).Return(nil)
+	s.problemRepo.EXPECT().ResolveProblem(gomock.Any(), reqID, problemID, "answered", "").Return(nil)
 
 	s.msgRepo.EXPECT().CreateServiceMessageForClient(gomock.Any(), reqID, problemID, chatID, gomock.Any()).
 		Return(types.NewMessageID(), nil)
@@ -192,6 +220,8 @@ func (s *UseCaseSuite) TestPutJobError() {
 		ID:        reqID,
 		ManagerID: managerID,
 		ChatID:    chatID,
+
+		ResolutionCode: "answered",
 	}
 
 	// Action.
@@ -215,7 +245,7 @@ func (s *UseCaseSuite) TestTransactionError() {
 
 	problemID := types.NewProblemID()
 	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
-	s.problemRepo.EXPECT().ResolveProblem(gomock.Any(), reqID, problemID).Return(nil)
+	s.problemRepo.EXPECT().ResolveProblem(gomock.Any(), reqID, problemID, "answered", "").Return(nil)
 
 	s.msgRepo.EXPECT().CreateServiceMessageForClient(gomock.Any(), reqID, problemID, chatID, gomock.Any()).
 		Return(types.NewMessageID(), nil)
@@ -227,6 +257,8 @@ func (s *UseCaseSuite) TestTransactionError() {
 		ID:        reqID,
 		ManagerID: managerID,
 		ChatID:    chatID,
+
+		ResolutionCode: "answered",
 	}
 
 	// Action.
@@ -249,7 +281,7 @@ func (s *UseCaseSuite) TestSuccessStory() {
 
 	problemID := types.NewProblemID()
 	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
-	s.problemRepo.EXPECT().ResolveProblem(gomock.Any(), reqID, problemID).Return(nil)
+	s.problemRepo.EXPECT().ResolveProblem(gomock.Any(), reqID, problemID, "escalated", "Passed to the cards team").Return(nil)
 
 	s.msgRepo.EXPECT().CreateServiceMessageForClient(gomock.Any(), reqID, problemID, chatID, gomock.Any()).
 		Return(types.NewMessageID(), nil)
@@ -261,6 +293,9 @@ func (s *UseCaseSuite) TestSuccessStory() {
 		ID:        reqID,
 		ManagerID: managerID,
 		ChatID:    chatID,
+
+		ResolutionCode:    "escalated",
+		ResolutionSummary: "Passed to the cards team",
 	}
 
 	// Action.
@@ -0,0 +1,44 @@
+package searchmessages
+
+import (
+	"errors"
+	"time"
+
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID        types.RequestID `validate:"required"`
+	ManagerID types.UserID    `validate:"required"`
+	Query     string          `validate:"required,min=2,max=256"`
+	PageSize  int             `validate:"omitempty,gte=10,lte=100"`
+	Cursor    string          `validate:"omitempty,base64url"`
+}
+
+func (r Request) Validate() error {
+	if r.Cursor == "" && r.PageSize == 0 {
+		return errors.New("either cursor or page size must be specified")
+	}
+	if r.Cursor != "" && r.PageSize != 0 {
+		return errors.New("either cursor or page size must be specified, not both")
+	}
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	Results    []Result
+	NextCursor string
+}
+
+type Result struct {
+	ChatID    types.ChatID
+	ProblemID types.ProblemID
+	MessageID types.MessageID
+	AuthorID  types.UserID
+	CreatedAt time.Time
+	Snippet   string
+
+	// HistoryCursor is the `get chat history` cursor of the page starting with the message, it is used with ProblemID.
+	HistoryCursor string
+}
@@ -0,0 +1,124 @@
+package searchmessages_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	searchmessages "github.com/zestagio/chat-service/internal/usecases/manager/search-messages"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request searchmessages.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "cursor specified",
+			request: searchmessages.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Query:     "card",
+				Cursor:    "eyJwYWdlX3NpemUiOjUwLCJsYXN0IjoxNjcwNTAyNTAyfQ==", // {"page_size":50,"last":1670502502}
+			},
+			wantErr: false,
+		},
+		{
+			name: "page size specified",
+			request: searchmessages.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Query:     "заблокирована карта",
+				PageSize:  20,
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "neither cursor nor page size specified",
+			request: searchmessages.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Query:     "card",
+			},
+			wantErr: true,
+		},
+		{
+			name: "cursor and page size specified",
+			request: searchmessages.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Query:     "card",
+				PageSize:  20,
+				Cursor:    "eyJwYWdlX3NpemUiOjUwLCJsYXN0IjoxNjcwNTAyNTAyfQ==",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require request id",
+			request: searchmessages.Request{
+				ID:        types.RequestIDNil,
+				ManagerID: types.NewUserID(),
+				Query:     "card",
+				PageSize:  20,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: searchmessages.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.UserIDNil,
+				Query:     "card",
+				PageSize:  20,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too short query",
+			request: searchmessages.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Query:     "c",
+				PageSize:  20,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long query",
+			request: searchmessages.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Query:     strings.Repeat("c", 257),
+				PageSize:  20,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too big page size",
+			request: searchmessages.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Query:     "card",
+				PageSize:  101,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package searchmessagesmocks is a generated GoMock package.
+package searchmessagesmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockcursorCodec is a mock of cursorCodec interface.
+type MockcursorCodec struct {
+	ctrl     *gomock.Controller
+	recorder *MockcursorCodecMockRecorder
+}
+
+// MockcursorCodecMockRecorder is the mock recorder for MockcursorCodec.
+type MockcursorCodecMockRecorder struct {
+	mock *MockcursorCodec
+}
+
+// NewMockcursorCodec creates a new mock instance.
+func NewMockcursorCodec(ctrl *gomock.Controller) *MockcursorCodec {
+	mock := &MockcursorCodec{ctrl: ctrl}
+	mock.recorder = &MockcursorCodecMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcursorCodec) EXPECT() *MockcursorCodecMockRecorder {
+	return m.recorder
+}
+
+// Decode mocks base method.
+func (m *MockcursorCodec) Decode(in string, to any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decode", in, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decode indicates an expected call of Decode.
+func (mr *MockcursorCodecMockRecorder) Decode(in, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decode", reflect.TypeOf((*MockcursorCodec)(nil).Decode), in, to)
+}
+
+// Encode mocks base method.
+func (m *MockcursorCodec) Encode(data any) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encode", data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encode indicates an expected call of Encode.
+func (mr *MockcursorCodecMockRecorder) Encode(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encode", reflect.TypeOf((*MockcursorCodec)(nil).Encode), data)
+}
+
+// MockmessagesRepository is a mock of messagesRepository interface.
+type MockmessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessagesRepositoryMockRecorder
+}
+
+// MockmessagesRepositoryMockRecorder is the mock recorder for MockmessagesRepository.
+type MockmessagesRepositoryMockRecorder struct {
+	mock *MockmessagesRepository
+}
+
+// NewMockmessagesRepository creates a new mock instance.
+func NewMockmessagesRepository(ctrl *gomock.Controller) *MockmessagesRepository {
+	mock := &MockmessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockmessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SearchManagerMessages mocks base method.
+func (m *MockmessagesRepository) SearchManagerMessages(ctx context.Context, managerID types.UserID, query string, pageSize int, cursor *messagesrepo.Cursor) ([]messagesrepo.FoundMessage, *messagesrepo.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchManagerMessages", ctx, managerID, query, pageSize, cursor)
+	ret0, _ := ret[0].([]messagesrepo.FoundMessage)
+	ret1, _ := ret[1].(*messagesrepo.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchManagerMessages indicates an expected call of SearchManagerMessages.
+func (mr *MockmessagesRepositoryMockRecorder) SearchManagerMessages(ctx, managerID, query, pageSize, cursor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchManagerMessages", reflect.TypeOf((*MockmessagesRepository)(nil).SearchManagerMessages), ctx, managerID, query, pageSize, cursor)
+}
@@ -0,0 +1,113 @@
+package searchmessages
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=searchmessagesmocks
+
+var (
+	ErrInvalidRequest = errors.New("invalid request")
+	ErrInvalidCursor  = errors.New("invalid cursor")
+)
+
+type cursorCodec interface {
+	Encode(data any) (string, error)
+	Decode(in string, to any) error
+}
+
+type messagesRepository interface {
+	SearchManagerMessages(
+		ctx context.Context,
+		managerID types.UserID,
+		query string,
+		pageSize int,
+		cursor *messagesrepo.Cursor,
+	) ([]messagesrepo.FoundMessage, *messagesrepo.Cursor, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	cursorCodec cursorCodec        `option:"mandatory" validate:"required"`
+	msgRepo     messagesRepository `option:"mandatory" validate:"required"`
+
+	// historyPageSize is the page size of the cursors into the chat history.
+	historyPageSize int `default:"20" validate:"min=10,max=100"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	req.Query = strings.TrimSpace(req.Query)
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	var c *messagesrepo.Cursor
+	if req.Cursor != "" {
+		if err := u.cursorCodec.Decode(req.Cursor, &c); err != nil {
+			return Response{}, fmt.Errorf("decode cursor: %w: %v", ErrInvalidCursor, err)
+		}
+	}
+
+	found, next, err := u.msgRepo.SearchManagerMessages(ctx, req.ManagerID, req.Query, req.PageSize, c)
+	if err != nil {
+		if errors.Is(err, messagesrepo.ErrInvalidCursor) {
+			return Response{}, fmt.Errorf("search manager messages: %w: %v", ErrInvalidCursor, err)
+		}
+		return Response{}, fmt.Errorf("search manager messages: %v", err)
+	}
+
+	var nextCursor string
+	if next != nil {
+		if nextCursor, err = u.cursorCodec.Encode(next); err != nil {
+			return Response{}, fmt.Errorf("encode next cursor: %v", err)
+		}
+	}
+
+	results := make([]Result, 0, len(found))
+	for _, m := range found {
+		historyCursor, err := u.historyCursor(m)
+		if err != nil {
+			return Response{}, fmt.Errorf("encode history cursor: %v", err)
+		}
+
+		results = append(results, Result{
+			ChatID:        m.ChatID,
+			ProblemID:     m.ProblemID,
+			MessageID:     m.ID,
+			AuthorID:      m.AuthorID,
+			CreatedAt:     m.CreatedAt,
+			Snippet:       m.Snippet,
+			HistoryCursor: historyCursor,
+		})
+	}
+
+	return Response{
+		Results:    results,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// historyCursor points to the history page starting with the message.
+// The history is paginated from the newest messages, so the cursor is right after the message.
+// The zero LastID keeps the messages created at the same microsecond on the page too.
+func (u UseCase) historyCursor(m messagesrepo.FoundMessage) (string, error) {
+	return u.cursorCodec.Encode(messagesrepo.Cursor{
+		LastCreatedAt: m.CreatedAt.Add(time.Microsecond),
+		PageSize:      u.historyPageSize,
+	})
+}
@@ -0,0 +1,68 @@
+// Code generated by options-gen. DO NOT EDIT.
+package searchmessages
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	cursorCodec cursorCodec,
+	msgRepo messagesRepository,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.historyPageSize = 20
+
+	o.cursorCodec = cursorCodec
+
+	o.msgRepo = msgRepo
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+// historyPageSize is the page size of the cursors into the chat history.
+func WithHistoryPageSize(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.historyPageSize = opt
+
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("cursorCodec", _validate_Options_cursorCodec(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("historyPageSize", _validate_Options_historyPageSize(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_cursorCodec(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.cursorCodec, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `cursorCodec` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_historyPageSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.historyPageSize, "min=10,max=100"); err != nil {
+		return fmt461e464ebed9.Errorf("field `historyPageSize` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package searchmessages_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/zestagio/chat-service/internal/cursor"
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	searchmessages "github.com/zestagio/chat-service/internal/usecases/manager/search-messages"
+	searchmessagesmocks "github.com/zestagio/chat-service/internal/usecases/manager/search-messages/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl    *gomock.Controller
+	codec   *cursor.Codec
+	msgRepo *searchmessagesmocks.MockmessagesRepository
+	uCase   searchmessages.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = searchmessagesmocks.NewMockmessagesRepository(s.ctrl)
+
+	var err error
+	s.codec, err = cursor.NewCodec(cursor.NewOptions("0123456789abcdef"))
+	s.Require().NoError(err)
+
+	s.uCase, err = searchmessages.New(searchmessages.NewOptions(s.codec, s.msgRepo, searchmessages.WithHistoryPageSize(10)))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := searchmessages.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		Query:     "  c  ",
+		PageSize:  10,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, searchmessages.ErrInvalidRequest)
+	s.Empty(resp.Results)
+	s.Empty(resp.NextCursor)
+}
+
+func (s *UseCaseSuite) TestCursorDecodingError() {
+	// Arrange.
+	req := searchmessages.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		Query:     "card",
+		Cursor:    "eyJwYWdlX3NpemUiOjEwMA==", // {"page_size":100
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, searchmessages.ErrInvalidCursor)
+	s.Empty(resp.Results)
+}
+
+func (s *UseCaseSuite) TestUnsignedCursor() {
+	// Arrange.
+	unsigned, err := cursor.Encode(messagesrepo.Cursor{PageSize: 100, LastCreatedAt: time.Now()})
+	s.Require().NoError(err)
+
+	req := searchmessages.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		Query:     "card",
+		Cursor:    unsigned,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, searchmessages.ErrInvalidCursor)
+	s.Require().ErrorContains(err, cursor.ErrInvalidSignature.Error())
+	s.Empty(resp.Results)
+}
+
+func (s *UseCaseSuite) TestSearch_InvalidCursor() {
+	// Arrange.
+	managerID := types.NewUserID()
+	c := messagesrepo.Cursor{PageSize: 10}
+	s.msgRepo.EXPECT().SearchManagerMessages(gomock.Any(), managerID, "card", 0, gomock.Any()).
+		Return(nil, nil, fmt.Errorf("%w: LastCreatedAt field must be specified", messagesrepo.ErrInvalidCursor))
+
+	rawCursor, err := s.codec.Encode(c)
+	s.Require().NoError(err)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, searchmessages.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		Query:     "card",
+		Cursor:    rawCursor,
+	})
+
+	// Assert.
+	s.Require().ErrorIs(err, searchmessages.ErrInvalidCursor)
+	s.Empty(resp.Results)
+}
+
+func (s *UseCaseSuite) TestSearch_Error() {
+	// Arrange.
+	managerID := types.NewUserID()
+	s.msgRepo.EXPECT().SearchManagerMessages(gomock.Any(), managerID, "card", 10, (*messagesrepo.Cursor)(nil)).
+		Return(nil, nil, errors.New("unexpected"))
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, searchmessages.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		Query:     "card",
+		PageSize:  10,
+	})
+
+	// Assert.
+	s.Require().Error(err)
+	s.NotErrorIs(err, searchmessages.ErrInvalidRequest)
+	s.Empty(resp.Results)
+}
+
+func (s *UseCaseSuite) TestSearch_Success() {
+	// Arrange.
+	managerID := types.NewUserID()
+	found := []messagesrepo.FoundMessage{
+		{
+			ID:        types.NewMessageID(),
+			ChatID:    types.NewChatID(),
+			ProblemID: types.NewProblemID(),
+			AuthorID:  types.NewUserID(),
+			CreatedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			Snippet:   "my <mark>card</mark> is blocked",
+		},
+		{
+			ID:        types.NewMessageID(),
+			ChatID:    types.NewChatID(),
+			ProblemID: types.NewProblemID(),
+			AuthorID:  types.NewUserID(),
+			CreatedAt: time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+			Snippet:   "new <mark>card</mark>",
+		},
+	}
+	next := &messagesrepo.Cursor{LastCreatedAt: found[1].CreatedAt, PageSize: 10}
+
+	s.msgRepo.EXPECT().SearchManagerMessages(gomock.Any(), managerID, "card", 10, (*messagesrepo.Cursor)(nil)).
+		Return(found, next, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, searchmessages.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		Query:     " card ",
+		PageSize:  10,
+	})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().NotEmpty(resp.NextCursor)
+
+	var nextCursor messagesrepo.Cursor
+	s.Require().NoError(s.codec.Decode(resp.NextCursor, &nextCursor))
+	s.Equal(*next, nextCursor)
+
+	s.Require().Len(resp.Results, len(found))
+	for i, r := range resp.Results {
+		s.Equal(found[i].ID, r.MessageID)
+		s.Equal(found[i].ChatID, r.ChatID)
+		s.Equal(found[i].ProblemID, r.ProblemID)
+		s.Equal(found[i].AuthorID, r.AuthorID)
+		s.Equal(found[i].CreatedAt, r.CreatedAt)
+		s.Equal(found[i].Snippet, r.Snippet)
+
+		var historyCursor messagesrepo.Cursor
+		s.Require().NoError(s.codec.Decode(r.HistoryCursor, &historyCursor))
+		s.Equal(10, historyCursor.PageSize)
+		s.True(historyCursor.LastCreatedAt.After(found[i].CreatedAt))
+		s.True(historyCursor.LastCreatedAt.Before(found[i].CreatedAt.Add(time.Millisecond)))
+	}
+}
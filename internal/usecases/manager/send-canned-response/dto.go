@@ -0,0 +1,30 @@
+package sendcannedresponse
+
+import (
+	"time"
+
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID               types.RequestID        `validate:"required"`
+	ManagerID        types.UserID           `validate:"required"`
+	ChatID           types.ChatID           `validate:"required"`
+	CannedResponseID types.CannedResponseID `validate:"required"`
+
+	// ManagerName is the manager name from the token, empty if unknown.
+	ManagerName string
+
+	// ReplyToMessageID is the message of the chat the manager answers to. Zero if the message is not a reply.
+	ReplyToMessageID types.MessageID
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	MessageID types.MessageID
+	CreatedAt time.Time
+}
@@ -0,0 +1,83 @@
+package sendcannedresponse_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	sendcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/send-canned-response"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request sendcannedresponse.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: sendcannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.NewUserID(),
+				ChatID:           types.NewChatID(),
+				CannedResponseID: types.NewCannedResponseID(),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: sendcannedresponse.Request{
+				ID:               types.RequestIDNil,
+				ManagerID:        types.NewUserID(),
+				ChatID:           types.NewChatID(),
+				CannedResponseID: types.NewCannedResponseID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: sendcannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.UserIDNil,
+				ChatID:           types.NewChatID(),
+				CannedResponseID: types.NewCannedResponseID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require chat id",
+			request: sendcannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.NewUserID(),
+				ChatID:           types.ChatIDNil,
+				CannedResponseID: types.NewCannedResponseID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require canned response id",
+			request: sendcannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.NewUserID(),
+				ChatID:           types.NewChatID(),
+				CannedResponseID: types.CannedResponseIDNil,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
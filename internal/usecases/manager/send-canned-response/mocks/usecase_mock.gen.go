@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package sendcannedresponsemocks is a generated GoMock package.
+package sendcannedresponsemocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	types "github.com/zestagio/chat-service/internal/types"
+	sendmessage "github.com/zestagio/chat-service/internal/usecases/manager/send-message"
+)
+
+// MockcannedResponsesRepository is a mock of cannedResponsesRepository interface.
+type MockcannedResponsesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockcannedResponsesRepositoryMockRecorder
+}
+
+// MockcannedResponsesRepositoryMockRecorder is the mock recorder for MockcannedResponsesRepository.
+type MockcannedResponsesRepositoryMockRecorder struct {
+	mock *MockcannedResponsesRepository
+}
+
+// NewMockcannedResponsesRepository creates a new mock instance.
+func NewMockcannedResponsesRepository(ctrl *gomock.Controller) *MockcannedResponsesRepository {
+	mock := &MockcannedResponsesRepository{ctrl: ctrl}
+	mock.recorder = &MockcannedResponsesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcannedResponsesRepository) EXPECT() *MockcannedResponsesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockcannedResponsesRepository) GetByID(ctx context.Context, id types.CannedResponseID) (*cannedresponsesrepo.CannedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*cannedresponsesrepo.CannedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockcannedResponsesRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockcannedResponsesRepository)(nil).GetByID), ctx, id)
+}
+
+// MockchatsRepository is a mock of chatsRepository interface.
+type MockchatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockchatsRepositoryMockRecorder
+}
+
+// MockchatsRepositoryMockRecorder is the mock recorder for MockchatsRepository.
+type MockchatsRepositoryMockRecorder struct {
+	mock *MockchatsRepository
+}
+
+// NewMockchatsRepository creates a new mock instance.
+func NewMockchatsRepository(ctrl *gomock.Controller) *MockchatsRepository {
+	mock := &MockchatsRepository{ctrl: ctrl}
+	mock.recorder = &MockchatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockchatsRepository) EXPECT() *MockchatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetChatClientName mocks base method.
+func (m *MockchatsRepository) GetChatClientName(ctx context.Context, chatID types.ChatID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatClientName", ctx, chatID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatClientName indicates an expected call of GetChatClientName.
+func (mr *MockchatsRepositoryMockRecorder) GetChatClientName(ctx, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatClientName", reflect.TypeOf((*MockchatsRepository)(nil).GetChatClientName), ctx, chatID)
+}
+
+// MockmessageSender is a mock of messageSender interface.
+type MockmessageSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessageSenderMockRecorder
+}
+
+// MockmessageSenderMockRecorder is the mock recorder for MockmessageSender.
+type MockmessageSenderMockRecorder struct {
+	mock *MockmessageSender
+}
+
+// NewMockmessageSender creates a new mock instance.
+func NewMockmessageSender(ctrl *gomock.Controller) *MockmessageSender {
+	mock := &MockmessageSender{ctrl: ctrl}
+	mock.recorder = &MockmessageSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessageSender) EXPECT() *MockmessageSenderMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockmessageSender) Handle(ctx context.Context, req sendmessage.Request) (sendmessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(sendmessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockmessageSenderMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockmessageSender)(nil).Handle), ctx, req)
+}
@@ -0,0 +1,95 @@
+package sendcannedresponse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	cannedtemplate "github.com/zestagio/chat-service/internal/services/canned-template"
+	"github.com/zestagio/chat-service/internal/types"
+	sendmessage "github.com/zestagio/chat-service/internal/usecases/manager/send-message"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=sendcannedresponsemocks
+
+var (
+	ErrInvalidRequest         = errors.New("invalid request")
+	ErrCannedResponseNotFound = errors.New("canned response not found")
+)
+
+type cannedResponsesRepository interface {
+	GetByID(ctx context.Context, id types.CannedResponseID) (*cannedresponsesrepo.CannedResponse, error)
+}
+
+type chatsRepository interface {
+	GetChatClientName(ctx context.Context, chatID types.ChatID) (string, error)
+}
+
+type messageSender interface {
+	Handle(ctx context.Context, req sendmessage.Request) (sendmessage.Response, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	cannedResponsesRepo cannedResponsesRepository `option:"mandatory" validate:"required"`
+	chatsRepo           chatsRepository           `option:"mandatory" validate:"required"`
+	msgSender           messageSender             `option:"mandatory" validate:"required"`
+}
+
+// UseCase renders the canned response for the chat and sends it as the usual manager message.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	cr, err := u.cannedResponsesRepo.GetByID(ctx, req.CannedResponseID)
+	if err != nil {
+		if errors.Is(err, cannedresponsesrepo.ErrCannedResponseNotFound) {
+			return Response{}, fmt.Errorf("%w: %v", ErrCannedResponseNotFound, err)
+		}
+		return Response{}, fmt.Errorf("get canned response: %v", err)
+	}
+	if !cr.IsShared && cr.AuthorID != req.ManagerID {
+		return Response{}, fmt.Errorf("%w: manager is not the author", ErrCannedResponseNotFound)
+	}
+
+	clientName, err := u.chatsRepo.GetChatClientName(ctx, req.ChatID)
+	if err != nil {
+		return Response{}, fmt.Errorf("get chat client name: %v", err)
+	}
+
+	body := cannedtemplate.Render(cr.Body, cannedtemplate.Vars{
+		ClientName:  clientName,
+		ManagerName: req.ManagerName,
+		Now:         time.Now(),
+	})
+	if body == "" {
+		return Response{}, fmt.Errorf("%w: rendered message is empty", ErrInvalidRequest)
+	}
+
+	resp, err := u.msgSender.Handle(ctx, sendmessage.Request{
+		ID:               req.ID,
+		ManagerID:        req.ManagerID,
+		ChatID:           req.ChatID,
+		MessageBody:      body,
+		ReplyToMessageID: req.ReplyToMessageID,
+	})
+	if err != nil {
+		return Response{}, fmt.Errorf("send message: %w", err)
+	}
+
+	return Response{
+		MessageID: resp.MessageID,
+		CreatedAt: resp.CreatedAt,
+	}, nil
+}
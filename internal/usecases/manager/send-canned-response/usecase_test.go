@@ -0,0 +1,194 @@
+package sendcannedresponse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	sendcannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/send-canned-response"
+	sendcannedresponsemocks "github.com/zestagio/chat-service/internal/usecases/manager/send-canned-response/mocks"
+	sendmessage "github.com/zestagio/chat-service/internal/usecases/manager/send-message"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl       *gomock.Controller
+	cannedRepo *sendcannedresponsemocks.MockcannedResponsesRepository
+	chatsRepo  *sendcannedresponsemocks.MockchatsRepository
+	msgSender  *sendcannedresponsemocks.MockmessageSender
+	uCase      sendcannedresponse.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.cannedRepo = sendcannedresponsemocks.NewMockcannedResponsesRepository(s.ctrl)
+	s.chatsRepo = sendcannedresponsemocks.NewMockchatsRepository(s.ctrl)
+	s.msgSender = sendcannedresponsemocks.NewMockmessageSender(s.ctrl)
+
+	var err error
+	s.uCase, err = sendcannedresponse.New(sendcannedresponse.NewOptions(s.cannedRepo, s.chatsRepo, s.msgSender))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := sendcannedresponse.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, sendcannedresponse.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestCannedResponseNotFound() {
+	// Arrange.
+	req := s.newRequest(types.NewUserID())
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, req.CannedResponseID).
+		Return(nil, cannedresponsesrepo.ErrCannedResponseNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, sendcannedresponse.ErrCannedResponseNotFound)
+}
+
+func (s *UseCaseSuite) TestPersonalResponseOfAnotherManager() {
+	// Arrange.
+	req := s.newRequest(types.NewUserID())
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, req.CannedResponseID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       req.CannedResponseID,
+		AuthorID: types.NewUserID(),
+		Body:     "Hello!",
+	}, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, sendcannedresponse.ErrCannedResponseNotFound)
+}
+
+func (s *UseCaseSuite) TestGetClientNameError() {
+	// Arrange.
+	req := s.newRequest(types.NewUserID())
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, req.CannedResponseID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       req.CannedResponseID,
+		AuthorID: req.ManagerID,
+		Body:     "Hello, {{client_name}}!",
+	}, nil)
+	s.chatsRepo.EXPECT().GetChatClientName(s.Ctx, req.ChatID).Return("", errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestRenderedMessageIsEmpty() {
+	// Arrange.
+	req := s.newRequest(types.NewUserID())
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, req.CannedResponseID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       req.CannedResponseID,
+		AuthorID: req.ManagerID,
+		Body:     "{{client_name}}",
+	}, nil)
+	s.chatsRepo.EXPECT().GetChatClientName(s.Ctx, req.ChatID).Return("", nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, sendcannedresponse.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestSendMessageError() {
+	// Arrange.
+	req := s.newRequest(types.NewUserID())
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, req.CannedResponseID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       req.CannedResponseID,
+		AuthorID: req.ManagerID,
+		Body:     "Hello!",
+	}, nil)
+	s.chatsRepo.EXPECT().GetChatClientName(s.Ctx, req.ChatID).Return("", nil)
+	s.msgSender.EXPECT().Handle(s.Ctx, gomock.Any()).Return(sendmessage.Response{}, sendmessage.ErrReplyToInvalid)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, sendmessage.ErrReplyToInvalid)
+}
+
+func (s *UseCaseSuite) TestSharedResponseSent() {
+	// Arrange.
+	req := s.newRequest(types.NewUserID())
+	req.ManagerName = "Anna"
+	req.ReplyToMessageID = types.NewMessageID()
+	msgID := types.NewMessageID()
+	createdAt := time.Now()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, req.CannedResponseID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       req.CannedResponseID,
+		AuthorID: types.NewUserID(),
+		IsShared: true,
+		Body:     "Hello, {{client_name}}! I am {{manager_name}}, today is {{current_date}}.",
+	}, nil)
+	s.chatsRepo.EXPECT().GetChatClientName(s.Ctx, req.ChatID).Return("Eric", nil)
+	s.msgSender.EXPECT().Handle(s.Ctx, sendmessage.Request{
+		ID:               req.ID,
+		ManagerID:        req.ManagerID,
+		ChatID:           req.ChatID,
+		MessageBody:      "Hello, Eric! I am Anna, today is " + time.Now().Format("02.01.2006") + ".",
+		ReplyToMessageID: req.ReplyToMessageID,
+	}).Return(sendmessage.Response{MessageID: msgID, CreatedAt: createdAt}, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(msgID, resp.MessageID)
+	s.Equal(createdAt, resp.CreatedAt)
+}
+
+func (s *UseCaseSuite) newRequest(managerID types.UserID) sendcannedresponse.Request {
+	return sendcannedresponse.Request{
+		ID:               types.NewRequestID(),
+		ManagerID:        managerID,
+		ChatID:           types.NewChatID(),
+		CannedResponseID: types.NewCannedResponseID(),
+	}
+}
@@ -1,6 +1,7 @@
 package sendmessage
 
 import (
+	"errors"
 	"time"
 
 	"github.com/zestagio/chat-service/internal/types"
@@ -11,10 +12,21 @@ type Request struct {
 	ID          types.RequestID `validate:"required"`
 	ManagerID   types.UserID    `validate:"required"`
 	ChatID      types.ChatID    `validate:"required"`
-	MessageBody string          `validate:"required,max=3000"`
+	MessageBody string          `validate:"max=3000"`
+
+	// AttachmentIDs are the files uploaded by the manager before the sending.
+	AttachmentIDs []types.AttachmentID `validate:"max=10,unique,dive,required"`
+
+	// ReplyToMessageID is the message of the chat the manager answers to. Zero if the message is not a reply.
+	ReplyToMessageID types.MessageID
 }
 
+var errEmptyMessage = errors.New("message body or attachments are required")
+
 func (r Request) Validate() error {
+	if r.MessageBody == "" && len(r.AttachmentIDs) == 0 {
+		return errEmptyMessage
+	}
 	return validator.Validator.Struct(r)
 }
 
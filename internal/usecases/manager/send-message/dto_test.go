@@ -11,6 +11,8 @@ import (
 )
 
 func TestRequest_Validate(t *testing.T) {
+	attachmentID := types.NewAttachmentID()
+
 	cases := []struct {
 		name    string
 		request sendmessage.Request
@@ -27,6 +29,16 @@ func TestRequest_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "attachments only",
+			request: sendmessage.Request{
+				ID:            types.NewRequestID(),
+				ManagerID:     types.NewUserID(),
+				ChatID:        types.NewChatID(),
+				AttachmentIDs: []types.AttachmentID{types.NewAttachmentID()},
+			},
+			wantErr: false,
+		},
 
 		// Negative.
 		{
@@ -79,6 +91,17 @@ func TestRequest_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "duplicated attachments",
+			request: sendmessage.Request{
+				ID:            types.NewRequestID(),
+				ManagerID:     types.NewUserID(),
+				ChatID:        types.NewChatID(),
+				MessageBody:   "How can I help you, sir?",
+				AttachmentIDs: []types.AttachmentID{attachmentID, attachmentID},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range cases {
@@ -37,19 +37,48 @@ func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AttachToMessage mocks base method.
+func (m *MockmessagesRepository) AttachToMessage(ctx context.Context, msgID types.MessageID, chatID types.ChatID, authorID types.UserID, ids []types.AttachmentID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachToMessage", ctx, msgID, chatID, authorID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachToMessage indicates an expected call of AttachToMessage.
+func (mr *MockmessagesRepositoryMockRecorder) AttachToMessage(ctx, msgID, chatID, authorID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachToMessage", reflect.TypeOf((*MockmessagesRepository)(nil).AttachToMessage), ctx, msgID, chatID, authorID, ids)
+}
+
 // CreateFullVisible mocks base method.
-func (m *MockmessagesRepository) CreateFullVisible(ctx context.Context, reqID types.RequestID, problemID types.ProblemID, chatID types.ChatID, authorID types.UserID, msgBody string) (*messagesrepo.Message, error) {
+func (m *MockmessagesRepository) CreateFullVisible(ctx context.Context, reqID types.RequestID, problemID types.ProblemID, chatID types.ChatID, authorID types.UserID, msgBody string, replyToID types.MessageID) (*messagesrepo.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateFullVisible", ctx, reqID, problemID, chatID, authorID, msgBody)
+	ret := m.ctrl.Call(m, "CreateFullVisible", ctx, reqID, problemID, chatID, authorID, msgBody, replyToID)
 	ret0, _ := ret[0].(*messagesrepo.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateFullVisible indicates an expected call of CreateFullVisible.
-func (mr *MockmessagesRepositoryMockRecorder) CreateFullVisible(ctx, reqID, problemID, chatID, authorID, msgBody interface{}) *gomock.Call {
+func (mr *MockmessagesRepositoryMockRecorder) CreateFullVisible(ctx, reqID, problemID, chatID, authorID, msgBody, replyToID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFullVisible", reflect.TypeOf((*MockmessagesRepository)(nil).CreateFullVisible), ctx, reqID, problemID, chatID, authorID, msgBody, replyToID)
+}
+
+// GetMessageByID mocks base method.
+func (m *MockmessagesRepository) GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageByID", ctx, msgID)
+	ret0, _ := ret[0].(*messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageByID indicates an expected call of GetMessageByID.
+func (mr *MockmessagesRepositoryMockRecorder) GetMessageByID(ctx, msgID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFullVisible", reflect.TypeOf((*MockmessagesRepository)(nil).CreateFullVisible), ctx, reqID, problemID, chatID, authorID, msgBody)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockmessagesRepository)(nil).GetMessageByID), ctx, msgID)
 }
 
 // MockoutboxService is a mock of outboxService interface.
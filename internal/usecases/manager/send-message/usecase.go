@@ -2,6 +2,7 @@ package sendmessage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,7 +14,13 @@ import (
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=sendmessagemocks
 
+var (
+	ErrAttachmentInvalid = errors.New("attachment is unknown or already sent")
+	ErrReplyToInvalid    = errors.New("replied message is unknown or not visible")
+)
+
 type messagesRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
 	CreateFullVisible(
 		ctx context.Context,
 		reqID types.RequestID,
@@ -21,7 +28,15 @@ type messagesRepository interface {
 		chatID types.ChatID,
 		authorID types.UserID,
 		msgBody string,
+		replyToID types.MessageID,
 	) (*messagesrepo.Message, error)
+	AttachToMessage(
+		ctx context.Context,
+		msgID types.MessageID,
+		chatID types.ChatID,
+		authorID types.UserID,
+		ids []types.AttachmentID,
+	) error
 }
 
 type outboxService interface {
@@ -65,11 +80,27 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 	var msg *messagesrepo.Message
 
 	if err := u.txtor.RunInTx(ctx, func(ctx context.Context) error {
-		m, err := u.msgRepo.CreateFullVisible(ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody)
+		if !req.ReplyToMessageID.IsZero() {
+			if err := u.checkReplyTo(ctx, req.ChatID, req.ReplyToMessageID); err != nil {
+				return err
+			}
+		}
+
+		m, err := u.msgRepo.CreateFullVisible(ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody, req.ReplyToMessageID)
 		if err != nil {
 			return fmt.Errorf("create full visible message: %v", err)
 		}
 
+		if len(req.AttachmentIDs) > 0 {
+			err = u.msgRepo.AttachToMessage(ctx, m.ID, req.ChatID, req.ManagerID, req.AttachmentIDs)
+			if errors.Is(err, messagesrepo.ErrAttachmentNotFound) {
+				return fmt.Errorf("%w: %v", ErrAttachmentInvalid, err)
+			}
+			if err != nil {
+				return fmt.Errorf("attach to message: %v", err)
+			}
+		}
+
 		_, err = u.outBox.Put(ctx, sendmanagermessagejob.Name, simpleid.MustMarshal(m.ID), time.Now())
 		if err != nil {
 			return fmt.Errorf("create `send manager message` job: %v", err)
@@ -78,7 +109,7 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 		msg = m
 		return nil
 	}); err != nil {
-		return Response{}, fmt.Errorf("`send manager message` tx: %v", err)
+		return Response{}, fmt.Errorf("`send manager message` tx: %w", err)
 	}
 
 	return Response{
@@ -86,3 +117,20 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 		CreatedAt: msg.CreatedAt,
 	}, nil
 }
+
+// checkReplyTo makes sure the manager answers to the not deleted message of the chat they see.
+// The internal note is never quoted: the reply is shown to the client. The canned responses are checked here too.
+func (u UseCase) checkReplyTo(ctx context.Context, chatID types.ChatID, msgID types.MessageID) error {
+	m, err := u.msgRepo.GetMessageByID(ctx, msgID)
+	if errors.Is(err, messagesrepo.ErrMsgNotFound) {
+		return fmt.Errorf("%w: %v", ErrReplyToInvalid, err)
+	}
+	if err != nil {
+		return fmt.Errorf("get replied message: %v", err)
+	}
+
+	if m.ChatID != chatID || !m.IsVisibleForManager || !m.DeletedAt.IsZero() || m.IsInternalNote {
+		return fmt.Errorf("%w: message %v", ErrReplyToInvalid, msgID)
+	}
+	return nil
+}
@@ -102,7 +102,8 @@ func (s *UseCaseSuite) TestCreateFullVisibleError() {
 	problemID := types.NewProblemID()
 	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
 
-	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "How can I help you, sir?").
+	s.msgRepo.EXPECT().
+		CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "How can I help you, sir?", types.MessageIDNil).
 		Return(nil, errors.New("unexpected"))
 
 	req := sendmessage.Request{
@@ -133,7 +134,8 @@ func (s *UseCaseSuite) TestPutJobError() {
 	problemID := types.NewProblemID()
 	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
 
-	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "How can I help you, sir?").
+	s.msgRepo.EXPECT().
+		CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "How can I help you, sir?", types.MessageIDNil).
 		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
 
 	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendmanagermessagejob.Name, gomock.Any(), gomock.Any()).
@@ -168,7 +170,8 @@ func (s *UseCaseSuite) TestTransactionError() {
 	problemID := types.NewProblemID()
 	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
 
-	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "How can I help you, sir?").
+	s.msgRepo.EXPECT().
+		CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "How can I help you, sir?", types.MessageIDNil).
 		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
 
 	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendmanagermessagejob.Name, gomock.Any(), gomock.Any()).
@@ -204,7 +207,8 @@ func (s *UseCaseSuite) TestSuccessStory() {
 
 	messageID := types.NewMessageID()
 	createdAt := time.Now()
-	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "How can I help you, sir?").
+	s.msgRepo.EXPECT().
+		CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "How can I help you, sir?", types.MessageIDNil).
 		Return(&messagesrepo.Message{
 			ID:        messageID,
 			CreatedAt: createdAt,
@@ -228,3 +232,178 @@ func (s *UseCaseSuite) TestSuccessStory() {
 	s.Equal(messageID, resp.MessageID)
 	s.True(resp.CreatedAt.Equal(createdAt))
 }
+
+func (s *UseCaseSuite) TestAttachmentInvalid() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	attachmentIDs := []types.AttachmentID{types.NewAttachmentID()}
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	messageID := types.NewMessageID()
+	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "", types.MessageIDNil).
+		Return(&messagesrepo.Message{ID: messageID}, nil)
+	s.msgRepo.EXPECT().AttachToMessage(gomock.Any(), messageID, chatID, managerID, attachmentIDs).
+		Return(messagesrepo.ErrAttachmentNotFound)
+
+	req := sendmessage.Request{
+		ID:            reqID,
+		ManagerID:     managerID,
+		ChatID:        chatID,
+		AttachmentIDs: attachmentIDs,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, sendmessage.ErrAttachmentInvalid)
+}
+
+func (s *UseCaseSuite) TestSuccessStory_WithAttachments() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	attachmentIDs := []types.AttachmentID{types.NewAttachmentID(), types.NewAttachmentID()}
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	messageID := types.NewMessageID()
+	s.msgRepo.EXPECT().
+		CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "See the statement.", types.MessageIDNil).
+		Return(&messagesrepo.Message{ID: messageID}, nil)
+	s.msgRepo.EXPECT().AttachToMessage(gomock.Any(), messageID, chatID, managerID, attachmentIDs).Return(nil)
+
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendmanagermessagejob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	req := sendmessage.Request{
+		ID:            reqID,
+		ManagerID:     managerID,
+		ChatID:        chatID,
+		MessageBody:   "See the statement.",
+		AttachmentIDs: attachmentIDs,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(messageID, resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestReplyCreated() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	replyToID := types.NewMessageID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+
+	problemID := types.NewProblemID()
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+
+	messageID := types.NewMessageID()
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), replyToID).
+		Return(&messagesrepo.Message{ID: replyToID, ChatID: chatID, IsVisibleForManager: true}, nil)
+	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), reqID, problemID, chatID, managerID, "Yes, we do.", replyToID).
+		Return(&messagesrepo.Message{ID: messageID}, nil)
+
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendmanagermessagejob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	req := sendmessage.Request{
+		ID:               reqID,
+		ManagerID:        managerID,
+		ChatID:           chatID,
+		MessageBody:      "Yes, we do.",
+		ReplyToMessageID: replyToID,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(messageID, resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestReplyToInvalid() {
+	chatID := types.NewChatID()
+	replyToID := types.NewMessageID()
+
+	cases := []struct {
+		name    string
+		replyTo *messagesrepo.Message
+		err     error
+	}{
+		{
+			name: "unknown message",
+			err:  messagesrepo.ErrMsgNotFound,
+		},
+		{
+			name:    "message of another chat",
+			replyTo: &messagesrepo.Message{ID: replyToID, ChatID: types.NewChatID(), IsVisibleForManager: true},
+		},
+		{
+			name:    "message invisible for manager",
+			replyTo: &messagesrepo.Message{ID: replyToID, ChatID: chatID, IsVisibleForClient: true},
+		},
+		{
+			name:    "deleted message",
+			replyTo: &messagesrepo.Message{ID: replyToID, ChatID: chatID, IsVisibleForManager: true, DeletedAt: time.Now()},
+		},
+		{
+			name:    "internal note",
+			replyTo: &messagesrepo.Message{ID: replyToID, ChatID: chatID, IsVisibleForManager: true, IsInternalNote: true},
+		},
+	}
+
+	for _, tt := range cases {
+		s.Run(tt.name, func() {
+			// Arrange.
+			managerID := types.NewUserID()
+
+			s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+			s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(types.NewProblemID(), nil)
+			s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), replyToID).Return(tt.replyTo, tt.err)
+
+			req := sendmessage.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        managerID,
+				ChatID:           chatID,
+				MessageBody:      "Yes, we do.",
+				ReplyToMessageID: replyToID,
+			}
+
+			// Action.
+			_, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().ErrorIs(err, sendmessage.ErrReplyToInvalid)
+		})
+	}
+}
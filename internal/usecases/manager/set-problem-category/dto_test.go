@@ -0,0 +1,82 @@
+package setproblemcategory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	setproblemcategory "github.com/zestagio/chat-service/internal/usecases/manager/set-problem-category"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request setproblemcategory.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: setproblemcategory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				Category:  "cards",
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: setproblemcategory.Request{
+				ID:        types.RequestIDNil,
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				Category:  "cards",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: setproblemcategory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.UserIDNil,
+				ChatID:    types.NewChatID(),
+				Category:  "cards",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require chat id",
+			request: setproblemcategory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.ChatIDNil,
+				Category:  "cards",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require category",
+			request: setproblemcategory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
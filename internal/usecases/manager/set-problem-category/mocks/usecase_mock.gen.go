@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package setproblemcategorymocks is a generated GoMock package.
+package setproblemcategorymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAssignedProblemID mocks base method.
+func (m *MockproblemsRepository) GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignedProblemID", ctx, managerID, chatID)
+	ret0, _ := ret[0].(types.ProblemID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignedProblemID indicates an expected call of GetAssignedProblemID.
+func (mr *MockproblemsRepositoryMockRecorder) GetAssignedProblemID(ctx, managerID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedProblemID", reflect.TypeOf((*MockproblemsRepository)(nil).GetAssignedProblemID), ctx, managerID, chatID)
+}
+
+// SetCategory mocks base method.
+func (m *MockproblemsRepository) SetCategory(ctx context.Context, problemID types.ProblemID, category string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategory", ctx, problemID, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategory indicates an expected call of SetCategory.
+func (mr *MockproblemsRepositoryMockRecorder) SetCategory(ctx, problemID, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategory", reflect.TypeOf((*MockproblemsRepository)(nil).SetCategory), ctx, problemID, category)
+}
+
+// MockproblemTaxonomy is a mock of problemTaxonomy interface.
+type MockproblemTaxonomy struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemTaxonomyMockRecorder
+}
+
+// MockproblemTaxonomyMockRecorder is the mock recorder for MockproblemTaxonomy.
+type MockproblemTaxonomyMockRecorder struct {
+	mock *MockproblemTaxonomy
+}
+
+// NewMockproblemTaxonomy creates a new mock instance.
+func NewMockproblemTaxonomy(ctrl *gomock.Controller) *MockproblemTaxonomy {
+	mock := &MockproblemTaxonomy{ctrl: ctrl}
+	mock.recorder = &MockproblemTaxonomyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemTaxonomy) EXPECT() *MockproblemTaxonomyMockRecorder {
+	return m.recorder
+}
+
+// HasCategory mocks base method.
+func (m *MockproblemTaxonomy) HasCategory(category string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasCategory", category)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasCategory indicates an expected call of HasCategory.
+func (mr *MockproblemTaxonomyMockRecorder) HasCategory(category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasCategory", reflect.TypeOf((*MockproblemTaxonomy)(nil).HasCategory), category)
+}
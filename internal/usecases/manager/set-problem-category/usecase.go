@@ -0,0 +1,64 @@
+package setproblemcategory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=setproblemcategorymocks
+
+var (
+	ErrAssignedProblemNotFound = errors.New("assigned problem not found")
+	ErrUnknownCategory         = errors.New("unknown problem category")
+)
+
+type problemsRepository interface {
+	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+	SetCategory(ctx context.Context, problemID types.ProblemID, category string) error
+}
+
+type problemTaxonomy interface {
+	HasCategory(category string) bool
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	taxonomy     problemTaxonomy    `option:"mandatory" validate:"required"`
+}
+
+// UseCase sets the contact reason of the problem, the manager can change it until the problem is resolved.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, err
+	}
+
+	if !u.taxonomy.HasCategory(req.Category) {
+		return Response{}, fmt.Errorf("%w: %q", ErrUnknownCategory, req.Category)
+	}
+
+	problemID, err := u.problemsRepo.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
+	if err != nil {
+		if errors.Is(err, problemsrepo.ErrAssignedProblemNotFound) {
+			return Response{}, ErrAssignedProblemNotFound
+		}
+		return Response{}, fmt.Errorf("get assigned problem: %v", err)
+	}
+
+	if err := u.problemsRepo.SetCategory(ctx, problemID, req.Category); err != nil {
+		return Response{}, fmt.Errorf("set category: %v", err)
+	}
+	return Response{}, nil
+}
@@ -0,0 +1,166 @@
+package setproblemcategory_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	problemtaxonomy "github.com/zestagio/chat-service/internal/services/problem-taxonomy"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	setproblemcategory "github.com/zestagio/chat-service/internal/usecases/manager/set-problem-category"
+	setproblemcategorymocks "github.com/zestagio/chat-service/internal/usecases/manager/set-problem-category/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	problemRepo *setproblemcategorymocks.MockproblemsRepository
+	uCase       setproblemcategory.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.problemRepo = setproblemcategorymocks.NewMockproblemsRepository(s.ctrl)
+
+	taxonomy, err := problemtaxonomy.New(problemtaxonomy.NewOptions([]string{"cards", "loans"}, []string{"answered"}))
+	s.Require().NoError(err)
+
+	s.uCase, err = setproblemcategory.New(setproblemcategory.NewOptions(s.problemRepo, taxonomy))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := setproblemcategory.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestUnknownCategory() {
+	// Arrange.
+	req := setproblemcategory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		ChatID:    types.NewChatID(),
+		Category:  "answered",
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, setproblemcategory.ErrUnknownCategory)
+}
+
+func (s *UseCaseSuite) TestGetAssignedProblemID_UnexpectedError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).
+		Return(types.ProblemIDNil, errors.New("unexpected"))
+
+	req := setproblemcategory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		Category:  "cards",
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.Require().NotErrorIs(err, setproblemcategory.ErrAssignedProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestGetAssignedProblemID_ProblemNotFoundError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrAssignedProblemNotFound)
+
+	req := setproblemcategory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		Category:  "cards",
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, setproblemcategory.ErrAssignedProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestSetCategoryError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+	s.problemRepo.EXPECT().SetCategory(gomock.Any(), problemID, "cards").Return(errors.New("unexpected"))
+
+	req := setproblemcategory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		Category:  "cards",
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(gomock.Any(), managerID, chatID).Return(problemID, nil)
+	s.problemRepo.EXPECT().SetCategory(gomock.Any(), problemID, "cards").Return(nil)
+
+	req := setproblemcategory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		Category:  "cards",
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
@@ -0,0 +1,44 @@
+package updatecannedresponse
+
+import (
+	"fmt"
+	"time"
+
+	cannedtemplate "github.com/zestagio/chat-service/internal/services/canned-template"
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID               types.RequestID        `validate:"required"`
+	ManagerID        types.UserID           `validate:"required"`
+	CannedResponseID types.CannedResponseID `validate:"required"`
+	Shortcut         string                 `validate:"required,max=32,printascii,excludesall= "`
+	Body             string                 `validate:"required,max=3000"`
+
+	// CanManageShared is true if the manager is allowed to edit the responses of the team.
+	CanManageShared bool
+}
+
+func (r Request) Validate() error {
+	if err := validator.Validator.Struct(r); err != nil {
+		return err
+	}
+	if err := cannedtemplate.Validate(r.Body); err != nil {
+		return fmt.Errorf("validate body: %v", err)
+	}
+	return nil
+}
+
+type Response struct {
+	CannedResponse CannedResponse
+}
+
+type CannedResponse struct {
+	ID        types.CannedResponseID
+	AuthorID  types.UserID
+	IsShared  bool
+	Shortcut  string
+	Body      string
+	UpdatedAt time.Time
+}
@@ -0,0 +1,88 @@
+package updatecannedresponse_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	updatecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/update-canned-response"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request updatecannedresponse.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: updatecannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.NewUserID(),
+				CannedResponseID: types.NewCannedResponseID(),
+				Shortcut:         "hello",
+				Body:             "Hello, {{client_name}}!",
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require canned response id",
+			request: updatecannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.NewUserID(),
+				CannedResponseID: types.CannedResponseIDNil,
+				Shortcut:         "hello",
+				Body:             "Hello!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require shortcut",
+			request: updatecannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.NewUserID(),
+				CannedResponseID: types.NewCannedResponseID(),
+				Body:             "Hello!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long body",
+			request: updatecannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.NewUserID(),
+				CannedResponseID: types.NewCannedResponseID(),
+				Shortcut:         "hello",
+				Body:             strings.Repeat("a", 3001),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown template variable",
+			request: updatecannedresponse.Request{
+				ID:               types.NewRequestID(),
+				ManagerID:        types.NewUserID(),
+				CannedResponseID: types.NewCannedResponseID(),
+				Shortcut:         "hello",
+				Body:             "Your balance is {{balance}}",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package updatecannedresponsemocks is a generated GoMock package.
+package updatecannedresponsemocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	types "github.com/zestagio/chat-service/internal/types"
+)
+
+// MockcannedResponsesRepository is a mock of cannedResponsesRepository interface.
+type MockcannedResponsesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockcannedResponsesRepositoryMockRecorder
+}
+
+// MockcannedResponsesRepositoryMockRecorder is the mock recorder for MockcannedResponsesRepository.
+type MockcannedResponsesRepositoryMockRecorder struct {
+	mock *MockcannedResponsesRepository
+}
+
+// NewMockcannedResponsesRepository creates a new mock instance.
+func NewMockcannedResponsesRepository(ctrl *gomock.Controller) *MockcannedResponsesRepository {
+	mock := &MockcannedResponsesRepository{ctrl: ctrl}
+	mock.recorder = &MockcannedResponsesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcannedResponsesRepository) EXPECT() *MockcannedResponsesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockcannedResponsesRepository) GetByID(ctx context.Context, id types.CannedResponseID) (*cannedresponsesrepo.CannedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*cannedresponsesrepo.CannedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockcannedResponsesRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockcannedResponsesRepository)(nil).GetByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockcannedResponsesRepository) Update(ctx context.Context, id types.CannedResponseID, shortcut, body string) (*cannedresponsesrepo.CannedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, shortcut, body)
+	ret0, _ := ret[0].(*cannedresponsesrepo.CannedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockcannedResponsesRepositoryMockRecorder) Update(ctx, id, shortcut, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockcannedResponsesRepository)(nil).Update), ctx, id, shortcut, body)
+}
@@ -0,0 +1,83 @@
+package updatecannedresponse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=updatecannedresponsemocks
+
+var (
+	ErrInvalidRequest         = errors.New("invalid request")
+	ErrCannedResponseNotFound = errors.New("canned response not found")
+	ErrForbidden              = errors.New("shared canned responses are managed by team leads")
+	ErrShortcutTaken          = errors.New("shortcut is already taken")
+)
+
+type cannedResponsesRepository interface {
+	GetByID(ctx context.Context, id types.CannedResponseID) (*cannedresponsesrepo.CannedResponse, error)
+	Update(
+		ctx context.Context,
+		id types.CannedResponseID,
+		shortcut string,
+		body string,
+	) (*cannedresponsesrepo.CannedResponse, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	cannedResponsesRepo cannedResponsesRepository `option:"mandatory" validate:"required"`
+}
+
+// UseCase changes the personal canned response of the manager or the shared one of the team.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	cr, err := u.cannedResponsesRepo.GetByID(ctx, req.CannedResponseID)
+	if err != nil {
+		if errors.Is(err, cannedresponsesrepo.ErrCannedResponseNotFound) {
+			return Response{}, fmt.Errorf("%w: %v", ErrCannedResponseNotFound, err)
+		}
+		return Response{}, fmt.Errorf("get canned response: %v", err)
+	}
+	if !cr.IsShared && cr.AuthorID != req.ManagerID {
+		return Response{}, fmt.Errorf("%w: manager is not the author", ErrCannedResponseNotFound)
+	}
+	if cr.IsShared && !req.CanManageShared {
+		return Response{}, ErrForbidden
+	}
+
+	cr, err = u.cannedResponsesRepo.Update(ctx, cr.ID, req.Shortcut, req.Body)
+	if err != nil {
+		if errors.Is(err, cannedresponsesrepo.ErrCannedResponseNotFound) {
+			return Response{}, fmt.Errorf("%w: %v", ErrCannedResponseNotFound, err)
+		}
+		if errors.Is(err, cannedresponsesrepo.ErrShortcutTaken) {
+			return Response{}, fmt.Errorf("%w: %v", ErrShortcutTaken, err)
+		}
+		return Response{}, fmt.Errorf("update canned response: %v", err)
+	}
+
+	return Response{CannedResponse: CannedResponse{
+		ID:        cr.ID,
+		AuthorID:  cr.AuthorID,
+		IsShared:  cr.IsShared,
+		Shortcut:  cr.Shortcut,
+		Body:      cr.Body,
+		UpdatedAt: cr.UpdatedAt,
+	}}, nil
+}
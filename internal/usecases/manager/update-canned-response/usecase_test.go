@@ -0,0 +1,198 @@
+package updatecannedresponse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	cannedresponsesrepo "github.com/zestagio/chat-service/internal/repositories/canned-responses"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	updatecannedresponse "github.com/zestagio/chat-service/internal/usecases/manager/update-canned-response"
+	updatecannedresponsemocks "github.com/zestagio/chat-service/internal/usecases/manager/update-canned-response/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl       *gomock.Controller
+	cannedRepo *updatecannedresponsemocks.MockcannedResponsesRepository
+	uCase      updatecannedresponse.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.cannedRepo = updatecannedresponsemocks.NewMockcannedResponsesRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = updatecannedresponse.New(updatecannedresponse.NewOptions(s.cannedRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := updatecannedresponse.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, updatecannedresponse.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestCannedResponseNotFound() {
+	// Arrange.
+	crID := types.NewCannedResponseID()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(nil, cannedresponsesrepo.ErrCannedResponseNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, s.newRequest(types.NewUserID(), crID, false))
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, updatecannedresponse.ErrCannedResponseNotFound)
+}
+
+func (s *UseCaseSuite) TestPersonalResponseOfAnotherManager() {
+	// Arrange.
+	crID := types.NewCannedResponseID()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       crID,
+		AuthorID: types.NewUserID(),
+	}, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, s.newRequest(types.NewUserID(), crID, true))
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, updatecannedresponse.ErrCannedResponseNotFound)
+}
+
+func (s *UseCaseSuite) TestSharedResponseForbidden() {
+	// Arrange.
+	managerID := types.NewUserID()
+	crID := types.NewCannedResponseID()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       crID,
+		AuthorID: managerID,
+		IsShared: true,
+	}, nil)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, s.newRequest(managerID, crID, false))
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, updatecannedresponse.ErrForbidden)
+}
+
+func (s *UseCaseSuite) TestShortcutTaken() {
+	// Arrange.
+	managerID := types.NewUserID()
+	crID := types.NewCannedResponseID()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       crID,
+		AuthorID: managerID,
+	}, nil)
+	s.cannedRepo.EXPECT().Update(s.Ctx, crID, "hi", "Hi, {{client_name}}!").
+		Return(nil, cannedresponsesrepo.ErrShortcutTaken)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, s.newRequest(managerID, crID, false))
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, updatecannedresponse.ErrShortcutTaken)
+}
+
+func (s *UseCaseSuite) TestUpdateError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	crID := types.NewCannedResponseID()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       crID,
+		AuthorID: managerID,
+	}, nil)
+	s.cannedRepo.EXPECT().Update(s.Ctx, crID, "hi", "Hi, {{client_name}}!").
+		Return(nil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, s.newRequest(managerID, crID, false))
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSharedResponseUpdated() {
+	// Arrange.
+	authorID := types.NewUserID()
+	crID := types.NewCannedResponseID()
+	updatedAt := time.Now()
+
+	s.cannedRepo.EXPECT().GetByID(s.Ctx, crID).Return(&cannedresponsesrepo.CannedResponse{
+		ID:       crID,
+		AuthorID: authorID,
+		IsShared: true,
+	}, nil)
+	s.cannedRepo.EXPECT().Update(s.Ctx, crID, "hi", "Hi, {{client_name}}!").
+		Return(&cannedresponsesrepo.CannedResponse{
+			ID:        crID,
+			AuthorID:  authorID,
+			IsShared:  true,
+			Shortcut:  "hi",
+			Body:      "Hi, {{client_name}}!",
+			UpdatedAt: updatedAt,
+		}, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, s.newRequest(types.NewUserID(), crID, true))
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(updatecannedresponse.CannedResponse{
+		ID:        crID,
+		AuthorID:  authorID,
+		IsShared:  true,
+		Shortcut:  "hi",
+		Body:      "Hi, {{client_name}}!",
+		UpdatedAt: updatedAt,
+	}, resp.CannedResponse)
+}
+
+func (s *UseCaseSuite) newRequest(
+	managerID types.UserID,
+	crID types.CannedResponseID,
+	canManageShared bool,
+) updatecannedresponse.Request {
+	return updatecannedresponse.Request{
+		ID:               types.NewRequestID(),
+		ManagerID:        managerID,
+		CannedResponseID: crID,
+		Shortcut:         "hi",
+		Body:             "Hi, {{client_name}}!",
+		CanManageShared:  canManageShared,
+	}
+}
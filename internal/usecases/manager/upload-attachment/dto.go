@@ -0,0 +1,27 @@
+package uploadattachment
+
+import (
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID        types.RequestID `validate:"required"`
+	ManagerID types.UserID    `validate:"required"`
+	ChatID    types.ChatID    `validate:"required"`
+	FileName  string
+	Data      []byte `validate:"min=1"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	AttachmentID types.AttachmentID
+	FileName     string
+	ContentType  string
+	Size         int64
+	URL          string
+	ThumbnailURL string
+}
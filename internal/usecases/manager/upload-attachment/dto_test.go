@@ -0,0 +1,87 @@
+package uploadattachment_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/manager/upload-attachment"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request uploadattachment.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: uploadattachment.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				FileName:  "statement.pdf",
+				Data:      []byte("%PDF-1.7"),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: uploadattachment.Request{
+				ID:        types.RequestIDNil,
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				FileName:  "statement.pdf",
+				Data:      []byte("%PDF-1.7"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: uploadattachment.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.UserIDNil,
+				ChatID:    types.NewChatID(),
+				FileName:  "statement.pdf",
+				Data:      []byte("%PDF-1.7"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require chat id",
+			request: uploadattachment.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.ChatIDNil,
+				FileName:  "statement.pdf",
+				Data:      []byte("%PDF-1.7"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require data",
+			request: uploadattachment.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				FileName:  "statement.pdf",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package uploadattachment_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/zestagio/chat-service/internal/repositories/problems"
+	"github.com/zestagio/chat-service/internal/services/attachments"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	uploadattachment "github.com/zestagio/chat-service/internal/usecases/manager/upload-attachment"
+	uploadattachmentmocks "github.com/zestagio/chat-service/internal/usecases/manager/upload-attachment/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl           *gomock.Controller
+	attachmentsSvc *uploadattachmentmocks.MockattachmentsService
+	problemsRepo   *uploadattachmentmocks.MockproblemsRepository
+	uCase          uploadattachment.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.attachmentsSvc = uploadattachmentmocks.NewMockattachmentsService(s.ctrl)
+	s.problemsRepo = uploadattachmentmocks.NewMockproblemsRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = uploadattachment.New(uploadattachment.NewOptions(s.attachmentsSvc, s.problemsRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := uploadattachment.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, uploadattachment.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestProblemNotAssigned() {
+	// Arrange.
+	req := s.newRequest()
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrAssignedProblemNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, uploadattachment.ErrAssignedProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestUploadErrors() {
+	for _, tt := range []struct {
+		name   string
+		err    error
+		expErr error
+	}{
+		{name: "too large", err: attachments.ErrFileTooLarge, expErr: uploadattachment.ErrFileTooLarge},
+		{
+			name:   "content type not allowed",
+			err:    attachments.ErrContentTypeNotAllowed,
+			expErr: uploadattachment.ErrContentTypeNotAllowed,
+		},
+		{name: "unknown", err: errors.New("unexpected"), expErr: nil},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			req := s.newRequest()
+
+			s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).
+				Return(types.NewProblemID(), nil)
+			s.attachmentsSvc.EXPECT().Upload(gomock.Any(), req.ChatID, req.ManagerID, req.FileName, req.Data).
+				Return(nil, tt.err)
+
+			// Action.
+			_, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().Error(err)
+			if tt.expErr != nil {
+				s.ErrorIs(err, tt.expErr)
+			}
+		})
+	}
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	a := messagesrepo.Attachment{
+		ID:          types.NewAttachmentID(),
+		ChatID:      req.ChatID,
+		UploadedBy:  req.ManagerID,
+		FileName:    req.FileName,
+		ContentType: "application/pdf",
+		Size:        int64(len(req.Data)),
+		StorageKey:  "key",
+	}
+
+	s.problemsRepo.EXPECT().GetAssignedProblemID(gomock.Any(), req.ManagerID, req.ChatID).
+		Return(types.NewProblemID(), nil)
+	s.attachmentsSvc.EXPECT().Upload(gomock.Any(), req.ChatID, req.ManagerID, req.FileName, req.Data).Return(&a, nil)
+	s.attachmentsSvc.EXPECT().URLs(a, req.ManagerID).Return("/attachments/1", "")
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(uploadattachment.Response{
+		AttachmentID: a.ID,
+		FileName:     "statement.pdf",
+		ContentType:  "application/pdf",
+		Size:         a.Size,
+		URL:          "/attachments/1",
+	}, resp)
+}
+
+func (s *UseCaseSuite) newRequest() uploadattachment.Request {
+	return uploadattachment.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		ChatID:    types.NewChatID(),
+		FileName:  "statement.pdf",
+		Data:      []byte("%PDF-1.7"),
+	}
+}
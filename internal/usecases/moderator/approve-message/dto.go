@@ -0,0 +1,18 @@
+package approvemessage
+
+import (
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID          types.RequestID        `validate:"required"`
+	ModeratorID types.UserID           `validate:"required"`
+	CaseID      types.ModerationCaseID `validate:"required"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct{}
@@ -0,0 +1,69 @@
+package approvemessage_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	approvemessage "github.com/zestagio/chat-service/internal/usecases/moderator/approve-message"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request approvemessage.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: approvemessage.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.NewUserID(),
+				CaseID:      types.NewModerationCaseID(),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: approvemessage.Request{
+				ID:          types.RequestIDNil,
+				ModeratorID: types.NewUserID(),
+				CaseID:      types.NewModerationCaseID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require moderator id",
+			request: approvemessage.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.UserIDNil,
+				CaseID:      types.NewModerationCaseID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require case id",
+			request: approvemessage.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.NewUserID(),
+				CaseID:      types.ModerationCaseIDNil,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
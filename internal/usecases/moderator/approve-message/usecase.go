@@ -0,0 +1,88 @@
+package approvemessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	moderationrepo "github.com/zestagio/chat-service/internal/repositories/moderation"
+	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=approvemessagemocks
+
+var (
+	ErrInvalidRequest = errors.New("invalid request")
+	ErrCaseNotFound   = errors.New("pending moderation case not found")
+)
+
+type messagesRepository interface {
+	MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID) error
+}
+
+type moderationRepository interface {
+	Decide(
+		ctx context.Context,
+		caseID types.ModerationCaseID,
+		moderatorID types.UserID,
+		decision moderationrepo.Decision,
+	) (*moderationrepo.Case, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo        messagesRepository   `option:"mandatory" validate:"required"`
+	moderationRepo moderationRepository `option:"mandatory" validate:"required"`
+	outBox         outboxService        `option:"mandatory" validate:"required"`
+	txtor          transactor           `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+// Handle releases the suspicious message: it becomes visible for manager
+// and goes the same way as the message with "ok" AFC verdict.
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	if err := u.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		c, err := u.moderationRepo.Decide(ctx, req.CaseID, req.ModeratorID, moderationrepo.DecisionApproved)
+		if err != nil {
+			if errors.Is(err, moderationrepo.ErrPendingCaseNotFound) {
+				return ErrCaseNotFound
+			}
+			return fmt.Errorf("decide: %v", err)
+		}
+
+		if err := u.msgRepo.MarkAsVisibleForManager(ctx, c.MessageID); err != nil {
+			return fmt.Errorf("mark message %q as visible for manager: %v", c.MessageID.String(), err)
+		}
+
+		_, err = u.outBox.Put(ctx, clientmessagesentjob.Name, simpleid.MustMarshal(c.MessageID), time.Now())
+		return err
+	}); err != nil {
+		if errors.Is(err, ErrCaseNotFound) {
+			return Response{}, err
+		}
+		return Response{}, fmt.Errorf("approve message tx: %v", err)
+	}
+	return Response{}, nil
+}
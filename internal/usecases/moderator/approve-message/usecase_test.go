@@ -0,0 +1,170 @@
+package approvemessage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	moderationrepo "github.com/zestagio/chat-service/internal/repositories/moderation"
+	clientmessagesentjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	approvemessage "github.com/zestagio/chat-service/internal/usecases/moderator/approve-message"
+	approvemessagemocks "github.com/zestagio/chat-service/internal/usecases/moderator/approve-message/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl      *gomock.Controller
+	msgRepo   *approvemessagemocks.MockmessagesRepository
+	modRepo   *approvemessagemocks.MockmoderationRepository
+	outBoxSvc *approvemessagemocks.MockoutboxService
+	txtor     *approvemessagemocks.Mocktransactor
+	uCase     approvemessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = approvemessagemocks.NewMockmessagesRepository(s.ctrl)
+	s.modRepo = approvemessagemocks.NewMockmoderationRepository(s.ctrl)
+	s.outBoxSvc = approvemessagemocks.NewMockoutboxService(s.ctrl)
+	s.txtor = approvemessagemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = approvemessage.New(approvemessage.NewOptions(s.msgRepo, s.modRepo, s.outBoxSvc, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := approvemessage.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, approvemessage.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestDecide_CaseNotFound() {
+	// Arrange.
+	req := s.newRequest()
+	s.expectTx()
+
+	s.modRepo.EXPECT().Decide(gomock.Any(), req.CaseID, req.ModeratorID, moderationrepo.DecisionApproved).
+		Return(nil, moderationrepo.ErrPendingCaseNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, approvemessage.ErrCaseNotFound)
+}
+
+func (s *UseCaseSuite) TestDecide_UnexpectedError() {
+	// Arrange.
+	req := s.newRequest()
+	s.expectTx()
+
+	s.modRepo.EXPECT().Decide(gomock.Any(), req.CaseID, req.ModeratorID, moderationrepo.DecisionApproved).
+		Return(nil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.NotErrorIs(err, approvemessage.ErrCaseNotFound)
+}
+
+func (s *UseCaseSuite) TestMarkAsVisibleForManagerError() {
+	// Arrange.
+	req := s.newRequest()
+	msgID := types.NewMessageID()
+	s.expectTx()
+
+	s.modRepo.EXPECT().Decide(gomock.Any(), req.CaseID, req.ModeratorID, moderationrepo.DecisionApproved).
+		Return(&moderationrepo.Case{ID: req.CaseID, MessageID: msgID}, nil)
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestOutboxPutError() {
+	// Arrange.
+	req := s.newRequest()
+	msgID := types.NewMessageID()
+	s.expectTx()
+
+	s.modRepo.EXPECT().Decide(gomock.Any(), req.CaseID, req.ModeratorID, moderationrepo.DecisionApproved).
+		Return(&moderationrepo.Case{ID: req.CaseID, MessageID: msgID}, nil)
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, simpleid.MustMarshal(msgID), gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	msgID := types.NewMessageID()
+	s.expectTx()
+
+	s.modRepo.EXPECT().Decide(gomock.Any(), req.CaseID, req.ModeratorID, moderationrepo.DecisionApproved).
+		Return(&moderationrepo.Case{ID: req.CaseID, MessageID: msgID}, nil)
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, simpleid.MustMarshal(msgID), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, availableAt time.Time) (types.JobID, error) {
+			s.WithinDuration(time.Now(), availableAt, time.Second)
+			return types.NewJobID(), nil
+		})
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) newRequest() approvemessage.Request {
+	return approvemessage.Request{
+		ID:          types.NewRequestID(),
+		ModeratorID: types.NewUserID(),
+		CaseID:      types.NewModerationCaseID(),
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
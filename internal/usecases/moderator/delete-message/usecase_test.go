@@ -0,0 +1,181 @@
+package deletemessage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	messagedeletedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/message-deleted"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	deletemessage "github.com/zestagio/chat-service/internal/usecases/moderator/delete-message"
+	deletemessagemocks "github.com/zestagio/chat-service/internal/usecases/moderator/delete-message/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl      *gomock.Controller
+	msgRepo   *deletemessagemocks.MockmessagesRepository
+	outBoxSvc *deletemessagemocks.MockoutboxService
+	txtor     *deletemessagemocks.Mocktransactor
+	uCase     deletemessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = deletemessagemocks.NewMockmessagesRepository(s.ctrl)
+	s.outBoxSvc = deletemessagemocks.NewMockoutboxService(s.ctrl)
+	s.txtor = deletemessagemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = deletemessage.New(deletemessage.NewOptions(s.msgRepo, s.outBoxSvc, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := deletemessage.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, deletemessage.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestDeletionFound() {
+	// Arrange.
+	req := s.newRequest()
+	d := s.newDeletion(req)
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetDeletionByRequestID(gomock.Any(), req.ID).Return(&d, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.MessageID, resp.MessageID)
+	s.Equal(d.CreatedAt, resp.DeletedAt)
+}
+
+func (s *UseCaseSuite) TestMessageNotFound() {
+	for _, tt := range []struct {
+		name string
+		err  error
+	}{
+		{name: "no message", err: messagesrepo.ErrMsgNotFound},
+		{name: "already deleted", err: messagesrepo.ErrMsgAlreadyDeleted},
+	} {
+		s.Run(tt.name, func() {
+			// Arrange.
+			req := s.newRequest()
+
+			s.expectTx()
+			s.expectNoDeletion(req.ID)
+			s.msgRepo.EXPECT().
+				DeleteMessage(gomock.Any(), req.ID, req.MessageID, req.ModeratorID, messagesrepo.DeleterRoleModerator).
+				Return(nil, tt.err)
+
+			// Action.
+			_, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().ErrorIs(err, deletemessage.ErrMessageNotFound)
+		})
+	}
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	d := s.newDeletion(req)
+
+	s.expectTx()
+	s.expectNoDeletion(req.ID)
+	s.msgRepo.EXPECT().
+		DeleteMessage(gomock.Any(), req.ID, req.MessageID, req.ModeratorID, messagesrepo.DeleterRoleModerator).
+		Return(&d, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), messagedeletedjob.Name, simpleid.MustMarshal(d.ID), gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	d := s.newDeletion(req)
+
+	s.expectTx()
+	s.expectNoDeletion(req.ID)
+	s.msgRepo.EXPECT().
+		DeleteMessage(gomock.Any(), req.ID, req.MessageID, req.ModeratorID, messagesrepo.DeleterRoleModerator).
+		Return(&d, nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), messagedeletedjob.Name, simpleid.MustMarshal(d.ID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.MessageID, resp.MessageID)
+	s.Equal(d.CreatedAt, resp.DeletedAt)
+}
+
+func (s *UseCaseSuite) newRequest() deletemessage.Request {
+	return deletemessage.Request{
+		ID:          types.NewRequestID(),
+		ModeratorID: types.NewUserID(),
+		MessageID:   types.NewMessageID(),
+	}
+}
+
+func (s *UseCaseSuite) newDeletion(req deletemessage.Request) messagesrepo.Deletion {
+	return messagesrepo.Deletion{
+		ID:            types.NewMessageDeletionID(),
+		MessageID:     req.MessageID,
+		RequestID:     req.ID,
+		DeletedBy:     req.ModeratorID,
+		DeletedByRole: messagesrepo.DeleterRoleModerator,
+		CreatedAt:     time.Now(),
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
+
+func (s *UseCaseSuite) expectNoDeletion(reqID types.RequestID) {
+	s.msgRepo.EXPECT().GetDeletionByRequestID(gomock.Any(), reqID).Return(nil, messagesrepo.ErrDeletionNotFound)
+}
@@ -0,0 +1,55 @@
+package getqueue
+
+import (
+	"time"
+
+	"github.com/zestagio/chat-service/internal/types"
+	"github.com/zestagio/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID          types.RequestID `validate:"required"`
+	ModeratorID types.UserID    `validate:"required"`
+	PageSize    int             `validate:"omitempty,min=1,max=100"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	Cases []Case
+}
+
+type Case struct {
+	ID        types.ModerationCaseID
+	CreatedAt time.Time
+	Message   Message
+	Context   []ContextMessage // From the oldest to the newest.
+}
+
+type Message struct {
+	ID        types.MessageID
+	ChatID    types.ChatID
+	AuthorID  types.UserID
+	Body      string
+	CreatedAt time.Time
+
+	Attachments []Attachment
+}
+
+type ContextMessage struct {
+	ID        types.MessageID
+	AuthorID  types.UserID // Zero for the service messages.
+	Body      string
+	CreatedAt time.Time
+}
+
+type Attachment struct {
+	ID           types.AttachmentID
+	FileName     string
+	ContentType  string
+	Size         int64
+	URL          string
+	ThumbnailURL string // Empty if the attachment has no thumbnail.
+}
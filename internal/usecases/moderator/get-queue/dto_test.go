@@ -0,0 +1,84 @@
+package getqueue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	getqueue "github.com/zestagio/chat-service/internal/usecases/moderator/get-queue"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request getqueue.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: getqueue.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.NewUserID(),
+				PageSize:    50,
+			},
+			wantErr: false,
+		},
+		{
+			name: "default page size",
+			request: getqueue.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.NewUserID(),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: getqueue.Request{
+				ID:          types.RequestIDNil,
+				ModeratorID: types.NewUserID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require moderator id",
+			request: getqueue.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.UserIDNil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too big page size",
+			request: getqueue.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.NewUserID(),
+				PageSize:    101,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative page size",
+			request: getqueue.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.NewUserID(),
+				PageSize:    -1,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
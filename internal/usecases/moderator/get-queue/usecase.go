@@ -0,0 +1,145 @@
+package getqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	moderationrepo "github.com/zestagio/chat-service/internal/repositories/moderation"
+	"github.com/zestagio/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getqueuemocks
+
+const (
+	defaultPageSize = 20
+	contextSize     = 10
+)
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+type attachmentsService interface {
+	URLs(a messagesrepo.Attachment, viewerID types.UserID) (fileURL, thumbnailURL string)
+}
+
+type messagesRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	GetProblemMessages(
+		ctx context.Context,
+		problemID types.ProblemID,
+		pageSize int,
+		cursor *messagesrepo.Cursor,
+	) ([]messagesrepo.Message, *messagesrepo.Cursor, error)
+}
+
+type moderationRepository interface {
+	GetPendingCases(ctx context.Context, pageSize int) ([]moderationrepo.Case, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	attachmentsSvc attachmentsService   `option:"mandatory" validate:"required"`
+	msgRepo        messagesRepository   `option:"mandatory" validate:"required"`
+	moderationRepo moderationRepository `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	return UseCase{Options: opts}, opts.Validate()
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request: %w: %v", ErrInvalidRequest, err)
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	cases, err := u.moderationRepo.GetPendingCases(ctx, pageSize)
+	if err != nil {
+		return Response{}, fmt.Errorf("get pending cases: %v", err)
+	}
+
+	result := make([]Case, 0, len(cases))
+	for _, c := range cases {
+		if c.Message == nil {
+			return Response{}, fmt.Errorf("case %v: no message loaded", c.ID)
+		}
+
+		// The files of the message could be the reason it is suspicious, the moderator must see them too.
+		m, err := u.msgRepo.GetMessageByID(ctx, c.MessageID)
+		if err != nil {
+			return Response{}, fmt.Errorf("get message of case %v: %v", c.ID, err)
+		}
+
+		// The suspicious message is hidden from the manager, so the preceding messages are taken
+		// by the cursor pointing to it rather than around it.
+		msgs, _, err := u.msgRepo.GetProblemMessages(ctx, m.ProblemID, contextSize, &messagesrepo.Cursor{
+			LastCreatedAt: m.CreatedAt,
+			LastID:        m.ID,
+			PageSize:      contextSize,
+		})
+		if err != nil {
+			return Response{}, fmt.Errorf("get context of case %v: %v", c.ID, err)
+		}
+
+		result = append(result, Case{
+			ID:        c.ID,
+			CreatedAt: c.CreatedAt,
+			Message: Message{
+				ID:        c.MessageID,
+				ChatID:    c.Message.ChatID,
+				AuthorID:  c.Message.AuthorID,
+				Body:      c.Message.Body,
+				CreatedAt: c.Message.CreatedAt,
+
+				Attachments: u.adaptAttachments(m.Attachments, req.ModeratorID),
+			},
+			Context: adaptContext(msgs),
+		})
+	}
+
+	return Response{Cases: result}, nil
+}
+
+// adaptContext reverses the messages returned from the newest to the oldest.
+func adaptContext(msgs []messagesrepo.Message) []ContextMessage {
+	result := make([]ContextMessage, 0, len(msgs))
+	for i := len(msgs) - 1; i >= 0; i-- {
+		m := msgs[i]
+		result = append(result, ContextMessage{
+			ID:        m.ID,
+			AuthorID:  m.AuthorID,
+			Body:      m.Body,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return result
+}
+
+func (u UseCase) adaptAttachments(aa []messagesrepo.Attachment, viewerID types.UserID) []Attachment {
+	if len(aa) == 0 {
+		return nil
+	}
+
+	result := make([]Attachment, 0, len(aa))
+	for _, a := range aa {
+		url, thumbnailURL := u.attachmentsSvc.URLs(a, viewerID)
+		result = append(result, Attachment{
+			ID:           a.ID,
+			FileName:     a.FileName,
+			ContentType:  a.ContentType,
+			Size:         a.Size,
+			URL:          url,
+			ThumbnailURL: thumbnailURL,
+		})
+	}
+	return result
+}
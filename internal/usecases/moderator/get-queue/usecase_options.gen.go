@@ -0,0 +1,62 @@
+// Code generated by options-gen. DO NOT EDIT.
+package getqueue
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	attachmentsSvc attachmentsService,
+	msgRepo messagesRepository,
+	moderationRepo moderationRepository,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.attachmentsSvc = attachmentsSvc
+
+	o.msgRepo = msgRepo
+
+	o.moderationRepo = moderationRepo
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("attachmentsSvc", _validate_Options_attachmentsSvc(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("moderationRepo", _validate_Options_moderationRepo(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_attachmentsSvc(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.attachmentsSvc, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `attachmentsSvc` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_moderationRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.moderationRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `moderationRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package getqueue_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	moderationrepo "github.com/zestagio/chat-service/internal/repositories/moderation"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	getqueue "github.com/zestagio/chat-service/internal/usecases/moderator/get-queue"
+	getqueuemocks "github.com/zestagio/chat-service/internal/usecases/moderator/get-queue/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl           *gomock.Controller
+	attachmentsSvc *getqueuemocks.MockattachmentsService
+	msgRepo        *getqueuemocks.MockmessagesRepository
+	modRepo        *getqueuemocks.MockmoderationRepository
+	uCase          getqueue.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.attachmentsSvc = getqueuemocks.NewMockattachmentsService(s.ctrl)
+	s.msgRepo = getqueuemocks.NewMockmessagesRepository(s.ctrl)
+	s.modRepo = getqueuemocks.NewMockmoderationRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = getqueue.New(getqueue.NewOptions(s.attachmentsSvc, s.msgRepo, s.modRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := getqueue.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getqueue.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestGetPendingCasesError() {
+	// Arrange.
+	s.modRepo.EXPECT().GetPendingCases(gomock.Any(), 20).Return(nil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, getqueue.Request{
+		ID:          types.NewRequestID(),
+		ModeratorID: types.NewUserID(),
+	})
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestGetContextError() {
+	// Arrange.
+	c := moderationrepo.Case{
+		ID:        types.NewModerationCaseID(),
+		MessageID: types.NewMessageID(),
+		CreatedAt: time.Now(),
+		Message:   &moderationrepo.Message{ChatID: types.NewChatID()},
+	}
+	s.modRepo.EXPECT().GetPendingCases(gomock.Any(), 20).Return([]moderationrepo.Case{c}, nil)
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), c.MessageID).Return(&messagesrepo.Message{
+		ID:        c.MessageID,
+		ProblemID: types.NewProblemID(),
+		CreatedAt: time.Now(),
+	}, nil)
+	s.msgRepo.EXPECT().GetProblemMessages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, getqueue.Request{
+		ID:          types.NewRequestID(),
+		ModeratorID: types.NewUserID(),
+	})
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	const pageSize = 42
+
+	c := moderationrepo.Case{
+		ID:        types.NewModerationCaseID(),
+		MessageID: types.NewMessageID(),
+		CreatedAt: time.Now(),
+		Message: &moderationrepo.Message{
+			ChatID:    types.NewChatID(),
+			AuthorID:  types.NewUserID(),
+			Body:      "Hello!",
+			CreatedAt: time.Now().Add(-time.Minute),
+		},
+	}
+	s.modRepo.EXPECT().GetPendingCases(gomock.Any(), pageSize).Return([]moderationrepo.Case{c}, nil)
+
+	a := messagesrepo.Attachment{
+		ID:          types.NewAttachmentID(),
+		ChatID:      c.Message.ChatID,
+		MessageID:   c.MessageID,
+		FileName:    "card.png",
+		ContentType: "image/png",
+		Size:        1024,
+	}
+	m := messagesrepo.Message{
+		ID:          c.MessageID,
+		ChatID:      c.Message.ChatID,
+		ProblemID:   types.NewProblemID(),
+		CreatedAt:   c.Message.CreatedAt,
+		Attachments: []messagesrepo.Attachment{a},
+	}
+	s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), c.MessageID).Return(&m, nil)
+
+	// The newest first.
+	serviceMsg := messagesrepo.Message{
+		ID:        types.NewMessageID(),
+		Body:      "Manager will answer you soon",
+		CreatedAt: m.CreatedAt.Add(-time.Second),
+		IsService: true,
+	}
+	clientMsg := messagesrepo.Message{
+		ID:        types.NewMessageID(),
+		AuthorID:  c.Message.AuthorID,
+		Body:      "My card is blocked",
+		CreatedAt: m.CreatedAt.Add(-2 * time.Second),
+	}
+	s.msgRepo.EXPECT().GetProblemMessages(gomock.Any(), m.ProblemID, 10, messagesrepo.NewCursorMatcher(messagesrepo.Cursor{
+		LastCreatedAt: m.CreatedAt,
+		LastID:        m.ID,
+		PageSize:      10,
+	})).Return([]messagesrepo.Message{serviceMsg, clientMsg}, nil, nil)
+
+	moderatorID := types.NewUserID()
+	s.attachmentsSvc.EXPECT().URLs(a, moderatorID).Return("/file", "/thumbnail")
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, getqueue.Request{
+		ID:          types.NewRequestID(),
+		ModeratorID: moderatorID,
+		PageSize:    pageSize,
+	})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Cases, 1)
+	s.Equal(getqueue.Case{
+		ID:        c.ID,
+		CreatedAt: c.CreatedAt,
+		Message: getqueue.Message{
+			ID:        c.MessageID,
+			ChatID:    c.Message.ChatID,
+			AuthorID:  c.Message.AuthorID,
+			Body:      c.Message.Body,
+			CreatedAt: c.Message.CreatedAt,
+			Attachments: []getqueue.Attachment{{
+				ID:           a.ID,
+				FileName:     a.FileName,
+				ContentType:  a.ContentType,
+				Size:         a.Size,
+				URL:          "/file",
+				ThumbnailURL: "/thumbnail",
+			}},
+		},
+		Context: []getqueue.ContextMessage{
+			{ID: clientMsg.ID, AuthorID: clientMsg.AuthorID, Body: clientMsg.Body, CreatedAt: clientMsg.CreatedAt},
+			{ID: serviceMsg.ID, Body: serviceMsg.Body, CreatedAt: serviceMsg.CreatedAt},
+		},
+	}, resp.Cases[0])
+}
@@ -0,0 +1,69 @@
+package rejectmessage_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/internal/types"
+	rejectmessage "github.com/zestagio/chat-service/internal/usecases/moderator/reject-message"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request rejectmessage.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: rejectmessage.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.NewUserID(),
+				CaseID:      types.NewModerationCaseID(),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: rejectmessage.Request{
+				ID:          types.RequestIDNil,
+				ModeratorID: types.NewUserID(),
+				CaseID:      types.NewModerationCaseID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require moderator id",
+			request: rejectmessage.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.UserIDNil,
+				CaseID:      types.NewModerationCaseID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require case id",
+			request: rejectmessage.Request{
+				ID:          types.NewRequestID(),
+				ModeratorID: types.NewUserID(),
+				CaseID:      types.ModerationCaseIDNil,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package rejectmessage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/zestagio/chat-service/internal/repositories/messages"
+	moderationrepo "github.com/zestagio/chat-service/internal/repositories/moderation"
+	clientmessageblockedjob "github.com/zestagio/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	"github.com/zestagio/chat-service/internal/services/outbox/jobs/payload/simpleid"
+	"github.com/zestagio/chat-service/internal/testingh"
+	"github.com/zestagio/chat-service/internal/types"
+	rejectmessage "github.com/zestagio/chat-service/internal/usecases/moderator/reject-message"
+	rejectmessagemocks "github.com/zestagio/chat-service/internal/usecases/moderator/reject-message/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl      *gomock.Controller
+	msgRepo   *rejectmessagemocks.MockmessagesRepository
+	modRepo   *rejectmessagemocks.MockmoderationRepository
+	outBoxSvc *rejectmessagemocks.MockoutboxService
+	txtor     *rejectmessagemocks.Mocktransactor
+	uCase     rejectmessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = rejectmessagemocks.NewMockmessagesRepository(s.ctrl)
+	s.modRepo = rejectmessagemocks.NewMockmoderationRepository(s.ctrl)
+	s.outBoxSvc = rejectmessagemocks.NewMockoutboxService(s.ctrl)
+	s.txtor = rejectmessagemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = rejectmessage.New(rejectmessage.NewOptions(s.msgRepo, s.modRepo, s.outBoxSvc, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := rejectmessage.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, rejectmessage.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestDecide_CaseNotFound() {
+	// Arrange.
+	req := s.newRequest()
+	s.expectTx()
+
+	s.modRepo.EXPECT().Decide(gomock.Any(), req.CaseID, req.ModeratorID, moderationrepo.DecisionRejected).
+		Return(nil, moderationrepo.ErrPendingCaseNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, rejectmessage.ErrCaseNotFound)
+}
+
+func (s *UseCaseSuite) TestDecide_UnexpectedError() {
+	// Arrange.
+	req := s.newRequest()
+	s.expectTx()
+
+	s.modRepo.EXPECT().Decide(gomock.Any(), req.CaseID, req.ModeratorID, moderationrepo.DecisionRejected).
+		Return(nil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.NotErrorIs(err, rejectmessage.ErrCaseNotFound)
+}
+
+func (s *UseCaseSuite) TestBlockMessageError() {
+	// Arrange.
+	req := s.newRequest()
+	msgID := types.NewMessageID()
+	s.expectTx()
+
+	s.modRepo.EXPECT().Decide(gomock.Any(), req.CaseID, req.ModeratorID, moderationrepo.DecisionRejected).
+		Return(&moderationrepo.Case{ID: req.CaseID, MessageID: msgID}, nil)
+	s.msgRepo.EXPECT().BlockMessage(gomock.Any(), msgID, messagesrepo.VerdictSourceModerator).Return(errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestOutboxPutError() {
+	// Arrange.
+	req := s.newRequest()
+	msgID := types.NewMessageID()
+	s.expectTx()
+
+	s.modRepo.EXPECT().Decide(gomock.Any(), req.CaseID, req.ModeratorID, moderationrepo.DecisionRejected).
+		Return(&moderationrepo.Case{ID: req.CaseID, MessageID: msgID}, nil)
+	s.msgRepo.EXPECT().BlockMessage(gomock.Any(), msgID, messagesrepo.VerdictSourceModerator).Return(nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, simpleid.MustMarshal(msgID), gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	msgID := types.NewMessageID()
+	s.expectTx()
+
+	s.modRepo.EXPECT().Decide(gomock.Any(), req.CaseID, req.ModeratorID, moderationrepo.DecisionRejected).
+		Return(&moderationrepo.Case{ID: req.CaseID, MessageID: msgID}, nil)
+	s.msgRepo.EXPECT().BlockMessage(gomock.Any(), msgID, messagesrepo.VerdictSourceModerator).Return(nil)
+	s.outBoxSvc.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, simpleid.MustMarshal(msgID), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, availableAt time.Time) (types.JobID, error) {
+			s.WithinDuration(time.Now(), availableAt, time.Second)
+			return types.NewJobID(), nil
+		})
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) newRequest() rejectmessage.Request {
+	return rejectmessage.Request{
+		ID:          types.NewRequestID(),
+		ModeratorID: types.NewUserID(),
+		CaseID:      types.NewModerationCaseID(),
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
@@ -0,0 +1,106 @@
+// Package msgcrypto helps to read messages from the encrypted chat messages topic.
+//
+// Every encrypted message is a nonce followed by the AES-GCM sealed payload.
+// The ID of the key used for the encryption is passed in the HeaderKeyID Kafka header.
+package msgcrypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// HeaderKeyID is the Kafka header containing the ID of the encryption key.
+const HeaderKeyID = "ENCRYPTION_KEY_ID"
+
+// DefaultKeyID is the key ID used when the producer is configured with a single key.
+const DefaultKeyID = "default"
+
+var (
+	ErrNoKeyID      = errors.New("no encryption key id in message headers")
+	ErrUnknownKeyID = errors.New("unknown encryption key id")
+	ErrTooShort     = errors.New("ciphertext is shorter than nonce")
+)
+
+// Keyring is a set of AES-GCM keys indexed by key ID.
+type Keyring struct {
+	ciphers map[string]cipher.AEAD
+}
+
+// NewKeyring builds the keyring from the map of key ID to HEX-encoded AES key.
+func NewKeyring(keys map[string]string) (*Keyring, error) {
+	ciphers := make(map[string]cipher.AEAD, len(keys))
+	for id, key := range keys {
+		if id == "" {
+			return nil, errors.New("empty key id")
+		}
+
+		aead, err := NewAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %v", id, err)
+		}
+		ciphers[id] = aead
+	}
+	return &Keyring{ciphers: ciphers}, nil
+}
+
+// NewAEAD builds AES-GCM cipher from the HEX-encoded key.
+func NewAEAD(hexKey string) (cipher.AEAD, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode key from HEX: %v", err)
+	}
+
+	aesBlockCipher, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("build AES cipher: %v", err)
+	}
+
+	aead, err := cipher.NewGCM(aesBlockCipher)
+	if err != nil {
+		return nil, fmt.Errorf("build AEAD cipher: %v", err)
+	}
+	return aead, nil
+}
+
+// KeyID returns the encryption key ID from the message headers.
+func KeyID(msg kafka.Message) (string, bool) {
+	for _, h := range msg.Headers {
+		if h.Key == HeaderKeyID {
+			return string(h.Value), true
+		}
+	}
+	return "", false
+}
+
+// Decrypt decrypts the message value with the key referenced by the message headers.
+func (k *Keyring) Decrypt(msg kafka.Message) ([]byte, error) {
+	keyID, ok := KeyID(msg)
+	if !ok {
+		return nil, ErrNoKeyID
+	}
+	return k.DecryptWithKey(keyID, msg.Value)
+}
+
+// DecryptWithKey decrypts the data with the key keyID.
+func (k *Keyring) DecryptWithKey(keyID string, data []byte) ([]byte, error) {
+	aead, ok := k.ciphers[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKeyID, keyID)
+	}
+
+	ns := aead.NonceSize()
+	if len(data) < ns {
+		return nil, ErrTooShort
+	}
+
+	decrypted, err := aead.Open(nil, data[:ns], data[ns:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("open: %v", err)
+	}
+	return decrypted, nil
+}
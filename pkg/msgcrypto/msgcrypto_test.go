@@ -0,0 +1,106 @@
+package msgcrypto_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zestagio/chat-service/pkg/msgcrypto"
+)
+
+const (
+	oldKey = "24432646294A404E635266546A576E5A"
+	newKey = "68566D597133743677397A2443264629"
+)
+
+func TestKeyring_Decrypt(t *testing.T) {
+	keyring, err := msgcrypto.NewKeyring(map[string]string{"old": oldKey, "new": newKey})
+	require.NoError(t, err)
+
+	payload := []byte(`{"id":"1"}`)
+
+	cases := []struct {
+		name    string
+		msg     kafka.Message
+		wantErr error
+	}{
+		{
+			name: "active key",
+			msg:  encrypt(t, "new", newKey, payload),
+		},
+		{
+			name: "retired key",
+			msg:  encrypt(t, "old", oldKey, payload),
+		},
+		{
+			name:    "unknown key",
+			msg:     encrypt(t, "unknown", newKey, payload),
+			wantErr: msgcrypto.ErrUnknownKeyID,
+		},
+		{
+			name:    "no key id header",
+			msg:     kafka.Message{Value: encrypt(t, "new", newKey, payload).Value},
+			wantErr: msgcrypto.ErrNoKeyID,
+		},
+		{
+			name: "too short",
+			msg: kafka.Message{
+				Headers: []kafka.Header{{Key: msgcrypto.HeaderKeyID, Value: []byte("new")}},
+				Value:   []byte("1"),
+			},
+			wantErr: msgcrypto.ErrTooShort,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Action.
+			data, err := keyring.Decrypt(tt.msg)
+
+			// Assert.
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, payload, data)
+		})
+	}
+}
+
+func TestKeyring_Decrypt_WrongKey(t *testing.T) {
+	keyring, err := msgcrypto.NewKeyring(map[string]string{"new": oldKey})
+	require.NoError(t, err)
+
+	_, err = keyring.Decrypt(encrypt(t, "new", newKey, []byte("data")))
+	require.Error(t, err)
+}
+
+func TestNewKeyring_Invalid(t *testing.T) {
+	for name, keys := range map[string]map[string]string{
+		"not hex":      {"k": "zz"},
+		"bad size":     {"k": "abcd"},
+		"empty key id": {"": oldKey},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := msgcrypto.NewKeyring(keys)
+			require.Error(t, err)
+		})
+	}
+}
+
+func encrypt(t *testing.T, keyID, key string, data []byte) kafka.Message {
+	t.Helper()
+
+	aead, err := msgcrypto.NewAEAD(key)
+	require.NoError(t, err)
+
+	nonce := bytes.Repeat([]byte{'1'}, aead.NonceSize())
+	return kafka.Message{
+		Headers: []kafka.Header{{Key: msgcrypto.HeaderKeyID, Value: []byte(keyID)}},
+		Value:   aead.Seal(nonce, nonce, data, nil),
+	}
+}
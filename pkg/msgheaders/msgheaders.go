@@ -0,0 +1,81 @@
+// Package msgheaders describes the metadata Kafka headers of the chat messages topic.
+package msgheaders
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	// RequestID is the X-Request-ID of the request the message was sent with.
+	RequestID = "REQUEST_ID"
+	// CreatedAt is the message creation time in RFC3339 with nanoseconds.
+	CreatedAt = "CREATED_AT"
+	// AuthorRole is the role of the message author: "client" or "manager".
+	AuthorRole = "AUTHOR_ROLE"
+	// SchemaVersion is the version of the message value schema.
+	SchemaVersion = "SCHEMA_VERSION"
+	// TraceParent is the W3C Trace Context traceparent.
+	TraceParent = "traceparent"
+)
+
+const (
+	AuthorRoleClient  = "client"
+	AuthorRoleManager = "manager"
+)
+
+// Metadata is the message metadata passed in headers.
+type Metadata struct {
+	RequestID     string
+	CreatedAt     time.Time
+	AuthorRole    string
+	SchemaVersion int
+	TraceParent   string
+}
+
+// Headers returns the Kafka headers for the non-empty metadata fields.
+func (m Metadata) Headers() []kafka.Header {
+	var headers []kafka.Header
+	add := func(k, v string) {
+		if v != "" {
+			headers = append(headers, kafka.Header{Key: k, Value: []byte(v)})
+		}
+	}
+
+	add(RequestID, m.RequestID)
+	if !m.CreatedAt.IsZero() {
+		add(CreatedAt, m.CreatedAt.UTC().Format(time.RFC3339Nano))
+	}
+	add(AuthorRole, m.AuthorRole)
+	if m.SchemaVersion != 0 {
+		add(SchemaVersion, strconv.Itoa(m.SchemaVersion))
+	}
+	add(TraceParent, m.TraceParent)
+
+	return headers
+}
+
+// Parse extracts the metadata from the Kafka headers.
+// Missing and malformed headers result in zero values of the fields.
+func Parse(headers []kafka.Header) Metadata {
+	var m Metadata
+	for _, h := range headers {
+		v := string(h.Value)
+
+		switch h.Key {
+		case RequestID:
+			m.RequestID = v
+		case CreatedAt:
+			m.CreatedAt, _ = time.Parse(time.RFC3339Nano, v)
+		case AuthorRole:
+			m.AuthorRole = v
+		case SchemaVersion:
+			m.SchemaVersion, _ = strconv.Atoi(v)
+		case TraceParent:
+			m.TraceParent = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,40 @@
+package msgheaders_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/pkg/msgheaders"
+)
+
+func TestMetadata(t *testing.T) {
+	md := msgheaders.Metadata{
+		RequestID:     "b4b1b9e7-26b7-4a4b-8a6b-5f1e7a0d1c2e",
+		CreatedAt:     time.Date(2024, 2, 3, 4, 5, 6, 7, time.UTC),
+		AuthorRole:    msgheaders.AuthorRoleClient,
+		SchemaVersion: 1,
+		TraceParent:   "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	}
+
+	headers := md.Headers()
+	assert.Equal(t, []kafka.Header{
+		{Key: msgheaders.RequestID, Value: []byte("b4b1b9e7-26b7-4a4b-8a6b-5f1e7a0d1c2e")},
+		{Key: msgheaders.CreatedAt, Value: []byte("2024-02-03T04:05:06.000000007Z")},
+		{Key: msgheaders.AuthorRole, Value: []byte("client")},
+		{Key: msgheaders.SchemaVersion, Value: []byte("1")},
+		{Key: msgheaders.TraceParent, Value: []byte("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")},
+	}, headers)
+	assert.Equal(t, md, msgheaders.Parse(headers))
+}
+
+func TestMetadata_Empty(t *testing.T) {
+	assert.Empty(t, msgheaders.Metadata{}.Headers())
+	assert.Equal(t, msgheaders.Metadata{}, msgheaders.Parse([]kafka.Header{
+		{Key: "UNKNOWN", Value: []byte("value")},
+		{Key: msgheaders.SchemaVersion, Value: []byte("not a number")},
+		{Key: msgheaders.CreatedAt, Value: []byte("yesterday")},
+	}))
+}
@@ -0,0 +1,12 @@
+package strutil
+
+import "unicode/utf8"
+
+// Truncate returns the first maxRunes runes of s.
+// It never cuts the multibyte character in the middle unlike the byte slicing.
+func Truncate(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	return string([]rune(s)[:maxRunes])
+}
@@ -0,0 +1,17 @@
+package strutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zestagio/chat-service/pkg/strutil"
+)
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "", strutil.Truncate("", 3))
+	assert.Equal(t, "abc", strutil.Truncate("abc", 3))
+	assert.Equal(t, "ab", strutil.Truncate("abc", 2))
+	assert.Equal(t, "При", strutil.Truncate("Привет", 3))
+	assert.Equal(t, "", strutil.Truncate("Привет", 0))
+}
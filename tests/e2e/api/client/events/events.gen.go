@@ -13,6 +13,26 @@ import (
 	"github.com/zestagio/chat-service/internal/types"
 )
 
+// Defines values for ReactionKind.
+const (
+	ReactionKindHeart      ReactionKind = "heart"
+	ReactionKindLaugh      ReactionKind = "laugh"
+	ReactionKindSad        ReactionKind = "sad"
+	ReactionKindSurprised  ReactionKind = "surprised"
+	ReactionKindThumbsDown ReactionKind = "thumbs_down"
+	ReactionKindThumbsUp   ReactionKind = "thumbs_up"
+)
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType  string             `json:"contentType"`
+	FileName     string             `json:"fileName"`
+	Id           types.AttachmentID `json:"id"`
+	Size         int64              `json:"size"`
+	ThumbnailUrl *string            `json:"thumbnailUrl,omitempty"`
+	Url          string             `json:"url"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	EventId   types.EventID   `json:"eventId"`
@@ -23,16 +43,31 @@ type Event struct {
 
 // Message defines model for Message.
 type Message struct {
-	AuthorId  *types.UserID   `json:"authorId,omitempty"`
-	Body      string          `json:"body"`
-	CreatedAt time.Time       `json:"createdAt"`
-	IsService bool            `json:"isService"`
-	MessageId types.MessageID `json:"messageId"`
+	Attachments *[]Attachment   `json:"attachments,omitempty"`
+	AuthorId    *types.UserID   `json:"authorId,omitempty"`
+	Body        string          `json:"body"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	IsService   bool            `json:"isService"`
+	MessageId   types.MessageID `json:"messageId"`
+	ReplyTo     *Quote          `json:"replyTo,omitempty"`
 }
 
 // MessageBlockedEvent defines model for MessageBlockedEvent.
 type MessageBlockedEvent = MessageId
 
+// MessageDeletedEvent defines model for MessageDeletedEvent.
+type MessageDeletedEvent struct {
+	DeletedAt time.Time       `json:"deletedAt"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// MessageEditedEvent defines model for MessageEditedEvent.
+type MessageEditedEvent struct {
+	Body      string          `json:"body"`
+	EditedAt  time.Time       `json:"editedAt"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
 // MessageId defines model for MessageId.
 type MessageId struct {
 	MessageId types.MessageID `json:"messageId"`
@@ -44,6 +79,41 @@ type MessageSentEvent = MessageId
 // NewMessageEvent defines model for NewMessageEvent.
 type NewMessageEvent = Message
 
+// Quote defines model for Quote.
+type Quote struct {
+	// AuthorId Absent if the quoted message is a service one or is hidden from you.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the quoted message body. Empty if the message is deleted or is hidden from you.
+	Preview string `json:"preview"`
+}
+
+// RateProblemRequestEvent The problem was resolved, the client is asked to rate it with /rateProblem.
+type RateProblemRequestEvent struct {
+	// ExpiresAt The rating is not accepted after this time.
+	ExpiresAt time.Time       `json:"expiresAt"`
+	ProblemId types.ProblemID `json:"problemId"`
+}
+
+// Reaction defines model for Reaction.
+type Reaction struct {
+	Count       int          `json:"count"`
+	Kind        ReactionKind `json:"kind"`
+	ReactedByMe bool         `json:"reactedByMe"`
+}
+
+// ReactionKind defines model for Reaction.Kind.
+type ReactionKind string
+
+// ReactionsChangedEvent defines model for ReactionsChangedEvent.
+type ReactionsChangedEvent struct {
+	MessageId types.MessageID `json:"messageId"`
+
+	// Reactions The actual reactions on the message, empty if the last one was taken off.
+	Reactions []Reaction `json:"reactions"`
+}
+
 // AsNewMessageEvent returns the union data inside the Event as a NewMessageEvent
 func (t Event) AsNewMessageEvent() (NewMessageEvent, error) {
 	var body NewMessageEvent
@@ -134,6 +204,126 @@ func (t *Event) MergeMessageBlockedEvent(v MessageBlockedEvent) error {
 	return err
 }
 
+// AsMessageEditedEvent returns the union data inside the Event as a MessageEditedEvent
+func (t Event) AsMessageEditedEvent() (MessageEditedEvent, error) {
+	var body MessageEditedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMessageEditedEvent overwrites any union data inside the Event as the provided MessageEditedEvent
+func (t *Event) FromMessageEditedEvent(v MessageEditedEvent) error {
+	t.EventType = "MessageEditedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMessageEditedEvent performs a merge with any union data inside the Event, using the provided MessageEditedEvent
+func (t *Event) MergeMessageEditedEvent(v MessageEditedEvent) error {
+	t.EventType = "MessageEditedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsMessageDeletedEvent returns the union data inside the Event as a MessageDeletedEvent
+func (t Event) AsMessageDeletedEvent() (MessageDeletedEvent, error) {
+	var body MessageDeletedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMessageDeletedEvent overwrites any union data inside the Event as the provided MessageDeletedEvent
+func (t *Event) FromMessageDeletedEvent(v MessageDeletedEvent) error {
+	t.EventType = "MessageDeletedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMessageDeletedEvent performs a merge with any union data inside the Event, using the provided MessageDeletedEvent
+func (t *Event) MergeMessageDeletedEvent(v MessageDeletedEvent) error {
+	t.EventType = "MessageDeletedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsReactionsChangedEvent returns the union data inside the Event as a ReactionsChangedEvent
+func (t Event) AsReactionsChangedEvent() (ReactionsChangedEvent, error) {
+	var body ReactionsChangedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromReactionsChangedEvent overwrites any union data inside the Event as the provided ReactionsChangedEvent
+func (t *Event) FromReactionsChangedEvent(v ReactionsChangedEvent) error {
+	t.EventType = "ReactionsChangedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeReactionsChangedEvent performs a merge with any union data inside the Event, using the provided ReactionsChangedEvent
+func (t *Event) MergeReactionsChangedEvent(v ReactionsChangedEvent) error {
+	t.EventType = "ReactionsChangedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRateProblemRequestEvent returns the union data inside the Event as a RateProblemRequestEvent
+func (t Event) AsRateProblemRequestEvent() (RateProblemRequestEvent, error) {
+	var body RateProblemRequestEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRateProblemRequestEvent overwrites any union data inside the Event as the provided RateProblemRequestEvent
+func (t *Event) FromRateProblemRequestEvent(v RateProblemRequestEvent) error {
+	t.EventType = "RateProblemRequestEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRateProblemRequestEvent performs a merge with any union data inside the Event, using the provided RateProblemRequestEvent
+func (t *Event) MergeRateProblemRequestEvent(v RateProblemRequestEvent) error {
+	t.EventType = "RateProblemRequestEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t Event) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"eventType"`
@@ -150,10 +340,18 @@ func (t Event) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "MessageBlockedEvent":
 		return t.AsMessageBlockedEvent()
+	case "MessageDeletedEvent":
+		return t.AsMessageDeletedEvent()
+	case "MessageEditedEvent":
+		return t.AsMessageEditedEvent()
 	case "MessageSentEvent":
 		return t.AsMessageSentEvent()
 	case "NewMessageEvent":
 		return t.AsNewMessageEvent()
+	case "RateProblemRequestEvent":
+		return t.AsRateProblemRequestEvent()
+	case "ReactionsChangedEvent":
+		return t.AsReactionsChangedEvent()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
@@ -24,25 +25,94 @@ const (
 
 // Defines values for ErrorCode.
 const (
-	ErrorCodeCreateChatError    ErrorCode = 1000
-	ErrorCodeCreateProblemError ErrorCode = 1001
+	ErrorCodeCreateChatError     ErrorCode = 1000
+	ErrorCodeCreateProblemError  ErrorCode = 1001
+	ErrorCodeDeleteWindowExpired ErrorCode = 1004
+	ErrorCodeEditWindowExpired   ErrorCode = 1003
+	ErrorCodeMessageNotEditable  ErrorCode = 1002
+	ErrorCodeProblemAlreadyRated ErrorCode = 1006
+	ErrorCodeProblemNotResolved  ErrorCode = 1005
+	ErrorCodeRatingWindowExpired ErrorCode = 1007
 )
 
+// Defines values for ReactionKind.
+const (
+	ReactionKindHeart      ReactionKind = "heart"
+	ReactionKindLaugh      ReactionKind = "laugh"
+	ReactionKindSad        ReactionKind = "sad"
+	ReactionKindSurprised  ReactionKind = "surprised"
+	ReactionKindThumbsDown ReactionKind = "thumbs_down"
+	ReactionKindThumbsUp   ReactionKind = "thumbs_up"
+)
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType string             `json:"contentType"`
+	FileName    string             `json:"fileName"`
+	Id          types.AttachmentID `json:"id"`
+	Size        int64              `json:"size"`
+
+	// ThumbnailUrl The signed URL of the image thumbnail. It is absent for the other files.
+	ThumbnailUrl *string `json:"thumbnailUrl,omitempty"`
+
+	// Url The signed download URL, it expires in some time. Get the history again to renew it.
+	Url string `json:"url"`
+}
+
+// DeleteMessageRequest defines model for DeleteMessageRequest.
+type DeleteMessageRequest struct {
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// DeleteMessageResponse defines model for DeleteMessageResponse.
+type DeleteMessageResponse struct {
+	Data  *DeletedMessageHeader `json:"data,omitempty"`
+	Error *Error                `json:"error,omitempty"`
+}
+
+// DeletedMessageHeader defines model for DeletedMessageHeader.
+type DeletedMessageHeader struct {
+	DeletedAt time.Time       `json:"deletedAt"`
+	Id        types.MessageID `json:"id"`
+}
+
+// EditMessageRequest defines model for EditMessageRequest.
+type EditMessageRequest struct {
+	MessageBody string          `json:"messageBody"`
+	MessageId   types.MessageID `json:"messageId"`
+}
+
+// EditMessageResponse defines model for EditMessageResponse.
+type EditMessageResponse struct {
+	Data  *EditedMessageHeader `json:"data,omitempty"`
+	Error *Error               `json:"error,omitempty"`
+}
+
+// EditedMessageHeader defines model for EditedMessageHeader.
+type EditedMessageHeader struct {
+	EditedAt time.Time       `json:"editedAt"`
+	Id       types.MessageID `json:"id"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
+	// The upload errors are 413 for the too large file and 415 for the not allowed file type.
 	Code    ErrorCode `json:"code"`
 	Details *string   `json:"details,omitempty"`
 	Message string    `json:"message"`
 }
 
 // ErrorCode contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
+// The upload errors are 413 for the too large file and 415 for the not allowed file type.
 type ErrorCode int
 
 // GetHistoryRequest defines model for GetHistoryRequest.
 type GetHistoryRequest struct {
-	Cursor   *string `json:"cursor,omitempty"`
-	PageSize *int    `json:"pageSize,omitempty"`
+	// AroundMessageId Open the history at the message (e.g. the search result or the quote), requires pageSize.
+	AroundMessageId *types.MessageID `json:"aroundMessageId,omitempty"`
+	Cursor          *string          `json:"cursor,omitempty"`
+	PageSize        *int             `json:"pageSize,omitempty"`
 }
 
 // GetHistoryResponse defines model for GetHistoryResponse.
@@ -53,13 +123,20 @@ type GetHistoryResponse struct {
 
 // Message defines model for Message.
 type Message struct {
-	AuthorId   *types.UserID   `json:"authorId,omitempty"`
-	Body       string          `json:"body"`
-	CreatedAt  time.Time       `json:"createdAt"`
+	Attachments *[]Attachment `json:"attachments,omitempty"`
+	AuthorId    *types.UserID `json:"authorId,omitempty"`
+	Body        string        `json:"body"`
+	CreatedAt   time.Time     `json:"createdAt"`
+
+	// DeletedAt The body and the attachments of the deleted message are empty.
+	DeletedAt  *time.Time      `json:"deletedAt,omitempty"`
+	EditedAt   *time.Time      `json:"editedAt,omitempty"`
 	Id         types.MessageID `json:"id"`
 	IsBlocked  bool            `json:"isBlocked"`
 	IsReceived bool            `json:"isReceived"`
 	IsService  bool            `json:"isService"`
+	Reactions  *[]Reaction     `json:"reactions,omitempty"`
+	ReplyTo    *Quote          `json:"replyTo,omitempty"`
 }
 
 // MessageHeader defines model for MessageHeader.
@@ -69,15 +146,79 @@ type MessageHeader struct {
 	Id        types.MessageID `json:"id"`
 }
 
+// MessageReactions defines model for MessageReactions.
+type MessageReactions struct {
+	Id        types.MessageID `json:"id"`
+	Reactions []Reaction      `json:"reactions"`
+}
+
 // MessagesPage defines model for MessagesPage.
 type MessagesPage struct {
 	Messages []Message `json:"messages"`
-	Next     string    `json:"next"`
+
+	// Next The cursor to the older messages, empty if there are no ones.
+	Next string `json:"next"`
+
+	// Prev The cursor to the newer messages, empty if the page is the newest one.
+	Prev string `json:"prev"`
+}
+
+// Quote defines model for Quote.
+type Quote struct {
+	// AuthorId Absent if the quoted message is a service one or is hidden from you.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the quoted message body. Empty if the message is deleted or is hidden from you.
+	Preview string `json:"preview"`
+}
+
+// RateProblemRequest defines model for RateProblemRequest.
+type RateProblemRequest struct {
+	Comment   *string         `json:"comment,omitempty"`
+	ProblemId types.ProblemID `json:"problemId"`
+	Score     int             `json:"score"`
+}
+
+// RateProblemResponse defines model for RateProblemResponse.
+type RateProblemResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// Reaction defines model for Reaction.
+type Reaction struct {
+	Count int          `json:"count"`
+	Kind  ReactionKind `json:"kind"`
+
+	// ReactedByMe The current user has put the reaction of this kind.
+	ReactedByMe bool `json:"reactedByMe"`
+}
+
+// ReactionKind defines model for ReactionKind.
+type ReactionKind string
+
+// ReactionRequest defines model for ReactionRequest.
+type ReactionRequest struct {
+	Kind      ReactionKind    `json:"kind"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// ReactionsResponse defines model for ReactionsResponse.
+type ReactionsResponse struct {
+	Data  *MessageReactions `json:"data,omitempty"`
+	Error *Error            `json:"error,omitempty"`
 }
 
 // SendMessageRequest defines model for SendMessageRequest.
 type SendMessageRequest struct {
+	AttachmentIds *[]types.AttachmentID `json:"attachmentIds,omitempty"`
+
+	// MessageBody Can be empty if the message has attachments.
 	MessageBody string `json:"messageBody"`
+
+	// ReplyToMessageId The message of the chat to answer to.
+	ReplyToMessageId *types.MessageID `json:"replyToMessageId,omitempty"`
 }
 
 // SendMessageResponse defines model for SendMessageResponse.
@@ -86,25 +227,84 @@ type SendMessageResponse struct {
 	Error *Error         `json:"error,omitempty"`
 }
 
+// UploadAttachmentRequest defines model for UploadAttachmentRequest.
+type UploadAttachmentRequest struct {
+	File openapi_types.File `json:"file"`
+}
+
+// UploadAttachmentResponse defines model for UploadAttachmentResponse.
+type UploadAttachmentResponse struct {
+	Data  *Attachment `json:"data,omitempty"`
+	Error *Error      `json:"error,omitempty"`
+}
+
 // XRequestIDHeader defines model for XRequestIDHeader.
 type XRequestIDHeader = types.RequestID
 
+// PostAddReactionParams defines parameters for PostAddReaction.
+type PostAddReactionParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostDeleteMessageParams defines parameters for PostDeleteMessage.
+type PostDeleteMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostEditMessageParams defines parameters for PostEditMessage.
+type PostEditMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostGetHistoryParams defines parameters for PostGetHistory.
 type PostGetHistoryParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostRateProblemParams defines parameters for PostRateProblem.
+type PostRateProblemParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostRemoveReactionParams defines parameters for PostRemoveReaction.
+type PostRemoveReactionParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostSendMessageParams defines parameters for PostSendMessage.
 type PostSendMessageParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostUploadAttachmentParams defines parameters for PostUploadAttachment.
+type PostUploadAttachmentParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostAddReactionJSONRequestBody defines body for PostAddReaction for application/json ContentType.
+type PostAddReactionJSONRequestBody = ReactionRequest
+
+// PostDeleteMessageJSONRequestBody defines body for PostDeleteMessage for application/json ContentType.
+type PostDeleteMessageJSONRequestBody = DeleteMessageRequest
+
+// PostEditMessageJSONRequestBody defines body for PostEditMessage for application/json ContentType.
+type PostEditMessageJSONRequestBody = EditMessageRequest
+
 // PostGetHistoryJSONRequestBody defines body for PostGetHistory for application/json ContentType.
 type PostGetHistoryJSONRequestBody = GetHistoryRequest
 
+// PostRateProblemJSONRequestBody defines body for PostRateProblem for application/json ContentType.
+type PostRateProblemJSONRequestBody = RateProblemRequest
+
+// PostRemoveReactionJSONRequestBody defines body for PostRemoveReaction for application/json ContentType.
+type PostRemoveReactionJSONRequestBody = ReactionRequest
+
 // PostSendMessageJSONRequestBody defines body for PostSendMessage for application/json ContentType.
 type PostSendMessageJSONRequestBody = SendMessageRequest
 
+// PostUploadAttachmentMultipartRequestBody defines body for PostUploadAttachment for multipart/form-data ContentType.
+type PostUploadAttachmentMultipartRequestBody = UploadAttachmentRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -178,15 +378,115 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// PostAddReactionWithBody request with any body
+	PostAddReactionWithBody(ctx context.Context, params *PostAddReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAddReaction(ctx context.Context, params *PostAddReactionParams, body PostAddReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDeleteMessageWithBody request with any body
+	PostDeleteMessageWithBody(ctx context.Context, params *PostDeleteMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostDeleteMessage(ctx context.Context, params *PostDeleteMessageParams, body PostDeleteMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEditMessageWithBody request with any body
+	PostEditMessageWithBody(ctx context.Context, params *PostEditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEditMessage(ctx context.Context, params *PostEditMessageParams, body PostEditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostGetHistoryWithBody request with any body
 	PostGetHistoryWithBody(ctx context.Context, params *PostGetHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostGetHistory(ctx context.Context, params *PostGetHistoryParams, body PostGetHistoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostRateProblemWithBody request with any body
+	PostRateProblemWithBody(ctx context.Context, params *PostRateProblemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRateProblem(ctx context.Context, params *PostRateProblemParams, body PostRateProblemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRemoveReactionWithBody request with any body
+	PostRemoveReactionWithBody(ctx context.Context, params *PostRemoveReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRemoveReaction(ctx context.Context, params *PostRemoveReactionParams, body PostRemoveReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostSendMessageWithBody request with any body
 	PostSendMessageWithBody(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostSendMessage(ctx context.Context, params *PostSendMessageParams, body PostSendMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUploadAttachmentWithBody request with any body
+	PostUploadAttachmentWithBody(ctx context.Context, params *PostUploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) PostAddReactionWithBody(ctx context.Context, params *PostAddReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAddReactionRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAddReaction(ctx context.Context, params *PostAddReactionParams, body PostAddReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAddReactionRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostDeleteMessageWithBody(ctx context.Context, params *PostDeleteMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeleteMessageRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostDeleteMessage(ctx context.Context, params *PostDeleteMessageParams, body PostDeleteMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeleteMessageRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEditMessageWithBody(ctx context.Context, params *PostEditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEditMessageRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEditMessage(ctx context.Context, params *PostEditMessageParams, body PostEditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEditMessageRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) PostGetHistoryWithBody(ctx context.Context, params *PostGetHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -213,6 +513,54 @@ func (c *Client) PostGetHistory(ctx context.Context, params *PostGetHistoryParam
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostRateProblemWithBody(ctx context.Context, params *PostRateProblemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRateProblemRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRateProblem(ctx context.Context, params *PostRateProblemParams, body PostRateProblemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRateProblemRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRemoveReactionWithBody(ctx context.Context, params *PostRemoveReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRemoveReactionRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRemoveReaction(ctx context.Context, params *PostRemoveReactionParams, body PostRemoveReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRemoveReactionRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostSendMessageWithBody(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostSendMessageRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
@@ -237,19 +585,31 @@ func (c *Client) PostSendMessage(ctx context.Context, params *PostSendMessagePar
 	return c.Client.Do(req)
 }
 
-// NewPostGetHistoryRequest calls the generic PostGetHistory builder with application/json body
-func NewPostGetHistoryRequest(server string, params *PostGetHistoryParams, body PostGetHistoryJSONRequestBody) (*http.Request, error) {
+func (c *Client) PostUploadAttachmentWithBody(ctx context.Context, params *PostUploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUploadAttachmentRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewPostAddReactionRequest calls the generic PostAddReaction builder with application/json body
+func NewPostAddReactionRequest(server string, params *PostAddReactionParams, body PostAddReactionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostGetHistoryRequestWithBody(server, params, "application/json", bodyReader)
+	return NewPostAddReactionRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPostGetHistoryRequestWithBody generates requests for PostGetHistory with any type of body
-func NewPostGetHistoryRequestWithBody(server string, params *PostGetHistoryParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostAddReactionRequestWithBody generates requests for PostAddReaction with any type of body
+func NewPostAddReactionRequestWithBody(server string, params *PostAddReactionParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -257,7 +617,7 @@ func NewPostGetHistoryRequestWithBody(server string, params *PostGetHistoryParam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/getHistory")
+	operationPath := fmt.Sprintf("/addReaction")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -290,19 +650,19 @@ func NewPostGetHistoryRequestWithBody(server string, params *PostGetHistoryParam
 	return req, nil
 }
 
-// NewPostSendMessageRequest calls the generic PostSendMessage builder with application/json body
-func NewPostSendMessageRequest(server string, params *PostSendMessageParams, body PostSendMessageJSONRequestBody) (*http.Request, error) {
+// NewPostDeleteMessageRequest calls the generic PostDeleteMessage builder with application/json body
+func NewPostDeleteMessageRequest(server string, params *PostDeleteMessageParams, body PostDeleteMessageJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostSendMessageRequestWithBody(server, params, "application/json", bodyReader)
+	return NewPostDeleteMessageRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPostSendMessageRequestWithBody generates requests for PostSendMessage with any type of body
-func NewPostSendMessageRequestWithBody(server string, params *PostSendMessageParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostDeleteMessageRequestWithBody generates requests for PostDeleteMessage with any type of body
+func NewPostDeleteMessageRequestWithBody(server string, params *PostDeleteMessageParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -310,7 +670,7 @@ func NewPostSendMessageRequestWithBody(server string, params *PostSendMessagePar
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sendMessage")
+	operationPath := fmt.Sprintf("/deleteMessage")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -343,58 +703,459 @@ func NewPostSendMessageRequestWithBody(server string, params *PostSendMessagePar
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewPostEditMessageRequest calls the generic PostEditMessage builder with application/json body
+func NewPostEditMessageRequest(server string, params *PostEditMessageParams, body PostEditMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEditMessageRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewPostEditMessageRequestWithBody generates requests for PostEditMessage with any type of body
+func NewPostEditMessageRequestWithBody(server string, params *PostEditMessageParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/editMessage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostGetHistoryWithBodyWithResponse request with any body
-	PostGetHistoryWithBodyWithResponse(ctx context.Context, params *PostGetHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetHistoryResponse, error)
 
-	PostGetHistoryWithResponse(ctx context.Context, params *PostGetHistoryParams, body PostGetHistoryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGetHistoryResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostSendMessageWithBodyWithResponse request with any body
-	PostSendMessageWithBodyWithResponse(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostGetHistoryRequest calls the generic PostGetHistory builder with application/json body
+func NewPostGetHistoryRequest(server string, params *PostGetHistoryParams, body PostGetHistoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostGetHistoryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostGetHistoryRequestWithBody generates requests for PostGetHistory with any type of body
+func NewPostGetHistoryRequestWithBody(server string, params *PostGetHistoryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/getHistory")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostRateProblemRequest calls the generic PostRateProblem builder with application/json body
+func NewPostRateProblemRequest(server string, params *PostRateProblemParams, body PostRateProblemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRateProblemRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRateProblemRequestWithBody generates requests for PostRateProblem with any type of body
+func NewPostRateProblemRequestWithBody(server string, params *PostRateProblemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rateProblem")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostRemoveReactionRequest calls the generic PostRemoveReaction builder with application/json body
+func NewPostRemoveReactionRequest(server string, params *PostRemoveReactionParams, body PostRemoveReactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRemoveReactionRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRemoveReactionRequestWithBody generates requests for PostRemoveReaction with any type of body
+func NewPostRemoveReactionRequestWithBody(server string, params *PostRemoveReactionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/removeReaction")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostSendMessageRequest calls the generic PostSendMessage builder with application/json body
+func NewPostSendMessageRequest(server string, params *PostSendMessageParams, body PostSendMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSendMessageRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostSendMessageRequestWithBody generates requests for PostSendMessage with any type of body
+func NewPostSendMessageRequestWithBody(server string, params *PostSendMessageParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sendMessage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostUploadAttachmentRequestWithBody generates requests for PostUploadAttachment with any type of body
+func NewPostUploadAttachmentRequestWithBody(server string, params *PostUploadAttachmentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uploadAttachment")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostAddReactionWithBodyWithResponse request with any body
+	PostAddReactionWithBodyWithResponse(ctx context.Context, params *PostAddReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAddReactionResponse, error)
+
+	PostAddReactionWithResponse(ctx context.Context, params *PostAddReactionParams, body PostAddReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAddReactionResponse, error)
+
+	// PostDeleteMessageWithBodyWithResponse request with any body
+	PostDeleteMessageWithBodyWithResponse(ctx context.Context, params *PostDeleteMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeleteMessageResponse, error)
+
+	PostDeleteMessageWithResponse(ctx context.Context, params *PostDeleteMessageParams, body PostDeleteMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDeleteMessageResponse, error)
+
+	// PostEditMessageWithBodyWithResponse request with any body
+	PostEditMessageWithBodyWithResponse(ctx context.Context, params *PostEditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEditMessageResponse, error)
+
+	PostEditMessageWithResponse(ctx context.Context, params *PostEditMessageParams, body PostEditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEditMessageResponse, error)
+
+	// PostGetHistoryWithBodyWithResponse request with any body
+	PostGetHistoryWithBodyWithResponse(ctx context.Context, params *PostGetHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetHistoryResponse, error)
+
+	PostGetHistoryWithResponse(ctx context.Context, params *PostGetHistoryParams, body PostGetHistoryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGetHistoryResponse, error)
+
+	// PostRateProblemWithBodyWithResponse request with any body
+	PostRateProblemWithBodyWithResponse(ctx context.Context, params *PostRateProblemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRateProblemResponse, error)
+
+	PostRateProblemWithResponse(ctx context.Context, params *PostRateProblemParams, body PostRateProblemJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRateProblemResponse, error)
+
+	// PostRemoveReactionWithBodyWithResponse request with any body
+	PostRemoveReactionWithBodyWithResponse(ctx context.Context, params *PostRemoveReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRemoveReactionResponse, error)
+
+	PostRemoveReactionWithResponse(ctx context.Context, params *PostRemoveReactionParams, body PostRemoveReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRemoveReactionResponse, error)
+
+	// PostSendMessageWithBodyWithResponse request with any body
+	PostSendMessageWithBodyWithResponse(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error)
+
+	PostSendMessageWithResponse(ctx context.Context, params *PostSendMessageParams, body PostSendMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error)
+
+	// PostUploadAttachmentWithBodyWithResponse request with any body
+	PostUploadAttachmentWithBodyWithResponse(ctx context.Context, params *PostUploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUploadAttachmentResponse, error)
+}
+
+type PostAddReactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReactionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAddReactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAddReactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDeleteMessageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteMessageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDeleteMessageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDeleteMessageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEditMessageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EditMessageResponse
+}
 
-	PostSendMessageWithResponse(ctx context.Context, params *PostSendMessageParams, body PostSendMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error)
+// Status returns HTTPResponse.Status
+func (r PostEditMessageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEditMessageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type PostGetHistoryResponse struct {
@@ -419,6 +1180,50 @@ func (r PostGetHistoryResponse) StatusCode() int {
 	return 0
 }
 
+type PostRateProblemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RateProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRateProblemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRateProblemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRemoveReactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReactionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRemoveReactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRemoveReactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostSendMessageResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -441,6 +1246,79 @@ func (r PostSendMessageResponse) StatusCode() int {
 	return 0
 }
 
+type PostUploadAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadAttachmentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUploadAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUploadAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostAddReactionWithBodyWithResponse request with arbitrary body returning *PostAddReactionResponse
+func (c *ClientWithResponses) PostAddReactionWithBodyWithResponse(ctx context.Context, params *PostAddReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAddReactionResponse, error) {
+	rsp, err := c.PostAddReactionWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAddReactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAddReactionWithResponse(ctx context.Context, params *PostAddReactionParams, body PostAddReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAddReactionResponse, error) {
+	rsp, err := c.PostAddReaction(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAddReactionResponse(rsp)
+}
+
+// PostDeleteMessageWithBodyWithResponse request with arbitrary body returning *PostDeleteMessageResponse
+func (c *ClientWithResponses) PostDeleteMessageWithBodyWithResponse(ctx context.Context, params *PostDeleteMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeleteMessageResponse, error) {
+	rsp, err := c.PostDeleteMessageWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeleteMessageResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostDeleteMessageWithResponse(ctx context.Context, params *PostDeleteMessageParams, body PostDeleteMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDeleteMessageResponse, error) {
+	rsp, err := c.PostDeleteMessage(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeleteMessageResponse(rsp)
+}
+
+// PostEditMessageWithBodyWithResponse request with arbitrary body returning *PostEditMessageResponse
+func (c *ClientWithResponses) PostEditMessageWithBodyWithResponse(ctx context.Context, params *PostEditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEditMessageResponse, error) {
+	rsp, err := c.PostEditMessageWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEditMessageResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEditMessageWithResponse(ctx context.Context, params *PostEditMessageParams, body PostEditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEditMessageResponse, error) {
+	rsp, err := c.PostEditMessage(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEditMessageResponse(rsp)
+}
+
 // PostGetHistoryWithBodyWithResponse request with arbitrary body returning *PostGetHistoryResponse
 func (c *ClientWithResponses) PostGetHistoryWithBodyWithResponse(ctx context.Context, params *PostGetHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetHistoryResponse, error) {
 	rsp, err := c.PostGetHistoryWithBody(ctx, params, contentType, body, reqEditors...)
@@ -458,6 +1336,40 @@ func (c *ClientWithResponses) PostGetHistoryWithResponse(ctx context.Context, pa
 	return ParsePostGetHistoryResponse(rsp)
 }
 
+// PostRateProblemWithBodyWithResponse request with arbitrary body returning *PostRateProblemResponse
+func (c *ClientWithResponses) PostRateProblemWithBodyWithResponse(ctx context.Context, params *PostRateProblemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRateProblemResponse, error) {
+	rsp, err := c.PostRateProblemWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRateProblemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRateProblemWithResponse(ctx context.Context, params *PostRateProblemParams, body PostRateProblemJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRateProblemResponse, error) {
+	rsp, err := c.PostRateProblem(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRateProblemResponse(rsp)
+}
+
+// PostRemoveReactionWithBodyWithResponse request with arbitrary body returning *PostRemoveReactionResponse
+func (c *ClientWithResponses) PostRemoveReactionWithBodyWithResponse(ctx context.Context, params *PostRemoveReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRemoveReactionResponse, error) {
+	rsp, err := c.PostRemoveReactionWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRemoveReactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRemoveReactionWithResponse(ctx context.Context, params *PostRemoveReactionParams, body PostRemoveReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRemoveReactionResponse, error) {
+	rsp, err := c.PostRemoveReaction(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRemoveReactionResponse(rsp)
+}
+
 // PostSendMessageWithBodyWithResponse request with arbitrary body returning *PostSendMessageResponse
 func (c *ClientWithResponses) PostSendMessageWithBodyWithResponse(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error) {
 	rsp, err := c.PostSendMessageWithBody(ctx, params, contentType, body, reqEditors...)
@@ -475,6 +1387,93 @@ func (c *ClientWithResponses) PostSendMessageWithResponse(ctx context.Context, p
 	return ParsePostSendMessageResponse(rsp)
 }
 
+// PostUploadAttachmentWithBodyWithResponse request with arbitrary body returning *PostUploadAttachmentResponse
+func (c *ClientWithResponses) PostUploadAttachmentWithBodyWithResponse(ctx context.Context, params *PostUploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUploadAttachmentResponse, error) {
+	rsp, err := c.PostUploadAttachmentWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUploadAttachmentResponse(rsp)
+}
+
+// ParsePostAddReactionResponse parses an HTTP response from a PostAddReactionWithResponse call
+func ParsePostAddReactionResponse(rsp *http.Response) (*PostAddReactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAddReactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReactionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDeleteMessageResponse parses an HTTP response from a PostDeleteMessageWithResponse call
+func ParsePostDeleteMessageResponse(rsp *http.Response) (*PostDeleteMessageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDeleteMessageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteMessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEditMessageResponse parses an HTTP response from a PostEditMessageWithResponse call
+func ParsePostEditMessageResponse(rsp *http.Response) (*PostEditMessageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEditMessageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EditMessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostGetHistoryResponse parses an HTTP response from a PostGetHistoryWithResponse call
 func ParsePostGetHistoryResponse(rsp *http.Response) (*PostGetHistoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -501,6 +1500,58 @@ func ParsePostGetHistoryResponse(rsp *http.Response) (*PostGetHistoryResponse, e
 	return response, nil
 }
 
+// ParsePostRateProblemResponse parses an HTTP response from a PostRateProblemWithResponse call
+func ParsePostRateProblemResponse(rsp *http.Response) (*PostRateProblemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRateProblemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RateProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRemoveReactionResponse parses an HTTP response from a PostRemoveReactionWithResponse call
+func ParsePostRemoveReactionResponse(rsp *http.Response) (*PostRemoveReactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRemoveReactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReactionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostSendMessageResponse parses an HTTP response from a PostSendMessageWithResponse call
 func ParsePostSendMessageResponse(rsp *http.Response) (*PostSendMessageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -526,3 +1577,29 @@ func ParsePostSendMessageResponse(rsp *http.Response) (*PostSendMessageResponse,
 
 	return response, nil
 }
+
+// ParsePostUploadAttachmentResponse parses an HTTP response from a PostUploadAttachmentWithResponse call
+func ParsePostUploadAttachmentResponse(rsp *http.Response) (*PostUploadAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUploadAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UploadAttachmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
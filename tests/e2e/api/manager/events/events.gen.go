@@ -13,6 +13,26 @@ import (
 	"github.com/zestagio/chat-service/internal/types"
 )
 
+// Defines values for ReactionKind.
+const (
+	ReactionKindHeart      ReactionKind = "heart"
+	ReactionKindLaugh      ReactionKind = "laugh"
+	ReactionKindSad        ReactionKind = "sad"
+	ReactionKindSurprised  ReactionKind = "surprised"
+	ReactionKindThumbsDown ReactionKind = "thumbs_down"
+	ReactionKindThumbsUp   ReactionKind = "thumbs_up"
+)
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType  string             `json:"contentType"`
+	FileName     string             `json:"fileName"`
+	Id           types.AttachmentID `json:"id"`
+	Size         int64              `json:"size"`
+	ThumbnailUrl *string            `json:"thumbnailUrl,omitempty"`
+	Url          string             `json:"url"`
+}
+
 // ChatClosedEvent defines model for ChatClosedEvent.
 type ChatClosedEvent struct {
 	CanTakeMoreProblems bool         `json:"canTakeMoreProblems"`
@@ -24,6 +44,21 @@ type ChatId struct {
 	ChatId types.ChatID `json:"chatId"`
 }
 
+// ChatUpdatedEvent defines model for ChatUpdatedEvent.
+type ChatUpdatedEvent struct {
+	ChatId      types.ChatID `json:"chatId"`
+	LastMessage *LastMessage `json:"lastMessage,omitempty"`
+
+	// ProblemCreatedAt The problem age is counted from it.
+	ProblemCreatedAt time.Time `json:"problemCreatedAt"`
+
+	// UnreadCount The client messages created after the manager has read the chat.
+	UnreadCount int `json:"unreadCount"`
+
+	// WaitingSince The oldest client message the manager has not answered yet. Absent if there is none.
+	WaitingSince *time.Time `json:"waitingSince,omitempty"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	EventId   types.EventID   `json:"eventId"`
@@ -32,12 +67,43 @@ type Event struct {
 	union     json.RawMessage
 }
 
+// LastMessage defines model for LastMessage.
+type LastMessage struct {
+	// AuthorId Absent if the message is a service one.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the message body.
+	Preview string `json:"preview"`
+}
+
 // Message defines model for Message.
 type Message struct {
-	AuthorId  types.UserID    `json:"authorId"`
+	Attachments *[]Attachment `json:"attachments,omitempty"`
+	AuthorId    types.UserID  `json:"authorId"`
+	Body        string        `json:"body"`
+	ChatId      types.ChatID  `json:"chatId"`
+	CreatedAt   time.Time     `json:"createdAt"`
+
+	// IsInternalNote The note is visible for managers only.
+	IsInternalNote *bool           `json:"isInternalNote,omitempty"`
+	MessageId      types.MessageID `json:"messageId"`
+	ReplyTo        *Quote          `json:"replyTo,omitempty"`
+}
+
+// MessageDeletedEvent defines model for MessageDeletedEvent.
+type MessageDeletedEvent struct {
+	ChatId    types.ChatID    `json:"chatId"`
+	DeletedAt time.Time       `json:"deletedAt"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// MessageEditedEvent defines model for MessageEditedEvent.
+type MessageEditedEvent struct {
 	Body      string          `json:"body"`
 	ChatId    types.ChatID    `json:"chatId"`
-	CreatedAt time.Time       `json:"createdAt"`
+	EditedAt  time.Time       `json:"editedAt"`
 	MessageId types.MessageID `json:"messageId"`
 }
 
@@ -56,6 +122,35 @@ type NewChatEvent struct {
 // NewMessageEvent defines model for NewMessageEvent.
 type NewMessageEvent = Message
 
+// Quote defines model for Quote.
+type Quote struct {
+	// AuthorId Absent if the quoted message is a service one or is hidden from you.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the quoted message body. Empty if the message is deleted or is hidden from you.
+	Preview string `json:"preview"`
+}
+
+// Reaction defines model for Reaction.
+type Reaction struct {
+	Count       int          `json:"count"`
+	Kind        ReactionKind `json:"kind"`
+	ReactedByMe bool         `json:"reactedByMe"`
+}
+
+// ReactionKind defines model for Reaction.Kind.
+type ReactionKind string
+
+// ReactionsChangedEvent defines model for ReactionsChangedEvent.
+type ReactionsChangedEvent struct {
+	ChatId    types.ChatID    `json:"chatId"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Reactions The actual reactions on the message, empty if the last one was taken off.
+	Reactions []Reaction `json:"reactions"`
+}
+
 // AsNewChatEvent returns the union data inside the Event as a NewChatEvent
 func (t Event) AsNewChatEvent() (NewChatEvent, error) {
 	var body NewChatEvent
@@ -146,6 +241,126 @@ func (t *Event) MergeChatClosedEvent(v ChatClosedEvent) error {
 	return err
 }
 
+// AsMessageEditedEvent returns the union data inside the Event as a MessageEditedEvent
+func (t Event) AsMessageEditedEvent() (MessageEditedEvent, error) {
+	var body MessageEditedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMessageEditedEvent overwrites any union data inside the Event as the provided MessageEditedEvent
+func (t *Event) FromMessageEditedEvent(v MessageEditedEvent) error {
+	t.EventType = "MessageEditedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMessageEditedEvent performs a merge with any union data inside the Event, using the provided MessageEditedEvent
+func (t *Event) MergeMessageEditedEvent(v MessageEditedEvent) error {
+	t.EventType = "MessageEditedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsMessageDeletedEvent returns the union data inside the Event as a MessageDeletedEvent
+func (t Event) AsMessageDeletedEvent() (MessageDeletedEvent, error) {
+	var body MessageDeletedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMessageDeletedEvent overwrites any union data inside the Event as the provided MessageDeletedEvent
+func (t *Event) FromMessageDeletedEvent(v MessageDeletedEvent) error {
+	t.EventType = "MessageDeletedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMessageDeletedEvent performs a merge with any union data inside the Event, using the provided MessageDeletedEvent
+func (t *Event) MergeMessageDeletedEvent(v MessageDeletedEvent) error {
+	t.EventType = "MessageDeletedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsReactionsChangedEvent returns the union data inside the Event as a ReactionsChangedEvent
+func (t Event) AsReactionsChangedEvent() (ReactionsChangedEvent, error) {
+	var body ReactionsChangedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromReactionsChangedEvent overwrites any union data inside the Event as the provided ReactionsChangedEvent
+func (t *Event) FromReactionsChangedEvent(v ReactionsChangedEvent) error {
+	t.EventType = "ReactionsChangedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeReactionsChangedEvent performs a merge with any union data inside the Event, using the provided ReactionsChangedEvent
+func (t *Event) MergeReactionsChangedEvent(v ReactionsChangedEvent) error {
+	t.EventType = "ReactionsChangedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChatUpdatedEvent returns the union data inside the Event as a ChatUpdatedEvent
+func (t Event) AsChatUpdatedEvent() (ChatUpdatedEvent, error) {
+	var body ChatUpdatedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChatUpdatedEvent overwrites any union data inside the Event as the provided ChatUpdatedEvent
+func (t *Event) FromChatUpdatedEvent(v ChatUpdatedEvent) error {
+	t.EventType = "ChatUpdatedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChatUpdatedEvent performs a merge with any union data inside the Event, using the provided ChatUpdatedEvent
+func (t *Event) MergeChatUpdatedEvent(v ChatUpdatedEvent) error {
+	t.EventType = "ChatUpdatedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t Event) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"eventType"`
@@ -162,10 +377,18 @@ func (t Event) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "ChatClosedEvent":
 		return t.AsChatClosedEvent()
+	case "ChatUpdatedEvent":
+		return t.AsChatUpdatedEvent()
+	case "MessageDeletedEvent":
+		return t.AsMessageDeletedEvent()
+	case "MessageEditedEvent":
+		return t.AsMessageEditedEvent()
 	case "NewChatEvent":
 		return t.AsNewChatEvent()
 	case "NewMessageEvent":
 		return t.AsNewMessageEvent()
+	case "ReactionsChangedEvent":
+		return t.AsReactionsChangedEvent()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/zestagio/chat-service/internal/types"
 )
 
@@ -25,13 +26,94 @@ const (
 // Defines values for ErrorCode.
 const (
 	ErrorCodeAssignedProblemNotFound ErrorCode = 5001
+	ErrorCodeEditWindowExpired       ErrorCode = 5003
 	ErrorCodeManagerOverloaded       ErrorCode = 5000
+	ErrorCodeMessageNotEditable      ErrorCode = 5002
+	ErrorCodeShortcutTaken           ErrorCode = 5004
+	ErrorCodeUnknownProblemCategory  ErrorCode = 5005
+	ErrorCodeUnknownResolutionCode   ErrorCode = 5006
 )
 
+// Defines values for ReactionKind.
+const (
+	ReactionKindHeart      ReactionKind = "heart"
+	ReactionKindLaugh      ReactionKind = "laugh"
+	ReactionKindSad        ReactionKind = "sad"
+	ReactionKindSurprised  ReactionKind = "surprised"
+	ReactionKindThumbsDown ReactionKind = "thumbs_down"
+	ReactionKindThumbsUp   ReactionKind = "thumbs_up"
+)
+
+// AddInternalNoteRequest defines model for AddInternalNoteRequest.
+type AddInternalNoteRequest struct {
+	ChatId      types.ChatID `json:"chatId"`
+	MessageBody string       `json:"messageBody"`
+}
+
+// AddInternalNoteResponse defines model for AddInternalNoteResponse.
+type AddInternalNoteResponse struct {
+	Data  *MessageWithoutBody `json:"data,omitempty"`
+	Error *Error              `json:"error,omitempty"`
+}
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType string             `json:"contentType"`
+	FileName    string             `json:"fileName"`
+	Id          types.AttachmentID `json:"id"`
+	Size        int64              `json:"size"`
+
+	// ThumbnailUrl The signed URL of the image thumbnail. It is absent for the other files.
+	ThumbnailUrl *string `json:"thumbnailUrl,omitempty"`
+
+	// Url The signed download URL, it expires in some time. Get the chat history again to renew it.
+	Url string `json:"url"`
+}
+
+// CannedResponse defines model for CannedResponse.
+type CannedResponse struct {
+	AuthorId types.UserID `json:"authorId"`
+
+	// Body The template of the message, it may contain the variables like {{client_name}}.
+	Body string                 `json:"body"`
+	Id   types.CannedResponseID `json:"id"`
+
+	// IsShared The response is available to the whole team.
+	IsShared  bool      `json:"isShared"`
+	Shortcut  string    `json:"shortcut"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CannedResponseList defines model for CannedResponseList.
+type CannedResponseList struct {
+	CannedResponses []CannedResponse `json:"cannedResponses"`
+}
+
+// CannedResponseResponse defines model for CannedResponseResponse.
+type CannedResponseResponse struct {
+	Data  *CannedResponse `json:"data,omitempty"`
+	Error *Error          `json:"error,omitempty"`
+}
+
 // Chat defines model for Chat.
 type Chat struct {
-	ChatId   types.ChatID `json:"chatId"`
-	ClientId types.UserID `json:"clientId"`
+	// Category The problem category. Absent until the manager sets it.
+	Category    *string      `json:"category,omitempty"`
+	ChatId      types.ChatID `json:"chatId"`
+	ClientId    types.UserID `json:"clientId"`
+	LastMessage *LastMessage `json:"lastMessage,omitempty"`
+
+	// ProblemCreatedAt The problem age is counted from it.
+	ProblemCreatedAt time.Time `json:"problemCreatedAt"`
+
+	// ReopenedFromProblemId The resolved problem of the chat continued by the current one. Absent if the problem is a new one.
+	ReopenedFromProblemId *types.ProblemID `json:"reopenedFromProblemId,omitempty"`
+
+	// UnreadCount The client messages shown to the manager (after the AFC check) since they have read the chat.
+	UnreadCount int `json:"unreadCount"`
+
+	// WaitingSince The oldest client message the manager has not answered yet. Absent if there is none.
+	WaitingSince *time.Time `json:"waitingSince,omitempty"`
 }
 
 // ChatId defines model for ChatId.
@@ -45,7 +127,13 @@ type ChatList struct {
 }
 
 // CloseChatRequest defines model for CloseChatRequest.
-type CloseChatRequest = ChatId
+type CloseChatRequest struct {
+	ChatId types.ChatID `json:"chatId"`
+
+	// ResolutionCode One of the resolution codes from /getProblemTaxonomy.
+	ResolutionCode    string  `json:"resolutionCode"`
+	ResolutionSummary *string `json:"resolutionSummary,omitempty"`
+}
 
 // CloseChatResponse defines model for CloseChatResponse.
 type CloseChatResponse struct {
@@ -53,15 +141,72 @@ type CloseChatResponse struct {
 	Error *Error                  `json:"error,omitempty"`
 }
 
+// CreateCannedResponseRequest defines model for CreateCannedResponseRequest.
+type CreateCannedResponseRequest struct {
+	Body string `json:"body"`
+
+	// IsShared Share the response with the whole team.
+	IsShared *bool  `json:"isShared,omitempty"`
+	Shortcut string `json:"shortcut"`
+}
+
+// DeleteCannedResponseRequest defines model for DeleteCannedResponseRequest.
+type DeleteCannedResponseRequest struct {
+	Id types.CannedResponseID `json:"id"`
+}
+
+// DeleteCannedResponseResponse defines model for DeleteCannedResponseResponse.
+type DeleteCannedResponseResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// DeleteMessageRequest defines model for DeleteMessageRequest.
+type DeleteMessageRequest struct {
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// DeleteMessageResponse defines model for DeleteMessageResponse.
+type DeleteMessageResponse struct {
+	Data  *DeletedMessageHeader `json:"data,omitempty"`
+	Error *Error                `json:"error,omitempty"`
+}
+
+// DeletedMessageHeader defines model for DeletedMessageHeader.
+type DeletedMessageHeader struct {
+	DeletedAt time.Time       `json:"deletedAt"`
+	Id        types.MessageID `json:"id"`
+}
+
+// EditMessageRequest defines model for EditMessageRequest.
+type EditMessageRequest struct {
+	MessageBody string          `json:"messageBody"`
+	MessageId   types.MessageID `json:"messageId"`
+}
+
+// EditMessageResponse defines model for EditMessageResponse.
+type EditMessageResponse struct {
+	Data  *EditedMessageHeader `json:"data,omitempty"`
+	Error *Error               `json:"error,omitempty"`
+}
+
+// EditedMessageHeader defines model for EditedMessageHeader.
+type EditedMessageHeader struct {
+	EditedAt time.Time       `json:"editedAt"`
+	Id       types.MessageID `json:"id"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
+	// The upload errors are 413 for the too large file and 415 for the not allowed file type.
 	Code    ErrorCode `json:"code"`
 	Details *string   `json:"details,omitempty"`
 	Message string    `json:"message"`
 }
 
 // ErrorCode contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
+// The upload errors are 413 for the too large file and 415 for the not allowed file type.
 type ErrorCode int
 
 // FreeHandsBtnAvailability defines model for FreeHandsBtnAvailability.
@@ -75,11 +220,29 @@ type FreeHandsResponse struct {
 	Error *Error                  `json:"error,omitempty"`
 }
 
+// GetCannedResponsesRequest defines model for GetCannedResponsesRequest.
+type GetCannedResponsesRequest struct {
+	// ShortcutPrefix Return only the responses which shortcuts start with it.
+	ShortcutPrefix *string `json:"shortcutPrefix,omitempty"`
+}
+
+// GetCannedResponsesResponse defines model for GetCannedResponsesResponse.
+type GetCannedResponsesResponse struct {
+	Data  *CannedResponseList `json:"data,omitempty"`
+	Error *Error              `json:"error,omitempty"`
+}
+
 // GetChatHistoryRequest defines model for GetChatHistoryRequest.
 type GetChatHistoryRequest struct {
-	ChatId   types.ChatID `json:"chatId"`
-	Cursor   *string      `json:"cursor,omitempty"`
-	PageSize *int         `json:"pageSize,omitempty"`
+	// AroundMessageId Open the history at the message (e.g. the search result or the quote), requires pageSize.
+	AroundMessageId *types.MessageID `json:"aroundMessageId,omitempty"`
+	ChatId          types.ChatID     `json:"chatId"`
+	Cursor          *string          `json:"cursor,omitempty"`
+	PageSize        *int             `json:"pageSize,omitempty"`
+
+	// ProblemId The problem of the chat to read (e.g. from the search result), the chat must have been handled by the manager.
+	// The problem currently assigned to the manager if absent.
+	ProblemId *types.ProblemID `json:"problemId,omitempty"`
 }
 
 // GetChatHistoryResponse defines model for GetChatHistoryResponse.
@@ -100,12 +263,72 @@ type GetFreeHandsBtnAvailabilityResponse struct {
 	Error *Error                    `json:"error,omitempty"`
 }
 
+// GetProblemTaxonomyResponse defines model for GetProblemTaxonomyResponse.
+type GetProblemTaxonomyResponse struct {
+	Data  *ProblemTaxonomy `json:"data,omitempty"`
+	Error *Error           `json:"error,omitempty"`
+}
+
+// GetSatisfactionScoresRequest defines model for GetSatisfactionScoresRequest.
+type GetSatisfactionScoresRequest struct {
+	// AllManagers Get the scores of the whole team, only for the team leads.
+	AllManagers *bool `json:"allManagers,omitempty"`
+
+	// From The ratings given since this time, inclusive.
+	From time.Time `json:"from"`
+
+	// To The ratings given before this time, exclusive.
+	To time.Time `json:"to"`
+}
+
+// GetSatisfactionScoresResponse defines model for GetSatisfactionScoresResponse.
+type GetSatisfactionScoresResponse struct {
+	Data  *SatisfactionScoreList `json:"data,omitempty"`
+	Error *Error                 `json:"error,omitempty"`
+}
+
+// LastMessage defines model for LastMessage.
+type LastMessage struct {
+	// AuthorId Absent if the message is a service one.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the message body.
+	Preview string `json:"preview"`
+}
+
+// MarkChatAsReadRequest defines model for MarkChatAsReadRequest.
+type MarkChatAsReadRequest = ChatId
+
+// MarkChatAsReadResponse defines model for MarkChatAsReadResponse.
+type MarkChatAsReadResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
 // Message defines model for Message.
 type Message struct {
-	AuthorId  types.UserID    `json:"authorId"`
-	Body      string          `json:"body"`
-	CreatedAt time.Time       `json:"createdAt"`
+	Attachments *[]Attachment `json:"attachments,omitempty"`
+	AuthorId    types.UserID  `json:"authorId"`
+	Body        string        `json:"body"`
+	CreatedAt   time.Time     `json:"createdAt"`
+
+	// DeletedAt The body and the attachments of the deleted message are empty.
+	DeletedAt *time.Time      `json:"deletedAt,omitempty"`
+	EditedAt  *time.Time      `json:"editedAt,omitempty"`
 	Id        types.MessageID `json:"id"`
+
+	// IsInternalNote The note is visible for managers only.
+	IsInternalNote *bool       `json:"isInternalNote,omitempty"`
+	Reactions      *[]Reaction `json:"reactions,omitempty"`
+	ReplyTo        *Quote      `json:"replyTo,omitempty"`
+}
+
+// MessageReactions defines model for MessageReactions.
+type MessageReactions struct {
+	Id        types.MessageID `json:"id"`
+	Reactions []Reaction      `json:"reactions"`
 }
 
 // MessageWithoutBody defines model for MessageWithoutBody.
@@ -118,13 +341,125 @@ type MessageWithoutBody struct {
 // MessagesPage defines model for MessagesPage.
 type MessagesPage struct {
 	Messages []Message `json:"messages"`
-	Next     string    `json:"next"`
+
+	// Next The cursor to the older messages, empty if there are no ones.
+	Next string `json:"next"`
+
+	// Prev The cursor to the newer messages, empty if the page is the newest one.
+	Prev string `json:"prev"`
+}
+
+// ProblemTaxonomy defines model for ProblemTaxonomy.
+type ProblemTaxonomy struct {
+	Categories      []string `json:"categories"`
+	ResolutionCodes []string `json:"resolutionCodes"`
+}
+
+// Quote defines model for Quote.
+type Quote struct {
+	// AuthorId Absent if the quoted message is a service one or is hidden from you.
+	AuthorId  *types.UserID   `json:"authorId,omitempty"`
+	MessageId types.MessageID `json:"messageId"`
+
+	// Preview The beginning of the quoted message body. Empty if the message is deleted or is hidden from you.
+	Preview string `json:"preview"`
+}
+
+// Reaction defines model for Reaction.
+type Reaction struct {
+	Count int          `json:"count"`
+	Kind  ReactionKind `json:"kind"`
+
+	// ReactedByMe The current user has put the reaction of this kind.
+	ReactedByMe bool `json:"reactedByMe"`
+}
+
+// ReactionKind defines model for ReactionKind.
+type ReactionKind string
+
+// ReactionRequest defines model for ReactionRequest.
+type ReactionRequest struct {
+	Kind      ReactionKind    `json:"kind"`
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// ReactionsResponse defines model for ReactionsResponse.
+type ReactionsResponse struct {
+	Data  *MessageReactions `json:"data,omitempty"`
+	Error *Error            `json:"error,omitempty"`
+}
+
+// SatisfactionScore defines model for SatisfactionScore.
+type SatisfactionScore struct {
+	AverageScore float64      `json:"averageScore"`
+	ManagerId    types.UserID `json:"managerId"`
+	RatingsCount int          `json:"ratingsCount"`
+
+	// SatisfiedCount The ratings of 4 and 5, CSAT is satisfiedCount / ratingsCount.
+	SatisfiedCount int `json:"satisfiedCount"`
+}
+
+// SatisfactionScoreList defines model for SatisfactionScoreList.
+type SatisfactionScoreList struct {
+	Scores []SatisfactionScore `json:"scores"`
+}
+
+// SearchMessagesRequest defines model for SearchMessagesRequest.
+type SearchMessagesRequest struct {
+	Cursor   *string `json:"cursor,omitempty"`
+	PageSize *int    `json:"pageSize,omitempty"`
+
+	// Query Words to search in Russian or English, supports "quoted phrases", OR and -exclusions.
+	Query string `json:"query"`
+}
+
+// SearchMessagesResponse defines model for SearchMessagesResponse.
+type SearchMessagesResponse struct {
+	Data  *SearchResultsPage `json:"data,omitempty"`
+	Error *Error             `json:"error,omitempty"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	AuthorId  *types.UserID `json:"authorId,omitempty"`
+	ChatId    types.ChatID  `json:"chatId"`
+	CreatedAt time.Time     `json:"createdAt"`
+
+	// HistoryCursor The /getChatHistory cursor of the page starting with the message.
+	// It is used together with the problemId, so the resolved problems can be opened too.
+	HistoryCursor string          `json:"historyCursor"`
+	MessageId     types.MessageID `json:"messageId"`
+	ProblemId     types.ProblemID `json:"problemId"`
+
+	// Snippet HTML-escaped fragments of the message body, the matched words are wrapped into <mark></mark>.
+	Snippet string `json:"snippet"`
+}
+
+// SearchResultsPage defines model for SearchResultsPage.
+type SearchResultsPage struct {
+	Next    string         `json:"next"`
+	Results []SearchResult `json:"results"`
+}
+
+// SendCannedResponseRequest defines model for SendCannedResponseRequest.
+type SendCannedResponseRequest struct {
+	CannedResponseId types.CannedResponseID `json:"cannedResponseId"`
+	ChatId           types.ChatID           `json:"chatId"`
+
+	// ReplyToMessageId The message of the chat to answer to.
+	ReplyToMessageId *types.MessageID `json:"replyToMessageId,omitempty"`
 }
 
 // SendMessageRequest defines model for SendMessageRequest.
 type SendMessageRequest struct {
-	ChatId      types.ChatID `json:"chatId"`
-	MessageBody string       `json:"messageBody"`
+	AttachmentIds *[]types.AttachmentID `json:"attachmentIds,omitempty"`
+	ChatId        types.ChatID          `json:"chatId"`
+
+	// MessageBody Can be empty if the message has attachments.
+	MessageBody string `json:"messageBody"`
+
+	// ReplyToMessageId The message of the chat to answer to.
+	ReplyToMessageId *types.MessageID `json:"replyToMessageId,omitempty"`
 }
 
 // SendMessageResponse defines model for SendMessageResponse.
@@ -133,19 +468,86 @@ type SendMessageResponse struct {
 	Error *Error              `json:"error,omitempty"`
 }
 
+// SetProblemCategoryRequest defines model for SetProblemCategoryRequest.
+type SetProblemCategoryRequest struct {
+	// Category One of the categories from /getProblemTaxonomy.
+	Category string       `json:"category"`
+	ChatId   types.ChatID `json:"chatId"`
+}
+
+// SetProblemCategoryResponse defines model for SetProblemCategoryResponse.
+type SetProblemCategoryResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// UpdateCannedResponseRequest defines model for UpdateCannedResponseRequest.
+type UpdateCannedResponseRequest struct {
+	Body     string                 `json:"body"`
+	Id       types.CannedResponseID `json:"id"`
+	Shortcut string                 `json:"shortcut"`
+}
+
+// UploadAttachmentRequest defines model for UploadAttachmentRequest.
+type UploadAttachmentRequest struct {
+	ChatId types.ChatID       `json:"chatId"`
+	File   openapi_types.File `json:"file"`
+}
+
+// UploadAttachmentResponse defines model for UploadAttachmentResponse.
+type UploadAttachmentResponse struct {
+	Data  *Attachment `json:"data,omitempty"`
+	Error *Error      `json:"error,omitempty"`
+}
+
 // XRequestIDHeader defines model for XRequestIDHeader.
 type XRequestIDHeader = types.RequestID
 
+// PostAddInternalNoteParams defines parameters for PostAddInternalNote.
+type PostAddInternalNoteParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostAddReactionParams defines parameters for PostAddReaction.
+type PostAddReactionParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostCloseChatParams defines parameters for PostCloseChat.
 type PostCloseChatParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostCreateCannedResponseParams defines parameters for PostCreateCannedResponse.
+type PostCreateCannedResponseParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostDeleteCannedResponseParams defines parameters for PostDeleteCannedResponse.
+type PostDeleteCannedResponseParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostDeleteMessageParams defines parameters for PostDeleteMessage.
+type PostDeleteMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostEditMessageParams defines parameters for PostEditMessage.
+type PostEditMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostFreeHandsParams defines parameters for PostFreeHands.
 type PostFreeHandsParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostGetCannedResponsesParams defines parameters for PostGetCannedResponses.
+type PostGetCannedResponsesParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostGetChatHistoryParams defines parameters for PostGetChatHistory.
 type PostGetChatHistoryParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
@@ -161,20 +563,110 @@ type PostGetFreeHandsBtnAvailabilityParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostGetProblemTaxonomyParams defines parameters for PostGetProblemTaxonomy.
+type PostGetProblemTaxonomyParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostGetSatisfactionScoresParams defines parameters for PostGetSatisfactionScores.
+type PostGetSatisfactionScoresParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostMarkChatAsReadParams defines parameters for PostMarkChatAsRead.
+type PostMarkChatAsReadParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostRemoveReactionParams defines parameters for PostRemoveReaction.
+type PostRemoveReactionParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSearchMessagesParams defines parameters for PostSearchMessages.
+type PostSearchMessagesParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSendCannedResponseParams defines parameters for PostSendCannedResponse.
+type PostSendCannedResponseParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostSendMessageParams defines parameters for PostSendMessage.
 type PostSendMessageParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostSetProblemCategoryParams defines parameters for PostSetProblemCategory.
+type PostSetProblemCategoryParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostUpdateCannedResponseParams defines parameters for PostUpdateCannedResponse.
+type PostUpdateCannedResponseParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostUploadAttachmentParams defines parameters for PostUploadAttachment.
+type PostUploadAttachmentParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostAddInternalNoteJSONRequestBody defines body for PostAddInternalNote for application/json ContentType.
+type PostAddInternalNoteJSONRequestBody = AddInternalNoteRequest
+
+// PostAddReactionJSONRequestBody defines body for PostAddReaction for application/json ContentType.
+type PostAddReactionJSONRequestBody = ReactionRequest
+
 // PostCloseChatJSONRequestBody defines body for PostCloseChat for application/json ContentType.
 type PostCloseChatJSONRequestBody = CloseChatRequest
 
+// PostCreateCannedResponseJSONRequestBody defines body for PostCreateCannedResponse for application/json ContentType.
+type PostCreateCannedResponseJSONRequestBody = CreateCannedResponseRequest
+
+// PostDeleteCannedResponseJSONRequestBody defines body for PostDeleteCannedResponse for application/json ContentType.
+type PostDeleteCannedResponseJSONRequestBody = DeleteCannedResponseRequest
+
+// PostDeleteMessageJSONRequestBody defines body for PostDeleteMessage for application/json ContentType.
+type PostDeleteMessageJSONRequestBody = DeleteMessageRequest
+
+// PostEditMessageJSONRequestBody defines body for PostEditMessage for application/json ContentType.
+type PostEditMessageJSONRequestBody = EditMessageRequest
+
+// PostGetCannedResponsesJSONRequestBody defines body for PostGetCannedResponses for application/json ContentType.
+type PostGetCannedResponsesJSONRequestBody = GetCannedResponsesRequest
+
 // PostGetChatHistoryJSONRequestBody defines body for PostGetChatHistory for application/json ContentType.
 type PostGetChatHistoryJSONRequestBody = GetChatHistoryRequest
 
+// PostGetSatisfactionScoresJSONRequestBody defines body for PostGetSatisfactionScores for application/json ContentType.
+type PostGetSatisfactionScoresJSONRequestBody = GetSatisfactionScoresRequest
+
+// PostMarkChatAsReadJSONRequestBody defines body for PostMarkChatAsRead for application/json ContentType.
+type PostMarkChatAsReadJSONRequestBody = MarkChatAsReadRequest
+
+// PostRemoveReactionJSONRequestBody defines body for PostRemoveReaction for application/json ContentType.
+type PostRemoveReactionJSONRequestBody = ReactionRequest
+
+// PostSearchMessagesJSONRequestBody defines body for PostSearchMessages for application/json ContentType.
+type PostSearchMessagesJSONRequestBody = SearchMessagesRequest
+
+// PostSendCannedResponseJSONRequestBody defines body for PostSendCannedResponse for application/json ContentType.
+type PostSendCannedResponseJSONRequestBody = SendCannedResponseRequest
+
 // PostSendMessageJSONRequestBody defines body for PostSendMessage for application/json ContentType.
 type PostSendMessageJSONRequestBody = SendMessageRequest
 
+// PostSetProblemCategoryJSONRequestBody defines body for PostSetProblemCategory for application/json ContentType.
+type PostSetProblemCategoryJSONRequestBody = SetProblemCategoryRequest
+
+// PostUpdateCannedResponseJSONRequestBody defines body for PostUpdateCannedResponse for application/json ContentType.
+type PostUpdateCannedResponseJSONRequestBody = UpdateCannedResponseRequest
+
+// PostUploadAttachmentMultipartRequestBody defines body for PostUploadAttachment for multipart/form-data ContentType.
+type PostUploadAttachmentMultipartRequestBody = UploadAttachmentRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -248,14 +740,49 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// PostAddInternalNoteWithBody request with any body
+	PostAddInternalNoteWithBody(ctx context.Context, params *PostAddInternalNoteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAddInternalNote(ctx context.Context, params *PostAddInternalNoteParams, body PostAddInternalNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAddReactionWithBody request with any body
+	PostAddReactionWithBody(ctx context.Context, params *PostAddReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAddReaction(ctx context.Context, params *PostAddReactionParams, body PostAddReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostCloseChatWithBody request with any body
 	PostCloseChatWithBody(ctx context.Context, params *PostCloseChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostCloseChat(ctx context.Context, params *PostCloseChatParams, body PostCloseChatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostCreateCannedResponseWithBody request with any body
+	PostCreateCannedResponseWithBody(ctx context.Context, params *PostCreateCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostCreateCannedResponse(ctx context.Context, params *PostCreateCannedResponseParams, body PostCreateCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDeleteCannedResponseWithBody request with any body
+	PostDeleteCannedResponseWithBody(ctx context.Context, params *PostDeleteCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostDeleteCannedResponse(ctx context.Context, params *PostDeleteCannedResponseParams, body PostDeleteCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDeleteMessageWithBody request with any body
+	PostDeleteMessageWithBody(ctx context.Context, params *PostDeleteMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostDeleteMessage(ctx context.Context, params *PostDeleteMessageParams, body PostDeleteMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEditMessageWithBody request with any body
+	PostEditMessageWithBody(ctx context.Context, params *PostEditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEditMessage(ctx context.Context, params *PostEditMessageParams, body PostEditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostFreeHands request
 	PostFreeHands(ctx context.Context, params *PostFreeHandsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostGetCannedResponsesWithBody request with any body
+	PostGetCannedResponsesWithBody(ctx context.Context, params *PostGetCannedResponsesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostGetCannedResponses(ctx context.Context, params *PostGetCannedResponsesParams, body PostGetCannedResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostGetChatHistoryWithBody request with any body
 	PostGetChatHistoryWithBody(ctx context.Context, params *PostGetChatHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -267,14 +794,55 @@ type ClientInterface interface {
 	// PostGetFreeHandsBtnAvailability request
 	PostGetFreeHandsBtnAvailability(ctx context.Context, params *PostGetFreeHandsBtnAvailabilityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostGetProblemTaxonomy request
+	PostGetProblemTaxonomy(ctx context.Context, params *PostGetProblemTaxonomyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostGetSatisfactionScoresWithBody request with any body
+	PostGetSatisfactionScoresWithBody(ctx context.Context, params *PostGetSatisfactionScoresParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostGetSatisfactionScores(ctx context.Context, params *PostGetSatisfactionScoresParams, body PostGetSatisfactionScoresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMarkChatAsReadWithBody request with any body
+	PostMarkChatAsReadWithBody(ctx context.Context, params *PostMarkChatAsReadParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMarkChatAsRead(ctx context.Context, params *PostMarkChatAsReadParams, body PostMarkChatAsReadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRemoveReactionWithBody request with any body
+	PostRemoveReactionWithBody(ctx context.Context, params *PostRemoveReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRemoveReaction(ctx context.Context, params *PostRemoveReactionParams, body PostRemoveReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSearchMessagesWithBody request with any body
+	PostSearchMessagesWithBody(ctx context.Context, params *PostSearchMessagesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSearchMessages(ctx context.Context, params *PostSearchMessagesParams, body PostSearchMessagesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSendCannedResponseWithBody request with any body
+	PostSendCannedResponseWithBody(ctx context.Context, params *PostSendCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSendCannedResponse(ctx context.Context, params *PostSendCannedResponseParams, body PostSendCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostSendMessageWithBody request with any body
 	PostSendMessageWithBody(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostSendMessage(ctx context.Context, params *PostSendMessageParams, body PostSendMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSetProblemCategoryWithBody request with any body
+	PostSetProblemCategoryWithBody(ctx context.Context, params *PostSetProblemCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSetProblemCategory(ctx context.Context, params *PostSetProblemCategoryParams, body PostSetProblemCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUpdateCannedResponseWithBody request with any body
+	PostUpdateCannedResponseWithBody(ctx context.Context, params *PostUpdateCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUpdateCannedResponse(ctx context.Context, params *PostUpdateCannedResponseParams, body PostUpdateCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUploadAttachmentWithBody request with any body
+	PostUploadAttachmentWithBody(ctx context.Context, params *PostUploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostCloseChatWithBody(ctx context.Context, params *PostCloseChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostCloseChatRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) PostAddInternalNoteWithBody(ctx context.Context, params *PostAddInternalNoteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAddInternalNoteRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -285,8 +853,8 @@ func (c *Client) PostCloseChatWithBody(ctx context.Context, params *PostCloseCha
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostCloseChat(ctx context.Context, params *PostCloseChatParams, body PostCloseChatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostCloseChatRequest(c.Server, params, body)
+func (c *Client) PostAddInternalNote(ctx context.Context, params *PostAddInternalNoteParams, body PostAddInternalNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAddInternalNoteRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -297,8 +865,8 @@ func (c *Client) PostCloseChat(ctx context.Context, params *PostCloseChatParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostFreeHands(ctx context.Context, params *PostFreeHandsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostFreeHandsRequest(c.Server, params)
+func (c *Client) PostAddReactionWithBody(ctx context.Context, params *PostAddReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAddReactionRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -309,8 +877,8 @@ func (c *Client) PostFreeHands(ctx context.Context, params *PostFreeHandsParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostGetChatHistoryWithBody(ctx context.Context, params *PostGetChatHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostGetChatHistoryRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) PostAddReaction(ctx context.Context, params *PostAddReactionParams, body PostAddReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAddReactionRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -321,8 +889,8 @@ func (c *Client) PostGetChatHistoryWithBody(ctx context.Context, params *PostGet
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostGetChatHistory(ctx context.Context, params *PostGetChatHistoryParams, body PostGetChatHistoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostGetChatHistoryRequest(c.Server, params, body)
+func (c *Client) PostCloseChatWithBody(ctx context.Context, params *PostCloseChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCloseChatRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -333,8 +901,8 @@ func (c *Client) PostGetChatHistory(ctx context.Context, params *PostGetChatHist
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostGetChats(ctx context.Context, params *PostGetChatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostGetChatsRequest(c.Server, params)
+func (c *Client) PostCloseChat(ctx context.Context, params *PostCloseChatParams, body PostCloseChatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCloseChatRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -345,8 +913,8 @@ func (c *Client) PostGetChats(ctx context.Context, params *PostGetChatsParams, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostGetFreeHandsBtnAvailability(ctx context.Context, params *PostGetFreeHandsBtnAvailabilityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostGetFreeHandsBtnAvailabilityRequest(c.Server, params)
+func (c *Client) PostCreateCannedResponseWithBody(ctx context.Context, params *PostCreateCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCreateCannedResponseRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -357,8 +925,8 @@ func (c *Client) PostGetFreeHandsBtnAvailability(ctx context.Context, params *Po
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostSendMessageWithBody(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSendMessageRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) PostCreateCannedResponse(ctx context.Context, params *PostCreateCannedResponseParams, body PostCreateCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCreateCannedResponseRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -369,8 +937,8 @@ func (c *Client) PostSendMessageWithBody(ctx context.Context, params *PostSendMe
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostSendMessage(ctx context.Context, params *PostSendMessageParams, body PostSendMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSendMessageRequest(c.Server, params, body)
+func (c *Client) PostDeleteCannedResponseWithBody(ctx context.Context, params *PostDeleteCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeleteCannedResponseRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -381,258 +949,392 @@ func (c *Client) PostSendMessage(ctx context.Context, params *PostSendMessagePar
 	return c.Client.Do(req)
 }
 
-// NewPostCloseChatRequest calls the generic PostCloseChat builder with application/json body
-func NewPostCloseChatRequest(server string, params *PostCloseChatParams, body PostCloseChatJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostDeleteCannedResponse(ctx context.Context, params *PostDeleteCannedResponseParams, body PostDeleteCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeleteCannedResponseRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostCloseChatRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostCloseChatRequestWithBody generates requests for PostCloseChat with any type of body
-func NewPostCloseChatRequestWithBody(server string, params *PostCloseChatParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostDeleteMessageWithBody(ctx context.Context, params *PostDeleteMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeleteMessageRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/closeChat")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostDeleteMessage(ctx context.Context, params *PostDeleteMessageParams, body PostDeleteMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeleteMessageRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostEditMessageWithBody(ctx context.Context, params *PostEditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEditMessageRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Request-ID", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostFreeHandsRequest generates requests for PostFreeHands
-func NewPostFreeHandsRequest(server string, params *PostFreeHandsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostEditMessage(ctx context.Context, params *PostEditMessageParams, body PostEditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEditMessageRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/freeHands")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostFreeHands(ctx context.Context, params *PostFreeHandsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFreeHandsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) PostGetCannedResponsesWithBody(ctx context.Context, params *PostGetCannedResponsesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGetCannedResponsesRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Request-ID", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostGetChatHistoryRequest calls the generic PostGetChatHistory builder with application/json body
-func NewPostGetChatHistoryRequest(server string, params *PostGetChatHistoryParams, body PostGetChatHistoryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostGetCannedResponses(ctx context.Context, params *PostGetCannedResponsesParams, body PostGetCannedResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGetCannedResponsesRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostGetChatHistoryRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostGetChatHistoryRequestWithBody generates requests for PostGetChatHistory with any type of body
-func NewPostGetChatHistoryRequestWithBody(server string, params *PostGetChatHistoryParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostGetChatHistoryWithBody(ctx context.Context, params *PostGetChatHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGetChatHistoryRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/getChatHistory")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostGetChatHistory(ctx context.Context, params *PostGetChatHistoryParams, body PostGetChatHistoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGetChatHistoryRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostGetChats(ctx context.Context, params *PostGetChatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGetChatsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Request-ID", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostGetChatsRequest generates requests for PostGetChats
-func NewPostGetChatsRequest(server string, params *PostGetChatsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostGetFreeHandsBtnAvailability(ctx context.Context, params *PostGetFreeHandsBtnAvailabilityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGetFreeHandsBtnAvailabilityRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/getChats")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostGetProblemTaxonomy(ctx context.Context, params *PostGetProblemTaxonomyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGetProblemTaxonomyRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) PostGetSatisfactionScoresWithBody(ctx context.Context, params *PostGetSatisfactionScoresParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGetSatisfactionScoresRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Request-ID", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostGetFreeHandsBtnAvailabilityRequest generates requests for PostGetFreeHandsBtnAvailability
-func NewPostGetFreeHandsBtnAvailabilityRequest(server string, params *PostGetFreeHandsBtnAvailabilityParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostGetSatisfactionScores(ctx context.Context, params *PostGetSatisfactionScoresParams, body PostGetSatisfactionScoresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGetSatisfactionScoresRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/getFreeHandsBtnAvailability")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostMarkChatAsReadWithBody(ctx context.Context, params *PostMarkChatAsReadParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMarkChatAsReadRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) PostMarkChatAsRead(ctx context.Context, params *PostMarkChatAsReadParams, body PostMarkChatAsReadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMarkChatAsReadRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Request-ID", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostSendMessageRequest calls the generic PostSendMessage builder with application/json body
-func NewPostSendMessageRequest(server string, params *PostSendMessageParams, body PostSendMessageJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostRemoveReactionWithBody(ctx context.Context, params *PostRemoveReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRemoveReactionRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostSendMessageRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostSendMessageRequestWithBody generates requests for PostSendMessage with any type of body
-func NewPostSendMessageRequestWithBody(server string, params *PostSendMessageParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostRemoveReaction(ctx context.Context, params *PostRemoveReactionParams, body PostRemoveReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRemoveReactionRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/sendMessage")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostSearchMessagesWithBody(ctx context.Context, params *PostSearchMessagesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSearchMessagesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSearchMessages(ctx context.Context, params *PostSearchMessagesParams, body PostSearchMessagesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSearchMessagesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSendCannedResponseWithBody(ctx context.Context, params *PostSendCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSendCannedResponseRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSendCannedResponse(ctx context.Context, params *PostSendCannedResponseParams, body PostSendCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSendCannedResponseRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSendMessageWithBody(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSendMessageRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSendMessage(ctx context.Context, params *PostSendMessageParams, body PostSendMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSendMessageRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSetProblemCategoryWithBody(ctx context.Context, params *PostSetProblemCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSetProblemCategoryRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSetProblemCategory(ctx context.Context, params *PostSetProblemCategoryParams, body PostSetProblemCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSetProblemCategoryRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUpdateCannedResponseWithBody(ctx context.Context, params *PostUpdateCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUpdateCannedResponseRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUpdateCannedResponse(ctx context.Context, params *PostUpdateCannedResponseParams, body PostUpdateCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUpdateCannedResponseRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUploadAttachmentWithBody(ctx context.Context, params *PostUploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUploadAttachmentRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewPostAddInternalNoteRequest calls the generic PostAddInternalNote builder with application/json body
+func NewPostAddInternalNoteRequest(server string, params *PostAddInternalNoteParams, body PostAddInternalNoteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAddInternalNoteRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostAddInternalNoteRequestWithBody generates requests for PostAddInternalNote with any type of body
+func NewPostAddInternalNoteRequestWithBody(server string, params *PostAddInternalNoteParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/addInternalNote")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
@@ -660,300 +1362,2450 @@ func NewPostSendMessageRequestWithBody(server string, params *PostSendMessagePar
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewPostAddReactionRequest calls the generic PostAddReaction builder with application/json body
+func NewPostAddReactionRequest(server string, params *PostAddReactionParams, body PostAddReactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAddReactionRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewPostAddReactionRequestWithBody generates requests for PostAddReaction with any type of body
+func NewPostAddReactionRequestWithBody(server string, params *PostAddReactionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/addReaction")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostCloseChatWithBodyWithResponse request with any body
-	PostCloseChatWithBodyWithResponse(ctx context.Context, params *PostCloseChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCloseChatResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PostCloseChatWithResponse(ctx context.Context, params *PostCloseChatParams, body PostCloseChatJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCloseChatResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostFreeHandsWithResponse request
-	PostFreeHandsWithResponse(ctx context.Context, params *PostFreeHandsParams, reqEditors ...RequestEditorFn) (*PostFreeHandsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// PostGetChatHistoryWithBodyWithResponse request with any body
-	PostGetChatHistoryWithBodyWithResponse(ctx context.Context, params *PostGetChatHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetChatHistoryResponse, error)
+	if params != nil {
 
-	PostGetChatHistoryWithResponse(ctx context.Context, params *PostGetChatHistoryParams, body PostGetChatHistoryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGetChatHistoryResponse, error)
+		var headerParam0 string
 
-	// PostGetChatsWithResponse request
-	PostGetChatsWithResponse(ctx context.Context, params *PostGetChatsParams, reqEditors ...RequestEditorFn) (*PostGetChatsResponse, error)
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
 
-	// PostGetFreeHandsBtnAvailabilityWithResponse request
-	PostGetFreeHandsBtnAvailabilityWithResponse(ctx context.Context, params *PostGetFreeHandsBtnAvailabilityParams, reqEditors ...RequestEditorFn) (*PostGetFreeHandsBtnAvailabilityResponse, error)
+		req.Header.Set("X-Request-ID", headerParam0)
 
-	// PostSendMessageWithBodyWithResponse request with any body
-	PostSendMessageWithBodyWithResponse(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error)
+	}
 
-	PostSendMessageWithResponse(ctx context.Context, params *PostSendMessageParams, body PostSendMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error)
+	return req, nil
 }
 
-type PostCloseChatResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CloseChatResponse
+// NewPostCloseChatRequest calls the generic PostCloseChat builder with application/json body
+func NewPostCloseChatRequest(server string, params *PostCloseChatParams, body PostCloseChatJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCloseChatRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostCloseChatResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostCloseChatRequestWithBody generates requests for PostCloseChat with any type of body
+func NewPostCloseChatRequestWithBody(server string, params *PostCloseChatParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostCloseChatResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/closeChat")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostCreateCannedResponseRequest calls the generic PostCreateCannedResponse builder with application/json body
+func NewPostCreateCannedResponseRequest(server string, params *PostCreateCannedResponseParams, body PostCreateCannedResponseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCreateCannedResponseRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostCreateCannedResponseRequestWithBody generates requests for PostCreateCannedResponse with any type of body
+func NewPostCreateCannedResponseRequestWithBody(server string, params *PostCreateCannedResponseParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/createCannedResponse")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostDeleteCannedResponseRequest calls the generic PostDeleteCannedResponse builder with application/json body
+func NewPostDeleteCannedResponseRequest(server string, params *PostDeleteCannedResponseParams, body PostDeleteCannedResponseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostDeleteCannedResponseRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostDeleteCannedResponseRequestWithBody generates requests for PostDeleteCannedResponse with any type of body
+func NewPostDeleteCannedResponseRequestWithBody(server string, params *PostDeleteCannedResponseParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deleteCannedResponse")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostDeleteMessageRequest calls the generic PostDeleteMessage builder with application/json body
+func NewPostDeleteMessageRequest(server string, params *PostDeleteMessageParams, body PostDeleteMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostDeleteMessageRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostDeleteMessageRequestWithBody generates requests for PostDeleteMessage with any type of body
+func NewPostDeleteMessageRequestWithBody(server string, params *PostDeleteMessageParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deleteMessage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostEditMessageRequest calls the generic PostEditMessage builder with application/json body
+func NewPostEditMessageRequest(server string, params *PostEditMessageParams, body PostEditMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEditMessageRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostEditMessageRequestWithBody generates requests for PostEditMessage with any type of body
+func NewPostEditMessageRequestWithBody(server string, params *PostEditMessageParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/editMessage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostFreeHandsRequest generates requests for PostFreeHands
+func NewPostFreeHandsRequest(server string, params *PostFreeHandsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/freeHands")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostGetCannedResponsesRequest calls the generic PostGetCannedResponses builder with application/json body
+func NewPostGetCannedResponsesRequest(server string, params *PostGetCannedResponsesParams, body PostGetCannedResponsesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostGetCannedResponsesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostGetCannedResponsesRequestWithBody generates requests for PostGetCannedResponses with any type of body
+func NewPostGetCannedResponsesRequestWithBody(server string, params *PostGetCannedResponsesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/getCannedResponses")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostGetChatHistoryRequest calls the generic PostGetChatHistory builder with application/json body
+func NewPostGetChatHistoryRequest(server string, params *PostGetChatHistoryParams, body PostGetChatHistoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostGetChatHistoryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostGetChatHistoryRequestWithBody generates requests for PostGetChatHistory with any type of body
+func NewPostGetChatHistoryRequestWithBody(server string, params *PostGetChatHistoryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/getChatHistory")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostGetChatsRequest generates requests for PostGetChats
+func NewPostGetChatsRequest(server string, params *PostGetChatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/getChats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostGetFreeHandsBtnAvailabilityRequest generates requests for PostGetFreeHandsBtnAvailability
+func NewPostGetFreeHandsBtnAvailabilityRequest(server string, params *PostGetFreeHandsBtnAvailabilityParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/getFreeHandsBtnAvailability")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostGetProblemTaxonomyRequest generates requests for PostGetProblemTaxonomy
+func NewPostGetProblemTaxonomyRequest(server string, params *PostGetProblemTaxonomyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/getProblemTaxonomy")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostGetSatisfactionScoresRequest calls the generic PostGetSatisfactionScores builder with application/json body
+func NewPostGetSatisfactionScoresRequest(server string, params *PostGetSatisfactionScoresParams, body PostGetSatisfactionScoresJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostGetSatisfactionScoresRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostGetSatisfactionScoresRequestWithBody generates requests for PostGetSatisfactionScores with any type of body
+func NewPostGetSatisfactionScoresRequestWithBody(server string, params *PostGetSatisfactionScoresParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/getSatisfactionScores")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostMarkChatAsReadRequest calls the generic PostMarkChatAsRead builder with application/json body
+func NewPostMarkChatAsReadRequest(server string, params *PostMarkChatAsReadParams, body PostMarkChatAsReadJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMarkChatAsReadRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostMarkChatAsReadRequestWithBody generates requests for PostMarkChatAsRead with any type of body
+func NewPostMarkChatAsReadRequestWithBody(server string, params *PostMarkChatAsReadParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/markChatAsRead")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostRemoveReactionRequest calls the generic PostRemoveReaction builder with application/json body
+func NewPostRemoveReactionRequest(server string, params *PostRemoveReactionParams, body PostRemoveReactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRemoveReactionRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRemoveReactionRequestWithBody generates requests for PostRemoveReaction with any type of body
+func NewPostRemoveReactionRequestWithBody(server string, params *PostRemoveReactionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/removeReaction")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostSearchMessagesRequest calls the generic PostSearchMessages builder with application/json body
+func NewPostSearchMessagesRequest(server string, params *PostSearchMessagesParams, body PostSearchMessagesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSearchMessagesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostSearchMessagesRequestWithBody generates requests for PostSearchMessages with any type of body
+func NewPostSearchMessagesRequestWithBody(server string, params *PostSearchMessagesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/searchMessages")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostSendCannedResponseRequest calls the generic PostSendCannedResponse builder with application/json body
+func NewPostSendCannedResponseRequest(server string, params *PostSendCannedResponseParams, body PostSendCannedResponseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSendCannedResponseRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostSendCannedResponseRequestWithBody generates requests for PostSendCannedResponse with any type of body
+func NewPostSendCannedResponseRequestWithBody(server string, params *PostSendCannedResponseParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sendCannedResponse")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostSendMessageRequest calls the generic PostSendMessage builder with application/json body
+func NewPostSendMessageRequest(server string, params *PostSendMessageParams, body PostSendMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSendMessageRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostSendMessageRequestWithBody generates requests for PostSendMessage with any type of body
+func NewPostSendMessageRequestWithBody(server string, params *PostSendMessageParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sendMessage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostSetProblemCategoryRequest calls the generic PostSetProblemCategory builder with application/json body
+func NewPostSetProblemCategoryRequest(server string, params *PostSetProblemCategoryParams, body PostSetProblemCategoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSetProblemCategoryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostSetProblemCategoryRequestWithBody generates requests for PostSetProblemCategory with any type of body
+func NewPostSetProblemCategoryRequestWithBody(server string, params *PostSetProblemCategoryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/setProblemCategory")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostUpdateCannedResponseRequest calls the generic PostUpdateCannedResponse builder with application/json body
+func NewPostUpdateCannedResponseRequest(server string, params *PostUpdateCannedResponseParams, body PostUpdateCannedResponseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUpdateCannedResponseRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostUpdateCannedResponseRequestWithBody generates requests for PostUpdateCannedResponse with any type of body
+func NewPostUpdateCannedResponseRequestWithBody(server string, params *PostUpdateCannedResponseParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/updateCannedResponse")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostUploadAttachmentRequestWithBody generates requests for PostUploadAttachment with any type of body
+func NewPostUploadAttachmentRequestWithBody(server string, params *PostUploadAttachmentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uploadAttachment")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, params.XRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Request-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostAddInternalNoteWithBodyWithResponse request with any body
+	PostAddInternalNoteWithBodyWithResponse(ctx context.Context, params *PostAddInternalNoteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAddInternalNoteResponse, error)
+
+	PostAddInternalNoteWithResponse(ctx context.Context, params *PostAddInternalNoteParams, body PostAddInternalNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAddInternalNoteResponse, error)
+
+	// PostAddReactionWithBodyWithResponse request with any body
+	PostAddReactionWithBodyWithResponse(ctx context.Context, params *PostAddReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAddReactionResponse, error)
+
+	PostAddReactionWithResponse(ctx context.Context, params *PostAddReactionParams, body PostAddReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAddReactionResponse, error)
+
+	// PostCloseChatWithBodyWithResponse request with any body
+	PostCloseChatWithBodyWithResponse(ctx context.Context, params *PostCloseChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCloseChatResponse, error)
+
+	PostCloseChatWithResponse(ctx context.Context, params *PostCloseChatParams, body PostCloseChatJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCloseChatResponse, error)
+
+	// PostCreateCannedResponseWithBodyWithResponse request with any body
+	PostCreateCannedResponseWithBodyWithResponse(ctx context.Context, params *PostCreateCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCreateCannedResponseResponse, error)
+
+	PostCreateCannedResponseWithResponse(ctx context.Context, params *PostCreateCannedResponseParams, body PostCreateCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCreateCannedResponseResponse, error)
+
+	// PostDeleteCannedResponseWithBodyWithResponse request with any body
+	PostDeleteCannedResponseWithBodyWithResponse(ctx context.Context, params *PostDeleteCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeleteCannedResponseResponse, error)
+
+	PostDeleteCannedResponseWithResponse(ctx context.Context, params *PostDeleteCannedResponseParams, body PostDeleteCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDeleteCannedResponseResponse, error)
+
+	// PostDeleteMessageWithBodyWithResponse request with any body
+	PostDeleteMessageWithBodyWithResponse(ctx context.Context, params *PostDeleteMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeleteMessageResponse, error)
+
+	PostDeleteMessageWithResponse(ctx context.Context, params *PostDeleteMessageParams, body PostDeleteMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDeleteMessageResponse, error)
+
+	// PostEditMessageWithBodyWithResponse request with any body
+	PostEditMessageWithBodyWithResponse(ctx context.Context, params *PostEditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEditMessageResponse, error)
+
+	PostEditMessageWithResponse(ctx context.Context, params *PostEditMessageParams, body PostEditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEditMessageResponse, error)
+
+	// PostFreeHandsWithResponse request
+	PostFreeHandsWithResponse(ctx context.Context, params *PostFreeHandsParams, reqEditors ...RequestEditorFn) (*PostFreeHandsResponse, error)
+
+	// PostGetCannedResponsesWithBodyWithResponse request with any body
+	PostGetCannedResponsesWithBodyWithResponse(ctx context.Context, params *PostGetCannedResponsesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetCannedResponsesResponse, error)
+
+	PostGetCannedResponsesWithResponse(ctx context.Context, params *PostGetCannedResponsesParams, body PostGetCannedResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGetCannedResponsesResponse, error)
+
+	// PostGetChatHistoryWithBodyWithResponse request with any body
+	PostGetChatHistoryWithBodyWithResponse(ctx context.Context, params *PostGetChatHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetChatHistoryResponse, error)
+
+	PostGetChatHistoryWithResponse(ctx context.Context, params *PostGetChatHistoryParams, body PostGetChatHistoryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGetChatHistoryResponse, error)
+
+	// PostGetChatsWithResponse request
+	PostGetChatsWithResponse(ctx context.Context, params *PostGetChatsParams, reqEditors ...RequestEditorFn) (*PostGetChatsResponse, error)
+
+	// PostGetFreeHandsBtnAvailabilityWithResponse request
+	PostGetFreeHandsBtnAvailabilityWithResponse(ctx context.Context, params *PostGetFreeHandsBtnAvailabilityParams, reqEditors ...RequestEditorFn) (*PostGetFreeHandsBtnAvailabilityResponse, error)
+
+	// PostGetProblemTaxonomyWithResponse request
+	PostGetProblemTaxonomyWithResponse(ctx context.Context, params *PostGetProblemTaxonomyParams, reqEditors ...RequestEditorFn) (*PostGetProblemTaxonomyResponse, error)
+
+	// PostGetSatisfactionScoresWithBodyWithResponse request with any body
+	PostGetSatisfactionScoresWithBodyWithResponse(ctx context.Context, params *PostGetSatisfactionScoresParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetSatisfactionScoresResponse, error)
+
+	PostGetSatisfactionScoresWithResponse(ctx context.Context, params *PostGetSatisfactionScoresParams, body PostGetSatisfactionScoresJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGetSatisfactionScoresResponse, error)
+
+	// PostMarkChatAsReadWithBodyWithResponse request with any body
+	PostMarkChatAsReadWithBodyWithResponse(ctx context.Context, params *PostMarkChatAsReadParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMarkChatAsReadResponse, error)
+
+	PostMarkChatAsReadWithResponse(ctx context.Context, params *PostMarkChatAsReadParams, body PostMarkChatAsReadJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMarkChatAsReadResponse, error)
+
+	// PostRemoveReactionWithBodyWithResponse request with any body
+	PostRemoveReactionWithBodyWithResponse(ctx context.Context, params *PostRemoveReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRemoveReactionResponse, error)
+
+	PostRemoveReactionWithResponse(ctx context.Context, params *PostRemoveReactionParams, body PostRemoveReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRemoveReactionResponse, error)
+
+	// PostSearchMessagesWithBodyWithResponse request with any body
+	PostSearchMessagesWithBodyWithResponse(ctx context.Context, params *PostSearchMessagesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSearchMessagesResponse, error)
+
+	PostSearchMessagesWithResponse(ctx context.Context, params *PostSearchMessagesParams, body PostSearchMessagesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSearchMessagesResponse, error)
+
+	// PostSendCannedResponseWithBodyWithResponse request with any body
+	PostSendCannedResponseWithBodyWithResponse(ctx context.Context, params *PostSendCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSendCannedResponseResponse, error)
+
+	PostSendCannedResponseWithResponse(ctx context.Context, params *PostSendCannedResponseParams, body PostSendCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSendCannedResponseResponse, error)
+
+	// PostSendMessageWithBodyWithResponse request with any body
+	PostSendMessageWithBodyWithResponse(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error)
+
+	PostSendMessageWithResponse(ctx context.Context, params *PostSendMessageParams, body PostSendMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error)
+
+	// PostSetProblemCategoryWithBodyWithResponse request with any body
+	PostSetProblemCategoryWithBodyWithResponse(ctx context.Context, params *PostSetProblemCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSetProblemCategoryResponse, error)
+
+	PostSetProblemCategoryWithResponse(ctx context.Context, params *PostSetProblemCategoryParams, body PostSetProblemCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSetProblemCategoryResponse, error)
+
+	// PostUpdateCannedResponseWithBodyWithResponse request with any body
+	PostUpdateCannedResponseWithBodyWithResponse(ctx context.Context, params *PostUpdateCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUpdateCannedResponseResponse, error)
+
+	PostUpdateCannedResponseWithResponse(ctx context.Context, params *PostUpdateCannedResponseParams, body PostUpdateCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUpdateCannedResponseResponse, error)
+
+	// PostUploadAttachmentWithBodyWithResponse request with any body
+	PostUploadAttachmentWithBodyWithResponse(ctx context.Context, params *PostUploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUploadAttachmentResponse, error)
+}
+
+type PostAddInternalNoteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AddInternalNoteResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAddInternalNoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAddInternalNoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAddReactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReactionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAddReactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAddReactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCloseChatResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CloseChatResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCloseChatResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCloseChatResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCreateCannedResponseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CannedResponseResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCreateCannedResponseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCreateCannedResponseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDeleteCannedResponseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteCannedResponseResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDeleteCannedResponseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDeleteCannedResponseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDeleteMessageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteMessageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDeleteMessageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDeleteMessageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEditMessageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EditMessageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEditMessageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEditMessageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFreeHandsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FreeHandsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFreeHandsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFreeHandsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGetCannedResponsesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetCannedResponsesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGetCannedResponsesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGetCannedResponsesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGetChatHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetChatHistoryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGetChatHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGetChatHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGetChatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetChatsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGetChatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGetChatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGetFreeHandsBtnAvailabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetFreeHandsBtnAvailabilityResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGetFreeHandsBtnAvailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGetFreeHandsBtnAvailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGetProblemTaxonomyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetProblemTaxonomyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGetProblemTaxonomyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGetProblemTaxonomyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGetSatisfactionScoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetSatisfactionScoresResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGetSatisfactionScoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGetSatisfactionScoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMarkChatAsReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MarkChatAsReadResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMarkChatAsReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMarkChatAsReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRemoveReactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReactionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRemoveReactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRemoveReactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSearchMessagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchMessagesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSearchMessagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSearchMessagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSendCannedResponseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SendMessageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSendCannedResponseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSendCannedResponseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSendMessageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SendMessageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSendMessageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSendMessageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSetProblemCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SetProblemCategoryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSetProblemCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSetProblemCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUpdateCannedResponseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CannedResponseResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUpdateCannedResponseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUpdateCannedResponseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUploadAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadAttachmentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUploadAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUploadAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostAddInternalNoteWithBodyWithResponse request with arbitrary body returning *PostAddInternalNoteResponse
+func (c *ClientWithResponses) PostAddInternalNoteWithBodyWithResponse(ctx context.Context, params *PostAddInternalNoteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAddInternalNoteResponse, error) {
+	rsp, err := c.PostAddInternalNoteWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAddInternalNoteResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAddInternalNoteWithResponse(ctx context.Context, params *PostAddInternalNoteParams, body PostAddInternalNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAddInternalNoteResponse, error) {
+	rsp, err := c.PostAddInternalNote(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAddInternalNoteResponse(rsp)
+}
+
+// PostAddReactionWithBodyWithResponse request with arbitrary body returning *PostAddReactionResponse
+func (c *ClientWithResponses) PostAddReactionWithBodyWithResponse(ctx context.Context, params *PostAddReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAddReactionResponse, error) {
+	rsp, err := c.PostAddReactionWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAddReactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAddReactionWithResponse(ctx context.Context, params *PostAddReactionParams, body PostAddReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAddReactionResponse, error) {
+	rsp, err := c.PostAddReaction(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAddReactionResponse(rsp)
+}
+
+// PostCloseChatWithBodyWithResponse request with arbitrary body returning *PostCloseChatResponse
+func (c *ClientWithResponses) PostCloseChatWithBodyWithResponse(ctx context.Context, params *PostCloseChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCloseChatResponse, error) {
+	rsp, err := c.PostCloseChatWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCloseChatResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCloseChatWithResponse(ctx context.Context, params *PostCloseChatParams, body PostCloseChatJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCloseChatResponse, error) {
+	rsp, err := c.PostCloseChat(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCloseChatResponse(rsp)
+}
+
+// PostCreateCannedResponseWithBodyWithResponse request with arbitrary body returning *PostCreateCannedResponseResponse
+func (c *ClientWithResponses) PostCreateCannedResponseWithBodyWithResponse(ctx context.Context, params *PostCreateCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCreateCannedResponseResponse, error) {
+	rsp, err := c.PostCreateCannedResponseWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCreateCannedResponseResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCreateCannedResponseWithResponse(ctx context.Context, params *PostCreateCannedResponseParams, body PostCreateCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCreateCannedResponseResponse, error) {
+	rsp, err := c.PostCreateCannedResponse(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCreateCannedResponseResponse(rsp)
+}
+
+// PostDeleteCannedResponseWithBodyWithResponse request with arbitrary body returning *PostDeleteCannedResponseResponse
+func (c *ClientWithResponses) PostDeleteCannedResponseWithBodyWithResponse(ctx context.Context, params *PostDeleteCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeleteCannedResponseResponse, error) {
+	rsp, err := c.PostDeleteCannedResponseWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeleteCannedResponseResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostDeleteCannedResponseWithResponse(ctx context.Context, params *PostDeleteCannedResponseParams, body PostDeleteCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDeleteCannedResponseResponse, error) {
+	rsp, err := c.PostDeleteCannedResponse(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeleteCannedResponseResponse(rsp)
+}
+
+// PostDeleteMessageWithBodyWithResponse request with arbitrary body returning *PostDeleteMessageResponse
+func (c *ClientWithResponses) PostDeleteMessageWithBodyWithResponse(ctx context.Context, params *PostDeleteMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeleteMessageResponse, error) {
+	rsp, err := c.PostDeleteMessageWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeleteMessageResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostDeleteMessageWithResponse(ctx context.Context, params *PostDeleteMessageParams, body PostDeleteMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDeleteMessageResponse, error) {
+	rsp, err := c.PostDeleteMessage(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeleteMessageResponse(rsp)
+}
+
+// PostEditMessageWithBodyWithResponse request with arbitrary body returning *PostEditMessageResponse
+func (c *ClientWithResponses) PostEditMessageWithBodyWithResponse(ctx context.Context, params *PostEditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEditMessageResponse, error) {
+	rsp, err := c.PostEditMessageWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEditMessageResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEditMessageWithResponse(ctx context.Context, params *PostEditMessageParams, body PostEditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEditMessageResponse, error) {
+	rsp, err := c.PostEditMessage(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEditMessageResponse(rsp)
+}
+
+// PostFreeHandsWithResponse request returning *PostFreeHandsResponse
+func (c *ClientWithResponses) PostFreeHandsWithResponse(ctx context.Context, params *PostFreeHandsParams, reqEditors ...RequestEditorFn) (*PostFreeHandsResponse, error) {
+	rsp, err := c.PostFreeHands(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFreeHandsResponse(rsp)
+}
+
+// PostGetCannedResponsesWithBodyWithResponse request with arbitrary body returning *PostGetCannedResponsesResponse
+func (c *ClientWithResponses) PostGetCannedResponsesWithBodyWithResponse(ctx context.Context, params *PostGetCannedResponsesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetCannedResponsesResponse, error) {
+	rsp, err := c.PostGetCannedResponsesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGetCannedResponsesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostGetCannedResponsesWithResponse(ctx context.Context, params *PostGetCannedResponsesParams, body PostGetCannedResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGetCannedResponsesResponse, error) {
+	rsp, err := c.PostGetCannedResponses(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGetCannedResponsesResponse(rsp)
+}
+
+// PostGetChatHistoryWithBodyWithResponse request with arbitrary body returning *PostGetChatHistoryResponse
+func (c *ClientWithResponses) PostGetChatHistoryWithBodyWithResponse(ctx context.Context, params *PostGetChatHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetChatHistoryResponse, error) {
+	rsp, err := c.PostGetChatHistoryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGetChatHistoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostGetChatHistoryWithResponse(ctx context.Context, params *PostGetChatHistoryParams, body PostGetChatHistoryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGetChatHistoryResponse, error) {
+	rsp, err := c.PostGetChatHistory(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGetChatHistoryResponse(rsp)
+}
+
+// PostGetChatsWithResponse request returning *PostGetChatsResponse
+func (c *ClientWithResponses) PostGetChatsWithResponse(ctx context.Context, params *PostGetChatsParams, reqEditors ...RequestEditorFn) (*PostGetChatsResponse, error) {
+	rsp, err := c.PostGetChats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGetChatsResponse(rsp)
+}
+
+// PostGetFreeHandsBtnAvailabilityWithResponse request returning *PostGetFreeHandsBtnAvailabilityResponse
+func (c *ClientWithResponses) PostGetFreeHandsBtnAvailabilityWithResponse(ctx context.Context, params *PostGetFreeHandsBtnAvailabilityParams, reqEditors ...RequestEditorFn) (*PostGetFreeHandsBtnAvailabilityResponse, error) {
+	rsp, err := c.PostGetFreeHandsBtnAvailability(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGetFreeHandsBtnAvailabilityResponse(rsp)
+}
+
+// PostGetProblemTaxonomyWithResponse request returning *PostGetProblemTaxonomyResponse
+func (c *ClientWithResponses) PostGetProblemTaxonomyWithResponse(ctx context.Context, params *PostGetProblemTaxonomyParams, reqEditors ...RequestEditorFn) (*PostGetProblemTaxonomyResponse, error) {
+	rsp, err := c.PostGetProblemTaxonomy(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGetProblemTaxonomyResponse(rsp)
+}
+
+// PostGetSatisfactionScoresWithBodyWithResponse request with arbitrary body returning *PostGetSatisfactionScoresResponse
+func (c *ClientWithResponses) PostGetSatisfactionScoresWithBodyWithResponse(ctx context.Context, params *PostGetSatisfactionScoresParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetSatisfactionScoresResponse, error) {
+	rsp, err := c.PostGetSatisfactionScoresWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGetSatisfactionScoresResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostGetSatisfactionScoresWithResponse(ctx context.Context, params *PostGetSatisfactionScoresParams, body PostGetSatisfactionScoresJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGetSatisfactionScoresResponse, error) {
+	rsp, err := c.PostGetSatisfactionScores(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGetSatisfactionScoresResponse(rsp)
+}
+
+// PostMarkChatAsReadWithBodyWithResponse request with arbitrary body returning *PostMarkChatAsReadResponse
+func (c *ClientWithResponses) PostMarkChatAsReadWithBodyWithResponse(ctx context.Context, params *PostMarkChatAsReadParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMarkChatAsReadResponse, error) {
+	rsp, err := c.PostMarkChatAsReadWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMarkChatAsReadResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMarkChatAsReadWithResponse(ctx context.Context, params *PostMarkChatAsReadParams, body PostMarkChatAsReadJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMarkChatAsReadResponse, error) {
+	rsp, err := c.PostMarkChatAsRead(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMarkChatAsReadResponse(rsp)
+}
+
+// PostRemoveReactionWithBodyWithResponse request with arbitrary body returning *PostRemoveReactionResponse
+func (c *ClientWithResponses) PostRemoveReactionWithBodyWithResponse(ctx context.Context, params *PostRemoveReactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRemoveReactionResponse, error) {
+	rsp, err := c.PostRemoveReactionWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRemoveReactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRemoveReactionWithResponse(ctx context.Context, params *PostRemoveReactionParams, body PostRemoveReactionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRemoveReactionResponse, error) {
+	rsp, err := c.PostRemoveReaction(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRemoveReactionResponse(rsp)
+}
+
+// PostSearchMessagesWithBodyWithResponse request with arbitrary body returning *PostSearchMessagesResponse
+func (c *ClientWithResponses) PostSearchMessagesWithBodyWithResponse(ctx context.Context, params *PostSearchMessagesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSearchMessagesResponse, error) {
+	rsp, err := c.PostSearchMessagesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSearchMessagesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSearchMessagesWithResponse(ctx context.Context, params *PostSearchMessagesParams, body PostSearchMessagesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSearchMessagesResponse, error) {
+	rsp, err := c.PostSearchMessages(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSearchMessagesResponse(rsp)
+}
+
+// PostSendCannedResponseWithBodyWithResponse request with arbitrary body returning *PostSendCannedResponseResponse
+func (c *ClientWithResponses) PostSendCannedResponseWithBodyWithResponse(ctx context.Context, params *PostSendCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSendCannedResponseResponse, error) {
+	rsp, err := c.PostSendCannedResponseWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSendCannedResponseResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSendCannedResponseWithResponse(ctx context.Context, params *PostSendCannedResponseParams, body PostSendCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSendCannedResponseResponse, error) {
+	rsp, err := c.PostSendCannedResponse(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSendCannedResponseResponse(rsp)
+}
+
+// PostSendMessageWithBodyWithResponse request with arbitrary body returning *PostSendMessageResponse
+func (c *ClientWithResponses) PostSendMessageWithBodyWithResponse(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error) {
+	rsp, err := c.PostSendMessageWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSendMessageResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSendMessageWithResponse(ctx context.Context, params *PostSendMessageParams, body PostSendMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error) {
+	rsp, err := c.PostSendMessage(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSendMessageResponse(rsp)
+}
+
+// PostSetProblemCategoryWithBodyWithResponse request with arbitrary body returning *PostSetProblemCategoryResponse
+func (c *ClientWithResponses) PostSetProblemCategoryWithBodyWithResponse(ctx context.Context, params *PostSetProblemCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSetProblemCategoryResponse, error) {
+	rsp, err := c.PostSetProblemCategoryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSetProblemCategoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSetProblemCategoryWithResponse(ctx context.Context, params *PostSetProblemCategoryParams, body PostSetProblemCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSetProblemCategoryResponse, error) {
+	rsp, err := c.PostSetProblemCategory(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSetProblemCategoryResponse(rsp)
+}
+
+// PostUpdateCannedResponseWithBodyWithResponse request with arbitrary body returning *PostUpdateCannedResponseResponse
+func (c *ClientWithResponses) PostUpdateCannedResponseWithBodyWithResponse(ctx context.Context, params *PostUpdateCannedResponseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUpdateCannedResponseResponse, error) {
+	rsp, err := c.PostUpdateCannedResponseWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUpdateCannedResponseResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUpdateCannedResponseWithResponse(ctx context.Context, params *PostUpdateCannedResponseParams, body PostUpdateCannedResponseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUpdateCannedResponseResponse, error) {
+	rsp, err := c.PostUpdateCannedResponse(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUpdateCannedResponseResponse(rsp)
+}
+
+// PostUploadAttachmentWithBodyWithResponse request with arbitrary body returning *PostUploadAttachmentResponse
+func (c *ClientWithResponses) PostUploadAttachmentWithBodyWithResponse(ctx context.Context, params *PostUploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUploadAttachmentResponse, error) {
+	rsp, err := c.PostUploadAttachmentWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUploadAttachmentResponse(rsp)
+}
+
+// ParsePostAddInternalNoteResponse parses an HTTP response from a PostAddInternalNoteWithResponse call
+func ParsePostAddInternalNoteResponse(rsp *http.Response) (*PostAddInternalNoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAddInternalNoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AddInternalNoteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAddReactionResponse parses an HTTP response from a PostAddReactionWithResponse call
+func ParsePostAddReactionResponse(rsp *http.Response) (*PostAddReactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAddReactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReactionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCloseChatResponse parses an HTTP response from a PostCloseChatWithResponse call
+func ParsePostCloseChatResponse(rsp *http.Response) (*PostCloseChatResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCloseChatResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CloseChatResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCreateCannedResponseResponse parses an HTTP response from a PostCreateCannedResponseWithResponse call
+func ParsePostCreateCannedResponseResponse(rsp *http.Response) (*PostCreateCannedResponseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCreateCannedResponseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CannedResponseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDeleteCannedResponseResponse parses an HTTP response from a PostDeleteCannedResponseWithResponse call
+func ParsePostDeleteCannedResponseResponse(rsp *http.Response) (*PostDeleteCannedResponseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDeleteCannedResponseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteCannedResponseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDeleteMessageResponse parses an HTTP response from a PostDeleteMessageWithResponse call
+func ParsePostDeleteMessageResponse(rsp *http.Response) (*PostDeleteMessageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDeleteMessageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteMessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEditMessageResponse parses an HTTP response from a PostEditMessageWithResponse call
+func ParsePostEditMessageResponse(rsp *http.Response) (*PostEditMessageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEditMessageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EditMessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFreeHandsResponse parses an HTTP response from a PostFreeHandsWithResponse call
+func ParsePostFreeHandsResponse(rsp *http.Response) (*PostFreeHandsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFreeHandsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FreeHandsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostGetCannedResponsesResponse parses an HTTP response from a PostGetCannedResponsesWithResponse call
+func ParsePostGetCannedResponsesResponse(rsp *http.Response) (*PostGetCannedResponsesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostGetCannedResponsesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetCannedResponsesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostGetChatHistoryResponse parses an HTTP response from a PostGetChatHistoryWithResponse call
+func ParsePostGetChatHistoryResponse(rsp *http.Response) (*PostGetChatHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostGetChatHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetChatHistoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type PostFreeHandsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FreeHandsResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostFreeHandsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePostGetChatsResponse parses an HTTP response from a PostGetChatsWithResponse call
+func ParsePostGetChatsResponse(rsp *http.Response) (*PostGetChatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostFreeHandsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PostGetChatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PostGetChatHistoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetChatHistoryResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetChatsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PostGetChatHistoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostGetChatHistoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type PostGetChatsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetChatsResponse
-}
+// ParsePostGetFreeHandsBtnAvailabilityResponse parses an HTTP response from a PostGetFreeHandsBtnAvailabilityWithResponse call
+func ParsePostGetFreeHandsBtnAvailabilityResponse(rsp *http.Response) (*PostGetFreeHandsBtnAvailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostGetChatsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &PostGetFreeHandsBtnAvailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostGetChatsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetFreeHandsBtnAvailabilityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type PostGetFreeHandsBtnAvailabilityResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetFreeHandsBtnAvailabilityResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostGetFreeHandsBtnAvailabilityResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePostGetProblemTaxonomyResponse parses an HTTP response from a PostGetProblemTaxonomyWithResponse call
+func ParsePostGetProblemTaxonomyResponse(rsp *http.Response) (*PostGetProblemTaxonomyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostGetFreeHandsBtnAvailabilityResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PostGetProblemTaxonomyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PostSendMessageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SendMessageResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetProblemTaxonomyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PostSendMessageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSendMessageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// PostCloseChatWithBodyWithResponse request with arbitrary body returning *PostCloseChatResponse
-func (c *ClientWithResponses) PostCloseChatWithBodyWithResponse(ctx context.Context, params *PostCloseChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCloseChatResponse, error) {
-	rsp, err := c.PostCloseChatWithBody(ctx, params, contentType, body, reqEditors...)
+// ParsePostGetSatisfactionScoresResponse parses an HTTP response from a PostGetSatisfactionScoresWithResponse call
+func ParsePostGetSatisfactionScoresResponse(rsp *http.Response) (*PostGetSatisfactionScoresResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostCloseChatResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostCloseChatWithResponse(ctx context.Context, params *PostCloseChatParams, body PostCloseChatJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCloseChatResponse, error) {
-	rsp, err := c.PostCloseChat(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostGetSatisfactionScoresResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostCloseChatResponse(rsp)
-}
 
-// PostFreeHandsWithResponse request returning *PostFreeHandsResponse
-func (c *ClientWithResponses) PostFreeHandsWithResponse(ctx context.Context, params *PostFreeHandsParams, reqEditors ...RequestEditorFn) (*PostFreeHandsResponse, error) {
-	rsp, err := c.PostFreeHands(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetSatisfactionScoresResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParsePostFreeHandsResponse(rsp)
+
+	return response, nil
 }
 
-// PostGetChatHistoryWithBodyWithResponse request with arbitrary body returning *PostGetChatHistoryResponse
-func (c *ClientWithResponses) PostGetChatHistoryWithBodyWithResponse(ctx context.Context, params *PostGetChatHistoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGetChatHistoryResponse, error) {
-	rsp, err := c.PostGetChatHistoryWithBody(ctx, params, contentType, body, reqEditors...)
+// ParsePostMarkChatAsReadResponse parses an HTTP response from a PostMarkChatAsReadWithResponse call
+func ParsePostMarkChatAsReadResponse(rsp *http.Response) (*PostMarkChatAsReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostGetChatHistoryResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostGetChatHistoryWithResponse(ctx context.Context, params *PostGetChatHistoryParams, body PostGetChatHistoryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGetChatHistoryResponse, error) {
-	rsp, err := c.PostGetChatHistory(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostMarkChatAsReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostGetChatHistoryResponse(rsp)
-}
 
-// PostGetChatsWithResponse request returning *PostGetChatsResponse
-func (c *ClientWithResponses) PostGetChatsWithResponse(ctx context.Context, params *PostGetChatsParams, reqEditors ...RequestEditorFn) (*PostGetChatsResponse, error) {
-	rsp, err := c.PostGetChats(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MarkChatAsReadResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParsePostGetChatsResponse(rsp)
+
+	return response, nil
 }
 
-// PostGetFreeHandsBtnAvailabilityWithResponse request returning *PostGetFreeHandsBtnAvailabilityResponse
-func (c *ClientWithResponses) PostGetFreeHandsBtnAvailabilityWithResponse(ctx context.Context, params *PostGetFreeHandsBtnAvailabilityParams, reqEditors ...RequestEditorFn) (*PostGetFreeHandsBtnAvailabilityResponse, error) {
-	rsp, err := c.PostGetFreeHandsBtnAvailability(ctx, params, reqEditors...)
+// ParsePostRemoveReactionResponse parses an HTTP response from a PostRemoveReactionWithResponse call
+func ParsePostRemoveReactionResponse(rsp *http.Response) (*PostRemoveReactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostGetFreeHandsBtnAvailabilityResponse(rsp)
-}
 
-// PostSendMessageWithBodyWithResponse request with arbitrary body returning *PostSendMessageResponse
-func (c *ClientWithResponses) PostSendMessageWithBodyWithResponse(ctx context.Context, params *PostSendMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error) {
-	rsp, err := c.PostSendMessageWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostRemoveReactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostSendMessageResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostSendMessageWithResponse(ctx context.Context, params *PostSendMessageParams, body PostSendMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSendMessageResponse, error) {
-	rsp, err := c.PostSendMessage(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReactionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParsePostSendMessageResponse(rsp)
+
+	return response, nil
 }
 
-// ParsePostCloseChatResponse parses an HTTP response from a PostCloseChatWithResponse call
-func ParsePostCloseChatResponse(rsp *http.Response) (*PostCloseChatResponse, error) {
+// ParsePostSearchMessagesResponse parses an HTTP response from a PostSearchMessagesWithResponse call
+func ParsePostSearchMessagesResponse(rsp *http.Response) (*PostSearchMessagesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostCloseChatResponse{
+	response := &PostSearchMessagesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CloseChatResponse
+		var dest SearchMessagesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -964,22 +3816,22 @@ func ParsePostCloseChatResponse(rsp *http.Response) (*PostCloseChatResponse, err
 	return response, nil
 }
 
-// ParsePostFreeHandsResponse parses an HTTP response from a PostFreeHandsWithResponse call
-func ParsePostFreeHandsResponse(rsp *http.Response) (*PostFreeHandsResponse, error) {
+// ParsePostSendCannedResponseResponse parses an HTTP response from a PostSendCannedResponseWithResponse call
+func ParsePostSendCannedResponseResponse(rsp *http.Response) (*PostSendCannedResponseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostFreeHandsResponse{
+	response := &PostSendCannedResponseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FreeHandsResponse
+		var dest SendMessageResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -990,22 +3842,22 @@ func ParsePostFreeHandsResponse(rsp *http.Response) (*PostFreeHandsResponse, err
 	return response, nil
 }
 
-// ParsePostGetChatHistoryResponse parses an HTTP response from a PostGetChatHistoryWithResponse call
-func ParsePostGetChatHistoryResponse(rsp *http.Response) (*PostGetChatHistoryResponse, error) {
+// ParsePostSendMessageResponse parses an HTTP response from a PostSendMessageWithResponse call
+func ParsePostSendMessageResponse(rsp *http.Response) (*PostSendMessageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostGetChatHistoryResponse{
+	response := &PostSendMessageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetChatHistoryResponse
+		var dest SendMessageResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1016,22 +3868,22 @@ func ParsePostGetChatHistoryResponse(rsp *http.Response) (*PostGetChatHistoryRes
 	return response, nil
 }
 
-// ParsePostGetChatsResponse parses an HTTP response from a PostGetChatsWithResponse call
-func ParsePostGetChatsResponse(rsp *http.Response) (*PostGetChatsResponse, error) {
+// ParsePostSetProblemCategoryResponse parses an HTTP response from a PostSetProblemCategoryWithResponse call
+func ParsePostSetProblemCategoryResponse(rsp *http.Response) (*PostSetProblemCategoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostGetChatsResponse{
+	response := &PostSetProblemCategoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetChatsResponse
+		var dest SetProblemCategoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1042,22 +3894,22 @@ func ParsePostGetChatsResponse(rsp *http.Response) (*PostGetChatsResponse, error
 	return response, nil
 }
 
-// ParsePostGetFreeHandsBtnAvailabilityResponse parses an HTTP response from a PostGetFreeHandsBtnAvailabilityWithResponse call
-func ParsePostGetFreeHandsBtnAvailabilityResponse(rsp *http.Response) (*PostGetFreeHandsBtnAvailabilityResponse, error) {
+// ParsePostUpdateCannedResponseResponse parses an HTTP response from a PostUpdateCannedResponseWithResponse call
+func ParsePostUpdateCannedResponseResponse(rsp *http.Response) (*PostUpdateCannedResponseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostGetFreeHandsBtnAvailabilityResponse{
+	response := &PostUpdateCannedResponseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetFreeHandsBtnAvailabilityResponse
+		var dest CannedResponseResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1068,22 +3920,22 @@ func ParsePostGetFreeHandsBtnAvailabilityResponse(rsp *http.Response) (*PostGetF
 	return response, nil
 }
 
-// ParsePostSendMessageResponse parses an HTTP response from a PostSendMessageWithResponse call
-func ParsePostSendMessageResponse(rsp *http.Response) (*PostSendMessageResponse, error) {
+// ParsePostUploadAttachmentResponse parses an HTTP response from a PostUploadAttachmentWithResponse call
+func ParsePostUploadAttachmentResponse(rsp *http.Response) (*PostUploadAttachmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostSendMessageResponse{
+	response := &PostUploadAttachmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SendMessageResponse
+		var dest UploadAttachmentResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
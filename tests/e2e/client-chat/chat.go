@@ -38,6 +38,9 @@ type Chat struct {
 	msgMu        *sync.RWMutex
 	messagesByID map[types.MessageID]*Message
 	messages     []*Message
+
+	// problemToRate is the resolved problem the client was asked to rate.
+	problemToRate types.ProblemID
 }
 
 func New(opts Options) (*Chat, error) {
@@ -83,6 +86,13 @@ func (c *Chat) Messages() []Message {
 	return result
 }
 
+func (c *Chat) ProblemToRate() types.ProblemID {
+	c.msgMu.RLock()
+	defer c.msgMu.RUnlock()
+
+	return c.problemToRate
+}
+
 func (c *Chat) MessagesCount() int {
 	c.msgMu.RLock()
 	defer c.msgMu.RUnlock()
@@ -195,6 +205,34 @@ func (c *Chat) SendMessage(ctx context.Context, body string, opts ...SendMessage
 	return nil
 }
 
+func (c *Chat) RateProblem(ctx context.Context, problemID types.ProblemID, score int, comment string) error {
+	resp, err := c.api.PostRateProblemWithResponse(ctx,
+		&apiclientv1.PostRateProblemParams{XRequestID: types.NewRequestID()},
+		apiclientv1.PostRateProblemJSONRequestBody{
+			ProblemId: problemID,
+			Score:     score,
+			Comment:   pointer.PtrWithZeroAsNil(comment),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("post request: %v", err)
+	}
+	if resp.JSON200 == nil {
+		return errNoResponseBody
+	}
+	if err := resp.JSON200.Error; err != nil {
+		return fmt.Errorf("%v: %v", err.Code, err.Message)
+	}
+
+	c.msgMu.Lock()
+	defer c.msgMu.Unlock()
+
+	if c.problemToRate == problemID {
+		c.problemToRate = types.ProblemIDNil
+	}
+	return nil
+}
+
 func (c *Chat) HandleEvent(_ context.Context, data []byte) error {
 	ginkgo.GinkgoWriter.Printf("client %s chat: new event: %s\n", c.id, string(data))
 
@@ -220,6 +258,12 @@ func (c *Chat) HandleEvent(_ context.Context, data []byte) error {
 			vv.CreatedAt,
 		))
 
+	case apiclientevents.RateProblemRequestEvent:
+		c.msgMu.Lock()
+		defer c.msgMu.Unlock()
+
+		c.problemToRate = vv.ProblemId
+
 	case apiclientevents.MessageId:
 		c.msgMu.Lock()
 		defer c.msgMu.Unlock()
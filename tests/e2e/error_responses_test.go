@@ -91,13 +91,25 @@ var _ = Describe("Error Responses", Ordered, func() {
 		// Action.
 		resp, err := apiManagerV1.PostCloseChatWithResponse(ctx,
 			&apimanagerv1.PostCloseChatParams{XRequestID: types.NewRequestID()},
-			apimanagerv1.PostCloseChatJSONRequestBody{ChatId: types.NewChatID()},
+			apimanagerv1.PostCloseChatJSONRequestBody{ChatId: types.NewChatID(), ResolutionCode: "answered"},
 		)
 
 		// Assert.
 		Expect(err).ShouldNot(HaveOccurred())
 		expectCloseChatRespCode(resp, apimanagerv1.ErrorCodeAssignedProblemNotFound)
 	})
+
+	It("custom code, resolution code is not in the taxonomy", func() {
+		// Action.
+		resp, err := apiManagerV1.PostCloseChatWithResponse(ctx,
+			&apimanagerv1.PostCloseChatParams{XRequestID: types.NewRequestID()},
+			apimanagerv1.PostCloseChatJSONRequestBody{ChatId: types.NewChatID(), ResolutionCode: "unknown"},
+		)
+
+		// Assert.
+		Expect(err).ShouldNot(HaveOccurred())
+		expectCloseChatRespCode(resp, apimanagerv1.ErrorCodeUnknownResolutionCode)
+	})
 })
 
 func expectSendClientMsgRespCode[TCode ~int](resp *apiclientv1.PostSendMessageResponse, code TCode) {
@@ -287,7 +287,7 @@ func (ws *Workspace) CloseChat(ctx context.Context, chatID types.ChatID) error {
 
 	resp, err := ws.api.PostCloseChatWithResponse(ctx,
 		&apimanagerv1.PostCloseChatParams{XRequestID: types.NewRequestID()},
-		apimanagerv1.PostCloseChatJSONRequestBody{ChatId: chatID},
+		apimanagerv1.PostCloseChatJSONRequestBody{ChatId: chatID, ResolutionCode: "answered"},
 	)
 	if err != nil {
 		return fmt.Errorf("post request: %v", err)
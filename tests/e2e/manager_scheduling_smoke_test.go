@@ -85,6 +85,7 @@ var _ = Describe("Manager Scheduling Smoke", Ordered, func() {
 		waitForEvent(clientStream) // NewMessageEvent.
 		waitForEvent(clientStream) // MessageSentEvent.
 		waitForEvent(clientStream) // NewMessageEvent (service).
+		waitForEvent(clientStream) // RateProblemRequestEvent.
 
 		msg, ok := clientChat.LastMessage()
 		Expect(ok).Should(BeTrue())
@@ -185,6 +186,7 @@ var _ = Describe("Manager Scheduling Smoke", Ordered, func() {
 		// Client side.
 
 		waitForEvent(clientStream) // NewMessageEvent (service).
+		waitForEvent(clientStream) // RateProblemRequestEvent.
 
 		msg, ok := clientChat.LastMessage()
 		Expect(ok).Should(BeTrue())
@@ -205,4 +207,16 @@ Thank you for being with us!`))
 		*/
 		Expect(clientChat.MessagesCount()).Should(Equal(4))
 	})
+
+	It("client rates resolved problem", func() {
+		problemID := clientChat.ProblemToRate()
+		Expect(problemID.IsZero()).Should(BeFalse())
+
+		err := clientChat.RateProblem(ctx, problemID, 5, "Thank you!")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(clientChat.ProblemToRate().IsZero()).Should(BeTrue())
+
+		err = clientChat.RateProblem(ctx, problemID, 1, "")
+		Expect(err).Should(HaveOccurred())
+	})
 })